@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"strconv"
 	"strings"
@@ -14,6 +15,8 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"golang.org/x/term"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-cd/v3/util/rbac"
@@ -28,6 +31,7 @@ import (
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
 	"github.com/argoproj/argo-cd/v3/util/localconfig"
 	sessionutil "github.com/argoproj/argo-cd/v3/util/session"
+	"github.com/argoproj/argo-cd/v3/util/settings"
 	"github.com/argoproj/argo-cd/v3/util/templates"
 )
 
@@ -60,15 +64,39 @@ func NewAccountCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command.AddCommand(NewAccountGenerateTokenCommand(clientOpts))
 	command.AddCommand(NewAccountGetCommand(clientOpts))
 	command.AddCommand(NewAccountDeleteTokenCommand(clientOpts))
+	command.AddCommand(NewAccountCreateCommand(clientOpts))
+	command.AddCommand(NewAccountDeleteCommand(clientOpts))
+	command.AddCommand(NewAccountDisableCommand(clientOpts))
+	command.AddCommand(NewAccountEnableCommand(clientOpts))
+	command.AddCommand(NewAccountUnlockCommand(clientOpts))
+	command.AddCommand(NewAccountHistoryCommand(clientOpts))
+	command.AddCommand(NewAccountPermissionsCommand(clientOpts))
 	command.AddCommand(NewBcryptCmd())
 	return command
 }
 
+// passwordPolicyViolations returns the password policy violations reported by the given UpdatePassword error, if any
+func passwordPolicyViolations(err error) []string {
+	if err == nil {
+		return nil
+	}
+	st := status.Convert(err)
+	if st.Code() != codes.InvalidArgument {
+		return nil
+	}
+	violations, ok := strings.CutPrefix(st.Message(), settings.PasswordPolicyErrorPrefix)
+	if !ok {
+		return nil
+	}
+	return strings.Split(violations, settings.PasswordPolicyViolationSeparator)
+}
+
 func NewAccountUpdatePasswordCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		account         string
-		currentPassword string
-		newPassword     string
+		account             string
+		currentPassword     string
+		currentPasswordFile string
+		newPassword         string
 	)
 	command := &cobra.Command{
 		Use:   "update-password",
@@ -84,6 +112,9 @@ has appropriate RBAC permissions to change other accounts.
 
 	# Update the password for user foobar
 	argocd account update-password --account foobar
+
+	# Update the current user's password, reading the current password from stdin
+	argocd account update-password --current-password-file - --new-password "$NEW_PASSWORD" < current-password.txt
 `,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
@@ -92,6 +123,12 @@ has appropriate RBAC permissions to change other accounts.
 				c.HelpFunc()(c, args)
 				os.Exit(1)
 			}
+			if currentPasswordFile != "" {
+				var err error
+				currentPassword, err = cli.ReadPasswordFile(currentPasswordFile)
+				errors.CheckErrorWithContext(ctx, err)
+			}
+
 			acdClient := headless.NewClientOrDie(clientOpts, c)
 			conn, usrIf := acdClient.NewAccountClientOrDie()
 			defer utilio.Close(conn)
@@ -99,9 +136,12 @@ has appropriate RBAC permissions to change other accounts.
 			userInfo := getCurrentAccount(ctx, acdClient)
 
 			if userInfo.Iss == sessionutil.SessionManagerClaimsIssuer && currentPassword == "" {
+				if !cli.StdinIsTerminal() {
+					errors.Fatal(errors.ErrorGeneric, "--current-password or --current-password-file must be provided when stdin is not a terminal")
+				}
 				fmt.Printf("*** Enter password of currently logged in user (%s): ", userInfo.Username)
 				password, err := term.ReadPassword(int(os.Stdin.Fd()))
-				errors.CheckError(err)
+				errors.CheckErrorWithContext(ctx, err)
 				currentPassword = string(password)
 				fmt.Print("\n")
 			}
@@ -110,45 +150,60 @@ has appropriate RBAC permissions to change other accounts.
 				account = userInfo.Username
 			}
 
-			if newPassword == "" {
-				var err error
-				newPassword, err = cli.ReadAndConfirmPassword(account)
-				errors.CheckError(err)
-			}
+			promptNewPassword := newPassword == ""
+			for {
+				if promptNewPassword {
+					var err error
+					newPassword, err = cli.ReadAndConfirmPassword(account)
+					errors.CheckErrorWithContext(ctx, err)
+				}
 
-			updatePasswordRequest := accountpkg.UpdatePasswordRequest{
-				NewPassword:     newPassword,
-				CurrentPassword: currentPassword,
-				Name:            account,
-			}
+				updatePasswordRequest := accountpkg.UpdatePasswordRequest{
+					NewPassword:     newPassword,
+					CurrentPassword: currentPassword,
+					Name:            account,
+				}
 
-			_, err := usrIf.UpdatePassword(ctx, &updatePasswordRequest)
-			errors.CheckError(err)
+				_, err := usrIf.UpdatePassword(ctx, &updatePasswordRequest)
+				if violations := passwordPolicyViolations(err); promptNewPassword && len(violations) > 0 {
+					// let the user pick another password rather than starting over
+					fmt.Println("New password does not satisfy the password policy:")
+					for _, violation := range violations {
+						fmt.Printf("  - %s\n", violation)
+					}
+					continue
+				}
+				errors.CheckErrorWithContext(ctx, err)
+				break
+			}
 			fmt.Printf("Password updated\n")
 
 			if account == "" || account == userInfo.Username {
 				// Get a new JWT token after updating the password
 				localCfg, err := localconfig.ReadLocalConfig(clientOpts.ConfigPath)
-				errors.CheckError(err)
+				errors.CheckErrorWithContext(ctx, err)
 				configCtx, err := localCfg.ResolveContext(clientOpts.Context)
-				errors.CheckError(err)
+				errors.CheckErrorWithContext(ctx, err)
 				claims, err := configCtx.User.Claims()
-				errors.CheckError(err)
+				errors.CheckErrorWithContext(ctx, err)
 				tokenString := passwordLogin(ctx, acdClient, localconfig.GetUsername(claims.Subject), newPassword)
 				localCfg.UpsertUser(localconfig.User{
 					Name:      localCfg.CurrentContext,
 					AuthToken: tokenString,
 				})
 				err = localconfig.WriteLocalConfig(*localCfg, clientOpts.ConfigPath)
-				errors.CheckError(err)
+				errors.CheckErrorWithContext(ctx, err)
 				fmt.Printf("Context '%s' updated\n", localCfg.CurrentContext)
 			}
 		},
 	}
 
 	command.Flags().StringVar(&currentPassword, "current-password", "", "Password of the currently logged on user")
+	command.Flags().StringVar(&currentPasswordFile, "current-password-file", "", "Path to a file containing the password of the currently logged on user, or '-' to read it from stdin")
 	command.Flags().StringVar(&newPassword, "new-password", "", "New password you want to update to")
+	command.MarkFlagsMutuallyExclusive("current-password", "current-password-file")
 	command.Flags().StringVar(&account, "account", "", "An account name that should be updated. Defaults to current user account")
+	errors.CheckError(command.RegisterFlagCompletionFunc("account", completeAccountNames(clientOpts)))
 	return command
 }
 
@@ -177,16 +232,16 @@ func NewAccountGetUserInfoCommand(clientOpts *argocdclient.ClientOptions) *cobra
 			defer utilio.Close(conn)
 
 			response, err := client.GetUserInfo(ctx, &session.GetUserInfoRequest{})
-			errors.CheckError(err)
+			errors.CheckErrorWithContext(ctx, err)
 
 			switch output {
 			case "yaml":
 				yamlBytes, err := yaml.Marshal(response)
-				errors.CheckError(err)
+				errors.CheckErrorWithContext(ctx, err)
 				fmt.Println(string(yamlBytes))
 			case "json":
 				jsonBytes, err := json.MarshalIndent(response, "", "  ")
-				errors.CheckError(err)
+				errors.CheckErrorWithContext(ctx, err)
 				fmt.Println(string(jsonBytes))
 			case "":
 				fmt.Printf("Logged In: %v\n", response.LoggedIn)
@@ -204,8 +259,60 @@ func NewAccountGetUserInfoCommand(clientOpts *argocdclient.ClientOptions) *cobra
 	return command
 }
 
+// canICheck is a single (action, resource, subresource) tuple checked by `argocd account can-i --file`
+type canICheck struct {
+	Action      string `json:"action"`
+	Resource    string `json:"resource"`
+	Subresource string `json:"subresource"`
+	Result      string `json:"result,omitempty"`
+}
+
+// parseCanIChecks parses the tuples to check either from a YAML/JSON list of objects with action, resource and
+// subresource fields, or from lines of whitespace separated ACTION RESOURCE SUBRESOURCE. Empty lines and lines
+// starting with '#' are ignored.
+func parseCanIChecks(data []byte) ([]canICheck, error) {
+	var checks []canICheck
+	if err := yaml.Unmarshal(data, &checks); err == nil {
+		for i, check := range checks {
+			if check.Action == "" || check.Resource == "" || check.Subresource == "" {
+				return nil, fmt.Errorf("entry %d: action, resource and subresource are required", i+1)
+			}
+		}
+		return checks, nil
+	}
+	checks = nil
+	for i, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("line %d: expected ACTION RESOURCE SUBRESOURCE, got %q", i+1, line)
+		}
+		checks = append(checks, canICheck{Action: fields[0], Resource: fields[1], Subresource: fields[2]})
+	}
+	return checks, nil
+}
+
+func printCanIChecksTable(out io.Writer, checks []canICheck) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "ACTION\tRESOURCE\tSUBRESOURCE\tRESULT\n")
+	for _, check := range checks {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", check.Action, check.Resource, check.Subresource, check.Result)
+	}
+	_ = w.Flush()
+}
+
 func NewAccountCanICommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
-	return &cobra.Command{
+	var (
+		list     bool
+		file     string
+		output   string
+		as       string
+		asGroups []string
+	)
+	command := &cobra.Command{
 		Use:   "can-i ACTION RESOURCE SUBRESOURCE",
 		Short: "Can I",
 		Example: fmt.Sprintf(`
@@ -218,29 +325,184 @@ argocd account can-i update projects 'default'
 # Can I create a cluster?
 argocd account can-i create clusters '*'
 
+# What am I allowed to do?
+argocd account can-i --list
+
+# Check several permissions at once, one "ACTION RESOURCE SUBRESOURCE" per line
+argocd account can-i --file checks.txt
+
+# Check permissions read from stdin
+echo "get applications default/*" | argocd account can-i --file -
+
+# Can alice, as a member of the sre group, sync apps of the myproj project? (requires the accounts, impersonate permission)
+argocd account can-i sync applications 'myproj/*' --as alice --as-group sre
+
 Actions: %v
 Resources: %v
 `, rbac.Actions, rbac.Resources),
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
-			if len(args) != 3 {
+			if list && file != "" {
+				errors.Fatal(errors.ErrorGeneric, "--list and --file cannot be combined")
+			}
+			impersonate := as != "" || len(asGroups) > 0
+			if list && impersonate {
+				errors.Fatal(errors.ErrorGeneric, "--list cannot be combined with --as or --as-group")
+			}
+			if ((list || file != "") && len(args) != 0) || (!list && file == "" && len(args) != 3) {
 				c.HelpFunc()(c, args)
 				os.Exit(1)
 			}
 
+			var checks []canICheck
+			if file != "" {
+				var data []byte
+				var err error
+				if file == "-" {
+					data, err = io.ReadAll(os.Stdin)
+				} else {
+					data, err = os.ReadFile(file)
+				}
+				errors.CheckErrorWithContext(ctx, err)
+				checks, err = parseCanIChecks(data)
+				if err != nil {
+					errors.Fatalf(errors.ErrorGeneric, "failed to parse %s: %v", file, err)
+				}
+			}
+
 			conn, client := headless.NewClientOrDie(clientOpts, c).NewAccountClientOrDie()
 			defer utilio.Close(conn)
 
-			response, err := client.CanI(ctx, &accountpkg.CanIRequest{
-				Action:      args[0],
-				Resource:    args[1],
-				Subresource: args[2],
-			})
-			errors.CheckError(err)
+			canI := func(action, resource, subresource string) (*accountpkg.CanIResponse, error) {
+				if impersonate {
+					return client.CanIAs(ctx, &accountpkg.CanIAsRequest{
+						Action:      action,
+						Resource:    resource,
+						Subresource: subresource,
+						Subject:     as,
+						Groups:      asGroups,
+					})
+				}
+				return client.CanI(ctx, &accountpkg.CanIRequest{
+					Action:      action,
+					Resource:    resource,
+					Subresource: subresource,
+				})
+			}
+
+			if list {
+				response, err := client.CanI(ctx, &accountpkg.CanIRequest{
+					Action:      accountpkg.CanIListAll,
+					Resource:    accountpkg.CanIListAll,
+					Subresource: accountpkg.CanIListAll,
+				})
+				errors.CheckErrorWithContext(ctx, err)
+				var permissions []accountpkg.Permission
+				err = json.Unmarshal([]byte(response.Value), &permissions)
+				if err != nil {
+					errors.Fatal(errors.ErrorGeneric, "the server does not support listing permissions, upgrade it to use --list")
+				}
+				errors.CheckErrorWithContext(ctx, printPermissions(permissions, output))
+				return
+			}
+
+			if file != "" {
+				for i := range checks {
+					response, err := canI(checks[i].Action, checks[i].Resource, checks[i].Subresource)
+					if err != nil {
+						checks[i].Result = "error: " + status.Convert(err).Message()
+						continue
+					}
+					checks[i].Result = response.Value
+				}
+				switch output {
+				case "json", "yaml":
+					err := PrintResourceList(checks, output, false)
+					errors.CheckErrorWithContext(ctx, err)
+				case "wide", "":
+					printCanIChecksTable(os.Stdout, checks)
+				default:
+					errors.Fatalf(errors.ErrorGeneric, "unknown output format: %s", output)
+				}
+				return
+			}
+
+			response, err := canI(args[0], args[1], args[2])
+			errors.CheckErrorWithContext(ctx, err)
 			fmt.Println(response.Value)
 		},
 	}
+	command.Flags().BoolVar(&list, "list", false, "List all permissions granted or denied to the current account by the RBAC policy")
+	command.Flags().StringVarP(&file, "file", "f", "", "Check every ACTION RESOURCE SUBRESOURCE tuple listed in the file, or in stdin if set to '-'. The file may also be a YAML or JSON list of objects with action, resource and subresource fields")
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format of --list and --file. One of: json|yaml|wide")
+	command.Flags().StringVar(&as, "as", "", "Check the permissions of the given user instead of the current account")
+	command.Flags().StringArrayVar(&asGroups, "as-group", []string{}, "Group to assume the --as user is a member of, can be repeated")
+	return command
+}
+
+// printPermissions prints the permissions in the given output format. The wide format merges the actions on the same
+// objects into a single row, while the table format prints a row per permission.
+func printPermissions(permissions []accountpkg.Permission, output string) error {
+	switch output {
+	case "json", "yaml":
+		return PrintResourceList(permissions, output, false)
+	case "wide", "":
+		printPermissionsTable(os.Stdout, permissions)
+	case "table":
+		printPermissionsRows(os.Stdout, permissions)
+	default:
+		return fmt.Errorf("unknown output format: %s", output)
+	}
+	return nil
+}
+
+// printPermissionsRows prints a row per permission, with the effect of deny rules in upper case
+func printPermissionsRows(out io.Writer, permissions []accountpkg.Permission) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "ACTION\tRESOURCE\tOBJECT PATTERN\tEFFECT\tVIA\n")
+	for _, p := range permissions {
+		effect := p.Effect
+		if effect == "deny" {
+			effect = "DENY"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", p.Action, p.Resource, p.Object, effect, p.Subject)
+	}
+	_ = w.Flush()
+}
+
+// printPermissionsTable prints the permissions with the actions allowed or denied on the same objects through the same
+// subject merged into a single row. Deny rules are marked with an upper case effect.
+func printPermissionsTable(out io.Writer, permissions []accountpkg.Permission) {
+	type row struct {
+		resource string
+		actions  []string
+		object   string
+		effect   string
+		subject  string
+	}
+	var rows []*row
+	index := make(map[string]*row)
+	for _, p := range permissions {
+		key := strings.Join([]string{p.Resource, p.Object, p.Effect, p.Subject}, "\x00")
+		r, ok := index[key]
+		if !ok {
+			r = &row{resource: p.Resource, object: p.Object, effect: p.Effect, subject: p.Subject}
+			index[key] = r
+			rows = append(rows, r)
+		}
+		r.actions = append(r.actions, p.Action)
+	}
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "RESOURCE\tACTIONS\tOBJECT PATTERN\tEFFECT\tVIA\n")
+	for _, r := range rows {
+		effect := r.effect
+		if effect == "deny" {
+			effect = "DENY"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", r.resource, strings.Join(r.actions, ","), r.object, effect, r.subject)
+	}
+	_ = w.Flush()
 }
 
 func printAccountNames(accounts []*accountpkg.Account) {
@@ -249,15 +511,34 @@ func printAccountNames(accounts []*accountpkg.Account) {
 	}
 }
 
-func printAccountsTable(items []*accountpkg.Account) {
-	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintf(w, "NAME\tENABLED\tCAPABILITIES\n")
+func printAccountsTable(out io.Writer, items []*accountpkg.Account) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "NAME\tENABLED\tCAPABILITIES\tTOKENS\tNEXT EXPIRY\n")
 	for _, a := range items {
-		fmt.Fprintf(w, "%s\t%v\t%s\n", a.Name, a.Enabled, strings.Join(a.Capabilities, ", "))
+		fmt.Fprintf(w, "%s\t%v\t%s\t%s\t%s\n", a.Name, a.Enabled, strings.Join(a.Capabilities, ", "), formatTokenCount(a), formatNextTokenExpiry(a))
 	}
 	_ = w.Flush()
 }
 
+// formatTokenCount returns the number of tokens of the account, highlighting the ones which never expire
+func formatTokenCount(a *accountpkg.Account) string {
+	if a.NonExpiringTokenCount == 0 {
+		return strconv.FormatInt(a.TokenCount, 10)
+	}
+	return fmt.Sprintf("%d (%d never expire)", a.TokenCount, a.NonExpiringTokenCount)
+}
+
+// formatNextTokenExpiry returns when the next token of the account expires
+func formatNextTokenExpiry(a *accountpkg.Account) string {
+	if a.NextTokenExpiresAt > 0 {
+		return time.Unix(a.NextTokenExpiresAt, 0).Format(time.RFC3339)
+	}
+	if a.NonExpiringTokenCount > 0 {
+		return "never"
+	}
+	return "-"
+}
+
 func NewAccountListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var output string
 	cmd := &cobra.Command{
@@ -272,15 +553,15 @@ func NewAccountListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 
 			response, err := client.ListAccounts(ctx, &accountpkg.ListAccountRequest{})
 
-			errors.CheckError(err)
+			errors.CheckErrorWithContext(ctx, err)
 			switch output {
 			case "yaml", "json":
 				err := PrintResourceList(response.Items, output, false)
-				errors.CheckError(err)
+				errors.CheckErrorWithContext(ctx, err)
 			case "name":
 				printAccountNames(response.Items)
 			case "wide", "":
-				printAccountsTable(response.Items)
+				printAccountsTable(os.Stdout, response.Items)
 			default:
 				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
 			}
@@ -294,7 +575,7 @@ func getCurrentAccount(ctx context.Context, clientset argocdclient.Client) sessi
 	conn, client := clientset.NewSessionClientOrDie()
 	defer utilio.Close(conn)
 	userInfo, err := client.GetUserInfo(ctx, &session.GetUserInfoRequest{})
-	errors.CheckError(err)
+	errors.CheckErrorWithContext(ctx, err)
 	return *userInfo
 }
 
@@ -325,11 +606,11 @@ argocd account get --account <account-name>`,
 
 			acc, err := client.GetAccount(ctx, &accountpkg.GetAccountRequest{Name: account})
 
-			errors.CheckError(err)
+			errors.CheckErrorWithContext(ctx, err)
 			switch output {
 			case "yaml", "json":
 				err := PrintResourceList(acc, output, true)
-				errors.CheckError(err)
+				errors.CheckErrorWithContext(ctx, err)
 			case "name":
 				fmt.Println(acc.Name)
 			case "wide", "":
@@ -341,19 +622,28 @@ argocd account get --account <account-name>`,
 	}
 	cmd.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide|name")
 	cmd.Flags().StringVarP(&account, "account", "a", "", "Account name. Defaults to the current account.")
+	errors.CheckError(cmd.RegisterFlagCompletionFunc("account", completeAccountNames(clientOpts)))
 	return cmd
 }
 
 func printAccountDetails(acc *accountpkg.Account) {
 	fmt.Printf(printOpFmtStr, "Name:", acc.Name)
 	fmt.Printf(printOpFmtStr, "Enabled:", strconv.FormatBool(acc.Enabled))
+	locked := strconv.FormatBool(acc.Locked)
+	if acc.Locked {
+		locked += fmt.Sprintf(" (since %s)", time.Unix(acc.LockedAt, 0).Format(time.RFC3339))
+	}
+	fmt.Printf(printOpFmtStr, "Locked:", locked)
 	fmt.Printf(printOpFmtStr, "Capabilities:", strings.Join(acc.Capabilities, ", "))
+	if len(acc.Projects) > 0 {
+		fmt.Printf(printOpFmtStr, "Projects:", strings.Join(acc.Projects, ", "))
+	}
 	fmt.Println("\nTokens:")
 	if len(acc.Tokens) == 0 {
 		fmt.Println("NONE")
 	} else {
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintf(w, "ID\tISSUED AT\tEXPIRING AT\n")
+		fmt.Fprintf(w, "ID\tISSUED AT\tEXPIRING AT\tLAST USED\tSCOPE\n")
 		for _, t := range acc.Tokens {
 			expiresAtFormatted := "never"
 			if t.ExpiresAt > 0 {
@@ -364,17 +654,44 @@ func printAccountDetails(acc *accountpkg.Account) {
 				}
 			}
 
-			fmt.Fprintf(w, "%s\t%s\t%s\n", t.Id, time.Unix(t.IssuedAt, 0).Format(time.RFC3339), expiresAtFormatted)
+			lastUsedFormatted := "never"
+			if t.LastUsedAt > 0 {
+				lastUsedFormatted = time.Unix(t.LastUsedAt, 0).Format(time.RFC3339)
+			}
+
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", t.Id, time.Unix(t.IssuedAt, 0).Format(time.RFC3339), expiresAtFormatted, lastUsedFormatted, formatTokenScope(t.Scope))
 		}
 		_ = w.Flush()
 	}
 }
 
+// formatTokenScope returns a human readable representation of the token scope
+func formatTokenScope(scope *accountpkg.TokenScope) string {
+	if scope == nil {
+		return "-"
+	}
+	var parts []string
+	if len(scope.Projects) > 0 {
+		parts = append(parts, "projects="+strings.Join(scope.Projects, ","))
+	}
+	if len(scope.Actions) > 0 {
+		parts = append(parts, "actions="+strings.Join(scope.Actions, ","))
+	}
+	if len(scope.Resources) > 0 {
+		parts = append(parts, "resources="+strings.Join(scope.Resources, ","))
+	}
+	return strings.Join(parts, " ")
+}
+
 func NewAccountGenerateTokenCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
 		account   string
 		expiresIn string
 		id        string
+		projects  []string
+		actions   []string
+		resources []string
+		tokenType string
 	)
 	cmd := &cobra.Command{
 		Use:   "generate-token",
@@ -383,7 +700,13 @@ func NewAccountGenerateTokenCommand(clientOpts *argocdclient.ClientOptions) *cob
 argocd account generate-token
 
 # Generate token for the account with the specified name
-argocd account generate-token --account <account-name>`,
+argocd account generate-token --account <account-name>
+
+# Generate token that may only get and sync applications of the project foo
+argocd account generate-token --account ci --project foo --action get,sync --resource applications
+
+# Generate a client id and secret, which are exchanged for short-lived tokens at the /auth/token endpoint
+argocd account generate-token --account ci --type client-credentials`,
 		Run: func(c *cobra.Command, _ []string) {
 			ctx := c.Context()
 
@@ -394,27 +717,44 @@ argocd account generate-token --account <account-name>`,
 				account = getCurrentAccount(ctx, clientset).Username
 			}
 			expiresIn, err := timeutil.ParseDuration(expiresIn)
-			errors.CheckError(err)
+			errors.CheckErrorWithContext(ctx, err)
+			var scope *accountpkg.TokenScope
+			if len(projects) > 0 || len(actions) > 0 || len(resources) > 0 {
+				scope = &accountpkg.TokenScope{Projects: projects, Actions: actions, Resources: resources}
+			}
 			response, err := client.CreateToken(ctx, &accountpkg.CreateTokenRequest{
 				Name:      account,
 				ExpiresIn: int64(expiresIn.Seconds()),
 				Id:        id,
+				Scope:     scope,
+				Type:      tokenType,
 			})
-			errors.CheckError(err)
+			errors.CheckErrorWithContext(ctx, err)
+			if tokenType == settings.TokenTypeClientCredentials {
+				fmt.Printf(printOpFmtStr, "Client ID:", response.ClientId)
+				fmt.Printf(printOpFmtStr, "Client Secret:", response.ClientSecret)
+				return
+			}
 			fmt.Println(response.Token)
 		},
 	}
 	cmd.Flags().StringVarP(&account, "account", "a", "", "Account name. Defaults to the current account.")
+	errors.CheckError(cmd.RegisterFlagCompletionFunc("account", completeAccountNames(clientOpts)))
 	cmd.Flags().StringVarP(&expiresIn, "expires-in", "e", "0s", "Duration before the token will expire. (Default: No expiration)")
 	cmd.Flags().StringVar(&id, "id", "", "Optional token id. Fall back to uuid if not value specified.")
+	cmd.Flags().StringSliceVar(&projects, "project", nil, "Restrict the token to objects of the given projects")
+	cmd.Flags().StringSliceVar(&actions, "action", nil, fmt.Sprintf("Restrict the token to the given actions, any of: %s", strings.Join(rbac.Actions, ", ")))
+	cmd.Flags().StringSliceVar(&resources, "resource", nil, fmt.Sprintf("Restrict the token to the given resources, any of: %s", strings.Join(rbac.Resources, ", ")))
+	cmd.Flags().StringVar(&tokenType, "type", "", fmt.Sprintf("Type of the token. Use %s to generate a client id and secret instead of an API key", settings.TokenTypeClientCredentials))
 	return cmd
 }
 
 func NewAccountDeleteTokenCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var account string
 	cmd := &cobra.Command{
-		Use:   "delete-token",
-		Short: "Deletes account token",
+		Use:               "delete-token",
+		ValidArgsFunction: completeTokenIDs(clientOpts),
+		Short:             "Deletes account token",
 		Example: `# Delete token of the currently logged in account
 argocd account delete-token ID
 
@@ -439,12 +779,280 @@ argocd account delete-token --account <account-name> ID`,
 			canDelete := promptUtil.Confirm(fmt.Sprintf("Are you sure you want to delete '%s' token? [y/n]", id))
 			if canDelete {
 				_, err := client.DeleteToken(ctx, &accountpkg.DeleteTokenRequest{Name: account, Id: id})
-				errors.CheckError(err)
+				errors.CheckErrorWithContext(ctx, err)
 			} else {
 				fmt.Printf("The command to delete '%s' was cancelled.\n", id)
 			}
 		},
 	}
 	cmd.Flags().StringVarP(&account, "account", "a", "", "Account name. Defaults to the current account.")
+	errors.CheckError(cmd.RegisterFlagCompletionFunc("account", completeAccountNames(clientOpts)))
+	return cmd
+}
+
+// NewAccountCreateCommand returns a new instance of an `argocd account create` command
+func NewAccountCreateCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		capabilities []string
+		projects     []string
+		output       string
+	)
+	cmd := &cobra.Command{
+		Use:   "create NAME",
+		Short: "Create a local account",
+		Example: `# Create an account that can log in to the UI
+argocd account create alice
+
+# Create an account that can log in and generate API tokens
+argocd account create alice --capabilities login,apiKey
+
+# Create an account for a CI system whose requests are restricted to the projects foo and bar
+argocd account create ci --capabilities apiKey --project foo,bar`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+
+			conn, client := headless.NewClientOrDie(clientOpts, c).NewAccountClientOrDie()
+			defer utilio.Close(conn)
+
+			acc, err := client.CreateAccount(ctx, &accountpkg.CreateAccountRequest{Name: args[0], Capabilities: capabilities, Projects: projects})
+			errors.CheckErrorWithContext(ctx, err)
+			switch output {
+			case "yaml", "json":
+				err := PrintResourceList(acc, output, true)
+				errors.CheckErrorWithContext(ctx, err)
+			case "name":
+				fmt.Println(acc.Name)
+			case "wide", "":
+				fmt.Printf("Account '%s' created\n", acc.Name)
+				fmt.Println("Use 'argocd account update-password --account " + acc.Name + "' to set its password")
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	cmd.Flags().StringSliceVar(&capabilities, "capabilities", []string{"login"}, "Comma separated list of account capabilities. Supported values: login, apiKey")
+	cmd.Flags().StringSliceVar(&projects, "project", nil, "Bind the account to the given projects. All requests of the account are restricted to them, regardless of its RBAC permissions")
+	cmd.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide|name")
+	return cmd
+}
+
+// NewAccountDeleteCommand returns a new instance of an `argocd account delete` command
+func NewAccountDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "delete NAME",
+		ValidArgsFunction: completeAccountNames(clientOpts),
+		Short:             "Delete a local account together with its password and tokens",
+		Example: `# Delete an account
+argocd account delete alice`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			name := args[0]
+
+			conn, client := headless.NewClientOrDie(clientOpts, c).NewAccountClientOrDie()
+			defer utilio.Close(conn)
+
+			promptUtil := utils.NewPrompt(clientOpts.PromptsEnabled)
+			canDelete := promptUtil.Confirm(fmt.Sprintf("Are you sure you want to delete account '%s'? [y/n]", name))
+			if canDelete {
+				_, err := client.DeleteAccount(ctx, &accountpkg.DeleteAccountRequest{Name: name})
+				errors.CheckErrorWithContext(ctx, err)
+				fmt.Printf("Account '%s' deleted\n", name)
+			} else {
+				fmt.Printf("The command to delete '%s' was cancelled.\n", name)
+			}
+		},
+	}
+	return cmd
+}
+
+// NewAccountDisableCommand returns a new instance of an `argocd account disable` command
+func NewAccountDisableCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "disable NAME",
+		ValidArgsFunction: completeAccountNames(clientOpts),
+		Short:             "Disable a local account and invalidate its active sessions and tokens",
+		Example: `# Disable an account
+argocd account disable alice`,
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			setAccountEnabled(c, clientOpts, args[0], false)
+			fmt.Printf("Account '%s' disabled\n", args[0])
+		},
+	}
+	return cmd
+}
+
+// NewAccountEnableCommand returns a new instance of an `argocd account enable` command
+func NewAccountEnableCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "enable NAME",
+		ValidArgsFunction: completeAccountNames(clientOpts),
+		Short:             "Enable a previously disabled local account",
+		Example: `# Enable an account
+argocd account enable alice`,
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			setAccountEnabled(c, clientOpts, args[0], true)
+			fmt.Printf("Account '%s' enabled\n", args[0])
+		},
+	}
+	return cmd
+}
+
+func setAccountEnabled(c *cobra.Command, clientOpts *argocdclient.ClientOptions, name string, enabled bool) {
+	ctx := c.Context()
+
+	conn, client := headless.NewClientOrDie(clientOpts, c).NewAccountClientOrDie()
+	defer utilio.Close(conn)
+
+	_, err := client.SetAccountEnabled(ctx, &accountpkg.SetAccountEnabledRequest{Name: name, Enabled: enabled})
+	errors.CheckErrorWithContext(ctx, err)
+}
+
+// NewAccountUnlockCommand returns a new instance of an `argocd account unlock` command
+func NewAccountUnlockCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "unlock NAME",
+		ValidArgsFunction: completeAccountNames(clientOpts),
+		Short:             "Unlock a local account locked due to too many failed logins",
+		Example: `# Unlock an account
+argocd account unlock alice`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			name := args[0]
+
+			conn, client := headless.NewClientOrDie(clientOpts, c).NewAccountClientOrDie()
+			defer utilio.Close(conn)
+
+			_, err := client.UnlockAccount(ctx, &accountpkg.UnlockAccountRequest{Name: name})
+			errors.CheckErrorWithContext(ctx, err)
+			fmt.Printf("Account '%s' unlocked\n", name)
+		},
+	}
+	return cmd
+}
+
+// NewAccountHistoryCommand returns a new instance of an `argocd account history` command
+func NewAccountHistoryCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		output  string
+		account string
+	)
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "Show the audit trail of an account",
+		Long:  "Show password changes, token creations and deletions, logins and other audit events recorded about a local account.",
+		Example: `# Show the audit trail of the currently logged in account
+argocd account history
+
+# Show the audit trail of an account by name
+argocd account history --account <account-name>`,
+		Run: func(c *cobra.Command, _ []string) {
+			ctx := c.Context()
+
+			clientset := headless.NewClientOrDie(clientOpts, c)
+
+			if account == "" {
+				account = getCurrentAccount(ctx, clientset).Username
+			}
+
+			conn, client := clientset.NewAccountClientOrDie()
+			defer utilio.Close(conn)
+
+			events, err := client.ListEvents(ctx, &accountpkg.ListAccountEventsRequest{Name: account})
+			errors.CheckErrorWithContext(ctx, err)
+			switch output {
+			case "yaml", "json":
+				err := PrintResourceList(events.Items, output, false)
+				errors.CheckErrorWithContext(ctx, err)
+			case "wide", "":
+				printAccountEventsTable(os.Stdout, events.Items)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	cmd.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	cmd.Flags().StringVarP(&account, "account", "a", "", "Account name. Defaults to the current account.")
+	errors.CheckError(cmd.RegisterFlagCompletionFunc("account", completeAccountNames(clientOpts)))
 	return cmd
 }
+
+func printAccountEventsTable(out io.Writer, items []*accountpkg.AccountEvent) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "TIME\tTYPE\tREASON\tUSER\tMESSAGE\n")
+	for _, e := range items {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", time.Unix(e.Time, 0).Format(time.RFC3339), e.Type, e.Reason, e.User, e.Message)
+	}
+	_ = w.Flush()
+}
+
+// NewAccountPermissionsCommand returns a new instance of an `argocd account permissions` command
+func NewAccountPermissionsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		output  string
+		account string
+	)
+	cmd := &cobra.Command{
+		Use:   "permissions",
+		Short: "Show the effective permissions of an account",
+		Long:  "Show the actions an account can perform on which resources and objects, as granted or denied by the RBAC policy to the account, its roles, the default role, and the project roles which list the account in their groups.",
+		Example: `# Show the effective permissions of the currently logged in account
+argocd account permissions
+
+# Show the effective permissions of an account by name
+argocd account permissions --account <account-name>
+
+# Show a row per action, resource and object pattern the account is granted or denied
+argocd account permissions --account <account-name> -o table`,
+		Run: func(c *cobra.Command, _ []string) {
+			ctx := c.Context()
+
+			clientset := headless.NewClientOrDie(clientOpts, c)
+
+			if account == "" {
+				account = getCurrentAccount(ctx, clientset).Username
+			}
+
+			conn, client := clientset.NewAccountClientOrDie()
+			defer utilio.Close(conn)
+
+			permissions, err := client.ListPermissions(ctx, &accountpkg.ListAccountPermissionsRequest{Name: account})
+			errors.CheckErrorWithContext(ctx, err)
+			errors.CheckErrorWithContext(ctx, printPermissions(toPermissions(permissions.Items), output))
+		},
+	}
+	cmd.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide|table")
+	cmd.Flags().StringVarP(&account, "account", "a", "", "Account name. Defaults to the current account.")
+	errors.CheckError(cmd.RegisterFlagCompletionFunc("account", completeAccountNames(clientOpts)))
+	return cmd
+}
+
+func toPermissions(items []*accountpkg.AccountPermission) []accountpkg.Permission {
+	permissions := make([]accountpkg.Permission, 0, len(items))
+	for _, p := range items {
+		permissions = append(permissions, accountpkg.Permission{Subject: p.Subject, Resource: p.Resource, Action: p.Action, Object: p.Object, Effect: p.Effect})
+	}
+	return permissions
+}
@@ -0,0 +1,153 @@
+package commands
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	accountpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/account"
+)
+
+func TestPrintPermissionsTable(t *testing.T) {
+	var buf bytes.Buffer
+	printPermissionsTable(&buf, []accountpkg.Permission{
+		{Subject: "role:dev", Resource: "applications", Action: "get", Object: "dev/*", Effect: "allow"},
+		{Subject: "role:dev", Resource: "applications", Action: "sync", Object: "dev/*", Effect: "allow"},
+		{Subject: "role:dev", Resource: "applications", Action: "delete", Object: "*/*", Effect: "deny"},
+		{Subject: "role:readonly", Resource: "clusters", Action: "get", Object: "*", Effect: "allow"},
+	})
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 4)
+	assert.Equal(t, []string{"RESOURCE", "ACTIONS", "OBJECT", "PATTERN", "EFFECT", "VIA"}, strings.Fields(lines[0]))
+	assert.Equal(t, []string{"applications", "get,sync", "dev/*", "allow", "role:dev"}, strings.Fields(lines[1]))
+	assert.Equal(t, []string{"applications", "delete", "*/*", "DENY", "role:dev"}, strings.Fields(lines[2]))
+	assert.Equal(t, []string{"clusters", "get", "*", "allow", "role:readonly"}, strings.Fields(lines[3]))
+}
+
+func TestPrintPermissionsRows(t *testing.T) {
+	var buf bytes.Buffer
+	printPermissionsRows(&buf, []accountpkg.Permission{
+		{Subject: "role:dev", Resource: "applications", Action: "get", Object: "dev/*", Effect: "allow"},
+		{Subject: "role:dev", Resource: "applications", Action: "delete", Object: "*/*", Effect: "deny"},
+	})
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 3)
+	assert.Equal(t, []string{"ACTION", "RESOURCE", "OBJECT", "PATTERN", "EFFECT", "VIA"}, strings.Fields(lines[0]))
+	assert.Equal(t, []string{"get", "applications", "dev/*", "allow", "role:dev"}, strings.Fields(lines[1]))
+	assert.Equal(t, []string{"delete", "applications", "*/*", "DENY", "role:dev"}, strings.Fields(lines[2]))
+}
+
+func TestToPermissions(t *testing.T) {
+	permissions := toPermissions([]*accountpkg.AccountPermission{
+		{Subject: "proj:demo:deployer", Resource: "applications", Action: "sync", Object: "demo/*", Effect: "allow"},
+	})
+	assert.Equal(t, []accountpkg.Permission{
+		{Subject: "proj:demo:deployer", Resource: "applications", Action: "sync", Object: "demo/*", Effect: "allow"},
+	}, permissions)
+}
+
+func TestParseCanIChecks(t *testing.T) {
+	t.Run("Lines", func(t *testing.T) {
+		checks, err := parseCanIChecks([]byte(`
+# applications
+get applications default/*
+sync  applications  default/guestbook
+
+create clusters *
+`))
+		require.NoError(t, err)
+		assert.Equal(t, []canICheck{
+			{Action: "get", Resource: "applications", Subresource: "default/*"},
+			{Action: "sync", Resource: "applications", Subresource: "default/guestbook"},
+			{Action: "create", Resource: "clusters", Subresource: "*"},
+		}, checks)
+	})
+
+	t.Run("YAML", func(t *testing.T) {
+		checks, err := parseCanIChecks([]byte(`
+- action: get
+  resource: applications
+  subresource: default/*
+- action: update
+  resource: projects
+  subresource: default
+`))
+		require.NoError(t, err)
+		assert.Equal(t, []canICheck{
+			{Action: "get", Resource: "applications", Subresource: "default/*"},
+			{Action: "update", Resource: "projects", Subresource: "default"},
+		}, checks)
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		checks, err := parseCanIChecks([]byte(`[{"action": "get", "resource": "logs", "subresource": "*/*"}]`))
+		require.NoError(t, err)
+		assert.Equal(t, []canICheck{{Action: "get", Resource: "logs", Subresource: "*/*"}}, checks)
+	})
+
+	t.Run("InvalidLine", func(t *testing.T) {
+		_, err := parseCanIChecks([]byte("get applications default/*\nsync applications"))
+		assert.ErrorContains(t, err, "line 2")
+	})
+
+	t.Run("MissingField", func(t *testing.T) {
+		_, err := parseCanIChecks([]byte(`[{"action": "get", "resource": "logs"}]`))
+		assert.ErrorContains(t, err, "entry 1")
+	})
+}
+
+func TestPrintCanIChecksTable(t *testing.T) {
+	var buf bytes.Buffer
+	printCanIChecksTable(&buf, []canICheck{
+		{Action: "get", Resource: "applications", Subresource: "default/*", Result: "yes"},
+		{Action: "delete", Resource: "clusters", Subresource: "*", Result: "no"},
+	})
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 3)
+	assert.Equal(t, []string{"ACTION", "RESOURCE", "SUBRESOURCE", "RESULT"}, strings.Fields(lines[0]))
+	assert.Equal(t, []string{"get", "applications", "default/*", "yes"}, strings.Fields(lines[1]))
+	assert.Equal(t, []string{"delete", "clusters", "*", "no"}, strings.Fields(lines[2]))
+}
+
+func TestPrintAccountsTable(t *testing.T) {
+	var buf bytes.Buffer
+	printAccountsTable(&buf, []*accountpkg.Account{
+		{Name: "admin", Enabled: true, Capabilities: []string{"login"}},
+		{Name: "ci", Enabled: true, Capabilities: []string{"apiKey"}, TokenCount: 3, NonExpiringTokenCount: 2},
+		{Name: "deploy", Enabled: false, Capabilities: []string{"apiKey"}, TokenCount: 1, NextTokenExpiresAt: 1700000000},
+	})
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 4)
+	assert.Equal(t, []string{"NAME", "ENABLED", "CAPABILITIES", "TOKENS", "NEXT", "EXPIRY"}, strings.Fields(lines[0]))
+	assert.Equal(t, []string{"admin", "true", "login", "0", "-"}, strings.Fields(lines[1]))
+	assert.Equal(t, []string{"ci", "true", "apiKey", "3", "(2", "never", "expire)", "never"}, strings.Fields(lines[2]))
+	assert.Equal(t, []string{"deploy", "false", "apiKey", "1", time.Unix(1700000000, 0).Format(time.RFC3339)}, strings.Fields(lines[3]))
+}
+
+func TestPrintAccountEventsTable(t *testing.T) {
+	var buf bytes.Buffer
+	printAccountEventsTable(&buf, []*accountpkg.AccountEvent{
+		{Time: 1700000000, Type: "Normal", Reason: "ResourceCreated", User: "admin", Message: "admin created token ci of account alice"},
+		{Time: 1700000060, Type: "Warning", Reason: "LoginFailed", User: "alice", Message: "alice failed to log in"},
+	})
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 3)
+	assert.Equal(t, []string{"TIME", "TYPE", "REASON", "USER", "MESSAGE"}, strings.Fields(lines[0]))
+	assert.Equal(t, []string{time.Unix(1700000000, 0).Format(time.RFC3339), "Normal", "ResourceCreated", "admin", "admin", "created", "token", "ci", "of", "account", "alice"}, strings.Fields(lines[1]))
+	assert.Equal(t, []string{time.Unix(1700000060, 0).Format(time.RFC3339), "Warning", "LoginFailed", "alice", "alice", "failed", "to", "log", "in"}, strings.Fields(lines[2]))
+}
+
+func TestPasswordPolicyViolations(t *testing.T) {
+	assert.Nil(t, passwordPolicyViolations(nil))
+	assert.Nil(t, passwordPolicyViolations(status.Error(codes.InvalidArgument, "current password does not match")))
+	assert.Nil(t, passwordPolicyViolations(status.Error(codes.PermissionDenied, "password does not satisfy the password policy: must be at least 12 characters long")))
+
+	err := status.Error(codes.InvalidArgument, "password does not satisfy the password policy: must be at least 12 characters long; must contain at least one digit character")
+	assert.Equal(t, []string{"must be at least 12 characters long", "must contain at least one digit character"}, passwordPolicyViolations(err))
+}
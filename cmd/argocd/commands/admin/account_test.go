@@ -0,0 +1,71 @@
+package admin
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateAccountToken(t *testing.T) {
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	t.Run("RecordsToken", func(t *testing.T) {
+		settingsMgr := newSettingsManager(map[string]string{"accounts.ci": "apiKey"})
+		token, claims, err := generateAccountToken(settingsMgr, "ci", "recovery", time.Hour, now, false)
+		require.NoError(t, err)
+		assert.Equal(t, "ci:apiKey", claims.Subject)
+		assert.Equal(t, "argocd", claims.Issuer)
+
+		parsed := jwt.RegisteredClaims{}
+		_, err = jwt.ParseWithClaims(token, &parsed, func(*jwt.Token) (any, error) {
+			return []byte("test"), nil
+		}, jwt.WithTimeFunc(func() time.Time { return now }))
+		require.NoError(t, err)
+		assert.Equal(t, "recovery", parsed.ID)
+		assert.Equal(t, now.Add(time.Hour).Unix(), parsed.ExpiresAt.Unix())
+
+		account, err := settingsMgr.GetAccount("ci")
+		require.NoError(t, err)
+		require.Len(t, account.Tokens, 1)
+		assert.Equal(t, "recovery", account.Tokens[0].ID)
+		assert.Equal(t, now.Unix(), account.Tokens[0].IssuedAt)
+		assert.Equal(t, now.Add(time.Hour).Unix(), account.Tokens[0].ExpiresAt)
+
+		_, _, err = generateAccountToken(settingsMgr, "ci", "recovery", 0, now, false)
+		require.ErrorContains(t, err, "already has token with id 'recovery'")
+	})
+
+	t.Run("DryRun", func(t *testing.T) {
+		settingsMgr := newSettingsManager(map[string]string{"accounts.ci": "apiKey"})
+		token, claims, err := generateAccountToken(settingsMgr, "ci", "", 0, now, true)
+		require.NoError(t, err)
+		assert.Empty(t, token)
+		assert.NotEmpty(t, claims.ID)
+		assert.Nil(t, claims.ExpiresAt)
+
+		account, err := settingsMgr.GetAccount("ci")
+		require.NoError(t, err)
+		assert.Empty(t, account.Tokens)
+	})
+
+	t.Run("MissingCapability", func(t *testing.T) {
+		settingsMgr := newSettingsManager(map[string]string{"accounts.ci": "login"})
+		_, _, err := generateAccountToken(settingsMgr, "ci", "", 0, now, false)
+		require.ErrorContains(t, err, "does not have apiKey capability")
+	})
+
+	t.Run("DisabledAccount", func(t *testing.T) {
+		settingsMgr := newSettingsManager(map[string]string{"accounts.ci": "apiKey", "accounts.ci.enabled": "false"})
+		_, _, err := generateAccountToken(settingsMgr, "ci", "", 0, now, true)
+		require.ErrorContains(t, err, "is disabled")
+	})
+
+	t.Run("UnknownAccount", func(t *testing.T) {
+		settingsMgr := newSettingsManager(nil)
+		_, _, err := generateAccountToken(settingsMgr, "missing", "", 0, now, false)
+		require.ErrorContains(t, err, "missing")
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"strings"
 
+	"github.com/pmezard/go-difflib/difflib"
 	"github.com/spf13/cobra"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -14,6 +15,7 @@ import (
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/yaml"
 
 	cmdutil "github.com/argoproj/argo-cd/v3/cmd/util"
 	"github.com/argoproj/argo-cd/v3/common"
@@ -81,6 +83,8 @@ $ argocd admin initial-password reset
 	command.AddCommand(NewNotificationsCommand())
 	command.AddCommand(NewInitialPasswordCommand())
 	command.AddCommand(NewRedisInitialPasswordCommand())
+	command.AddCommand(NewAccountCommand())
+	command.AddCommand(NewRepoServerCommand(clientOpts))
 
 	command.Flags().StringVar(&cmdutil.LogFormat, "logformat", "json", "Set the logging format. One of: json|text")
 	command.Flags().StringVar(&cmdutil.LogLevel, "loglevel", "info", "Set the logging level. One of: debug|info|warn|error")
@@ -169,6 +173,93 @@ func specsEqual(left, right unstructured.Unstructured) bool {
 	return false
 }
 
+// normalizeForImport returns a copy of the object with only the fields which are compared by specsEqual, without
+// the ones populated by the server such as resourceVersion and managedFields, for the dry-run diff output
+func normalizeForImport(un unstructured.Unstructured) *unstructured.Unstructured {
+	res := &unstructured.Unstructured{Object: map[string]any{}}
+	res.SetAPIVersion(un.GetAPIVersion())
+	res.SetKind(un.GetKind())
+	res.SetName(un.GetName())
+	res.SetNamespace(un.GetNamespace())
+	annotations := un.GetAnnotations()
+	delete(annotations, corev1.LastAppliedConfigAnnotation)
+	if len(annotations) > 0 {
+		res.SetAnnotations(annotations)
+	}
+	res.SetLabels(un.GetLabels())
+	res.SetFinalizers(un.GetFinalizers())
+	field := "spec"
+	if kind := un.GetKind(); kind == "Secret" || kind == "ConfigMap" {
+		field = "data"
+	}
+	if value, ok := un.Object[field]; ok {
+		res.Object[field] = runtime.DeepCopyJSONValue(value)
+	}
+	if un.GetKind() == application.ApplicationKind {
+		if status, ok, _ := unstructured.NestedMap(un.Object, "status"); ok {
+			delete(status, "reconciledAt")
+			delete(status, "observedAt")
+			res.Object["status"] = status
+		}
+	}
+	return res
+}
+
+// maskSecretData replaces the data values of a live and backup secret, so that a diff only shows which keys are
+// added, removed or changed, but not their values
+func maskSecretData(live, bak *unstructured.Unstructured) {
+	liveData, _, _ := unstructured.NestedMap(live.Object, "data")
+	bakData, _, _ := unstructured.NestedMap(bak.Object, "data")
+	for key, liveValue := range liveData {
+		bakValue, ok := bakData[key]
+		switch {
+		case !ok:
+			liveData[key] = "++++++++"
+		case reflect.DeepEqual(liveValue, bakValue):
+			liveData[key] = "++++++++"
+			bakData[key] = "++++++++"
+		default:
+			liveData[key] = "++++++++ (live)"
+			bakData[key] = "++++++++ (backup)"
+		}
+	}
+	for key := range bakData {
+		if _, ok := liveData[key]; !ok {
+			bakData[key] = "++++++++"
+		}
+	}
+	if liveData != nil {
+		_ = unstructured.SetNestedMap(live.Object, liveData, "data")
+	}
+	if bakData != nil {
+		_ = unstructured.SetNestedMap(bak.Object, bakData, "data")
+	}
+}
+
+// importDiff returns a unified diff of the fields of the live object which are updated from the backup
+func importDiff(live, bak unstructured.Unstructured) (string, error) {
+	normalizedLive := normalizeForImport(live)
+	normalizedBak := normalizeForImport(bak)
+	if live.GetKind() == "Secret" {
+		maskSecretData(normalizedLive, normalizedBak)
+	}
+	liveData, err := yaml.Marshal(normalizedLive.Object)
+	if err != nil {
+		return "", err
+	}
+	bakData, err := yaml.Marshal(normalizedBak.Object)
+	if err != nil {
+		return "", err
+	}
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(liveData)),
+		B:        difflib.SplitLines(string(bakData)),
+		FromFile: "live",
+		ToFile:   "backup",
+		Context:  3,
+	})
+}
+
 // Get additional namespaces from argocd-cmd-params
 func getAdditionalNamespaces(ctx context.Context, configMapsClient dynamic.ResourceInterface) *argocdAdditionalNamespaces {
 	applicationNamespaces := make([]string, 0)
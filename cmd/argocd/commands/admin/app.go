@@ -5,12 +5,15 @@ import (
 	"encoding/json"
 	stderrors "errors"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"sort"
+	"text/tabwriter"
 	"time"
 
 	"github.com/argoproj/gitops-engine/pkg/health"
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	"github.com/spf13/cobra"
 	corev1 "k8s.io/api/core/v1"
@@ -59,6 +62,9 @@ argocd admin app generate-spec APPNAME
 
 # Reconcile all applications and store reconciliation summary in the specified file
 argocd admin app get-reconcile-results APPNAME
+
+# Print application statistics
+argocd admin app stats
 `,
 		Run: func(c *cobra.Command, args []string) {
 			c.HelpFunc()(c, args)
@@ -68,6 +74,7 @@ argocd admin app get-reconcile-results APPNAME
 	command.AddCommand(NewGenAppSpecCommand())
 	command.AddCommand(NewReconcileCommand(clientOpts))
 	command.AddCommand(NewDiffReconcileResults())
+	command.AddCommand(NewAppStatsCommand())
 	return command
 }
 
@@ -480,3 +487,192 @@ func reconcileApplications(
 func newLiveStateCache(argoDB db.ArgoDB, appInformer kubecache.SharedIndexInformer, settingsMgr *settings.SettingsManager, server *metrics.MetricsServer) cache.LiveStateCache {
 	return cache.NewLiveStateCache(argoDB, appInformer, settingsMgr, server, func(_ map[string]bool, _ corev1.ObjectReference) {}, &sharding.ClusterSharding{}, argo.NewResourceTracking())
 }
+
+// appStats holds aggregated statistics about applications
+type appStats struct {
+	Total          int            `json:"total"`
+	ByProject      map[string]int `json:"byProject"`
+	ByCluster      map[string]int `json:"byCluster"`
+	BySyncStatus   map[string]int `json:"bySyncStatus"`
+	ByHealthStatus map[string]int `json:"byHealthStatus"`
+	Stale          []staleApp     `json:"stale"`
+}
+
+// staleApp is an application whose last successful sync is older than the stale threshold
+type staleApp struct {
+	Name         string       `json:"name"`
+	Namespace    string       `json:"namespace"`
+	Project      string       `json:"project"`
+	LastSyncedAt *metav1.Time `json:"lastSyncedAt,omitempty"`
+}
+
+func newAppStats() *appStats {
+	return &appStats{
+		ByProject:      map[string]int{},
+		ByCluster:      map[string]int{},
+		BySyncStatus:   map[string]int{},
+		ByHealthStatus: map[string]int{},
+		Stale:          []staleApp{},
+	}
+}
+
+// lastSuccessfulSync returns the time of the last successful sync of the application, or nil if it never synced
+func lastSuccessfulSync(app *v1alpha1.Application) *metav1.Time {
+	var last *metav1.Time
+	if op := app.Status.OperationState; op != nil && op.Phase == synccommon.OperationSucceeded && op.FinishedAt != nil {
+		last = op.FinishedAt
+	}
+	for i := range app.Status.History {
+		deployedAt := app.Status.History[i].DeployedAt
+		if last == nil || deployedAt.After(last.Time) {
+			last = &deployedAt
+		}
+	}
+	return last
+}
+
+// add records the application in the statistics. Applications not synced successfully since staleBefore are
+// reported as stale.
+func (s *appStats) add(app *v1alpha1.Application, staleBefore time.Time) {
+	s.Total++
+	s.ByProject[app.Spec.GetProject()]++
+	cluster := app.Spec.Destination.Server
+	if cluster == "" {
+		cluster = app.Spec.Destination.Name
+	}
+	s.ByCluster[cluster]++
+	syncStatus := string(app.Status.Sync.Status)
+	if syncStatus == "" {
+		syncStatus = string(v1alpha1.SyncStatusCodeUnknown)
+	}
+	s.BySyncStatus[syncStatus]++
+	healthStatus := string(app.Status.Health.Status)
+	if healthStatus == "" {
+		healthStatus = string(health.HealthStatusUnknown)
+	}
+	s.ByHealthStatus[healthStatus]++
+	if last := lastSuccessfulSync(app); last == nil || last.Time.Before(staleBefore) {
+		s.Stale = append(s.Stale, staleApp{Name: app.Name, Namespace: app.Namespace, Project: app.Spec.GetProject(), LastSyncedAt: last})
+	}
+}
+
+// collectAppStats lists applications page by page and aggregates them into statistics
+func collectAppStats(ctx context.Context, appClientset appclientset.Interface, namespace string, pageSize int64, staleThreshold time.Duration, now time.Time) (*appStats, error) {
+	stats := newAppStats()
+	staleBefore := now.Add(-staleThreshold)
+	opts := metav1.ListOptions{Limit: pageSize}
+	for {
+		appsList, err := appClientset.ArgoprojV1alpha1().Applications(namespace).List(ctx, opts)
+		if err != nil {
+			return nil, fmt.Errorf("error listing namespaced apps: %w", err)
+		}
+		for i := range appsList.Items {
+			stats.add(&appsList.Items[i], staleBefore)
+		}
+		if appsList.Continue == "" {
+			break
+		}
+		opts.Continue = appsList.Continue
+	}
+	sort.Slice(stats.Stale, func(i, j int) bool {
+		if stats.Stale[i].Namespace != stats.Stale[j].Namespace {
+			return stats.Stale[i].Namespace < stats.Stale[j].Namespace
+		}
+		return stats.Stale[i].Name < stats.Stale[j].Name
+	})
+	return stats, nil
+}
+
+// printAppStatsCounts prints the counts sorted by descending count and then by key
+func printAppStatsCounts(out io.Writer, title string, counts map[string]int) {
+	keys := make([]string, 0, len(counts))
+	for k := range counts {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if counts[keys[i]] != counts[keys[j]] {
+			return counts[keys[i]] > counts[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "%s\tCOUNT\n", title)
+	for _, k := range keys {
+		_, _ = fmt.Fprintf(w, "%s\t%d\n", k, counts[k])
+	}
+	_ = w.Flush()
+	_, _ = fmt.Fprintln(out)
+}
+
+func printAppStats(out io.Writer, stats *appStats, staleThreshold time.Duration) {
+	_, _ = fmt.Fprintf(out, "Total applications: %d\n\n", stats.Total)
+	printAppStatsCounts(out, "PROJECT", stats.ByProject)
+	printAppStatsCounts(out, "CLUSTER", stats.ByCluster)
+	printAppStatsCounts(out, "SYNC STATUS", stats.BySyncStatus)
+	printAppStatsCounts(out, "HEALTH STATUS", stats.ByHealthStatus)
+	_, _ = fmt.Fprintf(out, "Applications not synced successfully in the last %s: %d\n", staleThreshold, len(stats.Stale))
+	if len(stats.Stale) == 0 {
+		return
+	}
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "NAMESPACE\tNAME\tPROJECT\tLAST SYNCED\n")
+	for _, app := range stats.Stale {
+		lastSynced := "Never"
+		if app.LastSyncedAt != nil {
+			lastSynced = app.LastSyncedAt.UTC().Format(time.RFC3339)
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", app.Namespace, app.Name, app.Project, lastSynced)
+	}
+	_ = w.Flush()
+}
+
+// NewAppStatsCommand returns a new instance of `argocd admin app stats` command
+func NewAppStatsCommand() *cobra.Command {
+	var (
+		clientConfig   clientcmd.ClientConfig
+		output         string
+		staleThreshold time.Duration
+		pageSize       int64
+	)
+	command := &cobra.Command{
+		Use:   "stats",
+		Short: "Print aggregated statistics about applications",
+		Example: `# Print application counts by project, cluster, sync and health status
+argocd admin app stats
+
+# List applications that have not synced successfully in the last 7 days
+argocd admin app stats --stale-threshold 168h
+
+# Print the statistics as JSON
+argocd admin app stats -o json`,
+		Run: func(c *cobra.Command, _ []string) {
+			ctx := c.Context()
+
+			if output != "text" && output != "json" {
+				errors.Fatalf(errors.ErrorGeneric, "unknown output format: %s", output)
+			}
+			if pageSize < 0 {
+				errors.Fatal(errors.ErrorGeneric, "--page-size must not be negative")
+			}
+			cfg, err := clientConfig.ClientConfig()
+			errors.CheckError(err)
+			namespace, _, err := clientConfig.Namespace()
+			errors.CheckError(err)
+
+			stats, err := collectAppStats(ctx, appclientset.NewForConfigOrDie(cfg), namespace, pageSize, staleThreshold, time.Now())
+			errors.CheckError(err)
+			if output == "json" {
+				data, err := json.MarshalIndent(stats, "", "  ")
+				errors.CheckError(err)
+				fmt.Println(string(data))
+				return
+			}
+			printAppStats(os.Stdout, stats, staleThreshold)
+		},
+	}
+	clientConfig = cli.AddKubectlFlagsToCmd(command)
+	command.Flags().StringVarP(&output, "output", "o", "text", "Output format. One of: text|json")
+	command.Flags().DurationVar(&staleThreshold, "stale-threshold", 30*24*time.Hour, "Report applications whose last successful sync is older than this duration")
+	command.Flags().Int64Var(&pageSize, "page-size", 500, "Number of applications to list per request. 0 lists all applications at once")
+	return command
+}
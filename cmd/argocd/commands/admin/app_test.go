@@ -1,10 +1,14 @@
 package admin
 
 import (
+	"bytes"
+	"strings"
 	"testing"
+	"time"
 
 	clustermocks "github.com/argoproj/gitops-engine/pkg/cache/mocks"
 	"github.com/argoproj/gitops-engine/pkg/health"
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -12,7 +16,9 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	kubefake "k8s.io/client-go/kubernetes/fake"
+	kubetesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/argoproj/argo-cd/v3/common"
@@ -198,3 +204,65 @@ app3
 >   status: OutOfSync
 `, logs)
 }
+
+func TestCollectAppStats(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	recent := metav1.NewTime(now.Add(-time.Hour))
+	old := metav1.NewTime(now.Add(-60 * 24 * time.Hour))
+	newApp := func(name, project, server string, syncStatus v1alpha1.SyncStatusCode, healthStatus health.HealthStatusCode) *v1alpha1.Application {
+		return &v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "argocd"},
+			Spec: v1alpha1.ApplicationSpec{
+				Project:     project,
+				Destination: v1alpha1.ApplicationDestination{Server: server},
+			},
+			Status: v1alpha1.ApplicationStatus{
+				Sync:   v1alpha1.SyncStatus{Status: syncStatus},
+				Health: v1alpha1.AppHealthStatus{Status: healthStatus},
+			},
+		}
+	}
+	synced := newApp("synced", "default", "https://kubernetes.default.svc", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy)
+	synced.Status.OperationState = &v1alpha1.OperationState{Phase: synccommon.OperationSucceeded, FinishedAt: &recent}
+	stale := newApp("stale", "team-a", "https://remote", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusDegraded)
+	stale.Status.History = v1alpha1.RevisionHistories{{DeployedAt: old}}
+	never := newApp("never", "team-a", "", "", "")
+	never.Spec.Destination.Name = "in-cluster"
+	apps := []v1alpha1.Application{*synced, *stale, *never}
+
+	appClientset := appfake.NewSimpleClientset()
+	var requests []metav1.ListOptions
+	appClientset.PrependReactor("list", "applications", func(action kubetesting.Action) (bool, runtime.Object, error) {
+		opts := action.(kubetesting.ListActionImpl).ListOptions
+		requests = append(requests, opts)
+		if opts.Continue == "" {
+			return true, &v1alpha1.ApplicationList{ListMeta: metav1.ListMeta{Continue: "next"}, Items: apps[:2]}, nil
+		}
+		return true, &v1alpha1.ApplicationList{Items: apps[2:]}, nil
+	})
+
+	stats, err := collectAppStats(t.Context(), appClientset, "argocd", 2, 30*24*time.Hour, now)
+	require.NoError(t, err)
+	require.Len(t, requests, 2)
+	assert.Equal(t, int64(2), requests[0].Limit)
+	assert.Equal(t, "next", requests[1].Continue)
+
+	assert.Equal(t, 3, stats.Total)
+	assert.Equal(t, map[string]int{"default": 1, "team-a": 2}, stats.ByProject)
+	assert.Equal(t, map[string]int{"https://kubernetes.default.svc": 1, "https://remote": 1, "in-cluster": 1}, stats.ByCluster)
+	assert.Equal(t, map[string]int{"Synced": 1, "OutOfSync": 1, "Unknown": 1}, stats.BySyncStatus)
+	assert.Equal(t, map[string]int{"Healthy": 1, "Degraded": 1, "Unknown": 1}, stats.ByHealthStatus)
+	require.Len(t, stats.Stale, 2)
+	assert.Equal(t, "never", stats.Stale[0].Name)
+	assert.Nil(t, stats.Stale[0].LastSyncedAt)
+	assert.Equal(t, "stale", stats.Stale[1].Name)
+	assert.Equal(t, old.Unix(), stats.Stale[1].LastSyncedAt.Unix())
+
+	var out bytes.Buffer
+	printAppStats(&out, stats, 30*24*time.Hour)
+	lines := strings.Split(out.String(), "\n")
+	assert.Equal(t, "Total applications: 3", lines[0])
+	assert.Equal(t, []string{"team-a", "2"}, strings.Fields(lines[3]))
+	assert.Contains(t, lines, "Applications not synced successfully in the last 720h0m0s: 2")
+	assert.Equal(t, []string{"argocd", "never", "team-a", "Never"}, strings.Fields(lines[len(lines)-3]))
+}
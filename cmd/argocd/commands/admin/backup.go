@@ -2,9 +2,13 @@ package admin
 
 import (
 	"bufio"
+	"cmp"
+	"encoding/base64"
 	"fmt"
 	"io"
+	"maps"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
@@ -14,6 +18,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/tools/clientcmd"
 
@@ -36,13 +41,28 @@ func NewExportCommand() *cobra.Command {
 		out                      string
 		applicationNamespaces    []string
 		applicationsetNamespaces []string
+		kinds                    []string
+		projects                 []string
+		selector                 string
+		redactSecrets            bool
 	)
 	command := cobra.Command{
 		Use:   "export",
 		Short: "Export all Argo CD data to stdout (default) or a file",
+		Example: `  # Export all Argo CD data
+  argocd admin export > backup.yaml
+
+  # Export only the applications and projects of the team-a project
+  argocd admin export --kind applications --kind projects --project team-a
+
+  # Export the settings with all secret values replaced, for sharing in a ticket
+  argocd admin export --kind settings --redact-secrets`,
 		Run: func(c *cobra.Command, _ []string) {
 			ctx := c.Context()
 
+			filter, err := newExportFilter(kinds, projects, selector)
+			errors.CheckError(err)
+
 			config, err := clientConfig.ClientConfig()
 			errors.CheckError(err)
 			client, err := dynamic.NewForConfig(config)
@@ -84,53 +104,68 @@ func NewExportCommand() *cobra.Command {
 				acdClients.applicationSets = client.Resource(appplicationSetResource)
 			}
 
-			acdConfigMap, err := acdClients.configMaps.Get(ctx, common.ArgoCDConfigMapName, metav1.GetOptions{})
-			errors.CheckError(err)
-			export(writer, *acdConfigMap, namespace)
-			acdRBACConfigMap, err := acdClients.configMaps.Get(ctx, common.ArgoCDRBACConfigMapName, metav1.GetOptions{})
-			errors.CheckError(err)
-			export(writer, *acdRBACConfigMap, namespace)
-			acdKnownHostsConfigMap, err := acdClients.configMaps.Get(ctx, common.ArgoCDKnownHostsConfigMapName, metav1.GetOptions{})
-			errors.CheckError(err)
-			export(writer, *acdKnownHostsConfigMap, namespace)
-			acdTLSCertsConfigMap, err := acdClients.configMaps.Get(ctx, common.ArgoCDTLSCertsConfigMapName, metav1.GetOptions{})
-			errors.CheckError(err)
-			export(writer, *acdTLSCertsConfigMap, namespace)
-
-			secrets, err := acdClients.secrets.List(ctx, metav1.ListOptions{})
-			errors.CheckError(err)
-			for _, secret := range secrets.Items {
-				if isArgoCDSecret(secret) {
-					export(writer, secret, namespace)
+			if filter.kindEnabled(exportKindSettings) {
+				for _, name := range []string{common.ArgoCDConfigMapName, common.ArgoCDRBACConfigMapName, common.ArgoCDKnownHostsConfigMapName, common.ArgoCDTLSCertsConfigMapName} {
+					cm, err := acdClients.configMaps.Get(ctx, name, metav1.GetOptions{})
+					errors.CheckError(err)
+					if filter.matches(exportKindSettings, *cm) {
+						export(writer, *cm, namespace)
+					}
 				}
 			}
 
-			projects, err := acdClients.projects.List(ctx, metav1.ListOptions{})
-			errors.CheckError(err)
-			for _, proj := range projects.Items {
-				export(writer, proj, namespace)
+			if filter.kindEnabled(exportKindSettings) || filter.kindEnabled(exportKindRepositories) || filter.kindEnabled(exportKindClusters) {
+				secrets, err := acdClients.secrets.List(ctx, metav1.ListOptions{})
+				errors.CheckError(err)
+				sortUnstructured(secrets.Items)
+				for _, secret := range secrets.Items {
+					if isArgoCDSecret(secret) && filter.matches(secretExportKind(secret), secret) {
+						if redactSecrets {
+							redactSecretData(&secret)
+						}
+						export(writer, secret, namespace)
+					}
+				}
 			}
 
-			applications, err := acdClients.applications.List(ctx, metav1.ListOptions{})
-			errors.CheckError(err)
-			for _, app := range applications.Items {
-				// Export application only if it is in one of the enabled namespaces
-				if secutil.IsNamespaceEnabled(app.GetNamespace(), namespace, applicationNamespaces) {
-					export(writer, app, namespace)
+			if filter.kindEnabled(exportKindProjects) {
+				projects, err := acdClients.projects.List(ctx, metav1.ListOptions{})
+				errors.CheckError(err)
+				sortUnstructured(projects.Items)
+				for _, proj := range projects.Items {
+					if filter.matches(exportKindProjects, proj) {
+						export(writer, proj, namespace)
+					}
 				}
 			}
-			applicationSets, err := acdClients.applicationSets.List(ctx, metav1.ListOptions{})
-			if err != nil && !apierrors.IsNotFound(err) {
-				if apierrors.IsForbidden(err) {
-					log.Warn(err)
-				} else {
-					errors.CheckError(err)
+
+			if filter.kindEnabled(exportKindApplications) {
+				applications, err := acdClients.applications.List(ctx, metav1.ListOptions{})
+				errors.CheckError(err)
+				sortUnstructured(applications.Items)
+				for _, app := range applications.Items {
+					// Export application only if it is in one of the enabled namespaces
+					if secutil.IsNamespaceEnabled(app.GetNamespace(), namespace, applicationNamespaces) && filter.matches(exportKindApplications, app) {
+						export(writer, app, namespace)
+					}
 				}
 			}
-			if applicationSets != nil {
-				for _, appSet := range applicationSets.Items {
-					if secutil.IsNamespaceEnabled(appSet.GetNamespace(), namespace, applicationsetNamespaces) {
-						export(writer, appSet, namespace)
+
+			if filter.kindEnabled(exportKindApplicationSets) {
+				applicationSets, err := acdClients.applicationSets.List(ctx, metav1.ListOptions{})
+				if err != nil && !apierrors.IsNotFound(err) {
+					if apierrors.IsForbidden(err) {
+						log.Warn(err)
+					} else {
+						errors.CheckError(err)
+					}
+				}
+				if applicationSets != nil {
+					sortUnstructured(applicationSets.Items)
+					for _, appSet := range applicationSets.Items {
+						if secutil.IsNamespaceEnabled(appSet.GetNamespace(), namespace, applicationsetNamespaces) && filter.matches(exportKindApplicationSets, appSet) {
+							export(writer, appSet, namespace)
+						}
 					}
 				}
 			}
@@ -151,9 +186,121 @@ func NewExportCommand() *cobra.Command {
 		"If not specified, the value from '%s' in %s is used (if defined in the ConfigMap). "+
 		"If the ConfigMap value is not set, only ApplicationSets from the control plane namespace are exported.",
 		applicationsetNamespacesCmdParamsKey, common.ArgoCDCmdParamsConfigMapName))
+	command.Flags().StringArrayVar(&kinds, "kind", []string{}, fmt.Sprintf("Only export resources of the given kind, one of %s (can be repeated)", strings.Join(exportKinds, ", ")))
+	command.Flags().StringSliceVar(&projects, "project", []string{}, "Only export applications, ApplicationSets, projects, repositories and clusters of the given projects")
+	command.Flags().StringVarP(&selector, "selector", "l", "", "Only export resources matching the label selector")
+	command.Flags().BoolVar(&redactSecrets, "redact-secrets", false, "Replace the values of all secret data with a placeholder")
 	return &command
 }
 
+const (
+	exportKindApplications    = "applications"
+	exportKindApplicationSets = "applicationsets"
+	exportKindProjects        = "projects"
+	exportKindRepositories    = "repositories"
+	exportKindClusters        = "clusters"
+	exportKindSettings        = "settings"
+
+	// redactedSecretValue replaces the values of secret data when exporting with --redact-secrets
+	redactedSecretValue = "REDACTED"
+)
+
+var exportKinds = []string{exportKindApplications, exportKindApplicationSets, exportKindProjects, exportKindRepositories, exportKindClusters, exportKindSettings}
+
+// exportFilter selects the resources to export by kind, project and labels
+type exportFilter struct {
+	kinds    map[string]bool
+	projects map[string]bool
+	selector labels.Selector
+}
+
+func newExportFilter(kinds, projects []string, selector string) (*exportFilter, error) {
+	f := &exportFilter{kinds: map[string]bool{}, projects: map[string]bool{}, selector: labels.Everything()}
+	for _, kind := range kinds {
+		if !slices.Contains(exportKinds, kind) {
+			return nil, fmt.Errorf("unknown kind '%s', must be one of %s", kind, strings.Join(exportKinds, ", "))
+		}
+		f.kinds[kind] = true
+	}
+	for _, project := range projects {
+		f.projects[project] = true
+	}
+	if selector != "" {
+		var err error
+		if f.selector, err = labels.Parse(selector); err != nil {
+			return nil, fmt.Errorf("invalid selector: %w", err)
+		}
+	}
+	return f, nil
+}
+
+// kindEnabled returns whether resources of the given kind are exported
+func (f *exportFilter) kindEnabled(kind string) bool {
+	return len(f.kinds) == 0 || f.kinds[kind]
+}
+
+// matches returns whether the resource of the given kind is exported. The project filter does not apply to
+// settings, which are not project scoped.
+func (f *exportFilter) matches(kind string, un unstructured.Unstructured) bool {
+	if !f.kindEnabled(kind) || !f.selector.Matches(labels.Set(un.GetLabels())) {
+		return false
+	}
+	if len(f.projects) == 0 || kind == exportKindSettings {
+		return true
+	}
+	var project string
+	switch kind {
+	case exportKindApplications:
+		project, _, _ = unstructured.NestedString(un.Object, "spec", "project")
+	case exportKindApplicationSets:
+		project, _, _ = unstructured.NestedString(un.Object, "spec", "template", "spec", "project")
+	case exportKindProjects:
+		project = un.GetName()
+	case exportKindRepositories, exportKindClusters:
+		encoded, _, _ := unstructured.NestedString(un.Object, "data", "project")
+		decoded, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return false
+		}
+		project = string(decoded)
+	}
+	return f.projects[project]
+}
+
+// secretExportKind returns the export kind of an Argo CD secret
+func secretExportKind(un unstructured.Unstructured) string {
+	switch un.GetLabels()[common.LabelKeySecretType] {
+	case common.LabelValueSecretTypeCluster:
+		return exportKindClusters
+	case common.LabelValueSecretTypeRepository, common.LabelValueSecretTypeRepoCreds, common.LabelValueSecretTypeRepositoryWrite:
+		return exportKindRepositories
+	}
+	return exportKindSettings
+}
+
+// redactSecretData replaces the values of all data of the secret with a placeholder
+func redactSecretData(un *unstructured.Unstructured) {
+	data, _, _ := unstructured.NestedMap(un.Object, "data")
+	for key := range data {
+		data[key] = base64.StdEncoding.EncodeToString([]byte(redactedSecretValue))
+	}
+	if len(data) > 0 {
+		_ = unstructured.SetNestedMap(un.Object, data, "data")
+	}
+}
+
+// compareResourceKeys orders resource keys by group, kind, namespace and name
+func compareResourceKeys(a, b kube.ResourceKey) int {
+	return cmp.Or(cmp.Compare(a.Group, b.Group), cmp.Compare(a.Kind, b.Kind), cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Name, b.Name))
+}
+
+// sortUnstructured sorts resources by namespace and name, so that exports are deterministic
+func sortUnstructured(items []unstructured.Unstructured) {
+	slices.SortFunc(items, func(a, b unstructured.Unstructured) int {
+		return cmp.Or(cmp.Compare(a.GetNamespace(), b.GetNamespace()), cmp.Compare(a.GetName(), b.GetName()))
+	})
+}
+
 // NewImportCommand defines a new command for exporting Kubernetes and Argo CD resources.
 func NewImportCommand() *cobra.Command {
 	var (
@@ -328,7 +475,7 @@ func NewImportCommand() *cobra.Command {
 						fmt.Printf("%s/%s %s in namespace %s created%s\n", gvk.Group, gvk.Kind, bakObj.GetName(), bakObj.GetNamespace(), dryRunMsg)
 					}
 				case specsEqual(*bakObj, liveObj) && checkAppHasNoNeedToStopOperation(liveObj, stopOperation):
-					if verbose {
+					if verbose || dryRun {
 						fmt.Printf("%s/%s %s unchanged%s\n", gvk.Group, gvk.Kind, bakObj.GetName(), dryRunMsg)
 					}
 				default:
@@ -361,19 +508,39 @@ func NewImportCommand() *cobra.Command {
 					if !isForbidden {
 						fmt.Printf("%s/%s %s in namespace %s updated%s\n", gvk.Group, gvk.Kind, bakObj.GetName(), bakObj.GetNamespace(), dryRunMsg)
 					}
+					if dryRun {
+						diff, err := importDiff(liveObj, *bakObj)
+						errors.CheckError(err)
+						fmt.Print(diff)
+					}
 				}
 			}
 
 			promptUtil := utils.NewPrompt(promptsEnabled)
 
 			// Delete objects not in backup
-			for key, liveObj := range pruneObjects {
+			pruneKeys := slices.SortedFunc(maps.Keys(pruneObjects), compareResourceKeys)
+			pruneKeys = slices.DeleteFunc(pruneKeys, func(key kube.ResourceKey) bool {
+				liveObj := pruneObjects[key]
 				// If a live resource has a label to skip the import, it should never be pruned
 				if isSkipLabelMatches(&liveObj, skipResourcesWithLabel) {
 					fmt.Printf("Skipping pruning of %s/%s %s in namespace %s\n", key.Group, key.Kind, liveObj.GetName(), liveObj.GetNamespace())
-					continue
+					return true
 				}
-
+				return false
+			})
+			if prune && !dryRun && len(pruneKeys) > 0 {
+				fmt.Println("The following resources do not appear in the backup and will be pruned:")
+				for _, key := range pruneKeys {
+					fmt.Printf("  %s/%s %s in namespace %s\n", key.Group, key.Kind, key.Name, key.Namespace)
+				}
+				if !promptUtil.Confirm(fmt.Sprintf("Are you sure you want to prune these %d resources? [y/n]", len(pruneKeys))) {
+					fmt.Println("The command to prune was cancelled.")
+					pruneKeys = nil
+				}
+			}
+			for _, key := range pruneKeys {
+				liveObj := pruneObjects[key]
 				if prune {
 					var dynClient dynamic.ResourceInterface
 					switch key.Kind {
@@ -401,17 +568,12 @@ func NewImportCommand() *cobra.Command {
 					isForbidden := false
 
 					if !dryRun {
-						canPrune := promptUtil.Confirm(fmt.Sprintf("Are you sure you want to prune %s/%s %s ? [y/n]", key.Group, key.Kind, key.Name))
-						if canPrune {
-							err = dynClient.Delete(ctx, key.Name, metav1.DeleteOptions{})
-							if apierrors.IsForbidden(err) || apierrors.IsNotFound(err) {
-								isForbidden = true
-								log.Warnf("%s/%s %s: %v\n", key.Group, key.Kind, key.Name, err)
-							} else {
-								errors.CheckError(err)
-							}
+						err = dynClient.Delete(ctx, key.Name, metav1.DeleteOptions{})
+						if apierrors.IsForbidden(err) || apierrors.IsNotFound(err) {
+							isForbidden = true
+							log.Warnf("%s/%s %s: %v\n", key.Group, key.Kind, key.Name, err)
 						} else {
-							fmt.Printf("The command to prune %s/%s %s was cancelled.\n", key.Group, key.Kind, key.Name)
+							errors.CheckError(err)
 						}
 					}
 					if !isForbidden {
@@ -427,8 +589,8 @@ func NewImportCommand() *cobra.Command {
 	}
 
 	clientConfig = cli.AddKubectlFlagsToCmd(&command)
-	command.Flags().BoolVar(&dryRun, "dry-run", false, "Print what will be performed")
-	command.Flags().BoolVar(&prune, "prune", false, "Prune secrets, applications and projects which do not appear in the backup")
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "Print what will be performed, with a diff of each resource which will be updated, without changing anything")
+	command.Flags().BoolVar(&prune, "prune", false, "Prune secrets, applications and projects which do not appear in the backup, after confirming the list of resources")
 	command.Flags().BoolVar(&ignoreTracking, "ignore-tracking", false, "Do not update the tracking annotation if the resource is already tracked")
 	command.Flags().BoolVar(&overrideOnConflict, "override-on-conflict", false, "Override the resource on conflict when updating resources")
 	command.Flags().BoolVar(&verbose, "verbose", false, "Verbose output (versus only changed output)")
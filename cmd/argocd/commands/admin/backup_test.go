@@ -2,6 +2,7 @@ package admin
 
 import (
 	"bytes"
+	"encoding/base64"
 	"testing"
 
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -485,3 +487,144 @@ func TestIsSkipLabelMatches(t *testing.T) {
 		})
 	}
 }
+
+func newRepositorySecret(name, project string) *unstructured.Unstructured {
+	secret := corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Secret",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "argocd",
+			Labels: map[string]string{
+				common.LabelKeySecretType: common.LabelValueSecretTypeRepository,
+			},
+		},
+		Data: map[string][]byte{
+			"url":      []byte("https://github.com/argoproj/" + name),
+			"password": []byte("secret"),
+		},
+	}
+	if project != "" {
+		secret.Data["project"] = []byte(project)
+	}
+	return kube.MustToUnstructured(&secret)
+}
+
+func Test_exportFilter(t *testing.T) {
+	_, err := newExportFilter([]string{"apps"}, nil, "")
+	require.ErrorContains(t, err, "unknown kind 'apps'")
+	_, err = newExportFilter(nil, nil, "foo in (")
+	require.ErrorContains(t, err, "invalid selector")
+
+	teamApp := newApplication("argocd")
+	teamApp.SetLabels(map[string]string{"team": "a"})
+	_ = unstructured.SetNestedField(teamApp.Object, "team-a", "spec", "project")
+	otherApp := newApplication("argocd")
+
+	t.Run("All", func(t *testing.T) {
+		f, err := newExportFilter(nil, nil, "")
+		require.NoError(t, err)
+		for _, kind := range exportKinds {
+			assert.True(t, f.kindEnabled(kind))
+		}
+		assert.True(t, f.matches(exportKindApplications, *otherApp))
+		assert.True(t, f.matches(exportKindSettings, *newConfigmapObject()))
+	})
+	t.Run("Kind", func(t *testing.T) {
+		f, err := newExportFilter([]string{exportKindProjects, exportKindRepositories}, nil, "")
+		require.NoError(t, err)
+		assert.False(t, f.kindEnabled(exportKindApplications))
+		assert.False(t, f.matches(exportKindApplications, *otherApp))
+		assert.True(t, f.matches(exportKindProjects, *newAppProject()))
+		assert.True(t, f.matches(secretExportKind(*newRepositorySecret("repo", "")), *newRepositorySecret("repo", "")))
+		assert.False(t, f.matches(secretExportKind(*newSecretsObject()), *newSecretsObject()))
+	})
+	t.Run("Project", func(t *testing.T) {
+		f, err := newExportFilter(nil, []string{"team-a"}, "")
+		require.NoError(t, err)
+		assert.True(t, f.matches(exportKindApplications, *teamApp))
+		assert.False(t, f.matches(exportKindApplications, *otherApp))
+		assert.False(t, f.matches(exportKindProjects, *newAppProject()))
+		assert.True(t, f.matches(exportKindRepositories, *newRepositorySecret("team-repo", "team-a")))
+		assert.False(t, f.matches(exportKindRepositories, *newRepositorySecret("global-repo", "")))
+		assert.True(t, f.matches(exportKindSettings, *newConfigmapObject()))
+	})
+	t.Run("Selector", func(t *testing.T) {
+		f, err := newExportFilter(nil, nil, "team=a")
+		require.NoError(t, err)
+		assert.True(t, f.matches(exportKindApplications, *teamApp))
+		assert.False(t, f.matches(exportKindApplications, *otherApp))
+	})
+}
+
+func Test_redactSecretData(t *testing.T) {
+	secret := newRepositorySecret("repo", "team-a")
+	redactSecretData(secret)
+
+	buf := bytes.NewBuffer(nil)
+	export(buf, *secret, "argocd")
+	assert.NotContains(t, buf.String(), base64.StdEncoding.EncodeToString([]byte("secret")))
+	data, _, _ := unstructured.NestedStringMap(secret.Object, "data")
+	redacted := base64.StdEncoding.EncodeToString([]byte(redactedSecretValue))
+	assert.Equal(t, map[string]string{"url": redacted, "password": redacted, "project": redacted}, data)
+}
+
+func Test_sortUnstructured(t *testing.T) {
+	items := []unstructured.Unstructured{*newApplication("team-b"), *newApplication("argocd"), *newApplicationSet("argocd")}
+	sortUnstructured(items)
+	assert.Equal(t, "argocd", items[0].GetNamespace())
+	assert.Equal(t, "test", items[0].GetName())
+	assert.Equal(t, "test-appset", items[1].GetName())
+	assert.Equal(t, "team-b", items[2].GetNamespace())
+}
+
+func Test_importDiff(t *testing.T) {
+	t.Run("Unchanged ignores server populated fields", func(t *testing.T) {
+		bak := newApplication("argocd")
+		live := bak.DeepCopy()
+		live.SetResourceVersion("123")
+		live.SetManagedFields([]metav1.ManagedFieldsEntry{{Manager: "argocd-server"}})
+		_ = unstructured.SetNestedField(live.Object, "2025-01-01T00:00:00Z", "status", "reconciledAt")
+		assert.True(t, specsEqual(*bak, *live))
+		diff, err := importDiff(*live, *bak)
+		require.NoError(t, err)
+		assert.Empty(t, diff)
+	})
+	t.Run("Application status", func(t *testing.T) {
+		bak := newApplication("argocd")
+		_ = unstructured.SetNestedField(bak.Object, "OutOfSync", "status", "sync", "status")
+		live := bak.DeepCopy()
+		_ = unstructured.SetNestedField(live.Object, "Synced", "status", "sync", "status")
+		assert.False(t, specsEqual(*bak, *live))
+		diff, err := importDiff(*live, *bak)
+		require.NoError(t, err)
+		assert.Contains(t, diff, "-    status: Synced\n+    status: OutOfSync\n")
+	})
+	t.Run("ConfigMap", func(t *testing.T) {
+		bak := newConfigmapObject()
+		bak.SetKind("ConfigMap")
+		_ = unstructured.SetNestedStringMap(bak.Object, map[string]string{"url": "https://argocd.example.com"}, "data")
+		live := bak.DeepCopy()
+		_ = unstructured.SetNestedStringMap(live.Object, map[string]string{"url": "https://old.example.com"}, "data")
+		live.SetResourceVersion("123")
+		diff, err := importDiff(*live, *bak)
+		require.NoError(t, err)
+		assert.Contains(t, diff, "--- live\n+++ backup\n")
+		assert.Contains(t, diff, "-  url: https://old.example.com\n+  url: https://argocd.example.com\n")
+		assert.NotContains(t, diff, "resourceVersion")
+	})
+	t.Run("Secret values are masked", func(t *testing.T) {
+		bak := newRepositorySecret("repo", "")
+		live := bak.DeepCopy()
+		_ = unstructured.SetNestedField(live.Object, base64.StdEncoding.EncodeToString([]byte("old")), "data", "password")
+		_ = unstructured.SetNestedField(live.Object, base64.StdEncoding.EncodeToString([]byte("team-a")), "data", "project")
+		diff, err := importDiff(*live, *bak)
+		require.NoError(t, err)
+		assert.Contains(t, diff, "-  password: ++++++++ (live)\n-  project: ++++++++\n+  password: ++++++++ (backup)\n")
+		assert.Contains(t, diff, "   url: ++++++++\n")
+		assert.NotContains(t, diff, base64.StdEncoding.EncodeToString([]byte("secret")))
+		assert.NotContains(t, diff, base64.StdEncoding.EncodeToString([]byte("old")))
+	})
+}
@@ -601,6 +601,7 @@ func NewGenClusterConfigCommand(pathOpts *clientcmd.PathOptions) *cobra.Command
 				log.Fatal("Can only use one of --in-cluster or --cluster-endpoint")
 				return
 			}
+			errors.CheckError(clusterOpts.ValidateCredentialFlags())
 
 			overrides := clientcmd.ConfigOverrides{
 				Context: *clstContext,
@@ -619,6 +620,8 @@ func NewGenClusterConfigCommand(pathOpts *clientcmd.PathOptions) *cobra.Command
 					RoleARN:     clusterOpts.AwsRoleArn,
 					Profile:     clusterOpts.AwsProfile,
 				}
+			case clusterOpts.GCPWorkloadIdentity || clusterOpts.AzureWorkloadIdentity:
+				execProviderConf = clusterOpts.WorkloadIdentityExecProviderConfig()
 			case clusterOpts.ExecProviderCommand != "":
 				execProviderConf = &v1alpha1.ExecProviderConfig{
 					Command:     clusterOpts.ExecProviderCommand,
@@ -653,6 +656,9 @@ func NewGenClusterConfigCommand(pathOpts *clientcmd.PathOptions) *cobra.Command
 			if clusterOpts.Shard >= 0 {
 				clst.Shard = &clusterOpts.Shard
 			}
+			if clusterOpts.RotateAuthInterval > 0 {
+				clst.Config.RotateAuthInterval = clusterOpts.RotateAuthInterval.String()
+			}
 
 			settingsMgr := settings.NewSettingsManager(ctx, kubeClientset, ArgoCDNamespace)
 			argoDB := db.NewDB(ArgoCDNamespace, settingsMgr, kubeClientset)
@@ -2,9 +2,19 @@ package admin
 
 import (
 	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	stderrors "errors"
 	"fmt"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
 	"os/signal"
+	"strconv"
+	"strings"
 	"syscall"
+	"time"
 
 	"github.com/spf13/cobra"
 	"k8s.io/client-go/tools/clientcmd"
@@ -18,6 +28,9 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/errors"
 )
 
+// dashboardTokenCookie is the cookie used to remember a browser that authenticated with the dashboard token
+const dashboardTokenCookie = "argocd.dashboard.token"
+
 // DashboardConfig holds the configuration for starting the dashboard
 type DashboardConfig struct {
 	Port         int
@@ -25,6 +38,91 @@ type DashboardConfig struct {
 	ClientOpts   *argocdclient.ClientOptions
 	ClientConfig clientcmd.ClientConfig
 	Context      string
+	TLSCert      string
+	TLSKey       string
+	Token        string
+	InsecureBind bool
+}
+
+// useProxy returns whether the dashboard has to be served through the TLS and token enforcing proxy
+func (c *DashboardConfig) useProxy() bool {
+	return c.TLSCert != "" || c.Token != ""
+}
+
+// scheme returns the URL scheme the dashboard is served with
+func (c *DashboardConfig) scheme() string {
+	if c.TLSCert != "" {
+		return "https"
+	}
+	return "http"
+}
+
+// validate checks that the configuration does not expose an unprotected dashboard to the network
+func (c *DashboardConfig) validate() error {
+	if c.Port < 0 || c.Port > 65535 {
+		return fmt.Errorf("invalid port %d", c.Port)
+	}
+	if (c.TLSCert == "") != (c.TLSKey == "") {
+		return stderrors.New("--tls-cert and --tls-key must be specified together")
+	}
+	if !isLocalhostAddress(c.Address) && c.TLSCert == "" && !c.InsecureBind {
+		return fmt.Errorf("refusing to serve the dashboard without TLS on non-localhost address %q: specify --tls-cert and --tls-key, or --insecure-bind to override", c.Address)
+	}
+	return nil
+}
+
+// isLocalhostAddress returns whether the address only accepts connections from the local host
+func isLocalhostAddress(address string) bool {
+	if address == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(address)
+	return ip != nil && ip.IsLoopback()
+}
+
+// dashboardURL returns the URL the dashboard is reachable at
+func dashboardURL(scheme, address string, port int) string {
+	return (&url.URL{Scheme: scheme, Host: net.JoinHostPort(address, strconv.Itoa(port))}).String()
+}
+
+// newTokenAuthHandler wraps the handler so that only requests presenting the token are served. The token is accepted
+// as a bearer token, or once as the "token" query parameter, in which case it is stored in a cookie so that browsers
+// can use the dashboard.
+func newTokenAuthHandler(token string, next http.Handler) http.Handler {
+	matches := func(provided string) bool {
+		return subtle.ConstantTimeCompare([]byte(provided), []byte(token)) == 1
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if provided := query.Get("token"); provided != "" && matches(provided) {
+			http.SetCookie(w, &http.Cookie{
+				Name:     dashboardTokenCookie,
+				Value:    token,
+				Path:     "/",
+				HttpOnly: true,
+				Secure:   r.TLS != nil,
+				SameSite: http.SameSiteStrictMode,
+			})
+			query.Del("token")
+			redirect := *r.URL
+			redirect.RawQuery = query.Encode()
+			http.Redirect(w, r, redirect.RequestURI(), http.StatusFound)
+			return
+		}
+		authorized := false
+		if auth, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); ok && matches(auth) {
+			authorized = true
+			r.Header.Del("Authorization")
+		} else if cookie, err := r.Cookie(dashboardTokenCookie); err == nil && matches(cookie.Value) {
+			authorized = true
+		}
+		if !authorized {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
 }
 
 type dashboard struct {
@@ -42,13 +140,28 @@ func NewDashboard() *dashboard {
 func (ds *dashboard) Run(ctx context.Context, config *DashboardConfig) error {
 	ctx, stop := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
+	if config.Address == "" {
+		config.Address = common.DefaultAddressAdminDashboard
+	}
+	if err := config.validate(); err != nil {
+		return err
+	}
 	config.ClientOpts.Core = true
 	println("starting dashboard")
-	shutDownFunc, err := ds.startLocalServer(ctx, config.ClientOpts, config.Context, &config.Port, &config.Address, config.ClientConfig)
+	var shutDownFunc func()
+	var err error
+	if config.useProxy() {
+		shutDownFunc, err = ds.startProxiedServer(ctx, config)
+	} else {
+		shutDownFunc, err = ds.startLocalServer(ctx, config.ClientOpts, config.Context, &config.Port, &config.Address, config.ClientConfig)
+	}
 	if err != nil {
 		return fmt.Errorf("could not start dashboard: %w", err)
 	}
-	fmt.Printf("Argo CD UI is available at http://%s:%d\n", config.Address, config.Port)
+	fmt.Printf("Argo CD UI is available at %s\n", dashboardURL(config.scheme(), config.Address, config.Port))
+	if config.Token != "" {
+		println("requests must present the token as a bearer token, or open the URL once with the ?token=<token> query parameter")
+	}
 	<-ctx.Done()
 	stop() // unregister the signal handler as soon as we receive a signal
 	println("signal received, shutting down dashboard")
@@ -59,6 +172,55 @@ func (ds *dashboard) Run(ctx context.Context, config *DashboardConfig) error {
 	return nil
 }
 
+// startProxiedServer starts the API server on an ephemeral localhost port and serves it on the configured address
+// through a reverse proxy that terminates TLS and enforces the token.
+func (ds *dashboard) startProxiedServer(ctx context.Context, config *DashboardConfig) (func(), error) {
+	var tlsConfig *tls.Config
+	if config.TLSCert != "" {
+		cert, err := tls.LoadX509KeyPair(config.TLSCert, config.TLSKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load TLS key pair: %w", err)
+		}
+		tlsConfig = &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12}
+	}
+	addr := net.JoinHostPort(config.Address, strconv.Itoa(config.Port))
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on %q: %w", addr, err)
+	}
+	config.Port = ln.Addr().(*net.TCPAddr).Port
+
+	backendPort := 0
+	backendAddress := "localhost"
+	shutDownBackend, err := ds.startLocalServer(ctx, config.ClientOpts, config.Context, &backendPort, &backendAddress, config.ClientConfig)
+	if err != nil {
+		_ = ln.Close()
+		return nil, err
+	}
+	var handler http.Handler = httputil.NewSingleHostReverseProxy(&url.URL{Scheme: "http", Host: net.JoinHostPort(backendAddress, strconv.Itoa(backendPort))})
+	if config.Token != "" {
+		handler = newTokenAuthHandler(config.Token, handler)
+	}
+	srv := &http.Server{Handler: handler, ReadHeaderTimeout: 30 * time.Second}
+	if tlsConfig != nil {
+		srv.TLSConfig = tlsConfig
+		ln = tls.NewListener(ln, tlsConfig)
+	}
+	go func() {
+		if err := srv.Serve(ln); err != nil && !stderrors.Is(err, http.ErrServerClosed) {
+			fmt.Printf("dashboard server failed: %v\n", err)
+		}
+	}()
+	return func() {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = srv.Shutdown(shutdownCtx)
+		if shutDownBackend != nil {
+			shutDownBackend()
+		}
+	}, nil
+}
+
 func NewDashboardCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	config := &DashboardConfig{ClientOpts: clientOpts}
 	cmd := &cobra.Command{
@@ -76,10 +238,20 @@ $ argocd admin dashboard --port 8080 --address 127.0.0.1
 
 # Start the Argo CD Web UI with GZip compression
 $ argocd admin dashboard --redis-compress gzip
+
+# Start the Argo CD Web UI on a free port chosen by the operating system
+$ argocd admin dashboard --port 0
+
+# Serve the Argo CD Web UI over HTTPS on all interfaces, protected by a static token
+$ argocd admin dashboard --address 0.0.0.0 --tls-cert tls.crt --tls-key tls.key --dashboard-token "$DASHBOARD_TOKEN"
   `,
 	}
 	config.ClientConfig = cli.AddKubectlFlagsToSet(cmd.Flags())
-	cmd.Flags().IntVar(&config.Port, "port", common.DefaultPortAPIServer, "Listen on given port")
+	cmd.Flags().IntVar(&config.Port, "port", common.DefaultPortAPIServer, "Listen on given port, 0 picks a free port")
 	cmd.Flags().StringVar(&config.Address, "address", common.DefaultAddressAdminDashboard, "Listen on given address")
+	cmd.Flags().StringVar(&config.TLSCert, "tls-cert", "", "Path to a TLS certificate used to serve the dashboard over HTTPS")
+	cmd.Flags().StringVar(&config.TLSKey, "tls-key", "", "Path to the private key of the TLS certificate")
+	cmd.Flags().StringVar(&config.Token, "dashboard-token", "", "Static bearer token that requests to the dashboard must present.")
+	cmd.Flags().BoolVar(&config.InsecureBind, "insecure-bind", false, "Allow serving the dashboard without TLS on a non-localhost address")
 	return cmd
 }
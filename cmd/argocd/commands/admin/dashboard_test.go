@@ -2,11 +2,15 @@ package admin
 
 import (
 	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"syscall"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"k8s.io/client-go/tools/clientcmd"
 
@@ -48,3 +52,129 @@ func TestRun_SignalHandling_GracefulShutdown(t *testing.T) {
 
 	require.True(t, stopCalled)
 }
+
+func TestDashboardConfig_validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  DashboardConfig
+		wantErr string
+	}{
+		{name: "localhost", config: DashboardConfig{Address: "localhost"}},
+		{name: "loopback IP", config: DashboardConfig{Address: "127.0.0.1"}},
+		{name: "loopback IPv6", config: DashboardConfig{Address: "::1"}},
+		{name: "non-localhost with TLS", config: DashboardConfig{Address: "0.0.0.0", TLSCert: "tls.crt", TLSKey: "tls.key"}},
+		{name: "non-localhost with insecure bind", config: DashboardConfig{Address: "10.0.0.1", InsecureBind: true}},
+		{name: "non-localhost without TLS", config: DashboardConfig{Address: "0.0.0.0"}, wantErr: "refusing to serve the dashboard without TLS"},
+		{name: "certificate without key", config: DashboardConfig{Address: "localhost", TLSCert: "tls.crt"}, wantErr: "must be specified together"},
+		{name: "invalid port", config: DashboardConfig{Address: "localhost", Port: 70000}, wantErr: "invalid port"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.validate()
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDashboardURL(t *testing.T) {
+	assert.Equal(t, "http://localhost:8080", dashboardURL("http", "localhost", 8080))
+	assert.Equal(t, "https://[::1]:8443", dashboardURL("https", "::1", 8443))
+}
+
+func TestNewTokenAuthHandler(t *testing.T) {
+	handler := newTokenAuthHandler("secret", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusOK)
+	}))
+	serve := func(r *http.Request) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	t.Run("MissingToken", func(t *testing.T) {
+		w := serve(httptest.NewRequest(http.MethodGet, "/applications", http.NoBody))
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+	t.Run("WrongBearerToken", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/applications", http.NoBody)
+		r.Header.Set("Authorization", "Bearer wrong")
+		assert.Equal(t, http.StatusUnauthorized, serve(r).Code)
+	})
+	t.Run("BearerToken", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/applications", http.NoBody)
+		r.Header.Set("Authorization", "Bearer secret")
+		assert.Equal(t, http.StatusOK, serve(r).Code)
+	})
+	t.Run("QueryTokenSetsCookie", func(t *testing.T) {
+		w := serve(httptest.NewRequest(http.MethodGet, "/applications?token=secret&foo=bar", http.NoBody))
+		require.Equal(t, http.StatusFound, w.Code)
+		assert.Equal(t, "/applications?foo=bar", w.Header().Get("Location"))
+		cookies := w.Result().Cookies()
+		require.Len(t, cookies, 1)
+		assert.Equal(t, dashboardTokenCookie, cookies[0].Name)
+
+		r := httptest.NewRequest(http.MethodGet, "/applications", http.NoBody)
+		r.AddCookie(cookies[0])
+		assert.Equal(t, http.StatusOK, serve(r).Code)
+	})
+	t.Run("WrongQueryToken", func(t *testing.T) {
+		w := serve(httptest.NewRequest(http.MethodGet, "/applications?token=wrong", http.NoBody))
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
+func TestRun_RefusesInsecureNonLocalhostBind(t *testing.T) {
+	d := &dashboard{
+		startLocalServer: func(_ context.Context, _ *apiclient.ClientOptions, _ string, _ *int, _ *string, _ clientcmd.ClientConfig) (func(), error) {
+			t.Fatal("server must not be started")
+			return nil, nil
+		},
+	}
+	err := d.Run(t.Context(), &DashboardConfig{Address: "0.0.0.0", ClientOpts: &apiclient.ClientOptions{}})
+	require.ErrorContains(t, err, "--insecure-bind")
+}
+
+func TestRun_TokenProxy(t *testing.T) {
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer backend.Close()
+	backendPort := backend.Listener.Addr().(*net.TCPAddr).Port
+
+	d := &dashboard{
+		startLocalServer: func(_ context.Context, _ *apiclient.ClientOptions, _ string, port *int, address *string, _ clientcmd.ClientConfig) (func(), error) {
+			assert.Equal(t, "localhost", *address)
+			*address = "127.0.0.1"
+			*port = backendPort
+			return nil, nil
+		},
+	}
+	ctx, cancel := context.WithCancel(t.Context())
+	config := &DashboardConfig{Address: "127.0.0.1", Token: "secret", ClientOpts: &apiclient.ClientOptions{}}
+	shutdown, err := d.startProxiedServer(ctx, config)
+	require.NoError(t, err)
+	defer func() {
+		shutdown()
+		cancel()
+	}()
+	require.NotZero(t, config.Port)
+
+	url := dashboardURL("http", config.Address, config.Port)
+	resp, err := http.Get(url)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	req, err := http.NewRequest(http.MethodGet, url, http.NoBody)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer secret")
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
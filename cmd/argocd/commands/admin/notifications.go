@@ -1,11 +1,25 @@
 package admin
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
 	"log"
+	"os"
+	"strings"
+	"text/tabwriter"
 
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
@@ -14,7 +28,9 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/notification/settings"
 	"github.com/argoproj/argo-cd/v3/util/tls"
 
+	"github.com/argoproj/notifications-engine/pkg/api"
 	"github.com/argoproj/notifications-engine/pkg/cmd"
+	"github.com/argoproj/notifications-engine/pkg/services"
 	"github.com/spf13/cobra"
 
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
@@ -29,13 +45,18 @@ func NewNotificationsCommand() *cobra.Command {
 		argocdRepoServerStrictTLS bool
 	)
 
-	var argocdService service.Service
+	var (
+		argocdService service.Service
+		kubeConfig    clientcmd.ClientConfig
+	)
+	notificationSettings := settings.GetFactorySettingsForCLI(func() service.Service { return argocdService }, "argocd-notifications-secret", "argocd-notifications-cm", false)
 	toolsCommand := cmd.NewToolsCommand(
 		"notifications",
 		"argocd admin notifications",
 		applications,
-		settings.GetFactorySettingsForCLI(argocdService, "argocd-notifications-secret", "argocd-notifications-cm", false),
+		notificationSettings,
 		func(clientConfig clientcmd.ClientConfig) {
+			kubeConfig = clientConfig
 			k8sCfg, err := clientConfig.ClientConfig()
 			if err != nil {
 				log.Fatalf("Failed to parse k8s config: %v", err)
@@ -67,5 +88,216 @@ func NewNotificationsCommand() *cobra.Command {
 	toolsCommand.PersistentFlags().StringVar(&argocdRepoServer, "argocd-repo-server", common.DefaultRepoServerAddr, "Argo CD repo server address")
 	toolsCommand.PersistentFlags().BoolVar(&argocdRepoServerPlaintext, "argocd-repo-server-plaintext", false, "Use a plaintext client (non-TLS) to connect to repository server")
 	toolsCommand.PersistentFlags().BoolVar(&argocdRepoServerStrictTLS, "argocd-repo-server-strict-tls", false, "Perform strict validation of TLS certificates when connecting to repo server")
+	extendTriggerRunCommand(toolsCommand, notificationSettings, func() clientcmd.ClientConfig { return kubeConfig })
 	return toolsCommand
 }
+
+// extendTriggerRunCommand adds flags to the `trigger run` command of the notifications engine to render templates
+// against the evaluated application and optionally deliver the notification. Without these flags the original
+// command is executed.
+func extendTriggerRunCommand(toolsCommand *cobra.Command, notificationSettings api.Settings, getClientConfig func() clientcmd.ClientConfig) {
+	runCommand, _, err := toolsCommand.Find([]string{"trigger", "run"})
+	if err != nil || runCommand.Name() != "run" {
+		return
+	}
+	var (
+		templates   []string
+		send        bool
+		serviceName string
+		recipient   string
+	)
+	runE := runCommand.RunE
+	runCommand.RunE = func(c *cobra.Command, args []string) error {
+		if len(templates) == 0 && !send {
+			return runE(c, args)
+		}
+		if len(args) != 2 {
+			return fmt.Errorf("expected two arguments, got %d", len(args))
+		}
+		if len(templates) == 0 {
+			return errors.New("--send requires --template")
+		}
+		if send && serviceName == "" {
+			return errors.New("--send requires --service")
+		}
+		configMapPath, _ := c.Flags().GetString("config-map")
+		secretPath, _ := c.Flags().GetString("secret")
+
+		clientConfig := getClientConfig()
+		restConfig, err := clientConfig.ClientConfig()
+		if err != nil {
+			return fmt.Errorf("error getting REST config: %w", err)
+		}
+		namespace, _, err := clientConfig.Namespace()
+		if err != nil {
+			return fmt.Errorf("error getting namespace: %w", err)
+		}
+		kubeClientset, err := kubernetes.NewForConfig(restConfig)
+		if err != nil {
+			return fmt.Errorf("error creating Kubernetes client: %w", err)
+		}
+		dynamicClient, err := dynamic.NewForConfig(restConfig)
+		if err != nil {
+			return fmt.Errorf("error creating dynamic client: %w", err)
+		}
+		configMap, secret, err := loadNotificationsConfig(c.Context(), kubeClientset, namespace, notificationSettings, configMapPath, secretPath)
+		if err != nil {
+			return err
+		}
+		notificationsAPI, err := newNotificationsAPI(notificationSettings, configMap, secret)
+		if err != nil {
+			return err
+		}
+		app, err := loadApplication(c.Context(), dynamicClient, namespace, args[1])
+		if err != nil {
+			return err
+		}
+
+		var dest *services.Destination
+		if send {
+			dest = &services.Destination{Service: serviceName, Recipient: recipient}
+		}
+		return runTriggerAndRender(os.Stdout, notificationsAPI, args[0], app, templates, dest)
+	}
+	runCommand.Example += `
+
+# Evaluate the trigger against the live application and render a template without sending anything
+argocd admin notifications trigger run on-sync-succeeded guestbook --template app-sync-succeeded
+
+# Render the template and deliver it once using the slack service
+argocd admin notifications trigger run on-sync-succeeded guestbook --template app-sync-succeeded --send --service slack --recipient my-channel`
+	runCommand.Flags().StringArrayVar(&templates, "template", nil, "Render the given template against the application after evaluating the trigger. Can be repeated")
+	runCommand.Flags().BoolVar(&send, "send", false, "Deliver the rendered notification once using --service, regardless of the trigger result")
+	runCommand.Flags().StringVar(&serviceName, "service", "", "Name of the notification service used with --send, e.g. slack")
+	runCommand.Flags().StringVar(&recipient, "recipient", "", "Recipient used with --send, e.g. a Slack channel")
+}
+
+// loadNotificationsConfig returns the notifications ConfigMap and Secret, read from the given files if specified or
+// from the cluster otherwise. Like the notifications engine, the ":empty" secret path stands for an empty secret.
+func loadNotificationsConfig(ctx context.Context, kubeClientset kubernetes.Interface, namespace string, notificationSettings api.Settings, configMapPath, secretPath string) (*corev1.ConfigMap, *corev1.Secret, error) {
+	configMap := &corev1.ConfigMap{}
+	if configMapPath == "" {
+		cm, err := kubeClientset.CoreV1().ConfigMaps(namespace).Get(ctx, notificationSettings.ConfigMapName, metav1.GetOptions{})
+		if err != nil {
+			return nil, nil, fmt.Errorf("error getting ConfigMap %s: %w", notificationSettings.ConfigMapName, err)
+		}
+		configMap = cm
+	} else if err := unmarshalObjectFromFile(configMapPath, "ConfigMap", notificationSettings.ConfigMapName, configMap); err != nil {
+		return nil, nil, err
+	}
+
+	secret := &corev1.Secret{}
+	switch secretPath {
+	case ":empty":
+	case "":
+		s, err := kubeClientset.CoreV1().Secrets(namespace).Get(ctx, notificationSettings.SecretName, metav1.GetOptions{})
+		if err != nil {
+			return nil, nil, fmt.Errorf("error getting Secret %s: %w", notificationSettings.SecretName, err)
+		}
+		secret = s
+	default:
+		if err := unmarshalObjectFromFile(secretPath, "Secret", notificationSettings.SecretName, secret); err != nil {
+			return nil, nil, err
+		}
+		if secret.Data == nil {
+			secret.Data = map[string][]byte{}
+		}
+		for k, v := range secret.StringData {
+			secret.Data[k] = []byte(v)
+		}
+	}
+	return configMap, secret, nil
+}
+
+// unmarshalObjectFromFile finds the object with the given kind and name in a YAML file and converts it to result
+func unmarshalObjectFromFile(path, kind, name string, result any) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("error reading %s: %w", path, err)
+	}
+	objs, err := kube.SplitYAML(data)
+	if err != nil {
+		return fmt.Errorf("error parsing %s: %w", path, err)
+	}
+	for _, obj := range objs {
+		if obj.GetKind() == kind && obj.GetName() == name {
+			return runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, result)
+		}
+	}
+	return fmt.Errorf("file '%s' does not have %s '%s'", path, kind, name)
+}
+
+// loadApplication reads the application from a file if the name is a path to an existing file, or from the cluster
+func loadApplication(ctx context.Context, dynamicClient dynamic.Interface, namespace, name string) (*unstructured.Unstructured, error) {
+	if info, err := os.Stat(name); err == nil && !info.IsDir() {
+		data, err := os.ReadFile(name)
+		if err != nil {
+			return nil, fmt.Errorf("error reading %s: %w", name, err)
+		}
+		var app unstructured.Unstructured
+		if err := yaml.Unmarshal(data, &app); err != nil {
+			return nil, fmt.Errorf("error parsing %s: %w", name, err)
+		}
+		return &app, nil
+	}
+	app, err := dynamicClient.Resource(applications).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error getting application %s: %w", name, err)
+	}
+	return app, nil
+}
+
+// newNotificationsAPI creates the notifications API from the given ConfigMap and Secret
+func newNotificationsAPI(notificationSettings api.Settings, configMap *corev1.ConfigMap, secret *corev1.Secret) (api.API, error) {
+	cfg, err := api.ParseConfig(configMap, secret)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing notifications configuration: %w", err)
+	}
+	getVars, err := notificationSettings.InitGetVars(cfg, configMap, secret)
+	if err != nil {
+		return nil, err
+	}
+	return api.NewAPI(*cfg, getVars)
+}
+
+// runTriggerAndRender evaluates the trigger against the application, prints the result of each condition and renders
+// the templates to out. If a destination is given, the notification is also delivered to it.
+func runTriggerAndRender(out io.Writer, notificationsAPI api.API, triggerName string, app *unstructured.Unstructured, templates []string, dest *services.Destination) error {
+	if _, ok := notificationsAPI.GetConfig().Triggers[triggerName]; !ok {
+		var names []string
+		for name := range notificationsAPI.GetConfig().Triggers {
+			names = append(names, name)
+		}
+		return fmt.Errorf("trigger with name '%s' does not exist (found %s)", triggerName, strings.Join(names, ", "))
+	}
+	results, err := notificationsAPI.RunTrigger(triggerName, app.Object)
+	if err != nil {
+		return fmt.Errorf("failed to execute trigger %s: %w", triggerName, err)
+	}
+	triggered := false
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "CONDITION\tRESULT\n")
+	for i := range results {
+		_, _ = fmt.Fprintf(w, "%s\t%v\n", notificationsAPI.GetConfig().Triggers[triggerName][i].When, results[i].Triggered)
+		triggered = triggered || results[i].Triggered
+	}
+	_ = w.Flush()
+	_, _ = fmt.Fprintf(out, "\nTriggered: %v\n\n", triggered)
+
+	for _, template := range templates {
+		if _, ok := notificationsAPI.GetConfig().Templates[template]; !ok {
+			return fmt.Errorf("template with name '%s' does not exist", template)
+		}
+	}
+	notificationsAPI.AddNotificationService("console", services.NewConsoleService(out))
+	if err := notificationsAPI.Send(app.Object, templates, services.Destination{Service: "console", Recipient: "stdout"}); err != nil {
+		return fmt.Errorf("failed to render templates %s: %w", strings.Join(templates, ", "), err)
+	}
+	if dest != nil {
+		if err := notificationsAPI.Send(app.Object, templates, *dest); err != nil {
+			return fmt.Errorf("failed to send notification using service '%s': %w", dest.Service, err)
+		}
+		_, _ = fmt.Fprintf(out, "\nNotification sent using service '%s'\n", dest.Service)
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package admin
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/argoproj/notifications-engine/pkg/api"
+	"github.com/argoproj/notifications-engine/pkg/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+var testNotificationSettings = api.Settings{
+	SecretName:    "argocd-notifications-secret",
+	ConfigMapName: "argocd-notifications-cm",
+	InitGetVars: func(_ *api.Config, _ *corev1.ConfigMap, _ *corev1.Secret) (api.GetVars, error) {
+		return func(obj map[string]any, _ services.Destination) map[string]any {
+			return map[string]any{"app": obj}
+		}, nil
+	},
+}
+
+func newTestNotificationsAPI(t *testing.T, data map[string]string) api.API {
+	t.Helper()
+	notificationsAPI, err := newNotificationsAPI(testNotificationSettings, &corev1.ConfigMap{Data: data}, &corev1.Secret{})
+	require.NoError(t, err)
+	return notificationsAPI
+}
+
+func newTestNotificationApp() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"metadata": map[string]any{"name": "guestbook"},
+		"status":   map[string]any{"sync": map[string]any{"status": "Synced"}},
+	}}
+}
+
+func TestRunTriggerAndRender(t *testing.T) {
+	data := map[string]string{
+		"trigger.on-synced":   "- when: app.status.sync.status == 'Synced'\n  send: [app-synced]\n",
+		"template.app-synced": "message: Application {{.app.metadata.name}} is synced\n",
+		"template.app-failed": "message: '{{index .app.status.operationState \"phase\"}}'\n",
+	}
+
+	t.Run("Render", func(t *testing.T) {
+		var out bytes.Buffer
+		err := runTriggerAndRender(&out, newTestNotificationsAPI(t, data), "on-synced", newTestNotificationApp(), []string{"app-synced"}, nil)
+		require.NoError(t, err)
+		assert.Contains(t, out.String(), "app.status.sync.status == 'Synced'  true")
+		assert.Contains(t, out.String(), "Triggered: true")
+		assert.Contains(t, out.String(), "message: Application guestbook is synced")
+	})
+
+	t.Run("Send", func(t *testing.T) {
+		var out, sent bytes.Buffer
+		notificationsAPI := newTestNotificationsAPI(t, data)
+		notificationsAPI.AddNotificationService("test", services.NewConsoleService(&sent))
+		err := runTriggerAndRender(&out, notificationsAPI, "on-synced", newTestNotificationApp(), []string{"app-synced"}, &services.Destination{Service: "test"})
+		require.NoError(t, err)
+		assert.Contains(t, sent.String(), "message: Application guestbook is synced")
+		assert.Contains(t, out.String(), "Notification sent using service 'test'")
+	})
+
+	t.Run("UnknownTrigger", func(t *testing.T) {
+		err := runTriggerAndRender(&bytes.Buffer{}, newTestNotificationsAPI(t, data), "on-deleted", newTestNotificationApp(), []string{"app-synced"}, nil)
+		require.ErrorContains(t, err, "trigger with name 'on-deleted' does not exist")
+	})
+
+	t.Run("UnknownTemplate", func(t *testing.T) {
+		err := runTriggerAndRender(&bytes.Buffer{}, newTestNotificationsAPI(t, data), "on-synced", newTestNotificationApp(), []string{"app-missing"}, nil)
+		require.ErrorContains(t, err, "template with name 'app-missing' does not exist")
+	})
+
+	t.Run("RenderingErrorIncludesExpression", func(t *testing.T) {
+		err := runTriggerAndRender(&bytes.Buffer{}, newTestNotificationsAPI(t, data), "on-synced", newTestNotificationApp(), []string{"app-failed"}, nil)
+		require.ErrorContains(t, err, "failed to render templates app-failed")
+		require.ErrorContains(t, err, "index .app.status.operationState")
+	})
+}
+
+func TestLoadNotificationsConfig(t *testing.T) {
+	dir := t.TempDir()
+	cmPath := filepath.Join(dir, "cm.yaml")
+	require.NoError(t, os.WriteFile(cmPath, []byte(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: other
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: argocd-notifications-cm
+data:
+  trigger.on-synced: "- when: 'true'"
+`), 0o600))
+	kubeClientset := fake.NewClientset(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "argocd-notifications-secret", Namespace: "argocd"},
+		Data:       map[string][]byte{"token": []byte("abc")},
+	})
+
+	cm, secret, err := loadNotificationsConfig(t.Context(), kubeClientset, "argocd", testNotificationSettings, cmPath, "")
+	require.NoError(t, err)
+	assert.Equal(t, "- when: 'true'", cm.Data["trigger.on-synced"])
+	assert.Equal(t, []byte("abc"), secret.Data["token"])
+
+	_, secret, err = loadNotificationsConfig(t.Context(), kubeClientset, "argocd", testNotificationSettings, cmPath, ":empty")
+	require.NoError(t, err)
+	assert.Empty(t, secret.Data)
+
+	_, _, err = loadNotificationsConfig(t.Context(), kubeClientset, "argocd", testNotificationSettings, "", "")
+	require.ErrorContains(t, err, "argocd-notifications-cm")
+}
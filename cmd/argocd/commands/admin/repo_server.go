@@ -0,0 +1,197 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/redis/go-redis/v9"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	appclientset "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned"
+	reposervercache "github.com/argoproj/argo-cd/v3/reposerver/cache"
+	"github.com/argoproj/argo-cd/v3/util/cli"
+	"github.com/argoproj/argo-cd/v3/util/errors"
+	"github.com/argoproj/argo-cd/v3/util/git"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	kubeutil "github.com/argoproj/argo-cd/v3/util/kube"
+)
+
+// NewRepoServerCommand returns a new instance of `argocd admin repo-server` command
+func NewRepoServerCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "repo-server",
+		Short: "Manage repo-server internals",
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+		},
+	}
+	command.AddCommand(NewRepoServerCacheCommand(clientOpts))
+	return command
+}
+
+// NewRepoServerCacheCommand returns a new instance of `argocd admin repo-server cache` command
+func NewRepoServerCacheCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "cache",
+		Short: "Manage the repo-server cache",
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+		},
+	}
+	command.AddCommand(NewRepoServerCachePurgeCommand(clientOpts))
+	return command
+}
+
+// NewRepoServerCachePurgeCommand returns a new instance of `argocd admin repo-server cache purge` command
+func NewRepoServerCachePurgeCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		clientConfig  clientcmd.ClientConfig
+		repoURL       string
+		revision      string
+		all           bool
+		yes           bool
+		redisAddress  string
+		redisPassword string
+	)
+	command := &cobra.Command{
+		Use:   "purge",
+		Short: "Remove cached manifests and repository metadata from the repo-server cache",
+		Long: `Remove cached manifests and repository metadata from the repo-server cache.
+
+Use this command when a repository history was rewritten or a Helm chart was re-published under the same version and
+the repo-server keeps serving stale manifests. The cache is reached through Redis, which is port-forwarded unless
+--redis is specified. Manifests are cached per resolved revision, so --revision must be a commit SHA, a Helm chart
+version or an OCI digest rather than a branch name.`,
+		Example: `# Remove all cached entries of a repository
+argocd admin repo-server cache purge --repo https://github.com/argoproj/argocd-example-apps.git
+
+# Remove the cached entries of a single revision of a repository
+argocd admin repo-server cache purge --repo https://charts.example.com --revision 1.2.3
+
+# Remove all entries of the repo-server cache
+argocd admin repo-server cache purge --all`,
+		Run: func(c *cobra.Command, _ []string) {
+			ctx := c.Context()
+
+			if all == (repoURL != "") {
+				errors.Fatal(errors.ErrorGeneric, "exactly one of --repo or --all must be specified")
+			}
+			if all && revision != "" {
+				errors.Fatal(errors.ErrorGeneric, "--revision cannot be used with --all")
+			}
+
+			cfg, err := clientConfig.ClientConfig()
+			errors.CheckError(err)
+			namespace, _, err := clientConfig.Namespace()
+			errors.CheckError(err)
+			kubeClientset := kubernetes.NewForConfigOrDie(cfg)
+
+			var patterns []string
+			if all {
+				if !yes && !cli.AskToProceed("This will remove all entries of the repo-server cache. Proceed (y/n)? ") {
+					fmt.Println("Aborted")
+					return
+				}
+				patterns = reposervercache.AllKeyPatterns()
+			} else {
+				appNames, repoURLs, err := appsSourcingRepo(ctx, appclientset.NewForConfigOrDie(cfg), namespace, repoURL)
+				errors.CheckError(err)
+				for _, url := range repoURLs {
+					patterns = append(patterns, reposervercache.RepoKeyPatterns(url, revision, appNames)...)
+				}
+			}
+
+			redisOptions := &redis.Options{Addr: redisAddress, Password: redisPassword}
+			if redisPassword == "" {
+				if err := common.SetOptionalRedisPasswordFromKubeConfig(ctx, kubeClientset, namespace, redisOptions); err != nil {
+					log.Warnf("Failed to fetch Redis password for namespace %s: %v", namespace, err)
+				}
+			}
+			if redisAddress == "" {
+				overrides := clientcmd.ConfigOverrides{}
+				redisHaProxyPodLabelSelector := common.LabelKeyAppName + "=" + clientOpts.RedisHaProxyName
+				redisPodLabelSelector := common.LabelKeyAppName + "=" + clientOpts.RedisName
+				redisPort, err := kubeutil.PortForward(6379, namespace, &overrides, redisHaProxyPodLabelSelector, redisPodLabelSelector)
+				errors.CheckError(err)
+				redisOptions.Addr = fmt.Sprintf("localhost:%d", redisPort)
+			}
+			redisClient := redis.NewClient(redisOptions)
+			defer utilio.Close(redisClient)
+
+			removed, err := purgeCacheKeys(ctx, redisClient, patterns)
+			errors.CheckError(err)
+			fmt.Printf("Removed %d cache entries\n", removed)
+		},
+	}
+	clientConfig = cli.AddKubectlFlagsToCmd(command)
+	command.Flags().StringVar(&repoURL, "repo", "", "URL of the repository whose cache entries should be removed")
+	command.Flags().StringVar(&revision, "revision", "", "Only remove the entries of the given resolved revision")
+	command.Flags().BoolVar(&all, "all", false, "Remove all entries of the repo-server cache")
+	command.Flags().BoolVarP(&yes, "yes", "y", false, "Skip explicit confirmation")
+	command.Flags().StringVar(&redisAddress, "redis", "", "Redis server address. Defaults to a port-forward to the Argo CD Redis")
+	command.Flags().StringVar(&redisPassword, "redis-password", "", "Redis password. Defaults to the password stored in the argocd-redis secret")
+	return command
+}
+
+// appsSourcingRepo returns the instance names of the applications which have a source in the given repository, which
+// are used in the manifest cache keys, and the distinct spellings of the repository URL used by these applications.
+func appsSourcingRepo(ctx context.Context, appClientset appclientset.Interface, namespace string, repoURL string) ([]string, []string, error) {
+	apps, err := appClientset.ArgoprojV1alpha1().Applications(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("error listing apps: %w", err)
+	}
+	var appNames []string
+	repoURLs := map[string]bool{repoURL: true}
+	for i := range apps.Items {
+		app := &apps.Items[i]
+		matched := false
+		for _, source := range app.Spec.GetSources() {
+			if source.RepoURL != "" && git.SameURL(source.RepoURL, repoURL) {
+				repoURLs[source.RepoURL] = true
+				matched = true
+			}
+		}
+		if matched {
+			appNames = append(appNames, app.InstanceName(namespace))
+		}
+	}
+	urls := make([]string, 0, len(repoURLs))
+	for url := range repoURLs {
+		urls = append(urls, url)
+	}
+	sort.Strings(appNames)
+	sort.Strings(urls)
+	return appNames, urls, nil
+}
+
+// purgeCacheKeys removes the keys matching any of the patterns and returns the number of removed keys
+func purgeCacheKeys(ctx context.Context, client *redis.Client, patterns []string) (int, error) {
+	removed := 0
+	for _, pattern := range patterns {
+		iter := client.Scan(ctx, 0, pattern, 1000).Iterator()
+		var keys []string
+		for iter.Next(ctx) {
+			keys = append(keys, iter.Val())
+		}
+		if err := iter.Err(); err != nil {
+			return removed, fmt.Errorf("error scanning keys matching %q: %w", pattern, err)
+		}
+		for len(keys) > 0 {
+			batch := keys[:min(len(keys), 1000)]
+			keys = keys[len(batch):]
+			n, err := client.Del(ctx, batch...).Result()
+			if err != nil {
+				return removed, fmt.Errorf("error removing keys matching %q: %w", pattern, err)
+			}
+			removed += int(n)
+		}
+	}
+	return removed, nil
+}
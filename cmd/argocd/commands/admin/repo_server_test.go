@@ -0,0 +1,82 @@
+package admin
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	appfake "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned/fake"
+	reposervercache "github.com/argoproj/argo-cd/v3/reposerver/cache"
+	cacheutil "github.com/argoproj/argo-cd/v3/util/cache"
+)
+
+func TestAppsSourcingRepo(t *testing.T) {
+	appClientset := appfake.NewSimpleClientset(
+		&v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "argocd"},
+			Spec:       v1alpha1.ApplicationSpec{Source: &v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps"}},
+		},
+		&v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "multi", Namespace: "team-a"},
+			Spec: v1alpha1.ApplicationSpec{Sources: v1alpha1.ApplicationSources{
+				{RepoURL: "https://charts.example.com"},
+				{RepoURL: "https://github.com/argoproj/argocd-example-apps.git"},
+			}},
+		},
+		&v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "argocd"},
+			Spec:       v1alpha1.ApplicationSpec{Source: &v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/other"}},
+		},
+	)
+
+	appNames, repoURLs, err := appsSourcingRepo(t.Context(), appClientset, "argocd", "https://github.com/argoproj/argocd-example-apps.git")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"guestbook", "team-a_multi"}, appNames)
+	assert.Equal(t, []string{"https://github.com/argoproj/argocd-example-apps", "https://github.com/argoproj/argocd-example-apps.git"}, repoURLs)
+}
+
+func TestPurgeCacheKeys(t *testing.T) {
+	mr := miniredis.RunT(t)
+	redisClient := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer redisClient.Close()
+
+	populate := func() *reposervercache.Cache {
+		mr.FlushAll()
+		cache := reposervercache.NewCache(cacheutil.NewCache(cacheutil.NewRedisCache(redisClient, time.Hour, cacheutil.RedisCompressionGZip)), time.Hour, time.Hour, time.Minute)
+		repo := "https://github.com/argoproj/argocd-example-apps.git"
+		for _, revision := range []string{"aaa", "bbb"} {
+			require.NoError(t, cache.SetApps(repo, revision, map[string]string{"guestbook": "Directory"}))
+			require.NoError(t, cache.SetRevisionMetadata(repo, revision, &v1alpha1.RevisionMetadata{Message: "msg"}))
+			require.NoError(t, cache.SetGitDirectories(repo, revision, []string{"guestbook"}))
+			source := &v1alpha1.ApplicationSource{RepoURL: repo, Path: "guestbook"}
+			require.NoError(t, cache.SetManifests(revision, source, nil, &v1alpha1.ClusterInfo{}, "default", "", "app.kubernetes.io/instance", "guestbook",
+				&reposervercache.CachedManifestResponse{}, nil, ""))
+		}
+		require.NoError(t, cache.SetApps("https://github.com/argoproj/other.git", "aaa", map[string]string{"other": "Directory"}))
+		return cache
+	}
+
+	populate()
+	removed, err := purgeCacheKeys(t.Context(), redisClient, reposervercache.RepoKeyPatterns("https://github.com/argoproj/argocd-example-apps.git", "aaa", []string{"guestbook"}))
+	require.NoError(t, err)
+	assert.Equal(t, 4, removed)
+	assert.Len(t, mr.Keys(), 5)
+
+	populate()
+	removed, err = purgeCacheKeys(t.Context(), redisClient, reposervercache.RepoKeyPatterns("https://github.com/argoproj/argocd-example-apps.git", "", []string{"guestbook"}))
+	require.NoError(t, err)
+	assert.Equal(t, 8, removed)
+	assert.Len(t, mr.Keys(), 1)
+
+	populate()
+	removed, err = purgeCacheKeys(t.Context(), redisClient, reposervercache.AllKeyPatterns())
+	require.NoError(t, err)
+	assert.Equal(t, 9, removed)
+	assert.Empty(t, mr.Keys())
+}
@@ -3,6 +3,7 @@ package admin
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	stderrors "errors"
 	"fmt"
 	"os"
@@ -13,11 +14,14 @@ import (
 	"text/tabwriter"
 
 	healthutil "github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/tools/clientcmd"
@@ -36,6 +40,7 @@ import (
 type settingsOpts struct {
 	argocdCMPath        string
 	argocdSecretPath    string
+	files               []string
 	loadClusterSettings bool
 	clientConfig        clientcmd.ClientConfig
 }
@@ -63,11 +68,58 @@ func setSettingsMeta(obj metav1.Object) {
 	obj.SetLabels(labels)
 }
 
+// loadSettingsFiles reads argocd-cm, argocd-rbac-cm and argocd-secret from the manifests in the given files. Objects
+// which are not part of the settings are ignored.
+func loadSettingsFiles(paths []string) (*corev1.ConfigMap, *corev1.ConfigMap, *corev1.Secret, error) {
+	var argocdCM, rbacCM *corev1.ConfigMap
+	var argocdSecret *corev1.Secret
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		objs, err := kube.SplitYAML(data)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("error parsing %s: %w", path, err)
+		}
+		for _, obj := range objs {
+			switch {
+			case obj.GetKind() == "ConfigMap" && obj.GetName() == common.ArgoCDConfigMapName:
+				argocdCM = &corev1.ConfigMap{}
+				err = runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, argocdCM)
+			case obj.GetKind() == "ConfigMap" && obj.GetName() == common.ArgoCDRBACConfigMapName:
+				rbacCM = &corev1.ConfigMap{}
+				err = runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, rbacCM)
+			case obj.GetKind() == "Secret" && obj.GetName() == common.ArgoCDSecretName:
+				argocdSecret = &corev1.Secret{}
+				err = runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, argocdSecret)
+				if err == nil && len(argocdSecret.StringData) > 0 {
+					if argocdSecret.Data == nil {
+						argocdSecret.Data = map[string][]byte{}
+					}
+					for k, v := range argocdSecret.StringData {
+						argocdSecret.Data[k] = []byte(v)
+					}
+				}
+			}
+			if err != nil {
+				return nil, nil, nil, fmt.Errorf("error parsing %s %s in %s: %w", obj.GetKind(), obj.GetName(), path, err)
+			}
+		}
+	}
+	return argocdCM, rbacCM, argocdSecret, nil
+}
+
 func (opts *settingsOpts) createSettingsManager(ctx context.Context) (*settings.SettingsManager, error) {
-	var argocdCM *corev1.ConfigMap
+	argocdCM, rbacCM, argocdSecret, err := loadSettingsFiles(opts.files)
+	if err != nil {
+		return nil, err
+	}
+
 	switch {
+	case argocdCM != nil:
 	case opts.argocdCMPath == "" && !opts.loadClusterSettings:
-		return nil, stderrors.New("either --argocd-cm-path must be provided or --load-cluster-settings must be set to true")
+		return nil, stderrors.New("either --argocd-cm-path or --file must be provided or --load-cluster-settings must be set to true")
 	case opts.argocdCMPath == "":
 		realClientset, ns, err := opts.getK8sClient()
 		if err != nil {
@@ -90,8 +142,8 @@ func (opts *settingsOpts) createSettingsManager(ctx context.Context) (*settings.
 	}
 	setSettingsMeta(argocdCM)
 
-	var argocdSecret *corev1.Secret
 	switch {
+	case argocdSecret != nil:
 	case opts.argocdSecretPath != "":
 		data, err := os.ReadFile(opts.argocdSecretPath)
 		if err != nil {
@@ -123,7 +175,26 @@ func (opts *settingsOpts) createSettingsManager(ctx context.Context) (*settings.
 		}
 	}
 	setSettingsMeta(argocdSecret)
-	clientset := fake.NewClientset(argocdSecret, argocdCM)
+	objs := []runtime.Object{argocdSecret, argocdCM}
+
+	if rbacCM == nil && opts.loadClusterSettings {
+		realClientset, ns, err := opts.getK8sClient()
+		if err != nil {
+			return nil, err
+		}
+		rbacCM, err = realClientset.CoreV1().ConfigMaps(ns).Get(ctx, common.ArgoCDRBACConfigMapName, metav1.GetOptions{})
+		switch {
+		case apierrors.IsNotFound(err):
+			rbacCM = nil
+		case err != nil:
+			return nil, err
+		}
+	}
+	if rbacCM != nil {
+		setSettingsMeta(rbacCM)
+		objs = append(objs, rbacCM)
+	}
+	clientset := fake.NewClientset(objs...)
 
 	manager := settings.NewSettingsManager(ctx, clientset, "default")
 	errors.CheckError(manager.ResyncInformers())
@@ -168,6 +239,8 @@ func NewSettingsCommand() *cobra.Command {
 	opts.clientConfig = cli.AddKubectlFlagsToCmd(command)
 	command.PersistentFlags().StringVar(&opts.argocdCMPath, "argocd-cm-path", "", "Path to local argocd-cm.yaml file")
 	command.PersistentFlags().StringVar(&opts.argocdSecretPath, "argocd-secret-path", "", "Path to local argocd-secret.yaml file")
+	command.PersistentFlags().StringArrayVar(&opts.files, "file", nil,
+		"Path to a local file with argocd-cm, argocd-rbac-cm and/or argocd-secret manifests. Takes precedence over the other sources and can be repeated")
 	command.PersistentFlags().BoolVar(&opts.loadClusterSettings, "load-cluster-settings", false,
 		"Indicates that config map and secret should be loaded from cluster unless local file path is provided")
 	return command
@@ -265,7 +338,11 @@ var validatorsByGroup = map[string]settingValidator{
 }
 
 func NewValidateSettingsCommand(cmdCtx commandContext) *cobra.Command {
-	var groups []string
+	var (
+		groups           []string
+		warningsAsErrors bool
+		output           string
+	)
 
 	var allGroups []string
 	for k := range validatorsByGroup {
@@ -278,31 +355,51 @@ func NewValidateSettingsCommand(cmdCtx commandContext) *cobra.Command {
 	command := &cobra.Command{
 		Use:   "validate",
 		Short: "Validate settings",
-		Long:  "Validates settings specified in 'argocd-cm' ConfigMap and 'argocd-secret' Secret",
+		Long: `Validates settings specified in 'argocd-cm' and 'argocd-rbac-cm' ConfigMaps and 'argocd-secret' Secret
+
+Besides loading every group of settings, the structure of the keys is validated: Lua scripts of resource
+customizations are compiled, glob patterns of resource exclusions and inclusions are compiled, account capabilities,
+SSO configuration and RBAC policies are checked. Every finding is reported with the offending key and a snippet of its
+value. The command exits with a non-zero code if an error is found.`,
 		Example: `
 #Validates all settings in the specified YAML file
 argocd admin settings validate --argocd-cm-path ./argocd-cm.yaml
 
+#Validates the settings in local manifests of argocd-cm, argocd-rbac-cm and argocd-secret, failing on warnings too
+argocd admin settings validate --file ./argocd-cm.yaml --file ./argocd-rbac-cm.yaml --warnings-as-errors
+
 #Validates accounts and plugins settings in Kubernetes cluster of current kubeconfig context
 argocd admin settings validate --group accounts --group plugins --load-cluster-settings`,
 		Run: func(c *cobra.Command, _ []string) {
 			ctx := c.Context()
 
+			if output != "" && output != "json" {
+				errors.Fatalf(errors.ErrorGeneric, "unknown output format: %s", output)
+			}
+
 			settingsManager, err := cmdCtx.createSettingsManager(ctx)
 			errors.CheckError(err)
 
+			findings, err := validateSettingsStructure(settingsManager)
+			errors.CheckError(err)
+
 			if len(groups) == 0 {
 				groups = allGroups
 			}
 			for i, group := range groups {
 				validator := validatorsByGroup[group]
 
+				var validatorErr error
 				logs := collectLogs(func() {
-					summary, err := validator(settingsManager)
+					var summary string
+					summary, validatorErr = validator(settingsManager)
+					if output != "" {
+						return
+					}
 
-					if err != nil {
+					if validatorErr != nil {
 						_, _ = fmt.Fprintf(os.Stdout, "❌ %s\n", group)
-						_, _ = fmt.Fprintf(os.Stdout, "%s\n", err.Error())
+						_, _ = fmt.Fprintf(os.Stdout, "%s\n", validatorErr.Error())
 					} else {
 						_, _ = fmt.Fprintf(os.Stdout, "✅ %s\n", group)
 						if summary != "" {
@@ -310,6 +407,16 @@ argocd admin settings validate --group accounts --group plugins --load-cluster-s
 						}
 					}
 				})
+				if validatorErr != nil {
+					findings = append(findings, settingsFinding{
+						Severity: findingSeverityError,
+						Source:   common.ArgoCDConfigMapName,
+						Message:  fmt.Sprintf("invalid %s settings: %v", group, validatorErr),
+					})
+				}
+				if output != "" {
+					continue
+				}
 				if logs != "" {
 					_, _ = fmt.Fprintf(os.Stdout, "%s\n", logs)
 				}
@@ -317,11 +424,28 @@ argocd admin settings validate --group accounts --group plugins --load-cluster-s
 					_, _ = fmt.Fprintf(os.Stdout, "\n")
 				}
 			}
+
+			if output == "json" {
+				if findings == nil {
+					findings = []settingsFinding{}
+				}
+				jsonBytes, err := json.MarshalIndent(findings, "", "  ")
+				errors.CheckError(err)
+				_, _ = fmt.Fprintln(os.Stdout, string(jsonBytes))
+			} else if len(findings) > 0 {
+				_, _ = fmt.Fprintf(os.Stdout, "\nFindings:\n")
+				printSettingsFindings(os.Stdout, findings)
+			}
+			if failed := countFindings(findings, warningsAsErrors); failed > 0 {
+				errors.Fatalf(errors.ErrorGeneric, "settings validation failed with %d finding(s)", failed)
+			}
 		},
 	}
 
 	command.Flags().StringArrayVar(&groups, "group", nil, fmt.Sprintf(
 		"Optional list of setting groups that have to be validated ( one of: %s)", strings.Join(allGroups, ", ")))
+	command.Flags().BoolVar(&warningsAsErrors, "warnings-as-errors", false, "Exit with a non-zero code if a warning is found")
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: json")
 
 	return command
 }
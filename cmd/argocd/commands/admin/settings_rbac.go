@@ -2,9 +2,12 @@ package admin
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"strings"
+	"text/tabwriter"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -91,8 +94,10 @@ var applicationsActions = actionTraitMap{
 }
 
 var accountsActions = actionTraitMap{
-	rbac.ActionCreate: rbacTrait{},
-	rbac.ActionUpdate: rbacTrait{},
+	rbac.ActionCreate:      rbacTrait{},
+	rbac.ActionUpdate:      rbacTrait{},
+	rbac.ActionDelete:      rbacTrait{},
+	rbac.ActionImpersonate: rbacTrait{},
 }
 
 var execActions = actionTraitMap{
@@ -118,6 +123,7 @@ func NewRBACCommand() *cobra.Command {
 	}
 	command.AddCommand(NewRBACCanCommand())
 	command.AddCommand(NewRBACValidateCommand())
+	command.AddCommand(NewRBACSimulateCommand())
 	return command
 }
 
@@ -133,6 +139,10 @@ func NewRBACCanCommand() *cobra.Command {
 		action       string
 		resource     string
 		subResource  string
+		groups       []string
+		explain      bool
+		validate     bool
+		file         string
 		clientConfig clientcmd.ClientConfig
 	)
 	command := &cobra.Command{
@@ -159,17 +169,46 @@ argocd admin settings rbac can some:role create application 'default/app' --name
 # You can override a possibly configured default role
 argocd admin settings rbac can someuser create application 'default/app' --default-role role:readonly
 
+# Check a user which is a member of OIDC groups, and show the rule which decided the result
+argocd admin settings rbac can delete application 'prod/*' --subject alice --groups my-org:ci --policy-file policy.csv --explain
+
+# Report the line numbers of syntax errors in a policy file
+argocd admin settings rbac can --validate --policy-file policy.csv
+
+# Check a list of assertions, e.g. in CI before applying argocd-rbac-cm. The
+# command exits non-zero if any of them fails. The file is a YAML list like:
+#   - subject: role:ci
+#     action: delete
+#     resource: applications
+#     subresource: prod/*
+#     expect: deny
+argocd admin settings rbac can --file assertions.yaml --policy-file argocd-rbac-cm.yaml
+
 `,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
-			if len(args) < 3 || len(args) > 4 {
+			switch {
+			case validate || file != "":
+				if len(args) > 0 || subject != "" {
+					c.HelpFunc()(c, args)
+					log.Fatalf("--validate and --file do not take a subject, action or resource")
+				}
+			case subject != "":
+				if len(args) < 2 || len(args) > 3 {
+					c.HelpFunc()(c, args)
+					os.Exit(1)
+				}
+				args = append([]string{subject}, args...)
+			case len(args) < 3 || len(args) > 4:
 				c.HelpFunc()(c, args)
 				os.Exit(1)
 			}
-			subject = args[0]
-			action = args[1]
-			resource = args[2]
+			if len(args) > 0 {
+				subject = args[0]
+				action = args[1]
+				resource = args[2]
+			}
 			if len(args) > 3 {
 				subResource = args[3]
 			}
@@ -196,6 +235,18 @@ argocd admin settings rbac can someuser create application 'default/app' --defau
 
 			userPolicy, newDefaultRole, matchMode := getPolicy(ctx, policyFile, realClientset, namespace)
 
+			if validate {
+				errs := rbac.ValidatePolicyLines(userPolicy)
+				for _, err := range errs {
+					fmt.Printf("Policy is invalid: %v\n", err)
+				}
+				if len(errs) > 0 {
+					os.Exit(1)
+				}
+				fmt.Println("Policy is valid.")
+				os.Exit(0)
+			}
+
 			// Use built-in policy as augmentation if requested
 			builtinPolicy := ""
 			if useBuiltin {
@@ -208,15 +259,56 @@ argocd admin settings rbac can someuser create application 'default/app' --defau
 				defaultRole = newDefaultRole
 			}
 
-			res := checkPolicy(subject, action, resource, subResource, builtinPolicy, userPolicy, defaultRole, matchMode, strict)
-			if res {
+			if file != "" {
+				assertions, err := readRBACAssertions(file)
+				if err != nil {
+					log.Fatalf("could not read assertions: %v", err)
+				}
+				enf, err := newPolicyEnforcer(builtinPolicy, userPolicy, defaultRole, matchMode)
+				if err != nil {
+					log.Fatalf("%v", err)
+				}
+				results := make([]rbacAssertionResult, len(assertions))
+				for i, a := range assertions {
+					allowed, rule, err := enforcePolicy(enf, a.Subject, a.Groups, a.Action, a.Resource, a.SubResource, strict)
+					if err != nil {
+						log.Fatalf("error in assertion %d: %v", i+1, err)
+					}
+					results[i] = rbacAssertionResult{rbacAssertion: a, Allowed: allowed, Rule: rule}
+				}
+				passed := true
+				for _, r := range results {
+					passed = passed && r.Passed()
+				}
 				if !quiet {
-					fmt.Println("Yes")
+					printRBACAssertionResults(os.Stdout, results)
+				}
+				if !passed {
+					os.Exit(1)
 				}
 				os.Exit(0)
 			}
+
+			enf, err := newPolicyEnforcer(builtinPolicy, userPolicy, defaultRole, matchMode)
+			if err != nil {
+				log.Fatalf("%v", err)
+			}
+			res, rule, err := enforcePolicy(enf, subject, groups, action, resource, subResource, strict)
+			if err != nil {
+				log.Fatalf("error in RBAC request: %v", err)
+			}
 			if !quiet {
-				fmt.Println("No")
+				if res {
+					fmt.Println("Yes")
+				} else {
+					fmt.Println("No")
+				}
+				if explain {
+					fmt.Println(formatRBACRuleExplanation(rule))
+				}
+			}
+			if res {
+				os.Exit(0)
 			}
 			os.Exit(1)
 		},
@@ -227,6 +319,129 @@ argocd admin settings rbac can someuser create application 'default/app' --defau
 	command.Flags().BoolVar(&useBuiltin, "use-builtin-policy", true, "whether to also use builtin-policy")
 	command.Flags().BoolVar(&strict, "strict", true, "whether to perform strict check on action and resource names")
 	command.Flags().BoolVarP(&quiet, "quiet", "q", false, "quiet mode - do not print results to stdout")
+	command.Flags().StringVar(&subject, "subject", "", "role or subject to check, instead of the first argument")
+	command.Flags().StringSliceVar(&groups, "groups", []string{}, "groups of the subject, e.g. from its OIDC token, which are checked as well")
+	command.Flags().BoolVar(&explain, "explain", false, "print the policy rule which decided the result")
+	command.Flags().BoolVar(&validate, "validate", false, "validate the policy and report the line numbers of syntax errors")
+	command.Flags().StringVar(&file, "file", "", "path to a YAML file with a list of assertions to check")
+	return command
+}
+
+// NewRBACSimulateCommand is the command for 'rbac simulate'
+func NewRBACSimulateCommand() *cobra.Command {
+	var (
+		policyFile   string
+		requestsFile string
+		defaultRole  string
+		useBuiltin   bool
+		strict       bool
+		user         string
+		groups       []string
+		output       string
+		clientConfig clientcmd.ClientConfig
+	)
+	command := &cobra.Command{
+		Use:   "simulate --user USER [--groups GROUPS] ACTION,RESOURCE[,SUB-RESOURCE]...",
+		Short: "Simulate a list of requests of a user against an RBAC policy",
+		Long: `
+Simulate a list of requests of a user and its groups against an RBAC policy,
+and report for each request whether it is allowed or denied together with the
+policy line which decided the result. This allows to review the effect of
+policy changes, e.g. in CI before applying argocd-rbac-cm.
+`,
+		Example: `
+# Simulate requests of a user in OIDC groups against the live argocd-rbac-cm
+argocd admin settings rbac simulate --user alice --groups my-org:devs,my-org:ops \
+  get,applications,default/guestbook sync,applications,default/guestbook delete,clusters --namespace argocd
+
+# Simulate requests read from a file, one per line, against a local policy
+argocd admin settings rbac simulate --user alice --requests-file requests.txt --policy-file argocd-rbac-cm.yaml
+
+# Output the results as JSON
+argocd admin settings rbac simulate --user alice get,logs,default/* --policy-file policy.csv -o json
+`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if user == "" || (len(args) == 0 && requestsFile == "") {
+				c.HelpFunc()(c, args)
+				log.Fatalf("--user and at least one request must be given")
+			}
+			requests := make([]rbacSimulationRequest, 0, len(args))
+			for _, arg := range args {
+				r, err := parseRBACSimulationRequest(arg)
+				if err != nil {
+					log.Fatalf("%v", err)
+				}
+				requests = append(requests, r)
+			}
+			if requestsFile != "" {
+				fileRequests, err := readRBACSimulationRequests(requestsFile)
+				if err != nil {
+					log.Fatalf("could not read requests: %v", err)
+				}
+				requests = append(requests, fileRequests...)
+			}
+
+			namespace, nsOverride, err := clientConfig.Namespace()
+			if err != nil {
+				log.Fatalf("could not create k8s client: %v", err)
+			}
+			if (!nsOverride && policyFile == "") || (nsOverride && policyFile != "") {
+				c.HelpFunc()(c, args)
+				log.Fatalf("please provide exactly one of --policy-file or --namespace")
+			}
+			var kubeClient kubernetes.Interface
+			if policyFile == "" {
+				restConfig, err := clientConfig.ClientConfig()
+				if err != nil {
+					log.Fatalf("could not create k8s client: %v", err)
+				}
+				kubeClient, err = kubernetes.NewForConfig(restConfig)
+				if err != nil {
+					log.Fatalf("could not create k8s client: %v", err)
+				}
+			}
+			userPolicy, newDefaultRole, matchMode := getPolicy(ctx, policyFile, kubeClient, namespace)
+			if newDefaultRole != "" && defaultRole == "" {
+				defaultRole = newDefaultRole
+			}
+			builtinPolicy := ""
+			if useBuiltin {
+				builtinPolicy = assets.BuiltinPolicyCSV
+			}
+			enf, err := newPolicyEnforcer(builtinPolicy, userPolicy, defaultRole, matchMode)
+			if err != nil {
+				log.Fatalf("%v", err)
+			}
+
+			results, err := simulateRBACRequests(enf, builtinPolicy, userPolicy, user, groups, requests, strict)
+			if err != nil {
+				log.Fatalf("%v", err)
+			}
+			switch output {
+			case "json":
+				data, err := json.MarshalIndent(results, "", "  ")
+				if err != nil {
+					log.Fatalf("%v", err)
+				}
+				fmt.Println(string(data))
+			case "text", "":
+				printRBACSimulationResults(os.Stdout, results)
+			default:
+				log.Fatalf("unknown output format: %s", output)
+			}
+		},
+	}
+	clientConfig = cli.AddKubectlFlagsToCmd(command)
+	command.Flags().StringVar(&policyFile, "policy-file", "", "path to the policy file to use")
+	command.Flags().StringVar(&requestsFile, "requests-file", "", "path to a file with one ACTION,RESOURCE[,SUB-RESOURCE] request per line, or '-' to read them from stdin")
+	command.Flags().StringVar(&defaultRole, "default-role", "", "name of the default role to use")
+	command.Flags().BoolVar(&useBuiltin, "use-builtin-policy", true, "whether to also use builtin-policy")
+	command.Flags().BoolVar(&strict, "strict", true, "whether to perform strict check on action and resource names")
+	command.Flags().StringVar(&user, "user", "", "user to simulate the requests of")
+	command.Flags().StringSliceVar(&groups, "groups", []string{}, "groups of the user, e.g. from its OIDC token")
+	command.Flags().StringVarP(&output, "output", "o", "text", "Output format. One of: text|json")
 	return command
 }
 
@@ -375,26 +590,46 @@ func getPolicyConfigMap(ctx context.Context, client kubernetes.Interface, namesp
 // checkPolicy checks whether given subject is allowed to execute specified
 // action against specified resource
 func checkPolicy(subject, action, resource, subResource, builtinPolicy, userPolicy, defaultRole, matchMode string, strict bool) bool {
+	enf, err := newPolicyEnforcer(builtinPolicy, userPolicy, defaultRole, matchMode)
+	if err != nil {
+		log.Fatalf("%v", err)
+		return false
+	}
+	res, _, err := enforcePolicy(enf, subject, nil, action, resource, subResource, strict)
+	if err != nil {
+		log.Fatalf("error in RBAC request: %v", err)
+		return false
+	}
+	return res
+}
+
+// newPolicyEnforcer returns an enforcer for the given built-in and user
+// policy, default role and match mode, like the one of the API server
+func newPolicyEnforcer(builtinPolicy, userPolicy, defaultRole, matchMode string) (*rbac.Enforcer, error) {
 	enf := rbac.NewEnforcer(nil, "argocd", "argocd-rbac-cm", nil)
 	enf.SetDefaultRole(defaultRole)
 	enf.SetMatchMode(matchMode)
 	if builtinPolicy != "" {
 		if err := enf.SetBuiltinPolicy(builtinPolicy); err != nil {
-			log.Fatalf("could not set built-in policy: %v", err)
-			return false
+			return nil, fmt.Errorf("could not set built-in policy: %w", err)
 		}
 	}
 	if userPolicy != "" {
 		if err := rbac.ValidatePolicy(userPolicy); err != nil {
-			log.Fatalf("invalid user policy: %v", err)
-			return false
+			return nil, fmt.Errorf("invalid user policy: %w", err)
 		}
 		if err := enf.SetUserPolicy(userPolicy); err != nil {
-			log.Fatalf("could not set user policy: %v", err)
-			return false
+			return nil, fmt.Errorf("could not set user policy: %w", err)
 		}
 	}
+	return enf, nil
+}
 
+// enforcePolicy checks whether given subject, or one of its groups, is allowed
+// to execute specified action against specified resource. It also returns the
+// rule which decided the result, which is empty if the request was denied
+// because no rule matched.
+func enforcePolicy(enf *rbac.Enforcer, subject string, groups []string, action, resource, subResource string, strict bool) (bool, []string, error) {
 	// User could have used a mutation of the resource name (i.e. 'cert' for
 	// 'certificate') - let's resolve it to the valid resource.
 	realResource := resolveRBACResourceName(resource)
@@ -403,8 +638,7 @@ func checkPolicy(subject, action, resource, subResource, builtinPolicy, userPoli
 	// actually valid tokens.
 	if strict {
 		if err := validateRBACResourceAction(realResource, action); err != nil {
-			log.Fatalf("error in RBAC request: %v", err)
-			return false
+			return false, nil, err
 		}
 	}
 
@@ -416,7 +650,222 @@ func checkPolicy(subject, action, resource, subResource, builtinPolicy, userPoli
 			subResource = "*/*"
 		}
 	}
-	return enf.Enforce(subject, realResource, action, subResource)
+
+	// Like the API server, the subject is allowed if the subject itself or
+	// any of its groups is allowed
+	var denyRule []string
+	for _, sub := range append([]string{subject}, groups...) {
+		ok, rule, err := enf.EnforceExplain(sub, realResource, action, subResource)
+		if err != nil {
+			return false, nil, err
+		}
+		if ok {
+			return true, rule, nil
+		}
+		if len(denyRule) == 0 {
+			denyRule = rule
+		}
+	}
+	return false, denyRule, nil
+}
+
+// formatRBACRuleExplanation describes the rule which decided the result of a check
+func formatRBACRuleExplanation(rule []string) string {
+	if len(rule) == 0 {
+		return "No rule matched, denied by default"
+	}
+	return "Matched rule: p, " + strings.Join(rule, ", ")
+}
+
+// rbacAssertion is a check with its expected result in a --file of assertions
+type rbacAssertion struct {
+	Subject     string   `json:"subject"`
+	Groups      []string `json:"groups,omitempty"`
+	Action      string   `json:"action"`
+	Resource    string   `json:"resource"`
+	SubResource string   `json:"subresource,omitempty"`
+	Expect      string   `json:"expect"`
+}
+
+type rbacAssertionResult struct {
+	rbacAssertion
+	Allowed bool
+	Rule    []string
+}
+
+// Passed returns whether the result of the check is the expected one
+func (r rbacAssertionResult) Passed() bool {
+	return r.Allowed == (r.Expect == "allow")
+}
+
+// readRBACAssertions loads a YAML or JSON list of assertions from given path
+func readRBACAssertions(path string) ([]rbacAssertion, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var assertions []rbacAssertion
+	if err := yaml.UnmarshalStrict(data, &assertions); err != nil {
+		return nil, err
+	}
+	for i, a := range assertions {
+		if a.Subject == "" || a.Action == "" || a.Resource == "" {
+			return nil, fmt.Errorf("assertion %d must set subject, action and resource", i+1)
+		}
+		if a.Expect != "allow" && a.Expect != "deny" {
+			return nil, fmt.Errorf("assertion %d must expect allow or deny, not '%s'", i+1, a.Expect)
+		}
+	}
+	return assertions, nil
+}
+
+// printRBACAssertionResults prints the results of a list of assertions as a table
+func printRBACAssertionResults(out io.Writer, results []rbacAssertionResult) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "RESULT\tSUBJECT\tACTION\tRESOURCE\tSUBRESOURCE\tEXPECTED\tRULE\n")
+	for _, r := range results {
+		result := "PASS"
+		if !r.Passed() {
+			result = "FAIL"
+		}
+		rule := "-"
+		if len(r.Rule) > 0 {
+			rule = "p, " + strings.Join(r.Rule, ", ")
+		}
+		subject := r.Subject
+		if len(r.Groups) > 0 {
+			subject = fmt.Sprintf("%s (%s)", subject, strings.Join(r.Groups, ","))
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", result, subject, r.Action, r.Resource, r.SubResource, r.Expect, rule)
+	}
+	_ = w.Flush()
+}
+
+// rbacSimulationRequest is a request simulated by 'rbac simulate'
+type rbacSimulationRequest struct {
+	Action      string `json:"action"`
+	Resource    string `json:"resource"`
+	SubResource string `json:"subresource,omitempty"`
+}
+
+// rbacSimulationResult is the result of a simulated request. Source is the line of the user policy which decided the
+// result, "built-in" for a rule of the built-in policy, or empty if no rule matched.
+type rbacSimulationResult struct {
+	rbacSimulationRequest
+	Allowed bool   `json:"allowed"`
+	Rule    string `json:"rule,omitempty"`
+	Source  string `json:"source,omitempty"`
+}
+
+// parseRBACSimulationRequest parses a request in ACTION,RESOURCE[,SUB-RESOURCE] notation
+func parseRBACSimulationRequest(request string) (rbacSimulationRequest, error) {
+	parts := strings.Split(request, ",")
+	for i := range parts {
+		parts[i] = strings.TrimSpace(parts[i])
+	}
+	if len(parts) < 2 || len(parts) > 3 || parts[0] == "" || parts[1] == "" {
+		return rbacSimulationRequest{}, fmt.Errorf("invalid request '%s': must be ACTION,RESOURCE[,SUB-RESOURCE]", request)
+	}
+	r := rbacSimulationRequest{Action: parts[0], Resource: parts[1]}
+	if len(parts) == 3 {
+		r.SubResource = parts[2]
+	}
+	return r, nil
+}
+
+// readRBACSimulationRequests reads requests from given path, or stdin if it is '-', one per line. Empty lines and
+// lines starting with '#' are ignored.
+func readRBACSimulationRequests(path string) ([]rbacSimulationRequest, error) {
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var requests []rbacSimulationRequest
+	for i, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		r, err := parseRBACSimulationRequest(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+		requests = append(requests, r)
+	}
+	return requests, nil
+}
+
+// simulateRBACRequests checks the given requests of a user and its groups and locates the policy line which decided
+// the result of each one
+func simulateRBACRequests(enf *rbac.Enforcer, builtinPolicy, userPolicy, user string, groups []string, requests []rbacSimulationRequest, strict bool) ([]rbacSimulationResult, error) {
+	results := make([]rbacSimulationResult, len(requests))
+	for i, r := range requests {
+		allowed, rule, err := enforcePolicy(enf, user, groups, r.Action, r.Resource, r.SubResource, strict)
+		if err != nil {
+			return nil, fmt.Errorf("error in request %s,%s,%s: %w", r.Action, r.Resource, r.SubResource, err)
+		}
+		result := rbacSimulationResult{rbacSimulationRequest: r, Allowed: allowed}
+		if len(rule) > 0 {
+			result.Rule = "p, " + strings.Join(rule, ", ")
+			if line := findPolicyLine(userPolicy, rule); line > 0 {
+				result.Source = fmt.Sprintf("line %d", line)
+			} else if findPolicyLine(builtinPolicy, rule) > 0 {
+				result.Source = "built-in"
+			}
+		}
+		results[i] = result
+	}
+	return results, nil
+}
+
+// findPolicyLine returns the 1-based number of the line of the policy defining the given 'p' rule, or 0 if there is
+// none
+func findPolicyLine(policy string, rule []string) int {
+	for i, line := range strings.Split(policy, "\n") {
+		fields := strings.Split(line, ",")
+		if len(fields) != len(rule)+1 || strings.TrimSpace(fields[0]) != "p" {
+			continue
+		}
+		matches := true
+		for j, field := range fields[1:] {
+			if strings.TrimSpace(field) != rule[j] {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// printRBACSimulationResults prints the results of simulated requests as a table
+func printRBACSimulationResults(out io.Writer, results []rbacSimulationResult) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "RESULT\tACTION\tRESOURCE\tSUBRESOURCE\tRULE\tSOURCE\n")
+	for _, r := range results {
+		result := "deny"
+		if r.Allowed {
+			result = "allow"
+		}
+		rule := r.Rule
+		if rule == "" {
+			rule = "no rule matched, denied by default"
+		}
+		source := r.Source
+		if source == "" {
+			source = "-"
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", result, r.Action, r.Resource, r.SubResource, rule, source)
+	}
+	_ = w.Flush()
 }
 
 // resolveRBACResourceName resolves a user supplied value to a valid RBAC
@@ -1,7 +1,9 @@
 package admin
 
 import (
+	"bytes"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -134,6 +136,122 @@ func Test_PolicyFromYAML(t *testing.T) {
 		"", uPol, dRole, matchMode, true))
 }
 
+func Test_enforcePolicy(t *testing.T) {
+	uPol, _, _ := getPolicy(t.Context(), "testdata/rbac/policy.csv", nil, "")
+	enf, err := newPolicyEnforcer("", uPol, "", "")
+	require.NoError(t, err)
+
+	t.Run("Allowed by group", func(t *testing.T) {
+		ok, rule, err := enforcePolicy(enf, "alice", []string{"my-org:devs", "role:test"}, "get", "logs", "", true)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, []string{"role:test", "logs", "get", "*/*", "allow"}, rule)
+	})
+	t.Run("Denied by rule", func(t *testing.T) {
+		ok, rule, err := enforcePolicy(enf, "test", nil, "delete", "app", "default/guestbook", true)
+		require.NoError(t, err)
+		assert.False(t, ok)
+		assert.Equal(t, "Matched rule: p, role:user, applications, delete, */guestbook, deny", formatRBACRuleExplanation(rule))
+	})
+	t.Run("Denied by default", func(t *testing.T) {
+		ok, rule, err := enforcePolicy(enf, "alice", nil, "get", "logs", "", true)
+		require.NoError(t, err)
+		assert.False(t, ok)
+		assert.Equal(t, "No rule matched, denied by default", formatRBACRuleExplanation(rule))
+	})
+	t.Run("Invalid action", func(t *testing.T) {
+		_, _, err := enforcePolicy(enf, "test", nil, "sync", "clusters", "", true)
+		require.ErrorContains(t, err, "'sync' is not a valid action for clusters")
+	})
+}
+
+func Test_RBACAssertions(t *testing.T) {
+	uPol, _, _ := getPolicy(t.Context(), "testdata/rbac/policy.csv", nil, "")
+	enf, err := newPolicyEnforcer("", uPol, "", "")
+	require.NoError(t, err)
+
+	assertions, err := readRBACAssertions("testdata/rbac/assertions.yaml")
+	require.NoError(t, err)
+	require.Len(t, assertions, 3)
+
+	results := make([]rbacAssertionResult, len(assertions))
+	for i, a := range assertions {
+		allowed, rule, err := enforcePolicy(enf, a.Subject, a.Groups, a.Action, a.Resource, a.SubResource, true)
+		require.NoError(t, err)
+		results[i] = rbacAssertionResult{rbacAssertion: a, Allowed: allowed, Rule: rule}
+	}
+	assert.True(t, results[0].Passed())
+	assert.True(t, results[1].Passed())
+	assert.False(t, results[2].Passed())
+
+	var out bytes.Buffer
+	printRBACAssertionResults(&out, results)
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 4)
+	assert.Equal(t, []string{"RESULT", "SUBJECT", "ACTION", "RESOURCE", "SUBRESOURCE", "EXPECTED", "RULE"}, strings.Fields(lines[0]))
+	assert.Equal(t, []string{"PASS", "test", "delete", "applications", "default/guestbook", "deny"}, strings.Fields(lines[1])[:6])
+	assert.Contains(t, lines[1], "p, role:user, applications, delete, */guestbook, deny")
+	assert.Contains(t, lines[2], "alice (role:test)")
+	assert.True(t, strings.HasPrefix(lines[3], "FAIL"))
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(dir+"/invalid.yaml", []byte("- subject: test\n  action: get\n  resource: logs\n  expect: yes\n"), 0o600))
+	_, err = readRBACAssertions(dir + "/invalid.yaml")
+	require.ErrorContains(t, err, "assertion 1 must expect allow or deny")
+}
+
+func Test_simulateRBACRequests(t *testing.T) {
+	uPol, _, _ := getPolicy(t.Context(), "testdata/rbac/policy.csv", nil, "")
+	enf, err := newPolicyEnforcer(assets.BuiltinPolicyCSV, uPol, "", "")
+	require.NoError(t, err)
+
+	requests := make([]rbacSimulationRequest, 0, 3)
+	for _, arg := range []string{"get,logs,default/guestbook", "delete, applications, default/guestbook", "get,clusters"} {
+		r, err := parseRBACSimulationRequest(arg)
+		require.NoError(t, err)
+		requests = append(requests, r)
+	}
+	results, err := simulateRBACRequests(enf, assets.BuiltinPolicyCSV, uPol, "alice", []string{"role:test", "test"}, requests, true)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	assert.True(t, results[0].Allowed)
+	assert.Equal(t, "p, role:test, logs, get, */*, allow", results[0].Rule)
+	assert.Equal(t, "line 11", results[0].Source)
+	assert.False(t, results[1].Allowed)
+	assert.Equal(t, "line 7", results[1].Source)
+	assert.True(t, results[2].Allowed)
+	assert.Equal(t, "line 1", results[2].Source)
+
+	results, err = simulateRBACRequests(enf, assets.BuiltinPolicyCSV, uPol, "bob", []string{"role:readonly"}, requests[:1], true)
+	require.NoError(t, err)
+	assert.True(t, results[0].Allowed)
+	assert.Equal(t, "built-in", results[0].Source)
+
+	results, err = simulateRBACRequests(enf, assets.BuiltinPolicyCSV, uPol, "bob", nil, requests[:1], true)
+	require.NoError(t, err)
+	assert.False(t, results[0].Allowed)
+	assert.Empty(t, results[0].Rule)
+
+	var out bytes.Buffer
+	printRBACSimulationResults(&out, results)
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 2)
+	assert.Equal(t, []string{"RESULT", "ACTION", "RESOURCE", "SUBRESOURCE", "RULE", "SOURCE"}, strings.Fields(lines[0]))
+	assert.Equal(t, []string{"deny", "get", "logs", "default/guestbook", "no", "rule", "matched,", "denied", "by", "default", "-"}, strings.Fields(lines[1]))
+
+	_, err = parseRBACSimulationRequest("get")
+	require.ErrorContains(t, err, "must be ACTION,RESOURCE[,SUB-RESOURCE]")
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(dir+"/requests.txt", []byte("# requests of alice\nget,logs,default/*\n\nsync,applications,default/guestbook\n"), 0o600))
+	requests, err = readRBACSimulationRequests(dir + "/requests.txt")
+	require.NoError(t, err)
+	assert.Equal(t, []rbacSimulationRequest{
+		{Action: "get", Resource: "logs", SubResource: "default/*"},
+		{Action: "sync", Resource: "applications", SubResource: "default/guestbook"},
+	}, requests)
+}
+
 func Test_PolicyFromK8s(t *testing.T) {
 	data, err := os.ReadFile("testdata/rbac/policy.csv")
 	ctx := t.Context()
@@ -307,3 +425,11 @@ func TestNewRBACValidateCommand(t *testing.T) {
 	assert.Equal(t, "validate", command.Name())
 	assert.Equal(t, "Validate RBAC policy", command.Short)
 }
+
+func TestNewRBACSimulateCommand(t *testing.T) {
+	command := NewRBACSimulateCommand()
+
+	require.NotNil(t, command)
+	assert.Equal(t, "simulate", command.Name())
+	assert.Equal(t, "Simulate a list of requests of a user against an RBAC policy", command.Short)
+}
@@ -450,3 +450,122 @@ resume   false
 		assert.Contains(t, out, "false")
 	})
 }
+
+func TestValidateArgoCDConfigMap(t *testing.T) {
+	findings := validateArgoCDConfigMap(map[string]string{
+		"resource.customizations.health.apps_Deployment":  "hs = {}\nif obj.status then\nreturn hs",
+		"resource.customizations.health.a_b_c":            "return {}",
+		"resource.customizations.unknown.apps_Deployment": "foo",
+		"resource.exclusions":                             "- apiGroups:\n  - '[abc'\n  kinds:\n  - '*'",
+		"accounts.alice":                                  "login, admin",
+		"accounts.alice.enabled":                          "maybe",
+		"repositories":                                    "- url: https://github.com/argoproj/argocd-example-apps",
+	})
+	byKey := map[string][]settingsFinding{}
+	for _, finding := range findings {
+		assert.Equal(t, common.ArgoCDConfigMapName, finding.Source)
+		byKey[finding.Key] = append(byKey[finding.Key], finding)
+	}
+
+	health := byKey["resource.customizations.health.apps_Deployment"]
+	require.Len(t, health, 1)
+	assert.Equal(t, findingSeverityError, health[0].Severity)
+	assert.NotEmpty(t, health[0].Snippet)
+	require.Len(t, byKey["resource.customizations.health.a_b_c"], 1)
+	assert.Contains(t, byKey["resource.customizations.health.a_b_c"][0].Message, "key should be in format")
+	require.Len(t, byKey["resource.customizations.unknown.apps_Deployment"], 1)
+	assert.Contains(t, byKey["resource.customizations.unknown.apps_Deployment"][0].Message, `unknown customization type "unknown"`)
+
+	require.Len(t, byKey["resource.exclusions"], 1)
+	assert.Equal(t, "[abc", byKey["resource.exclusions"][0].Snippet)
+
+	require.Len(t, byKey["accounts.alice"], 1)
+	assert.Contains(t, byKey["accounts.alice"][0].Message, `unsupported account capability "admin"`)
+	require.Len(t, byKey["accounts.alice.enabled"], 1)
+	assert.Equal(t, "maybe", byKey["accounts.alice.enabled"][0].Snippet)
+
+	require.Len(t, byKey["repositories"], 1)
+	assert.Equal(t, findingSeverityWarning, byKey["repositories"][0].Severity)
+}
+
+func TestValidateArgoCDConfigMap_LegacyCustomizations(t *testing.T) {
+	findings := validateArgoCDConfigMap(map[string]string{
+		"resource.customizations": `apps/Deployment:
+  health.lua: |
+    return {
+  actions: |
+    definitions:
+    - name: restart
+      action.lua: |
+        return obj`,
+	})
+	require.Len(t, findings, 2)
+	assert.Equal(t, findingSeverityWarning, findings[0].Severity)
+	assert.Equal(t, findingSeverityError, findings[1].Severity)
+	assert.Contains(t, findings[1].Message, "invalid health.lua of apps/Deployment")
+}
+
+func TestValidateRBACConfigMap(t *testing.T) {
+	findings := validateRBACConfigMap(map[string]string{
+		"policy.csv":       "p, role:org-admin, applications, *, */*, allow\np, role:org-admin, applications, get\ng, alice, role:org-admin",
+		"policy.default":   "readonly",
+		"policy.matchMode": "wildcard",
+		"scopes":           "[groups",
+	})
+	require.Len(t, findings, 4)
+	assert.Equal(t, "policy.csv", findings[0].Key)
+	assert.Contains(t, findings[0].Message, "line 2")
+	assert.Equal(t, "p, role:org-admin, applications, get", findings[0].Snippet)
+	assert.Equal(t, "policy.default", findings[1].Key)
+	assert.Equal(t, findingSeverityWarning, findings[1].Severity)
+	assert.Equal(t, "policy.matchMode", findings[2].Key)
+	assert.Equal(t, "scopes", findings[3].Key)
+	assert.Equal(t, findingSeverityError, findings[3].Severity)
+}
+
+func TestCountFindings(t *testing.T) {
+	findings := []settingsFinding{{Severity: findingSeverityWarning}, {Severity: findingSeverityError}}
+	assert.Equal(t, 1, countFindings(findings, false))
+	assert.Equal(t, 2, countFindings(findings, true))
+}
+
+func TestCreateSettingsManager_Files(t *testing.T) {
+	f, closer, err := tempFile(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: argocd-cm
+data:
+  url: https://myargocd.com
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: argocd-rbac-cm
+data:
+  policy.default: role:readonly
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: argocd-secret
+stringData:
+  server.secretkey: test`)
+	require.NoError(t, err)
+	defer utilio.Close(closer)
+
+	opts := settingsOpts{files: []string{f}}
+	settingsManager, err := opts.createSettingsManager(t.Context())
+	require.NoError(t, err)
+
+	argoCDSettings, err := settingsManager.GetSettings()
+	require.NoError(t, err)
+	assert.Equal(t, "https://myargocd.com", argoCDSettings.URL)
+
+	rbacCM, err := settingsManager.GetConfigMapByName(common.ArgoCDRBACConfigMapName)
+	require.NoError(t, err)
+	assert.Equal(t, "role:readonly", rbacCM.Data["policy.default"])
+
+	findings, err := validateSettingsStructure(settingsManager)
+	require.NoError(t, err)
+	assert.Empty(t, findings)
+}
@@ -0,0 +1,346 @@
+package admin
+
+import (
+	stderrors "errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	gopherlua "github.com/yuin/gopher-lua"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/glob"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+const (
+	findingSeverityError   = "error"
+	findingSeverityWarning = "warning"
+
+	resourceCustomizationsKey = "resource.customizations"
+	maxFindingSnippetLength   = 80
+	serverSignatureKey        = "server.secretkey"
+)
+
+// findingLineRegexp extracts the line number from YAML ("line 3") and Lua ("line:3") parse errors
+var findingLineRegexp = regexp.MustCompile(`line[: ](\d+)`)
+
+// settingsFinding is a problem found by the structural validation of the Argo CD settings
+type settingsFinding struct {
+	Severity string `json:"severity"`
+	Source   string `json:"source"`
+	Key      string `json:"key,omitempty"`
+	Message  string `json:"message"`
+	Snippet  string `json:"snippet,omitempty"`
+}
+
+func newSettingsError(source, key, value string, err error) settingsFinding {
+	return settingsFinding{
+		Severity: findingSeverityError,
+		Source:   source,
+		Key:      key,
+		Message:  err.Error(),
+		Snippet:  findingSnippet(value, err),
+	}
+}
+
+func newSettingsWarning(source, key, message string) settingsFinding {
+	return settingsFinding{Severity: findingSeverityWarning, Source: source, Key: key, Message: message}
+}
+
+// findingSnippet returns the line of the value the error refers to, or its first non-empty line if the error has
+// no line number
+func findingSnippet(value string, err error) string {
+	lines := strings.Split(value, "\n")
+	snippet := ""
+	if m := findingLineRegexp.FindStringSubmatch(err.Error()); m != nil {
+		if n, convErr := strconv.Atoi(m[1]); convErr == nil && n >= 1 && n <= len(lines) {
+			snippet = lines[n-1]
+		}
+	}
+	if snippet == "" {
+		for _, line := range lines {
+			if strings.TrimSpace(line) != "" {
+				snippet = line
+				break
+			}
+		}
+	}
+	snippet = strings.TrimSpace(snippet)
+	if len(snippet) > maxFindingSnippetLength {
+		snippet = snippet[:maxFindingSnippetLength-3] + "..."
+	}
+	return snippet
+}
+
+// validateSettingsStructure validates the structure of the keys of argocd-cm, argocd-rbac-cm and argocd-secret
+func validateSettingsStructure(manager *settings.SettingsManager) ([]settingsFinding, error) {
+	var findings []settingsFinding
+	argocdCM, err := manager.GetConfigMapByName(common.ArgoCDConfigMapName)
+	if err != nil {
+		return nil, fmt.Errorf("error getting %s: %w", common.ArgoCDConfigMapName, err)
+	}
+	findings = append(findings, validateArgoCDConfigMap(argocdCM.Data)...)
+
+	rbacCM, err := manager.GetConfigMapByName(common.ArgoCDRBACConfigMapName)
+	switch {
+	case apierrors.IsNotFound(err):
+	case err != nil:
+		return nil, fmt.Errorf("error getting %s: %w", common.ArgoCDRBACConfigMapName, err)
+	default:
+		findings = append(findings, validateRBACConfigMap(rbacCM.Data)...)
+	}
+
+	argocdSecret, err := manager.GetSecretByName(common.ArgoCDSecretName)
+	switch {
+	case apierrors.IsNotFound(err):
+	case err != nil:
+		return nil, fmt.Errorf("error getting %s: %w", common.ArgoCDSecretName, err)
+	default:
+		findings = append(findings, validateArgoCDSecret(argocdSecret.Data)...)
+	}
+	return findings, nil
+}
+
+func sortedKeys[V any](data map[string]V) []string {
+	keys := make([]string, 0, len(data))
+	for k := range data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func validateArgoCDConfigMap(data map[string]string) []settingsFinding {
+	source := common.ArgoCDConfigMapName
+	var findings []settingsFinding
+	for _, key := range sortedKeys(data) {
+		value := data[key]
+		switch {
+		case key == resourceCustomizationsKey:
+			findings = append(findings, newSettingsWarning(source, key,
+				"resource.customizations is deprecated, use resource.customizations.<type>.<group_kind> keys instead"))
+			var overrides map[string]v1alpha1.ResourceOverride
+			if err := yaml.Unmarshal([]byte(value), &overrides); err != nil {
+				findings = append(findings, newSettingsError(source, key, value, err))
+				continue
+			}
+			for _, groupKind := range sortedKeys(overrides) {
+				override := overrides[groupKind]
+				if err := validateLuaScript(override.HealthLua); err != nil {
+					findings = append(findings, newSettingsError(source, key, override.HealthLua, fmt.Errorf("invalid health.lua of %s: %w", groupKind, err)))
+				}
+				if value, err := validateResourceActions(override.Actions); err != nil {
+					findings = append(findings, newSettingsError(source, key, value, fmt.Errorf("invalid actions of %s: %w", groupKind, err)))
+				}
+			}
+		case strings.HasPrefix(key, resourceCustomizationsKey+"."):
+			if err := validateResourceCustomization(key, value); err != nil {
+				findings = append(findings, newSettingsError(source, key, value, err))
+			}
+		case key == "resource.exclusions" || key == "resource.inclusions":
+			findings = append(findings, validateFilteredResources(source, key, value)...)
+		case strings.HasPrefix(key, "accounts."):
+			findings = append(findings, validateAccountKey(source, key, value)...)
+		case key == "dex.config":
+			if _, err := settings.UnmarshalDexConfig(value); err != nil {
+				findings = append(findings, newSettingsError(source, key, value, err))
+			}
+		case key == "oidc.config":
+			if err := settings.ValidateOIDCConfig(value); err != nil {
+				findings = append(findings, newSettingsError(source, key, value, err))
+			}
+			if data["dex.config"] != "" {
+				findings = append(findings, newSettingsWarning(source, key, "oidc.config is ignored because dex.config is set"))
+			}
+		case key == "ldap.config":
+			if err := settings.ValidateLDAPConfig(value); err != nil {
+				findings = append(findings, newSettingsError(source, key, value, err))
+			}
+		case key == "repositories" || key == "repository.credentials":
+			var repos []map[string]any
+			if err := yaml.Unmarshal([]byte(value), &repos); err != nil {
+				findings = append(findings, newSettingsError(source, key, value, err))
+			}
+			findings = append(findings, newSettingsWarning(source, key, key+" is deprecated, use repository secrets instead"))
+		}
+	}
+	return findings
+}
+
+// validateLuaScript compiles the script without running it
+func validateLuaScript(script string) error {
+	if script == "" {
+		return nil
+	}
+	l := gopherlua.NewState(gopherlua.Options{SkipOpenLibs: true})
+	defer l.Close()
+	_, err := l.LoadString(script)
+	return err
+}
+
+// validateResourceActions parses the actions definition and compiles its scripts. It returns the part of the
+// definition the error refers to.
+func validateResourceActions(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	var actions v1alpha1.ResourceActions
+	if err := yaml.Unmarshal([]byte(value), &actions); err != nil {
+		return value, err
+	}
+	if err := validateLuaScript(actions.ActionDiscoveryLua); err != nil {
+		return actions.ActionDiscoveryLua, fmt.Errorf("invalid discovery.lua: %w", err)
+	}
+	for _, definition := range actions.Definitions {
+		if err := validateLuaScript(definition.ActionLua); err != nil {
+			return definition.ActionLua, fmt.Errorf("invalid action.lua of action %s: %w", definition.Name, err)
+		}
+	}
+	return "", nil
+}
+
+func validateResourceCustomization(key, value string) error {
+	customizationType, groupKind, ok := strings.Cut(strings.TrimPrefix(key, resourceCustomizationsKey+"."), ".")
+	if !ok || groupKind == "" || len(strings.Split(groupKind, "_")) > 2 {
+		return stderrors.New("key should be in format resource.customizations.<type>.<group_kind> or resource.customizations.<type>.<kind>")
+	}
+	switch customizationType {
+	case "health":
+		return validateLuaScript(value)
+	case "useOpenLibs":
+		_, err := strconv.ParseBool(value)
+		return err
+	case "actions":
+		_, err := validateResourceActions(value)
+		return err
+	case "ignoreDifferences", "ignoreResourceUpdates":
+		var ignoreDiff v1alpha1.OverrideIgnoreDiff
+		return yaml.Unmarshal([]byte(value), &ignoreDiff)
+	case "knownTypeFields":
+		var fields []v1alpha1.KnownTypeField
+		return yaml.Unmarshal([]byte(value), &fields)
+	default:
+		return fmt.Errorf("unknown customization type %q", customizationType)
+	}
+}
+
+func validateFilteredResources(source, key, value string) []settingsFinding {
+	var resources []settings.FilteredResource
+	if err := yaml.Unmarshal([]byte(value), &resources); err != nil {
+		return []settingsFinding{newSettingsError(source, key, value, err)}
+	}
+	var findings []settingsFinding
+	for _, resource := range resources {
+		for _, patterns := range [][]string{resource.APIGroups, resource.Kinds, resource.Clusters} {
+			for _, pattern := range patterns {
+				if _, err := glob.MatchWithError(pattern, ""); err != nil {
+					findings = append(findings, newSettingsError(source, key, pattern, fmt.Errorf("invalid glob pattern %q: %w", pattern, err)))
+				}
+			}
+		}
+	}
+	return findings
+}
+
+func validateAccountKey(source, key, value string) []settingsFinding {
+	parts := strings.Split(key, ".")
+	switch {
+	case len(parts) == 2:
+		var findings []settingsFinding
+		for _, capability := range strings.Split(value, ",") {
+			capability = strings.TrimSpace(capability)
+			switch capability {
+			case "", string(settings.AccountCapabilityLogin), string(settings.AccountCapabilityApiKey):
+			default:
+				findings = append(findings, newSettingsError(source, key, value, fmt.Errorf("unsupported account capability %q", capability)))
+			}
+		}
+		return findings
+	case len(parts) == 3 && parts[2] == "enabled":
+		if _, err := strconv.ParseBool(value); err != nil {
+			return []settingsFinding{newSettingsError(source, key, value, err)}
+		}
+		return nil
+	default:
+		return []settingsFinding{newSettingsWarning(source, key, "unexpected account key, it is ignored")}
+	}
+}
+
+func validateRBACConfigMap(data map[string]string) []settingsFinding {
+	source := common.ArgoCDRBACConfigMapName
+	var findings []settingsFinding
+	for _, key := range sortedKeys(data) {
+		value := data[key]
+		switch {
+		case strings.HasPrefix(key, "policy.") && strings.HasSuffix(key, ".csv"):
+			lines := strings.Split(value, "\n")
+			for _, err := range rbac.ValidatePolicyLines(value) {
+				var lineErr *rbac.PolicyLineError
+				if stderrors.As(err, &lineErr) && lineErr.Line >= 1 && lineErr.Line <= len(lines) {
+					findings = append(findings, newSettingsError(source, key, lines[lineErr.Line-1], err))
+				} else {
+					findings = append(findings, newSettingsError(source, key, value, err))
+				}
+			}
+		case key == rbac.ConfigMapPolicyDefaultKey:
+			if value != "" && !strings.HasPrefix(value, "role:") {
+				findings = append(findings, newSettingsWarning(source, key, fmt.Sprintf("default role %q is not prefixed with 'role:'", value)))
+			}
+		case key == rbac.ConfigMapMatchModeKey:
+			if value != rbac.GlobMatchMode && value != rbac.RegexMatchMode {
+				findings = append(findings, newSettingsError(source, key, value,
+					fmt.Errorf("match mode should be %q or %q", rbac.GlobMatchMode, rbac.RegexMatchMode)))
+			}
+		case key == rbac.ConfigMapScopesKey:
+			var scopes []string
+			if err := yaml.Unmarshal([]byte(value), &scopes); err != nil {
+				findings = append(findings, newSettingsError(source, key, value, err))
+			}
+		}
+	}
+	return findings
+}
+
+func validateArgoCDSecret(data map[string][]byte) []settingsFinding {
+	if len(data[serverSignatureKey]) == 0 {
+		return []settingsFinding{newSettingsWarning(common.ArgoCDSecretName, serverSignatureKey,
+			"server signature key is not set, the API server generates one on startup")}
+	}
+	return nil
+}
+
+// countFindings returns the number of findings which fail the validation
+func countFindings(findings []settingsFinding, warningsAsErrors bool) int {
+	count := 0
+	for _, finding := range findings {
+		if finding.Severity == findingSeverityError || warningsAsErrors {
+			count++
+		}
+	}
+	return count
+}
+
+func printSettingsFindings(out io.Writer, findings []settingsFinding) {
+	for _, finding := range findings {
+		icon := "❌"
+		if finding.Severity == findingSeverityWarning {
+			icon = "⚠️"
+		}
+		location := finding.Source
+		if finding.Key != "" {
+			location = fmt.Sprintf("%s %s", location, finding.Key)
+		}
+		_, _ = fmt.Fprintf(out, "%s %s: %s\n", icon, location, finding.Message)
+		if finding.Snippet != "" {
+			_, _ = fmt.Fprintf(out, "    > %s\n", finding.Snippet)
+		}
+	}
+}
@@ -5,8 +5,14 @@ import (
 	"encoding/json"
 	stderrors "errors"
 	"fmt"
+	"hash/fnv"
 	"io"
+	"maps"
+	"math"
+	"net"
 	"os"
+	"os/exec"
+	"path/filepath"
 	"reflect"
 	"slices"
 	"sort"
@@ -17,21 +23,28 @@ import (
 	"time"
 	"unicode/utf8"
 
+	"github.com/argoproj/gitops-engine/pkg/diff"
 	"github.com/argoproj/gitops-engine/pkg/health"
 	"github.com/argoproj/gitops-engine/pkg/sync/common"
 	"github.com/argoproj/gitops-engine/pkg/sync/hook"
 	"github.com/argoproj/gitops-engine/pkg/sync/ignore"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/vm"
 	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/retry"
 	"github.com/mattn/go-isatty"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	jsonpatchv2 "gomodules.xyz/jsonpatch/v2"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	k8swatch "k8s.io/apimachinery/pkg/watch"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/yaml"
@@ -39,6 +52,9 @@ import (
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/utils"
 	cmdutil "github.com/argoproj/argo-cd/v3/cmd/util"
+	"github.com/argoproj/argo-cd/v3/cmpserver"
+	"github.com/argoproj/argo-cd/v3/cmpserver/plugin"
+	argocommon "github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/controller"
 	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
@@ -56,6 +72,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/git"
 	"github.com/argoproj/argo-cd/v3/util/grpc"
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/io/files"
 	logutils "github.com/argoproj/argo-cd/v3/util/log"
 	"github.com/argoproj/argo-cd/v3/util/manifeststream"
 	"github.com/argoproj/argo-cd/v3/util/templates"
@@ -87,6 +104,7 @@ func NewApplicationCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 	command.AddCommand(NewApplicationUnsetCommand(clientOpts))
 	command.AddCommand(NewApplicationSyncCommand(clientOpts))
 	command.AddCommand(NewApplicationHistoryCommand(clientOpts))
+	command.AddCommand(NewApplicationHistoryDiffCommand(clientOpts))
 	command.AddCommand(NewApplicationRollbackCommand(clientOpts))
 	command.AddCommand(NewApplicationListCommand(clientOpts))
 	command.AddCommand(NewApplicationDeleteCommand(clientOpts))
@@ -100,20 +118,70 @@ func NewApplicationCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 	command.AddCommand(NewApplicationResourceActionsCommand(clientOpts))
 	command.AddCommand(NewApplicationListResourcesCommand(clientOpts))
 	command.AddCommand(NewApplicationLogsCommand(clientOpts))
+	command.AddCommand(NewApplicationExecCommand(clientOpts))
+	command.AddCommand(NewApplicationPortForwardCommand(clientOpts))
+	command.AddCommand(NewApplicationTopCommand(clientOpts))
+	command.AddCommand(NewApplicationEventsCommand(clientOpts))
 	command.AddCommand(NewApplicationAddSourceCommand(clientOpts))
 	command.AddCommand(NewApplicationRemoveSourceCommand(clientOpts))
 	command.AddCommand(NewApplicationConfirmDeletionCommand(clientOpts))
 	return command
 }
 
+// outputNone makes waitOnApplicationStatus print nothing, for callers which print their own result
+const outputNone = "none"
+
 type watchOpts struct {
-	sync      bool
-	health    bool
-	operation bool
-	suspended bool
-	degraded  bool
-	delete    bool
-	hydrated  bool
+	sync       bool
+	health     bool
+	operation  bool
+	suspended  bool
+	degraded   bool
+	delete     bool
+	hydrated   bool
+	conditions *waitConditions
+}
+
+// waitConditions are expressions which must all evaluate to true against the application being waited for
+type waitConditions struct {
+	expressions []string
+	programs    []*vm.Program
+}
+
+// newWaitConditions compiles the given expressions, which are evaluated with the fields of the application, like
+// metadata, spec and status, as variables. The expressions use the expr language (https://expr-lang.org), which is
+// already a dependency of Argo CD, rather than CEL.
+func newWaitConditions(expressions []string) (*waitConditions, error) {
+	conditions := &waitConditions{expressions: expressions}
+	for _, expression := range expressions {
+		program, err := expr.Compile(expression, expr.AsBool(), expr.AllowUndefinedVariables())
+		if err != nil {
+			return nil, fmt.Errorf("invalid condition %q: %w", expression, err)
+		}
+		conditions.programs = append(conditions.programs, program)
+	}
+	return conditions, nil
+}
+
+// met returns whether all conditions are true for the application. A condition which cannot be evaluated, e.g.
+// because it refers to a field which is not set yet, is not met.
+func (c *waitConditions) met(app *argoappv1.Application) bool {
+	env, err := runtime.DefaultUnstructuredConverter.ToUnstructured(app)
+	if err != nil {
+		log.Warnf("Failed to convert application %s to evaluate the wait conditions: %v", app.QualifiedName(), err)
+		return false
+	}
+	for i, program := range c.programs {
+		out, err := expr.Run(program, env)
+		if err != nil {
+			log.Debugf("Failed to evaluate condition %q: %v", c.expressions[i], err)
+			return false
+		}
+		if met, ok := out.(bool); !ok || !met {
+			return false
+		}
+	}
+	return true
 }
 
 // NewApplicationCreateCommand returns a new instance of an `argocd app create` command
@@ -156,7 +224,7 @@ func NewApplicationCreateCommand(clientOpts *argocdclient.ClientOptions) *cobra.
 
 			argocdClient := headless.NewClientOrDie(clientOpts, c)
 			apps, err := cmdutil.ConstructApps(fileURL, appName, labels, annotations, args, appOpts, c.Flags())
-			errors.CheckError(err)
+			errors.CheckErrorWithContext(ctx, err)
 
 			for _, app := range apps {
 				if app.Name == "" {
@@ -182,11 +250,11 @@ func NewApplicationCreateCommand(clientOpts *argocdclient.ClientOptions) *cobra.
 				unwrappedError := grpc.UnwrapGRPCStatus(err).Code()
 				// As part of the fix for CVE-2022-41354, the API will return Permission Denied when an app does not exist.
 				if unwrappedError != codes.NotFound && unwrappedError != codes.PermissionDenied {
-					errors.CheckError(err)
+					errors.CheckErrorWithContext(ctx, err)
 				}
 
 				created, err := appIf.Create(ctx, &appCreateRequest)
-				errors.CheckError(err)
+				errors.CheckErrorWithContext(ctx, err)
 
 				var action string
 				switch {
@@ -282,7 +350,7 @@ func parentChildDetails(ctx context.Context, appIf application.ApplicationServic
 	parentNode := make(map[string]struct{})
 
 	resourceTree, err := appIf.ResourceTree(ctx, &application.ResourcesQuery{Name: &appName, AppNamespace: &appNs, ApplicationName: &appName})
-	errors.CheckError(err)
+	errors.CheckErrorWithContext(ctx, err)
 
 	for _, node := range resourceTree.Nodes {
 		mapUIDToNode[node.UID] = node
@@ -321,6 +389,45 @@ func printHeader(ctx context.Context, acdClient argocdclient.Client, app *argoap
 	}
 }
 
+// resolveSourcePosition returns the position, counting from 1, of the source of an application which is addressed by
+// either its position, its index in spec.sources counting from 0, or its name. For an application with multiple
+// sources one of them must be given.
+func resolveSourcePosition(app *argoappv1.Application, sourcePosition, sourceIndex int, sourceName string) (int, error) {
+	given := 0
+	for _, ok := range []bool{sourcePosition != -1, sourceIndex != -1, sourceName != ""} {
+		if ok {
+			given++
+		}
+	}
+	if given > 1 {
+		return 0, stderrors.New("only one of source-position, source-index and source-name can be specified")
+	}
+
+	switch {
+	case sourceName != "":
+		pos, ok := getSourceNameToPositionMap(app)[sourceName]
+		if !ok {
+			return 0, fmt.Errorf("unknown source name '%s'", sourceName)
+		}
+		sourcePosition = int(pos)
+	case sourceIndex != -1:
+		if sourceIndex < 0 {
+			return 0, stderrors.New("source index must be greater than or equal to 0")
+		}
+		sourcePosition = sourceIndex + 1
+	}
+
+	if app.Spec.HasMultipleSources() {
+		if sourcePosition <= 0 {
+			return 0, stderrors.New("source position should be specified and must be greater than 0 for applications with multiple sources")
+		}
+		if len(app.Spec.GetSources()) < sourcePosition {
+			return 0, stderrors.New("source position should be less than the number of sources in the application")
+		}
+	}
+	return sourcePosition, nil
+}
+
 // getSourceNameToPositionMap returns a map of source name to position
 func getSourceNameToPositionMap(app *argoappv1.Application) map[string]int64 {
 	sourceNameToPosition := make(map[string]int64)
@@ -344,10 +451,12 @@ func NewApplicationGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 		appNamespace   string
 		sourcePosition int
 		sourceName     string
+		watch          bool
 	)
 	command := &cobra.Command{
-		Use:   "get APPNAME",
-		Short: "Get application details",
+		Use:               "get APPNAME",
+		ValidArgsFunction: completeAppNames(clientOpts, 1),
+		Short:             "Get application details",
 		Example: templates.Examples(`  
   # Get basic details about the application "my-app" in wide format
   argocd app get my-app -o wide
@@ -381,6 +490,9 @@ func NewApplicationGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
   
   # Get application details and display them in a detailed tree format
   argocd app get my-app --output tree=detailed
+
+  # Watch the application and print its details again on every change
+  argocd app get my-app --watch
   		`),
 
 		Run: func(c *cobra.Command, args []string) {
@@ -396,8 +508,9 @@ func NewApplicationGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 
 			appName, appNs := argo.ParseFromQualifiedName(args[0], appNamespace)
 
+			var timer *time.Timer
 			if timeout != 0 {
-				time.AfterFunc(time.Duration(timeout)*time.Second, func() {
+				timer = time.AfterFunc(time.Duration(timeout)*time.Second, func() {
 					if ctx.Err() != nil {
 						fmt.Println("Timeout function: context already cancelled:", ctx.Err())
 					} else {
@@ -439,7 +552,7 @@ func NewApplicationGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 			}
 
 			app, err := getAppStateWithRetry()
-			errors.CheckError(err)
+			errors.CheckErrorWithContext(ctx, err)
 
 			if ctx.Err() != nil {
 				ctx = context.Background() // Reset context for subsequent requests
@@ -470,38 +583,64 @@ func NewApplicationGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 			pConn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
 			defer utilio.Close(pConn)
 			proj, err := projIf.Get(ctx, &projectpkg.ProjectQuery{Name: app.Spec.Project})
-			errors.CheckError(err)
-
-			windows := proj.Spec.SyncWindows.Matches(app)
+			errors.CheckErrorWithContext(ctx, err)
+
+			printApp := func(app *argoappv1.Application) {
+				windows := proj.Spec.SyncWindows.Matches(app)
+
+				switch output {
+				case "yaml", "json":
+					err := PrintResource(app, output)
+					errors.CheckErrorWithContext(ctx, err)
+				case "wide", "":
+					printHeader(ctx, acdClient, app, windows, showOperation, showParams, sourcePosition)
+					if len(app.Status.Resources) > 0 {
+						fmt.Println()
+						w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+						printAppResources(w, app)
+						_ = w.Flush()
+					}
+				case "tree":
+					printHeader(ctx, acdClient, app, windows, showOperation, showParams, sourcePosition)
+					mapUIDToNode, mapParentToChild, parentNode, mapNodeNameToResourceState := resourceParentChild(ctx, acdClient, appName, appNs)
+					if len(mapUIDToNode) > 0 {
+						fmt.Println()
+						printTreeView(mapUIDToNode, mapParentToChild, parentNode, mapNodeNameToResourceState)
+					}
+				case "tree=detailed":
+					printHeader(ctx, acdClient, app, windows, showOperation, showParams, sourcePosition)
+					mapUIDToNode, mapParentToChild, parentNode, mapNodeNameToResourceState := resourceParentChild(ctx, acdClient, appName, appNs)
+					if len(mapUIDToNode) > 0 {
+						fmt.Println()
+						printTreeViewDetailed(mapUIDToNode, mapParentToChild, parentNode, mapNodeNameToResourceState)
+					}
+				default:
+					errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+				}
+			}
+			printApp(app)
+			if !watch {
+				return
+			}
 
-			switch output {
-			case "yaml", "json":
-				err := PrintResource(app, output)
-				errors.CheckError(err)
-			case "wide", "":
-				printHeader(ctx, acdClient, app, windows, showOperation, showParams, sourcePosition)
-				if len(app.Status.Resources) > 0 {
-					fmt.Println()
-					w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-					printAppResources(w, app)
-					_ = w.Flush()
-				}
-			case "tree":
-				printHeader(ctx, acdClient, app, windows, showOperation, showParams, sourcePosition)
-				mapUIDToNode, mapParentToChild, parentNode, mapNodeNameToResourceState := resourceParentChild(ctx, acdClient, appName, appNs)
-				if len(mapUIDToNode) > 0 {
-					fmt.Println()
-					printTreeView(mapUIDToNode, mapParentToChild, parentNode, mapNodeNameToResourceState)
-				}
-			case "tree=detailed":
-				printHeader(ctx, acdClient, app, windows, showOperation, showParams, sourcePosition)
-				mapUIDToNode, mapParentToChild, parentNode, mapNodeNameToResourceState := resourceParentChild(ctx, acdClient, appName, appNs)
-				if len(mapUIDToNode) > 0 {
-					fmt.Println()
-					printTreeViewDetailed(mapUIDToNode, mapParentToChild, parentNode, mapNodeNameToResourceState)
+			// the timeout only applies to the initial retrieval of the application
+			if timer != nil {
+				timer.Stop()
+			}
+			ctx = c.Context()
+			lastVersion := app.ResourceVersion
+			for appEvent := range acdClient.WatchApplicationWithRetry(ctx, app.QualifiedName(), app.ResourceVersion) {
+				if appEvent.Type == k8swatch.Deleted {
+					fmt.Printf("Application '%s' deleted\n", app.QualifiedName())
+					return
 				}
-			default:
-				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+				// the watch of a single application always starts with its current state
+				if appEvent.Application.ResourceVersion == lastVersion {
+					continue
+				}
+				lastVersion = appEvent.Application.ResourceVersion
+				printWatchSeparator(output)
+				printApp(&appEvent.Application)
 			}
 		},
 	}
@@ -514,6 +653,7 @@ func NewApplicationGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Only get application from namespace")
 	command.Flags().IntVar(&sourcePosition, "source-position", -1, "Position of the source from the list of sources of the app. Counting starts at 1.")
 	command.Flags().StringVar(&sourceName, "source-name", "", "Name of the source from the list of sources of the app.")
+	command.Flags().BoolVarP(&watch, "watch", "w", false, "Watch the application and print it again on every change")
 	return command
 }
 
@@ -532,10 +672,14 @@ func NewApplicationLogsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 		container    string
 		previous     bool
 		matchCase    bool
+		selector     string
+		since        time.Duration
+		prefix       bool
 	)
 	command := &cobra.Command{
-		Use:   "logs APPNAME",
-		Short: "Get logs of application pods",
+		Use:               "logs APPNAME",
+		ValidArgsFunction: completeAppNames(clientOpts, 1),
+		Short:             "Get logs of application pods",
 		Example: templates.Examples(`  
   # Get logs of pods associated with the application "my-app"
   argocd app logs my-app
@@ -575,6 +719,12 @@ func NewApplicationLogsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 
   # Get previously terminated container logs
   argocd app logs my-app -p
+
+  # Stream the logs of all pods of the application "my-app" with the label app=foo, prefixed with the pod names
+  argocd app logs my-app -l app=foo --prefix -f
+
+  # Get the logs of the last 10 minutes
+  argocd app logs my-app --since 10m
   		`),
 
 		Run: func(c *cobra.Command, args []string) {
@@ -584,11 +734,22 @@ func NewApplicationLogsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				c.HelpFunc()(c, args)
 				os.Exit(1)
 			}
+			if since != 0 {
+				if sinceSeconds != 0 {
+					errors.Fatal(errors.ErrorGeneric, "--since cannot be combined with --since-seconds")
+				}
+				sinceSeconds = int64(math.Ceil(since.Seconds()))
+			}
 			acdClient := headless.NewClientOrDie(clientOpts, c)
 			conn, appIf := acdClient.NewApplicationClientOrDie()
 			defer utilio.Close(conn)
 			appName, appNs := argo.ParseFromQualifiedName(args[0], "")
 
+			colored := prefix && (isatty.IsTerminal(os.Stdout.Fd()) || isatty.IsCygwinTerminal(os.Stdout.Fd()))
+			// lastSeen holds the timestamp of the last printed entry of every pod, so that the entries which are
+			// received again after reconnecting are skipped
+			lastSeen := make(map[string]time.Time)
+			var sinceTime *metav1.Time
 			retry := true
 			for retry {
 				retry = false
@@ -601,12 +762,14 @@ func NewApplicationLogsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 					Follow:       ptr.To(follow),
 					TailLines:    ptr.To(tail),
 					SinceSeconds: ptr.To(sinceSeconds),
+					SinceTime:    sinceTime,
 					UntilTime:    &untilTime,
 					Filter:       &filter,
 					MatchCase:    ptr.To(matchCase),
 					Container:    ptr.To(container),
 					Previous:     ptr.To(previous),
 					AppNamespace: &appNs,
+					Selector:     ptr.To(selector),
 				})
 				if err != nil {
 					log.Fatalf("failed to get pod logs: %v", err)
@@ -615,7 +778,10 @@ func NewApplicationLogsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 					msg, err := stream.Recv()
 					if err != nil {
 						if stderrors.Is(err, io.EOF) {
-							return
+							// the stream ends once the logs of all pods ended, e.g. because the pods were
+							// restarted, so the logs of the new pods are followed after reconnecting
+							retry = follow && untilTime == ""
+							break
 						}
 						st, ok := status.FromError(err)
 						if !ok {
@@ -623,16 +789,34 @@ func NewApplicationLogsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 						}
 						if st.Code() == codes.Unavailable && follow {
 							retry = true
-							sinceSeconds = 1
 							break
 						}
 						log.Fatalf("stream read failed: %v", err)
 					}
 					if msg.GetLast() {
-						return
+						retry = follow && untilTime == ""
+						break
+					}
+					if ts, err := time.Parse(time.RFC3339Nano, msg.GetTimeStampStr()); err == nil {
+						if last, ok := lastSeen[msg.GetPodName()]; ok && !ts.After(last) {
+							continue
+						}
+						lastSeen[msg.GetPodName()] = ts
 					}
-					fmt.Println(msg.GetContent())
+					fmt.Println(formatLogEntry(msg, prefix, colored))
 				} // Done with receive message
+				if retry {
+					// reconnect with the logs since the connection was lost, without busy looping while there
+					// are no pods
+					sinceTime = ptr.To(metav1.NewTime(time.Now().Add(-time.Second)))
+					sinceSeconds = 0
+					tail = 0
+					select {
+					case <-ctx.Done():
+						return
+					case <-time.After(logsReconnectDelay):
+					}
+				}
 			} // Done with retry
 		},
 	}
@@ -649,10 +833,35 @@ func NewApplicationLogsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	command.Flags().StringVarP(&container, "container", "c", "", "Optional container name")
 	command.Flags().BoolVarP(&previous, "previous", "p", false, "Specify if the previously terminated container logs should be returned")
 	command.Flags().BoolVarP(&matchCase, "match-case", "m", false, "Specify if the filter should be case-sensitive")
+	command.Flags().StringVarP(&selector, "selector", "l", "", "Only show the logs of the pods matching this label selector, e.g. app=foo")
+	command.Flags().DurationVar(&since, "since", 0, "Only show logs newer than a relative duration like 5s, 2m or 3h")
+	command.Flags().BoolVar(&prefix, "prefix", false, "Prefix each log line with the name of its pod, colorized when writing to a terminal")
 
 	return command
 }
 
+// logsReconnectDelay is the delay before reconnecting to the logs stream while following logs
+var logsReconnectDelay = 2 * time.Second
+
+// logPrefixColors are the ANSI colors of the pod name prefixes of log lines
+var logPrefixColors = []int{31, 32, 33, 34, 35, 36, 91, 92, 93, 94, 95, 96}
+
+// formatLogEntry formats a log entry for printing, optionally prefixed with the name of its pod. The color of a
+// prefix is derived from the pod name, so that the lines of a pod keep their color across reconnections.
+func formatLogEntry(entry *application.LogEntry, prefix, colored bool) string {
+	if !prefix {
+		return entry.GetContent()
+	}
+	podName := entry.GetPodName()
+	if !colored {
+		return fmt.Sprintf("[%s] %s", podName, entry.GetContent())
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(podName))
+	color := logPrefixColors[h.Sum32()%uint32(len(logPrefixColors))]
+	return fmt.Sprintf("\x1b[%dm[%s]\x1b[0m %s", color, podName, entry.GetContent())
+}
+
 func printAppSummaryTable(app *argoappv1.Application, appURL string, windows *argoappv1.SyncWindows) {
 	fmt.Printf(printOpFmtStr, "Name:", app.QualifiedName())
 	fmt.Printf(printOpFmtStr, "Project:", app.Spec.GetProject())
@@ -778,7 +987,7 @@ func getAppURL(ctx context.Context, acdClient argocdclient.Client, appName strin
 	conn, settingsIf := acdClient.NewSettingsClientOrDie()
 	defer utilio.Close(conn)
 	argoSettings, err := settingsIf.Get(ctx, &settings.SettingsQuery{})
-	errors.CheckError(err)
+	errors.CheckErrorWithContext(ctx, err)
 
 	if argoSettings.URL != "" {
 		return fmt.Sprintf("%s/applications/%s", argoSettings.URL, appName)
@@ -845,11 +1054,12 @@ func NewApplicationSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 		appOpts        cmdutil.AppOptions
 		appNamespace   string
 		sourcePosition int
-		sourceName     string
+		sourceIndex    int
 	)
 	command := &cobra.Command{
-		Use:   "set APPNAME",
-		Short: "Set application parameters",
+		Use:               "set APPNAME",
+		ValidArgsFunction: completeAppNames(clientOpts, 1),
+		Short:             "Set application parameters",
 		Example: templates.Examples(`  
   # Set application parameters for the application "my-app"
   argocd app set my-app --parameter key1=value1 --parameter key2=value2
@@ -863,6 +1073,12 @@ func NewApplicationSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
   # Set and override application parameters for a source named "test" under spec.sources of app my-app.
   argocd app set my-app --source-name test --repo https://github.com/argoproj/argocd-example-apps.git
 
+  # Set the target revision and helm values of the first source under spec.sources of app my-app. source-index starts at 0.
+  argocd app set my-app --source-index 0 --revision v1.2.0 --values values-prod.yaml
+
+  # Set helm parameters of a source named "chart" under spec.sources of app my-app.
+  argocd app set my-app --source-name chart --helm-set image.tag=v1.2.0
+
   # Set application parameters and specify the namespace
   argocd app set my-app --parameter key1=value1 --parameter key2=value2 --namespace my-namespace
   		`),
@@ -879,30 +1095,10 @@ func NewApplicationSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 			conn, appIf := argocdClient.NewApplicationClientOrDie()
 			defer utilio.Close(conn)
 			app, err := appIf.Get(ctx, &application.ApplicationQuery{Name: &appName, AppNamespace: &appNs})
-			errors.CheckError(err)
-
-			sourceName = appOpts.SourceName
-			if sourceName != "" && sourcePosition != -1 {
-				errors.Fatal(errors.ErrorGeneric, "Only one of source-position and source-name can be specified.")
-			}
-
-			if sourceName != "" {
-				sourceNameToPosition := getSourceNameToPositionMap(app)
-				pos, ok := sourceNameToPosition[sourceName]
-				if !ok {
-					log.Fatalf("Unknown source name '%s'", sourceName)
-				}
-				sourcePosition = int(pos)
-			}
+			errors.CheckErrorWithContext(ctx, err)
 
-			if app.Spec.HasMultipleSources() {
-				if sourcePosition <= 0 {
-					errors.Fatal(errors.ErrorGeneric, "Source position should be specified and must be greater than 0 for applications with multiple sources")
-				}
-				if len(app.Spec.GetSources()) < sourcePosition {
-					errors.Fatal(errors.ErrorGeneric, "Source position should be less than the number of sources in the application")
-				}
-			}
+			sourcePosition, err = resolveSourcePosition(app, sourcePosition, sourceIndex, appOpts.SourceName)
+			errors.CheckError(err)
 
 			visited := cmdutil.SetAppSpecOptions(c.Flags(), &app.Spec, &appOpts, sourcePosition)
 			if visited == 0 {
@@ -918,12 +1114,13 @@ func NewApplicationSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 				Validate:     &appOpts.Validate,
 				AppNamespace: &appNs,
 			})
-			errors.CheckError(err)
+			errors.CheckErrorWithContext(ctx, err)
 		},
 	}
 	cmdutil.AddAppFlags(command, &appOpts)
 	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Set application parameters in namespace")
 	command.Flags().IntVar(&sourcePosition, "source-position", -1, "Position of the source from the list of sources of the app. Counting starts at 1.")
+	command.Flags().IntVar(&sourceIndex, "source-index", -1, "Index of the source from the list of sources of the app. Counting starts at 0.")
 	return command
 }
 
@@ -960,13 +1157,14 @@ func (o *unsetOpts) KustomizeIsZero() bool {
 // NewApplicationUnsetCommand returns a new instance of an `argocd app unset` command
 func NewApplicationUnsetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var sourcePosition int
-	var sourceName string
+	var sourceIndex int
 	appOpts := cmdutil.AppOptions{}
 	opts := unsetOpts{}
 	var appNamespace string
 	command := &cobra.Command{
-		Use:   "unset APPNAME parameters",
-		Short: "Unset application parameters",
+		Use:               "unset APPNAME parameters",
+		ValidArgsFunction: completeAppNames(clientOpts, 1),
+		Short:             "Unset application parameters",
 		Example: `  # Unset kustomize override kustomize image
   argocd app unset my-app --kustomize-image=alpine
 
@@ -980,7 +1178,13 @@ func NewApplicationUnsetCommand(clientOpts *argocdclient.ClientOptions) *cobra.C
   argocd app unset my-app --source-name test --namesuffix
 
   # Unset parameter override
-  argocd app unset my-app -p COMPONENT=PARAM`,
+  argocd app unset my-app -p COMPONENT=PARAM
+
+  # Unset a Helm values file and the inline values of the source named "chart" of a multi-source app
+  argocd app unset my-app --source-name chart --values values-prod.yaml --values-literal
+
+  # Unset a plugin env entry for the source at position 2
+  argocd app unset my-app --source-position 2 --plugin-env NAME`,
 
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
@@ -994,39 +1198,20 @@ func NewApplicationUnsetCommand(clientOpts *argocdclient.ClientOptions) *cobra.C
 			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
 			defer utilio.Close(conn)
 			app, err := appIf.Get(ctx, &application.ApplicationQuery{Name: &appName, AppNamespace: &appNs})
-			errors.CheckError(err)
-
-			sourceName = appOpts.SourceName
-			if sourceName != "" && sourcePosition != -1 {
-				errors.Fatal(errors.ErrorGeneric, "Only one of source-position and source-name can be specified.")
-			}
-
-			if sourceName != "" {
-				sourceNameToPosition := getSourceNameToPositionMap(app)
-				pos, ok := sourceNameToPosition[sourceName]
-				if !ok {
-					log.Fatalf("Unknown source name '%s'", sourceName)
-				}
-				sourcePosition = int(pos)
-			}
+			errors.CheckErrorWithContext(ctx, err)
 
-			if app.Spec.HasMultipleSources() {
-				if sourcePosition <= 0 {
-					errors.Fatal(errors.ErrorGeneric, "Source position should be specified and must be greater than 0 for applications with multiple sources")
-				}
-				if len(app.Spec.GetSources()) < sourcePosition {
-					errors.Fatal(errors.ErrorGeneric, "Source position should be less than the number of sources in the application")
-				}
-			}
+			sourcePosition, err = resolveSourcePosition(app, sourcePosition, sourceIndex, appOpts.SourceName)
+			errors.CheckError(err)
 
 			source := app.Spec.GetSourcePtrByPosition(sourcePosition)
 
-			updated, nothingToUnset := unset(source, opts)
+			removed, nothingToUnset := unset(source, opts)
 			if nothingToUnset {
 				c.HelpFunc()(c, args)
 				os.Exit(1)
 			}
-			if !updated {
+			if len(removed) == 0 {
+				fmt.Println("Nothing was removed: none of the given overrides are set on the source.")
 				return
 			}
 
@@ -1041,7 +1226,10 @@ func NewApplicationUnsetCommand(clientOpts *argocdclient.ClientOptions) *cobra.C
 					Validate:     &appOpts.Validate,
 					AppNamespace: &appNs,
 				})
-				errors.CheckError(err)
+				errors.CheckErrorWithContext(ctx, err)
+				for _, field := range removed {
+					fmt.Printf("Removed %s\n", field)
+				}
 			} else {
 				fmt.Println("The command to unset the parameters has been cancelled.")
 			}
@@ -1063,45 +1251,50 @@ func NewApplicationUnsetCommand(clientOpts *argocdclient.ClientOptions) *cobra.C
 	command.Flags().BoolVar(&opts.passCredentials, "pass-credentials", false, "Unset passCredentials")
 	command.Flags().BoolVar(&opts.ref, "ref", false, "Unset ref on the source")
 	command.Flags().IntVar(&sourcePosition, "source-position", -1, "Position of the source from the list of sources of the app. Counting starts at 1.")
+	command.Flags().IntVar(&sourceIndex, "source-index", -1, "Index of the source from the list of sources of the app. Counting starts at 0.")
+	command.Flags().StringVar(&appOpts.SourceName, "source-name", "", "Name of the source from the list of sources of the app.")
 	return command
 }
 
-func unset(source *argoappv1.ApplicationSource, opts unsetOpts) (updated bool, nothingToUnset bool) {
+// unset removes the overrides described by opts from the given source. It returns the list of fields which were
+// actually removed, and whether opts did not describe anything to unset for the type of the source.
+func unset(source *argoappv1.ApplicationSource, opts unsetOpts) (removed []string, nothingToUnset bool) {
 	needToUnsetRef := false
 	if opts.ref && source.IsRef() {
 		source.Ref = ""
-		updated = true
+		removed = append(removed, "ref")
 		needToUnsetRef = true
 	}
 
 	if source.Kustomize != nil {
 		if opts.KustomizeIsZero() {
-			return updated, !needToUnsetRef
+			return removed, !needToUnsetRef
 		}
+		removedBefore := len(removed)
 
 		if opts.namePrefix && source.Kustomize.NamePrefix != "" {
-			updated = true
+			removed = append(removed, "kustomize.namePrefix")
 			source.Kustomize.NamePrefix = ""
 		}
 
 		if opts.nameSuffix && source.Kustomize.NameSuffix != "" {
-			updated = true
+			removed = append(removed, "kustomize.nameSuffix")
 			source.Kustomize.NameSuffix = ""
 		}
 
 		if opts.kustomizeVersion && source.Kustomize.Version != "" {
-			updated = true
+			removed = append(removed, "kustomize.version")
 			source.Kustomize.Version = ""
 		}
 
 		if opts.kustomizeNamespace && source.Kustomize.Namespace != "" {
-			updated = true
+			removed = append(removed, "kustomize.namespace")
 			source.Kustomize.Namespace = ""
 		}
 
 		if opts.ignoreMissingComponents && source.Kustomize.IgnoreMissingComponents {
 			source.Kustomize.IgnoreMissingComponents = false
-			updated = true
+			removed = append(removed, "kustomize.ignoreMissingComponents")
 		}
 
 		for _, kustomizeImage := range opts.kustomizeImages {
@@ -1109,7 +1302,7 @@ func unset(source *argoappv1.ApplicationSource, opts unsetOpts) (updated bool, n
 				if !argoappv1.KustomizeImage(kustomizeImage).Match(item) {
 					continue
 				}
-				updated = true
+				removed = append(removed, fmt.Sprintf("kustomize.images[%s]", item))
 				// remove i
 				a := source.Kustomize.Images
 				copy(a[i:], a[i+1:]) // Shift a[i+1:] left one index.
@@ -1124,22 +1317,28 @@ func unset(source *argoappv1.ApplicationSource, opts unsetOpts) (updated bool, n
 			for i, item := range kustomizeReplicas {
 				if kustomizeReplica == item.Name {
 					source.Kustomize.Replicas = append(kustomizeReplicas[0:i], kustomizeReplicas[i+1:]...)
-					updated = true
+					removed = append(removed, fmt.Sprintf("kustomize.replicas[%s]", item.Name))
 					break
 				}
 			}
 		}
+
+		// do not leave an empty `kustomize: {}` stub behind once the last override has been removed
+		if len(removed) > removedBefore && source.Kustomize.IsZero() {
+			source.Kustomize = nil
+		}
 	}
 	if source.Helm != nil {
 		if len(opts.parameters) == 0 && len(opts.valuesFiles) == 0 && !opts.valuesLiteral && !opts.ignoreMissingValueFiles && !opts.passCredentials {
-			return updated, !needToUnsetRef
+			return removed, !needToUnsetRef
 		}
+		removedBefore := len(removed)
 		for _, paramStr := range opts.parameters {
 			helmParams := source.Helm.Parameters
 			for i, p := range helmParams {
 				if p.Name == paramStr {
 					source.Helm.Parameters = append(helmParams[0:i], helmParams[i+1:]...)
-					updated = true
+					removed = append(removed, fmt.Sprintf("helm.parameters[%s]", p.Name))
 					break
 				}
 			}
@@ -1147,7 +1346,7 @@ func unset(source *argoappv1.ApplicationSource, opts unsetOpts) (updated bool, n
 		if opts.valuesLiteral && !source.Helm.ValuesIsEmpty() {
 			err := source.Helm.SetValuesString("")
 			if err == nil {
-				updated = true
+				removed = append(removed, "helm.values")
 			}
 		}
 		for _, valuesFile := range opts.valuesFiles {
@@ -1155,33 +1354,39 @@ func unset(source *argoappv1.ApplicationSource, opts unsetOpts) (updated bool, n
 			for i, vf := range specValueFiles {
 				if vf == valuesFile {
 					source.Helm.ValueFiles = append(specValueFiles[0:i], specValueFiles[i+1:]...)
-					updated = true
+					removed = append(removed, fmt.Sprintf("helm.valueFiles[%s]", vf))
 					break
 				}
 			}
 		}
 		if opts.ignoreMissingValueFiles && source.Helm.IgnoreMissingValueFiles {
 			source.Helm.IgnoreMissingValueFiles = false
-			updated = true
+			removed = append(removed, "helm.ignoreMissingValueFiles")
 		}
 		if opts.passCredentials && source.Helm.PassCredentials {
 			source.Helm.PassCredentials = false
-			updated = true
+			removed = append(removed, "helm.passCredentials")
+		}
+
+		// do not leave an empty `helm: {}` stub behind once the last override has been removed
+		if len(removed) > removedBefore && source.Helm.IsZero() {
+			source.Helm = nil
 		}
 	}
 
 	if source.Plugin != nil {
 		if len(opts.pluginEnvs) == 0 {
-			return false, !needToUnsetRef
+			return removed, !needToUnsetRef
 		}
+		// an empty plugin section is kept as it is required for plugin discovery
 		for _, env := range opts.pluginEnvs {
 			err := source.Plugin.RemoveEnvEntry(env)
 			if err == nil {
-				updated = true
+				removed = append(removed, fmt.Sprintf("plugin.env[%s]", env))
 			}
 		}
 	}
-	return updated, false
+	return removed, false
 }
 
 // targetObjects deserializes the list of target states into unstructured objects
@@ -1205,7 +1410,7 @@ func getLocalObjects(ctx context.Context, app *argoappv1.Application, proj *argo
 	for i := range manifestStrings {
 		obj := unstructured.Unstructured{}
 		err := json.Unmarshal([]byte(manifestStrings[i]), &obj)
-		errors.CheckError(err)
+		errors.CheckErrorWithContext(ctx, err)
 		objs[i] = &obj
 	}
 	return objs
@@ -1229,11 +1434,266 @@ func getLocalObjectsString(ctx context.Context, app *argoappv1.Application, proj
 		ProjectSourceRepos:              proj.Spec.SourceRepos,
 		AnnotationManifestGeneratePaths: app.GetAnnotation(argoappv1.AnnotationKeyManifestGeneratePaths),
 	}, true, &git.NoopCredsStore{}, resource.MustParse("0"), nil)
-	errors.CheckError(err)
+	errors.CheckErrorWithContext(ctx, err)
 
 	return res.Manifests
 }
 
+// localPluginName is the name of the config management plugin which is served for --plugin-binary if the application
+// does not reference a plugin by name
+const localPluginName = "local"
+
+// startLocalPlugin serves a config management plugin which runs the given binary as its generate command on a
+// socket in a temporary directory, and points local manifest generation at it. The plugin is served by the same code
+// as the argocd-cmp-server sidecar, so the binary receives the same files and environment it would on the repo server.
+// The returned function stops the plugin and removes the temporary directory.
+func startLocalPlugin(app *argoappv1.Application, binary string) (func(), error) {
+	binaryPath, err := exec.LookPath(binary)
+	if err != nil {
+		return nil, fmt.Errorf("error finding plugin binary %s: %w", binary, err)
+	}
+	// the generate command runs in the app directory, so a relative path would not be found
+	binaryPath, err = filepath.Abs(binaryPath)
+	if err != nil {
+		return nil, fmt.Errorf("error getting absolute path of plugin binary %s: %w", binary, err)
+	}
+
+	source := app.Spec.GetSourcePtrByIndex(0)
+	if source.Plugin == nil {
+		source.Plugin = &argoappv1.ApplicationSourcePlugin{}
+	}
+	if source.Plugin.Name == "" {
+		source.Plugin.Name = localPluginName
+	}
+
+	dir, err := os.MkdirTemp("", "argocd-cmp")
+	if err != nil {
+		return nil, fmt.Errorf("error creating plugin socket directory: %w", err)
+	}
+	if err := os.Setenv(argocommon.EnvPluginSockFilePath, dir); err != nil {
+		_ = os.RemoveAll(dir)
+		return nil, err
+	}
+	if err := os.Setenv(argocommon.EnvCMPWorkDir, dir); err != nil {
+		_ = os.RemoveAll(dir)
+		return nil, err
+	}
+
+	server, err := cmpserver.NewServer(plugin.CMPServerInitConstants{
+		PluginConfig: plugin.PluginConfig{
+			TypeMeta: metav1.TypeMeta{Kind: plugin.ConfigManagementPluginKind},
+			Metadata: metav1.ObjectMeta{Name: source.Plugin.Name},
+			Spec:     plugin.PluginConfigSpec{Generate: plugin.Command{Command: []string{binaryPath}}},
+		},
+	})
+	if err != nil {
+		_ = os.RemoveAll(dir)
+		return nil, fmt.Errorf("error creating plugin server: %w", err)
+	}
+	grpcServer, err := server.CreateGRPC()
+	if err != nil {
+		_ = os.RemoveAll(dir)
+		return nil, fmt.Errorf("error creating plugin server: %w", err)
+	}
+	listener, err := net.Listen("unix", filepath.Join(dir, source.Plugin.Name+".sock"))
+	if err != nil {
+		_ = os.RemoveAll(dir)
+		return nil, fmt.Errorf("error listening on plugin socket: %w", err)
+	}
+	go func() {
+		if err := grpcServer.Serve(listener); err != nil {
+			log.Warnf("Local plugin server stopped: %v", err)
+		}
+	}()
+	return func() {
+		grpcServer.Stop()
+		_ = os.RemoveAll(dir)
+	}, nil
+}
+
+// localHelmValues describes the Helm values overrides which are applied when rendering local manifests
+type localHelmValues struct {
+	valuesFiles []string
+	setValues   []string
+}
+
+// IsZero returns true when no local Helm values overrides have been given
+func (v localHelmValues) IsZero() bool {
+	return len(v.valuesFiles) == 0 && len(v.setValues) == 0
+}
+
+// validateLocalHelmValues returns an error if local Helm values overrides cannot be applied to the given source
+func validateLocalHelmValues(app *argoappv1.Application, source *argoappv1.ApplicationSource) error {
+	sourceType := app.Status.SourceType
+	if st, _ := source.ExplicitType(); st != nil {
+		sourceType = *st
+	}
+	switch sourceType {
+	case argoappv1.ApplicationSourceTypeHelm, argoappv1.ApplicationSourceTypePlugin:
+		return nil
+	case "":
+		return stderrors.New("local Helm values can only be used once the source type of the application is known")
+	default:
+		return fmt.Errorf("local Helm values are not supported for %s sources", sourceType)
+	}
+}
+
+// load reads and merges the local values files and the --local-set values. Values given later take precedence.
+func (v localHelmValues) load() (map[string]any, error) {
+	values := make(map[string]any)
+	for _, valuesFile := range v.valuesFiles {
+		data, err := os.ReadFile(valuesFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading values file %s: %w", valuesFile, err)
+		}
+		fileValues := make(map[string]any)
+		if err := yaml.Unmarshal(data, &fileValues); err != nil {
+			return nil, fmt.Errorf("error unmarshaling values file %s: %w", valuesFile, err)
+		}
+		mergeHelmValues(values, fileValues)
+	}
+	for _, setValue := range v.setValues {
+		if err := setHelmValue(values, setValue); err != nil {
+			return nil, err
+		}
+	}
+	return values, nil
+}
+
+// applyTo merges the local values into the inline values of the Helm source and turns the --local-set values into
+// Helm parameters, so they take precedence over the values and parameters which are already set on the application.
+func (v localHelmValues) applyTo(source *argoappv1.ApplicationSource) error {
+	fileValues, err := localHelmValues{valuesFiles: v.valuesFiles}.load()
+	if err != nil {
+		return err
+	}
+	if source.Helm == nil {
+		source.Helm = &argoappv1.ApplicationSourceHelm{}
+	}
+	if len(fileValues) > 0 {
+		values := make(map[string]any)
+		if err := yaml.Unmarshal(source.Helm.ValuesYAML(), &values); err != nil {
+			return fmt.Errorf("error unmarshaling application values: %w", err)
+		}
+		mergeHelmValues(values, fileValues)
+		data, err := yaml.Marshal(values)
+		if err != nil {
+			return fmt.Errorf("error marshaling merged values: %w", err)
+		}
+		if err := source.Helm.SetValuesString(string(data)); err != nil {
+			return fmt.Errorf("error setting merged values: %w", err)
+		}
+	}
+	for _, setValue := range v.setValues {
+		param, err := argoappv1.NewHelmParameter(setValue, false)
+		if err != nil {
+			return err
+		}
+		source.Helm.AddParameter(*param)
+	}
+	return nil
+}
+
+// overrideQuery sets the Helm values and parameters of the server-side manifest query to the ones of the source with
+// the local values applied, so the local values take the same precedence as with applyTo
+func (v localHelmValues) overrideQuery(source *argoappv1.ApplicationSource, query *application.ApplicationManifestQueryWithFiles) error {
+	source = source.DeepCopy()
+	if err := v.applyTo(source); err != nil {
+		return err
+	}
+	values := source.Helm.ValuesString()
+	query.HelmValues = &values
+	query.HelmParameters = make([]*argoappv1.HelmParameter, 0, len(source.Helm.Parameters))
+	for i := range source.Helm.Parameters {
+		query.HelmParameters = append(query.HelmParameters, &source.Helm.Parameters[i])
+	}
+	return nil
+}
+
+// stage copies the local directory into a temporary directory and merges the local values into the values file
+// of the copy which takes precedence among the value files of the source. It returns the path of the copy, which
+// must be removed by the caller. It is used for plugin sources, which have no parameters to pass the values as.
+func (v localHelmValues) stage(local string, source *argoappv1.ApplicationSource) (string, error) {
+	values, err := v.load()
+	if err != nil {
+		return "", err
+	}
+	stagingDir, err := os.MkdirTemp("", "argocd-local-values")
+	if err != nil {
+		return "", fmt.Errorf("error creating staging directory: %w", err)
+	}
+	appDir := filepath.Join(stagingDir, filepath.Base(filepath.Clean(local)))
+	if err := os.CopyFS(appDir, os.DirFS(local)); err != nil {
+		_ = os.RemoveAll(stagingDir)
+		return "", fmt.Errorf("error copying %s: %w", local, err)
+	}
+
+	valuesFile := filepath.Join(appDir, "values.yaml")
+	if source.Helm != nil {
+		for i := len(source.Helm.ValueFiles) - 1; i >= 0; i-- {
+			candidate := filepath.Join(appDir, source.Helm.ValueFiles[i])
+			if _, err := os.Stat(candidate); err == nil && files.Inbound(candidate, appDir) {
+				valuesFile = candidate
+				break
+			}
+		}
+	}
+	existing := make(map[string]any)
+	if data, err := os.ReadFile(valuesFile); err == nil {
+		if err := yaml.Unmarshal(data, &existing); err != nil {
+			_ = os.RemoveAll(stagingDir)
+			return "", fmt.Errorf("error unmarshaling values file %s: %w", valuesFile, err)
+		}
+	}
+	mergeHelmValues(existing, values)
+	data, err := yaml.Marshal(existing)
+	if err == nil {
+		err = os.WriteFile(valuesFile, data, 0o644)
+	}
+	if err != nil {
+		_ = os.RemoveAll(stagingDir)
+		return "", fmt.Errorf("error writing values file %s: %w", valuesFile, err)
+	}
+	return appDir, nil
+}
+
+// mergeHelmValues deep merges src into dst. Values of src take precedence, except for maps which are merged.
+func mergeHelmValues(dst, src map[string]any) {
+	for key, srcValue := range src {
+		srcMap, srcIsMap := srcValue.(map[string]any)
+		dstMap, dstIsMap := dst[key].(map[string]any)
+		if srcIsMap && dstIsMap {
+			mergeHelmValues(dstMap, srcMap)
+			continue
+		}
+		dst[key] = srcValue
+	}
+}
+
+// setHelmValue sets the value of a key=value pair in the given values, where the key is a dot separated path
+func setHelmValue(values map[string]any, keyValue string) error {
+	key, value, ok := strings.Cut(keyValue, "=")
+	if !ok || key == "" {
+		return fmt.Errorf("expected value in the form key=value, got %q", keyValue)
+	}
+	var typedValue any
+	if err := yaml.Unmarshal([]byte(value), &typedValue); err != nil {
+		typedValue = value
+	}
+	path := strings.Split(key, ".")
+	current := values
+	for _, part := range path[:len(path)-1] {
+		next, ok := current[part].(map[string]any)
+		if !ok {
+			next = make(map[string]any)
+			current[part] = next
+		}
+		current = next
+	}
+	current[path[len(path)-1]] = typedValue
+	return nil
+}
+
 type resourceInfoProvider struct {
 	namespacedByGk map[schema.GroupKind]bool
 }
@@ -1286,13 +1746,35 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 		revisions            []string
 		sourcePositions      []int64
 		sourceNames          []string
+		localValues          localHelmValues
 		ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts
+		ignoreNormalizer     string
+		ignoredOnly          bool
+		output               string
 	)
 	shortDesc := "Perform a diff against the target and live state."
 	command := &cobra.Command{
-		Use:   "diff APPNAME",
-		Short: shortDesc,
-		Long:  shortDesc + "\nUses 'diff' to render the difference. KUBECTL_EXTERNAL_DIFF environment variable can be used to select your own diff tool.\nReturns the following exit codes: 2 on general errors, 1 when a diff is found, and 0 when no diff is found\nKubernetes Secrets are ignored from this diff.",
+		Use:               "diff APPNAME",
+		ValidArgsFunction: completeAppNames(clientOpts, 1),
+		Short:             shortDesc,
+		Long:              shortDesc + "\nUses 'diff' to render the difference. KUBECTL_EXTERNAL_DIFF environment variable can be used to select your own diff tool.\nReturns the following exit codes: 2 on general errors, 1 when a diff is found, and 0 when no diff is found\nKubernetes Secrets are ignored from this diff.",
+		Example: `  # Compare the live state of an application to its target state
+  argocd app diff my-app
+
+  # Compare the live state to manifests rendered from a local directory
+  argocd app diff my-app --local ./guestbook --server-side-generate
+
+  # Preview a change of Helm values before committing it
+  argocd app diff my-app --local ./chart --server-side-generate --local-values values-prod.yaml --local-set image.tag=v2
+
+  # Compare without applying the ignoreDifferences rules of the application and the system
+  argocd app diff my-app --ignore-normalizer-config=off
+
+  # Show only the differences which are suppressed by ignoreDifferences rules
+  argocd app diff my-app --ignored-only
+
+  # Print the differences as JSON patches per resource for consumption by CI tools
+  argocd app diff my-app -o jsonpatch`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
@@ -1313,6 +1795,16 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				errors.Fatal(errors.ErrorGeneric, "While using --revisions and --source-names, length of values for both flags should be same.")
 			}
 
+			if ignoreNormalizer != "on" && ignoreNormalizer != "off" {
+				errors.Fatalf(errors.ErrorGeneric, "--ignore-normalizer-config must be one of: on, off; got %q", ignoreNormalizer)
+			}
+			if ignoredOnly && ignoreNormalizer == "off" {
+				errors.Fatal(errors.ErrorGeneric, "--ignored-only cannot be used with --ignore-normalizer-config=off")
+			}
+			if output != "" && output != "json" && output != "jsonpatch" {
+				errors.Fatalf(errors.ErrorGeneric, "--output must be one of: json, jsonpatch; got %q", output)
+			}
+
 			clientset := headless.NewClientOrDie(clientOpts, c)
 			conn, appIf := clientset.NewApplicationClientOrDie()
 			defer utilio.Close(conn)
@@ -1322,7 +1814,7 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				Refresh:      getRefreshType(refresh, hardRefresh),
 				AppNamespace: &appNs,
 			})
-			errors.CheckError(err)
+			errors.CheckErrorWithContext(ctx, err)
 
 			if len(sourceNames) > 0 {
 				sourceNameToPosition := getSourceNameToPositionMap(app)
@@ -1336,13 +1828,24 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				}
 			}
 
+			if !localValues.IsZero() {
+				if local == "" {
+					errors.Fatal(errors.ErrorGeneric, "--local-values and --local-set can only be used together with --local")
+				}
+				errors.CheckError(validateLocalHelmValues(app, app.Spec.GetSourcePtrByPosition(0)))
+			}
+
 			resources, err := appIf.ManagedResources(ctx, &application.ResourcesQuery{ApplicationName: &appName, AppNamespace: &appNs})
-			errors.CheckError(err)
+			errors.CheckErrorWithContext(ctx, err)
 			conn, settingsIf := clientset.NewSettingsClientOrDie()
 			defer utilio.Close(conn)
 			argoSettings, err := settingsIf.Get(ctx, &settings.SettingsQuery{})
-			errors.CheckError(err)
-			diffOption := &DifferenceOption{}
+			errors.CheckErrorWithContext(ctx, err)
+			diffOption := &DifferenceOption{
+				skipIgnoreRules: ignoreNormalizer == "off",
+				ignoredOnly:     ignoredOnly,
+				output:          output,
+			}
 			switch {
 			case app.Spec.HasMultipleSources() && len(revisions) > 0 && len(sourcePositions) > 0:
 				numOfSources := int64(len(app.Spec.GetSources()))
@@ -1359,7 +1862,7 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 					SourcePositions: sourcePositions,
 				}
 				res, err := appIf.GetManifests(ctx, &q)
-				errors.CheckError(err)
+				errors.CheckErrorWithContext(ctx, err)
 
 				diffOption.res = res
 				diffOption.revisions = revisions
@@ -1370,19 +1873,31 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 					AppNamespace: &appNs,
 				}
 				res, err := appIf.GetManifests(ctx, &q)
-				errors.CheckError(err)
+				errors.CheckErrorWithContext(ctx, err)
 				diffOption.res = res
 				diffOption.revision = revision
 			case local != "":
 				if serverSideGenerate {
+					localDir := local
+					query := &application.ApplicationManifestQueryWithFiles{Name: &appName, AppNamespace: &appNs}
+					if !localValues.IsZero() {
+						source := app.Spec.GetSourcePtrByPosition(0)
+						if source.Plugin != nil {
+							localDir, err = localValues.stage(local, source)
+							errors.CheckErrorWithContext(ctx, err)
+							defer os.RemoveAll(filepath.Dir(localDir))
+						} else {
+							errors.CheckErrorWithContext(ctx, localValues.overrideQuery(source, query))
+						}
+					}
 					client, err := appIf.GetManifestsWithFiles(ctx, grpc_retry.Disable())
-					errors.CheckError(err)
+					errors.CheckErrorWithContext(ctx, err)
 
-					err = manifeststream.SendApplicationManifestQueryWithFiles(ctx, client, appName, appNs, local, localIncludes)
-					errors.CheckError(err)
+					err = manifeststream.SendApplicationManifestQuery(ctx, client, query, localDir, localIncludes)
+					errors.CheckErrorWithContext(ctx, err)
 
 					res, err := client.CloseAndRecv()
-					errors.CheckError(err)
+					errors.CheckErrorWithContext(ctx, err)
 
 					diffOption.serversideRes = res
 				} else {
@@ -1390,7 +1905,15 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 					conn, clusterIf := clientset.NewClusterClientOrDie()
 					defer utilio.Close(conn)
 					cluster, err := clusterIf.Get(ctx, &clusterpkg.ClusterQuery{Name: app.Spec.Destination.Name, Server: app.Spec.Destination.Server})
-					errors.CheckError(err)
+					errors.CheckErrorWithContext(ctx, err)
+
+					if !localValues.IsZero() {
+						source := app.Spec.GetSourcePtrByPosition(0)
+						if source.Plugin != nil {
+							errors.Fatal(errors.ErrorGeneric, "local Helm values for plugin sources require --server-side-generate")
+						}
+						errors.CheckError(localValues.applyTo(source))
+					}
 
 					diffOption.local = local
 					diffOption.localRepoRoot = localRepoRoot
@@ -1413,11 +1936,16 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	command.Flags().StringVar(&localRepoRoot, "local-repo-root", "/", "Path to the repository root. Used together with --local allows setting the repository root")
 	command.Flags().BoolVar(&serverSideGenerate, "server-side-generate", false, "Used with --local, this will send your manifests to the server for diffing")
 	command.Flags().StringArrayVar(&localIncludes, "local-include", []string{"*.yaml", "*.yml", "*.json"}, "Used with --server-side-generate, specify patterns of filenames to send. Matching is based on filename and not path.")
+	command.Flags().StringArrayVar(&localValues.valuesFiles, "local-values", []string{}, "Used with --local, merge the given Helm values file into the values of the application. This option may be specified repeatedly")
+	command.Flags().StringArrayVar(&localValues.setValues, "local-set", []string{}, "Used with --local, set a Helm value on top of the values of the application (e.g. --local-set image.tag=v2). This option may be specified repeatedly")
 	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Only render the difference in namespace")
 	command.Flags().StringArrayVar(&revisions, "revisions", []string{}, "Show manifests at specific revisions for source position in source-positions")
 	command.Flags().Int64SliceVar(&sourcePositions, "source-positions", []int64{}, "List of source positions. Default is empty array. Counting start at 1.")
 	command.Flags().StringArrayVar(&sourceNames, "source-names", []string{}, "List of source names. Default is an empty array.")
 	command.Flags().DurationVar(&ignoreNormalizerOpts.JQExecutionTimeout, "ignore-normalizer-jq-execution-timeout", normalizers.DefaultJQExecutionTimeout, "Set ignore normalizer JQ execution timeout")
+	command.Flags().StringVar(&ignoreNormalizer, "ignore-normalizer-config", "on", "Whether the system-level and application-level ignoreDifferences rules are applied before diffing. One of: on|off. The exit code follows the chosen view")
+	command.Flags().BoolVar(&ignoredOnly, "ignored-only", false, "Only show the differences which are suppressed by ignoreDifferences rules. The exit code still reflects the differences which are not ignored")
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: json|jsonpatch. By default, a unified diff is printed")
 	return command
 }
 
@@ -1430,83 +1958,137 @@ type DifferenceOption struct {
 	res           *repoapiclient.ManifestResponse
 	serversideRes *repoapiclient.ManifestResponse
 	revisions     []string
+	// skipIgnoreRules disables the system-level and application-level ignoreDifferences rules
+	skipIgnoreRules bool
+	// ignoredOnly prints only the differences suppressed by the ignoreDifferences rules
+	ignoredOnly bool
+	// output is the machine-readable output format (json or jsonpatch), or empty to print unified diffs
+	output string
 }
 
-// findandPrintDiff ... Prints difference between application current state and state stored in git or locally, returns boolean as true if difference is found else returns false
+// useRawLiveState returns whether the diff has to start from the live state as it is in the cluster rather than
+// from the live state normalized by the controller, which already has the ignoreDifferences rules applied
+func (o *DifferenceOption) useRawLiveState() bool {
+	return o.skipIgnoreRules || o.ignoredOnly
+}
+
+// findandPrintDiff ... Prints difference between application current state and state stored in git or locally, returns boolean as true if difference is found else returns false
 func findandPrintDiff(ctx context.Context, app *argoappv1.Application, proj *argoappv1.AppProject, resources *application.ManagedResourcesResponse, argoSettings *settings.Settings, diffOptions *DifferenceOption, ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts) bool {
 	var foundDiffs bool
 	liveObjs, err := cmdutil.LiveObjects(resources.Items)
-	errors.CheckError(err)
+	errors.CheckErrorWithContext(ctx, err)
 	items := make([]objKeyLiveTarget, 0)
 	switch {
 	case diffOptions.local != "":
 		localObjs := groupObjsByKey(getLocalObjects(ctx, app, proj, diffOptions.local, diffOptions.localRepoRoot, argoSettings.AppLabelKey, diffOptions.cluster.Info.ServerVersion, diffOptions.cluster.Info.APIVersions, argoSettings.KustomizeOptions, argoSettings.TrackingMethod), liveObjs, app.Spec.Destination.Namespace)
-		items = groupObjsForDiff(resources, localObjs, items, argoSettings, app.InstanceName(argoSettings.ControllerNamespace), app.Spec.Destination.Namespace)
+		items = groupObjsForDiff(resources, localObjs, items, argoSettings, app.InstanceName(argoSettings.ControllerNamespace), app.Spec.Destination.Namespace, diffOptions.useRawLiveState())
 	case diffOptions.revision != "" || len(diffOptions.revisions) > 0:
 		var unstructureds []*unstructured.Unstructured
 		for _, mfst := range diffOptions.res.Manifests {
 			obj, err := argoappv1.UnmarshalToUnstructured(mfst)
-			errors.CheckError(err)
+			errors.CheckErrorWithContext(ctx, err)
 			unstructureds = append(unstructureds, obj)
 		}
 		groupedObjs := groupObjsByKey(unstructureds, liveObjs, app.Spec.Destination.Namespace)
-		items = groupObjsForDiff(resources, groupedObjs, items, argoSettings, app.InstanceName(argoSettings.ControllerNamespace), app.Spec.Destination.Namespace)
+		items = groupObjsForDiff(resources, groupedObjs, items, argoSettings, app.InstanceName(argoSettings.ControllerNamespace), app.Spec.Destination.Namespace, diffOptions.useRawLiveState())
 	case diffOptions.serversideRes != nil:
 		var unstructureds []*unstructured.Unstructured
 		for _, mfst := range diffOptions.serversideRes.Manifests {
 			obj, err := argoappv1.UnmarshalToUnstructured(mfst)
-			errors.CheckError(err)
+			errors.CheckErrorWithContext(ctx, err)
 			unstructureds = append(unstructureds, obj)
 		}
 		groupedObjs := groupObjsByKey(unstructureds, liveObjs, app.Spec.Destination.Namespace)
-		items = groupObjsForDiff(resources, groupedObjs, items, argoSettings, app.InstanceName(argoSettings.ControllerNamespace), app.Spec.Destination.Namespace)
+		items = groupObjsForDiff(resources, groupedObjs, items, argoSettings, app.InstanceName(argoSettings.ControllerNamespace), app.Spec.Destination.Namespace, diffOptions.useRawLiveState())
 	default:
 		for i := range resources.Items {
 			res := resources.Items[i]
 			live := &unstructured.Unstructured{}
-			err := json.Unmarshal([]byte(res.NormalizedLiveState), &live)
-			errors.CheckError(err)
+			err := json.Unmarshal([]byte(liveStateOf(res, diffOptions.useRawLiveState())), &live)
+			errors.CheckErrorWithContext(ctx, err)
 
 			target := &unstructured.Unstructured{}
 			err = json.Unmarshal([]byte(res.TargetState), &target)
-			errors.CheckError(err)
+			errors.CheckErrorWithContext(ctx, err)
 
 			items = append(items, objKeyLiveTarget{kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name), live, target})
 		}
 	}
 
-	for _, item := range items {
-		if item.target != nil && hook.IsHook(item.target) || item.live != nil && hook.IsHook(item.live) {
-			continue
-		}
-		overrides := make(map[string]argoappv1.ResourceOverride)
-		for k := range argoSettings.ResourceOverrides {
-			val := argoSettings.ResourceOverrides[k]
-			overrides[k] = *val
-		}
-
+	overrides := make(map[string]argoappv1.ResourceOverride)
+	for k := range argoSettings.ResourceOverrides {
+		val := argoSettings.ResourceOverrides[k]
+		overrides[k] = *val
+	}
+	newDiffConfig := func(ignoreDifferences []argoappv1.ResourceIgnoreDifferences, overrides map[string]argoappv1.ResourceOverride) argodiff.DiffConfig {
 		// TODO remove hardcoded IgnoreAggregatedRoles and retrieve the
 		// compareOptions in the protobuf
 		ignoreAggregatedRoles := false
 		diffConfig, err := argodiff.NewDiffConfigBuilder().
-			WithDiffSettings(app.Spec.IgnoreDifferences, overrides, ignoreAggregatedRoles, ignoreNormalizerOpts).
+			WithDiffSettings(ignoreDifferences, overrides, ignoreAggregatedRoles, ignoreNormalizerOpts).
 			WithTracking(argoSettings.AppLabelKey, argoSettings.TrackingMethod).
 			WithNoCache().
 			WithLogger(logutils.NewLogrusLogger(logutils.NewWithCurrentConfig())).
 			Build()
-		errors.CheckError(err)
+		errors.CheckErrorWithContext(ctx, err)
+		return diffConfig
+	}
+	normalizedConfig := newDiffConfig(app.Spec.IgnoreDifferences, overrides)
+	rawConfig := newDiffConfig(nil, withoutIgnoreRules(overrides))
+	diffConfig := normalizedConfig
+	if diffOptions.skipIgnoreRules {
+		diffConfig = rawConfig
+	}
+
+	diffs := make([]*resourceDiff, 0)
+	printDiff := func(key kube.ResourceKey, ignored bool, live, target *unstructured.Unstructured) {
+		if diffOptions.output != "" {
+			d, err := newResourceDiff(key, ignored, live, target, diffOptions.output)
+			errors.CheckErrorWithContext(ctx, err)
+			diffs = append(diffs, d)
+			return
+		}
+		if ignored {
+			fmt.Printf("\n===== %s/%s %s/%s (ignored) ======\n", key.Group, key.Kind, key.Namespace, key.Name)
+		} else {
+			fmt.Printf("\n===== %s/%s %s/%s ======\n", key.Group, key.Kind, key.Namespace, key.Name)
+		}
+		_ = cli.PrintDiff(key.Name, live, target)
+	}
+
+	for _, item := range items {
+		if item.target != nil && hook.IsHook(item.target) || item.live != nil && hook.IsHook(item.live) {
+			continue
+		}
 		diffRes, err := argodiff.StateDiff(item.live, item.target, diffConfig)
-		errors.CheckError(err)
+		errors.CheckErrorWithContext(ctx, err)
+		modified := diffRes.Modified || item.target == nil || item.live == nil
 
-		if diffRes.Modified || item.target == nil || item.live == nil {
-			fmt.Printf("\n===== %s/%s %s/%s ======\n", item.key.Group, item.key.Kind, item.key.Namespace, item.key.Name)
+		if diffOptions.ignoredOnly {
+			if modified {
+				foundDiffs = true
+			}
+			if item.target == nil || item.live == nil {
+				continue
+			}
+			rawRes, err := argodiff.StateDiff(item.live, item.target, rawConfig)
+			errors.CheckErrorWithContext(ctx, err)
+			live, target, err := ignoredDifferences(diffRes, rawRes)
+			errors.CheckErrorWithContext(ctx, err)
+			if target != nil {
+				printDiff(item.key, true, live, target)
+			}
+			continue
+		}
+
+		if modified {
 			var live *unstructured.Unstructured
 			var target *unstructured.Unstructured
 			if item.target != nil && item.live != nil {
 				target = &unstructured.Unstructured{}
 				live = item.live
 				err = json.Unmarshal(diffRes.PredictedLive, target)
-				errors.CheckError(err)
+				errors.CheckErrorWithContext(ctx, err)
 			} else {
 				live = item.live
 				target = item.target
@@ -1514,17 +2096,183 @@ func findandPrintDiff(ctx context.Context, app *argoappv1.Application, proj *arg
 			if !foundDiffs {
 				foundDiffs = true
 			}
-			_ = cli.PrintDiff(item.key.Name, live, target)
+			printDiff(item.key, false, live, target)
 		}
 	}
+	if diffOptions.output != "" {
+		errors.CheckError(PrintResourceList(diffs, "json", false))
+	}
 	return foundDiffs
 }
 
-func groupObjsForDiff(resources *application.ManagedResourcesResponse, objs map[kube.ResourceKey]*unstructured.Unstructured, items []objKeyLiveTarget, argoSettings *settings.Settings, appName, namespace string) []objKeyLiveTarget {
+// resourceDiff is the difference of a single resource printed by `argocd app diff -o json|jsonpatch`
+type resourceDiff struct {
+	Group     string `json:"group"`
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	// Ignored is set for differences which are suppressed by ignoreDifferences rules (--ignored-only)
+	Ignored bool `json:"ignored,omitempty"`
+	// Live and Target are the normalized live and target states of the resource in the json output format. Live is
+	// omitted for resources which do not exist yet, and Target for resources which are going to be pruned.
+	Live   *unstructured.Unstructured `json:"live,omitempty"`
+	Target *unstructured.Unstructured `json:"target,omitempty"`
+	// Patch is the JSON patch (RFC 6902) which turns the live state into the target state in the jsonpatch output
+	// format
+	Patch []jsonpatchv2.Operation `json:"patch,omitempty"`
+}
+
+// newResourceDiff returns the difference between the live and the target state of the resource in the given
+// output format
+func newResourceDiff(key kube.ResourceKey, ignored bool, live, target *unstructured.Unstructured, output string) (*resourceDiff, error) {
+	res := &resourceDiff{Group: key.Group, Kind: key.Kind, Namespace: key.Namespace, Name: key.Name, Ignored: ignored}
+	if output == "json" {
+		res.Live = live
+		res.Target = target
+		return res, nil
+	}
+	switch {
+	case live == nil:
+		res.Patch = []jsonpatchv2.Operation{jsonpatchv2.NewOperation("add", "", target.Object)}
+	case target == nil:
+		res.Patch = []jsonpatchv2.Operation{jsonpatchv2.NewOperation("remove", "", nil)}
+	default:
+		liveData, err := json.Marshal(live.Object)
+		if err != nil {
+			return nil, err
+		}
+		targetData, err := json.Marshal(target.Object)
+		if err != nil {
+			return nil, err
+		}
+		res.Patch, err = jsonpatchv2.CreatePatch(liveData, targetData)
+		if err != nil {
+			return nil, fmt.Errorf("error creating JSON patch: %w", err)
+		}
+		sortJSONPatch(res.Patch)
+	}
+	return res, nil
+}
+
+// sortJSONPatch sorts the operations by path, so that the same states always produce the same patch. Array items are
+// ordered by index, except for removals, which must stay in descending order so that the indexes of the items which
+// are yet to be removed do not change.
+func sortJSONPatch(patch []jsonpatchv2.Operation) {
+	sort.SliceStable(patch, func(i, j int) bool {
+		a, b := strings.Split(patch[i].Path, "/"), strings.Split(patch[j].Path, "/")
+		for k := 0; k < len(a) && k < len(b); k++ {
+			if a[k] == b[k] {
+				continue
+			}
+			ai, aErr := strconv.Atoi(a[k])
+			bi, bErr := strconv.Atoi(b[k])
+			if aErr != nil || bErr != nil {
+				return a[k] < b[k]
+			}
+			if patch[i].Operation == "remove" && patch[j].Operation == "remove" {
+				return ai > bi
+			}
+			return ai < bi
+		}
+		return len(a) < len(b)
+	})
+}
+
+// liveStateOf returns the live state of the resource, either as it is in the cluster or as normalized by the
+// controller, which includes applying the ignoreDifferences rules
+func liveStateOf(res *argoappv1.ResourceDiff, raw bool) string {
+	if raw {
+		return res.LiveState
+	}
+	return res.NormalizedLiveState
+}
+
+// withoutIgnoreRules returns a copy of the resource overrides without their ignoreDifferences rules
+func withoutIgnoreRules(overrides map[string]argoappv1.ResourceOverride) map[string]argoappv1.ResourceOverride {
+	res := make(map[string]argoappv1.ResourceOverride, len(overrides))
+	for k, override := range overrides {
+		override.IgnoreDifferences = argoappv1.OverrideIgnoreDiff{}
+		res[k] = override
+	}
+	return res
+}
+
+// ignoredDifferences returns the live state and a target state which only differs from it by the differences that
+// are found without ignore rules (raw) but suppressed by the ignore rules (normalized). The returned target is nil if
+// the ignore rules do not suppress any difference.
+func ignoredDifferences(normalized, raw diff.DiffResult) (*unstructured.Unstructured, *unstructured.Unstructured, error) {
+	if !raw.Modified {
+		return nil, nil, nil
+	}
+	rawPatch, err := jsonMergePatch(raw.NormalizedLive, raw.PredictedLive)
+	if err != nil {
+		return nil, nil, err
+	}
+	normalizedPatch, err := jsonMergePatch(normalized.NormalizedLive, normalized.PredictedLive)
+	if err != nil {
+		return nil, nil, err
+	}
+	ignoredPatch := subtractMergePatch(rawPatch, normalizedPatch)
+	if len(ignoredPatch) == 0 {
+		return nil, nil, nil
+	}
+	patch, err := json.Marshal(ignoredPatch)
+	if err != nil {
+		return nil, nil, err
+	}
+	targetData, err := jsonpatch.MergePatch(raw.NormalizedLive, patch)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to apply the ignored differences: %w", err)
+	}
+	live := &unstructured.Unstructured{}
+	if err := json.Unmarshal(raw.NormalizedLive, live); err != nil {
+		return nil, nil, err
+	}
+	target := &unstructured.Unstructured{}
+	if err := json.Unmarshal(targetData, target); err != nil {
+		return nil, nil, err
+	}
+	return live, target, nil
+}
+
+// jsonMergePatch returns the JSON merge patch which turns the original document into the modified one
+func jsonMergePatch(original, modified []byte) (map[string]any, error) {
+	data, err := jsonpatch.CreateMergePatch(original, modified)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create merge patch: %w", err)
+	}
+	patch := make(map[string]any)
+	if err := json.Unmarshal(data, &patch); err != nil {
+		return nil, err
+	}
+	return patch, nil
+}
+
+// subtractMergePatch returns the parts of the merge patch which are not also part of the other merge patch
+func subtractMergePatch(patch, other map[string]any) map[string]any {
+	res := make(map[string]any)
+	for k, v := range patch {
+		otherValue, ok := other[k]
+		if !ok {
+			res[k] = v
+			continue
+		}
+		nested, isMap := v.(map[string]any)
+		otherNested, otherIsMap := otherValue.(map[string]any)
+		if isMap && otherIsMap {
+			if remaining := subtractMergePatch(nested, otherNested); len(remaining) > 0 {
+				res[k] = remaining
+			}
+		}
+	}
+	return res
+}
+
+func groupObjsForDiff(resources *application.ManagedResourcesResponse, objs map[kube.ResourceKey]*unstructured.Unstructured, items []objKeyLiveTarget, argoSettings *settings.Settings, appName, namespace string, rawLiveState bool) []objKeyLiveTarget {
 	resourceTracking := argo.NewResourceTracking()
 	for _, res := range resources.Items {
 		live := &unstructured.Unstructured{}
-		err := json.Unmarshal([]byte(res.NormalizedLiveState), &live)
+		err := json.Unmarshal([]byte(liveStateOf(res, rawLiveState)), &live)
 		errors.CheckError(err)
 
 		key := kube.ResourceKey{Name: res.Name, Namespace: res.Namespace, Group: res.Group, Kind: res.Kind}
@@ -1563,10 +2311,12 @@ func NewApplicationDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.
 		selector          string
 		wait              bool
 		appNamespace      string
+		keepResources     []string
 	)
 	command := &cobra.Command{
-		Use:   "delete APPNAME",
-		Short: "Delete an application",
+		Use:               "delete APPNAME",
+		ValidArgsFunction: completeAppNames(clientOpts, 0),
+		Short:             "Delete an application",
 		Example: `  # Delete an app
   argocd app delete my-app
 
@@ -1578,7 +2328,10 @@ func NewApplicationDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.
   argocd app delete -l app.kubernetes.io/instance!=my-app
   argocd app delete -l app.kubernetes.io/instance
   argocd app delete -l '!app.kubernetes.io/instance'
-  argocd app delete -l 'app.kubernetes.io/instance notin (my-app,other-app)'`,
+  argocd app delete -l 'app.kubernetes.io/instance notin (my-app,other-app)'
+
+  # Delete an app but keep some of its resources in the cluster
+  argocd app delete my-app --keep-resources :PersistentVolumeClaim:data --keep-resources apps:StatefulSet:my-ns/db`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
@@ -1596,12 +2349,18 @@ func NewApplicationDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.
 			}
 
 			appNames, err := getAppNamesBySelector(ctx, appIf, selector)
-			errors.CheckError(err)
+			errors.CheckErrorWithContext(ctx, err)
 
 			if len(appNames) == 0 {
 				appNames = args
 			}
 
+			keep, err := parseSelectedResources(keepResources)
+			errors.CheckError(err)
+			if len(keep) > 0 && !cascade {
+				errors.Fatal(errors.ErrorGeneric, "--keep-resources cannot be combined with --cascade=false, which keeps all resources")
+			}
+
 			numOfApps := len(appNames)
 
 			// This is for backward compatibility,
@@ -1631,8 +2390,20 @@ func NewApplicationDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.
 					confirm, confirmAll = promptUtil.ConfirmBaseOnCount(messageForSingle, messageForAll, numOfApps)
 				}
 				if confirm || confirmAll {
+					var kept []argoappv1.ResourceStatus
+					restore := func() {}
+					if len(keep) > 0 {
+						kept, restore, err = orphanKeptResources(ctx, appIf, appName, appNs, keep)
+						errors.CheckErrorWithContext(ctx, err)
+					}
 					_, err := appIf.Delete(ctx, &appDeleteReq)
-					errors.CheckError(err)
+					if err != nil {
+						restore()
+					}
+					errors.CheckErrorWithContext(ctx, err)
+					for _, res := range kept {
+						fmt.Printf("resource %s/%s '%s' will be kept\n", res.Group, res.Kind, res.Name)
+					}
 					if wait {
 						checkForDeleteEvent(ctx, acdClient, appFullName)
 					}
@@ -1649,9 +2420,115 @@ func NewApplicationDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.
 	command.Flags().StringVarP(&selector, "selector", "l", "", "Delete all apps with matching label. Supports '=', '==', '!=', in, notin, exists & not exists. Matching apps must satisfy all of the specified label constraints.")
 	command.Flags().BoolVar(&wait, "wait", false, "Wait until deletion of the application(s) completes")
 	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Namespace where the application will be deleted from")
+	command.Flags().StringArrayVar(&keepResources, "keep-resources", []string{}, fmt.Sprintf("Keep a resource in the cluster when deleting the application with cascade. The format is GROUP%sKIND%sNAME or GROUP%sKIND%sNAMESPACE%sNAME. Can be repeated", resourceFieldDelimiter, resourceFieldDelimiter, resourceFieldDelimiter, resourceFieldDelimiter, resourceFieldNamespaceDelimiter))
 	return command
 }
 
+// orphanKeptResources annotates the resources of an application which should be kept in the cluster with the orphan
+// deletion policy, so that the controller does not delete them when the application is deleted. The annotation has to
+// be set before the application is deleted, so it returns a function restoring the previous deletion policies of the
+// resources, which must be called if the application is not deleted.
+func orphanKeptResources(ctx context.Context, appIf application.ApplicationServiceClient, appName, appNs string, keep []*argoappv1.SyncOperationResource) ([]argoappv1.ResourceStatus, func(), error) {
+	app, err := appIf.Get(ctx, &application.ApplicationQuery{Name: &appName, AppNamespace: &appNs})
+	if err != nil {
+		return nil, nil, err
+	}
+	resources, err := findKeptResources(app.Status.Resources, keep)
+	if err != nil {
+		return nil, nil, err
+	}
+	patchDeletionPolicy := func(res argoappv1.ResourceStatus, policy *string) error {
+		patch, err := json.Marshal(map[string]any{"metadata": map[string]any{"annotations": map[string]*string{argocommon.AnnotationDeletionPolicy: policy}}})
+		if err != nil {
+			return err
+		}
+		_, err = appIf.PatchResource(ctx, &application.ApplicationResourcePatchRequest{
+			Name:         &appName,
+			AppNamespace: &appNs,
+			Namespace:    ptr.To(res.Namespace),
+			ResourceName: ptr.To(res.Name),
+			Version:      ptr.To(res.Version),
+			Group:        ptr.To(res.Group),
+			Kind:         ptr.To(res.Kind),
+			Patch:        ptr.To(string(patch)),
+			PatchType:    ptr.To(string(types.MergePatchType)),
+			Project:      ptr.To(app.Spec.GetProject()),
+		})
+		return err
+	}
+
+	var orphaned []argoappv1.ResourceStatus
+	var previousPolicies []*string
+	restore := func() {
+		for i, res := range orphaned {
+			if err := patchDeletionPolicy(res, previousPolicies[i]); err != nil {
+				log.Warnf("Failed to restore the deletion policy of resource %s/%s '%s': %v", res.Group, res.Kind, res.Name, err)
+			}
+		}
+	}
+	for _, res := range resources {
+		previousPolicy, err := getDeletionPolicy(ctx, appIf, app, res)
+		if err == nil {
+			err = patchDeletionPolicy(res, ptr.To(argocommon.DeletionPolicyOrphan))
+		}
+		if err != nil {
+			restore()
+			return nil, nil, err
+		}
+		orphaned = append(orphaned, res)
+		previousPolicies = append(previousPolicies, previousPolicy)
+	}
+	return resources, restore, nil
+}
+
+// getDeletionPolicy returns the value of the deletion-policy annotation of a live resource of the application, or nil
+// if it is not set
+func getDeletionPolicy(ctx context.Context, appIf application.ApplicationServiceClient, app *argoappv1.Application, res argoappv1.ResourceStatus) (*string, error) {
+	live, err := appIf.GetResource(ctx, &application.ApplicationResourceRequest{
+		Name:         &app.Name,
+		AppNamespace: &app.Namespace,
+		Namespace:    ptr.To(res.Namespace),
+		ResourceName: ptr.To(res.Name),
+		Version:      ptr.To(res.Version),
+		Group:        ptr.To(res.Group),
+		Kind:         ptr.To(res.Kind),
+		Project:      ptr.To(app.Spec.GetProject()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	obj := unstructured.Unstructured{}
+	if err := json.Unmarshal([]byte(live.GetManifest()), &obj); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal resource %s/%s '%s': %w", res.Group, res.Kind, res.Name, err)
+	}
+	if policy, ok := obj.GetAnnotations()[argocommon.AnnotationDeletionPolicy]; ok {
+		return &policy, nil
+	}
+	return nil, nil
+}
+
+// findKeptResources returns the resources of an application which match the resources to keep. Every resource to
+// keep must match at least one resource of the application.
+func findKeptResources(resources []argoappv1.ResourceStatus, keep []*argoappv1.SyncOperationResource) ([]argoappv1.ResourceStatus, error) {
+	var kept []argoappv1.ResourceStatus
+	for _, k := range keep {
+		if k.Exclude {
+			return nil, fmt.Errorf("resources to keep cannot be excluded with %q", resourceExcludeIndicator)
+		}
+		found := false
+		for _, res := range resources {
+			if k.HasIdentity(res.Name, res.Namespace, res.GroupVersionKind()) {
+				kept = append(kept, res)
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("resource %s%s%s%s%s is not managed by the application", k.Group, resourceFieldDelimiter, k.Kind, resourceFieldDelimiter, k.Name)
+		}
+	}
+	return kept, nil
+}
+
 func checkForDeleteEvent(ctx context.Context, acdClient argocdclient.Client, appFullName string) {
 	appEventCh := acdClient.WatchApplicationWithRetry(ctx, appFullName, "")
 	for appEvent := range appEventCh {
@@ -1661,6 +2538,18 @@ func checkForDeleteEvent(ctx context.Context, acdClient argocdclient.Client, app
 	}
 }
 
+// printWatchSeparator separates the output printed for a change of a watched resource from the previous output
+func printWatchSeparator(output string) {
+	switch output {
+	case "yaml":
+		fmt.Println("---")
+	case "json":
+		// JSON documents are simply concatenated, like `kubectl get --watch` does
+	default:
+		fmt.Println()
+	}
+}
+
 // Print simple list of application names
 func printApplicationNames(apps []argoappv1.Application) {
 	for _, app := range apps {
@@ -1708,6 +2597,11 @@ func NewApplicationListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 		repo         string
 		appNamespace string
 		cluster      string
+		healthStatus string
+		syncStatus   string
+		limit        int64
+		continueFrom string
+		watch        bool
 	)
 	command := &cobra.Command{
 		Use:   "list",
@@ -1720,49 +2614,120 @@ func NewApplicationListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
   argocd app list -l app.kubernetes.io/instance!=my-app
   argocd app list -l app.kubernetes.io/instance
   argocd app list -l '!app.kubernetes.io/instance'
-  argocd app list -l 'app.kubernetes.io/instance notin (my-app,other-app)'`,
+  argocd app list -l 'app.kubernetes.io/instance notin (my-app,other-app)'
+
+  # Watch the apps of a project and list them again on every change
+  argocd app list -p my-project --watch
+
+  # List the degraded or out of sync apps of a cluster
+  argocd app list --cluster in-cluster --health Degraded
+  argocd app list --cluster in-cluster --sync-status OutOfSync
+
+  # List the apps in pages of 100 apps, passing the printed continue token to get the next page
+  argocd app list --limit 100
+  argocd app list --limit 100 --continue <token>`,
 		Run: func(c *cobra.Command, _ []string) {
 			ctx := c.Context()
 
-			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			if watch && (limit != 0 || continueFrom != "") {
+				errors.Fatal(errors.ErrorGeneric, "--watch cannot be combined with --limit or --continue")
+			}
+			if limit < 0 {
+				errors.Fatal(errors.ErrorGeneric, "--limit must not be negative")
+			}
+
+			acdClient := headless.NewClientOrDie(clientOpts, c)
+			conn, appIf := acdClient.NewApplicationClientOrDie()
 			defer utilio.Close(conn)
 			apps, err := appIf.List(ctx, &application.ApplicationQuery{
 				Selector:     ptr.To(selector),
 				AppNamespace: &appNamespace,
+				Projects:     projects,
+				Repo:         ptr.To(repo),
+				Cluster:      ptr.To(cluster),
+				Health:       ptr.To(healthStatus),
+				Sync:         ptr.To(syncStatus),
+				Limit:        ptr.To(limit),
+				Continue:     ptr.To(continueFrom),
 			})
 
-			errors.CheckError(err)
-			appList := apps.Items
+			errors.CheckErrorWithContext(ctx, err)
 
-			if len(projects) != 0 {
-				appList = argo.FilterByProjects(appList, projects)
+			printApps := func(appList []argoappv1.Application) {
+				// the applications are filtered on the client side as well, since watch events are not filtered by
+				// repo, cluster, health or sync status and older servers ignore these filters
+				if len(projects) != 0 {
+					appList = argo.FilterByProjects(appList, projects)
+				}
+				if repo != "" {
+					appList = argo.FilterByRepo(appList, repo)
+				}
+				if cluster != "" {
+					appList = argo.FilterByCluster(appList, cluster)
+				}
+				if healthStatus != "" {
+					appList = argo.FilterByHealth(appList, healthStatus)
+				}
+				if syncStatus != "" {
+					appList = argo.FilterBySyncStatus(appList, syncStatus)
+				}
+
+				switch output {
+				case "yaml", "json":
+					err := PrintResourceList(appList, output, false)
+					errors.CheckErrorWithContext(ctx, err)
+				case "name":
+					printApplicationNames(appList)
+				case "wide", "":
+					printApplicationTable(appList, &output)
+				default:
+					errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+				}
 			}
-			if repo != "" {
-				appList = argo.FilterByRepo(appList, repo)
+			printApps(apps.Items)
+			if apps.Continue != "" {
+				fmt.Fprintf(os.Stderr, "More applications are available, use --continue %s to list them\n", apps.Continue)
 			}
-			if cluster != "" {
-				appList = argo.FilterByCluster(appList, cluster)
+			if !watch {
+				return
 			}
 
-			switch output {
-			case "yaml", "json":
-				err := PrintResourceList(appList, output, false)
-				errors.CheckError(err)
-			case "name":
-				printApplicationNames(appList)
-			case "wide", "":
-				printApplicationTable(appList, &output)
-			default:
-				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			appsByName := make(map[string]argoappv1.Application, len(apps.Items))
+			for _, app := range apps.Items {
+				appsByName[app.QualifiedName()] = app
+			}
+			appEventCh := acdClient.WatchApplicationsWithRetry(ctx, &application.ApplicationQuery{
+				Selector:        ptr.To(selector),
+				AppNamespace:    &appNamespace,
+				ResourceVersion: ptr.To(apps.ResourceVersion),
+			})
+			for appEvent := range appEventCh {
+				if appEvent.Type == k8swatch.Deleted {
+					delete(appsByName, appEvent.Application.QualifiedName())
+				} else {
+					appsByName[appEvent.Application.QualifiedName()] = appEvent.Application
+				}
+				appList := make([]argoappv1.Application, 0, len(appsByName))
+				for _, name := range slices.Sorted(maps.Keys(appsByName)) {
+					appList = append(appList, appsByName[name])
+				}
+				printWatchSeparator(output)
+				printApps(appList)
 			}
 		},
 	}
 	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: wide|name|json|yaml")
 	command.Flags().StringVarP(&selector, "selector", "l", "", "List apps by label. Supports '=', '==', '!=', in, notin, exists & not exists. Matching apps must satisfy all of the specified label constraints.")
 	command.Flags().StringArrayVarP(&projects, "project", "p", []string{}, "Filter by project name")
+	errors.CheckError(command.RegisterFlagCompletionFunc("project", completeProjectNames(clientOpts, 0)))
 	command.Flags().StringVarP(&repo, "repo", "r", "", "List apps by source repo URL")
 	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Only list applications in namespace")
 	command.Flags().StringVarP(&cluster, "cluster", "c", "", "List apps by cluster name or url")
+	command.Flags().StringVar(&healthStatus, "health", "", "List apps by health status, e.g. Healthy or Degraded")
+	command.Flags().StringVar(&syncStatus, "sync-status", "", "List apps by sync status, e.g. Synced or OutOfSync")
+	command.Flags().Int64Var(&limit, "limit", 0, "Maximum number of apps to list, 0 lists all apps")
+	command.Flags().StringVar(&continueFrom, "continue", "", "Continue token of a previous list with --limit, to list the next apps")
+	command.Flags().BoolVarP(&watch, "watch", "w", false, "Watch the applications and print them again on every change")
 	return command
 }
 
@@ -1863,12 +2828,13 @@ func parseSelectedResources(resources []string) ([]*argoappv1.SyncOperationResou
 }
 
 func getWatchOpts(watch watchOpts) watchOpts {
-	// if no opts are defined should wait for sync,health,operation
-	if (watch == watchOpts{}) {
+	// if no opts are defined should wait for sync,health,operation, in addition to the custom conditions
+	if (watch == watchOpts{conditions: watch.conditions}) {
 		return watchOpts{
-			sync:      true,
-			health:    true,
-			operation: true,
+			sync:       true,
+			health:     true,
+			operation:  true,
+			conditions: watch.conditions,
 		}
 	}
 	return watch
@@ -1883,10 +2849,12 @@ func NewApplicationWaitCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 		resources    []string
 		output       string
 		appNamespace string
+		conditions   []string
 	)
 	command := &cobra.Command{
-		Use:   "wait [APPNAME.. | -l selector]",
-		Short: "Wait for an application to reach a synced and healthy state",
+		Use:               "wait [APPNAME.. | -l selector]",
+		ValidArgsFunction: completeAppNames(clientOpts, 0),
+		Short:             "Wait for an application to reach a synced and healthy state",
 		Example: `  # Wait for an app
   argocd app wait my-app
 
@@ -1908,7 +2876,11 @@ func NewApplicationWaitCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
   argocd app wait -l app.kubernetes.io/instance!=my-app
   argocd app wait -l app.kubernetes.io/instance
   argocd app wait -l '!app.kubernetes.io/instance'
-  argocd app wait -l 'app.kubernetes.io/instance notin (my-app,other-app)'`,
+  argocd app wait -l 'app.kubernetes.io/instance notin (my-app,other-app)'
+
+  # Wait for an app until its status matches custom conditions, e.g. a specific image was rolled out
+  argocd app wait my-app --for '"my-image:1.2.3" in status.summary.images'
+  argocd app wait my-app --health --for 'status.sync.revision startsWith "2f4c"'`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
@@ -1916,16 +2888,21 @@ func NewApplicationWaitCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				c.HelpFunc()(c, args)
 				os.Exit(1)
 			}
+			if len(conditions) > 0 {
+				var err error
+				watch.conditions, err = newWaitConditions(conditions)
+				errors.CheckErrorWithContext(ctx, err)
+			}
 			watch = getWatchOpts(watch)
 			selectedResources, err := parseSelectedResources(resources)
-			errors.CheckError(err)
+			errors.CheckErrorWithContext(ctx, err)
 			appNames := args
 			acdClient := headless.NewClientOrDie(clientOpts, c)
 			closer, appIf := acdClient.NewApplicationClientOrDie()
 			defer utilio.Close(closer)
 			if selector != "" {
 				list, err := appIf.List(ctx, &application.ApplicationQuery{Selector: ptr.To(selector)})
-				errors.CheckError(err)
+				errors.CheckErrorWithContext(ctx, err)
 				for _, i := range list.Items {
 					appNames = append(appNames, i.QualifiedName())
 				}
@@ -1936,7 +2913,7 @@ func NewApplicationWaitCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 					appName = appNamespace + "/" + appName
 				}
 				_, _, err := waitOnApplicationStatus(ctx, acdClient, appName, timeout, watch, selectedResources, output)
-				errors.CheckError(err)
+				errors.CheckErrorWithContext(ctx, err)
 			}
 		},
 	}
@@ -1951,6 +2928,7 @@ func NewApplicationWaitCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	command.Flags().BoolVar(&watch.operation, "operation", false, "Wait for pending operations")
 	command.Flags().UintVar(&timeout, "timeout", defaultCheckTimeoutSeconds, "Time out after this many seconds")
 	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Only wait for an application  in namespace")
+	command.Flags().StringArrayVar(&conditions, "for", []string{}, "Wait until the expr language expression evaluates to true for the application, whose fields like metadata, spec and status are available as variables. Unless other wait options are specified, the app is also waited for to be synced and healthy. This option may be specified repeatedly")
 	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide|tree|tree=detailed")
 	return command
 }
@@ -1989,6 +2967,8 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 		sourcePositions         []int64
 		sourceNames             []string
 		resources               []string
+		resourceSelector        string
+		reason                  string
 		labels                  []string
 		selector                string
 		prune                   bool
@@ -2009,14 +2989,18 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 		infos                   []string
 		diffChanges             bool
 		diffChangesConfirm      bool
+		preview                 bool
 		projects                []string
 		output                  string
 		appNamespace            string
 		ignoreNormalizerOpts    normalizers.IgnoreNormalizerOpts
+		maxParallel             int64
+		abortOnFailure          bool
 	)
 	command := &cobra.Command{
-		Use:   "sync [APPNAME... | -l selector | --project project-name]",
-		Short: "Sync an application to its target state",
+		Use:               "sync [APPNAME... | -l selector | --project project-name]",
+		ValidArgsFunction: completeAppNames(clientOpts, 0),
+		Short:             "Sync an application to its target state",
 		Example: `  # Sync an app
   argocd app sync my-app
 
@@ -2042,7 +3026,27 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
   argocd app sync my-app --resource apps:Deployment:my-service --resource :Service:my-service
   argocd app sync my-app --resource '!*:Service:*'
   # Specify namespace if the application has resources with the same name in different namespaces
-  argocd app sync my-app --resource argoproj.io:Rollout:my-namespace/my-rollout`,
+  argocd app sync my-app --resource argoproj.io:Rollout:my-namespace/my-rollout
+
+  # Sync only the resources whose labels match a selector
+  argocd app sync my-app --resource-selector tier=frontend
+  argocd app sync my-app --resource-selector 'tier in (frontend,backend),!canary'
+
+  # Record why the sync was initiated, the reason is shown in the history of the application
+  argocd app sync my-app --reason "JIRA-123 hotfix"
+
+  # Preview the resources a sync would create, update and prune, the hooks it would run and the order of its waves.
+  # Exits with a non-zero code if resources would be pruned.
+  argocd app sync my-app --prune --preview
+
+  # Retry a failed sync up to 5 times, backing off from 10s to at most 2m between attempts
+  argocd app sync my-app --retry-limit 5 --retry-backoff-duration 10s --retry-backoff-factor 2 --retry-backoff-max-duration 2m
+
+  # Print the result of the sync operation, including the synced resources and hooks, as JSON
+  argocd app sync my-app -o json
+
+  # Sync the apps matching a label on the server, five at a time, and skip the remaining apps once a sync failed
+  argocd app sync -l env=staging --max-parallel 5 --abort-on-failure`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 			if len(args) == 0 && selector == "" && len(projects) == 0 {
@@ -2079,17 +3083,110 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				}
 			}
 
+			if preview {
+				if async || diffChanges || output == "json" || output == "yaml" {
+					log.Fatal("Cannot use --preview with --async, --preview-changes or the json and yaml output formats")
+				}
+				dryRun = true
+			}
+
+			retryStrategy, err := newRetryStrategy(retryLimit, retryBackoffDuration, retryBackoffMaxDuration, retryBackoffFactor)
+			errors.CheckErrorWithContext(ctx, err)
+
+			var syncStrategy *argoappv1.SyncStrategy
+			switch strategy {
+			case "apply":
+				syncStrategy = &argoappv1.SyncStrategy{Apply: &argoappv1.SyncStrategyApply{}}
+				syncStrategy.Apply.Force = force
+			case "", "hook":
+				syncStrategy = &argoappv1.SyncStrategy{Hook: &argoappv1.SyncStrategyHook{}}
+				syncStrategy.Hook.Force = force
+			default:
+				log.Fatalf("Unknown sync strategy: '%s'", strategy)
+			}
+
+			syncOptionsFactory := func() *application.SyncOptions {
+				syncOptions := application.SyncOptions{}
+				items := make([]string, 0)
+				if replace {
+					items = append(items, common.SyncOptionReplace)
+				}
+				if serverSideApply {
+					items = append(items, common.SyncOptionServerSideApply)
+				}
+				if applyOutOfSyncOnly {
+					items = append(items, common.SyncOptionApplyOutOfSyncOnly)
+				}
+
+				if len(items) == 0 {
+					// for prevent send even empty array if not need
+					return nil
+				}
+				syncOptions.Items = items
+				return &syncOptions
+			}
+
 			acdClient := headless.NewClientOrDie(clientOpts, c)
 			conn, appIf := acdClient.NewApplicationClientOrDie()
 			defer utilio.Close(conn)
 
+			if maxParallel != 0 || abortOnFailure {
+				if async || local != "" || diffChanges || preview || len(labels) > 0 || len(resources) > 0 || revision != "" || len(revisions) > 0 {
+					log.Fatal("Cannot use --max-parallel and --abort-on-failure with --async, --local, --preview-changes, --preview, --label, --resource, --revision or --revisions")
+				}
+				req := &application.ApplicationsSyncRequest{
+					Names:          args,
+					AppNamespace:   &appNamespace,
+					Projects:       projects,
+					MaxParallel:    &maxParallel,
+					AbortOnFailure: &abortOnFailure,
+					DryRun:         &dryRun,
+					Prune:          &prune,
+					Strategy:       syncStrategy,
+					Infos:          getInfos(infos),
+					RetryStrategy:  retryStrategy,
+					SyncOptions:    syncOptionsFactory(),
+				}
+				if selector != "" {
+					req.Selector = &selector
+				}
+				if resourceSelector != "" {
+					req.ResourceSelector = &resourceSelector
+				}
+				if reason != "" {
+					req.Reason = &reason
+				}
+				if timeout != 0 {
+					var cancel context.CancelFunc
+					ctx, cancel = context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
+					defer cancel()
+				}
+				stream, err := appIf.SyncApplications(ctx, req)
+				errors.CheckErrorWithContext(ctx, err)
+				results, err := recvApplicationsSyncResults(stream, func(res *application.ApplicationsSyncResult) {
+					log.Infof("Application '%s/%s': %s", res.GetAppNamespace(), res.GetName(), res.GetMessage())
+				})
+				errors.CheckErrorWithContext(ctx, err)
+				switch output {
+				case "json", "yaml":
+					err := PrintResourceList(results, output, false)
+					errors.CheckErrorWithContext(ctx, err)
+				default:
+					printApplicationsSyncResults(os.Stdout, results)
+				}
+				if failed := countFailedSyncs(results); failed > 0 {
+					log.Fatalf("%d of %d applications failed to sync", failed, len(results))
+				}
+				return
+			}
+
 			selectedLabels, err := label.Parse(labels)
-			errors.CheckError(err)
+			errors.CheckErrorWithContext(ctx, err)
 
 			if len(args) == 1 && len(sourceNames) > 0 {
 				appName, _ := argo.ParseFromQualifiedName(args[0], appNamespace)
 				app, err := appIf.Get(context.Background(), &application.ApplicationQuery{Name: &appName})
-				errors.CheckError(err)
+				errors.CheckErrorWithContext(ctx, err)
 
 				sourceNameToPosition := getSourceNameToPositionMap(app)
 
@@ -2109,7 +3206,7 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 					AppNamespace: &appNamespace,
 					Projects:     projects,
 				})
-				errors.CheckError(err)
+				errors.CheckErrorWithContext(ctx, err)
 
 				// unlike list, we'd want to fail if nothing was found
 				if len(list.Items) == 0 {
@@ -2128,6 +3225,8 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				}
 			}
 
+			// prunes is the number of resources which would be pruned by the previewed syncs
+			prunes := 0
 			for _, appQualifiedName := range appNames {
 				// Construct QualifiedName
 				if appNamespace != "" && !strings.Contains(appQualifiedName, "/") {
@@ -2153,7 +3252,7 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 
 					for _, mfst := range res.Manifests {
 						obj, err := argoappv1.UnmarshalToUnstructured(mfst)
-						errors.CheckError(err)
+						errors.CheckErrorWithContext(ctx, err)
 						for key, selectedValue := range selectedLabels {
 							if objectValue, ok := obj.GetLabels()[key]; ok && selectedValue == objectValue {
 								gvk := obj.GroupVersionKind()
@@ -2171,7 +3270,7 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				}
 
 				selectedResources, err := parseSelectedResources(resources)
-				errors.CheckError(err)
+				errors.CheckErrorWithContext(ctx, err)
 
 				var localObjsStrings []string
 				diffOption := &DifferenceOption{}
@@ -2180,7 +3279,7 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 					Name:         &appName,
 					AppNamespace: &appNs,
 				})
-				errors.CheckError(err)
+				errors.CheckErrorWithContext(ctx, err)
 
 				if app.Spec.HasMultipleSources() {
 					if revision != "" {
@@ -2207,47 +3306,26 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 						log.Fatal("Cannot use local sync when Automatic Sync Policy is enabled except with --dry-run")
 					}
 
-					errors.CheckError(err)
+					errors.CheckErrorWithContext(ctx, err)
 					conn, settingsIf := acdClient.NewSettingsClientOrDie()
 					argoSettings, err := settingsIf.Get(ctx, &settings.SettingsQuery{})
-					errors.CheckError(err)
+					errors.CheckErrorWithContext(ctx, err)
 					utilio.Close(conn)
 
 					conn, clusterIf := acdClient.NewClusterClientOrDie()
 					defer utilio.Close(conn)
 					cluster, err := clusterIf.Get(ctx, &clusterpkg.ClusterQuery{Name: app.Spec.Destination.Name, Server: app.Spec.Destination.Server})
-					errors.CheckError(err)
+					errors.CheckErrorWithContext(ctx, err)
 					utilio.Close(conn)
 
 					proj := getProject(ctx, c, clientOpts, app.Spec.Project)
 					localObjsStrings = getLocalObjectsString(ctx, app, proj.Project, local, localRepoRoot, argoSettings.AppLabelKey, cluster.Info.ServerVersion, cluster.Info.APIVersions, argoSettings.KustomizeOptions, argoSettings.TrackingMethod)
-					errors.CheckError(err)
+					errors.CheckErrorWithContext(ctx, err)
 					diffOption.local = local
 					diffOption.localRepoRoot = localRepoRoot
 					diffOption.cluster = cluster
 				}
 
-				syncOptionsFactory := func() *application.SyncOptions {
-					syncOptions := application.SyncOptions{}
-					items := make([]string, 0)
-					if replace {
-						items = append(items, common.SyncOptionReplace)
-					}
-					if serverSideApply {
-						items = append(items, common.SyncOptionServerSideApply)
-					}
-					if applyOutOfSyncOnly {
-						items = append(items, common.SyncOptionApplyOutOfSyncOnly)
-					}
-
-					if len(items) == 0 {
-						// for prevent send even empty array if not need
-						return nil
-					}
-					syncOptions.Items = items
-					return &syncOptions
-				}
-
 				syncReq := application.ApplicationSyncRequest{
 					Name:            &appName,
 					AppNamespace:    &appNs,
@@ -2261,37 +3339,25 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 					Revisions:       revisions,
 					SourcePositions: sourcePositions,
 				}
-
-				switch strategy {
-				case "apply":
-					syncReq.Strategy = &argoappv1.SyncStrategy{Apply: &argoappv1.SyncStrategyApply{}}
-					syncReq.Strategy.Apply.Force = force
-				case "", "hook":
-					syncReq.Strategy = &argoappv1.SyncStrategy{Hook: &argoappv1.SyncStrategyHook{}}
-					syncReq.Strategy.Hook.Force = force
-				default:
-					log.Fatalf("Unknown sync strategy: '%s'", strategy)
-				}
-				if retryLimit > 0 {
-					syncReq.RetryStrategy = &argoappv1.RetryStrategy{
-						Limit: retryLimit,
-						Backoff: &argoappv1.Backoff{
-							Duration:    retryBackoffDuration.String(),
-							MaxDuration: retryBackoffMaxDuration.String(),
-							Factor:      ptr.To(retryBackoffFactor),
-						},
-					}
+				if resourceSelector != "" {
+					syncReq.ResourceSelector = &resourceSelector
+				}
+				if reason != "" {
+					syncReq.Reason = &reason
 				}
+
+				syncReq.Strategy = syncStrategy
+				syncReq.RetryStrategy = retryStrategy
 				if diffChanges {
 					resources, err := appIf.ManagedResources(ctx, &application.ResourcesQuery{
 						ApplicationName: &appName,
 						AppNamespace:    &appNs,
 					})
-					errors.CheckError(err)
+					errors.CheckErrorWithContext(ctx, err)
 					conn, settingsIf := acdClient.NewSettingsClientOrDie()
 					defer utilio.Close(conn)
 					argoSettings, err := settingsIf.Get(ctx, &settings.SettingsQuery{})
-					errors.CheckError(err)
+					errors.CheckErrorWithContext(ctx, err)
 					foundDiffs := false
 					fmt.Printf("====== Previewing differences between live and desired state of application %s ======\n", appQualifiedName)
 
@@ -2309,12 +3375,47 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 						}
 					}
 				}
-				_, err = appIf.Sync(ctx, &syncReq)
-				errors.CheckError(err)
+				syncedApp, err := appIf.Sync(ctx, &syncReq)
+				errors.CheckErrorWithContext(ctx, err)
+				printResult := output == "json" || output == "yaml"
+
+				if async && printResult {
+					err := PrintResource(newAcceptedSyncOperationResult(syncedApp, time.Now()), output)
+					errors.CheckErrorWithContext(ctx, err)
+				}
 
 				if !async {
-					app, opState, err := waitOnApplicationStatus(ctx, acdClient, appQualifiedName, timeout, watchOpts{operation: true}, selectedResources, output)
-					errors.CheckError(err)
+					waitOutput := output
+					if printResult || preview {
+						waitOutput = outputNone
+					}
+					app, opState, err := waitOnApplicationStatus(ctx, acdClient, appQualifiedName, timeout, watchOpts{operation: true}, selectedResources, waitOutput)
+					if printResult && opState != nil {
+						// the result is printed before exiting on failure, so that failed syncs can be inspected too
+						printErr := PrintResource(newSyncOperationResult(appQualifiedName, opState), output)
+						errors.CheckErrorWithContext(ctx, printErr)
+					}
+					errors.CheckErrorWithContext(ctx, err)
+
+					if preview {
+						resources, err := appIf.ManagedResources(ctx, &application.ResourcesQuery{
+							ApplicationName: &appName,
+							AppNamespace:    &appNs,
+						})
+						errors.CheckErrorWithContext(ctx, err)
+						var results argoappv1.ResourceResults
+						if opState.SyncResult != nil {
+							results = opState.SyncResult.Resources
+						}
+						syncPreview, err := newSyncPreview(results, resources.Items)
+						errors.CheckErrorWithContext(ctx, err)
+						printSyncPreview(os.Stdout, appQualifiedName, syncPreview)
+						if !opState.Phase.Successful() {
+							log.Fatalf("Dry run has completed with phase: %s: %s", opState.Phase, opState.Message)
+						}
+						prunes += syncPreview.counts[syncPreviewPrune]
+						continue
+					}
 
 					if !dryRun {
 						if !opState.Phase.Successful() {
@@ -2329,16 +3430,21 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 					}
 				}
 			}
+			if prunes > 0 {
+				log.Fatalf("%d resources would be pruned by the sync", prunes)
+			}
 		},
 	}
 	command.Flags().BoolVar(&dryRun, "dry-run", false, "Preview apply without affecting cluster")
 	command.Flags().BoolVar(&prune, "prune", false, "Allow deleting unexpected resources")
 	command.Flags().StringVar(&revision, "revision", "", "Sync to a specific revision. Preserves parameter overrides")
 	command.Flags().StringArrayVar(&resources, "resource", []string{}, fmt.Sprintf("Sync only specific resources as GROUP%[1]sKIND%[1]sNAME or %[2]sGROUP%[1]sKIND%[1]sNAME. Fields may be blank and '*' can be used. This option may be specified repeatedly", resourceFieldDelimiter, resourceExcludeIndicator))
+	command.Flags().StringVar(&resourceSelector, "resource-selector", "", "Sync only the resources whose labels match the given label selector (e.g. tier=frontend)")
+	command.Flags().StringVar(&reason, "reason", "", "Free-form description of why the sync is initiated, e.g. a ticket reference. It is recorded in the history of the application")
 	command.Flags().StringVarP(&selector, "selector", "l", "", "Sync apps that match this label. Supports '=', '==', '!=', in, notin, exists & not exists. Matching apps must satisfy all of the specified label constraints.")
 	command.Flags().StringArrayVar(&labels, "label", []string{}, "Sync only specific resources with a label. This option may be specified repeatedly.")
 	command.Flags().UintVar(&timeout, "timeout", defaultCheckTimeoutSeconds, "Time out after this many seconds")
-	command.Flags().Int64Var(&retryLimit, "retry-limit", 0, "Max number of allowed sync retries")
+	command.Flags().Int64Var(&retryLimit, "retry-limit", 0, "Max number of allowed sync retries, -1 retries until the sync succeeds")
 	command.Flags().DurationVar(&retryBackoffDuration, "retry-backoff-duration", argoappv1.DefaultSyncRetryDuration, "Retry backoff base duration. Input needs to be a duration (e.g. 2m, 1h)")
 	command.Flags().DurationVar(&retryBackoffMaxDuration, "retry-backoff-max-duration", argoappv1.DefaultSyncRetryMaxDuration, "Max retry backoff duration. Input needs to be a duration (e.g. 2m, 1h)")
 	command.Flags().Int64Var(&retryBackoffFactor, "retry-backoff-factor", argoappv1.DefaultSyncRetryFactor, "Factor multiplies the base duration after each failed retry")
@@ -2353,16 +3459,144 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	command.Flags().StringArrayVar(&infos, "info", []string{}, "A list of key-value pairs during sync process. These infos will be persisted in app.")
 	command.Flags().BoolVar(&diffChangesConfirm, "assumeYes", false, "Assume yes as answer for all user queries or prompts")
 	command.Flags().BoolVar(&diffChanges, "preview-changes", false, "Preview difference against the target and live state before syncing app and wait for user confirmation")
+	command.Flags().BoolVar(&preview, "preview", false, "Perform a dry run of the sync and print a summary of the resources to create, update and prune, the hooks to run and the order of the waves. Exits with a non-zero code if resources would be pruned")
 	command.Flags().StringArrayVar(&projects, "project", []string{}, "Sync apps that belong to the specified projects. This option may be specified repeatedly.")
-	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide|tree|tree=detailed")
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide|tree|tree=detailed. json and yaml print the result of the sync operation, or the accepted operation with --async")
 	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Only sync an application in namespace")
 	command.Flags().DurationVar(&ignoreNormalizerOpts.JQExecutionTimeout, "ignore-normalizer-jq-execution-timeout", normalizers.DefaultJQExecutionTimeout, "Set ignore normalizer JQ execution timeout")
 	command.Flags().StringArrayVar(&revisions, "revisions", []string{}, "Show manifests at specific revisions for source position in source-positions")
 	command.Flags().Int64SliceVar(&sourcePositions, "source-positions", []int64{}, "List of source positions. Default is empty array. Counting start at 1.")
 	command.Flags().StringArrayVar(&sourceNames, "source-names", []string{}, "List of source names. Default is an empty array.")
+	command.Flags().Int64Var(&maxParallel, "max-parallel", 0, "Sync the apps on the server, at most this many at the same time, and wait for all syncs to complete")
+	command.Flags().BoolVar(&abortOnFailure, "abort-on-failure", false, "Sync the apps on the server and skip the apps whose sync has not been started yet once a sync failed")
 	return command
 }
 
+// recvApplicationsSyncResults receives the results of a bulk sync until all syncs have completed and calls onResult for
+// each result as soon as it has been received
+func recvApplicationsSyncResults(stream application.ApplicationService_SyncApplicationsClient, onResult func(*application.ApplicationsSyncResult)) ([]*application.ApplicationsSyncResult, error) {
+	var results []*application.ApplicationsSyncResult
+	for {
+		res, err := stream.Recv()
+		if err != nil {
+			if stderrors.Is(err, io.EOF) {
+				return results, nil
+			}
+			return results, err
+		}
+		onResult(res)
+		results = append(results, res)
+	}
+}
+
+// printApplicationsSyncResults prints the results of a bulk sync as a table
+func printApplicationsSyncResults(out io.Writer, results []*application.ApplicationsSyncResult) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "NAME\tPHASE\tMESSAGE\n")
+	for _, res := range results {
+		phase := res.GetPhase()
+		if phase == "" {
+			phase = "-"
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", res.GetAppNamespace()+"/"+res.GetName(), phase, res.GetMessage())
+	}
+	_ = w.Flush()
+}
+
+// countFailedSyncs returns the number of applications of a bulk sync which were not synced successfully
+func countFailedSyncs(results []*application.ApplicationsSyncResult) int {
+	failed := 0
+	for _, res := range results {
+		if res.GetPhase() != string(common.OperationSucceeded) {
+			failed++
+		}
+	}
+	return failed
+}
+
+// syncOperationResult is the machine-readable result of a sync operation printed by `argocd app sync -o json|yaml`
+type syncOperationResult struct {
+	Application string   `json:"application"`
+	Revision    string   `json:"revision,omitempty"`
+	Revisions   []string `json:"revisions,omitempty"`
+	// StartedAt is the time the operation was started, or accepted by the API server for asynchronous syncs
+	StartedAt      *metav1.Time              `json:"startedAt,omitempty"`
+	Duration       string                    `json:"duration,omitempty"`
+	OperationState *argoappv1.OperationState `json:"operationState,omitempty"`
+}
+
+// newSyncOperationResult returns the result of the completed sync operation of the application
+func newSyncOperationResult(appName string, opState *argoappv1.OperationState) *syncOperationResult {
+	res := &syncOperationResult{
+		Application:    appName,
+		StartedAt:      opState.StartedAt.DeepCopy(),
+		OperationState: opState,
+	}
+	switch {
+	case opState.SyncResult != nil:
+		res.Revision = opState.SyncResult.Revision
+		res.Revisions = opState.SyncResult.Revisions
+	case opState.Operation.Sync != nil:
+		res.Revision = opState.Operation.Sync.Revision
+		res.Revisions = opState.Operation.Sync.Revisions
+	}
+	if opState.FinishedAt != nil {
+		res.Duration = opState.FinishedAt.Sub(opState.StartedAt.Time).String()
+	}
+	return res
+}
+
+// newAcceptedSyncOperationResult returns the result of a sync operation which was accepted by the API server but
+// not waited for, so only the operation metadata is known
+func newAcceptedSyncOperationResult(app *argoappv1.Application, acceptedAt time.Time) *syncOperationResult {
+	res := &syncOperationResult{
+		Application: app.QualifiedName(),
+		StartedAt:   &metav1.Time{Time: acceptedAt.UTC().Truncate(time.Second)},
+	}
+	if app.Operation != nil && app.Operation.Sync != nil {
+		res.Revision = app.Operation.Sync.Revision
+		res.Revisions = app.Operation.Sync.Revisions
+	}
+	return res
+}
+
+// newRetryStrategy builds the retry strategy of a sync operation from the retry flags of the sync command.
+// A retry limit of 0 disables retries, in which case nil is returned.
+func newRetryStrategy(limit int64, backoffDuration, backoffMaxDuration time.Duration, backoffFactor int64) (*argoappv1.RetryStrategy, error) {
+	switch {
+	case limit == 0:
+		return nil, nil
+	case limit < -1:
+		return nil, fmt.Errorf("invalid retry limit %d: must be greater than or equal to -1", limit)
+	case backoffDuration <= 0:
+		return nil, fmt.Errorf("invalid retry backoff duration %s: must be greater than 0", backoffDuration)
+	case backoffMaxDuration < backoffDuration:
+		return nil, fmt.Errorf("invalid retry backoff max duration %s: must not be less than the backoff duration %s", backoffMaxDuration, backoffDuration)
+	case backoffFactor < 1:
+		return nil, fmt.Errorf("invalid retry backoff factor %d: must be greater than or equal to 1", backoffFactor)
+	}
+	return &argoappv1.RetryStrategy{
+		Limit: limit,
+		Backoff: &argoappv1.Backoff{
+			Duration:    backoffDuration.String(),
+			MaxDuration: backoffMaxDuration.String(),
+			Factor:      ptr.To(backoffFactor),
+		},
+	}, nil
+}
+
+// formatRetryAttempt returns a human readable description of the retry attempt of the given operation, or an
+// empty string if the operation has not been retried.
+func formatRetryAttempt(opState *argoappv1.OperationState) string {
+	if opState == nil || opState.RetryCount == 0 {
+		return ""
+	}
+	if opState.Operation.Retry.Limit < 0 {
+		return fmt.Sprintf("attempt %d", opState.RetryCount)
+	}
+	return fmt.Sprintf("attempt %d/%d", opState.RetryCount, opState.Operation.Retry.Limit)
+}
+
 func getAppNamesBySelector(ctx context.Context, appIf application.ApplicationServiceClient, selector string) ([]string, error) {
 	appNames := []string{}
 	if selector != "" {
@@ -2545,7 +3779,7 @@ func resourceParentChild(ctx context.Context, acdClient argocdclient.Client, app
 	_, appIf := acdClient.NewApplicationClientOrDie()
 	mapUIDToNode, mapParentToChild, parentNode := parentChildDetails(ctx, appIf, appName, appNs)
 	app, err := appIf.Get(ctx, &application.ApplicationQuery{Name: ptr.To(appName), AppNamespace: ptr.To(appNs)})
-	errors.CheckError(err)
+	errors.CheckErrorWithContext(ctx, err)
 	mapNodeNameToResourceState := make(map[string]*resourceState)
 	for _, res := range getResourceStates(app, nil) {
 		mapNodeNameToResourceState[res.Kind+"/"+res.Name] = res
@@ -2598,7 +3832,7 @@ func waitOnApplicationStatus(ctx context.Context, acdClient argocdclient.Client,
 
 	// printSummary controls whether we print the app summary table, OperationState, and ResourceState
 	// We don't want to print these when output type is json or yaml, as the output would become unparsable.
-	printSummary := output != "json" && output != "yaml"
+	printSummary := output != "json" && output != "yaml" && output != outputNone
 
 	appRealName, appNs := argo.ParseFromQualifiedName(appName, "")
 
@@ -2612,7 +3846,7 @@ func waitOnApplicationStatus(ctx context.Context, acdClient argocdclient.Client,
 				Refresh:      &refreshType,
 				AppNamespace: &appNs,
 			})
-			errors.CheckError(err)
+			errors.CheckErrorWithContext(ctx, err)
 			_ = conn.Close()
 		}
 
@@ -2628,7 +3862,7 @@ func waitOnApplicationStatus(ctx context.Context, acdClient argocdclient.Client,
 		switch output {
 		case "yaml", "json":
 			err := PrintResource(app, output)
-			errors.CheckError(err)
+			errors.CheckErrorWithContext(ctx, err)
 		case "wide", "":
 			if len(app.Status.Resources) > 0 {
 				fmt.Println()
@@ -2649,6 +3883,7 @@ func waitOnApplicationStatus(ctx context.Context, acdClient argocdclient.Client,
 				fmt.Println()
 				printTreeViewDetailed(mapUIDToNode, mapParentToChild, parentNode, mapNodeNameToResourceState)
 			}
+		case outputNone:
 		default:
 			errors.CheckError(fmt.Errorf("unknown output format: %s", output))
 		}
@@ -2671,7 +3906,7 @@ func waitOnApplicationStatus(ctx context.Context, acdClient argocdclient.Client,
 				Name:         &appRealName,
 				AppNamespace: &appNs,
 			})
-			errors.CheckError(err)
+			errors.CheckErrorWithContext(ctx, err)
 			// Update the app object
 			appWithLock.SetApp(app)
 			// Cancel the context to stop the watch
@@ -2690,13 +3925,14 @@ func waitOnApplicationStatus(ctx context.Context, acdClient argocdclient.Client,
 	}
 
 	prevStates := make(map[string]*resourceState)
+	var prevRetryCount int64
 	conn, appClient := acdClient.NewApplicationClientOrDie()
 	defer utilio.Close(conn)
 	app, err := appClient.Get(ctx, &application.ApplicationQuery{
 		Name:         &appRealName,
 		AppNamespace: &appNs,
 	})
-	errors.CheckError(err)
+	errors.CheckErrorWithContext(ctx, err)
 	appWithLock.SetApp(app) // Update the app object
 
 	// printFinalStatus() will refresh and update the app object, potentially causing the app's
@@ -2755,12 +3991,23 @@ func waitOnApplicationStatus(ctx context.Context, acdClient argocdclient.Client,
 			// Wait on the application as a whole
 			selectedResourcesAreReady = checkResourceStatus(watch, string(app.Status.Health.Status), string(app.Status.Sync.Status), appEvent.Application.Operation, hydrationFinished)
 		}
+		if selectedResourcesAreReady && watch.conditions != nil {
+			selectedResourcesAreReady = watch.conditions.met(app)
+		}
 
 		if selectedResourcesAreReady && (!operationInProgress || !watch.operation) {
 			app = printFinalStatus(app)
 			return app, finalOperationState, nil
 		}
 
+		if opState := app.Status.OperationState; watch.operation && opState != nil && opState.RetryCount > prevRetryCount {
+			prevRetryCount = opState.RetryCount
+			if printSummary {
+				_ = w.Flush()
+				fmt.Printf("Retrying operation (%s): %s\n", formatRetryAttempt(opState), opState.Message)
+			}
+		}
+
 		newStates := groupResourceStates(app, selectedResources)
 		for _, newState := range newStates {
 			var doPrint bool
@@ -2796,7 +4043,9 @@ func setParameterOverrides(app *argoappv1.Application, parameters []string, sour
 	var sourceType argoappv1.ApplicationSourceType
 	if st, _ := source.ExplicitType(); st != nil {
 		sourceType = *st
-	} else if app.Status.SourceType != "" {
+	} else if app.Spec.HasMultipleSources() && sourcePosition > 0 && sourcePosition <= len(app.Status.SourceTypes) {
+		sourceType = app.Status.SourceTypes[sourcePosition-1]
+	} else if !app.Spec.HasMultipleSources() && app.Status.SourceType != "" {
 		sourceType = app.Status.SourceType
 	} else if len(strings.SplitN(parameters[0], "=", 2)) == 2 {
 		sourceType = argoappv1.ApplicationSourceTypeHelm
@@ -2835,10 +4084,16 @@ func printApplicationHistoryTable(revHistory []argoappv1.RevisionHistory) {
 		id       int64
 		date     string
 		revision string
+		reason   string
 	}
 	varHistory := map[string][]history{}
 	varHistoryKeys := []string{}
+	// the reason column is only printed if at least one sync was initiated with a reason
+	hasReason := false
 	for _, depInfo := range revHistory {
+		if depInfo.Reason != "" {
+			hasReason = true
+		}
 		if depInfo.Sources != nil {
 			for i, sourceInfo := range depInfo.Sources {
 				rev := sourceInfo.TargetRevision
@@ -2852,6 +4107,7 @@ func printApplicationHistoryTable(revHistory []argoappv1.RevisionHistory) {
 					id:       depInfo.ID,
 					date:     depInfo.DeployedAt.String(),
 					revision: rev,
+					reason:   depInfo.Reason,
 				})
 			}
 		} else {
@@ -2866,14 +4122,23 @@ func printApplicationHistoryTable(revHistory []argoappv1.RevisionHistory) {
 				id:       depInfo.ID,
 				date:     depInfo.DeployedAt.String(),
 				revision: rev,
+				reason:   depInfo.Reason,
 			})
 		}
 	}
 	for i, key := range varHistoryKeys {
 		_, _ = fmt.Fprintf(w, "SOURCE\t%s\n", key)
-		_, _ = fmt.Fprintf(w, "ID\tDATE\tREVISION\n")
+		if hasReason {
+			_, _ = fmt.Fprintf(w, "ID\tDATE\tREVISION\tREASON\n")
+		} else {
+			_, _ = fmt.Fprintf(w, "ID\tDATE\tREVISION\n")
+		}
 		for _, history := range varHistory[key] {
-			_, _ = fmt.Fprintf(w, "%d\t%s\t%s\n", history.id, history.date, history.revision)
+			if hasReason {
+				_, _ = fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", history.id, history.date, history.revision, history.reason)
+			} else {
+				_, _ = fmt.Fprintf(w, "%d\t%s\t%s\n", history.id, history.date, history.revision)
+			}
 		}
 		// Add a newline if it's not the last iteration
 		if i < len(varHistoryKeys)-1 {
@@ -2890,8 +4155,9 @@ func NewApplicationHistoryCommand(clientOpts *argocdclient.ClientOptions) *cobra
 		appNamespace string
 	)
 	command := &cobra.Command{
-		Use:   "history APPNAME",
-		Short: "Show application deployment history",
+		Use:               "history APPNAME",
+		ValidArgsFunction: completeAppNames(clientOpts, 1),
+		Short:             "Show application deployment history",
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
@@ -2906,7 +4172,7 @@ func NewApplicationHistoryCommand(clientOpts *argocdclient.ClientOptions) *cobra
 				Name:         &appName,
 				AppNamespace: &appNs,
 			})
-			errors.CheckError(err)
+			errors.CheckErrorWithContext(ctx, err)
 
 			if output == "id" {
 				printApplicationHistoryIDs(app.Status.History)
@@ -2920,6 +4186,140 @@ func NewApplicationHistoryCommand(clientOpts *argocdclient.ClientOptions) *cobra
 	return command
 }
 
+// NewApplicationHistoryDiffCommand returns a new instance of an `argocd app history-diff` command
+func NewApplicationHistoryDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		fromID       int64
+		toID         int64
+		appNamespace string
+		exitCode     bool
+		diffExitCode int
+	)
+	command := &cobra.Command{
+		Use:               "history-diff APPNAME",
+		ValidArgsFunction: completeAppNames(clientOpts, 1),
+		Short:             "Show the difference between the manifests of two deployments of an application",
+		Long:              "Show the difference between the manifests of two deployments of an application. Both deployments are rendered by the repo server from the sources and revisions recorded in the application history.",
+		Example: templates.Examples(`
+  # Show what changed with the last deployment of the application "my-app"
+  argocd app history-diff my-app
+
+  # Show what changed between the deployments 42 and 43
+  argocd app history-diff my-app --from 42 --to 43
+`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer utilio.Close(conn)
+			appName, appNs := argo.ParseFromQualifiedName(args[0], appNamespace)
+			app, err := appIf.Get(ctx, &application.ApplicationQuery{
+				Name:         &appName,
+				AppNamespace: &appNs,
+			})
+			errors.CheckErrorWithContext(ctx, err)
+
+			from, to, err := findHistoryDiffRange(app, fromID, toID)
+			errors.CheckErrorWithContext(ctx, err)
+			foundDiffs := printHistoryManifestsDiff(ctx, appIf, appName, appNs, from.ID, to.ID)
+			if !foundDiffs {
+				fmt.Printf("The manifests of the deployments %d and %d do not differ\n", from.ID, to.ID)
+				return
+			}
+			if exitCode {
+				os.Exit(diffExitCode)
+			}
+		},
+	}
+	command.Flags().Int64Var(&fromID, "from", -1, "ID of the deployment to compare from. Defaults to the deployment preceding the one to compare to")
+	command.Flags().Int64Var(&toID, "to", -1, "ID of the deployment to compare to. Defaults to the last deployment")
+	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Namespace of the application")
+	command.Flags().BoolVar(&exitCode, "exit-code", true, "Return non-zero exit code when there is a diff. May also return non-zero exit code if there is an error.")
+	command.Flags().IntVar(&diffExitCode, "diff-exit-code", 1, "Return specified exit code when there is a diff. Typical error code is 20.")
+	return command
+}
+
+// printHistoryManifestsDiff prints the difference between the manifests of two deployments of an application and
+// returns whether the manifests differ
+func printHistoryManifestsDiff(ctx context.Context, appIf application.ApplicationServiceClient, appName, appNs string, fromID, toID int64) bool {
+	getObjs := func(id int64) map[kube.ResourceKey]*unstructured.Unstructured {
+		res, err := appIf.GetManifests(ctx, &application.ApplicationManifestQuery{
+			Name:         &appName,
+			AppNamespace: &appNs,
+			HistoryId:    ptr.To(id),
+		})
+		errors.CheckErrorWithContext(ctx, err)
+		objs := make(map[kube.ResourceKey]*unstructured.Unstructured)
+		for _, mfst := range res.Manifests {
+			obj, err := argoappv1.UnmarshalToUnstructured(mfst)
+			errors.CheckErrorWithContext(ctx, err)
+			objs[kube.GetResourceKey(obj)] = obj
+		}
+		return objs
+	}
+	fromObjs := getObjs(fromID)
+	toObjs := getObjs(toID)
+
+	foundDiffs := false
+	for _, key := range sortedResourceKeys(fromObjs, toObjs) {
+		fromObj, toObj := fromObjs[key], toObjs[key]
+		if fromObj != nil && toObj != nil && reflect.DeepEqual(fromObj.Object, toObj.Object) {
+			continue
+		}
+		foundDiffs = true
+		fmt.Printf("\n===== %s/%s %s/%s ======\n", key.Group, key.Kind, key.Namespace, key.Name)
+		_ = cli.PrintDiff(key.Name, fromObj, toObj)
+	}
+	return foundDiffs
+}
+
+// findHistoryDiffRange returns the history entries of the deployments to compare. A negative ID selects the default,
+// which is the last deployment to compare to and the deployment preceding it to compare from.
+func findHistoryDiffRange(app *argoappv1.Application, fromID, toID int64) (*argoappv1.RevisionHistory, *argoappv1.RevisionHistory, error) {
+	history := app.Status.History
+	if len(history) == 0 {
+		return nil, nil, fmt.Errorf("application '%s' has no deployments", app.Name)
+	}
+	toIndex := len(history) - 1
+	if toID >= 0 {
+		toIndex = slices.IndexFunc(history, func(h argoappv1.RevisionHistory) bool { return h.ID == toID })
+		if toIndex < 0 {
+			return nil, nil, fmt.Errorf("application '%s' does not have deployment id '%d' in history", app.Name, toID)
+		}
+	}
+	fromIndex := toIndex - 1
+	if fromID >= 0 {
+		fromIndex = slices.IndexFunc(history, func(h argoappv1.RevisionHistory) bool { return h.ID == fromID })
+		if fromIndex < 0 {
+			return nil, nil, fmt.Errorf("application '%s' does not have deployment id '%d' in history", app.Name, fromID)
+		}
+	}
+	if fromIndex < 0 {
+		return nil, nil, fmt.Errorf("application '%s' has no deployment preceding the one to compare to", app.Name)
+	}
+	return &history[fromIndex], &history[toIndex], nil
+}
+
+// sortedResourceKeys returns the keys of all given objects in a stable order
+func sortedResourceKeys(objs ...map[kube.ResourceKey]*unstructured.Unstructured) []kube.ResourceKey {
+	var keys []kube.ResourceKey
+	for _, m := range objs {
+		for key := range m {
+			if !slices.Contains(keys, key) {
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].String() < keys[j].String()
+	})
+	return keys
+}
+
 func findRevisionHistory(application *argoappv1.Application, historyId int64) (*argoappv1.RevisionHistory, error) {
 	// in case if history id not passed and need fetch previous history revision
 	if historyId == -1 {
@@ -2937,6 +4337,111 @@ func findRevisionHistory(application *argoappv1.Application, historyId int64) (*
 	return nil, fmt.Errorf("application '%s' does not have deployment id '%d' in history", application.Name, historyId)
 }
 
+// historyRevision returns the revision, or the comma separated revisions of a multi-source application, deployed by
+// the history entry
+func historyRevision(history argoappv1.RevisionHistory) string {
+	if len(history.Revisions) > 0 {
+		return strings.Join(history.Revisions, ", ")
+	}
+	return history.Revision
+}
+
+// findPreviousRevisionHistory returns the most recent history entry which deployed a revision different from the
+// currently deployed one
+func findPreviousRevisionHistory(application *argoappv1.Application) (*argoappv1.RevisionHistory, error) {
+	l := len(application.Status.History)
+	if l > 0 {
+		current := historyRevision(application.Status.History[l-1])
+		for i := l - 2; i >= 0; i-- {
+			if historyRevision(application.Status.History[i]) != current {
+				return &application.Status.History[i], nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("application '%s' has nothing to roll back to: its history has fewer than two distinct revisions", application.Name)
+}
+
+// findRevisionHistoryByRevision returns the most recent history entry which deployed the given revision. The revision
+// may be abbreviated to a prefix of at least 7 characters, as git commit SHAs commonly are.
+func findRevisionHistoryByRevision(application *argoappv1.Application, revision string) (*argoappv1.RevisionHistory, error) {
+	matches := func(deployed string) bool {
+		return deployed == revision || (len(revision) >= 7 && strings.HasPrefix(deployed, revision))
+	}
+	for i := len(application.Status.History) - 1; i >= 0; i-- {
+		history := application.Status.History[i]
+		if matches(history.Revision) || slices.ContainsFunc(history.Revisions, matches) {
+			return &application.Status.History[i], nil
+		}
+	}
+	return nil, fmt.Errorf("application '%s' has no deployment of revision '%s' in history", application.Name, revision)
+}
+
+// printRollbackCandidates prints the history entries of an application, most recent first, with the author and
+// message of the deployed revision if the repo server can provide them
+func printRollbackCandidates(ctx context.Context, appIf application.ApplicationServiceClient, app *argoappv1.Application) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "ID\tDATE\tREVISION\tAUTHOR\tMESSAGE\n")
+	for i := len(app.Status.History) - 1; i >= 0; i-- {
+		history := app.Status.History[i]
+		query := &application.RevisionMetadataQuery{
+			Name:         ptr.To(app.Name),
+			AppNamespace: ptr.To(app.Namespace),
+			Revision:     ptr.To(history.Revision),
+		}
+		if len(history.Revisions) > 0 {
+			query.Revision = ptr.To(history.Revisions[0])
+			query.SourceIndex = ptr.To(int32(0))
+			query.VersionId = ptr.To(int32(history.ID))
+		}
+		var author, message string
+		// the metadata is only available for git revisions, so it is omitted for any other source
+		if meta, err := appIf.RevisionMetadata(ctx, query); err == nil {
+			author = meta.Author
+			message, _, _ = strings.Cut(meta.Message, "\n")
+		}
+		_, _ = fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\n", history.ID, history.DeployedAt.Format(time.RFC3339), historyRevision(history), author, message)
+	}
+	_ = w.Flush()
+}
+
+// selectRollbackHistory interactively asks for the history entry to roll back to, after listing the history entries
+// of the application, and previews the difference to the current deployment before asking for confirmation. It returns
+// nil if the rollback is aborted.
+func selectRollbackHistory(ctx context.Context, appIf application.ApplicationServiceClient, app *argoappv1.Application) *argoappv1.RevisionHistory {
+	if len(app.Status.History) < 2 {
+		errors.Fatal(errors.ErrorGeneric, fmt.Sprintf("application '%s' should have at least two successful deployments", app.Name))
+	}
+	printRollbackCandidates(ctx, appIf, app)
+	current := app.Status.History[len(app.Status.History)-1]
+	for {
+		fmt.Println()
+		idStr := cli.PromptMessage("History ID to roll back to", "")
+		id, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			fmt.Printf("Invalid history ID '%s'\n", idStr)
+			continue
+		}
+		depInfo, err := findRevisionHistory(app, id)
+		if err != nil {
+			fmt.Println(err)
+			continue
+		}
+		if depInfo.ID == current.ID {
+			fmt.Printf("History ID %d is the current deployment\n", id)
+			continue
+		}
+		if !printHistoryManifestsDiff(ctx, appIf, app.Name, app.Namespace, current.ID, depInfo.ID) {
+			fmt.Printf("The manifests of the current deployment and the deployment %d do not differ\n", depInfo.ID)
+		}
+		fmt.Printf("\nRolling back application '%s' to history ID %d: revision %s deployed at %s\n",
+			app.QualifiedName(), depInfo.ID, historyRevision(*depInfo), depInfo.DeployedAt.Format(time.RFC3339))
+		if !cli.AskToProceed("Proceed (y/n)? ") {
+			return nil
+		}
+		return depInfo
+	}
+}
+
 // NewApplicationRollbackCommand returns a new instance of an `argocd app rollback` command
 func NewApplicationRollbackCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
@@ -2944,10 +4449,28 @@ func NewApplicationRollbackCommand(clientOpts *argocdclient.ClientOptions) *cobr
 		timeout      uint
 		output       string
 		appNamespace string
+		previous     bool
+		toRevision   string
+		interactive  bool
+		yes          bool
 	)
 	command := &cobra.Command{
-		Use:   "rollback APPNAME [ID]",
-		Short: "Rollback application to a previous deployed version by History ID, omitted will Rollback to the previous version",
+		Use:               "rollback APPNAME [ID]",
+		ValidArgsFunction: completeAppNames(clientOpts, 1),
+		Short:             "Rollback application to a previous deployed version by History ID, omitted will Rollback to the previous version",
+		Example: templates.Examples(`
+  # Roll back the application "my-app" to the deployment with history ID 42
+  argocd app rollback my-app 42
+
+  # Roll back to the most recent deployment of a revision different from the current one
+  argocd app rollback my-app --previous
+
+  # Roll back to the most recent deployment of a git commit, which may be abbreviated
+  argocd app rollback my-app --to-revision 4b825dc
+
+  # List the deployments with their authors, preview the difference and confirm before rolling back
+  argocd app rollback my-app --interactive
+`),
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 			if len(args) == 0 {
@@ -2955,11 +4478,20 @@ func NewApplicationRollbackCommand(clientOpts *argocdclient.ClientOptions) *cobr
 				os.Exit(1)
 			}
 			appName, appNs := argo.ParseFromQualifiedName(args[0], appNamespace)
+			selectors := 0
+			for _, set := range []bool{len(args) > 1, previous, toRevision != "", interactive} {
+				if set {
+					selectors++
+				}
+			}
+			if selectors > 1 {
+				errors.Fatal(errors.ErrorGeneric, "only one of a history ID, --previous, --to-revision and --interactive can be specified")
+			}
 			var err error
 			depID := -1
 			if len(args) > 1 {
 				depID, err = strconv.Atoi(args[1])
-				errors.CheckError(err)
+				errors.CheckErrorWithContext(ctx, err)
 			}
 			acdClient := headless.NewClientOrDie(clientOpts, c)
 			conn, appIf := acdClient.NewApplicationClientOrDie()
@@ -2968,10 +4500,33 @@ func NewApplicationRollbackCommand(clientOpts *argocdclient.ClientOptions) *cobr
 				Name:         &appName,
 				AppNamespace: &appNs,
 			})
-			errors.CheckError(err)
+			errors.CheckErrorWithContext(ctx, err)
 
-			depInfo, err := findRevisionHistory(app, int64(depID))
-			errors.CheckError(err)
+			var depInfo *argoappv1.RevisionHistory
+			switch {
+			case interactive:
+				depInfo = selectRollbackHistory(ctx, appIf, app)
+				if depInfo == nil {
+					fmt.Println("Aborted")
+					return
+				}
+			case previous || toRevision != "":
+				if previous {
+					depInfo, err = findPreviousRevisionHistory(app)
+				} else {
+					depInfo, err = findRevisionHistoryByRevision(app, toRevision)
+				}
+				errors.CheckErrorWithContext(ctx, err)
+				fmt.Printf("Rolling back application '%s' to history ID %d: revision %s deployed at %s\n",
+					app.QualifiedName(), depInfo.ID, historyRevision(*depInfo), depInfo.DeployedAt.Format(time.RFC3339))
+				if !yes && !cli.AskToProceed("Proceed (y/n)? ") {
+					fmt.Println("Aborted")
+					return
+				}
+			default:
+				depInfo, err = findRevisionHistory(app, int64(depID))
+				errors.CheckErrorWithContext(ctx, err)
+			}
 
 			_, err = appIf.Rollback(ctx, &application.ApplicationRollbackRequest{
 				Name:         &appName,
@@ -2979,18 +4534,22 @@ func NewApplicationRollbackCommand(clientOpts *argocdclient.ClientOptions) *cobr
 				Id:           ptr.To(depInfo.ID),
 				Prune:        ptr.To(prune),
 			})
-			errors.CheckError(err)
+			errors.CheckErrorWithContext(ctx, err)
 
 			_, _, err = waitOnApplicationStatus(ctx, acdClient, app.QualifiedName(), timeout, watchOpts{
 				operation: true,
 			}, nil, output)
-			errors.CheckError(err)
+			errors.CheckErrorWithContext(ctx, err)
 		},
 	}
 	command.Flags().BoolVar(&prune, "prune", false, "Allow deleting unexpected resources")
 	command.Flags().UintVar(&timeout, "timeout", defaultCheckTimeoutSeconds, "Time out after this many seconds")
 	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide|tree|tree=detailed")
 	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Rollback application in namespace")
+	command.Flags().BoolVar(&previous, "previous", false, "Rollback to the most recent deployment of a revision different from the currently deployed one")
+	command.Flags().StringVar(&toRevision, "to-revision", "", "Rollback to the most recent deployment of this revision, e.g. a git commit SHA of at least 7 characters")
+	command.Flags().BoolVarP(&interactive, "interactive", "i", false, "Select the deployment to roll back to from the history, and preview the difference before confirming")
+	command.Flags().BoolVarP(&yes, "yes", "y", false, "Skip explicit confirmation of --previous and --to-revision")
 	return command
 }
 
@@ -3036,10 +4595,13 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 		sourceNames     []string
 		local           string
 		localRepoRoot   string
+		pluginSocketDir string
+		pluginBinary    string
 	)
 	command := &cobra.Command{
-		Use:   "manifests APPNAME",
-		Short: "Print manifests of an application",
+		Use:               "manifests APPNAME",
+		ValidArgsFunction: completeAppNames(clientOpts, 1),
+		Short:             "Print manifests of an application",
 		Example: templates.Examples(`
   # Get manifests for an application
   argocd app manifests my-app
@@ -3052,6 +4614,12 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 
   # Get manifests for a multi-source application at specific revisions for specific sources
   argocd app manifests my-app --revisions 0.0.1 --source-positions 1 --revisions 0.0.2 --source-positions 2
+
+  # Get locally-generated manifests of a plugin application using the plugins of a locally running argocd-cmp-server
+  argocd app manifests my-app --local ./apps/my-app --local-plugin-socket-dir /tmp/argocd-plugins
+
+  # Get locally-generated manifests of a plugin application by running the plugin's generate command locally
+  argocd app manifests my-app --local ./apps/my-app --plugin-binary ./bin/my-plugin
   		`),
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
@@ -3079,6 +4647,13 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 				}
 			}
 
+			if (pluginSocketDir != "" || pluginBinary != "") && local == "" {
+				errors.Fatal(errors.ErrorGeneric, "--local-plugin-socket-dir and --plugin-binary can only be used together with --local")
+			}
+			if pluginSocketDir != "" && pluginBinary != "" {
+				errors.Fatal(errors.ErrorGeneric, "Only one of --local-plugin-socket-dir and --plugin-binary can be specified")
+			}
+
 			appName, appNs := argo.ParseFromQualifiedName(args[0], "")
 			clientset := headless.NewClientOrDie(clientOpts, c)
 			conn, appIf := clientset.NewApplicationClientOrDie()
@@ -3088,7 +4663,7 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 				Name:         &appName,
 				AppNamespace: &appNs,
 			})
-			errors.CheckError(err)
+			errors.CheckErrorWithContext(ctx, err)
 
 			if len(sourceNames) > 0 {
 				sourceNameToPosition := getSourceNameToPositionMap(app)
@@ -3106,7 +4681,7 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 				ApplicationName: &appName,
 				AppNamespace:    &appNs,
 			})
-			errors.CheckError(err)
+			errors.CheckErrorWithContext(ctx, err)
 
 			var unstructureds []*unstructured.Unstructured
 			switch source {
@@ -3116,12 +4691,21 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 					settingsConn, settingsIf := clientset.NewSettingsClientOrDie()
 					defer utilio.Close(settingsConn)
 					argoSettings, err := settingsIf.Get(context.Background(), &settings.SettingsQuery{})
-					errors.CheckError(err)
+					errors.CheckErrorWithContext(ctx, err)
 
 					clusterConn, clusterIf := clientset.NewClusterClientOrDie()
 					defer utilio.Close(clusterConn)
 					cluster, err := clusterIf.Get(context.Background(), &clusterpkg.ClusterQuery{Name: app.Spec.Destination.Name, Server: app.Spec.Destination.Server})
-					errors.CheckError(err)
+					errors.CheckErrorWithContext(ctx, err)
+
+					switch {
+					case pluginSocketDir != "":
+						errors.CheckError(os.Setenv(argocommon.EnvPluginSockFilePath, pluginSocketDir))
+					case pluginBinary != "":
+						stopPlugin, err := startLocalPlugin(app, pluginBinary)
+						errors.CheckError(err)
+						defer stopPlugin()
+					}
 
 					proj := getProject(ctx, c, clientOpts, app.Spec.Project)
 					//nolint:staticcheck
@@ -3135,11 +4719,11 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 						SourcePositions: sourcePositions,
 					}
 					res, err := appIf.GetManifests(ctx, &q)
-					errors.CheckError(err)
+					errors.CheckErrorWithContext(ctx, err)
 
 					for _, mfst := range res.Manifests {
 						obj, err := argoappv1.UnmarshalToUnstructured(mfst)
-						errors.CheckError(err)
+						errors.CheckErrorWithContext(ctx, err)
 						unstructureds = append(unstructureds, obj)
 					}
 				case revision != "":
@@ -3149,21 +4733,21 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 						Revision:     ptr.To(revision),
 					}
 					res, err := appIf.GetManifests(ctx, &q)
-					errors.CheckError(err)
+					errors.CheckErrorWithContext(ctx, err)
 
 					for _, mfst := range res.Manifests {
 						obj, err := argoappv1.UnmarshalToUnstructured(mfst)
-						errors.CheckError(err)
+						errors.CheckErrorWithContext(ctx, err)
 						unstructureds = append(unstructureds, obj)
 					}
 				default:
 					targetObjs, err := targetObjects(resources.Items)
-					errors.CheckError(err)
+					errors.CheckErrorWithContext(ctx, err)
 					unstructureds = targetObjs
 				}
 			case "live":
 				liveObjs, err := cmdutil.LiveObjects(resources.Items)
-				errors.CheckError(err)
+				errors.CheckErrorWithContext(ctx, err)
 				unstructureds = liveObjs
 			default:
 				log.Fatalf("Unknown source type '%s'", source)
@@ -3172,7 +4756,7 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 			for _, obj := range unstructureds {
 				fmt.Println("---")
 				yamlBytes, err := yaml.Marshal(obj)
-				errors.CheckError(err)
+				errors.CheckErrorWithContext(ctx, err)
 				fmt.Printf("%s\n", yamlBytes)
 			}
 		},
@@ -3184,14 +4768,17 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 	command.Flags().StringArrayVar(&sourceNames, "source-names", []string{}, "List of source names. Default is an empty array.")
 	command.Flags().StringVar(&local, "local", "", "If set, show locally-generated manifests. Value is the absolute path to app manifests within the manifest repo. Example: '/home/username/apps/env/app-1'.")
 	command.Flags().StringVar(&localRepoRoot, "local-repo-root", ".", "Path to the local repository root. Used together with --local allows setting the repository root. Example: '/home/username/apps'.")
+	command.Flags().StringVar(&pluginSocketDir, "local-plugin-socket-dir", "", "Directory containing the sockets of locally running config management plugin servers. Used together with --local to generate manifests of plugin applications.")
+	command.Flags().StringVar(&pluginBinary, "plugin-binary", "", "Path to a binary which generates the manifests of a plugin application. Used together with --local, it is run in the app directory with the environment a config management plugin sidecar would provide.")
 	return command
 }
 
 // NewApplicationTerminateOpCommand returns a new instance of an `argocd app terminate-op` command
 func NewApplicationTerminateOpCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command := &cobra.Command{
-		Use:   "terminate-op APPNAME",
-		Short: "Terminate running operation of an application",
+		Use:               "terminate-op APPNAME",
+		ValidArgsFunction: completeAppNames(clientOpts, 1),
+		Short:             "Terminate running operation of an application",
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
@@ -3206,7 +4793,7 @@ func NewApplicationTerminateOpCommand(clientOpts *argocdclient.ClientOptions) *c
 				Name:         &appName,
 				AppNamespace: &appNs,
 			})
-			errors.CheckError(err)
+			errors.CheckErrorWithContext(ctx, err)
 			fmt.Printf("Application '%s' operation terminating\n", appName)
 		},
 	}
@@ -3214,10 +4801,20 @@ func NewApplicationTerminateOpCommand(clientOpts *argocdclient.ClientOptions) *c
 }
 
 func NewApplicationEditCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
-	var appNamespace string
+	var (
+		appNamespace string
+		outputPatch  bool
+	)
 	command := &cobra.Command{
-		Use:   "edit APPNAME",
-		Short: "Edit application",
+		Use:               "edit APPNAME",
+		ValidArgsFunction: completeAppNames(clientOpts, 1),
+		Short:             "Edit application",
+		Long:              "Edit the spec of an application using the editor defined by the KUBE_EDITOR or EDITOR environment variables",
+		Example: `  # Edit the spec of an application
+  argocd app edit my-app
+
+  # Print the JSON patch resulting from the edit instead of applying it
+  argocd app edit my-app --output-patch`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
@@ -3233,22 +4830,45 @@ func NewApplicationEditCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				Name:         &appName,
 				AppNamespace: &appNs,
 			})
-			errors.CheckError(err)
+			errors.CheckErrorWithContext(ctx, err)
 
 			appData, err := json.Marshal(app.Spec)
-			errors.CheckError(err)
+			errors.CheckErrorWithContext(ctx, err)
 			appData, err = yaml.JSONToYAML(appData)
-			errors.CheckError(err)
+			errors.CheckErrorWithContext(ctx, err)
 
 			cli.InteractiveEdit(appName+"-*-edit.yaml", appData, func(input []byte) error {
-				input, err = yaml.YAMLToJSON(input)
+				updatedSpec, err := unmarshalApplicationSpec(input)
+				if err != nil {
+					return err
+				}
+
+				if outputPatch {
+					patch, err := getApplicationSpecPatch(&app.Spec, updatedSpec)
+					if err != nil {
+						return err
+					}
+					fmt.Println(string(patch))
+					return nil
+				}
+
+				err = printApplicationSpecDiff(appName, &app.Spec, updatedSpec)
 				if err != nil {
-					return fmt.Errorf("error converting YAML to JSON: %w", err)
+					return err
 				}
-				updatedSpec := argoappv1.ApplicationSpec{}
-				err = json.Unmarshal(input, &updatedSpec)
+
+				// refuse to overwrite changes which were made to the spec since it was fetched. The editor is re-opened
+				// with the edits, which are compared to the current spec once saved again.
+				current, err := appIf.Get(ctx, &application.ApplicationQuery{
+					Name:         &appName,
+					AppNamespace: &appNs,
+				})
 				if err != nil {
-					return fmt.Errorf("error unmarshaling input into application spec: %w", err)
+					return fmt.Errorf("failed to get application: %w", err)
+				}
+				if current.ResourceVersion != app.ResourceVersion && !reflect.DeepEqual(current.Spec, app.Spec) {
+					app = current
+					return fmt.Errorf("application '%s' was modified concurrently, save again to overwrite the changes", appName)
 				}
 
 				var appOpts cmdutil.AppOptions
@@ -3258,10 +4878,11 @@ func NewApplicationEditCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 					cmdutil.SetAppSpecOptions(c.Flags(), &app.Spec, &appOpts, 0)
 				}
 				_, err = appIf.UpdateSpec(ctx, &application.ApplicationUpdateSpecRequest{
-					Name:         &appName,
-					Spec:         &updatedSpec,
-					Validate:     &appOpts.Validate,
-					AppNamespace: &appNs,
+					Name:            &appName,
+					Spec:            updatedSpec,
+					Validate:        &appOpts.Validate,
+					AppNamespace:    &appNs,
+					ResourceVersion: &current.ResourceVersion,
 				})
 				if err != nil {
 					return fmt.Errorf("failed to update application spec: %w", err)
@@ -3271,9 +4892,72 @@ func NewApplicationEditCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 		},
 	}
 	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Only edit application in namespace")
+	command.Flags().BoolVar(&outputPatch, "output-patch", false, "Print the JSON patch (RFC 6902) of the edit instead of applying it")
 	return command
 }
 
+// unmarshalApplicationSpec converts the edited YAML into an application spec, rejecting fields which are not part
+// of the Application schema.
+func unmarshalApplicationSpec(input []byte) (*argoappv1.ApplicationSpec, error) {
+	spec := argoappv1.ApplicationSpec{}
+	if err := yaml.UnmarshalStrict(input, &spec); err != nil {
+		return nil, fmt.Errorf("error unmarshaling input into application spec: %w", err)
+	}
+	if spec.Destination.Server == "" && spec.Destination.Name == "" {
+		return nil, stderrors.New("application destination must specify either a server or a name")
+	}
+	if !spec.HasMultipleSources() && spec.Source == nil && spec.SourceHydrator == nil {
+		return nil, stderrors.New("application must specify a source, sources or a source hydrator")
+	}
+	return &spec, nil
+}
+
+// getApplicationSpecPatch returns the JSON patch (RFC 6902) which turns the original application spec into the
+// updated one. The patch is rooted at the application, so it can be passed to `argocd app patch --type json`.
+func getApplicationSpecPatch(original, updated *argoappv1.ApplicationSpec) ([]byte, error) {
+	originalData, err := json.Marshal(map[string]any{"spec": original})
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling original application spec: %w", err)
+	}
+	updatedData, err := json.Marshal(map[string]any{"spec": updated})
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling updated application spec: %w", err)
+	}
+	patch, err := jsonpatchv2.CreatePatch(originalData, updatedData)
+	if err != nil {
+		return nil, fmt.Errorf("error creating JSON patch: %w", err)
+	}
+	return json.Marshal(patch)
+}
+
+// printApplicationSpecDiff prints the difference between the original and the updated application spec
+func printApplicationSpecDiff(appName string, original, updated *argoappv1.ApplicationSpec) error {
+	originalObj, err := toUnstructuredSpec(original)
+	if err != nil {
+		return err
+	}
+	updatedObj, err := toUnstructuredSpec(updated)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("===== Changes to application %s =====\n", appName)
+	// diff exits with a non-zero code when there are differences, so its error is not relevant here
+	_ = cli.PrintDiff(appName, originalObj, updatedObj)
+	return nil
+}
+
+func toUnstructuredSpec(spec *argoappv1.ApplicationSpec) (*unstructured.Unstructured, error) {
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling application spec: %w", err)
+	}
+	obj := make(map[string]any)
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, fmt.Errorf("error unmarshaling application spec: %w", err)
+	}
+	return &unstructured.Unstructured{Object: obj}, nil
+}
+
 func NewApplicationPatchCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
 		patch        string
@@ -3282,8 +4966,9 @@ func NewApplicationPatchCommand(clientOpts *argocdclient.ClientOptions) *cobra.C
 	)
 
 	command := cobra.Command{
-		Use:   "patch APPNAME",
-		Short: "Patch application",
+		Use:               "patch APPNAME",
+		ValidArgsFunction: completeAppNames(clientOpts, 1),
+		Short:             "Patch application",
 		Example: `  # Update an application's source path using json patch
   argocd app patch myapplication --patch='[{"op": "replace", "path": "/spec/source/path", "value": "newPath"}]' --type json
 
@@ -3306,10 +4991,10 @@ func NewApplicationPatchCommand(clientOpts *argocdclient.ClientOptions) *cobra.C
 				PatchType:    &patchType,
 				AppNamespace: &appNs,
 			})
-			errors.CheckError(err)
+			errors.CheckErrorWithContext(ctx, err)
 
 			yamlBytes, err := yaml.Marshal(patchedApp)
-			errors.CheckError(err)
+			errors.CheckErrorWithContext(ctx, err)
 
 			fmt.Println(string(yamlBytes))
 		},
@@ -3327,8 +5012,9 @@ func NewApplicationAddSourceCommand(clientOpts *argocdclient.ClientOptions) *cob
 		appNamespace string
 	)
 	command := &cobra.Command{
-		Use:   "add-source APPNAME",
-		Short: "Adds a source to the list of sources in the application",
+		Use:               "add-source APPNAME",
+		ValidArgsFunction: completeAppNames(clientOpts, 1),
+		Short:             "Adds a source to the list of sources in the application",
 		Example: `  # Append a source to the list of sources in the application
   argocd app add-source guestbook --repo https://github.com/argoproj/argocd-example-apps.git --path guestbook --source-name guestbook`,
 		Run: func(c *cobra.Command, args []string) {
@@ -3350,7 +5036,7 @@ func NewApplicationAddSourceCommand(clientOpts *argocdclient.ClientOptions) *cob
 				AppNamespace: &appNs,
 			})
 
-			errors.CheckError(err)
+			errors.CheckErrorWithContext(ctx, err)
 
 			if c.Flags() == nil {
 				errors.Fatal(errors.ErrorGeneric, "ApplicationSource needs atleast repoUrl, path or chart or ref field. No source to add.")
@@ -3371,7 +5057,7 @@ func NewApplicationAddSourceCommand(clientOpts *argocdclient.ClientOptions) *cob
 					Validate:     &appOpts.Validate,
 					AppNamespace: &appNs,
 				})
-				errors.CheckError(err)
+				errors.CheckErrorWithContext(ctx, err)
 
 				fmt.Printf("Application '%s' updated successfully\n", app.Name)
 			} else {
@@ -3392,8 +5078,9 @@ func NewApplicationRemoveSourceCommand(clientOpts *argocdclient.ClientOptions) *
 		appNamespace   string
 	)
 	command := &cobra.Command{
-		Use:   "remove-source APPNAME",
-		Short: "Remove a source from multiple sources application.",
+		Use:               "remove-source APPNAME",
+		ValidArgsFunction: completeAppNames(clientOpts, 1),
+		Short:             "Remove a source from multiple sources application.",
 		Example: `  # Remove the source at position 1 from application's sources. Counting starts at 1.
   argocd app remove-source myapplication --source-position 1
   
@@ -3422,7 +5109,7 @@ func NewApplicationRemoveSourceCommand(clientOpts *argocdclient.ClientOptions) *
 				Refresh:      getRefreshType(false, false),
 				AppNamespace: &appNs,
 			})
-			errors.CheckError(err)
+			errors.CheckErrorWithContext(ctx, err)
 
 			if sourceName != "" && sourcePosition != -1 {
 				errors.Fatal(errors.ErrorGeneric, "Only one of source-position and source-name can be specified.")
@@ -3459,7 +5146,7 @@ func NewApplicationRemoveSourceCommand(clientOpts *argocdclient.ClientOptions) *
 					Spec:         &app.Spec,
 					AppNamespace: &appNs,
 				})
-				errors.CheckError(err)
+				errors.CheckErrorWithContext(ctx, err)
 
 				fmt.Printf("Application '%s' updated successfully\n", app.Name)
 			} else {
@@ -3476,8 +5163,9 @@ func NewApplicationRemoveSourceCommand(clientOpts *argocdclient.ClientOptions) *
 func NewApplicationConfirmDeletionCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var appNamespace string
 	command := &cobra.Command{
-		Use:   "confirm-deletion APPNAME",
-		Short: "Confirms deletion/pruning of an application resources",
+		Use:               "confirm-deletion APPNAME",
+		ValidArgsFunction: completeAppNames(clientOpts, 1),
+		Short:             "Confirms deletion/pruning of an application resources",
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
@@ -3497,7 +5185,7 @@ func NewApplicationConfirmDeletionCommand(clientOpts *argocdclient.ClientOptions
 				Refresh:      getRefreshType(false, false),
 				AppNamespace: &appNs,
 			})
-			errors.CheckError(err)
+			errors.CheckErrorWithContext(ctx, err)
 
 			annotations := app.Annotations
 			if annotations == nil {
@@ -3511,7 +5199,7 @@ func NewApplicationConfirmDeletionCommand(clientOpts *argocdclient.ClientOptions
 				Validate:    ptr.To(false),
 				Project:     &app.Spec.Project,
 			})
-			errors.CheckError(err)
+			errors.CheckErrorWithContext(ctx, err)
 
 			fmt.Printf("Application '%s' updated successfully\n", app.Name)
 		},
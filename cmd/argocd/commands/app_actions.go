@@ -67,8 +67,9 @@ func NewApplicationResourceActionsListCommand(clientOpts *argocdclient.ClientOpt
 	var resourceName string
 	var output string
 	command := &cobra.Command{
-		Use:   "list APPNAME",
-		Short: "Lists available actions on a resource",
+		Use:               "list APPNAME",
+		ValidArgsFunction: completeAppNames(clientOpts, 1),
+		Short:             "Lists available actions on a resource",
 		Example: templates.Examples(`
 	# List all the available actions for an application
 	argocd app actions list APPNAME
@@ -149,9 +150,10 @@ func NewApplicationResourceActionsRunCommand(clientOpts *argocdclient.ClientOpti
 	var group string
 	var all bool
 	command := &cobra.Command{
-		Use:   "run APPNAME ACTION",
-		Short: "Runs an available action on resource(s) matching the specified filters.",
-		Long:  "All filters except --kind are optional. Use --all to run the action on all matching resources if more than one resource matches the filters. Actions may only be run on resources that are represented in git and cannot be run on child resources.",
+		Use:               "run APPNAME ACTION",
+		ValidArgsFunction: completeAppNames(clientOpts, 1),
+		Short:             "Runs an available action on resource(s) matching the specified filters.",
+		Long:              "All filters except --kind are optional. Use --all to run the action on all matching resources if more than one resource matches the filters. Actions may only be run on resources that are represented in git and cannot be run on child resources.",
 		Example: templates.Examples(`
 	# Run an available action for an application
 	argocd app actions run APPNAME ACTION --kind KIND [--resource-name RESOURCE] [--namespace NAMESPACE] [--group GROUP]
@@ -0,0 +1,217 @@
+package commands
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+
+	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
+	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	applicationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	argoappv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/errors"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/templates"
+)
+
+// eventsReconnectDelay is the delay before reconnecting to the events stream after the connection was lost
+var eventsReconnectDelay = 2 * time.Second
+
+// NewApplicationEventsCommand returns a new instance of an `argocd app events` command
+func NewApplicationEventsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		appNamespace      string
+		group             string
+		kind              string
+		resourceNamespace string
+		resourceName      string
+		output            string
+		watch             bool
+	)
+	command := &cobra.Command{
+		Use:               "events APPNAME",
+		ValidArgsFunction: completeAppNames(clientOpts, 1),
+		Short:             "List the events of an application or of one of its resources",
+		Example: templates.Examples(`
+  # List the events of the application "my-app"
+  argocd app events my-app
+
+  # List the events of a deployment of the application "my-app"
+  argocd app events my-app --kind Deployment --name guestbook-ui --namespace default
+
+  # Watch the events of the application "my-app" and print every event as a JSON object on its own line
+  argocd app events my-app --watch -o json
+`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			if output != "wide" && output != "json" && output != "yaml" {
+				errors.Fatal(errors.ErrorGeneric, fmt.Sprintf("unknown output format: %s", output))
+			}
+			if watch && output == "yaml" {
+				errors.Fatal(errors.ErrorGeneric, "--watch can only be used with the wide or json output format")
+			}
+			appName, appNs := argo.ParseFromQualifiedName(args[0], appNamespace)
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer utilio.Close(conn)
+
+			q := &applicationpkg.ApplicationResourceEventsQuery{
+				Name:         &appName,
+				AppNamespace: &appNs,
+			}
+			if resourceName != "" {
+				tree, err := appIf.ResourceTree(ctx, &applicationpkg.ResourcesQuery{
+					ApplicationName: &appName,
+					AppNamespace:    &appNs,
+				})
+				errors.CheckErrorWithContext(ctx, err)
+				node, err := findEventsResourceNode(tree, group, kind, resourceNamespace, resourceName)
+				errors.CheckError(err)
+				q.ResourceName = &node.Name
+				q.ResourceNamespace = &node.Namespace
+				q.ResourceUID = &node.UID
+			}
+
+			if !watch {
+				list, err := appIf.ListResourceEvents(ctx, q)
+				errors.CheckErrorWithContext(ctx, err)
+				sortEvents(list.Items)
+				if output == "wide" {
+					printEventsTable(os.Stdout, list.Items)
+					return
+				}
+				err = PrintResourceList(list.Items, output, false)
+				errors.CheckError(err)
+				return
+			}
+
+			// seen holds the last printed resource version of every event, so that the events which are received
+			// again after reconnecting are skipped
+			seen := make(map[string]string)
+			w := tabwriter.NewWriter(os.Stdout, 10, 0, 2, ' ', 0)
+			if output == "wide" {
+				printEventsTableHeader(w)
+			}
+			for {
+				stream, err := appIf.WatchResourceEvents(ctx, q)
+				errors.CheckErrorWithContext(ctx, err)
+				for {
+					event, err := stream.Recv()
+					if err != nil {
+						if stderrors.Is(err, io.EOF) || status.Code(err) == codes.Unavailable {
+							break
+						}
+						errors.CheckErrorWithContext(ctx, err)
+					}
+					if seen[string(event.UID)] == event.ResourceVersion {
+						continue
+					}
+					seen[string(event.UID)] = event.ResourceVersion
+					if output == "json" {
+						data, err := json.Marshal(event)
+						errors.CheckError(err)
+						fmt.Println(string(data))
+						continue
+					}
+					printEventRow(w, event)
+					_ = w.Flush()
+				}
+				log.Debugf("Reconnecting to the events stream of application %s", appName)
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(eventsReconnectDelay):
+				}
+			}
+		},
+	}
+	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Namespace of the application")
+	command.Flags().StringVar(&group, "group", "", "Group of the resource")
+	command.Flags().StringVar(&kind, "kind", "", "Kind of the resource")
+	command.Flags().StringVar(&resourceNamespace, "namespace", "", "Namespace of the resource")
+	command.Flags().StringVar(&resourceName, "name", "", "Name of the resource. If not set, the events of the application are listed")
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: wide|json|yaml")
+	command.Flags().BoolVarP(&watch, "watch", "w", false, "Watch the events and print them as they are created or updated. Events are printed as one JSON object per line with the json output format")
+	return command
+}
+
+// findEventsResourceNode returns the node of the resource tree of an application which matches the given resource.
+// The group, kind and namespace are only compared if they are set.
+func findEventsResourceNode(tree *argoappv1.ApplicationTree, group, kind, namespace, name string) (*argoappv1.ResourceNode, error) {
+	var found []argoappv1.ResourceNode
+	for _, node := range append(tree.Nodes, tree.OrphanedNodes...) {
+		if node.Name != name ||
+			(group != "" && node.Group != group) ||
+			(kind != "" && node.Kind != kind) ||
+			(namespace != "" && node.Namespace != namespace) {
+			continue
+		}
+		found = append(found, node)
+	}
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("resource %s not found in the application", name)
+	case 1:
+		return &found[0], nil
+	default:
+		return nil, fmt.Errorf("resource name %s is ambiguous, use --group, --kind and --namespace to select one of %d resources", name, len(found))
+	}
+}
+
+// eventTime returns the time an event was last seen
+func eventTime(event *corev1.Event) time.Time {
+	switch {
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp.Time
+	case !event.EventTime.IsZero():
+		return event.EventTime.Time
+	default:
+		return event.CreationTimestamp.Time
+	}
+}
+
+// sortEvents sorts the events by the time they were last seen, oldest first
+func sortEvents(events []corev1.Event) {
+	sort.SliceStable(events, func(i, j int) bool {
+		return eventTime(&events[i]).Before(eventTime(&events[j]))
+	})
+}
+
+// printEventsTable prints the events like kubectl get events does
+func printEventsTable(out io.Writer, events []corev1.Event) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	printEventsTableHeader(w)
+	for i := range events {
+		printEventRow(w, &events[i])
+	}
+	_ = w.Flush()
+}
+
+func printEventsTableHeader(w io.Writer) {
+	_, _ = fmt.Fprintf(w, "LAST SEEN\tTYPE\tREASON\tOBJECT\tMESSAGE\n")
+}
+
+func printEventRow(w io.Writer, event *corev1.Event) {
+	lastSeen := "<unknown>"
+	if t := eventTime(event); !t.IsZero() {
+		lastSeen = duration.HumanDuration(time.Since(t))
+	}
+	_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s/%s\t%s\n", lastSeen, event.Type, event.Reason, event.InvolvedObject.Kind, event.InvolvedObject.Name, event.Message)
+}
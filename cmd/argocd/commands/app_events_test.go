@@ -0,0 +1,71 @@
+package commands
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestFindEventsResourceNode(t *testing.T) {
+	tree := &v1alpha1.ApplicationTree{
+		Nodes: []v1alpha1.ResourceNode{
+			{ResourceRef: v1alpha1.ResourceRef{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook", UID: "1"}},
+			{ResourceRef: v1alpha1.ResourceRef{Kind: "Service", Namespace: "default", Name: "guestbook", UID: "2"}},
+		},
+		OrphanedNodes: []v1alpha1.ResourceNode{
+			{ResourceRef: v1alpha1.ResourceRef{Kind: "ConfigMap", Namespace: "default", Name: "orphan", UID: "3"}},
+		},
+	}
+
+	node, err := findEventsResourceNode(tree, "", "Service", "", "guestbook")
+	require.NoError(t, err)
+	assert.Equal(t, "2", node.UID)
+	node, err = findEventsResourceNode(tree, "apps", "", "default", "guestbook")
+	require.NoError(t, err)
+	assert.Equal(t, "1", node.UID)
+	node, err = findEventsResourceNode(tree, "", "", "", "orphan")
+	require.NoError(t, err)
+	assert.Equal(t, "3", node.UID)
+
+	_, err = findEventsResourceNode(tree, "", "", "", "guestbook")
+	require.ErrorContains(t, err, "ambiguous")
+	_, err = findEventsResourceNode(tree, "", "", "", "missing")
+	require.ErrorContains(t, err, "not found")
+}
+
+func TestPrintEventsTable(t *testing.T) {
+	now := time.Now()
+	events := []corev1.Event{
+		{
+			InvolvedObject: corev1.ObjectReference{Kind: "Application", Name: "my-app"},
+			Type:           corev1.EventTypeNormal,
+			Reason:         "OperationCompleted",
+			Message:        "Sync operation to 1a2b3c succeeded",
+			LastTimestamp:  metav1.NewTime(now.Add(-time.Minute)),
+		},
+		{
+			InvolvedObject: corev1.ObjectReference{Kind: "Application", Name: "my-app"},
+			Type:           corev1.EventTypeNormal,
+			Reason:         "OperationStarted",
+			Message:        "Initiated automated sync to 1a2b3c",
+			EventTime:      metav1.NewMicroTime(now.Add(-2 * time.Minute)),
+		},
+	}
+
+	sortEvents(events)
+	assert.Equal(t, "OperationStarted", events[0].Reason)
+
+	var out bytes.Buffer
+	printEventsTable(&out, events)
+	assert.Equal(t, `LAST SEEN  TYPE    REASON              OBJECT              MESSAGE
+2m         Normal  OperationStarted    Application/my-app  Initiated automated sync to 1a2b3c
+60s        Normal  OperationCompleted  Application/my-app  Sync operation to 1a2b3c succeeded
+`, out.String())
+}
@@ -0,0 +1,252 @@
+package commands
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/gorilla/websocket"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/remotecommand"
+	"k8s.io/kubectl/pkg/util/term"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
+	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	applicationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	appserver "github.com/argoproj/argo-cd/v3/server/application"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/errors"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/templates"
+)
+
+// defaultContainerAnnotation is the annotation kubectl uses to select the default container of a pod
+const defaultContainerAnnotation = "kubectl.kubernetes.io/default-container"
+
+// NewApplicationExecCommand returns a new instance of an `argocd app exec` command
+func NewApplicationExecCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		pod       string
+		namespace string
+		container string
+		shell     string
+	)
+	command := &cobra.Command{
+		Use:               "exec APPNAME [-- COMMAND [ARGS...]]",
+		ValidArgsFunction: completeAppNames(clientOpts, 1),
+		Short:             "Execute a shell or command in a pod of an application",
+		Long:              "Execute a shell or command in a pod of an application. This requires the exec feature to be enabled and the 'exec, create' permission for the application.",
+		Example: templates.Examples(`
+  # Open a shell in the first running pod of the application "my-app"
+  argocd app exec my-app
+
+  # Open a bash shell in the container "web" of a specific pod
+  argocd app exec my-app --pod my-app-7d9f8b6c5-x2x4q -c web --shell bash
+
+  # Run a command in a pod of the application
+  argocd app exec my-app --pod my-app-7d9f8b6c5-x2x4q -- ls -l /tmp
+`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) == 0 || len(args) > 1 && c.ArgsLenAtDash() != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName, appNs := argo.ParseFromQualifiedName(args[0], "")
+			execCommand := args[1:]
+
+			acdClient := headless.NewClientOrDie(clientOpts, c)
+			conn, appIf := acdClient.NewApplicationClientOrDie()
+			defer utilio.Close(conn)
+
+			app, err := appIf.Get(ctx, &applicationpkg.ApplicationQuery{Name: &appName, AppNamespace: &appNs})
+			errors.CheckError(err)
+			tree, err := appIf.ResourceTree(ctx, &applicationpkg.ResourcesQuery{ApplicationName: &appName, AppNamespace: &appNs})
+			errors.CheckError(err)
+			podNode, err := findExecPod(tree, pod, namespace)
+			errors.CheckError(err)
+
+			if container == "" {
+				res, err := appIf.GetResource(ctx, &applicationpkg.ApplicationResourceRequest{
+					Name:         &appName,
+					AppNamespace: &appNs,
+					Namespace:    ptr.To(podNode.Namespace),
+					ResourceName: ptr.To(podNode.Name),
+					Version:      ptr.To("v1"),
+					Group:        ptr.To(""),
+					Kind:         ptr.To(kube.PodKind),
+				})
+				errors.CheckError(err)
+				container, err = defaultExecContainer(res.GetManifest())
+				errors.CheckError(err)
+			}
+
+			query := url.Values{
+				"pod":          {podNode.Name},
+				"container":    {container},
+				"appName":      {app.Name},
+				"appNamespace": {app.Namespace},
+				"projectName":  {app.Spec.Project},
+				"namespace":    {podNode.Namespace},
+			}
+			if shell != "" {
+				query.Set("shell", shell)
+			}
+			if len(execCommand) > 0 {
+				query["command"] = execCommand
+			}
+			wsConn, err := acdClient.NewTerminalConn(ctx, query)
+			errors.CheckError(err)
+			defer utilio.Close(wsConn)
+
+			tty := term.TTY{In: os.Stdin, Out: os.Stdout}
+			tty.Raw = tty.IsTerminalIn()
+			sizeQueue := tty.MonitorSize(tty.GetSize())
+			var exitCode int
+			err = tty.Safe(func() error {
+				var err error
+				exitCode, err = streamTerminal(wsConn, os.Stdin, os.Stdout, sizeQueue)
+				return err
+			})
+			errors.CheckError(err)
+			if exitCode != 0 {
+				os.Exit(exitCode)
+			}
+		},
+	}
+	command.Flags().StringVar(&pod, "pod", "", "Name of the pod. Defaults to the first running pod of the application")
+	command.Flags().StringVar(&namespace, "namespace", "", "Namespace of the pod")
+	command.Flags().StringVarP(&container, "container", "c", "", "Name of the container. Defaults to the container selected by the kubectl.kubernetes.io/default-container annotation or the first container of the pod")
+	command.Flags().StringVar(&shell, "shell", "", "Shell to open, if no command is given. Must be one of the shells allowed by the server, which are tried in order by default")
+	return command
+}
+
+// findExecPod returns the pod of the application resource tree matching the given name and namespace. Without a name,
+// the first running pod is returned.
+func findExecPod(tree *v1alpha1.ApplicationTree, name, namespace string) (*v1alpha1.ResourceNode, error) {
+	var pods []v1alpha1.ResourceNode
+	for _, node := range tree.Nodes {
+		if node.Kind != kube.PodKind || node.Group != "" || node.UID == "" {
+			continue
+		}
+		if name != "" && node.Name != name || namespace != "" && node.Namespace != namespace {
+			continue
+		}
+		pods = append(pods, node)
+	}
+	if name != "" {
+		switch len(pods) {
+		case 0:
+			return nil, fmt.Errorf("pod %s does not belong to the application", name)
+		case 1:
+			return &pods[0], nil
+		default:
+			return nil, fmt.Errorf("pod %s exists in several namespaces, please specify the namespace", name)
+		}
+	}
+	sort.Slice(pods, func(i, j int) bool {
+		if pods[i].Namespace != pods[j].Namespace {
+			return pods[i].Namespace < pods[j].Namespace
+		}
+		return pods[i].Name < pods[j].Name
+	})
+	for i := range pods {
+		for _, info := range pods[i].Info {
+			if info.Name == "Status Reason" && info.Value == string(corev1.PodRunning) {
+				return &pods[i], nil
+			}
+		}
+	}
+	return nil, stderrors.New("the application has no running pods")
+}
+
+// defaultExecContainer returns the name of the container to execute a command in, if no container was given
+func defaultExecContainer(manifest string) (string, error) {
+	var pod corev1.Pod
+	if err := yaml.Unmarshal([]byte(manifest), &pod); err != nil {
+		return "", fmt.Errorf("failed to unmarshal pod: %w", err)
+	}
+	if name := pod.Annotations[defaultContainerAnnotation]; name != "" {
+		return name, nil
+	}
+	if len(pod.Spec.Containers) == 0 {
+		return "", fmt.Errorf("pod %s has no containers", pod.Name)
+	}
+	return pod.Spec.Containers[0].Name, nil
+}
+
+// streamTerminal connects the input, output and terminal size changes to the terminal session of the given WebSocket
+// connection, until the session is closed by the server. The exit code of the executed command is returned.
+func streamTerminal(conn *websocket.Conn, in io.Reader, out io.Writer, sizeQueue remotecommand.TerminalSizeQueue) (int, error) {
+	var writeLock sync.Mutex
+	send := func(msg appserver.TerminalMessage) error {
+		writeLock.Lock()
+		defer writeLock.Unlock()
+		return conn.WriteJSON(msg)
+	}
+
+	if sizeQueue != nil {
+		go func() {
+			for size := sizeQueue.Next(); size != nil; size = sizeQueue.Next() {
+				if err := send(appserver.TerminalMessage{Operation: "resize", Cols: size.Width, Rows: size.Height}); err != nil {
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		buf := make([]byte, 1024)
+		for {
+			n, err := in.Read(buf)
+			if n > 0 {
+				if err := send(appserver.TerminalMessage{Operation: "stdin", Data: string(buf[:n])}); err != nil {
+					return
+				}
+			}
+			if err != nil {
+				// signal the end of the input to the process, which is attached to a terminal
+				_ = send(appserver.TerminalMessage{Operation: "stdin", Data: appserver.EndOfTransmission})
+				return
+			}
+		}
+	}()
+
+	exitCode := 0
+	for {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			var closeErr *websocket.CloseError
+			if stderrors.As(err, &closeErr) {
+				return exitCode, nil
+			}
+			return 0, err
+		}
+		var cmd appserver.TerminalCommand
+		if err := json.Unmarshal(data, &cmd); err == nil && cmd.Code == appserver.ReconnectCode {
+			return 0, stderrors.New("the terminal session was closed, because the auth token was refreshed or is no longer valid")
+		}
+		var msg appserver.TerminalMessage
+		if err := json.Unmarshal(data, &msg); err != nil {
+			return 0, fmt.Errorf("failed to parse terminal message: %w", err)
+		}
+		switch msg.Operation {
+		case "stdout":
+			if _, err := io.WriteString(out, msg.Data); err != nil {
+				return 0, err
+			}
+		case "exit":
+			exitCode = msg.ExitCode
+		}
+	}
+}
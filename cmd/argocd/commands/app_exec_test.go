@@ -0,0 +1,110 @@
+package commands
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	appserver "github.com/argoproj/argo-cd/v3/server/application"
+)
+
+func newPodNode(namespace, name, statusReason string) v1alpha1.ResourceNode {
+	return v1alpha1.ResourceNode{
+		ResourceRef: v1alpha1.ResourceRef{Version: "v1", Kind: "Pod", Namespace: namespace, Name: name, UID: name},
+		Info:        []v1alpha1.InfoItem{{Name: "Status Reason", Value: statusReason}},
+	}
+}
+
+func TestFindExecPod(t *testing.T) {
+	tree := &v1alpha1.ApplicationTree{Nodes: []v1alpha1.ResourceNode{
+		{ResourceRef: v1alpha1.ResourceRef{Group: "apps", Version: "v1", Kind: "Deployment", Namespace: "default", Name: "web", UID: "web"}},
+		newPodNode("default", "web-2", "Running"),
+		newPodNode("default", "web-1", "ContainerCreating"),
+		newPodNode("default", "web-3", "Running"),
+		newPodNode("other", "web-3", "Running"),
+	}}
+
+	t.Run("FirstRunningPod", func(t *testing.T) {
+		pod, err := findExecPod(tree, "", "")
+		require.NoError(t, err)
+		assert.Equal(t, "web-2", pod.Name)
+	})
+	t.Run("Name", func(t *testing.T) {
+		pod, err := findExecPod(tree, "web-1", "")
+		require.NoError(t, err)
+		assert.Equal(t, "web-1", pod.Name)
+	})
+	t.Run("NameAndNamespace", func(t *testing.T) {
+		pod, err := findExecPod(tree, "web-3", "other")
+		require.NoError(t, err)
+		assert.Equal(t, "other", pod.Namespace)
+	})
+	t.Run("AmbiguousName", func(t *testing.T) {
+		_, err := findExecPod(tree, "web-3", "")
+		assert.ErrorContains(t, err, "several namespaces")
+	})
+	t.Run("UnknownPod", func(t *testing.T) {
+		_, err := findExecPod(tree, "web", "")
+		assert.ErrorContains(t, err, "does not belong to the application")
+	})
+	t.Run("NoRunningPod", func(t *testing.T) {
+		_, err := findExecPod(tree, "", "missing")
+		assert.ErrorContains(t, err, "no running pods")
+	})
+}
+
+func TestDefaultExecContainer(t *testing.T) {
+	container, err := defaultExecContainer(`{"metadata":{"name":"web"},"spec":{"containers":[{"name":"app"},{"name":"sidecar"}]}}`)
+	require.NoError(t, err)
+	assert.Equal(t, "app", container)
+
+	container, err = defaultExecContainer(`{"metadata":{"name":"web","annotations":{"kubectl.kubernetes.io/default-container":"sidecar"}},"spec":{"containers":[{"name":"app"},{"name":"sidecar"}]}}`)
+	require.NoError(t, err)
+	assert.Equal(t, "sidecar", container)
+
+	_, err = defaultExecContainer(`{"metadata":{"name":"web"}}`)
+	assert.ErrorContains(t, err, "has no containers")
+}
+
+func TestStreamTerminal(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		// echo the input in upper case until the end of the input
+		for {
+			var msg appserver.TerminalMessage
+			if err := conn.ReadJSON(&msg); err != nil {
+				return
+			}
+			if msg.Data == appserver.EndOfTransmission {
+				_ = conn.WriteJSON(appserver.TerminalMessage{Operation: "exit", ExitCode: 3})
+				return
+			}
+			if err := conn.WriteJSON(appserver.TerminalMessage{Operation: "stdout", Data: strings.ToUpper(msg.Data)}); err != nil {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	var out bytes.Buffer
+	exitCode, err := streamTerminal(conn, strings.NewReader("hello"), &out, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "HELLO", out.String())
+	assert.Equal(t, 3, exitCode)
+}
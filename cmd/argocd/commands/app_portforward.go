@@ -0,0 +1,216 @@
+package commands
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
+	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	applicationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/errors"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/templates"
+)
+
+// portMapping is a local port forwarded to a port of a pod or service
+type portMapping struct {
+	// local is the local port, or zero to pick a random port
+	local int
+	// remote is the number or name of the remote port
+	remote string
+}
+
+// NewApplicationPortForwardCommand returns a new instance of an `argocd app port-forward` command
+func NewApplicationPortForwardCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		namespace string
+		addresses []string
+	)
+	command := &cobra.Command{
+		Use:               "port-forward APPNAME TYPE/NAME [LOCAL_PORT:]REMOTE_PORT [...[LOCAL_PORT_N:]REMOTE_PORT_N]",
+		ValidArgsFunction: completeAppNames(clientOpts, 1),
+		Short:             "Forward local ports to a pod or service of an application through the Argo CD server",
+		Long:              "Forward local ports to a pod or service of an application through the Argo CD server, which does not require access to the destination cluster. This requires the exec feature to be enabled and the 'exec, create' permission for the application.",
+		Example: templates.Examples(`
+  # Forward the local port 8080 to the port 80 of the service "my-svc" of the application "my-app"
+  argocd app port-forward my-app svc/my-svc 8080:80
+
+  # Forward the local ports 5000 and 6000 to the same ports of a pod
+  argocd app port-forward my-app pod/my-pod 5000 6000
+
+  # Forward a random local port to the port named "http" of a service in a specific namespace
+  argocd app port-forward my-app svc/my-svc :http --namespace my-namespace
+
+  # Listen on all addresses instead of localhost only
+  argocd app port-forward my-app svc/my-svc 8080:80 --address 0.0.0.0
+`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) < 3 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName, appNs := argo.ParseFromQualifiedName(args[0], "")
+			kind, name, err := parsePortForwardResource(args[1])
+			errors.CheckError(err)
+			var mappings []portMapping
+			for _, arg := range args[2:] {
+				mapping, err := parsePortMapping(arg)
+				errors.CheckError(err)
+				mappings = append(mappings, mapping)
+			}
+
+			acdClient := headless.NewClientOrDie(clientOpts, c)
+			conn, appIf := acdClient.NewApplicationClientOrDie()
+			defer utilio.Close(conn)
+
+			app, err := appIf.Get(ctx, &applicationpkg.ApplicationQuery{Name: &appName, AppNamespace: &appNs})
+			errors.CheckError(err)
+			tree, err := appIf.ResourceTree(ctx, &applicationpkg.ResourcesQuery{ApplicationName: &appName, AppNamespace: &appNs})
+			errors.CheckError(err)
+			node, err := findAppResource(tree, kind, name, namespace)
+			errors.CheckError(err)
+
+			ctx, stop := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
+			defer stop()
+
+			for _, mapping := range mappings {
+				query := url.Values{
+					"appName":      {app.Name},
+					"appNamespace": {app.Namespace},
+					"projectName":  {app.Spec.Project},
+					"namespace":    {node.Namespace},
+					"kind":         {node.Kind},
+					"name":         {node.Name},
+					"port":         {mapping.remote},
+				}
+				for _, address := range addresses {
+					listener, err := net.Listen("tcp", net.JoinHostPort(address, strconv.Itoa(mapping.local)))
+					errors.CheckError(err)
+					defer utilio.Close(listener)
+					fmt.Printf("Forwarding from %s -> %s\n", listener.Addr(), mapping.remote)
+					go forwardPortConnections(ctx, acdClient, listener, query)
+				}
+			}
+			<-ctx.Done()
+		},
+	}
+	command.Flags().StringVar(&namespace, "namespace", "", "Namespace of the pod or service")
+	command.Flags().StringSliceVar(&addresses, "address", []string{"localhost"}, "Addresses to listen on (comma separated)")
+	return command
+}
+
+// parsePortForwardResource parses the TYPE/NAME argument of the port-forward command. A name without type refers to a
+// pod.
+func parsePortForwardResource(arg string) (string, string, error) {
+	resourceType, name, found := strings.Cut(arg, "/")
+	if !found {
+		resourceType, name = "pod", arg
+	}
+	if name == "" {
+		return "", "", fmt.Errorf("invalid resource %q: name must not be empty", arg)
+	}
+	switch strings.ToLower(resourceType) {
+	case "po", "pod", "pods":
+		return kube.PodKind, name, nil
+	case "svc", "service", "services":
+		return kube.ServiceKind, name, nil
+	}
+	return "", "", fmt.Errorf("invalid resource %q: only pods and services are supported", arg)
+}
+
+// parsePortMapping parses a [LOCAL_PORT:]REMOTE_PORT argument of the port-forward command
+func parsePortMapping(arg string) (portMapping, error) {
+	local, remote, found := strings.Cut(arg, ":")
+	if !found {
+		// the remote port is also used as local port
+		remote = local
+	}
+	if remote == "" {
+		return portMapping{}, fmt.Errorf("invalid port %q: remote port must not be empty", arg)
+	}
+	if local == "" {
+		return portMapping{remote: remote}, nil
+	}
+	localPort, err := strconv.ParseUint(local, 10, 16)
+	if err != nil {
+		if !found {
+			return portMapping{}, fmt.Errorf("invalid port %q: the local port is required for a named remote port", arg)
+		}
+		return portMapping{}, fmt.Errorf("invalid local port %q", local)
+	}
+	return portMapping{local: int(localPort), remote: remote}, nil
+}
+
+// findAppResource returns the pod or service of the application resource tree with the given kind and name
+func findAppResource(tree *v1alpha1.ApplicationTree, kind, name, namespace string) (*v1alpha1.ResourceNode, error) {
+	var found []v1alpha1.ResourceNode
+	for _, node := range tree.Nodes {
+		if node.Kind == kind && node.Group == "" && node.Name == name && node.UID != "" && (namespace == "" || node.Namespace == namespace) {
+			found = append(found, node)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("%s %s does not belong to the application", strings.ToLower(kind), name)
+	case 1:
+		return &found[0], nil
+	}
+	return nil, fmt.Errorf("%s %s exists in several namespaces, please specify the namespace", strings.ToLower(kind), name)
+}
+
+// forwardPortConnections forwards the connections accepted by the listener through the Argo CD server, until the
+// listener is closed
+func forwardPortConnections(ctx context.Context, acdClient argocdclient.Client, listener net.Listener, query url.Values) {
+	for {
+		localConn, err := listener.Accept()
+		if err != nil {
+			if ctx.Err() == nil && !stderrors.Is(err, net.ErrClosed) {
+				log.Errorf("Failed to accept connection on %s: %v", listener.Addr(), err)
+			}
+			return
+		}
+		go func() {
+			defer utilio.Close(localConn)
+			wsConn, err := acdClient.NewPortForwardConn(ctx, query)
+			if err != nil {
+				log.Errorf("Failed to forward connection from %s: %v", localConn.RemoteAddr(), err)
+				return
+			}
+			stream := utilio.NewWebSocketStream(wsConn)
+			defer utilio.Close(stream)
+			fmt.Printf("Handling connection for %s\n", query.Get("port"))
+			copyConnections(localConn, stream)
+		}()
+	}
+}
+
+// copyConnections copies the data between both sides until either of them is closed
+func copyConnections(a, b io.ReadWriter) {
+	done := make(chan struct{}, 2)
+	go func() {
+		_, _ = io.Copy(a, b)
+		done <- struct{}{}
+	}()
+	go func() {
+		_, _ = io.Copy(b, a)
+		done <- struct{}{}
+	}()
+	<-done
+}
@@ -0,0 +1,85 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestParsePortForwardResource(t *testing.T) {
+	for _, tc := range []struct {
+		arg  string
+		kind string
+		name string
+		err  string
+	}{
+		{arg: "svc/my-svc", kind: "Service", name: "my-svc"},
+		{arg: "service/my-svc", kind: "Service", name: "my-svc"},
+		{arg: "pod/my-pod", kind: "Pod", name: "my-pod"},
+		{arg: "my-pod", kind: "Pod", name: "my-pod"},
+		{arg: "deploy/my-deploy", err: "only pods and services are supported"},
+		{arg: "svc/", err: "name must not be empty"},
+	} {
+		t.Run(tc.arg, func(t *testing.T) {
+			kind, name, err := parsePortForwardResource(tc.arg)
+			if tc.err != "" {
+				assert.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.kind, kind)
+			assert.Equal(t, tc.name, name)
+		})
+	}
+}
+
+func TestParsePortMapping(t *testing.T) {
+	for _, tc := range []struct {
+		arg     string
+		mapping portMapping
+		err     string
+	}{
+		{arg: "8080:80", mapping: portMapping{local: 8080, remote: "80"}},
+		{arg: "80", mapping: portMapping{local: 80, remote: "80"}},
+		{arg: ":80", mapping: portMapping{remote: "80"}},
+		{arg: "8080:http", mapping: portMapping{local: 8080, remote: "http"}},
+		{arg: "http", err: "the local port is required"},
+		{arg: "8080:", err: "remote port must not be empty"},
+		{arg: "99999:80", err: "invalid local port"},
+	} {
+		t.Run(tc.arg, func(t *testing.T) {
+			mapping, err := parsePortMapping(tc.arg)
+			if tc.err != "" {
+				assert.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.mapping, mapping)
+		})
+	}
+}
+
+func TestFindAppResource(t *testing.T) {
+	tree := &v1alpha1.ApplicationTree{Nodes: []v1alpha1.ResourceNode{
+		{ResourceRef: v1alpha1.ResourceRef{Version: "v1", Kind: "Service", Namespace: "default", Name: "web", UID: "1"}},
+		{ResourceRef: v1alpha1.ResourceRef{Version: "v1", Kind: "Service", Namespace: "other", Name: "web", UID: "2"}},
+		{ResourceRef: v1alpha1.ResourceRef{Version: "v1", Kind: "Service", Namespace: "default", Name: "api", UID: "3"}},
+	}}
+
+	node, err := findAppResource(tree, "Service", "api", "")
+	require.NoError(t, err)
+	assert.Equal(t, "default", node.Namespace)
+
+	node, err = findAppResource(tree, "Service", "web", "other")
+	require.NoError(t, err)
+	assert.Equal(t, "other", node.Namespace)
+
+	_, err = findAppResource(tree, "Service", "web", "")
+	require.ErrorContains(t, err, "several namespaces")
+
+	_, err = findAppResource(tree, "Pod", "web", "")
+	assert.ErrorContains(t, err, "pod web does not belong to the application")
+}
@@ -2,6 +2,7 @@ package commands
 
 import (
 	"bytes"
+	"encoding/json"
 	"testing"
 	"text/tabwriter"
 
@@ -117,3 +118,61 @@ func TestPrintResourcesTree(t *testing.T) {
 
 	assert.Equal(t, expectation, output)
 }
+
+func TestResourceGraph(t *testing.T) {
+	tree := &v1alpha1.ApplicationTree{
+		Nodes: []v1alpha1.ResourceNode{
+			{
+				ResourceRef: v1alpha1.ResourceRef{Version: "v1", Kind: "Pod", Namespace: "default", Name: "web-1", UID: "pod"},
+				ParentRefs:  []v1alpha1.ResourceRef{{Group: "apps", Kind: "ReplicaSet", Namespace: "default", Name: "web", UID: "rs"}},
+				Health:      &v1alpha1.HealthStatus{Status: "Healthy"},
+			},
+			{
+				ResourceRef: v1alpha1.ResourceRef{Group: "apps", Version: "v1", Kind: "ReplicaSet", Namespace: "default", Name: "web", UID: "rs"},
+				ParentRefs:  []v1alpha1.ResourceRef{{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "web", UID: "deploy"}},
+			},
+			{ResourceRef: v1alpha1.ResourceRef{Group: "apps", Version: "v1", Kind: "Deployment", Namespace: "default", Name: "web", UID: "deploy"}},
+		},
+		OrphanedNodes: []v1alpha1.ResourceNode{
+			{ResourceRef: v1alpha1.ResourceRef{Version: "v1", Kind: "ConfigMap", Namespace: "default", Name: "old"}},
+		},
+	}
+
+	t.Run("Dot", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, newResourceGraph("my-app", true, false, tree).print(&buf, "dot"))
+		assert.Equal(t, `digraph "my-app" {
+  rankdir=LR;
+  node [shape=box];
+  "/ConfigMap/default/old" [label="ConfigMap\ndefault/old", style=dashed];
+  "pod" [label="Pod\ndefault/web-1", tooltip="Healthy"];
+  "deploy" [label="Deployment\ndefault/web"];
+  "rs" [label="ReplicaSet\ndefault/web"];
+  "rs" -> "pod";
+  "deploy" -> "rs";
+}
+`, buf.String())
+	})
+
+	t.Run("JSONGraph", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, newResourceGraph("my-app", false, false, tree).print(&buf, "json-graph"))
+		var graph struct {
+			Graph struct {
+				ID       string                    `json:"id"`
+				Directed bool                      `json:"directed"`
+				Nodes    map[string]map[string]any `json:"nodes"`
+				Edges    []map[string]string       `json:"edges"`
+			} `json:"graph"`
+		}
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &graph))
+		assert.Equal(t, "my-app", graph.Graph.ID)
+		assert.True(t, graph.Graph.Directed)
+		assert.Len(t, graph.Graph.Nodes, 3)
+		assert.Equal(t, "apps/ReplicaSet/default/web", graph.Graph.Nodes["rs"]["label"])
+		assert.Equal(t, []map[string]string{
+			{"source": "rs", "target": "pod", "relation": "owns"},
+			{"source": "deploy", "target": "rs", "relation": "owns"},
+		}, graph.Graph.Edges)
+	})
+}
@@ -1,8 +1,12 @@
 package commands
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
 	"os"
+	"sort"
+	"strings"
 	"text/tabwriter"
 
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/utils"
@@ -32,8 +36,9 @@ func NewApplicationPatchResourceCommand(clientOpts *argocdclient.ClientOptions)
 	var all bool
 	var project string
 	command := &cobra.Command{
-		Use:   "patch-resource APPNAME",
-		Short: "Patch resource in an application",
+		Use:               "patch-resource APPNAME",
+		ValidArgsFunction: completeAppNames(clientOpts, 1),
+		Short:             "Patch resource in an application",
 	}
 
 	command.Flags().StringVar(&patch, "patch", "", "Patch")
@@ -99,8 +104,9 @@ func NewApplicationDeleteResourceCommand(clientOpts *argocdclient.ClientOptions)
 	var all bool
 	var project string
 	command := &cobra.Command{
-		Use:   "delete-resource APPNAME",
-		Short: "Delete resource in an application",
+		Use:               "delete-resource APPNAME",
+		ValidArgsFunction: completeAppNames(clientOpts, 1),
+		Short:             "Delete resource in an application",
 	}
 
 	command.Flags().StringVar(&resourceName, "resource-name", "", "Name of resource")
@@ -261,8 +267,9 @@ func NewApplicationListResourcesCommand(clientOpts *argocdclient.ClientOptions)
 	var output string
 	var project string
 	command := &cobra.Command{
-		Use:   "resources APPNAME",
-		Short: "List resource of application",
+		Use:               "resources APPNAME",
+		ValidArgsFunction: completeAppNames(clientOpts, 1),
+		Short:             "List resource of application",
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 			if len(args) != 1 {
@@ -279,11 +286,153 @@ func NewApplicationListResourcesCommand(clientOpts *argocdclient.ClientOptions)
 				Project:         &project,
 			})
 			errors.CheckError(err)
-			printResources(listAll, orphaned, appResourceTree, output)
+			switch output {
+			case "dot", "json-graph":
+				graph := newResourceGraph(appName, listAll, orphaned, appResourceTree)
+				errors.CheckError(graph.print(os.Stdout, output))
+			default:
+				printResources(listAll, orphaned, appResourceTree, output)
+			}
 		},
 	}
 	command.Flags().BoolVar(&orphaned, "orphaned", false, "Lists only orphaned resources")
-	command.Flags().StringVar(&output, "output", "", "Provides the tree view of the resources")
+	command.Flags().StringVar(&output, "output", "", "Provides the tree view of the resources, or the graph of their ownership. One of: tree|tree=detailed|dot|json-graph")
 	command.Flags().StringVar(&project, "project", "", `The name of the application's project - specifying this allows the command to report "not found" instead of "permission denied" if the app does not exist`)
 	return command
 }
+
+// resourceGraphNode is a node of the resource ownership graph
+type resourceGraphNode struct {
+	id       string
+	node     v1alpha1.ResourceNode
+	orphaned bool
+}
+
+// resourceGraph is the ownership graph of the resources of an application, in which every edge points from an owner to
+// a resource it owns
+type resourceGraph struct {
+	name  string
+	nodes []resourceGraphNode
+	edges [][2]string
+}
+
+// resourceGraphNodeID returns the ID of a resource in the ownership graph
+func resourceGraphNodeID(ref v1alpha1.ResourceRef) string {
+	if ref.UID != "" {
+		return ref.UID
+	}
+	return fmt.Sprintf("%s/%s/%s/%s", ref.Group, ref.Kind, ref.Namespace, ref.Name)
+}
+
+// newResourceGraph returns the ownership graph of the (orphaned) resources of the application resource tree. Edges to
+// owners which are not part of the graph are omitted.
+func newResourceGraph(name string, listAll bool, orphaned bool, tree *v1alpha1.ApplicationTree) *resourceGraph {
+	graph := &resourceGraph{name: name}
+	if !orphaned || listAll {
+		for _, node := range tree.Nodes {
+			graph.nodes = append(graph.nodes, resourceGraphNode{id: resourceGraphNodeID(node.ResourceRef), node: node})
+		}
+	}
+	if orphaned || listAll {
+		for _, node := range tree.OrphanedNodes {
+			graph.nodes = append(graph.nodes, resourceGraphNode{id: resourceGraphNodeID(node.ResourceRef), node: node, orphaned: true})
+		}
+	}
+	sort.Slice(graph.nodes, func(i, j int) bool {
+		return graph.nodes[i].node.FullName() < graph.nodes[j].node.FullName()
+	})
+
+	ids := make(map[string]bool, len(graph.nodes))
+	for _, n := range graph.nodes {
+		ids[n.id] = true
+	}
+	for _, n := range graph.nodes {
+		for _, parent := range n.node.ParentRefs {
+			if parentID := resourceGraphNodeID(parent); ids[parentID] {
+				graph.edges = append(graph.edges, [2]string{parentID, n.id})
+			}
+		}
+	}
+	return graph
+}
+
+// dotQuote returns the given string as a quoted Graphviz DOT identifier, in which line breaks are kept as escape
+// sequences
+func dotQuote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s) + `"`
+}
+
+// print prints the graph in the given format, which is either dot or json-graph
+func (g *resourceGraph) print(w io.Writer, format string) error {
+	if format == "json-graph" {
+		return g.printJSONGraph(w)
+	}
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "digraph %s {\n", dotQuote(g.name))
+	sb.WriteString("  rankdir=LR;\n")
+	sb.WriteString("  node [shape=box];\n")
+	for _, n := range g.nodes {
+		name := n.node.Name
+		if n.node.Namespace != "" {
+			name = n.node.Namespace + "/" + name
+		}
+		attrs := []string{"label=" + dotQuote(n.node.Kind+"\n"+name)}
+		if n.node.Health != nil {
+			attrs = append(attrs, "tooltip="+dotQuote(string(n.node.Health.Status)))
+		}
+		if n.orphaned {
+			attrs = append(attrs, "style=dashed")
+		}
+		fmt.Fprintf(&sb, "  %s [%s];\n", dotQuote(n.id), strings.Join(attrs, ", "))
+	}
+	for _, e := range g.edges {
+		fmt.Fprintf(&sb, "  %s -> %s;\n", dotQuote(e[0]), dotQuote(e[1]))
+	}
+	sb.WriteString("}\n")
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// printJSONGraph prints the graph in the JSON Graph Format (https://jsongraphformat.info)
+func (g *resourceGraph) printJSONGraph(w io.Writer) error {
+	type jsonGraphNode struct {
+		Label    string         `json:"label"`
+		Metadata map[string]any `json:"metadata"`
+	}
+	type jsonGraphEdge struct {
+		Source   string `json:"source"`
+		Target   string `json:"target"`
+		Relation string `json:"relation"`
+	}
+	type jsonGraph struct {
+		ID       string                   `json:"id"`
+		Directed bool                     `json:"directed"`
+		Nodes    map[string]jsonGraphNode `json:"nodes"`
+		Edges    []jsonGraphEdge          `json:"edges"`
+	}
+
+	graph := jsonGraph{ID: g.name, Directed: true, Nodes: map[string]jsonGraphNode{}, Edges: []jsonGraphEdge{}}
+	for _, n := range g.nodes {
+		metadata := map[string]any{
+			"group":     n.node.Group,
+			"version":   n.node.Version,
+			"kind":      n.node.Kind,
+			"namespace": n.node.Namespace,
+			"name":      n.node.Name,
+			"orphaned":  n.orphaned,
+		}
+		if n.node.Health != nil {
+			metadata["health"] = n.node.Health.Status
+		}
+		graph.Nodes[n.id] = jsonGraphNode{Label: n.node.FullName(), Metadata: metadata}
+	}
+	for _, e := range g.edges {
+		graph.Edges = append(graph.Edges, jsonGraphEdge{Source: e[0], Target: e[1], Relation: "owns"})
+	}
+	data, err := json.MarshalIndent(map[string]jsonGraph{"graph": graph}, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, string(data))
+	return err
+}
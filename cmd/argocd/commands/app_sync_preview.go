@@ -0,0 +1,221 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/sync/syncwaves"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+
+	argoappv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+const (
+	syncPreviewCreate    = "create"
+	syncPreviewUpdate    = "update"
+	syncPreviewPrune     = "prune"
+	syncPreviewUnchanged = "unchanged"
+	syncPreviewHook      = "hook"
+	syncPreviewSkipped   = "skip-prune"
+	syncPreviewFailed    = "failed"
+)
+
+// syncPhaseOrder is the order in which the phases of a sync are run
+var syncPhaseOrder = map[common.SyncPhase]int{
+	common.SyncPhasePreSync:  0,
+	common.SyncPhaseSync:     1,
+	common.SyncPhasePostSync: 2,
+	common.SyncPhaseSyncFail: 3,
+}
+
+// syncPreviewChange is the change of a single resource which would be made by a sync
+type syncPreviewChange struct {
+	action  string
+	phase   common.SyncPhase
+	wave    int
+	fields  int
+	result  *argoappv1.ResourceResult
+	message string
+}
+
+// syncPreview summarizes the changes which would be made by a sync, computed from the result of a dry-run sync
+type syncPreview struct {
+	changes []syncPreviewChange
+	counts  map[string]int
+	// fields is the total number of changed fields of the updated resources
+	fields int
+}
+
+// newSyncPreview computes the preview of a sync from the resource results of a dry-run sync operation and the diffs
+// of the managed resources of the application. The diffs are used to count the changed fields and to find the sync
+// wave of every resource.
+func newSyncPreview(results argoappv1.ResourceResults, diffs []*argoappv1.ResourceDiff) (*syncPreview, error) {
+	diffByKey := make(map[kube.ResourceKey]*argoappv1.ResourceDiff)
+	for _, d := range diffs {
+		diffByKey[kube.NewResourceKey(d.Group, d.Kind, d.Namespace, d.Name)] = d
+	}
+	preview := &syncPreview{counts: make(map[string]int)}
+	for _, res := range results {
+		change := syncPreviewChange{
+			action:  syncPreviewActionOf(res),
+			phase:   res.SyncPhase,
+			result:  res,
+			message: res.Message,
+		}
+		if change.phase == "" {
+			change.phase = common.SyncPhaseSync
+		}
+		if d, ok := diffByKey[kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name)]; ok {
+			wave, err := syncPreviewWave(d)
+			if err != nil {
+				return nil, err
+			}
+			change.wave = wave
+			if change.action == syncPreviewUpdate {
+				target := d.PredictedLiveState
+				if target == "" {
+					target = d.TargetState
+				}
+				fields, err := countChangedFields(d.NormalizedLiveState, target)
+				if err != nil {
+					return nil, fmt.Errorf("error computing changed fields of %s/%s: %w", res.Kind, res.Name, err)
+				}
+				change.fields = fields
+				preview.fields += fields
+			}
+		}
+		preview.counts[change.action]++
+		preview.changes = append(preview.changes, change)
+	}
+	sort.SliceStable(preview.changes, func(i, j int) bool {
+		a, b := preview.changes[i], preview.changes[j]
+		if a.phase != b.phase {
+			return syncPhaseOrder[a.phase] < syncPhaseOrder[b.phase]
+		}
+		return a.wave < b.wave
+	})
+	return preview, nil
+}
+
+// syncPreviewActionOf returns the action of a dry-run resource result. kubectl reports whether a resource would be
+// created, configured or left unchanged in the message of the result.
+func syncPreviewActionOf(res *argoappv1.ResourceResult) string {
+	switch {
+	case res.Status == common.ResultCodeSyncFailed:
+		return syncPreviewFailed
+	case res.HookType != "":
+		return syncPreviewHook
+	case res.Status == common.ResultCodePruned:
+		return syncPreviewPrune
+	case res.Status == common.ResultCodePruneSkipped:
+		return syncPreviewSkipped
+	case strings.Contains(res.Message, " created"):
+		return syncPreviewCreate
+	case strings.Contains(res.Message, " unchanged"):
+		return syncPreviewUnchanged
+	default:
+		return syncPreviewUpdate
+	}
+}
+
+// syncPreviewWave returns the sync wave of a resource, which is taken from its target state, or from its live state
+// if the resource is going to be pruned
+func syncPreviewWave(d *argoappv1.ResourceDiff) (int, error) {
+	obj, err := d.TargetObject()
+	if err == nil && obj == nil {
+		obj, err = d.LiveObject()
+	}
+	if err != nil {
+		return 0, fmt.Errorf("error unmarshaling state of %s/%s: %w", d.Kind, d.Name, err)
+	}
+	if obj == nil {
+		return 0, nil
+	}
+	return syncwaves.Wave(obj), nil
+}
+
+// countChangedFields returns the number of leaf fields which differ between the live and the target state of a
+// resource. Lists are compared as a whole.
+func countChangedFields(live, target string) (int, error) {
+	var liveObj, targetObj any
+	if live != "" {
+		if err := json.Unmarshal([]byte(live), &liveObj); err != nil {
+			return 0, err
+		}
+	}
+	if target != "" {
+		if err := json.Unmarshal([]byte(target), &targetObj); err != nil {
+			return 0, err
+		}
+	}
+	return countChangedValues(liveObj, targetObj), nil
+}
+
+func countChangedValues(live, target any) int {
+	liveMap, liveIsMap := live.(map[string]any)
+	targetMap, targetIsMap := target.(map[string]any)
+	if !liveIsMap || !targetIsMap {
+		if reflect.DeepEqual(live, target) {
+			return 0
+		}
+		return 1
+	}
+	count := 0
+	for k, v := range targetMap {
+		count += countChangedValues(liveMap[k], v)
+	}
+	for k, v := range liveMap {
+		if _, ok := targetMap[k]; !ok {
+			count += countChangedValues(v, nil)
+		}
+	}
+	return count
+}
+
+// printSyncPreview prints the changes of a sync preview ordered by phase and wave, followed by a summary
+func printSyncPreview(out io.Writer, appName string, preview *syncPreview) {
+	_, _ = fmt.Fprintf(out, "Previewing sync of application %s (dry run)\n\n", appName)
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "PHASE\tWAVE\tACTION\tGROUP\tKIND\tNAMESPACE\tNAME\tCHANGED FIELDS\tMESSAGE\n")
+	var waves []string
+	for _, change := range preview.changes {
+		if change.action == syncPreviewUnchanged {
+			continue
+		}
+		wave := fmt.Sprintf("%s/%d", change.phase, change.wave)
+		if len(waves) == 0 || waves[len(waves)-1] != wave {
+			waves = append(waves, wave)
+		}
+		fields := ""
+		if change.action == syncPreviewUpdate {
+			fields = fmt.Sprintf("%d", change.fields)
+		}
+		message := ""
+		if change.action == syncPreviewFailed || change.action == syncPreviewSkipped {
+			message = change.message
+		}
+		res := change.result
+		_, _ = fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", change.phase, change.wave, change.action, res.Group, res.Kind, res.Namespace, res.Name, fields, message)
+	}
+	_ = w.Flush()
+
+	_, _ = fmt.Fprintf(out, "\nSummary: %d to create, %d to update (%d changed fields), %d to prune, %d unchanged, %d hooks to run",
+		preview.counts[syncPreviewCreate], preview.counts[syncPreviewUpdate], preview.fields, preview.counts[syncPreviewPrune],
+		preview.counts[syncPreviewUnchanged], preview.counts[syncPreviewHook])
+	if n := preview.counts[syncPreviewSkipped]; n > 0 {
+		_, _ = fmt.Fprintf(out, ", %d not pruned", n)
+	}
+	if n := preview.counts[syncPreviewFailed]; n > 0 {
+		_, _ = fmt.Fprintf(out, ", %d failed", n)
+	}
+	_, _ = fmt.Fprintln(out)
+	if len(waves) > 0 {
+		_, _ = fmt.Fprintf(out, "Waves: %s\n", strings.Join(waves, " -> "))
+	}
+}
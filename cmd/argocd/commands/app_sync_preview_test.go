@@ -0,0 +1,93 @@
+package commands
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	argoappv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestCountChangedFields(t *testing.T) {
+	count, err := countChangedFields(
+		`{"metadata":{"name":"guestbook","labels":{"a":"1","b":"2"}},"spec":{"replicas":1,"ports":[80]}}`,
+		`{"metadata":{"name":"guestbook","labels":{"a":"2","c":"3"}},"spec":{"replicas":1,"ports":[80,443]}}`,
+	)
+	require.NoError(t, err)
+	// labels a, b and c and the ports
+	assert.Equal(t, 4, count)
+
+	count, err = countChangedFields(`{"spec":{"replicas":1}}`, `{"spec":{"replicas":1}}`)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	count, err = countChangedFields("", `{"spec":{"replicas":1}}`)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	_, err = countChangedFields("{", "{}")
+	assert.Error(t, err)
+}
+
+func TestSyncPreviewActionOf(t *testing.T) {
+	assert.Equal(t, syncPreviewCreate, syncPreviewActionOf(&argoappv1.ResourceResult{Status: common.ResultCodeSynced, Message: "service/guestbook created (dry run)"}))
+	assert.Equal(t, syncPreviewUpdate, syncPreviewActionOf(&argoappv1.ResourceResult{Status: common.ResultCodeSynced, Message: "deployment.apps/guestbook configured (dry run)"}))
+	assert.Equal(t, syncPreviewUnchanged, syncPreviewActionOf(&argoappv1.ResourceResult{Status: common.ResultCodeSynced, Message: "configmap/guestbook unchanged (dry run)"}))
+	assert.Equal(t, syncPreviewPrune, syncPreviewActionOf(&argoappv1.ResourceResult{Status: common.ResultCodePruned, Message: "pruned (dry run)"}))
+	assert.Equal(t, syncPreviewSkipped, syncPreviewActionOf(&argoappv1.ResourceResult{Status: common.ResultCodePruneSkipped, Message: "ignored (requires pruning)"}))
+	assert.Equal(t, syncPreviewHook, syncPreviewActionOf(&argoappv1.ResourceResult{HookType: common.HookTypePreSync, Message: "job.batch/migrate created (dry run)"}))
+	assert.Equal(t, syncPreviewFailed, syncPreviewActionOf(&argoappv1.ResourceResult{Status: common.ResultCodeSyncFailed, Message: "error validating data"}))
+}
+
+func TestSyncPreview(t *testing.T) {
+	results := argoappv1.ResourceResults{
+		{Kind: "Service", Namespace: "default", Name: "guestbook", Status: common.ResultCodeSynced, SyncPhase: common.SyncPhaseSync, Message: "service/guestbook created (dry run)"},
+		{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook", Status: common.ResultCodeSynced, SyncPhase: common.SyncPhaseSync, Message: "deployment.apps/guestbook configured (dry run)"},
+		{Kind: "ConfigMap", Namespace: "default", Name: "config", Status: common.ResultCodeSynced, SyncPhase: common.SyncPhaseSync, Message: "configmap/config unchanged (dry run)"},
+		{Kind: "ConfigMap", Namespace: "default", Name: "old", Status: common.ResultCodePruned, SyncPhase: common.SyncPhaseSync, Message: "pruned (dry run)"},
+		{Group: "batch", Kind: "Job", Namespace: "default", Name: "migrate", HookType: common.HookTypePreSync, SyncPhase: common.SyncPhasePreSync, Message: "job.batch/migrate created (dry run)"},
+	}
+	diffs := []*argoappv1.ResourceDiff{
+		{
+			Kind: "Service", Namespace: "default", Name: "guestbook",
+			TargetState: `{"apiVersion":"v1","kind":"Service","metadata":{"name":"guestbook","annotations":{"argocd.argoproj.io/sync-wave":"-1"}}}`,
+			LiveState:   "null",
+		},
+		{
+			Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook",
+			TargetState:         `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"guestbook"},"spec":{"replicas":2}}`,
+			NormalizedLiveState: `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"guestbook"},"spec":{"replicas":1,"paused":true}}`,
+			PredictedLiveState:  `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"guestbook"},"spec":{"replicas":2}}`,
+			Modified:            true,
+		},
+		{
+			Kind: "ConfigMap", Namespace: "default", Name: "old",
+			TargetState: "null",
+			LiveState:   `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"old","annotations":{"argocd.argoproj.io/sync-wave":"2"}}}`,
+		},
+	}
+
+	preview, err := newSyncPreview(results, diffs)
+	require.NoError(t, err)
+	assert.Equal(t, 1, preview.counts[syncPreviewCreate])
+	assert.Equal(t, 1, preview.counts[syncPreviewUpdate])
+	assert.Equal(t, 1, preview.counts[syncPreviewUnchanged])
+	assert.Equal(t, 1, preview.counts[syncPreviewPrune])
+	assert.Equal(t, 1, preview.counts[syncPreviewHook])
+	assert.Equal(t, 2, preview.fields)
+
+	var out bytes.Buffer
+	printSyncPreview(&out, "argocd/guestbook", preview)
+	expectation := "Previewing sync of application argocd/guestbook (dry run)\n\n" +
+		"PHASE    WAVE  ACTION  GROUP  KIND        NAMESPACE  NAME       CHANGED FIELDS  MESSAGE\n" +
+		"PreSync  0     hook    batch  Job         default    migrate                    \n" +
+		"Sync     -1    create         Service     default    guestbook                  \n" +
+		"Sync     0     update  apps   Deployment  default    guestbook  2               \n" +
+		"Sync     2     prune          ConfigMap   default    old                        \n\n" +
+		"Summary: 1 to create, 1 to update (2 changed fields), 1 to prune, 1 unchanged, 1 hooks to run\n" +
+		"Waves: PreSync/0 -> Sync/-1 -> Sync/0 -> Sync/2\n"
+	assert.Equal(t, expectation, out.String())
+}
@@ -1,22 +1,27 @@
 package commands
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
+	"path/filepath"
 	"slices"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/argoproj/gitops-engine/pkg/diff"
 	"github.com/argoproj/gitops-engine/pkg/health"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	"github.com/coreos/go-oidc/v3/oidc"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/gorilla/websocket"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/oauth2"
@@ -27,8 +32,10 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/yaml"
 
+	argocommon "github.com/argoproj/argo-cd/v3/common"
 	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
 	accountpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/account"
 	applicationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
@@ -214,6 +221,77 @@ func TestPrintTreeViewDetailedAppGet(t *testing.T) {
 	assert.Contains(t, output, "Readiness Gate failed")
 }
 
+func TestFindPreviousRevisionHistory(t *testing.T) {
+	t.Run("SkipsRedeploymentsOfCurrentRevision", func(t *testing.T) {
+		application := v1alpha1.Application{Status: v1alpha1.ApplicationStatus{History: v1alpha1.RevisionHistories{
+			{ID: 1, Revision: "aaa"},
+			{ID: 2, Revision: "bbb"},
+			{ID: 3, Revision: "ccc"},
+			{ID: 4, Revision: "ccc"},
+		}}}
+		history, err := findPreviousRevisionHistory(&application)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), history.ID)
+	})
+
+	t.Run("MultipleSources", func(t *testing.T) {
+		application := v1alpha1.Application{Status: v1alpha1.ApplicationStatus{History: v1alpha1.RevisionHistories{
+			{ID: 1, Revisions: []string{"aaa", "1.0.0"}},
+			{ID: 2, Revisions: []string{"aaa", "1.1.0"}},
+		}}}
+		history, err := findPreviousRevisionHistory(&application)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), history.ID)
+	})
+
+	t.Run("NothingToRollBackTo", func(t *testing.T) {
+		application := v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "guestbook"},
+			Status: v1alpha1.ApplicationStatus{History: v1alpha1.RevisionHistories{
+				{ID: 1, Revision: "aaa"},
+				{ID: 2, Revision: "aaa"},
+			}},
+		}
+		_, err := findPreviousRevisionHistory(&application)
+		require.EqualError(t, err, "application 'guestbook' has nothing to roll back to: its history has fewer than two distinct revisions")
+	})
+}
+
+func TestFindRevisionHistoryByRevision(t *testing.T) {
+	application := v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook"},
+		Status: v1alpha1.ApplicationStatus{History: v1alpha1.RevisionHistories{
+			{ID: 1, Revision: "4b825dc642cb6eb9a060e54bf8d69288fbee4904"},
+			{ID: 2, Revision: "a1b2c3d4e5f60718293a4b5c6d7e8f9012345678"},
+			{ID: 3, Revision: "4b825dc642cb6eb9a060e54bf8d69288fbee4904"},
+			{ID: 4, Revisions: []string{"a1b2c3d4e5f60718293a4b5c6d7e8f9012345678", "1.0.0"}},
+		}},
+	}
+
+	t.Run("MostRecentDeployment", func(t *testing.T) {
+		history, err := findRevisionHistoryByRevision(&application, "4b825dc642cb6eb9a060e54bf8d69288fbee4904")
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), history.ID)
+	})
+
+	t.Run("AbbreviatedRevision", func(t *testing.T) {
+		history, err := findRevisionHistoryByRevision(&application, "4b825dc")
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), history.ID)
+	})
+
+	t.Run("MultipleSources", func(t *testing.T) {
+		history, err := findRevisionHistoryByRevision(&application, "1.0.0")
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), history.ID)
+	})
+
+	t.Run("TooShortPrefix", func(t *testing.T) {
+		_, err := findRevisionHistoryByRevision(&application, "4b8")
+		require.EqualError(t, err, "application 'guestbook' has no deployment of revision '4b8' in history")
+	})
+}
+
 func TestFindRevisionHistoryWithoutPassedIdWithMultipleSources(t *testing.T) {
 	histories := v1alpha1.RevisionHistories{}
 
@@ -359,6 +437,39 @@ func TestFindRevisionHistoryWithPassedIdThatNotExist(t *testing.T) {
 	require.EqualError(t, err, "application '' does not have deployment id '4' in history", "Find revision history should fail with correct error message")
 }
 
+func TestFindHistoryDiffRange(t *testing.T) {
+	app := &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-app"},
+		Status: v1alpha1.ApplicationStatus{History: v1alpha1.RevisionHistories{
+			{ID: 1}, {ID: 2}, {ID: 5},
+		}},
+	}
+
+	from, to, err := findHistoryDiffRange(app, -1, -1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), from.ID)
+	assert.Equal(t, int64(5), to.ID)
+
+	from, to, err = findHistoryDiffRange(app, -1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), from.ID)
+	assert.Equal(t, int64(2), to.ID)
+
+	from, to, err = findHistoryDiffRange(app, 5, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), from.ID)
+	assert.Equal(t, int64(1), to.ID)
+
+	_, _, err = findHistoryDiffRange(app, -1, 1)
+	require.EqualError(t, err, "application 'my-app' has no deployment preceding the one to compare to")
+
+	_, _, err = findHistoryDiffRange(app, 3, -1)
+	require.EqualError(t, err, "application 'my-app' does not have deployment id '3' in history")
+
+	_, _, err = findHistoryDiffRange(&v1alpha1.Application{ObjectMeta: metav1.ObjectMeta{Name: "my-app"}}, -1, -1)
+	require.EqualError(t, err, "application 'my-app' has no deployments")
+}
+
 func Test_groupObjsByKey(t *testing.T) {
 	localObjs := []*unstructured.Unstructured{
 		{
@@ -586,6 +697,35 @@ func TestPrintApplicationHistoryTable(t *testing.T) {
 	require.Equalf(t, output, expectation, "Incorrect print operation output %q, should be %q", output, expectation)
 }
 
+func TestPrintApplicationHistoryTableWithReason(t *testing.T) {
+	histories := []v1alpha1.RevisionHistory{
+		{
+			ID: 1,
+			Source: v1alpha1.ApplicationSource{
+				TargetRevision: "1",
+				RepoURL:        "test",
+			},
+		},
+		{
+			ID: 2,
+			Source: v1alpha1.ApplicationSource{
+				TargetRevision: "2",
+				RepoURL:        "test",
+			},
+			Reason: "JIRA-123 hotfix",
+		},
+	}
+
+	output, _ := captureOutput(func() error {
+		printApplicationHistoryTable(histories)
+		return nil
+	})
+
+	expectation := "SOURCE  test\nID      DATE                           REVISION  REASON\n1       0001-01-01 00:00:00 +0000 UTC  1         \n2       0001-01-01 00:00:00 +0000 UTC  2         JIRA-123 hotfix\n"
+
+	require.Equalf(t, output, expectation, "Incorrect print operation output %q, should be %q", output, expectation)
+}
+
 func TestPrintApplicationHistoryTableWithMultipleSources(t *testing.T) {
 	histories := []v1alpha1.RevisionHistory{
 		{
@@ -1109,111 +1249,111 @@ func Test_unset(t *testing.T) {
 	}
 
 	assert.Equal(t, "some-prefix", kustomizeSource.Kustomize.NamePrefix)
-	updated, nothingToUnset := unset(kustomizeSource, unsetOpts{namePrefix: true})
+	removed, nothingToUnset := unset(kustomizeSource, unsetOpts{namePrefix: true})
 	assert.Empty(t, kustomizeSource.Kustomize.NamePrefix)
-	assert.True(t, updated)
+	assert.NotEmpty(t, removed)
 	assert.False(t, nothingToUnset)
-	updated, nothingToUnset = unset(kustomizeSource, unsetOpts{namePrefix: true})
-	assert.False(t, updated)
+	removed, nothingToUnset = unset(kustomizeSource, unsetOpts{namePrefix: true})
+	assert.Empty(t, removed)
 	assert.False(t, nothingToUnset)
 
 	assert.Equal(t, "some-suffix", kustomizeSource.Kustomize.NameSuffix)
-	updated, nothingToUnset = unset(kustomizeSource, unsetOpts{nameSuffix: true})
+	removed, nothingToUnset = unset(kustomizeSource, unsetOpts{nameSuffix: true})
 	assert.Empty(t, kustomizeSource.Kustomize.NameSuffix)
-	assert.True(t, updated)
+	assert.NotEmpty(t, removed)
 	assert.False(t, nothingToUnset)
-	updated, nothingToUnset = unset(kustomizeSource, unsetOpts{nameSuffix: true})
-	assert.False(t, updated)
+	removed, nothingToUnset = unset(kustomizeSource, unsetOpts{nameSuffix: true})
+	assert.Empty(t, removed)
 	assert.False(t, nothingToUnset)
 
 	assert.Equal(t, "123", kustomizeSource.Kustomize.Version)
-	updated, nothingToUnset = unset(kustomizeSource, unsetOpts{kustomizeVersion: true})
+	removed, nothingToUnset = unset(kustomizeSource, unsetOpts{kustomizeVersion: true})
 	assert.Empty(t, kustomizeSource.Kustomize.Version)
-	assert.True(t, updated)
+	assert.NotEmpty(t, removed)
 	assert.False(t, nothingToUnset)
-	updated, nothingToUnset = unset(kustomizeSource, unsetOpts{kustomizeVersion: true})
-	assert.False(t, updated)
+	removed, nothingToUnset = unset(kustomizeSource, unsetOpts{kustomizeVersion: true})
+	assert.Empty(t, removed)
 	assert.False(t, nothingToUnset)
 
 	assert.Len(t, kustomizeSource.Kustomize.Images, 2)
-	updated, nothingToUnset = unset(kustomizeSource, unsetOpts{kustomizeImages: []string{"old1=new:tag"}})
+	removed, nothingToUnset = unset(kustomizeSource, unsetOpts{kustomizeImages: []string{"old1=new:tag"}})
 	assert.Len(t, kustomizeSource.Kustomize.Images, 1)
-	assert.True(t, updated)
+	assert.NotEmpty(t, removed)
 	assert.False(t, nothingToUnset)
-	updated, nothingToUnset = unset(kustomizeSource, unsetOpts{kustomizeImages: []string{"old1=new:tag"}})
-	assert.False(t, updated)
+	removed, nothingToUnset = unset(kustomizeSource, unsetOpts{kustomizeImages: []string{"old1=new:tag"}})
+	assert.Empty(t, removed)
 	assert.False(t, nothingToUnset)
 
 	assert.Len(t, kustomizeSource.Kustomize.Replicas, 2)
-	updated, nothingToUnset = unset(kustomizeSource, unsetOpts{kustomizeReplicas: []string{"my-deployment"}})
+	removed, nothingToUnset = unset(kustomizeSource, unsetOpts{kustomizeReplicas: []string{"my-deployment"}})
 	assert.Len(t, kustomizeSource.Kustomize.Replicas, 1)
-	assert.True(t, updated)
+	assert.NotEmpty(t, removed)
 	assert.False(t, nothingToUnset)
-	updated, nothingToUnset = unset(kustomizeSource, unsetOpts{kustomizeReplicas: []string{"my-deployment"}})
-	assert.False(t, updated)
+	removed, nothingToUnset = unset(kustomizeSource, unsetOpts{kustomizeReplicas: []string{"my-deployment"}})
+	assert.Empty(t, removed)
 	assert.False(t, nothingToUnset)
 
 	assert.True(t, kustomizeSource.Kustomize.IgnoreMissingComponents)
-	updated, nothingToUnset = unset(kustomizeSource, unsetOpts{ignoreMissingComponents: true})
+	removed, nothingToUnset = unset(kustomizeSource, unsetOpts{ignoreMissingComponents: true})
 	assert.False(t, kustomizeSource.Kustomize.IgnoreMissingComponents)
-	assert.True(t, updated)
+	assert.NotEmpty(t, removed)
 	assert.False(t, nothingToUnset)
-	updated, nothingToUnset = unset(kustomizeSource, unsetOpts{ignoreMissingComponents: true})
-	assert.False(t, updated)
+	removed, nothingToUnset = unset(kustomizeSource, unsetOpts{ignoreMissingComponents: true})
+	assert.Empty(t, removed)
 	assert.False(t, nothingToUnset)
 
 	assert.Len(t, helmSource.Helm.Parameters, 2)
-	updated, nothingToUnset = unset(helmSource, unsetOpts{parameters: []string{"name-1"}})
+	removed, nothingToUnset = unset(helmSource, unsetOpts{parameters: []string{"name-1"}})
 	assert.Len(t, helmSource.Helm.Parameters, 1)
-	assert.True(t, updated)
+	assert.NotEmpty(t, removed)
 	assert.False(t, nothingToUnset)
-	updated, nothingToUnset = unset(helmSource, unsetOpts{parameters: []string{"name-1"}})
-	assert.False(t, updated)
+	removed, nothingToUnset = unset(helmSource, unsetOpts{parameters: []string{"name-1"}})
+	assert.Empty(t, removed)
 	assert.False(t, nothingToUnset)
 
 	assert.Len(t, helmSource.Helm.ValueFiles, 2)
-	updated, nothingToUnset = unset(helmSource, unsetOpts{valuesFiles: []string{"values-1.yaml"}})
+	removed, nothingToUnset = unset(helmSource, unsetOpts{valuesFiles: []string{"values-1.yaml"}})
 	assert.Len(t, helmSource.Helm.ValueFiles, 1)
-	assert.True(t, updated)
+	assert.NotEmpty(t, removed)
 	assert.False(t, nothingToUnset)
-	updated, nothingToUnset = unset(helmSource, unsetOpts{valuesFiles: []string{"values-1.yaml"}})
-	assert.False(t, updated)
+	removed, nothingToUnset = unset(helmSource, unsetOpts{valuesFiles: []string{"values-1.yaml"}})
+	assert.Empty(t, removed)
 	assert.False(t, nothingToUnset)
 
 	assert.Equal(t, "some: yaml", helmSource.Helm.ValuesString())
-	updated, nothingToUnset = unset(helmSource, unsetOpts{valuesLiteral: true})
+	removed, nothingToUnset = unset(helmSource, unsetOpts{valuesLiteral: true})
 	assert.Empty(t, helmSource.Helm.ValuesString())
-	assert.True(t, updated)
+	assert.NotEmpty(t, removed)
 	assert.False(t, nothingToUnset)
-	updated, nothingToUnset = unset(helmSource, unsetOpts{valuesLiteral: true})
-	assert.False(t, updated)
+	removed, nothingToUnset = unset(helmSource, unsetOpts{valuesLiteral: true})
+	assert.Empty(t, removed)
 	assert.False(t, nothingToUnset)
 
 	assert.True(t, helmSource.Helm.IgnoreMissingValueFiles)
-	updated, nothingToUnset = unset(helmSource, unsetOpts{ignoreMissingValueFiles: true})
+	removed, nothingToUnset = unset(helmSource, unsetOpts{ignoreMissingValueFiles: true})
 	assert.False(t, helmSource.Helm.IgnoreMissingValueFiles)
-	assert.True(t, updated)
+	assert.NotEmpty(t, removed)
 	assert.False(t, nothingToUnset)
-	updated, nothingToUnset = unset(helmSource, unsetOpts{ignoreMissingValueFiles: true})
-	assert.False(t, updated)
+	removed, nothingToUnset = unset(helmSource, unsetOpts{ignoreMissingValueFiles: true})
+	assert.Empty(t, removed)
 	assert.False(t, nothingToUnset)
 
 	assert.True(t, helmSource.Helm.PassCredentials)
-	updated, nothingToUnset = unset(helmSource, unsetOpts{passCredentials: true})
+	removed, nothingToUnset = unset(helmSource, unsetOpts{passCredentials: true})
 	assert.False(t, helmSource.Helm.PassCredentials)
-	assert.True(t, updated)
+	assert.NotEmpty(t, removed)
 	assert.False(t, nothingToUnset)
-	updated, nothingToUnset = unset(helmSource, unsetOpts{passCredentials: true})
-	assert.False(t, updated)
+	removed, nothingToUnset = unset(helmSource, unsetOpts{passCredentials: true})
+	assert.Empty(t, removed)
 	assert.False(t, nothingToUnset)
 
 	assert.Len(t, pluginSource.Plugin.Env, 2)
-	updated, nothingToUnset = unset(pluginSource, unsetOpts{pluginEnvs: []string{"env-1"}})
+	removed, nothingToUnset = unset(pluginSource, unsetOpts{pluginEnvs: []string{"env-1"}})
 	assert.Len(t, pluginSource.Plugin.Env, 1)
-	assert.True(t, updated)
+	assert.NotEmpty(t, removed)
 	assert.False(t, nothingToUnset)
-	updated, nothingToUnset = unset(pluginSource, unsetOpts{pluginEnvs: []string{"env-1"}})
-	assert.False(t, updated)
+	removed, nothingToUnset = unset(pluginSource, unsetOpts{pluginEnvs: []string{"env-1"}})
+	assert.Empty(t, removed)
 	assert.False(t, nothingToUnset)
 }
 
@@ -1235,13 +1375,50 @@ func Test_unset_nothingToUnset(t *testing.T) {
 		t.Run(testCaseCopy.name, func(t *testing.T) {
 			t.Parallel()
 
-			updated, nothingToUnset := unset(&testCaseCopy.source, unsetOpts{})
-			assert.False(t, updated)
+			removed, nothingToUnset := unset(&testCaseCopy.source, unsetOpts{})
+			assert.Empty(t, removed)
 			assert.True(t, nothingToUnset)
 		})
 	}
 }
 
+func Test_unset_removesEmptyStructs(t *testing.T) {
+	helmSource := &v1alpha1.ApplicationSource{
+		Helm: &v1alpha1.ApplicationSourceHelm{
+			Parameters: []v1alpha1.HelmParameter{{Name: "name-1", Value: "value-1"}},
+			ValueFiles: []string{"values-1.yaml"},
+		},
+	}
+	removed, nothingToUnset := unset(helmSource, unsetOpts{parameters: []string{"name-1"}})
+	assert.Equal(t, []string{"helm.parameters[name-1]"}, removed)
+	assert.False(t, nothingToUnset)
+	require.NotNil(t, helmSource.Helm)
+	removed, nothingToUnset = unset(helmSource, unsetOpts{valuesFiles: []string{"values-1.yaml"}})
+	assert.Equal(t, []string{"helm.valueFiles[values-1.yaml]"}, removed)
+	assert.False(t, nothingToUnset)
+	assert.Nil(t, helmSource.Helm)
+
+	kustomizeSource := &v1alpha1.ApplicationSource{
+		Kustomize: &v1alpha1.ApplicationSourceKustomize{
+			Images: v1alpha1.KustomizeImages{"old1=new:tag"},
+		},
+	}
+	removed, nothingToUnset = unset(kustomizeSource, unsetOpts{kustomizeImages: []string{"old1"}})
+	assert.Equal(t, []string{"kustomize.images[old1=new:tag]"}, removed)
+	assert.False(t, nothingToUnset)
+	assert.Nil(t, kustomizeSource.Kustomize)
+
+	pluginSource := &v1alpha1.ApplicationSource{
+		Plugin: &v1alpha1.ApplicationSourcePlugin{
+			Env: v1alpha1.Env{{Name: "env-1", Value: "env-value-1"}},
+		},
+	}
+	removed, nothingToUnset = unset(pluginSource, unsetOpts{pluginEnvs: []string{"env-1"}})
+	assert.Equal(t, []string{"plugin.env[env-1]"}, removed)
+	assert.False(t, nothingToUnset)
+	assert.NotNil(t, pluginSource.Plugin)
+}
+
 func TestFilterAppResources(t *testing.T) {
 	// App resources
 	var (
@@ -1545,6 +1722,156 @@ func TestParseSelectedResourcesEmptyList(t *testing.T) {
 	assert.Empty(t, operationResources)
 }
 
+func TestFindKeptResources(t *testing.T) {
+	resources := []v1alpha1.ResourceStatus{
+		{Kind: "PersistentVolumeClaim", Version: "v1", Namespace: "default", Name: "data"},
+		{Kind: "PersistentVolumeClaim", Version: "v1", Namespace: "other", Name: "data"},
+		{Group: "apps", Kind: "StatefulSet", Version: "v1", Namespace: "default", Name: "db"},
+	}
+
+	t.Run("match in all namespaces", func(t *testing.T) {
+		keep, err := parseSelectedResources([]string{":PersistentVolumeClaim:data"})
+		require.NoError(t, err)
+		kept, err := findKeptResources(resources, keep)
+		require.NoError(t, err)
+		assert.Equal(t, resources[:2], kept)
+	})
+	t.Run("match in namespace", func(t *testing.T) {
+		keep, err := parseSelectedResources([]string{"apps:StatefulSet:default/db", ":PersistentVolumeClaim:other/data"})
+		require.NoError(t, err)
+		kept, err := findKeptResources(resources, keep)
+		require.NoError(t, err)
+		assert.Equal(t, []v1alpha1.ResourceStatus{resources[2], resources[1]}, kept)
+	})
+	t.Run("no match", func(t *testing.T) {
+		keep, err := parseSelectedResources([]string{"apps:Deployment:db"})
+		require.NoError(t, err)
+		_, err = findKeptResources(resources, keep)
+		assert.ErrorContains(t, err, "apps:Deployment:db is not managed by the application")
+	})
+	t.Run("excluded", func(t *testing.T) {
+		keep, err := parseSelectedResources([]string{"!apps:StatefulSet:db"})
+		require.NoError(t, err)
+		_, err = findKeptResources(resources, keep)
+		assert.ErrorContains(t, err, "cannot be excluded")
+	})
+}
+
+// keptResourcesAppServiceClient serves an application with annotated resources and records the patches of them
+type keptResourcesAppServiceClient struct {
+	fakeAppServiceClient
+	manifests map[string]string
+	patches   map[string][]string
+}
+
+func (c *keptResourcesAppServiceClient) Get(_ context.Context, _ *applicationpkg.ApplicationQuery, _ ...grpc.CallOption) (*v1alpha1.Application, error) {
+	return &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "argocd"},
+		Status: v1alpha1.ApplicationStatus{Resources: []v1alpha1.ResourceStatus{
+			{Kind: "PersistentVolumeClaim", Version: "v1", Namespace: "default", Name: "data"},
+			{Group: "apps", Kind: "StatefulSet", Version: "v1", Namespace: "default", Name: "db"},
+		}},
+	}, nil
+}
+
+func (c *keptResourcesAppServiceClient) GetResource(_ context.Context, in *applicationpkg.ApplicationResourceRequest, _ ...grpc.CallOption) (*applicationpkg.ApplicationResourceResponse, error) {
+	return &applicationpkg.ApplicationResourceResponse{Manifest: ptr.To(c.manifests[in.GetResourceName()])}, nil
+}
+
+func (c *keptResourcesAppServiceClient) PatchResource(_ context.Context, in *applicationpkg.ApplicationResourcePatchRequest, _ ...grpc.CallOption) (*applicationpkg.ApplicationResourceResponse, error) {
+	c.patches[in.GetResourceName()] = append(c.patches[in.GetResourceName()], in.GetPatch())
+	return nil, nil
+}
+
+func TestOrphanKeptResources(t *testing.T) {
+	appIf := &keptResourcesAppServiceClient{
+		manifests: map[string]string{
+			"data": `{"apiVersion":"v1","kind":"PersistentVolumeClaim","metadata":{"name":"data"}}`,
+			"db":   `{"apiVersion":"apps/v1","kind":"StatefulSet","metadata":{"name":"db","annotations":{"argocd.argoproj.io/deletion-policy":"background"}}}`,
+		},
+		patches: map[string][]string{},
+	}
+	keep, err := parseSelectedResources([]string{":PersistentVolumeClaim:data", "apps:StatefulSet:db"})
+	require.NoError(t, err)
+
+	kept, restore, err := orphanKeptResources(t.Context(), appIf, "app", "argocd", keep)
+	require.NoError(t, err)
+	assert.Len(t, kept, 2)
+	assert.Equal(t, []string{`{"metadata":{"annotations":{"argocd.argoproj.io/deletion-policy":"orphan"}}}`}, appIf.patches["data"])
+	assert.Equal(t, []string{`{"metadata":{"annotations":{"argocd.argoproj.io/deletion-policy":"orphan"}}}`}, appIf.patches["db"])
+
+	// the previous deletion policies are restored if the application is not deleted
+	restore()
+	assert.Equal(t, `{"metadata":{"annotations":{"argocd.argoproj.io/deletion-policy":null}}}`, appIf.patches["data"][1])
+	assert.Equal(t, `{"metadata":{"annotations":{"argocd.argoproj.io/deletion-policy":"background"}}}`, appIf.patches["db"][1])
+}
+
+func TestResolveSourcePosition(t *testing.T) {
+	multiSourceApp := &v1alpha1.Application{
+		Spec: v1alpha1.ApplicationSpec{
+			Sources: v1alpha1.ApplicationSources{
+				{RepoURL: "https://github.com/argoproj/argocd-example-apps.git", Name: "guestbook"},
+				{RepoURL: "https://argoproj.github.io/argo-helm", Chart: "argo-cd", Name: "chart"},
+			},
+		},
+	}
+	singleSourceApp := &v1alpha1.Application{
+		Spec: v1alpha1.ApplicationSpec{
+			Source: &v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps.git"},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		app            *v1alpha1.Application
+		sourcePosition int
+		sourceIndex    int
+		sourceName     string
+		expected       int
+		expectedErr    string
+	}{
+		{name: "position", app: multiSourceApp, sourcePosition: 2, sourceIndex: -1, expected: 2},
+		{name: "index", app: multiSourceApp, sourcePosition: -1, sourceIndex: 1, expected: 2},
+		{name: "name", app: multiSourceApp, sourcePosition: -1, sourceIndex: -1, sourceName: "guestbook", expected: 1},
+		{name: "single source", app: singleSourceApp, sourcePosition: -1, sourceIndex: -1, expected: -1},
+		{name: "position and index", app: multiSourceApp, sourcePosition: 1, sourceIndex: 0, expectedErr: "only one of"},
+		{name: "index and name", app: multiSourceApp, sourcePosition: -1, sourceIndex: 0, sourceName: "chart", expectedErr: "only one of"},
+		{name: "unknown name", app: multiSourceApp, sourcePosition: -1, sourceIndex: -1, sourceName: "unknown", expectedErr: "unknown source name 'unknown'"},
+		{name: "negative index", app: multiSourceApp, sourcePosition: -1, sourceIndex: -2, expectedErr: "source index must be greater than or equal to 0"},
+		{name: "index out of range", app: multiSourceApp, sourcePosition: -1, sourceIndex: 2, expectedErr: "less than the number of sources"},
+		{name: "missing source for multiple sources", app: multiSourceApp, sourcePosition: -1, sourceIndex: -1, expectedErr: "should be specified"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pos, err := resolveSourcePosition(tt.app, tt.sourcePosition, tt.sourceIndex, tt.sourceName)
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, pos)
+		})
+	}
+}
+
+func TestSetParameterOverridesMultiSource(t *testing.T) {
+	app := &v1alpha1.Application{
+		Spec: v1alpha1.ApplicationSpec{
+			Sources: v1alpha1.ApplicationSources{
+				{RepoURL: "https://github.com/argoproj/argocd-example-apps.git", Path: "guestbook"},
+				{RepoURL: "https://argoproj.github.io/argo-helm", Chart: "argo-cd"},
+			},
+		},
+		Status: v1alpha1.ApplicationStatus{
+			SourceTypes: []v1alpha1.ApplicationSourceType{v1alpha1.ApplicationSourceTypeDirectory, v1alpha1.ApplicationSourceTypeHelm},
+		},
+	}
+	setParameterOverrides(app, []string{"image.tag=v1.2.0"}, 2)
+	assert.Nil(t, app.Spec.Sources[0].Helm)
+	require.NotNil(t, app.Spec.Sources[1].Helm)
+	assert.Equal(t, []v1alpha1.HelmParameter{{Name: "image.tag", Value: "v1.2.0"}}, app.Spec.Sources[1].Helm.Parameters)
+}
+
 func TestPrintApplicationTableNotWide(t *testing.T) {
 	output, err := captureOutput(func() error {
 		app := &v1alpha1.Application{
@@ -2196,6 +2523,18 @@ func (c *fakeAppServiceClient) Sync(_ context.Context, _ *applicationpkg.Applica
 	return nil, nil
 }
 
+func (c *fakeAppServiceClient) SyncApplications(_ context.Context, _ *applicationpkg.ApplicationsSyncRequest, _ ...grpc.CallOption) (applicationpkg.ApplicationService_SyncApplicationsClient, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) ResourceUsage(_ context.Context, _ *applicationpkg.ApplicationResourceUsageQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationResourceUsageResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) WatchResourceEvents(_ context.Context, _ *applicationpkg.ApplicationResourceEventsQuery, _ ...grpc.CallOption) (applicationpkg.ApplicationService_WatchResourceEventsClient, error) {
+	return nil, nil
+}
+
 func (c *fakeAppServiceClient) ManagedResources(_ context.Context, _ *applicationpkg.ResourcesQuery, _ ...grpc.CallOption) (*applicationpkg.ManagedResourcesResponse, error) {
 	return nil, nil
 }
@@ -2382,3 +2721,575 @@ func (c *fakeAcdClient) WatchApplicationWithRetry(_ context.Context, _ string, _
 	}()
 	return appEventsCh
 }
+
+func (c *fakeAcdClient) WatchApplicationsWithRetry(_ context.Context, _ *applicationpkg.ApplicationQuery) chan *v1alpha1.ApplicationWatchEvent {
+	appEventsCh := make(chan *v1alpha1.ApplicationWatchEvent)
+	close(appEventsCh)
+	return appEventsCh
+}
+
+func (c *fakeAcdClient) NewTerminalConn(_ context.Context, _ url.Values) (*websocket.Conn, error) {
+	return nil, nil
+}
+
+func (c *fakeAcdClient) NewPortForwardConn(_ context.Context, _ url.Values) (*websocket.Conn, error) {
+	return nil, nil
+}
+
+func TestPrintWatchSeparator(t *testing.T) {
+	for output, expected := range map[string]string{"yaml": "---\n", "json": "", "wide": "\n", "name": "\n"} {
+		t.Run(output, func(t *testing.T) {
+			actual, err := captureOutput(func() error {
+				printWatchSeparator(output)
+				return nil
+			})
+			require.NoError(t, err)
+			assert.Equal(t, expected, actual)
+		})
+	}
+}
+
+func TestPrintApplicationsSyncResults(t *testing.T) {
+	results := []*applicationpkg.ApplicationsSyncResult{
+		{Name: ptr.To("frontend"), AppNamespace: ptr.To("argocd"), Phase: ptr.To("Succeeded"), Message: ptr.To("successfully synced (all tasks run)")},
+		{Name: ptr.To("backend"), AppNamespace: ptr.To("argocd"), Phase: ptr.To("Failed"), Message: ptr.To("one or more objects failed to apply")},
+		{Name: ptr.To("database"), AppNamespace: ptr.To("argocd"), Message: ptr.To("skipped because the sync of another application failed")},
+	}
+	var buf bytes.Buffer
+	printApplicationsSyncResults(&buf, results)
+	assert.Equal(t, `NAME             PHASE      MESSAGE
+argocd/frontend  Succeeded  successfully synced (all tasks run)
+argocd/backend   Failed     one or more objects failed to apply
+argocd/database  -          skipped because the sync of another application failed
+`, buf.String())
+	assert.Equal(t, 2, countFailedSyncs(results))
+}
+
+type fakeSyncApplicationsClient struct {
+	grpc.ClientStream
+	results []*applicationpkg.ApplicationsSyncResult
+	err     error
+}
+
+func (c *fakeSyncApplicationsClient) Recv() (*applicationpkg.ApplicationsSyncResult, error) {
+	if len(c.results) == 0 {
+		return nil, c.err
+	}
+	res := c.results[0]
+	c.results = c.results[1:]
+	return res, nil
+}
+
+func TestRecvApplicationsSyncResults(t *testing.T) {
+	newStream := func(err error) *fakeSyncApplicationsClient {
+		return &fakeSyncApplicationsClient{results: []*applicationpkg.ApplicationsSyncResult{
+			{Name: ptr.To("frontend"), Phase: ptr.To("Succeeded")},
+			{Name: ptr.To("backend"), Phase: ptr.To("Failed")},
+		}, err: err}
+	}
+
+	var received []string
+	results, err := recvApplicationsSyncResults(newStream(io.EOF), func(res *applicationpkg.ApplicationsSyncResult) {
+		received = append(received, res.GetName())
+	})
+	require.NoError(t, err)
+	assert.Len(t, results, 2)
+	assert.Equal(t, []string{"frontend", "backend"}, received)
+
+	results, err = recvApplicationsSyncResults(newStream(context.DeadlineExceeded), func(*applicationpkg.ApplicationsSyncResult) {})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Len(t, results, 2)
+}
+
+func TestWaitConditions(t *testing.T) {
+	app := &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-app", Labels: map[string]string{"env": "staging"}},
+		Status: v1alpha1.ApplicationStatus{
+			Sync:    v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeSynced, Revision: "2f4c8b1"},
+			Summary: v1alpha1.ApplicationSummary{Images: []string{"my-image:1.2.3", "sidecar:0.1.0"}},
+		},
+	}
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := newWaitConditions([]string{"status.sync.status =="})
+		require.ErrorContains(t, err, "invalid condition")
+	})
+	t.Run("Met", func(t *testing.T) {
+		conditions, err := newWaitConditions([]string{`"my-image:1.2.3" in status.summary.images`, `metadata.labels.env == "staging" && status.sync.revision startsWith "2f4c"`})
+		require.NoError(t, err)
+		assert.True(t, conditions.met(app))
+	})
+	t.Run("NotMet", func(t *testing.T) {
+		conditions, err := newWaitConditions([]string{`"my-image:1.2.4" in status.summary.images`})
+		require.NoError(t, err)
+		assert.False(t, conditions.met(app))
+	})
+	t.Run("UnsetField", func(t *testing.T) {
+		conditions, err := newWaitConditions([]string{`status.operationState.phase == "Succeeded"`})
+		require.NoError(t, err)
+		assert.False(t, conditions.met(app))
+	})
+	t.Run("NotBool", func(t *testing.T) {
+		conditions, err := newWaitConditions([]string{`status.sync.revision`})
+		require.NoError(t, err)
+		assert.False(t, conditions.met(app))
+	})
+	t.Run("OnlyConditions", func(t *testing.T) {
+		conditions, err := newWaitConditions([]string{`true`})
+		require.NoError(t, err)
+		watch := getWatchOpts(watchOpts{conditions: conditions})
+		assert.True(t, watch.sync)
+		assert.True(t, watch.health)
+		assert.True(t, watch.operation)
+		assert.Same(t, conditions, watch.conditions)
+	})
+	t.Run("ConditionsWithWaitOptions", func(t *testing.T) {
+		conditions, err := newWaitConditions([]string{`true`})
+		require.NoError(t, err)
+		watch := getWatchOpts(watchOpts{health: true, conditions: conditions})
+		assert.False(t, watch.sync)
+		assert.True(t, watch.health)
+		assert.False(t, watch.operation)
+		assert.Same(t, conditions, watch.conditions)
+	})
+}
+
+func TestNewRetryStrategy(t *testing.T) {
+	t.Run("Disabled", func(t *testing.T) {
+		strategy, err := newRetryStrategy(0, time.Second, time.Minute, 2)
+		require.NoError(t, err)
+		assert.Nil(t, strategy)
+	})
+	t.Run("Valid", func(t *testing.T) {
+		strategy, err := newRetryStrategy(5, 10*time.Second, 2*time.Minute, 3)
+		require.NoError(t, err)
+		require.NotNil(t, strategy)
+		assert.Equal(t, int64(5), strategy.Limit)
+		assert.Equal(t, "10s", strategy.Backoff.Duration)
+		assert.Equal(t, "2m0s", strategy.Backoff.MaxDuration)
+		assert.Equal(t, int64(3), *strategy.Backoff.Factor)
+	})
+	t.Run("UnlimitedRetries", func(t *testing.T) {
+		strategy, err := newRetryStrategy(-1, time.Second, time.Minute, 2)
+		require.NoError(t, err)
+		require.NotNil(t, strategy)
+		assert.Equal(t, int64(-1), strategy.Limit)
+	})
+	t.Run("NegativeLimit", func(t *testing.T) {
+		_, err := newRetryStrategy(-2, time.Second, time.Minute, 2)
+		require.ErrorContains(t, err, "invalid retry limit")
+	})
+	t.Run("ZeroDuration", func(t *testing.T) {
+		_, err := newRetryStrategy(1, 0, time.Minute, 2)
+		require.ErrorContains(t, err, "invalid retry backoff duration")
+	})
+	t.Run("MaxDurationLessThanDuration", func(t *testing.T) {
+		_, err := newRetryStrategy(1, time.Minute, time.Second, 2)
+		require.ErrorContains(t, err, "invalid retry backoff max duration")
+	})
+	t.Run("InvalidFactor", func(t *testing.T) {
+		_, err := newRetryStrategy(1, time.Second, time.Minute, 0)
+		require.ErrorContains(t, err, "invalid retry backoff factor")
+	})
+}
+
+func TestFormatRetryAttempt(t *testing.T) {
+	assert.Empty(t, formatRetryAttempt(nil))
+	assert.Empty(t, formatRetryAttempt(&v1alpha1.OperationState{}))
+	assert.Equal(t, "attempt 2/5", formatRetryAttempt(&v1alpha1.OperationState{
+		Operation:  v1alpha1.Operation{Retry: v1alpha1.RetryStrategy{Limit: 5}},
+		RetryCount: 2,
+	}))
+	assert.Equal(t, "attempt 3", formatRetryAttempt(&v1alpha1.OperationState{
+		Operation:  v1alpha1.Operation{Retry: v1alpha1.RetryStrategy{Limit: -1}},
+		RetryCount: 3,
+	}))
+}
+
+func TestUnmarshalApplicationSpec(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		spec, err := unmarshalApplicationSpec([]byte(`
+destination:
+  server: https://kubernetes.default.svc
+  namespace: default
+project: default
+source:
+  repoURL: https://github.com/argoproj/argocd-example-apps.git
+  path: guestbook
+`))
+		require.NoError(t, err)
+		assert.Equal(t, "guestbook", spec.Source.Path)
+	})
+	t.Run("UnknownField", func(t *testing.T) {
+		_, err := unmarshalApplicationSpec([]byte(`
+destination:
+  server: https://kubernetes.default.svc
+source:
+  repoURL: https://github.com/argoproj/argocd-example-apps.git
+  pathh: guestbook
+`))
+		require.ErrorContains(t, err, "unknown field")
+	})
+	t.Run("MissingDestination", func(t *testing.T) {
+		_, err := unmarshalApplicationSpec([]byte(`
+source:
+  repoURL: https://github.com/argoproj/argocd-example-apps.git
+`))
+		require.ErrorContains(t, err, "destination")
+	})
+	t.Run("MissingSource", func(t *testing.T) {
+		_, err := unmarshalApplicationSpec([]byte(`
+destination:
+  name: in-cluster
+`))
+		require.ErrorContains(t, err, "source")
+	})
+}
+
+func TestGetApplicationSpecPatch(t *testing.T) {
+	original := &v1alpha1.ApplicationSpec{
+		Project:     "default",
+		Destination: v1alpha1.ApplicationDestination{Name: "in-cluster"},
+		Source:      &v1alpha1.ApplicationSource{RepoURL: "https://example.com/repo.git", Path: "old"},
+	}
+	updated := original.DeepCopy()
+	updated.Source.Path = "new"
+
+	patch, err := getApplicationSpecPatch(original, updated)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[{"op":"replace","path":"/spec/source/path","value":"new"}]`, string(patch))
+}
+
+func TestLocalHelmValues(t *testing.T) {
+	valuesFile := filepath.Join(t.TempDir(), "values.yaml")
+	require.NoError(t, os.WriteFile(valuesFile, []byte("image:\n  repository: nginx\n  tag: v1\nreplicas: 1\n"), 0o644))
+
+	t.Run("Load", func(t *testing.T) {
+		values, err := localHelmValues{valuesFiles: []string{valuesFile}, setValues: []string{"image.tag=v2", "service.enabled=true"}}.load()
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{
+			"image":    map[string]any{"repository": "nginx", "tag": "v2"},
+			"replicas": float64(1),
+			"service":  map[string]any{"enabled": true},
+		}, values)
+	})
+
+	t.Run("InvalidSetValue", func(t *testing.T) {
+		_, err := localHelmValues{setValues: []string{"image.tag"}}.load()
+		require.ErrorContains(t, err, "key=value")
+	})
+
+	t.Run("ApplyTo", func(t *testing.T) {
+		source := &v1alpha1.ApplicationSource{
+			Helm: &v1alpha1.ApplicationSourceHelm{
+				Parameters: []v1alpha1.HelmParameter{{Name: "image.tag", Value: "v0"}, {Name: "foo", Value: "bar"}},
+				Values:     "replicas: 3\nresources:\n  limits:\n    cpu: 100m\n",
+			},
+		}
+		err := localHelmValues{valuesFiles: []string{valuesFile}, setValues: []string{"image.tag=v2"}}.applyTo(source)
+		require.NoError(t, err)
+		assert.Equal(t, "image:\n  repository: nginx\n  tag: v1\nreplicas: 1\nresources:\n  limits:\n    cpu: 100m", source.Helm.ValuesString())
+		assert.Equal(t, []v1alpha1.HelmParameter{{Name: "image.tag", Value: "v2"}, {Name: "foo", Value: "bar"}}, source.Helm.Parameters)
+	})
+
+	t.Run("OverrideQuery", func(t *testing.T) {
+		source := &v1alpha1.ApplicationSource{
+			Helm: &v1alpha1.ApplicationSourceHelm{
+				Parameters: []v1alpha1.HelmParameter{{Name: "image.tag", Value: "v0"}},
+				Values:     "replicas: 3\n",
+			},
+		}
+		query := &applicationpkg.ApplicationManifestQueryWithFiles{}
+		err := localHelmValues{valuesFiles: []string{valuesFile}, setValues: []string{"image.tag=v2"}}.overrideQuery(source, query)
+		require.NoError(t, err)
+		assert.Equal(t, "image:\n  repository: nginx\n  tag: v1\nreplicas: 1", query.GetHelmValues())
+		assert.Equal(t, []*v1alpha1.HelmParameter{{Name: "image.tag", Value: "v2"}}, query.HelmParameters)
+		assert.Equal(t, "replicas: 3\n", source.Helm.ValuesString())
+	})
+
+	t.Run("Stage", func(t *testing.T) {
+		local := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(local, "Chart.yaml"), []byte("name: test\n"), 0o644))
+		require.NoError(t, os.WriteFile(filepath.Join(local, "values.yaml"), []byte("replicas: 5\n"), 0o644))
+		require.NoError(t, os.WriteFile(filepath.Join(local, "values-prod.yaml"), []byte("replicas: 10\nenv: prod\n"), 0o644))
+		source := &v1alpha1.ApplicationSource{Helm: &v1alpha1.ApplicationSourceHelm{ValueFiles: []string{"values-prod.yaml", "missing.yaml"}}}
+
+		staged, err := localHelmValues{valuesFiles: []string{valuesFile}}.stage(local, source)
+		require.NoError(t, err)
+		defer os.RemoveAll(filepath.Dir(staged))
+
+		data, err := os.ReadFile(filepath.Join(staged, "values-prod.yaml"))
+		require.NoError(t, err)
+		assert.Equal(t, "env: prod\nimage:\n  repository: nginx\n  tag: v1\nreplicas: 1\n", string(data))
+		data, err = os.ReadFile(filepath.Join(staged, "values.yaml"))
+		require.NoError(t, err)
+		assert.Equal(t, "replicas: 5\n", string(data))
+		data, err = os.ReadFile(filepath.Join(local, "values-prod.yaml"))
+		require.NoError(t, err)
+		assert.Equal(t, "replicas: 10\nenv: prod\n", string(data))
+	})
+}
+
+func TestValidateLocalHelmValues(t *testing.T) {
+	app := &v1alpha1.Application{Status: v1alpha1.ApplicationStatus{SourceType: v1alpha1.ApplicationSourceTypeHelm}}
+	require.NoError(t, validateLocalHelmValues(app, &v1alpha1.ApplicationSource{}))
+	require.NoError(t, validateLocalHelmValues(app, &v1alpha1.ApplicationSource{Plugin: &v1alpha1.ApplicationSourcePlugin{}}))
+	err := validateLocalHelmValues(app, &v1alpha1.ApplicationSource{Kustomize: &v1alpha1.ApplicationSourceKustomize{NamePrefix: "x"}})
+	require.ErrorContains(t, err, "not supported for Kustomize sources")
+	err = validateLocalHelmValues(&v1alpha1.Application{}, &v1alpha1.ApplicationSource{})
+	require.ErrorContains(t, err, "source type")
+}
+
+func TestStartLocalPlugin(t *testing.T) {
+	t.Setenv(argocommon.EnvPluginSockFilePath, "")
+	t.Setenv(argocommon.EnvCMPWorkDir, "")
+
+	binary := filepath.Join(t.TempDir(), "generate.sh")
+	script := "#!/bin/sh\nprintf 'apiVersion: v1\\nkind: ConfigMap\\nmetadata:\\n  name: %s\\ndata:\\n  foo: %s\\n' \"$ARGOCD_APP_NAME\" \"$ARGOCD_ENV_FOO\"\n"
+	require.NoError(t, os.WriteFile(binary, []byte(script), 0o755))
+	local := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(local, "config.txt"), []byte("foo"), 0o644))
+
+	app := &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-app"},
+		Spec: v1alpha1.ApplicationSpec{
+			Source: &v1alpha1.ApplicationSource{
+				RepoURL: "https://github.com/argoproj/argocd-example-apps.git",
+				Plugin:  &v1alpha1.ApplicationSourcePlugin{Env: v1alpha1.Env{{Name: "FOO", Value: "bar"}}},
+			},
+		},
+	}
+	stopPlugin, err := startLocalPlugin(app, binary)
+	require.NoError(t, err)
+	defer stopPlugin()
+	assert.Equal(t, localPluginName, app.Spec.Source.Plugin.Name)
+
+	manifests := getLocalObjectsString(t.Context(), app, &v1alpha1.AppProject{}, local, local, "", "", nil, nil, "")
+	require.Len(t, manifests, 1)
+	assert.JSONEq(t, `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"my-app"},"data":{"foo":"bar"}}`, manifests[0])
+
+	_, err = startLocalPlugin(app, filepath.Join(t.TempDir(), "missing"))
+	require.ErrorContains(t, err, "error finding plugin binary")
+}
+
+func TestSubtractMergePatch(t *testing.T) {
+	patch := map[string]any{
+		"metadata": map[string]any{"labels": map[string]any{"a": "1"}},
+		"spec":     map[string]any{"replicas": float64(3), "paused": true},
+	}
+	other := map[string]any{
+		"metadata": map[string]any{"labels": map[string]any{"a": "1"}},
+		"spec":     map[string]any{"paused": true},
+	}
+	assert.Equal(t, map[string]any{"spec": map[string]any{"replicas": float64(3)}}, subtractMergePatch(patch, other))
+	assert.Empty(t, subtractMergePatch(patch, patch))
+}
+
+func TestWithoutIgnoreRules(t *testing.T) {
+	overrides := map[string]v1alpha1.ResourceOverride{
+		"apps/Deployment": {
+			HealthLua:         "return {}",
+			IgnoreDifferences: v1alpha1.OverrideIgnoreDiff{JSONPointers: []string{"/spec/replicas"}},
+		},
+	}
+	res := withoutIgnoreRules(overrides)
+	assert.Empty(t, res["apps/Deployment"].IgnoreDifferences.JSONPointers)
+	assert.Equal(t, "return {}", res["apps/Deployment"].HealthLua)
+	assert.Equal(t, []string{"/spec/replicas"}, overrides["apps/Deployment"].IgnoreDifferences.JSONPointers)
+}
+
+func TestIgnoredDifferences(t *testing.T) {
+	live := []byte(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"guestbook"},"spec":{"replicas":5,"paused":false}}`)
+	target := []byte(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"guestbook"},"spec":{"replicas":1,"paused":true}}`)
+	normalizedTarget := []byte(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"guestbook"},"spec":{"replicas":5,"paused":true}}`)
+
+	t.Run("SomeDifferencesIgnored", func(t *testing.T) {
+		raw := diff.DiffResult{Modified: true, NormalizedLive: live, PredictedLive: target}
+		normalized := diff.DiffResult{Modified: true, NormalizedLive: live, PredictedLive: normalizedTarget}
+		liveObj, targetObj, err := ignoredDifferences(normalized, raw)
+		require.NoError(t, err)
+		require.NotNil(t, targetObj)
+		replicas, _, _ := unstructured.NestedInt64(liveObj.Object, "spec", "replicas")
+		assert.Equal(t, int64(5), replicas)
+		replicas, _, _ = unstructured.NestedInt64(targetObj.Object, "spec", "replicas")
+		assert.Equal(t, int64(1), replicas)
+		paused, _, _ := unstructured.NestedBool(targetObj.Object, "spec", "paused")
+		assert.False(t, paused, "differences which are not ignored must not be shown")
+	})
+
+	t.Run("NothingIgnored", func(t *testing.T) {
+		raw := diff.DiffResult{Modified: true, NormalizedLive: live, PredictedLive: target}
+		_, targetObj, err := ignoredDifferences(raw, raw)
+		require.NoError(t, err)
+		assert.Nil(t, targetObj)
+	})
+
+	t.Run("NoDifferences", func(t *testing.T) {
+		raw := diff.DiffResult{NormalizedLive: live, PredictedLive: live}
+		_, targetObj, err := ignoredDifferences(raw, raw)
+		require.NoError(t, err)
+		assert.Nil(t, targetObj)
+	})
+}
+
+func TestNewResourceDiff(t *testing.T) {
+	key := kube.NewResourceKey("apps", "Deployment", "default", "guestbook")
+	live := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps/v1", "kind": "Deployment",
+		"metadata": map[string]any{"name": "guestbook", "labels": map[string]any{"app": "guestbook"}},
+		"spec":     map[string]any{"replicas": int64(1), "paused": true, "args": []any{"a", "b", "c"}},
+	}}
+	target := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps/v1", "kind": "Deployment",
+		"metadata": map[string]any{"name": "guestbook", "annotations": map[string]any{"a/b": "c"}},
+		"spec":     map[string]any{"replicas": int64(3), "paused": nil, "args": []any{"a"}},
+	}}
+
+	t.Run("JSONPatch", func(t *testing.T) {
+		res, err := newResourceDiff(key, false, live, target, "jsonpatch")
+		require.NoError(t, err)
+		assert.Equal(t, "guestbook", res.Name)
+		assert.Nil(t, res.Live)
+		data, err := json.Marshal(res.Patch)
+		require.NoError(t, err)
+		assert.JSONEq(t, `[
+			{"op":"add","path":"/metadata/annotations","value":{"a/b":"c"}},
+			{"op":"remove","path":"/metadata/labels"},
+			{"op":"remove","path":"/spec/args/2"},
+			{"op":"remove","path":"/spec/args/1"},
+			{"op":"replace","path":"/spec/paused","value":null},
+			{"op":"replace","path":"/spec/replicas","value":3}
+		]`, string(data))
+	})
+
+	t.Run("JSONPatchOfArraysAndEscapedKeys", func(t *testing.T) {
+		live := &unstructured.Unstructured{Object: map[string]any{
+			"metadata": map[string]any{"annotations": map[string]any{"a~b/c": "1"}},
+			"spec":     map[string]any{"args": []any{"a"}, "ports": []any{map[string]any{"port": int64(80)}}},
+		}}
+		target := &unstructured.Unstructured{Object: map[string]any{
+			"metadata": map[string]any{"annotations": map[string]any{"a~b/c": "2"}},
+			"spec":     map[string]any{"args": []any{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"}, "ports": []any{map[string]any{"port": int64(8080)}}},
+		}}
+		for range 5 {
+			res, err := newResourceDiff(key, false, live, target, "jsonpatch")
+			require.NoError(t, err)
+			data, err := json.Marshal(res.Patch)
+			require.NoError(t, err)
+			assert.JSONEq(t, `[
+				{"op":"replace","path":"/metadata/annotations/a~0b~1c","value":"2"},
+				{"op":"add","path":"/spec/args/1","value":"b"},
+				{"op":"add","path":"/spec/args/2","value":"c"},
+				{"op":"add","path":"/spec/args/3","value":"d"},
+				{"op":"add","path":"/spec/args/4","value":"e"},
+				{"op":"add","path":"/spec/args/5","value":"f"},
+				{"op":"add","path":"/spec/args/6","value":"g"},
+				{"op":"add","path":"/spec/args/7","value":"h"},
+				{"op":"add","path":"/spec/args/8","value":"i"},
+				{"op":"add","path":"/spec/args/9","value":"j"},
+				{"op":"add","path":"/spec/args/10","value":"k"},
+				{"op":"replace","path":"/spec/ports/0/port","value":8080}
+			]`, string(data))
+		}
+
+		res, err := newResourceDiff(key, false, target, live, "jsonpatch")
+		require.NoError(t, err)
+		var paths []string
+		for _, op := range res.Patch {
+			paths = append(paths, op.Path)
+		}
+		assert.Equal(t, []string{
+			"/metadata/annotations/a~0b~1c",
+			"/spec/args/10", "/spec/args/9", "/spec/args/8", "/spec/args/7", "/spec/args/6",
+			"/spec/args/5", "/spec/args/4", "/spec/args/3", "/spec/args/2", "/spec/args/1",
+			"/spec/ports/0/port",
+		}, paths)
+	})
+
+	t.Run("JSONPatchOfNewResource", func(t *testing.T) {
+		res, err := newResourceDiff(key, false, nil, target, "jsonpatch")
+		require.NoError(t, err)
+		require.Len(t, res.Patch, 1)
+		assert.Equal(t, "add", res.Patch[0].Operation)
+		assert.Empty(t, res.Patch[0].Path)
+	})
+
+	t.Run("JSONPatchOfPrunedResource", func(t *testing.T) {
+		res, err := newResourceDiff(key, false, live, nil, "jsonpatch")
+		require.NoError(t, err)
+		data, err := json.Marshal(res.Patch)
+		require.NoError(t, err)
+		assert.JSONEq(t, `[{"op":"remove","path":""}]`, string(data))
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		res, err := newResourceDiff(key, true, live, target, "json")
+		require.NoError(t, err)
+		assert.Empty(t, res.Patch)
+		data, err := json.Marshal(res)
+		require.NoError(t, err)
+		assert.Contains(t, string(data), `"group":"apps","kind":"Deployment","namespace":"default","name":"guestbook","ignored":true`)
+		assert.Contains(t, string(data), `"target":{"apiVersion":"apps/v1"`)
+	})
+}
+
+func TestNewSyncOperationResult(t *testing.T) {
+	started := metav1.NewTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	finished := metav1.NewTime(started.Add(90 * time.Second))
+
+	t.Run("Completed", func(t *testing.T) {
+		opState := &v1alpha1.OperationState{
+			Operation:  v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{Revision: "main"}},
+			Phase:      "Failed",
+			StartedAt:  started,
+			FinishedAt: &finished,
+			SyncResult: &v1alpha1.SyncOperationResult{
+				Revision:  "abc123",
+				Resources: v1alpha1.ResourceResults{{Kind: "Deployment", Name: "guestbook", Message: "configured"}},
+			},
+		}
+		res := newSyncOperationResult("argocd/guestbook", opState)
+		assert.Equal(t, "argocd/guestbook", res.Application)
+		assert.Equal(t, "abc123", res.Revision)
+		assert.Equal(t, "1m30s", res.Duration)
+		assert.Equal(t, started, *res.StartedAt)
+
+		data, err := json.Marshal(res)
+		require.NoError(t, err)
+		assert.Contains(t, string(data), `"phase":"Failed"`)
+		assert.Contains(t, string(data), `"message":"configured"`)
+	})
+
+	t.Run("WithoutSyncResult", func(t *testing.T) {
+		opState := &v1alpha1.OperationState{
+			Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{Revisions: []string{"v1", "v2"}}},
+			StartedAt: started,
+		}
+		res := newSyncOperationResult("guestbook", opState)
+		assert.Equal(t, []string{"v1", "v2"}, res.Revisions)
+		assert.Empty(t, res.Duration)
+	})
+}
+
+func TestNewAcceptedSyncOperationResult(t *testing.T) {
+	app := &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "team"},
+		Operation:  &v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{Revision: "abc123"}},
+	}
+	res := newAcceptedSyncOperationResult(app, time.Date(2025, 1, 1, 0, 0, 0, 500, time.UTC))
+	assert.Equal(t, "team/guestbook", res.Application)
+	assert.Equal(t, "abc123", res.Revision)
+	assert.Equal(t, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), res.StartedAt.Time)
+	assert.Nil(t, res.OperationState)
+}
+
+func TestFormatLogEntry(t *testing.T) {
+	entry := &applicationpkg.LogEntry{PodName: ptr.To("guestbook-ui-6b9c"), Content: ptr.To("started")}
+
+	assert.Equal(t, "started", formatLogEntry(entry, false, true))
+	assert.Equal(t, "[guestbook-ui-6b9c] started", formatLogEntry(entry, true, false))
+
+	colored := formatLogEntry(entry, true, true)
+	assert.Regexp(t, `^\x1b\[\d+m\[guestbook-ui-6b9c\]\x1b\[0m started$`, colored)
+	assert.Equal(t, colored, formatLogEntry(entry, true, true), "the color of a pod must be stable")
+}
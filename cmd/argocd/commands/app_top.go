@@ -0,0 +1,106 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
+	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	applicationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/errors"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/templates"
+)
+
+// NewApplicationTopCommand returns a new instance of an `argocd app top` command
+func NewApplicationTopCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		appNamespace string
+		output       string
+		sortBy       string
+	)
+	command := &cobra.Command{
+		Use:               "top APPNAME",
+		ValidArgsFunction: completeAppNames(clientOpts, 1),
+		Short:             "Show the CPU and memory usage of the workloads of an application",
+		Long:              "Show the CPU and memory usage of the workloads of an application, as reported by the metrics-server of the destination cluster. The usage of the pods of a workload, e.g. a deployment, is summed up.",
+		Example: templates.Examples(`
+  # Show the resource usage of the workloads of the application "my-app"
+  argocd app top my-app
+
+  # Show the workloads with the highest memory usage first
+  argocd app top my-app --sort-by memory
+`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			if sortBy != "" && sortBy != "cpu" && sortBy != "memory" {
+				errors.Fatal(errors.ErrorGeneric, fmt.Sprintf("unknown sort field %q: must be one of cpu|memory", sortBy))
+			}
+			appName, appNs := argo.ParseFromQualifiedName(args[0], appNamespace)
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer utilio.Close(conn)
+
+			res, err := appIf.ResourceUsage(ctx, &applicationpkg.ApplicationResourceUsageQuery{
+				Name:         &appName,
+				AppNamespace: &appNs,
+			})
+			errors.CheckErrorWithContext(ctx, err)
+			sortWorkloadResourceUsage(res.Workloads, sortBy)
+
+			switch output {
+			case "json", "yaml":
+				err := PrintResourceList(res.Workloads, output, false)
+				errors.CheckError(err)
+			case "wide", "":
+				printWorkloadResourceUsageTable(os.Stdout, res.Workloads)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+			if res.GetMessage() != "" {
+				fmt.Fprintln(os.Stderr, res.GetMessage())
+			}
+		},
+	}
+	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Namespace of the application")
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: wide|json|yaml")
+	command.Flags().StringVar(&sortBy, "sort-by", "", "Sort the workloads by their usage, highest first. One of: cpu|memory")
+	return command
+}
+
+// sortWorkloadResourceUsage sorts the workloads by their CPU or memory usage, highest first. The order of the server,
+// which is by kind, namespace and name, is kept for an empty field.
+func sortWorkloadResourceUsage(workloads []*applicationpkg.WorkloadResourceUsage, sortBy string) {
+	switch sortBy {
+	case "cpu":
+		sort.SliceStable(workloads, func(i, j int) bool {
+			return workloads[i].GetCpuMillis() > workloads[j].GetCpuMillis()
+		})
+	case "memory":
+		sort.SliceStable(workloads, func(i, j int) bool {
+			return workloads[i].GetMemoryBytes() > workloads[j].GetMemoryBytes()
+		})
+	}
+}
+
+// printWorkloadResourceUsageTable prints the usage of the workloads like kubectl top does, with the CPU usage in
+// millicores and the memory usage in mebibytes
+func printWorkloadResourceUsageTable(out io.Writer, workloads []*applicationpkg.WorkloadResourceUsage) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "KIND\tNAMESPACE\tNAME\tPODS\tCPU\tMEMORY\n")
+	for _, usage := range workloads {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%dm\t%dMi\n",
+			usage.GetKind(), usage.GetNamespace(), usage.GetName(), usage.GetPods(), usage.GetCpuMillis(), usage.GetMemoryBytes()/(1024*1024))
+	}
+	_ = w.Flush()
+}
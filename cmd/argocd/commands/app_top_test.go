@@ -0,0 +1,30 @@
+package commands
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/ptr"
+
+	applicationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+)
+
+func TestWorkloadResourceUsage(t *testing.T) {
+	workloads := []*applicationpkg.WorkloadResourceUsage{
+		{Kind: ptr.To("Deployment"), Namespace: ptr.To("default"), Name: ptr.To("frontend"), Pods: ptr.To(int64(2)), CpuMillis: ptr.To(int64(360)), MemoryBytes: ptr.To(int64(194 * 1024 * 1024))},
+		{Kind: ptr.To("StatefulSet"), Namespace: ptr.To("default"), Name: ptr.To("redis"), Pods: ptr.To(int64(1)), CpuMillis: ptr.To(int64(40)), MemoryBytes: ptr.To(int64(512 * 1024 * 1024))},
+	}
+
+	sortWorkloadResourceUsage(workloads, "memory")
+	assert.Equal(t, "redis", workloads[0].GetName())
+	sortWorkloadResourceUsage(workloads, "cpu")
+	assert.Equal(t, "frontend", workloads[0].GetName())
+
+	var out bytes.Buffer
+	printWorkloadResourceUsageTable(&out, workloads)
+	assert.Equal(t, `KIND         NAMESPACE  NAME      PODS  CPU   MEMORY
+Deployment   default    frontend  2     360m  194Mi
+StatefulSet  default    redis     1     40m   512Mi
+`, out.String())
+}
@@ -1,15 +1,20 @@
 package commands
 
 import (
+	"bufio"
+	"context"
 	"crypto/x509"
+	"encoding/base64"
 	stderrors "errors"
 	"fmt"
+	"io"
 	"os"
 	"sort"
 	"strings"
 	"text/tabwriter"
 
 	"github.com/spf13/cobra"
+	"golang.org/x/crypto/ssh"
 
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/utils"
@@ -139,18 +144,59 @@ func NewCertAddTLSCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 // NewCertAddSSHCommand returns a new instance of an `argocd cert add` command
 func NewCertAddSSHCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		fromFile     string
-		batchProcess bool
-		upsert       bool
-		certificates []appsv1.RepositoryCertificate
+		fromFile       string
+		fromKnownHosts string
+		batchProcess   bool
+		upsert         bool
+		dryRun         bool
+		certificates   []appsv1.RepositoryCertificate
 	)
 
 	command := &cobra.Command{
 		Use:   "add-ssh --batch",
 		Short: "Add SSH known host entries for repository servers",
+		Example: `  # Add SSH known host entries by scanning a host
+  ssh-keyscan cd.example.com | argocd cert add-ssh --batch
+
+  # Import all entries of a known_hosts file, replacing the keys of hosts which already have an entry of the same type
+  argocd cert add-ssh --from-known-hosts ~/.ssh/known_hosts --upsert
+
+  # Preview the import of a known_hosts file without changing anything
+  argocd cert add-ssh --from-known-hosts ~/.ssh/known_hosts --dry-run`,
 		Run: func(c *cobra.Command, _ []string) {
 			ctx := c.Context()
 
+			if fromKnownHosts != "" {
+				if batchProcess || fromFile != "" {
+					errors.Fatal(errors.ErrorGeneric, "--from-known-hosts cannot be combined with --batch or --from")
+				}
+				f, err := os.Open(fromKnownHosts)
+				errors.CheckError(err)
+				defer utilio.Close(f)
+				entries, parseErrs := parseKnownHosts(f)
+				for _, err := range parseErrs {
+					_, _ = fmt.Fprintf(os.Stderr, "%s: %v\n", fromKnownHosts, err)
+				}
+
+				conn, certIf := headless.NewClientOrDie(clientOpts, c).NewCertClientOrDie()
+				defer utilio.Close(conn)
+				existing, err := certIf.ListCertificates(ctx, &certificatepkg.RepositoryCertificateQuery{CertType: "ssh"})
+				errors.CheckError(err)
+				results := planKnownHostsImport(entries, existing.Items, upsert)
+				failed := 0
+				if !dryRun {
+					failed = importKnownHosts(ctx, certIf, results, upsert)
+				}
+				printKnownHostsImportResults(os.Stdout, results, dryRun)
+				if len(parseErrs) > 0 || failed > 0 {
+					errors.Fatalf(errors.ErrorGeneric, "%d malformed lines, %d entries failed to import", len(parseErrs), failed)
+				}
+				return
+			}
+			if dryRun {
+				errors.Fatal(errors.ErrorGeneric, "--dry-run is only supported with --from-known-hosts")
+			}
+
 			conn, certIf := headless.NewClientOrDie(clientOpts, c).NewCertClientOrDie()
 			defer utilio.Close(conn)
 
@@ -167,7 +213,7 @@ func NewCertAddSSHCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 					sshKnownHostsLists, err = certutil.ParseSSHKnownHostsFromStream(os.Stdin)
 				}
 			} else {
-				err = stderrors.New("you need to specify --batch or specify --help for usage instructions")
+				err = stderrors.New("you need to specify --batch or --from-known-hosts, or specify --help for usage instructions")
 			}
 
 			errors.CheckError(err)
@@ -203,11 +249,147 @@ func NewCertAddSSHCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 		},
 	}
 	command.Flags().StringVar(&fromFile, "from", "", "Read SSH known hosts data from file (default is to read from stdin)")
-	command.Flags().BoolVar(&batchProcess, "batch", false, "Perform batch processing by reading in SSH known hosts data (mandatory flag)")
+	command.Flags().StringVar(&fromKnownHosts, "from-known-hosts", "", "Import all entries of a known_hosts file and print the result for each host")
+	command.Flags().BoolVar(&batchProcess, "batch", false, "Perform batch processing by reading in SSH known hosts data (mandatory flag unless --from-known-hosts is used)")
 	command.Flags().BoolVar(&upsert, "upsert", false, "Replace existing SSH server public host keys if key is different in input")
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "Print the result of a --from-known-hosts import without changing anything")
 	return command
 }
 
+// knownHostsImportBatchSize is the maximum number of entries sent in a single create request
+const knownHostsImportBatchSize = 50
+
+const (
+	knownHostsStatusAdded   = "ADDED"
+	knownHostsStatusUpdated = "UPDATED"
+	knownHostsStatusSkipped = "SKIPPED"
+	knownHostsStatusFailed  = "FAILED"
+)
+
+// knownHostsImportResult holds the outcome of importing a single known hosts entry
+type knownHostsImportResult struct {
+	Certificate appsv1.RepositoryCertificate
+	Status      string
+	Reason      string
+}
+
+// parseKnownHosts parses the entries of a known_hosts file, returning one certificate per host. Errors are reported
+// per line so that the valid lines can still be imported.
+func parseKnownHosts(r io.Reader) ([]appsv1.RepositoryCertificate, []error) {
+	var certificates []appsv1.RepositoryCertificate
+	var errs []error
+	scanner := bufio.NewScanner(r)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		switch {
+		case strings.HasPrefix(fields[0], "@"):
+			errs = append(errs, fmt.Errorf("line %d: marker %s is not supported", lineNum, fields[0]))
+			continue
+		case strings.HasPrefix(fields[0], "|"):
+			errs = append(errs, fmt.Errorf("line %d: hashed hostnames are not supported, use ssh-keyscan to get the entry with a plain hostname", lineNum))
+			continue
+		}
+		_, hosts, key, _, _, err := ssh.ParseKnownHosts([]byte(line))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("line %d: %w", lineNum, err))
+			continue
+		}
+		for _, host := range hosts {
+			certificates = append(certificates, appsv1.RepositoryCertificate{
+				ServerName:  host,
+				CertType:    "ssh",
+				CertSubType: key.Type(),
+				CertData:    []byte(base64.StdEncoding.EncodeToString(key.Marshal())),
+				CertInfo:    "SHA256:" + certutil.SSHFingerprintSHA256(key),
+			})
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		errs = append(errs, fmt.Errorf("line %d: %w", lineNum+1, err))
+	}
+	return certificates, errs
+}
+
+// planKnownHostsImport determines for each entry whether it would be added, updated or skipped given the existing
+// SSH known hosts entries. Entries are compared by host, subtype and SHA256 fingerprint, since the server does not
+// return the key data of existing entries.
+func planKnownHostsImport(entries []appsv1.RepositoryCertificate, existing []appsv1.RepositoryCertificate, upsert bool) []*knownHostsImportResult {
+	known := map[string]string{}
+	for _, cert := range existing {
+		known[cert.ServerName+"/"+cert.CertSubType] = cert.CertInfo
+	}
+	var results []*knownHostsImportResult
+	for _, entry := range entries {
+		result := &knownHostsImportResult{Certificate: entry, Status: knownHostsStatusAdded}
+		key := entry.ServerName + "/" + entry.CertSubType
+		if fingerprint, ok := known[key]; ok {
+			switch {
+			case fingerprint == entry.CertInfo:
+				result.Status = knownHostsStatusSkipped
+				result.Reason = "already exists"
+			case upsert:
+				result.Status = knownHostsStatusUpdated
+			default:
+				result.Status = knownHostsStatusSkipped
+				result.Reason = "a different key exists, use --upsert to replace it"
+			}
+		}
+		known[key] = entry.CertInfo
+		results = append(results, result)
+	}
+	return results
+}
+
+// importKnownHosts creates the added and updated entries in batches and returns the number of failed entries
+func importKnownHosts(ctx context.Context, certIf certificatepkg.CertificateServiceClient, results []*knownHostsImportResult, upsert bool) int {
+	var pending []*knownHostsImportResult
+	for _, result := range results {
+		if result.Status == knownHostsStatusAdded || result.Status == knownHostsStatusUpdated {
+			pending = append(pending, result)
+		}
+	}
+	failed := 0
+	for len(pending) > 0 {
+		batch := pending[:min(len(pending), knownHostsImportBatchSize)]
+		pending = pending[len(batch):]
+		certList := &appsv1.RepositoryCertificateList{}
+		for _, result := range batch {
+			certList.Items = append(certList.Items, result.Certificate)
+		}
+		_, err := certIf.CreateCertificate(ctx, &certificatepkg.RepositoryCertificateCreateRequest{
+			Certificates: certList,
+			Upsert:       upsert,
+		})
+		if err != nil {
+			for _, result := range batch {
+				result.Status = knownHostsStatusFailed
+				result.Reason = err.Error()
+			}
+			failed += len(batch)
+		}
+	}
+	return failed
+}
+
+// printKnownHostsImportResults prints the outcome of a known hosts import as a table
+func printKnownHostsImportResults(out io.Writer, results []*knownHostsImportResult, dryRun bool) {
+	if dryRun {
+		_, _ = fmt.Fprintln(out, "Dry run, no changes were made")
+	}
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "HOST\tTYPE\tSTATUS\tREASON\n")
+	for _, result := range results {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", result.Certificate.ServerName, result.Certificate.CertSubType, result.Status, result.Reason)
+	}
+	_ = w.Flush()
+}
+
 // NewCertRemoveCommand returns a new instance of an `argocd cert rm` command
 func NewCertRemoveCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
@@ -0,0 +1,123 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	stderrors "errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	"google.golang.org/grpc"
+
+	certificatepkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/certificate"
+	appsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	certutil "github.com/argoproj/argo-cd/v3/util/cert"
+)
+
+func newTestSSHPublicKey(t *testing.T) ssh.PublicKey {
+	t.Helper()
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	key, err := ssh.NewPublicKey(pub)
+	require.NoError(t, err)
+	return key
+}
+
+func knownHostsLine(hosts string, key ssh.PublicKey) string {
+	return fmt.Sprintf("%s %s %s", hosts, key.Type(), base64.StdEncoding.EncodeToString(key.Marshal()))
+}
+
+func Test_parseKnownHosts(t *testing.T) {
+	key := newTestSSHPublicKey(t)
+	input := strings.Join([]string{
+		"# comment",
+		knownHostsLine("gitlab.example.com,[gitlab.example.com]:2222", key) + " user@host",
+		"|1|F1E1KeoE/eEWhi10WpGv4OdiO6Y=|3988QV0VE8wmZL7suNrYQLITLCg= ssh-ed25519 AAAA",
+		"",
+		"github.com ssh-rsa not-base64",
+		"@cert-authority *.example.com " + key.Type() + " " + base64.StdEncoding.EncodeToString(key.Marshal()),
+	}, "\n")
+
+	certs, errs := parseKnownHosts(strings.NewReader(input))
+	require.Len(t, certs, 2)
+	assert.Equal(t, "gitlab.example.com", certs[0].ServerName)
+	assert.Equal(t, "[gitlab.example.com]:2222", certs[1].ServerName)
+	assert.Equal(t, "ssh-ed25519", certs[0].CertSubType)
+	assert.Equal(t, base64.StdEncoding.EncodeToString(key.Marshal()), string(certs[0].CertData))
+	assert.Equal(t, "SHA256:"+certutil.SSHFingerprintSHA256FromString(knownHostsLine("gitlab.example.com", key)), certs[0].CertInfo)
+
+	require.Len(t, errs, 3)
+	assert.ErrorContains(t, errs[0], "line 3: hashed hostnames are not supported")
+	assert.ErrorContains(t, errs[1], "line 5:")
+	assert.ErrorContains(t, errs[2], "line 6: marker @cert-authority is not supported")
+}
+
+func Test_planKnownHostsImport(t *testing.T) {
+	cert := func(host, fingerprint string) appsv1.RepositoryCertificate {
+		return appsv1.RepositoryCertificate{ServerName: host, CertType: "ssh", CertSubType: "ssh-ed25519", CertInfo: "SHA256:" + fingerprint}
+	}
+	entries := []appsv1.RepositoryCertificate{cert("new.example.com", "a"), cert("same.example.com", "b"), cert("changed.example.com", "c"), cert("new.example.com", "a")}
+	existing := []appsv1.RepositoryCertificate{cert("same.example.com", "b"), cert("changed.example.com", "old")}
+
+	statuses := func(results []*knownHostsImportResult) []string {
+		var s []string
+		for _, r := range results {
+			s = append(s, r.Status)
+		}
+		return s
+	}
+	assert.Equal(t, []string{"ADDED", "SKIPPED", "SKIPPED", "SKIPPED"}, statuses(planKnownHostsImport(entries, existing, false)))
+	assert.Equal(t, []string{"ADDED", "SKIPPED", "UPDATED", "SKIPPED"}, statuses(planKnownHostsImport(entries, existing, true)))
+}
+
+type fakeCertificateClient struct {
+	certificatepkg.CertificateServiceClient
+	requests []*certificatepkg.RepositoryCertificateCreateRequest
+	failOn   string
+}
+
+func (c *fakeCertificateClient) CreateCertificate(_ context.Context, in *certificatepkg.RepositoryCertificateCreateRequest, _ ...grpc.CallOption) (*appsv1.RepositoryCertificateList, error) {
+	c.requests = append(c.requests, in)
+	for _, item := range in.Certificates.Items {
+		if item.ServerName == c.failOn {
+			return nil, stderrors.New("invalid hostname")
+		}
+	}
+	return in.Certificates, nil
+}
+
+func Test_importKnownHosts(t *testing.T) {
+	var results []*knownHostsImportResult
+	for i := 0; i < knownHostsImportBatchSize+1; i++ {
+		results = append(results, &knownHostsImportResult{
+			Certificate: appsv1.RepositoryCertificate{ServerName: fmt.Sprintf("host%d.example.com", i), CertType: "ssh", CertSubType: "ssh-ed25519"},
+			Status:      knownHostsStatusAdded,
+		})
+	}
+	results = append(results, &knownHostsImportResult{Status: knownHostsStatusSkipped})
+	client := &fakeCertificateClient{failOn: fmt.Sprintf("host%d.example.com", knownHostsImportBatchSize)}
+
+	failed := importKnownHosts(t.Context(), client, results, true)
+	require.Len(t, client.requests, 2)
+	assert.Len(t, client.requests[0].Certificates.Items, knownHostsImportBatchSize)
+	assert.True(t, client.requests[0].Upsert)
+	assert.Equal(t, 1, failed)
+	assert.Equal(t, knownHostsStatusAdded, results[0].Status)
+	assert.Equal(t, knownHostsStatusFailed, results[knownHostsImportBatchSize].Status)
+	assert.Equal(t, "invalid hostname", results[knownHostsImportBatchSize].Reason)
+
+	var out bytes.Buffer
+	printKnownHostsImportResults(&out, results[knownHostsImportBatchSize:knownHostsImportBatchSize+1], true)
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 3)
+	assert.Equal(t, "Dry run, no changes were made", lines[0])
+	assert.Equal(t, []string{"HOST", "TYPE", "STATUS", "REASON"}, strings.Fields(lines[1]))
+	assert.Equal(t, []string{"host50.example.com", "ssh-ed25519", "FAILED", "invalid", "hostname"}, strings.Fields(lines[2]))
+}
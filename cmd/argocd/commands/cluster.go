@@ -1,12 +1,19 @@
 package commands
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"maps"
 	"net/http"
 	"os"
 	"regexp"
+	"slices"
 	"strings"
+	"sync"
 	"text/tabwriter"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -14,18 +21,26 @@ import (
 	"github.com/mattn/go-isatty"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
 	cmdutil "github.com/argoproj/argo-cd/v3/cmd/util"
 	"github.com/argoproj/argo-cd/v3/common"
 	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	applicationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
 	clusterpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/cluster"
 	argoappv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/cli"
 	"github.com/argoproj/argo-cd/v3/util/clusterauth"
+	"github.com/argoproj/argo-cd/v3/util/db"
 	"github.com/argoproj/argo-cd/v3/util/errors"
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
 	"github.com/argoproj/argo-cd/v3/util/text/label"
@@ -42,6 +57,8 @@ const (
 	clusterFieldLabel = "labels"
 	// cluster field is 'annotations'
 	clusterFieldAnnotation = "annotations"
+	// cluster field is 'rotateAuthInterval'
+	clusterFieldRotateAuthInterval = "rotateAuthInterval"
 	// indicates managing all namespaces
 	allNamespaces = "*"
 )
@@ -64,15 +81,22 @@ func NewClusterCommand(clientOpts *argocdclient.ClientOptions, pathOpts *clientc
   # Get specific details about a cluster in plain text (wide) format:
   argocd cluster get example-cluster -o wide
 
+  # Check the connectivity, credentials and permissions of a cluster:
+  argocd cluster diagnose example-cluster
+
   # Remove a target cluster context from ArgoCD
   argocd cluster rm example-cluster
 
   # Set a target cluster context from ArgoCD
   argocd cluster set CLUSTER_NAME --name new-cluster-name --namespace '*'
-  argocd cluster set CLUSTER_NAME --name new-cluster-name --namespace namespace-one --namespace namespace-two`,
+  argocd cluster set CLUSTER_NAME --name new-cluster-name --namespace namespace-one --namespace namespace-two
+
+  # Add or change the env label and remove the team label
+  argocd cluster set CLUSTER_NAME --label env=prod --label team-`,
 	}
 
 	command.AddCommand(NewClusterAddCommand(clientOpts, pathOpts))
+	command.AddCommand(NewClusterDiagnoseCommand(clientOpts))
 	command.AddCommand(NewClusterGetCommand(clientOpts))
 	command.AddCommand(NewClusterListCommand(clientOpts))
 	command.AddCommand(NewClusterRemoveCommand(clientOpts, pathOpts))
@@ -88,15 +112,66 @@ func NewClusterAddCommand(clientOpts *argocdclient.ClientOptions, pathOpts *clie
 		skipConfirmation bool
 		labels           []string
 		annotations      []string
+		bearerTokenFile  string
+		caDataFile       string
+		server           string
+		execPassthrough  bool
+		assumeExecAvail  bool
+		dryRun           bool
+		output           string
 	)
 	command := &cobra.Command{
-		Use:   "add CONTEXT",
+		Use:   "add [CONTEXT]",
 		Short: cliName + " cluster add CONTEXT",
+		Example: `  # Add the cluster of a kubeconfig context, installing the argocd-manager service account on it
+  argocd cluster add my-context
+
+  # Add a cluster with the token of an existing service account and its CA, without a kubeconfig context
+  argocd cluster add --cluster-server https://10.0.0.1:6443 --name my-cluster --bearer-token-file token --ca-data-file ca.crt
+
+  # Add the cluster of a context using an exec credential plugin, which Argo CD invokes whenever it connects
+  argocd cluster add my-eks-context --exec-command-passthrough
+
+  # Add an EKS, GKE or AKS cluster which Argo CD authenticates to with the workload identity of its own pods,
+  # without storing a long-lived service account token
+  argocd cluster add my-eks-context --aws-cluster-name my-cluster --aws-role-arn arn:aws:iam::123456789012:role/argocd-deployer
+  argocd cluster add my-gke-context --gcp-workload-identity
+  argocd cluster add my-aks-context --azure-workload-identity
+
+  # Add a cluster whose bearer token is rotated by the application controller every 30 days
+  argocd cluster add my-context --rotate-auth-interval 720h
+
+  # Print the RBAC resources which would be installed on the cluster and the cluster secret which would be stored in
+  # Argo CD, without creating anything. The bearer token in the cluster secret is redacted.
+  argocd cluster add my-context --namespace team-a --namespace team-b --dry-run -o yaml`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
+			if output != "yaml" && output != "json" {
+				log.Fatalf("Unknown output format: %s. Supported formats: yaml|json", output)
+			}
+
+			staticCredentials := bearerTokenFile != ""
+			if !staticCredentials && (caDataFile != "" || server != "") {
+				log.Fatal("--ca-data-file and --cluster-server can only be used with --bearer-token-file")
+			}
+			errors.CheckError(clusterOpts.ValidateCredentialFlags())
+			credentialFlags := clusterOpts.CredentialFlags()
+			if staticCredentials && (clusterOpts.ServiceAccount != "" || len(credentialFlags) > 0) {
+				log.Fatal("--bearer-token-file cannot be used with --service-account, --aws-cluster-name, --gcp-workload-identity, --azure-workload-identity or --exec-command")
+			}
+			if execPassthrough && (staticCredentials || clusterOpts.ServiceAccount != "" || len(credentialFlags) > 0) {
+				log.Fatal("--exec-command-passthrough cannot be used with --bearer-token-file, --service-account, --aws-cluster-name, --gcp-workload-identity, --azure-workload-identity or --exec-command")
+			}
+			if execPassthrough && clusterOpts.RotateAuthInterval > 0 {
+				log.Fatal("--rotate-auth-interval cannot be used with --exec-command-passthrough")
+			}
+			if staticCredentials && len(args) == 0 && clusterOpts.Name == "" {
+				log.Fatal("--name is required when adding a cluster without a kubeconfig context")
+			}
+
 			var configAccess clientcmd.ConfigAccess = pathOpts
-			if len(args) == 0 {
+			if len(args) == 0 && (!staticCredentials || server == "") {
 				log.Error("Choose a context name from:")
 				cmdutil.PrintKubeContexts(configAccess)
 				os.Exit(1)
@@ -107,9 +182,25 @@ func NewClusterAddCommand(clientOpts *argocdclient.ClientOptions, pathOpts *clie
 				return
 			}
 
-			contextName := args[0]
-			conf, err := getRestConfig(pathOpts, contextName)
-			errors.CheckError(err)
+			var contextName string
+			conf := &rest.Config{}
+			var err error
+			if len(args) > 0 {
+				contextName = args[0]
+				conf, err = getRestConfig(pathOpts, contextName)
+				errors.CheckError(err)
+			}
+			var staticBearerToken string
+			if staticCredentials {
+				staticBearerToken, err = cmdutil.ReadBearerTokenFile(bearerTokenFile)
+				errors.CheckError(err)
+				var caData []byte
+				if caDataFile != "" {
+					caData, err = cmdutil.ReadCADataFile(caDataFile)
+					errors.CheckError(err)
+				}
+				errors.CheckError(cmdutil.SetStaticCredentials(conf, server, staticBearerToken, caData))
+			}
 			if clusterOpts.ProxyUrl != "" {
 				u, err := argoappv1.ParseProxyUrl(clusterOpts.ProxyUrl)
 				errors.CheckError(err)
@@ -120,13 +211,35 @@ func NewClusterAddCommand(clientOpts *argocdclient.ClientOptions, pathOpts *clie
 			managerBearerToken := ""
 			var awsAuthConf *argoappv1.AWSAuthConfig
 			var execProviderConf *argoappv1.ExecProviderConfig
+			var manifests []runtime.Object
+			isTerminal := isatty.IsTerminal(os.Stdout.Fd()) || isatty.IsCygwinTerminal(os.Stdout.Fd())
+			checkExecCommand := func(command string) {
+				if assumeExecAvail {
+					return
+				}
+				if warning := cmdutil.ExecCommandWarning(command); warning != "" {
+					log.Warn(warning)
+					if isTerminal && !skipConfirmation && !cli.AskToProceed("Do you want to continue [y/N]? ") {
+						os.Exit(1)
+					}
+				}
+			}
 			switch {
+			case execPassthrough:
+				execProviderConf, err = cmdutil.ExecProviderConfigFromRestConfig(conf)
+				errors.CheckError(err)
+				checkExecCommand(execProviderConf.Command)
+			case staticCredentials:
+				// the token was issued for an existing service account, so RBAC resources are not installed
+				managerBearerToken = staticBearerToken
 			case clusterOpts.AwsClusterName != "":
 				awsAuthConf = &argoappv1.AWSAuthConfig{
 					ClusterName: clusterOpts.AwsClusterName,
 					RoleARN:     clusterOpts.AwsRoleArn,
 					Profile:     clusterOpts.AwsProfile,
 				}
+			case clusterOpts.GCPWorkloadIdentity || clusterOpts.AzureWorkloadIdentity:
+				execProviderConf = clusterOpts.WorkloadIdentityExecProviderConfig()
 			case clusterOpts.ExecProviderCommand != "":
 				execProviderConf = &argoappv1.ExecProviderConfig{
 					Command:     clusterOpts.ExecProviderCommand,
@@ -135,12 +248,17 @@ func NewClusterAddCommand(clientOpts *argocdclient.ClientOptions, pathOpts *clie
 					APIVersion:  clusterOpts.ExecProviderAPIVersion,
 					InstallHint: clusterOpts.ExecProviderInstallHint,
 				}
+				checkExecCommand(execProviderConf.Command)
+			case dryRun:
+				// Only render the RBAC resources for managing the cluster, the token is not known until they exist
+				manifests, err = clusterManagerManifests(ctx, clientset, clusterOpts.SystemNamespace, clusterOpts.ServiceAccount, clusterOpts.Namespaces)
+				errors.CheckError(err)
+				managerBearerToken = redactedCredential
 			default:
 				// Install RBAC resources for managing the cluster
 				if clusterOpts.ServiceAccount != "" {
 					managerBearerToken, err = clusterauth.GetServiceAccountBearerToken(clientset, clusterOpts.SystemNamespace, clusterOpts.ServiceAccount, common.BearerTokenTimeout)
 				} else {
-					isTerminal := isatty.IsTerminal(os.Stdout.Fd()) || isatty.IsCygwinTerminal(os.Stdout.Fd())
 					if isTerminal && !skipConfirmation {
 						accessLevel := "cluster"
 						if len(clusterOpts.Namespaces) > 0 {
@@ -161,8 +279,6 @@ func NewClusterAddCommand(clientOpts *argocdclient.ClientOptions, pathOpts *clie
 			annotationsMap, err := label.Parse(annotations)
 			errors.CheckError(err)
 
-			conn, clusterIf := headless.NewClientOrDie(clientOpts, c).NewClusterClientOrDie()
-			defer utilio.Close(conn)
 			if clusterOpts.Name != "" {
 				contextName = clusterOpts.Name
 			}
@@ -186,6 +302,18 @@ func NewClusterAddCommand(clientOpts *argocdclient.ClientOptions, pathOpts *clie
 			if clusterOpts.Project != "" {
 				clst.Project = clusterOpts.Project
 			}
+			if clusterOpts.RotateAuthInterval > 0 {
+				clst.Config.RotateAuthInterval = clusterOpts.RotateAuthInterval.String()
+			}
+			if dryRun {
+				secret, err := clusterSecretManifest(clst)
+				errors.CheckError(err)
+				errors.CheckError(printClusterAddManifests(os.Stdout, append(manifests, secret), output))
+				return
+			}
+
+			conn, clusterIf := headless.NewClientOrDie(clientOpts, c).NewClusterClientOrDie()
+			defer utilio.Close(conn)
 			clstCreateReq := clusterpkg.ClusterCreateRequest{
 				Cluster: clst,
 				Upsert:  clusterOpts.Upsert,
@@ -203,10 +331,89 @@ func NewClusterAddCommand(clientOpts *argocdclient.ClientOptions, pathOpts *clie
 	command.Flags().StringArrayVar(&labels, "label", nil, "Set metadata labels (e.g. --label key=value)")
 	command.Flags().StringArrayVar(&annotations, "annotation", nil, "Set metadata annotations (e.g. --annotation key=value)")
 	command.Flags().StringVar(&clusterOpts.ProxyUrl, "proxy-url", "", "use proxy to connect cluster")
+	command.Flags().StringVar(&bearerTokenFile, "bearer-token-file", "", "Path to a file containing the bearer token of an existing service account to use instead of installing the argocd-manager service account")
+	command.Flags().StringVar(&caDataFile, "ca-data-file", "", "Path to a file containing the PEM encoded certificate authority of the cluster. Requires --bearer-token-file")
+	command.Flags().BoolVar(&execPassthrough, "exec-command-passthrough", false, "Store the exec credential plugin configuration of the kubeconfig context, so Argo CD runs the plugin when connecting to the cluster")
+	command.Flags().BoolVar(&assumeExecAvail, "assume-exec-available", false, "Don't warn when the exec command is not part of the Argo CD image")
+	command.Flags().StringVar(&server, "cluster-server", "", "Cluster API server URL, allows adding a cluster without a kubeconfig context. Requires --bearer-token-file")
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "Print the resources which would be created on the cluster and the cluster secret which would be stored in Argo CD, without creating them")
+	command.Flags().StringVarP(&output, "output", "o", "yaml", "Output format of --dry-run. One of: yaml|json")
 	cmdutil.AddClusterFlags(command, &clusterOpts)
 	return command
 }
 
+// redactedCredential replaces credentials in the manifests printed by `argocd cluster add --dry-run`
+const redactedCredential = "REDACTED"
+
+// clusterManagerManifests returns the resources which `argocd cluster add` creates on the cluster in order to obtain a
+// bearer token. With an existing service account only its token secret is created, and the account must exist.
+func clusterManagerManifests(ctx context.Context, clientset kubernetes.Interface, systemNamespace, serviceAccount string, namespaces []string) ([]runtime.Object, error) {
+	if serviceAccount == "" {
+		return clusterauth.ClusterManagerRBACManifests(systemNamespace, namespaces), nil
+	}
+	if _, err := clientset.CoreV1().ServiceAccounts(systemNamespace).Get(ctx, serviceAccount, metav1.GetOptions{}); err != nil {
+		return nil, fmt.Errorf("failed to get service account %q in namespace %q: %w", serviceAccount, systemNamespace, err)
+	}
+	return []runtime.Object{clusterauth.NewServiceAccountTokenSecret(serviceAccount, systemNamespace)}, nil
+}
+
+// clusterSecretManifest returns the secret in which Argo CD stores the cluster, with its credentials redacted and its
+// data in clear text so that it can be reviewed
+func clusterSecretManifest(clst *argoappv1.Cluster) (*corev1.Secret, error) {
+	clst = clst.DeepCopy()
+	if clst.Config.BearerToken != "" {
+		clst.Config.BearerToken = redactedCredential
+	}
+	if clst.Config.Password != "" {
+		clst.Config.Password = redactedCredential
+	}
+	if len(clst.Config.KeyData) > 0 {
+		clst.Config.KeyData = []byte(redactedCredential)
+	}
+	secret, err := db.NewClusterSecret(clst)
+	if err != nil {
+		return nil, err
+	}
+	secret.TypeMeta = metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"}
+	secret.StringData = make(map[string]string, len(secret.Data))
+	for k, v := range secret.Data {
+		secret.StringData[k] = string(v)
+	}
+	secret.Data = nil
+	return secret, nil
+}
+
+// printClusterAddManifests prints the manifests as a multi-document YAML stream, or as a JSON list, which can be
+// applied with kubectl. The cluster secret comes last and belongs to the namespace Argo CD is installed in.
+func printClusterAddManifests(out io.Writer, manifests []runtime.Object, output string) error {
+	switch output {
+	case "json":
+		data, err := json.MarshalIndent(map[string]any{"apiVersion": "v1", "kind": "List", "items": manifests}, "", "  ")
+		if err != nil {
+			return fmt.Errorf("unable to marshal manifests to json: %w", err)
+		}
+		_, err = fmt.Fprintln(out, string(data))
+		return err
+	case "yaml":
+		for i, obj := range manifests {
+			data, err := yaml.Marshal(obj)
+			if err != nil {
+				return fmt.Errorf("unable to marshal manifests to yaml: %w", err)
+			}
+			if i > 0 {
+				_, _ = fmt.Fprintln(out, "---")
+			}
+			if i == len(manifests)-1 {
+				_, _ = fmt.Fprintln(out, "# Cluster secret to apply in the namespace Argo CD is installed in, once the redacted credentials are filled in")
+			}
+			_, _ = fmt.Fprint(out, string(data))
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown output format: %s", output)
+	}
+}
+
 func getRestConfig(pathOpts *clientcmd.PathOptions, ctxName string) (*rest.Config, error) {
 	config, err := pathOpts.GetStartingConfig()
 	if err != nil {
@@ -234,17 +441,23 @@ func getRestConfig(pathOpts *clientcmd.PathOptions, ctxName string) (*rest.Confi
 // NewClusterSetCommand returns a new instance of an `argocd cluster set` command
 func NewClusterSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		clusterOptions cmdutil.ClusterOptions
-		clusterName    string
-		labels         []string
-		annotations    []string
+		clusterOptions     cmdutil.ClusterOptions
+		clusterName        string
+		labels             []string
+		annotations        []string
+		rotateAuthInterval string
 	)
 	command := &cobra.Command{
-		Use:   "set NAME",
-		Short: "Set cluster information",
+		Use:               "set NAME",
+		ValidArgsFunction: completeClusters(clientOpts, 1),
+		Short:             "Set cluster information",
 		Example: `  # Set cluster information
   argocd cluster set CLUSTER_NAME --name new-cluster-name --namespace '*'
-  argocd cluster set CLUSTER_NAME --name new-cluster-name --namespace namespace-one --namespace namespace-two`,
+  argocd cluster set CLUSTER_NAME --name new-cluster-name --namespace namespace-one --namespace namespace-two
+
+  # Rotate the bearer token of the cluster every 30 days, or stop rotating it
+  argocd cluster set CLUSTER_NAME --rotate-auth-interval 720h
+  argocd cluster set CLUSTER_NAME --rotate-auth-interval ""`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 			if len(args) != 1 {
@@ -257,17 +470,28 @@ func NewClusterSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 			defer utilio.Close(conn)
 			// checks the fields that needs to be updated
 			updatedFields := checkFieldsToUpdate(clusterOptions, labels, annotations)
+			if c.Flags().Changed("rotate-auth-interval") {
+				updatedFields = append(updatedFields, clusterFieldRotateAuthInterval)
+			}
 			namespaces := clusterOptions.Namespaces
 			// check if all namespaces have to be considered
 			if len(namespaces) == 1 && strings.EqualFold(namespaces[0], allNamespaces) {
 				namespaces[0] = ""
 			}
-			// parse the labels you're receiving from the label flag
-			labelsMap, err := label.Parse(labels)
-			errors.CheckError(err)
-			// parse the annotations you're receiving from the annotation flag
-			annotationsMap, err := label.Parse(annotations)
-			errors.CheckError(err)
+			clusterID := &clusterpkg.ClusterID{
+				Type:  clusterIdTypeName,
+				Value: clusterName,
+			}
+			// labels and annotations are applied on top of the existing ones, so fetch the cluster first
+			var labelsMap, annotationsMap map[string]string
+			if labels != nil || annotations != nil {
+				existing, err := clusterIf.Get(ctx, &clusterpkg.ClusterQuery{Id: clusterID})
+				errors.CheckError(err)
+				labelsMap, err = applyMetadataChanges(existing.Labels, labels, validateLabel)
+				errors.CheckError(err)
+				annotationsMap, err = applyMetadataChanges(existing.Annotations, annotations, validateAnnotation)
+				errors.CheckError(err)
+			}
 			if updatedFields != nil {
 				clusterUpdateRequest := clusterpkg.ClusterUpdateRequest{
 					Cluster: &argoappv1.Cluster{
@@ -275,14 +499,12 @@ func NewClusterSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 						Namespaces:  namespaces,
 						Labels:      labelsMap,
 						Annotations: annotationsMap,
+						Config:      argoappv1.ClusterConfig{RotateAuthInterval: rotateAuthInterval},
 					},
 					UpdatedFields: updatedFields,
-					Id: &clusterpkg.ClusterID{
-						Type:  clusterIdTypeName,
-						Value: clusterName,
-					},
+					Id:            clusterID,
 				}
-				_, err := clusterIf.Update(ctx, &clusterUpdateRequest)
+				updated, err := clusterIf.Update(ctx, &clusterUpdateRequest)
 				if err != nil {
 					if status.Code(err) == codes.PermissionDenied {
 						log.Error("Ensure that the cluster is present and you have the necessary permissions to update the cluster")
@@ -290,6 +512,12 @@ func NewClusterSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 					errors.CheckError(err)
 				}
 				fmt.Printf("Cluster '%s' updated.\n", clusterName)
+				if labels != nil {
+					printMetadata(os.Stdout, "Labels", updated.Labels)
+				}
+				if annotations != nil {
+					printMetadata(os.Stdout, "Annotations", updated.Annotations)
+				}
 			} else {
 				fmt.Print("Specify the cluster field to be updated.\n")
 			}
@@ -297,11 +525,69 @@ func NewClusterSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 	}
 	command.Flags().StringVar(&clusterOptions.Name, "name", "", "Overwrite the cluster name")
 	command.Flags().StringArrayVar(&clusterOptions.Namespaces, "namespace", nil, "List of namespaces which are allowed to manage. Specify '*' to manage all namespaces")
-	command.Flags().StringArrayVar(&labels, "label", nil, "Set metadata labels (e.g. --label key=value)")
-	command.Flags().StringArrayVar(&annotations, "annotation", nil, "Set metadata annotations (e.g. --annotation key=value)")
+	command.Flags().StringArrayVar(&labels, "label", nil, "Set metadata labels (e.g. --label key=value), or remove one with a trailing dash (e.g. --label key-)")
+	command.Flags().StringArrayVar(&annotations, "annotation", nil, "Set metadata annotations (e.g. --annotation key=value), or remove one with a trailing dash (e.g. --annotation key-)")
+	command.Flags().StringVar(&rotateAuthInterval, "rotate-auth-interval", "", "Interval at which the application controller rotates the bearer token of the cluster, e.g. 720h. An empty value disables the rotation")
 	return command
 }
 
+// applyMetadataChanges returns a copy of existing with the given key=value changes applied. A change of the form
+// key- removes the key.
+func applyMetadataChanges(existing map[string]string, changes []string, validate func(key, value string) error) (map[string]string, error) {
+	result := make(map[string]string, len(existing))
+	maps.Copy(result, existing)
+	for _, change := range changes {
+		key, value, found := strings.Cut(change, "=")
+		if !found {
+			if !strings.HasSuffix(change, "-") {
+				return nil, fmt.Errorf("invalid change %q: expected key=value to set or key- to remove", change)
+			}
+			key = strings.TrimSuffix(change, "-")
+			if err := validate(key, ""); err != nil {
+				return nil, err
+			}
+			delete(result, key)
+			continue
+		}
+		if err := validate(key, value); err != nil {
+			return nil, err
+		}
+		result[key] = value
+	}
+	return result, nil
+}
+
+// validateLabel checks that key and value are a valid Kubernetes label
+func validateLabel(key, value string) error {
+	if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+		return fmt.Errorf("invalid label key %q: %s", key, strings.Join(errs, "; "))
+	}
+	if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+		return fmt.Errorf("invalid value %q for label %q: %s", value, key, strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// validateAnnotation checks that key is a valid Kubernetes annotation key
+func validateAnnotation(key, _ string) error {
+	if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+		return fmt.Errorf("invalid annotation key %q: %s", key, strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// printMetadata prints labels or annotations sorted by key
+func printMetadata(out io.Writer, title string, metadata map[string]string) {
+	_, _ = fmt.Fprintf(out, "%s:\n", title)
+	if len(metadata) == 0 {
+		_, _ = fmt.Fprintf(out, "  <none>\n")
+		return
+	}
+	for _, key := range slices.Sorted(maps.Keys(metadata)) {
+		_, _ = fmt.Fprintf(out, "  %s=%s\n", key, metadata[key])
+	}
+}
+
 // checkFieldsToUpdate returns the fields that needs to be updated
 func checkFieldsToUpdate(clusterOptions cmdutil.ClusterOptions, labels []string, annotations []string) []string {
 	var updatedFields []string
@@ -322,12 +608,19 @@ func checkFieldsToUpdate(clusterOptions cmdutil.ClusterOptions, labels []string,
 
 // NewClusterGetCommand returns a new instance of an `argocd cluster get` command
 func NewClusterGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
-	var output string
+	var (
+		output   string
+		diagnose bool
+	)
 	command := &cobra.Command{
-		Use:   "get SERVER/NAME",
-		Short: "Get cluster information",
+		Use:               "get SERVER/NAME",
+		ValidArgsFunction: completeClusters(clientOpts, 0),
+		Short:             "Get cluster information",
 		Example: `argocd cluster get https://12.34.567.89
-argocd cluster get in-cluster`,
+argocd cluster get in-cluster
+
+# Show why a cluster is in Unknown or Failed connection state
+argocd cluster get in-cluster -o wide --diagnose`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
@@ -345,10 +638,24 @@ argocd cluster get in-cluster`,
 			}
 			switch output {
 			case "yaml", "json":
+				if diagnose {
+					res := make([]clusterWithDiagnosis, 0, len(clusters))
+					for i := range clusters {
+						res = append(res, clusterWithDiagnosis{Cluster: &clusters[i], Diagnosis: diagnoseCluster(clusters[i])})
+					}
+					err := PrintResourceList(res, output, true)
+					errors.CheckError(err)
+					return
+				}
 				err := PrintResourceList(clusters, output, true)
 				errors.CheckError(err)
 			case "wide", "":
 				printClusterDetails(clusters)
+				if diagnose {
+					for _, clst := range clusters {
+						printClusterDiagnosis(os.Stdout, clst.Server, diagnoseCluster(clst))
+					}
+				}
 			case "server":
 				printClusterServers(clusters)
 			default:
@@ -358,9 +665,73 @@ argocd cluster get in-cluster`,
 	}
 	// we have yaml as default to not break backwards-compatibility
 	command.Flags().StringVarP(&output, "output", "o", "yaml", "Output format. One of: json|yaml|wide|server")
+	command.Flags().BoolVar(&diagnose, "diagnose", false, "Include connection and cache diagnostics reported by the application controller")
 	return command
 }
 
+// clusterWithDiagnosis is the output of `argocd cluster get --diagnose` in json or yaml format
+type clusterWithDiagnosis struct {
+	*argoappv1.Cluster
+	Diagnosis clusterDiagnosis `json:"diagnosis"`
+}
+
+// clusterDiagnosis summarizes the connection and cache state the application controller tracks for a cluster
+type clusterDiagnosis struct {
+	ConnectionStatus  argoappv1.ConnectionStatus `json:"connectionStatus"`
+	AttemptedAt       *metav1.Time               `json:"attemptedAt,omitempty"`
+	ServerVersion     string                     `json:"serverVersion,omitempty"`
+	LastCacheSyncTime *metav1.Time               `json:"lastCacheSyncTime,omitempty"`
+	APIsCount         int64                      `json:"apisCount"`
+	ResourcesCount    int64                      `json:"resourcesCount"`
+	ApplicationsCount int64                      `json:"applicationsCount"`
+	FailedAPIGroups   []string                   `json:"failedAPIGroups,omitempty"`
+	ErrorChain        []string                   `json:"errorChain,omitempty"`
+}
+
+// diagnoseCluster builds a diagnosis from the cluster info reported by the application controller
+func diagnoseCluster(clst argoappv1.Cluster) clusterDiagnosis {
+	info := clst.Info
+	return clusterDiagnosis{
+		ConnectionStatus:  info.ConnectionState.Status,
+		AttemptedAt:       info.ConnectionState.ModifiedAt,
+		ServerVersion:     info.ServerVersion,
+		LastCacheSyncTime: info.CacheInfo.LastCacheSyncTime,
+		APIsCount:         info.CacheInfo.APIsCount,
+		ResourcesCount:    info.CacheInfo.ResourcesCount,
+		ApplicationsCount: info.ApplicationsCount,
+		FailedAPIGroups:   info.FailedAPIGroups,
+		ErrorChain:        info.ErrorChain,
+	}
+}
+
+// printClusterDiagnosis prints the diagnosis of the cluster with the given server
+func printClusterDiagnosis(out io.Writer, server string, diagnosis clusterDiagnosis) {
+	formatTime := func(t *metav1.Time) string {
+		if t == nil {
+			return "-"
+		}
+		return t.Format(time.RFC3339)
+	}
+	_, _ = fmt.Fprintf(out, "Connection diagnostics (%s)\n\n", server)
+	_, _ = fmt.Fprintf(out, "  Connection status:     %s\n", strWithDefault(string(diagnosis.ConnectionStatus), "-"))
+	_, _ = fmt.Fprintf(out, "  Last attempt:          %s\n", formatTime(diagnosis.AttemptedAt))
+	_, _ = fmt.Fprintf(out, "  Server version:        %s\n", strWithDefault(diagnosis.ServerVersion, "-"))
+	_, _ = fmt.Fprintf(out, "  Last cache sync:       %s\n", formatTime(diagnosis.LastCacheSyncTime))
+	_, _ = fmt.Fprintf(out, "  Cached APIs:           %d\n", diagnosis.APIsCount)
+	_, _ = fmt.Fprintf(out, "  Cached resources:      %d\n", diagnosis.ResourcesCount)
+	_, _ = fmt.Fprintf(out, "  Applications:          %d\n", diagnosis.ApplicationsCount)
+	if len(diagnosis.FailedAPIGroups) > 0 {
+		_, _ = fmt.Fprintf(out, "  Failed API groups:     %s\n", strings.Join(diagnosis.FailedAPIGroups, ", "))
+	}
+	if len(diagnosis.ErrorChain) > 0 {
+		_, _ = fmt.Fprintf(out, "  Error chain:\n")
+		for i, e := range diagnosis.ErrorChain {
+			_, _ = fmt.Fprintf(out, "    %s%s\n", strings.Repeat("  ", i), e)
+		}
+	}
+	_, _ = fmt.Fprintln(out)
+}
+
 func strWithDefault(value string, def string) string {
 	if value == "" {
 		return def
@@ -390,20 +761,55 @@ func printClusterDetails(clusters []argoappv1.Cluster) {
 		fmt.Printf("  Basic authentication:  %v\n", cluster.Config.Username != "")
 		fmt.Printf("  oAuth authentication:  %v\n", cluster.Config.BearerToken != "")
 		fmt.Printf("  AWS authentication:    %v\n", cluster.Config.AWSAuthConfig != nil)
+		fmt.Printf("  Exec authentication:   %v\n", cluster.Config.ExecProviderConfig != nil)
+		if cluster.Config.RotateAuthInterval != "" {
+			fmt.Printf("  Rotate auth interval:  %s\n", cluster.Config.RotateAuthInterval)
+			fmt.Printf("  Auth rotated:          %s\n", formatClusterAuthRotation(cluster))
+			if cluster.AuthRotationError != "" {
+				fmt.Printf("  Auth rotation error:   %s\n", cluster.AuthRotationError)
+			}
+		}
+		if exec := cluster.Config.ExecProviderConfig; exec != nil {
+			fmt.Printf("\nExec provider\n\n")
+			fmt.Printf("  Command:               %s\n", exec.Command)
+			fmt.Printf("  Args:                  %s\n", strWithDefault(strings.Join(exec.Args, " "), "-"))
+			fmt.Printf("  Env:                   %s\n", formatExecProviderEnv(exec.Env))
+			fmt.Printf("  API version:           %s\n", strWithDefault(exec.APIVersion, "-"))
+			fmt.Printf("  Install hint:          %s\n", strWithDefault(exec.InstallHint, "-"))
+		}
 		fmt.Printf("\nDisable compression: %v\n", cluster.Config.DisableCompression)
 		fmt.Printf("\nUse proxy: %v\n", cluster.Config.ProxyUrl != "")
 		fmt.Println()
 	}
 }
 
+// formatExecProviderEnv formats the environment variables of an exec provider with their values redacted
+func formatExecProviderEnv(env map[string]string) string {
+	if len(env) == 0 {
+		return "-"
+	}
+	vars := make([]string, 0, len(env))
+	for _, name := range slices.Sorted(maps.Keys(env)) {
+		vars = append(vars, name+"=******")
+	}
+	return strings.Join(vars, ", ")
+}
+
 // NewClusterRemoveCommand returns a new instance of an `argocd cluster rm` command
 func NewClusterRemoveCommand(clientOpts *argocdclient.ClientOptions, pathOpts *clientcmd.PathOptions) *cobra.Command {
-	var noPrompt bool
+	var (
+		noPrompt bool
+		dryRun   bool
+	)
 	command := &cobra.Command{
-		Use:   "rm SERVER/NAME",
-		Short: "Remove cluster credentials",
+		Use:               "rm SERVER/NAME",
+		ValidArgsFunction: completeClusters(clientOpts, 0),
+		Short:             "Remove cluster credentials",
 		Example: `argocd cluster rm https://12.34.567.89
-argocd cluster rm cluster-name`,
+argocd cluster rm cluster-name
+
+# Show the applications that would be impacted by removing the cluster, without removing it
+argocd cluster rm cluster-name --dry-run`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
@@ -411,41 +817,59 @@ argocd cluster rm cluster-name`,
 				c.HelpFunc()(c, args)
 				os.Exit(1)
 			}
-			conn, clusterIf := headless.NewClientOrDie(clientOpts, c).NewClusterClientOrDie()
+			acdClient := headless.NewClientOrDie(clientOpts, c)
+			conn, clusterIf := acdClient.NewClusterClientOrDie()
 			defer utilio.Close(conn)
-			numOfClusters := len(args)
-			var isConfirmAll bool
+			appConn, appIf := acdClient.NewApplicationClientOrDie()
+			defer utilio.Close(appConn)
 
+			clusters, err := clusterIf.List(ctx, &clusterpkg.ClusterQuery{})
+			errors.CheckError(err)
+			apps, err := appIf.List(ctx, &applicationpkg.ApplicationQuery{})
+			errors.CheckError(err)
+
+			// resolve all clusters first so that nothing is removed if any of them is unknown or ambiguous
+			toRemove := make([]argoappv1.Cluster, 0, len(args))
 			for _, clusterSelector := range args {
-				clusterQuery := getQueryBySelector(clusterSelector)
+				clst, err := resolveCluster(clusters.Items, clusterSelector)
+				errors.CheckError(err)
+				toRemove = append(toRemove, clst)
+			}
+
+			numOfClusters := len(toRemove)
+			var isConfirmAll bool
+
+			for _, clst := range toRemove {
+				impacted := getApplicationsTargetingCluster(clst, apps.Items)
+				printImpactedApplications(os.Stdout, clst, impacted)
+				if dryRun {
+					continue
+				}
+
 				var lowercaseAnswer string
-				if !noPrompt {
-					if numOfClusters == 1 {
-						lowercaseAnswer = cli.AskToProceedS("Are you sure you want to remove '" + clusterSelector + "'? Any Apps deploying to this cluster will go to health status Unknown.[y/n] ")
-					} else {
-						if !isConfirmAll {
-							lowercaseAnswer = cli.AskToProceedS("Are you sure you want to remove '" + clusterSelector + "'? Any Apps deploying to this cluster will go to health status Unknown.[y/n/A] where 'A' is to remove all specified clusters without prompting. Any Apps deploying to these clusters will go to health status Unknown. ")
-							if lowercaseAnswer == "a" {
-								lowercaseAnswer = "y"
-								isConfirmAll = true
-							}
-						} else {
-							lowercaseAnswer = "y"
-						}
-					}
-				} else {
+				switch {
+				case noPrompt:
+					lowercaseAnswer = "y"
+				case numOfClusters == 1:
+					lowercaseAnswer = cli.AskToProceedS("Are you sure you want to remove '" + clst.Server + "'? Any Apps deploying to this cluster will go to health status Unknown.[y/n] ")
+				case isConfirmAll && len(impacted) == 0:
 					lowercaseAnswer = "y"
+				case len(impacted) > 0:
+					// removing a cluster which is still in use always requires an explicit confirmation
+					lowercaseAnswer = cli.AskToProceedS("Are you sure you want to remove '" + clst.Server + "'? Any Apps deploying to this cluster will go to health status Unknown.[y/n] ")
+				default:
+					lowercaseAnswer = cli.AskToProceedS("Are you sure you want to remove '" + clst.Server + "'? Any Apps deploying to this cluster will go to health status Unknown.[y/n/A] where 'A' is to remove all specified clusters without prompting. Any Apps deploying to these clusters will go to health status Unknown. ")
+					if lowercaseAnswer == "a" {
+						lowercaseAnswer = "y"
+						isConfirmAll = true
+					}
 				}
 
 				if lowercaseAnswer == "y" {
-					// get the cluster name to use as context to delete RBAC on cluster
-					clst, err := clusterIf.Get(ctx, clusterQuery)
-					errors.CheckError(err)
-
 					// remove cluster
-					_, err = clusterIf.Delete(ctx, clusterQuery)
+					_, err = clusterIf.Delete(ctx, &clusterpkg.ClusterQuery{Server: clst.Server})
 					errors.CheckError(err)
-					fmt.Printf("Cluster '%s' removed\n", clusterSelector)
+					fmt.Printf("Cluster '%s' removed\n", clst.Server)
 
 					// remove RBAC from cluster
 					conf, err := getRestConfig(pathOpts, clst.Name)
@@ -457,30 +881,168 @@ argocd cluster rm cluster-name`,
 					err = clusterauth.UninstallClusterManagerRBAC(clientset)
 					errors.CheckError(err)
 				} else {
-					fmt.Println("The command to remove '" + clusterSelector + "' was cancelled.")
+					fmt.Println("The command to remove '" + clst.Server + "' was cancelled.")
 				}
 			}
 		},
 	}
 	command.Flags().BoolVarP(&noPrompt, "yes", "y", false, "Turn off prompting to confirm remove of cluster resources")
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "Show the applications impacted by removing the clusters without removing them")
 	return command
 }
 
+// resolveCluster returns the cluster whose server URL or name is the given selector. An error listing the candidates
+// is returned if the name is used by several clusters.
+func resolveCluster(clusters []argoappv1.Cluster, clusterSelector string) (argoappv1.Cluster, error) {
+	query := getQueryBySelector(clusterSelector)
+	var matches []argoappv1.Cluster
+	for _, clst := range clusters {
+		if query.Server != "" && strings.TrimSuffix(clst.Server, "/") == strings.TrimSuffix(query.Server, "/") {
+			return clst, nil
+		}
+		if query.Name != "" && clst.Name == query.Name {
+			matches = append(matches, clst)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return argoappv1.Cluster{}, fmt.Errorf("cluster '%s' not found", clusterSelector)
+	case 1:
+		return matches[0], nil
+	}
+	candidates := make([]string, 0, len(matches))
+	for _, clst := range matches {
+		candidates = append(candidates, clst.Server)
+	}
+	return argoappv1.Cluster{}, fmt.Errorf("cluster name '%s' is ambiguous, specify the server URL of one of: %s", clusterSelector, strings.Join(candidates, ", "))
+}
+
+// getApplicationsTargetingCluster returns the applications whose destination is the cluster, either by server URL or
+// by name
+func getApplicationsTargetingCluster(clst argoappv1.Cluster, apps []argoappv1.Application) []argoappv1.Application {
+	var impacted []argoappv1.Application
+	for _, app := range apps {
+		dest := app.Spec.Destination
+		if dest.Server == clst.Server || (dest.Server == "" && clst.Name != "" && dest.Name == clst.Name) {
+			impacted = append(impacted, app)
+		}
+	}
+	return impacted
+}
+
+// printImpactedApplications prints the applications which would be impacted by removing the cluster
+func printImpactedApplications(out io.Writer, clst argoappv1.Cluster, impacted []argoappv1.Application) {
+	if len(impacted) == 0 {
+		_, _ = fmt.Fprintf(out, "Cluster '%s' (%s) is not targeted by any application\n", clst.Server, strWithDefault(clst.Name, "-"))
+		return
+	}
+	_, _ = fmt.Fprintf(out, "Cluster '%s' (%s) is targeted by %d application(s):\n", clst.Server, strWithDefault(clst.Name, "-"), len(impacted))
+	for _, app := range impacted {
+		_, _ = fmt.Fprintf(out, "  %s\n", app.QualifiedName())
+	}
+}
+
 // Print table of cluster information
-func printClusterTable(clusters []argoappv1.Cluster) {
-	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	_, _ = fmt.Fprintf(w, "SERVER\tNAME\tVERSION\tSTATUS\tMESSAGE\tPROJECT\n")
+func printClusterTable(out io.Writer, clusters []argoappv1.Cluster, labelColumns []string, wide bool, usage map[string]clusterUsage) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "SERVER\tNAME\tVERSION\tSTATUS\tMESSAGE\tPROJECT")
+	if wide {
+		_, _ = fmt.Fprintf(w, "\tAPPS\tLAST CONNECTED\tAUTH ROTATED")
+	}
+	for _, key := range labelColumns {
+		_, _ = fmt.Fprintf(w, "\t%s", strings.ToUpper(key))
+	}
+	_, _ = fmt.Fprintln(w)
 	for _, c := range clusters {
 		server := c.Server
 		if len(c.Namespaces) > 0 {
 			server = fmt.Sprintf("%s (%d namespaces)", c.Server, len(c.Namespaces))
 		}
 		//nolint:staticcheck
-		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", server, c.Name, c.ServerVersion, c.ConnectionState.Status, c.ConnectionState.Message, c.Project)
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s", server, c.Name, c.ServerVersion, c.ConnectionState.Status, c.ConnectionState.Message, c.Project)
+		if wide {
+			u := usage[c.Server]
+			lastConnected := "-"
+			if u.LastConnected != nil {
+				lastConnected = u.LastConnected.Format(time.RFC3339)
+			}
+			_, _ = fmt.Fprintf(w, "\t%d\t%s\t%s", u.Applications, lastConnected, formatClusterAuthRotation(c))
+		}
+		for _, key := range labelColumns {
+			_, _ = fmt.Fprintf(w, "\t%s", c.Labels[key])
+		}
+		_, _ = fmt.Fprintln(w)
 	}
 	_ = w.Flush()
 }
 
+// formatClusterAuthRotation formats when the bearer token of a cluster has last been rotated, and whether the last
+// scheduled rotation failed
+func formatClusterAuthRotation(c argoappv1.Cluster) string {
+	if c.Config.RotateAuthInterval == "" && c.AuthRotatedAt == nil && c.AuthRotationError == "" {
+		return "-"
+	}
+	rotatedAt := "Never"
+	if c.AuthRotatedAt != nil {
+		rotatedAt = c.AuthRotatedAt.Format(time.RFC3339)
+	}
+	if c.AuthRotationError != "" {
+		rotatedAt += " (last rotation failed)"
+	}
+	return rotatedAt
+}
+
+// clusterWithUsage is the output of `argocd cluster list` in json or yaml format
+type clusterWithUsage struct {
+	*argoappv1.Cluster
+	Usage clusterUsage `json:"usage"`
+}
+
+type clusterUsage struct {
+	Applications  int          `json:"applications"`
+	LastConnected *metav1.Time `json:"lastConnected,omitempty"`
+}
+
+// getClustersUsage returns the usage of each cluster keyed by server. Applications are matched to clusters by their
+// destination server, or by their destination name when no server is set.
+func getClustersUsage(clusters []argoappv1.Cluster, apps []argoappv1.Application) map[string]clusterUsage {
+	serverByName := make(map[string]string, len(clusters))
+	for _, c := range clusters {
+		if c.Name != "" {
+			serverByName[c.Name] = c.Server
+		}
+	}
+	appCounts := make(map[string]int)
+	for _, app := range apps {
+		server := app.Spec.Destination.Server
+		if server == "" {
+			server = serverByName[app.Spec.Destination.Name]
+		}
+		if server != "" {
+			appCounts[server]++
+		}
+	}
+	usage := make(map[string]clusterUsage, len(clusters))
+	for _, c := range clusters {
+		usage[c.Server] = clusterUsage{
+			Applications:  appCounts[c.Server],
+			LastConnected: getClusterLastConnected(c),
+		}
+	}
+	return usage
+}
+
+// getClusterLastConnected returns when the application controller last talked to the cluster successfully
+func getClusterLastConnected(c argoappv1.Cluster) *metav1.Time {
+	if c.Info.CacheInfo.LastCacheSyncTime != nil {
+		return c.Info.CacheInfo.LastCacheSyncTime
+	}
+	if c.Info.ConnectionState.Status == argoappv1.ConnectionStatusSuccessful {
+		return c.Info.ConnectionState.ModifiedAt
+	}
+	return nil
+}
+
 // Returns cluster query for getting cluster depending on the cluster selector
 func getQueryBySelector(clusterSelector string) *clusterpkg.ClusterQuery {
 	var query clusterpkg.ClusterQuery
@@ -502,33 +1064,65 @@ func printClusterServers(clusters []argoappv1.Cluster) {
 
 // NewClusterListCommand returns a new instance of an `argocd cluster rm` command
 func NewClusterListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
-	var output string
+	var (
+		output       string
+		labelColumns []string
+		hasApps      bool
+	)
 	command := &cobra.Command{
 		Use:   "list",
 		Short: "List configured clusters",
 		Run: func(c *cobra.Command, _ []string) {
 			ctx := c.Context()
 
-			conn, clusterIf := headless.NewClientOrDie(clientOpts, c).NewClusterClientOrDie()
+			acdClient := headless.NewClientOrDie(clientOpts, c)
+			conn, clusterIf := acdClient.NewClusterClientOrDie()
 			defer utilio.Close(conn)
 			clusters, err := clusterIf.List(ctx, &clusterpkg.ClusterQuery{})
 			errors.CheckError(err)
+			items := clusters.Items
+
+			filterByApps := c.Flags().Changed("has-apps")
+			var usage map[string]clusterUsage
+			if filterByApps || output == "wide" || output == "json" || output == "yaml" {
+				appConn, appIf := acdClient.NewApplicationClientOrDie()
+				defer utilio.Close(appConn)
+				apps, err := appIf.List(ctx, &applicationpkg.ApplicationQuery{})
+				errors.CheckError(err)
+				usage = getClustersUsage(items, apps.Items)
+			}
+			if filterByApps {
+				items = slices.DeleteFunc(items, func(clst argoappv1.Cluster) bool {
+					return (usage[clst.Server].Applications > 0) != hasApps
+				})
+			}
+
 			switch output {
 			case "yaml", "json":
-				err := PrintResourceList(clusters.Items, output, false)
+				res := make([]clusterWithUsage, 0, len(items))
+				for i := range items {
+					res = append(res, clusterWithUsage{Cluster: &items[i], Usage: usage[items[i].Server]})
+				}
+				err := PrintResourceList(res, output, false)
 				errors.CheckError(err)
 			case "server":
-				printClusterServers(clusters.Items)
+				printClusterServers(items)
 			case "wide", "":
-				printClusterTable(clusters.Items)
+				printClusterTable(os.Stdout, items, labelColumns, output == "wide", usage)
 			default:
 				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
 			}
 		},
 		Example: `
-# List Clusters in Default "Wide" Format
+# List Clusters in Default Format
 argocd cluster list
 
+# List Clusters with the number of applications targeting them and when they were last connected
+argocd cluster list -o wide
+
+# List Clusters that no application targets anymore
+argocd cluster list --has-apps=false
+
 # List Cluster via specifying the server
 argocd cluster list --server <ARGOCD_SERVER_ADDRESS>
 
@@ -541,36 +1135,161 @@ argocd cluster list -o yaml --server <ARGOCD_SERVER_ADDRESS>
 # List Clusters that have been added to your Argo CD 
 argocd cluster list -o server <ARGOCD_SERVER_ADDRESS>
 
+# List Clusters with the values of their env and team labels as extra columns
+argocd cluster list --label-columns env,team
+
 `,
 	}
-	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide|server")
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: json|yaml|wide|server")
+	command.Flags().StringSliceVar(&labelColumns, "label-columns", nil, "Comma separated list of label keys to show as columns in the table output")
+	command.Flags().BoolVar(&hasApps, "has-apps", false, "Only list clusters targeted by at least one application, or with --has-apps=false only those targeted by none")
 	return command
 }
 
 // NewClusterRotateAuthCommand returns a new instance of an `argocd cluster rotate-auth` command
 func NewClusterRotateAuthCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		all         bool
+		selector    string
+		concurrency int
+		dryRun      bool
+	)
 	command := &cobra.Command{
-		Use:   "rotate-auth SERVER/NAME",
-		Short: cliName + " cluster rotate-auth SERVER/NAME",
+		Use:               "rotate-auth [SERVER/NAME]",
+		ValidArgsFunction: completeClusters(clientOpts, 1),
+		Short:             cliName + " cluster rotate-auth SERVER/NAME",
 		Example: `argocd cluster rotate-auth https://12.34.567.89
-argocd cluster rotate-auth cluster-name`,
+argocd cluster rotate-auth cluster-name
+
+# Rotate the credentials of every cluster, at most 4 at a time
+argocd cluster rotate-auth --all --concurrency 4
+
+# Show which clusters labelled env=prod would be rotated
+argocd cluster rotate-auth --selector env=prod --dry-run`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
-			if len(args) != 1 {
+			bulk := all || selector != ""
+			if (bulk && len(args) != 0) || (!bulk && len(args) != 1) {
 				c.HelpFunc()(c, args)
 				os.Exit(1)
 			}
+			if dryRun && !bulk {
+				errors.Fatal(errors.ErrorGeneric, "--dry-run requires --all or --selector")
+			}
+			if concurrency < 1 {
+				errors.Fatal(errors.ErrorGeneric, "--concurrency must be at least 1")
+			}
 			conn, clusterIf := headless.NewClientOrDie(clientOpts, c).NewClusterClientOrDie()
 			defer utilio.Close(conn)
 
-			cluster := args[0]
-			clusterQuery := getQueryBySelector(cluster)
-			_, err := clusterIf.RotateAuth(ctx, clusterQuery)
+			if !bulk {
+				cluster := args[0]
+				clusterQuery := getQueryBySelector(cluster)
+				_, err := clusterIf.RotateAuth(ctx, clusterQuery)
+				errors.CheckError(err)
+
+				fmt.Printf("Cluster '%s' rotated auth\n", cluster)
+				return
+			}
+
+			labelSelector, err := labels.Parse(selector)
 			errors.CheckError(err)
+			clusters, err := clusterIf.List(ctx, &clusterpkg.ClusterQuery{})
+			errors.CheckError(err)
+			targets, skipped := selectClustersForRotation(clusters.Items, labelSelector)
 
-			fmt.Printf("Cluster '%s' rotated auth\n", cluster)
+			var results []clusterRotateResult
+			for _, clst := range skipped {
+				results = append(results, clusterRotateResult{Server: clst.Server, Name: clst.Name, Result: "skipped (in-cluster)"})
+			}
+			if dryRun {
+				for _, clst := range targets {
+					results = append(results, clusterRotateResult{Server: clst.Server, Name: clst.Name, Result: "would rotate"})
+				}
+				printClusterRotateResults(os.Stdout, results)
+				return
+			}
+			results = append(results, rotateClustersAuth(ctx, targets, concurrency, func(ctx context.Context, server string) error {
+				_, err := clusterIf.RotateAuth(ctx, &clusterpkg.ClusterQuery{Server: server})
+				return err
+			})...)
+			printClusterRotateResults(os.Stdout, results)
+
+			failed := 0
+			for _, r := range results {
+				if r.Err != nil {
+					failed++
+				}
+			}
+			if failed > 0 {
+				errors.Fatalf(errors.ErrorGeneric, "failed to rotate auth for %d of %d clusters", failed, len(targets))
+			}
 		},
 	}
+	command.Flags().BoolVar(&all, "all", false, "Rotate auth of all clusters")
+	command.Flags().StringVarP(&selector, "selector", "l", "", "Rotate auth of clusters matching the label selector (e.g. env=prod)")
+	command.Flags().IntVar(&concurrency, "concurrency", 4, "Maximum number of clusters rotated in parallel")
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "List the clusters that would be rotated without rotating them")
 	return command
 }
+
+// clusterRotateResult is the outcome of rotating the auth of a single cluster
+type clusterRotateResult struct {
+	Server string
+	Name   string
+	Result string
+	Err    error
+}
+
+// selectClustersForRotation returns the clusters matching the selector which support auth rotation, and those which
+// match but were skipped because rotation doesn't apply to them (the in-cluster pseudo-cluster)
+func selectClustersForRotation(clusters []argoappv1.Cluster, selector labels.Selector) (targets []argoappv1.Cluster, skipped []argoappv1.Cluster) {
+	for _, clst := range clusters {
+		if !selector.Matches(labels.Set(clst.Labels)) {
+			continue
+		}
+		if clst.Server == argoappv1.KubernetesInternalAPIServerAddr {
+			skipped = append(skipped, clst)
+			continue
+		}
+		targets = append(targets, clst)
+	}
+	return targets, skipped
+}
+
+// rotateClustersAuth calls rotate for each cluster with at most concurrency calls in flight. Failures do not stop the
+// remaining rotations; results are returned in the same order as clusters.
+func rotateClustersAuth(ctx context.Context, clusters []argoappv1.Cluster, concurrency int, rotate func(ctx context.Context, server string) error) []clusterRotateResult {
+	results := make([]clusterRotateResult, len(clusters))
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for i, clst := range clusters {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			result := clusterRotateResult{Server: clst.Server, Name: clst.Name, Result: "rotated"}
+			if err := rotate(ctx, clst.Server); err != nil {
+				result.Err = err
+				result.Result = "failed: " + status.Convert(err).Message()
+			}
+			results[i] = result
+		}()
+	}
+	wg.Wait()
+	return results
+}
+
+// printClusterRotateResults prints a summary table of cluster auth rotations
+func printClusterRotateResults(out io.Writer, results []clusterRotateResult) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "SERVER\tNAME\tRESULT\n")
+	for _, r := range results {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", r.Server, r.Name, r.Result)
+	}
+	_ = w.Flush()
+}
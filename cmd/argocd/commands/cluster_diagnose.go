@@ -0,0 +1,78 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
+	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	clusterpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/cluster"
+	"github.com/argoproj/argo-cd/v3/util/errors"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/templates"
+)
+
+// NewClusterDiagnoseCommand returns a new instance of an `argocd cluster diagnose` command
+func NewClusterDiagnoseCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var output string
+	command := &cobra.Command{
+		Use:               "diagnose SERVER/NAME",
+		ValidArgsFunction: completeClusters(clientOpts, 1),
+		Short:             "Check the connectivity, credentials and permissions of a cluster",
+		Long: "Check the connectivity, credentials and permissions of a cluster from the Argo CD API server. " +
+			"The API server reachability, TLS certificate, credentials expiry, clock skew, permissions to list namespaces " +
+			"and to watch the resources tracked by the application controller, and websocket support are checked. " +
+			"Requires the update permission on the cluster.",
+		Example: templates.Examples(`
+			# Find out why a cluster is in Unknown connection state
+			argocd cluster diagnose https://12.34.567.89
+
+			# Diagnose a cluster by name and print the report in json format
+			argocd cluster diagnose in-cluster -o json
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, clusterIf := headless.NewClientOrDie(clientOpts, c).NewClusterClientOrDie()
+			defer utilio.Close(conn)
+
+			report, err := clusterIf.Diagnose(ctx, getQueryBySelector(args[0]))
+			errors.CheckError(err)
+			switch output {
+			case "yaml", "json":
+				err := PrintResource(report, output)
+				errors.CheckError(err)
+			case "wide", "":
+				printClusterDiagnosisReport(os.Stdout, report)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	return command
+}
+
+func printClusterDiagnosisReport(out io.Writer, report *clusterpkg.ClusterDiagnosisReport) {
+	_, _ = fmt.Fprintf(out, "Server:          %s\n", report.Server)
+	_, _ = fmt.Fprintf(out, "Name:            %s\n", strWithDefault(report.Name, "-"))
+	_, _ = fmt.Fprintf(out, "Server Version:  %s\n", strWithDefault(report.ServerVersion, "-"))
+	_, _ = fmt.Fprintln(out)
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "CHECK\tSTATUS\tMESSAGE\n")
+	for _, check := range report.Checks {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", check.Name, check.Status, check.Message)
+		for _, detail := range check.Details {
+			_, _ = fmt.Fprintf(w, "\t\t  %s\n", detail)
+		}
+	}
+	_ = w.Flush()
+}
@@ -0,0 +1,35 @@
+package commands
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	clusterpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/cluster"
+)
+
+func TestPrintClusterDiagnosisReport(t *testing.T) {
+	out := &bytes.Buffer{}
+	printClusterDiagnosisReport(out, &clusterpkg.ClusterDiagnosisReport{
+		Server:        "https://kubernetes.default.svc",
+		Name:          "in-cluster",
+		ServerVersion: "v1.31.0",
+		Checks: []*clusterpkg.ClusterDiagnosisCheck{
+			{Name: "Reachability", Status: "Passed", Message: "API server v1.31.0 responded in 12ms"},
+			{Name: "Watch resources", Status: "Failed", Message: "Credentials are not allowed to watch 2 of 40 tracked resources", Details: []string{"watch secrets", "watch leases.coordination.k8s.io"}},
+			{Name: "Websocket", Status: "Passed", Message: "API server accepted a websocket connection"},
+		},
+	})
+	assert.Equal(t, `Server:          https://kubernetes.default.svc
+Name:            in-cluster
+Server Version:  v1.31.0
+
+CHECK            STATUS  MESSAGE
+Reachability     Passed  API server v1.31.0 responded in 12ms
+Watch resources  Failed  Credentials are not allowed to watch 2 of 40 tracked resources
+                           watch secrets
+                           watch leases.coordination.k8s.io
+Websocket        Passed  API server accepted a websocket connection
+`, out.String())
+}
@@ -1,11 +1,22 @@
 package commands
 
 import (
+	"bytes"
+	"context"
+	stderrors "errors"
+	"io"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 
@@ -27,7 +38,7 @@ func Test_getQueryBySelector(t *testing.T) {
 }
 
 func Test_printClusterTable(_ *testing.T) {
-	printClusterTable([]v1alpha1.Cluster{
+	printClusterTable(io.Discard, []v1alpha1.Cluster{
 		{
 			Server: "my-server",
 			Name:   "my-name",
@@ -46,7 +57,58 @@ func Test_printClusterTable(_ *testing.T) {
 			},
 			ServerVersion: "my-version",
 		},
-	})
+	}, nil, false, nil)
+}
+
+func Test_printClusterTableLabelColumns(t *testing.T) {
+	var buf bytes.Buffer
+	printClusterTable(&buf, []v1alpha1.Cluster{
+		{Server: "https://prod", Name: "prod", Labels: map[string]string{"env": "prod", "team": "payments"}},
+		{Server: "https://dev", Name: "dev", Labels: map[string]string{"env": "dev"}},
+	}, []string{"team", "env"}, false, nil)
+	assert.Equal(t, `SERVER        NAME  VERSION  STATUS  MESSAGE  PROJECT  TEAM      ENV
+https://prod  prod                                     payments  prod
+https://dev   dev                                                dev
+`, buf.String())
+}
+
+func Test_applyMetadataChanges(t *testing.T) {
+	existing := map[string]string{"env": "dev", "team": "payments"}
+
+	result, err := applyMetadataChanges(existing, []string{"env=prod", "team-", "example.com/tier=gold"}, validateLabel)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"env": "prod", "example.com/tier": "gold"}, result)
+	assert.Equal(t, map[string]string{"env": "dev", "team": "payments"}, existing, "existing labels must not be modified")
+
+	result, err = applyMetadataChanges(nil, []string{"missing-"}, validateLabel)
+	require.NoError(t, err)
+	assert.Empty(t, result)
+
+	result, err = applyMetadataChanges(nil, []string{"note=a=b c"}, validateAnnotation)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"note": "a=b c"}, result)
+
+	_, err = applyMetadataChanges(existing, []string{"env"}, validateLabel)
+	require.ErrorContains(t, err, "expected key=value to set or key- to remove")
+
+	_, err = applyMetadataChanges(existing, []string{"bad key=value"}, validateLabel)
+	require.ErrorContains(t, err, `invalid label key "bad key"`)
+
+	_, err = applyMetadataChanges(existing, []string{"env=not valid"}, validateLabel)
+	require.ErrorContains(t, err, `invalid value "not valid" for label "env"`)
+
+	_, err = applyMetadataChanges(existing, []string{"-bad=value"}, validateAnnotation)
+	require.ErrorContains(t, err, `invalid annotation key "-bad"`)
+}
+
+func Test_printMetadata(t *testing.T) {
+	var buf bytes.Buffer
+	printMetadata(&buf, "Labels", map[string]string{"team": "payments", "env": "prod"})
+	assert.Equal(t, "Labels:\n  env=prod\n  team=payments\n", buf.String())
+
+	buf.Reset()
+	printMetadata(&buf, "Annotations", nil)
+	assert.Equal(t, "Annotations:\n  <none>\n", buf.String())
 }
 
 func Test_getRestConfig(t *testing.T) {
@@ -108,3 +170,308 @@ func Test_getRestConfig(t *testing.T) {
 		})
 	}
 }
+
+func Test_selectClustersForRotation(t *testing.T) {
+	clusters := []v1alpha1.Cluster{
+		{Server: v1alpha1.KubernetesInternalAPIServerAddr, Name: "in-cluster", Labels: map[string]string{"env": "prod"}},
+		{Server: "https://prod", Name: "prod", Labels: map[string]string{"env": "prod"}},
+		{Server: "https://dev", Name: "dev", Labels: map[string]string{"env": "dev"}},
+	}
+
+	targets, skipped := selectClustersForRotation(clusters, labels.Everything())
+	require.Len(t, targets, 2)
+	require.Len(t, skipped, 1)
+	assert.Equal(t, "in-cluster", skipped[0].Name)
+
+	selector, err := labels.Parse("env=prod")
+	require.NoError(t, err)
+	targets, skipped = selectClustersForRotation(clusters, selector)
+	require.Len(t, targets, 1)
+	assert.Equal(t, "prod", targets[0].Name)
+	require.Len(t, skipped, 1)
+
+	selector, err = labels.Parse("env=dev")
+	require.NoError(t, err)
+	targets, skipped = selectClustersForRotation(clusters, selector)
+	require.Len(t, targets, 1)
+	assert.Equal(t, "dev", targets[0].Name)
+	assert.Empty(t, skipped)
+}
+
+func Test_rotateClustersAuth(t *testing.T) {
+	clusters := []v1alpha1.Cluster{
+		{Server: "https://a", Name: "a"},
+		{Server: "https://b", Name: "b"},
+		{Server: "https://c", Name: "c"},
+		{Server: "https://d", Name: "d"},
+	}
+	var inFlight, maxInFlight atomic.Int32
+	results := rotateClustersAuth(context.Background(), clusters, 2, func(_ context.Context, server string) error {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			current := maxInFlight.Load()
+			if n <= current || maxInFlight.CompareAndSwap(current, n) {
+				break
+			}
+		}
+		if server == "https://b" {
+			return stderrors.New("token is not a service account token")
+		}
+		return nil
+	})
+
+	assert.LessOrEqual(t, maxInFlight.Load(), int32(2))
+	require.Len(t, results, 4)
+	for i, r := range results {
+		assert.Equal(t, clusters[i].Server, r.Server)
+		assert.Equal(t, clusters[i].Name, r.Name)
+	}
+	assert.Equal(t, "rotated", results[0].Result)
+	require.Error(t, results[1].Err)
+	assert.Equal(t, "failed: token is not a service account token", results[1].Result)
+	assert.NoError(t, results[2].Err)
+	assert.NoError(t, results[3].Err)
+}
+
+func Test_printClusterRotateResults(t *testing.T) {
+	var buf bytes.Buffer
+	printClusterRotateResults(&buf, []clusterRotateResult{
+		{Server: v1alpha1.KubernetesInternalAPIServerAddr, Name: "in-cluster", Result: "skipped (in-cluster)"},
+		{Server: "https://prod", Name: "prod", Result: "rotated"},
+	})
+	assert.Equal(t, `SERVER                          NAME        RESULT
+https://kubernetes.default.svc  in-cluster  skipped (in-cluster)
+https://prod                    prod        rotated
+`, buf.String())
+}
+
+func Test_diagnoseCluster(t *testing.T) {
+	syncTime := metav1.NewTime(time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC))
+	clst := v1alpha1.Cluster{
+		Server: "https://my-server",
+		Info: v1alpha1.ClusterInfo{
+			ConnectionState: v1alpha1.ConnectionState{
+				Status:  v1alpha1.ConnectionStatusFailed,
+				Message: "failed to sync cluster https://my-server: failed to get api resources: unable to retrieve the complete list of server APIs: metrics.k8s.io/v1beta1: the server is currently unable to handle the request",
+			},
+			ServerVersion: "1.29",
+			CacheInfo: v1alpha1.ClusterCacheInfo{
+				ResourcesCount:    120,
+				APIsCount:         45,
+				LastCacheSyncTime: &syncTime,
+			},
+			ApplicationsCount: 3,
+			FailedAPIGroups:   []string{"metrics.k8s.io/v1beta1"},
+			ErrorChain: []string{
+				"failed to sync cluster https://my-server",
+				"failed to get api resources",
+				"unable to retrieve the complete list of server APIs: metrics.k8s.io/v1beta1: the server is currently unable to handle the request",
+			},
+		},
+	}
+
+	diagnosis := diagnoseCluster(clst)
+	assert.Equal(t, v1alpha1.ConnectionStatus(v1alpha1.ConnectionStatusFailed), diagnosis.ConnectionStatus)
+	assert.Equal(t, "1.29", diagnosis.ServerVersion)
+	assert.Equal(t, &syncTime, diagnosis.LastCacheSyncTime)
+	assert.Equal(t, int64(45), diagnosis.APIsCount)
+	assert.Equal(t, int64(120), diagnosis.ResourcesCount)
+	assert.Equal(t, int64(3), diagnosis.ApplicationsCount)
+	assert.Equal(t, []string{"metrics.k8s.io/v1beta1"}, diagnosis.FailedAPIGroups)
+	assert.Equal(t, clst.Info.ErrorChain, diagnosis.ErrorChain)
+}
+
+func Test_printClusterDiagnosis(t *testing.T) {
+	var buf bytes.Buffer
+	printClusterDiagnosis(&buf, "https://my-server", clusterDiagnosis{
+		ConnectionStatus: v1alpha1.ConnectionStatusFailed,
+		FailedAPIGroups:  []string{"metrics.k8s.io/v1beta1"},
+		ErrorChain:       []string{"failed to sync cluster", "connection refused"},
+	})
+	out := buf.String()
+	assert.Contains(t, out, "Connection diagnostics (https://my-server)")
+	assert.Contains(t, out, "  Connection status:     Failed\n")
+	assert.Contains(t, out, "  Last cache sync:       -\n")
+	assert.Contains(t, out, "  Failed API groups:     metrics.k8s.io/v1beta1\n")
+	assert.Contains(t, out, "    failed to sync cluster\n      connection refused\n")
+}
+
+func Test_getClustersUsage(t *testing.T) {
+	syncTime := metav1.NewTime(time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC))
+	attemptTime := metav1.NewTime(time.Date(2024, 3, 2, 10, 0, 0, 0, time.UTC))
+	clusters := []v1alpha1.Cluster{
+		{Server: v1alpha1.KubernetesInternalAPIServerAddr, Name: "in-cluster", Info: v1alpha1.ClusterInfo{CacheInfo: v1alpha1.ClusterCacheInfo{LastCacheSyncTime: &syncTime}}},
+		{Server: "https://prod", Name: "prod", Info: v1alpha1.ClusterInfo{ConnectionState: v1alpha1.ConnectionState{Status: v1alpha1.ConnectionStatusSuccessful, ModifiedAt: &attemptTime}}},
+		{Server: "https://unused", Name: "unused", Info: v1alpha1.ClusterInfo{ConnectionState: v1alpha1.ConnectionState{Status: v1alpha1.ConnectionStatusFailed, ModifiedAt: &attemptTime}}},
+	}
+	apps := []v1alpha1.Application{
+		{Spec: v1alpha1.ApplicationSpec{Destination: v1alpha1.ApplicationDestination{Server: v1alpha1.KubernetesInternalAPIServerAddr}}},
+		{Spec: v1alpha1.ApplicationSpec{Destination: v1alpha1.ApplicationDestination{Name: "in-cluster"}}},
+		{Spec: v1alpha1.ApplicationSpec{Destination: v1alpha1.ApplicationDestination{Name: "prod"}}},
+		{Spec: v1alpha1.ApplicationSpec{Destination: v1alpha1.ApplicationDestination{Name: "unknown"}}},
+	}
+
+	usage := getClustersUsage(clusters, apps)
+	assert.Equal(t, clusterUsage{Applications: 2, LastConnected: &syncTime}, usage[v1alpha1.KubernetesInternalAPIServerAddr])
+	assert.Equal(t, clusterUsage{Applications: 1, LastConnected: &attemptTime}, usage["https://prod"])
+	assert.Equal(t, clusterUsage{}, usage["https://unused"])
+}
+
+func Test_printClusterTableWide(t *testing.T) {
+	syncTime := metav1.NewTime(time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC))
+	var buf bytes.Buffer
+	printClusterTable(&buf, []v1alpha1.Cluster{
+		{Server: "https://prod", Name: "prod"},
+		{Server: "https://unused", Name: "unused"},
+	}, nil, true, map[string]clusterUsage{
+		"https://prod": {Applications: 3, LastConnected: &syncTime},
+	})
+	assert.Equal(t, `SERVER          NAME    VERSION  STATUS  MESSAGE  PROJECT  APPS  LAST CONNECTED        AUTH ROTATED
+https://prod    prod                                       3     2024-03-01T10:00:00Z  -
+https://unused  unused                                     0     -                     -
+`, buf.String())
+}
+
+func Test_formatClusterAuthRotation(t *testing.T) {
+	rotatedAt := metav1.NewTime(time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC))
+	assert.Equal(t, "-", formatClusterAuthRotation(v1alpha1.Cluster{}))
+	assert.Equal(t, "Never", formatClusterAuthRotation(v1alpha1.Cluster{Config: v1alpha1.ClusterConfig{RotateAuthInterval: "720h"}}))
+	assert.Equal(t, "2024-03-01T10:00:00Z", formatClusterAuthRotation(v1alpha1.Cluster{AuthRotatedAt: &rotatedAt}))
+	assert.Equal(t, "2024-03-01T10:00:00Z (last rotation failed)", formatClusterAuthRotation(v1alpha1.Cluster{
+		Config:            v1alpha1.ClusterConfig{RotateAuthInterval: "720h"},
+		AuthRotatedAt:     &rotatedAt,
+		AuthRotationError: "failed to verify the new token",
+	}))
+}
+
+func Test_formatExecProviderEnv(t *testing.T) {
+	assert.Equal(t, "-", formatExecProviderEnv(nil))
+	assert.Equal(t, "AWS_PROFILE=******, AWS_REGION=******", formatExecProviderEnv(map[string]string{"AWS_REGION": "eu-west-1", "AWS_PROFILE": "prod"}))
+}
+
+func Test_resolveCluster(t *testing.T) {
+	clusters := []v1alpha1.Cluster{
+		{Server: "https://prod-1", Name: "prod"},
+		{Server: "https://prod-2", Name: "prod"},
+		{Server: "https://dev/", Name: "dev"},
+	}
+
+	clst, err := resolveCluster(clusters, "dev")
+	require.NoError(t, err)
+	assert.Equal(t, "https://dev/", clst.Server)
+
+	clst, err = resolveCluster(clusters, "https://dev")
+	require.NoError(t, err)
+	assert.Equal(t, "dev", clst.Name)
+
+	clst, err = resolveCluster(clusters, "https://prod-2")
+	require.NoError(t, err)
+	assert.Equal(t, "https://prod-2", clst.Server)
+
+	_, err = resolveCluster(clusters, "prod")
+	require.EqualError(t, err, "cluster name 'prod' is ambiguous, specify the server URL of one of: https://prod-1, https://prod-2")
+
+	_, err = resolveCluster(clusters, "staging")
+	require.EqualError(t, err, "cluster 'staging' not found")
+}
+
+func Test_getApplicationsTargetingCluster(t *testing.T) {
+	newApp := func(name string, dest v1alpha1.ApplicationDestination) v1alpha1.Application {
+		return v1alpha1.Application{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "argocd"}, Spec: v1alpha1.ApplicationSpec{Destination: dest}}
+	}
+	apps := []v1alpha1.Application{
+		newApp("by-server", v1alpha1.ApplicationDestination{Server: "https://prod"}),
+		newApp("by-name", v1alpha1.ApplicationDestination{Name: "prod"}),
+		newApp("other", v1alpha1.ApplicationDestination{Server: "https://dev"}),
+		newApp("other-by-name", v1alpha1.ApplicationDestination{Name: "dev"}),
+	}
+
+	impacted := getApplicationsTargetingCluster(v1alpha1.Cluster{Server: "https://prod", Name: "prod"}, apps)
+	require.Len(t, impacted, 2)
+	assert.Equal(t, "by-server", impacted[0].Name)
+	assert.Equal(t, "by-name", impacted[1].Name)
+
+	assert.Empty(t, getApplicationsTargetingCluster(v1alpha1.Cluster{Server: "https://staging"}, apps))
+}
+
+func Test_printImpactedApplications(t *testing.T) {
+	clst := v1alpha1.Cluster{Server: "https://prod", Name: "prod"}
+	var buf bytes.Buffer
+	printImpactedApplications(&buf, clst, nil)
+	assert.Equal(t, "Cluster 'https://prod' (prod) is not targeted by any application\n", buf.String())
+
+	buf.Reset()
+	printImpactedApplications(&buf, clst, []v1alpha1.Application{{ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "argocd"}}})
+	assert.Equal(t, "Cluster 'https://prod' (prod) is targeted by 1 application(s):\n  argocd/guestbook\n", buf.String())
+}
+
+func Test_clusterManagerManifests(t *testing.T) {
+	t.Run("ManagerServiceAccount", func(t *testing.T) {
+		objs, err := clusterManagerManifests(t.Context(), fake.NewClientset(), "kube-system", "", []string{"team-a"})
+		require.NoError(t, err)
+		var kinds []string
+		for _, obj := range objs {
+			kinds = append(kinds, obj.GetObjectKind().GroupVersionKind().Kind)
+		}
+		assert.Equal(t, []string{"ServiceAccount", "Role", "RoleBinding", "Secret"}, kinds)
+	})
+
+	t.Run("ExistingServiceAccount", func(t *testing.T) {
+		sa := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "deployer", Namespace: "kube-system"}}
+		objs, err := clusterManagerManifests(t.Context(), fake.NewClientset(sa), "kube-system", "deployer", nil)
+		require.NoError(t, err)
+		require.Len(t, objs, 1)
+		secret := objs[0].(*corev1.Secret)
+		assert.Equal(t, "deployer-long-lived-token", secret.Name)
+		assert.Equal(t, "deployer", secret.Annotations[corev1.ServiceAccountNameKey])
+	})
+
+	t.Run("MissingServiceAccount", func(t *testing.T) {
+		_, err := clusterManagerManifests(t.Context(), fake.NewClientset(), "kube-system", "deployer", nil)
+		require.ErrorContains(t, err, `failed to get service account "deployer" in namespace "kube-system"`)
+	})
+}
+
+func Test_clusterSecretManifest(t *testing.T) {
+	clst := &v1alpha1.Cluster{
+		Server:           "https://10.0.0.1:6443",
+		Name:             "prod",
+		Namespaces:       []string{"team-a"},
+		ClusterResources: true,
+		Config:           v1alpha1.ClusterConfig{BearerToken: "secret-token"},
+	}
+	secret, err := clusterSecretManifest(clst)
+	require.NoError(t, err)
+	assert.Equal(t, "Secret", secret.Kind)
+	assert.Nil(t, secret.Data)
+	assert.Equal(t, "prod", secret.StringData["name"])
+	assert.Equal(t, "team-a", secret.StringData["namespaces"])
+	assert.Equal(t, "true", secret.StringData["clusterResources"])
+	assert.Contains(t, secret.StringData["config"], `"bearerToken":"REDACTED"`)
+	assert.NotContains(t, secret.StringData["config"], "secret-token")
+	assert.Equal(t, "secret-token", clst.Config.BearerToken)
+}
+
+func Test_printClusterAddManifests(t *testing.T) {
+	manifests := []runtime.Object{
+		&corev1.ServiceAccount{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "ServiceAccount"}, ObjectMeta: metav1.ObjectMeta{Name: "argocd-manager"}},
+		&corev1.Secret{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"}, ObjectMeta: metav1.ObjectMeta{Name: "cluster-prod"}},
+	}
+
+	t.Run("YAML", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, printClusterAddManifests(&buf, manifests, "yaml"))
+		docs := strings.Split(buf.String(), "---\n")
+		require.Len(t, docs, 2)
+		assert.Contains(t, docs[0], "kind: ServiceAccount")
+		assert.Contains(t, docs[1], "kind: Secret")
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, printClusterAddManifests(&buf, manifests, "json"))
+		assert.Contains(t, buf.String(), `"kind": "List"`)
+		assert.Contains(t, buf.String(), `"name": "cluster-prod"`)
+	})
+}
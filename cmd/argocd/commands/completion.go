@@ -1,12 +1,24 @@
 package commands
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
+	"slices"
+	"strings"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+
+	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	accountpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/account"
+	applicationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	clusterpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/cluster"
+	projectpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/project"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/session"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
 )
 
 const (
@@ -182,6 +194,7 @@ __argocd_custom_func() {
 		argocd_proj_role_delete | \
 		argocd_proj_role_get | \
 		argocd_proj_role_create-token | \
+		argocd_proj_role_rotate-token | \
 		argocd_proj_role_delete-token)
 			__argocd_proj_role
 			return
@@ -262,3 +275,181 @@ func runCompletionZsh(out io.Writer, cmd *cobra.Command) error {
 func runCompletionFish(out io.Writer, cmd *cobra.Command) error {
 	return cmd.GenFishCompletion(out, true)
 }
+
+// completionTimeout bounds the time spent querying the API server for shell completion candidates
+var completionTimeout = 5 * time.Second
+
+// newCompletionClient creates the API client used to query completion candidates. Unlike headless.NewClientOrDie it
+// never exits or logs, and it does not start a local API server in core mode, so completion stays fast and silent.
+var newCompletionClient = func(clientOpts *argocdclient.ClientOptions) (argocdclient.Client, error) {
+	opts := *clientOpts
+	opts.NoVersionWarning = true
+	return argocdclient.NewClient(&opts)
+}
+
+// completionLister returns the completion candidates given the arguments which were already provided
+type completionLister func(ctx context.Context, client argocdclient.Client, cmd *cobra.Command, args []string) ([]string, error)
+
+// newAPICompletionFunc returns a cobra completion function which completes the candidates returned by the lister,
+// for at most maxArgs positional arguments (0 for no limit). Errors and timeouts result in no candidates, so that
+// nothing but candidates is ever written to the completion stream.
+func newAPICompletionFunc(clientOpts *argocdclient.ClientOptions, maxArgs int, list completionLister) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+		if maxArgs > 0 && len(args) >= maxArgs {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), completionTimeout)
+		defer cancel()
+
+		type result struct {
+			candidates []string
+			err        error
+		}
+		resultCh := make(chan result, 1)
+		go func() {
+			client, err := newCompletionClient(clientOpts)
+			if err != nil {
+				resultCh <- result{err: err}
+				return
+			}
+			candidates, err := list(ctx, client, cmd, args)
+			resultCh <- result{candidates: candidates, err: err}
+		}()
+
+		var res result
+		select {
+		case res = <-resultCh:
+		case <-ctx.Done():
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		if res.err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		completions := make([]cobra.Completion, 0, len(res.candidates))
+		for _, candidate := range res.candidates {
+			if strings.HasPrefix(candidate, toComplete) && !slices.Contains(args, candidate) {
+				completions = append(completions, candidate)
+			}
+		}
+		return completions, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// completeAppNames completes the (namespace qualified) names of applications
+func completeAppNames(clientOpts *argocdclient.ClientOptions, maxArgs int) cobra.CompletionFunc {
+	return newAPICompletionFunc(clientOpts, maxArgs, func(ctx context.Context, client argocdclient.Client, _ *cobra.Command, _ []string) ([]string, error) {
+		conn, appIf, err := client.NewApplicationClient()
+		if err != nil {
+			return nil, err
+		}
+		defer utilio.Close(conn)
+		apps, err := appIf.List(ctx, &applicationpkg.ApplicationQuery{})
+		if err != nil {
+			return nil, err
+		}
+		names := make([]string, 0, len(apps.Items))
+		for _, app := range apps.Items {
+			names = append(names, app.QualifiedName())
+		}
+		return names, nil
+	})
+}
+
+// completeProjectNames completes the names of projects
+func completeProjectNames(clientOpts *argocdclient.ClientOptions, maxArgs int) cobra.CompletionFunc {
+	return newAPICompletionFunc(clientOpts, maxArgs, func(ctx context.Context, client argocdclient.Client, _ *cobra.Command, _ []string) ([]string, error) {
+		conn, projIf, err := client.NewProjectClient()
+		if err != nil {
+			return nil, err
+		}
+		defer utilio.Close(conn)
+		projects, err := projIf.List(ctx, &projectpkg.ProjectQuery{})
+		if err != nil {
+			return nil, err
+		}
+		names := make([]string, 0, len(projects.Items))
+		for _, proj := range projects.Items {
+			names = append(names, proj.Name)
+		}
+		return names, nil
+	})
+}
+
+// completeClusters completes both the names and the server URLs of clusters
+func completeClusters(clientOpts *argocdclient.ClientOptions, maxArgs int) cobra.CompletionFunc {
+	return newAPICompletionFunc(clientOpts, maxArgs, func(ctx context.Context, client argocdclient.Client, _ *cobra.Command, _ []string) ([]string, error) {
+		conn, clusterIf, err := client.NewClusterClient()
+		if err != nil {
+			return nil, err
+		}
+		defer utilio.Close(conn)
+		clusters, err := clusterIf.List(ctx, &clusterpkg.ClusterQuery{})
+		if err != nil {
+			return nil, err
+		}
+		var candidates []string
+		for _, cluster := range clusters.Items {
+			if cluster.Name != "" {
+				candidates = append(candidates, cluster.Name)
+			}
+			candidates = append(candidates, cluster.Server)
+		}
+		return candidates, nil
+	})
+}
+
+// completeAccountNames completes the names of local accounts
+func completeAccountNames(clientOpts *argocdclient.ClientOptions) cobra.CompletionFunc {
+	return newAPICompletionFunc(clientOpts, 0, func(ctx context.Context, client argocdclient.Client, _ *cobra.Command, _ []string) ([]string, error) {
+		conn, accountIf, err := client.NewAccountClient()
+		if err != nil {
+			return nil, err
+		}
+		defer utilio.Close(conn)
+		accounts, err := accountIf.ListAccounts(ctx, &accountpkg.ListAccountRequest{})
+		if err != nil {
+			return nil, err
+		}
+		names := make([]string, 0, len(accounts.Items))
+		for _, account := range accounts.Items {
+			names = append(names, account.Name)
+		}
+		return names, nil
+	})
+}
+
+// completeTokenIDs completes the token ids of the account given by the --account flag, or of the current account
+func completeTokenIDs(clientOpts *argocdclient.ClientOptions) cobra.CompletionFunc {
+	return newAPICompletionFunc(clientOpts, 1, func(ctx context.Context, client argocdclient.Client, cmd *cobra.Command, _ []string) ([]string, error) {
+		name, err := cmd.Flags().GetString("account")
+		if err != nil {
+			return nil, err
+		}
+		if name == "" {
+			sessConn, sessionIf, err := client.NewSessionClient()
+			if err != nil {
+				return nil, err
+			}
+			defer utilio.Close(sessConn)
+			userInfo, err := sessionIf.GetUserInfo(ctx, &session.GetUserInfoRequest{})
+			if err != nil {
+				return nil, err
+			}
+			name = userInfo.Username
+		}
+		conn, accountIf, err := client.NewAccountClient()
+		if err != nil {
+			return nil, err
+		}
+		defer utilio.Close(conn)
+		account, err := accountIf.GetAccount(ctx, &accountpkg.GetAccountRequest{Name: name})
+		if err != nil {
+			return nil, err
+		}
+		ids := make([]string, 0, len(account.Tokens))
+		for _, token := range account.Tokens {
+			ids = append(ids, token.Id)
+		}
+		return ids, nil
+	})
+}
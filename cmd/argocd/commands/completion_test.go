@@ -0,0 +1,178 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	accountpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/account"
+	applicationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	clusterpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/cluster"
+	projectpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/project"
+	sessionpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/session"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+type completionAppClient struct {
+	applicationpkg.ApplicationServiceClient
+}
+
+func (c *completionAppClient) List(_ context.Context, _ *applicationpkg.ApplicationQuery, _ ...grpc.CallOption) (*v1alpha1.ApplicationList, error) {
+	return &v1alpha1.ApplicationList{Items: []v1alpha1.Application{
+		{ObjectMeta: metav1.ObjectMeta{Name: "guestbook"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "helm-guestbook"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "team"}},
+	}}, nil
+}
+
+type completionProjectClient struct {
+	projectpkg.ProjectServiceClient
+}
+
+func (c *completionProjectClient) List(_ context.Context, _ *projectpkg.ProjectQuery, _ ...grpc.CallOption) (*v1alpha1.AppProjectList, error) {
+	return &v1alpha1.AppProjectList{Items: []v1alpha1.AppProject{
+		{ObjectMeta: metav1.ObjectMeta{Name: "default"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "platform"}},
+	}}, nil
+}
+
+type completionClusterClient struct {
+	clusterpkg.ClusterServiceClient
+}
+
+func (c *completionClusterClient) List(_ context.Context, _ *clusterpkg.ClusterQuery, _ ...grpc.CallOption) (*v1alpha1.ClusterList, error) {
+	return &v1alpha1.ClusterList{Items: []v1alpha1.Cluster{
+		{Name: "in-cluster", Server: "https://kubernetes.default.svc"},
+	}}, nil
+}
+
+type completionAccountClient struct {
+	accountpkg.AccountServiceClient
+}
+
+func (c *completionAccountClient) ListAccounts(_ context.Context, _ *accountpkg.ListAccountRequest, _ ...grpc.CallOption) (*accountpkg.AccountsList, error) {
+	return &accountpkg.AccountsList{Items: []*accountpkg.Account{{Name: "admin"}, {Name: "ci"}}}, nil
+}
+
+func (c *completionAccountClient) GetAccount(_ context.Context, in *accountpkg.GetAccountRequest, _ ...grpc.CallOption) (*accountpkg.Account, error) {
+	return &accountpkg.Account{Name: in.Name, Tokens: []*accountpkg.Token{{Id: in.Name + "-token"}}}, nil
+}
+
+type completionSessionClient struct {
+	sessionpkg.SessionServiceClient
+}
+
+func (c *completionSessionClient) GetUserInfo(_ context.Context, _ *sessionpkg.GetUserInfoRequest, _ ...grpc.CallOption) (*sessionpkg.GetUserInfoResponse, error) {
+	return &sessionpkg.GetUserInfoResponse{LoggedIn: true, Username: "admin"}, nil
+}
+
+type completionAcdClient struct {
+	*fakeAcdClient
+}
+
+func (c *completionAcdClient) NewApplicationClient() (io.Closer, applicationpkg.ApplicationServiceClient, error) {
+	return &fakeConnection{}, &completionAppClient{}, nil
+}
+
+func (c *completionAcdClient) NewProjectClient() (io.Closer, projectpkg.ProjectServiceClient, error) {
+	return &fakeConnection{}, &completionProjectClient{}, nil
+}
+
+func (c *completionAcdClient) NewClusterClient() (io.Closer, clusterpkg.ClusterServiceClient, error) {
+	return &fakeConnection{}, &completionClusterClient{}, nil
+}
+
+func (c *completionAcdClient) NewAccountClient() (io.Closer, accountpkg.AccountServiceClient, error) {
+	return &fakeConnection{}, &completionAccountClient{}, nil
+}
+
+func (c *completionAcdClient) NewSessionClient() (io.Closer, sessionpkg.SessionServiceClient, error) {
+	return &fakeConnection{}, &completionSessionClient{}, nil
+}
+
+// runCompletion runs the hidden completion command of the CLI and returns the completion candidates
+func runCompletion(t *testing.T, newClient func(*argocdclient.ClientOptions) (argocdclient.Client, error), args ...string) []string {
+	t.Helper()
+	orig := newCompletionClient
+	newCompletionClient = newClient
+	t.Cleanup(func() { newCompletionClient = orig })
+
+	out := new(bytes.Buffer)
+	cmd := NewCommand()
+	cmd.SetOut(out)
+	cmd.SetErr(io.Discard)
+	cmd.SetArgs(append([]string{cobra.ShellCompRequestCmd}, args...))
+	require.NoError(t, cmd.Execute())
+
+	var candidates []string
+	for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+		if !strings.HasPrefix(line, ":") {
+			candidates = append(candidates, line)
+		}
+	}
+	return candidates
+}
+
+func newCompletionTestClient(_ *argocdclient.ClientOptions) (argocdclient.Client, error) {
+	return &completionAcdClient{fakeAcdClient: &fakeAcdClient{}}, nil
+}
+
+func TestCompletion(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		expected []string
+	}{
+		{name: "AppNames", args: []string{"app", "get", ""}, expected: []string{"guestbook", "helm-guestbook", "team/guestbook"}},
+		{name: "AppNamesPrefix", args: []string{"app", "get", "helm"}, expected: []string{"helm-guestbook"}},
+		{name: "AppNamesSingleArg", args: []string{"app", "get", "guestbook", ""}, expected: nil},
+		{name: "AppNamesMultipleArgs", args: []string{"app", "sync", "guestbook", ""}, expected: []string{"helm-guestbook", "team/guestbook"}},
+		{name: "ProjectNames", args: []string{"proj", "get", ""}, expected: []string{"default", "platform"}},
+		{name: "ProjectFlag", args: []string{"app", "list", "--project", "pl"}, expected: []string{"platform"}},
+		{name: "Clusters", args: []string{"cluster", "get", ""}, expected: []string{"in-cluster", "https://kubernetes.default.svc"}},
+		{name: "AccountFlag", args: []string{"account", "get", "--account", ""}, expected: []string{"admin", "ci"}},
+		{name: "TokenIDsCurrentAccount", args: []string{"account", "delete-token", ""}, expected: []string{"admin-token"}},
+		{name: "TokenIDsOfAccount", args: []string{"account", "delete-token", "--account", "ci", ""}, expected: []string{"ci-token"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, runCompletion(t, newCompletionTestClient, tt.args...))
+		})
+	}
+}
+
+func TestCompletion_ClientError(t *testing.T) {
+	candidates := runCompletion(t, func(_ *argocdclient.ClientOptions) (argocdclient.Client, error) {
+		return nil, errors.New("Argo CD server address unspecified")
+	}, "app", "get", "")
+	assert.Empty(t, candidates)
+}
+
+func TestNewAPICompletionFunc_Timeout(t *testing.T) {
+	orig, origTimeout := newCompletionClient, completionTimeout
+	newCompletionClient, completionTimeout = newCompletionTestClient, 100*time.Millisecond
+	t.Cleanup(func() { newCompletionClient, completionTimeout = orig, origTimeout })
+
+	blocked := make(chan struct{})
+	defer close(blocked)
+	completionFunc := newAPICompletionFunc(&argocdclient.ClientOptions{}, 0, func(_ context.Context, _ argocdclient.Client, _ *cobra.Command, _ []string) ([]string, error) {
+		<-blocked
+		return []string{"too-late"}, nil
+	})
+	start := time.Now()
+	completions, directive := completionFunc(&cobra.Command{}, nil, "")
+	assert.Empty(t, completions)
+	assert.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+	assert.Less(t, time.Since(start), time.Second)
+}
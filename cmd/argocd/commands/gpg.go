@@ -3,10 +3,15 @@ package commands
 import (
 	stderrors "errors"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 	"text/tabwriter"
+	"time"
 
+	"github.com/ProtonMail/go-crypto/openpgp"
+	timeutil "github.com/argoproj/pkg/v2/time"
+	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
@@ -34,12 +39,16 @@ func NewGPGCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command.AddCommand(NewGPGGetCommand(clientOpts))
 	command.AddCommand(NewGPGAddCommand(clientOpts))
 	command.AddCommand(NewGPGDeleteCommand(clientOpts))
+	command.AddCommand(NewGPGCheckCommand(clientOpts))
 	return command
 }
 
 // NewGPGListCommand lists all configured public keys from the server
 func NewGPGListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
-	var output string
+	var (
+		output         string
+		expiringWithin string
+	)
 	command := &cobra.Command{
 		Use:   "list",
 		Short: "List configured GPG public keys",
@@ -52,27 +61,47 @@ func NewGPGListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 		
   # List all configured GPG public keys in YAML format.
   argocd gpg list -o yaml
+
+  # List the GPG public keys which expire within the next 30 days or have already expired.
+  argocd gpg list --expiring-within 30d
   		`),
 
 		Run: func(c *cobra.Command, _ []string) {
 			ctx := c.Context()
 
+			var within *time.Duration
+			if expiringWithin != "" {
+				d, err := timeutil.ParseDuration(expiringWithin)
+				errors.CheckError(err)
+				within = d
+			}
+
 			conn, gpgIf := headless.NewClientOrDie(clientOpts, c).NewGPGKeyClientOrDie()
 			defer utilio.Close(conn)
-			keys, err := gpgIf.List(ctx, &gpgkeypkg.GnuPGPublicKeyQuery{})
+			keys, err := gpgIf.List(ctx, &gpgkeypkg.GnuPGPublicKeyQuery{WithKeyData: true})
 			errors.CheckError(err)
+			infos := getGPGKeyInfos(keys.Items, time.Now())
+			for _, info := range infos {
+				if info.Error != "" {
+					log.Warnf("could not determine the validity of key %s: %s", info.KeyID, info.Error)
+				}
+			}
+			if within != nil {
+				infos = filterExpiringGPGKeys(infos, time.Now().Add(*within))
+			}
 			switch output {
 			case "yaml", "json":
-				err := PrintResourceList(keys.Items, output, false)
+				err := PrintResourceList(infos, output, false)
 				errors.CheckError(err)
 			case "wide", "":
-				printKeyTable(keys.Items)
+				printKeyTable(os.Stdout, infos)
 			default:
 				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
 			}
 		},
 	}
 	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	command.Flags().StringVar(&expiringWithin, "expiring-within", "", "Only list keys that expire within the given duration (e.g. 30d) or have already expired")
 	return command
 }
 
@@ -112,6 +141,10 @@ func NewGPGGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 				fmt.Printf("Key fingerprint: %s\n", key.Fingerprint)
 				fmt.Printf("Key subtype:     %s\n", strings.ToUpper(key.SubType))
 				fmt.Printf("Key owner:       %s\n", key.Owner)
+				if info, err := newGPGKeyInfo(*key, time.Now()); err == nil {
+					fmt.Printf("Key created:     %s\n", formatGPGKeyTime(info.CreatedAt))
+					fmt.Printf("Key expires:     %s\n", formatGPGKeyTime(info.ExpiresAt))
+				}
 				fmt.Printf("Key data follows until EOF:\n%s\n", key.KeyData)
 			default:
 				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
@@ -189,13 +222,159 @@ func NewGPGDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 	return command
 }
 
+// NewGPGCheckCommand reports whether a public key can currently be used for signature verification
+func NewGPGCheckCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var output string
+	command := &cobra.Command{
+		Use:   "check KEYID",
+		Short: "Check whether the GPG public key with ID <KEYID> can be used for signature verification",
+		Long: `Check whether the GPG public key with ID <KEYID> can currently be used for signature verification, and
+until when. The command exits with a non-zero code if the key is expired, revoked or cannot sign.`,
+		Example: templates.Examples(`
+  # Check whether a GPG public key can be used for signature verification.
+  argocd gpg check KEYID
+  		`),
+
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				errors.Fatal(errors.ErrorGeneric, "Missing KEYID argument")
+			}
+			conn, gpgIf := headless.NewClientOrDie(clientOpts, c).NewGPGKeyClientOrDie()
+			defer utilio.Close(conn)
+			key, err := gpgIf.Get(ctx, &gpgkeypkg.GnuPGPublicKeyQuery{KeyID: args[0]})
+			errors.CheckError(err)
+			info, err := newGPGKeyInfo(*key, time.Now())
+			errors.CheckError(err)
+			info.KeyData = ""
+			switch output {
+			case "yaml", "json":
+				err := PrintResource(info, output)
+				errors.CheckError(err)
+			case "wide", "":
+				printGPGKeyCheck(os.Stdout, info)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+			if !info.Usable {
+				os.Exit(1)
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	return command
+}
+
+// gpgKeyInfo is a GnuPG public key along with validity details parsed from its key data
+type gpgKeyInfo struct {
+	appsv1.GnuPGPublicKey
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	Expired   bool       `json:"expired"`
+	Revoked   bool       `json:"revoked"`
+	Usable    bool       `json:"usable"`
+	Reason    string     `json:"reason,omitempty"`
+	Error     string     `json:"error,omitempty"`
+}
+
+// newGPGKeyInfo parses the key data of the public key to determine when it was created, when it expires and whether
+// it can be used to verify signatures at the given time
+func newGPGKeyInfo(key appsv1.GnuPGPublicKey, now time.Time) (*gpgKeyInfo, error) {
+	entities, err := openpgp.ReadArmoredKeyRing(strings.NewReader(key.KeyData))
+	if err != nil {
+		return nil, fmt.Errorf("could not parse key data of key %s: %w", key.KeyID, err)
+	}
+	if len(entities) == 0 {
+		return nil, fmt.Errorf("key data of key %s does not contain any key", key.KeyID)
+	}
+	entity := entities[0]
+	for _, e := range entities {
+		if strings.EqualFold(e.PrimaryKey.KeyIdString(), key.KeyID) {
+			entity = e
+			break
+		}
+	}
+
+	created := entity.PrimaryKey.CreationTime
+	info := &gpgKeyInfo{GnuPGPublicKey: key, CreatedAt: &created}
+	if sig, _ := entity.PrimarySelfSignature(); sig != nil && sig.KeyLifetimeSecs != nil && *sig.KeyLifetimeSecs != 0 {
+		expires := created.Add(time.Duration(*sig.KeyLifetimeSecs) * time.Second)
+		info.ExpiresAt = &expires
+		info.Expired = !now.Before(expires)
+	}
+	info.Revoked = entity.Revoked(now)
+	_, canSign := entity.SigningKey(now)
+	switch {
+	case info.Revoked:
+		info.Reason = "key is revoked"
+	case info.Expired:
+		info.Reason = "key is expired"
+	case !canSign:
+		info.Reason = "key has no valid signing key"
+	default:
+		info.Usable = true
+	}
+	return info, nil
+}
+
+// getGPGKeyInfos parses the validity of each listed key, a key whose data cannot be parsed gets an error instead of
+// failing the whole list
+func getGPGKeyInfos(keys []appsv1.GnuPGPublicKey, now time.Time) []*gpgKeyInfo {
+	infos := make([]*gpgKeyInfo, 0, len(keys))
+	for _, k := range keys {
+		info, err := newGPGKeyInfo(k, now)
+		if err != nil {
+			info = &gpgKeyInfo{GnuPGPublicKey: k, Error: err.Error()}
+		}
+		// Remove key's data from the list output like the list API does
+		info.KeyData = ""
+		infos = append(infos, info)
+	}
+	return infos
+}
+
+// filterExpiringGPGKeys returns the keys that expire before the given time
+func filterExpiringGPGKeys(infos []*gpgKeyInfo, before time.Time) []*gpgKeyInfo {
+	filtered := make([]*gpgKeyInfo, 0)
+	for _, info := range infos {
+		if info.ExpiresAt != nil && info.ExpiresAt.Before(before) {
+			filtered = append(filtered, info)
+		}
+	}
+	return filtered
+}
+
+func formatGPGKeyTime(t *time.Time) string {
+	if t == nil {
+		return "never"
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
 // Print table of certificate info
-func printKeyTable(keys []appsv1.GnuPGPublicKey) {
-	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintf(w, "KEYID\tTYPE\tIDENTITY\n")
+func printKeyTable(out io.Writer, keys []*gpgKeyInfo) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "KEYID\tTYPE\tIDENTITY\tCREATED\tEXPIRES\tEXPIRED\n")
 
 	for _, k := range keys {
-		fmt.Fprintf(w, "%s\t%s\t%s\n", k.KeyID, strings.ToUpper(k.SubType), k.Owner)
+		if k.Error != "" {
+			fmt.Fprintf(w, "%s\t%s\t%s\t-\t-\tunknown\n", k.KeyID, strings.ToUpper(k.SubType), k.Owner)
+			continue
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%v\n", k.KeyID, strings.ToUpper(k.SubType), k.Owner, formatGPGKeyTime(k.CreatedAt), formatGPGKeyTime(k.ExpiresAt), k.Expired)
 	}
 	_ = w.Flush()
 }
+
+func printGPGKeyCheck(out io.Writer, info *gpgKeyInfo) {
+	fmt.Fprintf(out, "Key ID:          %s\n", info.KeyID)
+	fmt.Fprintf(out, "Key owner:       %s\n", info.Owner)
+	fmt.Fprintf(out, "Key created:     %s\n", formatGPGKeyTime(info.CreatedAt))
+	fmt.Fprintf(out, "Key expires:     %s\n", formatGPGKeyTime(info.ExpiresAt))
+	if info.Usable {
+		fmt.Fprintf(out, "Usable:          yes, until %s\n", formatGPGKeyTime(info.ExpiresAt))
+	} else {
+		fmt.Fprintf(out, "Usable:          no, %s\n", info.Reason)
+	}
+}
@@ -0,0 +1,126 @@
+package commands
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/ProtonMail/go-crypto/openpgp/packet"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func newTestGPGKey(t *testing.T, created time.Time, lifetimeSecs uint32) appsv1.GnuPGPublicKey {
+	t.Helper()
+	entity, err := openpgp.NewEntity("Test", "", "test@example.com", &packet.Config{
+		Time:            func() time.Time { return created },
+		KeyLifetimeSecs: lifetimeSecs,
+		RSABits:         1024,
+	})
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	w, err := armor.Encode(&buf, openpgp.PublicKeyType, nil)
+	require.NoError(t, err)
+	require.NoError(t, entity.Serialize(w))
+	require.NoError(t, w.Close())
+	return appsv1.GnuPGPublicKey{
+		KeyID:   entity.PrimaryKey.KeyIdString(),
+		Owner:   "Test <test@example.com>",
+		SubType: "rsa1024",
+		KeyData: buf.String(),
+	}
+}
+
+func TestNewGPGKeyInfo(t *testing.T) {
+	created := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("NoExpiry", func(t *testing.T) {
+		key := newTestGPGKey(t, created, 0)
+		info, err := newGPGKeyInfo(key, created.Add(24*time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, created, info.CreatedAt.UTC())
+		assert.Nil(t, info.ExpiresAt)
+		assert.False(t, info.Expired)
+		assert.True(t, info.Usable)
+	})
+
+	t.Run("NotYetExpired", func(t *testing.T) {
+		key := newTestGPGKey(t, created, 48*3600)
+		info, err := newGPGKeyInfo(key, created.Add(24*time.Hour))
+		require.NoError(t, err)
+		require.NotNil(t, info.ExpiresAt)
+		assert.Equal(t, created.Add(48*time.Hour), info.ExpiresAt.UTC())
+		assert.False(t, info.Expired)
+		assert.True(t, info.Usable)
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		key := newTestGPGKey(t, created, 48*3600)
+		info, err := newGPGKeyInfo(key, created.Add(72*time.Hour))
+		require.NoError(t, err)
+		assert.True(t, info.Expired)
+		assert.False(t, info.Usable)
+		assert.Equal(t, "key is expired", info.Reason)
+	})
+
+	t.Run("InvalidKeyData", func(t *testing.T) {
+		_, err := newGPGKeyInfo(appsv1.GnuPGPublicKey{KeyID: "abc", KeyData: "garbage"}, created)
+		require.ErrorContains(t, err, "could not parse key data of key abc")
+	})
+}
+
+func TestGetGPGKeyInfos(t *testing.T) {
+	created := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	valid := newTestGPGKey(t, created, 0)
+	infos := getGPGKeyInfos([]appsv1.GnuPGPublicKey{valid, {KeyID: "abc", KeyData: "garbage"}}, created.Add(24*time.Hour))
+	require.Len(t, infos, 2)
+	assert.Equal(t, valid.KeyID, infos[0].KeyID)
+	assert.True(t, infos[0].Usable)
+	assert.Empty(t, infos[0].Error)
+	assert.Empty(t, infos[0].KeyData)
+	assert.Equal(t, "abc", infos[1].KeyID)
+	assert.False(t, infos[1].Usable)
+	assert.Contains(t, infos[1].Error, "could not parse key data of key abc")
+	assert.Empty(t, infos[1].KeyData)
+}
+
+func TestFilterExpiringGPGKeys(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	soon := now.Add(10 * 24 * time.Hour)
+	later := now.Add(60 * 24 * time.Hour)
+	past := now.Add(-time.Hour)
+	infos := []*gpgKeyInfo{
+		{GnuPGPublicKey: appsv1.GnuPGPublicKey{KeyID: "never"}},
+		{GnuPGPublicKey: appsv1.GnuPGPublicKey{KeyID: "soon"}, ExpiresAt: &soon},
+		{GnuPGPublicKey: appsv1.GnuPGPublicKey{KeyID: "later"}, ExpiresAt: &later},
+		{GnuPGPublicKey: appsv1.GnuPGPublicKey{KeyID: "expired"}, ExpiresAt: &past, Expired: true},
+	}
+	filtered := filterExpiringGPGKeys(infos, now.Add(30*24*time.Hour))
+	var ids []string
+	for _, info := range filtered {
+		ids = append(ids, info.KeyID)
+	}
+	assert.Equal(t, []string{"soon", "expired"}, ids)
+}
+
+func TestPrintKeyTable(t *testing.T) {
+	created := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	expires := created.Add(24 * time.Hour)
+	var buf bytes.Buffer
+	printKeyTable(&buf, []*gpgKeyInfo{
+		{GnuPGPublicKey: appsv1.GnuPGPublicKey{KeyID: "AAAA", SubType: "rsa4096", Owner: "a"}, CreatedAt: &created},
+		{GnuPGPublicKey: appsv1.GnuPGPublicKey{KeyID: "BBBB", SubType: "rsa4096", Owner: "b"}, CreatedAt: &created, ExpiresAt: &expires, Expired: true},
+		{GnuPGPublicKey: appsv1.GnuPGPublicKey{KeyID: "CCCC", SubType: "rsa4096", Owner: "c"}, Error: "could not parse key data of key CCCC"},
+	})
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 4)
+	assert.Equal(t, []string{"KEYID", "TYPE", "IDENTITY", "CREATED", "EXPIRES", "EXPIRED"}, strings.Fields(lines[0]))
+	assert.Equal(t, []string{"AAAA", "RSA4096", "a", "2025-01-01T00:00:00Z", "never", "false"}, strings.Fields(lines[1]))
+	assert.Equal(t, []string{"BBBB", "RSA4096", "b", "2025-01-01T00:00:00Z", "2025-01-02T00:00:00Z", "true"}, strings.Fields(lines[2]))
+	assert.Equal(t, []string{"CCCC", "RSA4096", "c", "-", "-", "unknown"}, strings.Fields(lines[3]))
+}
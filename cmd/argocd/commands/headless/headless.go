@@ -41,13 +41,22 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/localconfig"
 )
 
+const (
+	// RedisModeAuto port-forwards to the Argo CD Redis and falls back to an in-memory cache if it cannot be reached
+	RedisModeAuto = "auto"
+	// RedisModeDisabled uses an in-memory cache without connecting to the Argo CD Redis
+	RedisModeDisabled = "disabled"
+)
+
+// portForward is overridden in tests
+var portForward = kubeutil.PortForward
+
 type forwardCacheClient struct {
 	namespace        string
 	context          string
 	init             sync.Once
 	client           cache.CacheClient
 	compression      cache.RedisCompressionType
-	err              error
 	redisHaProxyName string
 	redisName        string
 	redisPassword    string
@@ -60,19 +69,19 @@ func (c *forwardCacheClient) doLazy(action func(client cache.CacheClient) error)
 		}
 		redisHaProxyPodLabelSelector := common.LabelKeyAppName + "=" + c.redisHaProxyName
 		redisPodLabelSelector := common.LabelKeyAppName + "=" + c.redisName
-		redisPort, err := kubeutil.PortForward(6379, c.namespace, &overrides,
+		redisPort, err := portForward(6379, c.namespace, &overrides,
 			redisHaProxyPodLabelSelector, redisPodLabelSelector)
 		if err != nil {
-			c.err = err
+			// the state computed by the application controller, such as resource trees, is not available in the
+			// in-memory cache, but the commands which only read Kubernetes resources keep working
+			_, _ = fmt.Fprintf(os.Stderr, "WARNING: failed to port-forward to Redis, using an in-memory cache instead: %v\n", err)
+			c.client = cache.NewInMemoryCache(time.Hour)
 			return
 		}
 
 		redisClient := redis.NewClient(&redis.Options{Addr: fmt.Sprintf("localhost:%d", redisPort), Password: c.redisPassword})
 		c.client = cache.NewRedisCache(redisClient, time.Hour, c.compression)
 	})
-	if c.err != nil {
-		return c.err
-	}
 	return action(c.client)
 }
 
@@ -211,13 +220,17 @@ func MaybeStartLocalServer(ctx context.Context, clientOpts *apiclient.ClientOpti
 	if address == nil {
 		address = ptr.To("localhost")
 	}
-	if port == nil || *port == 0 {
-		addr := *address + ":0"
+	if port == nil {
+		port = ptr.To(0)
+	}
+	if *port == 0 {
+		addr := net.JoinHostPort(*address, "0")
 		ln, err := net.Listen("tcp", addr)
 		if err != nil {
 			return nil, fmt.Errorf("failed to listen on %q: %w", addr, err)
 		}
-		port = &ln.Addr().(*net.TCPAddr).Port
+		// write the chosen port back so that callers can report it
+		*port = ln.Addr().(*net.TCPAddr).Port
 		utilio.Close(ln)
 	}
 
@@ -266,11 +279,21 @@ func MaybeStartLocalServer(ctx context.Context, clientOpts *apiclient.ClientOpti
 		return nil, fmt.Errorf("error running miniredis: %w", err)
 	}
 	redisOptions := &redis.Options{Addr: mr.Addr()}
-	if err = common.SetOptionalRedisPasswordFromKubeConfig(ctx, kubeClientset, namespace, redisOptions); err != nil {
-		log.Warnf("Failed to fetch & set redis password for namespace %s: %v", namespace, err)
+
+	var cacheClient cache.CacheClient
+	switch clientOpts.RedisMode {
+	case RedisModeDisabled:
+		cacheClient = cache.NewInMemoryCache(time.Hour)
+	case "", RedisModeAuto:
+		if err = common.SetOptionalRedisPasswordFromKubeConfig(ctx, kubeClientset, namespace, redisOptions); err != nil {
+			log.Warnf("Failed to fetch & set redis password for namespace %s: %v", namespace, err)
+		}
+		cacheClient = &forwardCacheClient{namespace: namespace, context: ctxStr, compression: cache.RedisCompressionType(clientOpts.RedisCompression), redisHaProxyName: clientOpts.RedisHaProxyName, redisName: clientOpts.RedisName, redisPassword: redisOptions.Password}
+	default:
+		return nil, fmt.Errorf("unknown redis mode %q, should be one of: %s, %s", clientOpts.RedisMode, RedisModeAuto, RedisModeDisabled)
 	}
 
-	appstateCache := appstatecache.NewCache(cache.NewCache(&forwardCacheClient{namespace: namespace, context: ctxStr, compression: cache.RedisCompressionType(clientOpts.RedisCompression), redisHaProxyName: clientOpts.RedisHaProxyName, redisName: clientOpts.RedisName, redisPassword: redisOptions.Password}), time.Hour)
+	appstateCache := appstatecache.NewCache(cache.NewCache(cacheClient), time.Hour)
 	srv := server.NewServer(ctx, server.ArgoCDServerOpts{
 		EnableGZip:              false,
 		Namespace:               namespace,
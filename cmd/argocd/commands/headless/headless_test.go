@@ -0,0 +1,123 @@
+package headless
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	accountpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/account"
+	applicationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned"
+	"github.com/argoproj/argo-cd/v3/util/cache"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+func TestForwardCacheClient_FallsBackToInMemoryCache(t *testing.T) {
+	orig := portForward
+	t.Cleanup(func() { portForward = orig })
+	calls := 0
+	portForward = func(_ int, _ string, _ *clientcmd.ConfigOverrides, _ ...string) (int, error) {
+		calls++
+		return 0, errors.New("unable to find a pod with labels")
+	}
+
+	client := &forwardCacheClient{namespace: "argocd"}
+	require.NoError(t, client.Set(&cache.Item{Key: "key", Object: "value"}))
+	var value string
+	require.NoError(t, client.Get("key", &value))
+	assert.Equal(t, "value", value)
+	assert.Equal(t, 1, calls)
+	assert.IsType(t, &cache.InMemoryCache{}, client.client)
+}
+
+// TestMaybeStartLocalServer_RedisDisabled starts the in-process API server of core mode against a kube-apiserver
+// without any Argo CD workloads, so Redis is not available. It requires the envtest binaries, see
+// https://book.kubebuilder.io/reference/envtest.html
+func TestMaybeStartLocalServer_RedisDisabled(t *testing.T) {
+	if os.Getenv("KUBEBUILDER_ASSETS") == "" {
+		t.Skip("KUBEBUILDER_ASSETS is not set, install the envtest binaries with setup-envtest to run this test")
+	}
+	testEnv := &envtest.Environment{CRDDirectoryPaths: []string{filepath.Join("..", "..", "..", "..", "manifests", "crds")}}
+	restConfig, err := testEnv.Start()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = testEnv.Stop() })
+
+	const namespace = "argocd"
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	kubeClientset, err := kubernetes.NewForConfig(restConfig)
+	require.NoError(t, err)
+	appClientset, err := appclientset.NewForConfig(restConfig)
+	require.NoError(t, err)
+	_, err = kubeClientset.CoreV1().Namespaces().Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}}, metav1.CreateOptions{})
+	require.NoError(t, err)
+	_, err = kubeClientset.CoreV1().ConfigMaps(namespace).Create(ctx, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDConfigMapName, Labels: map[string]string{"app.kubernetes.io/part-of": "argocd"}},
+		Data:       map[string]string{"accounts.alice": "apiKey"},
+	}, metav1.CreateOptions{})
+	require.NoError(t, err)
+	_, err = kubeClientset.CoreV1().Secrets(namespace).Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDSecretName, Labels: map[string]string{"app.kubernetes.io/part-of": "argocd"}},
+		Data:       map[string][]byte{"server.secretkey": []byte("test")},
+	}, metav1.CreateOptions{})
+	require.NoError(t, err)
+	_, err = appClientset.ArgoprojV1alpha1().AppProjects(namespace).Create(ctx, &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+	}, metav1.CreateOptions{})
+	require.NoError(t, err)
+	_, err = appClientset.ArgoprojV1alpha1().Applications(namespace).Create(ctx, &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook"},
+		Spec: v1alpha1.ApplicationSpec{
+			Project:     "default",
+			Source:      &v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps.git", Path: "guestbook"},
+			Destination: v1alpha1.ApplicationDestination{Server: v1alpha1.KubernetesInternalAPIServerAddr, Namespace: "default"},
+		},
+	}, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	clientOpts := &apiclient.ClientOptions{Core: true, RedisMode: RedisModeDisabled}
+	clientConfig := clientcmd.NewDefaultClientConfig(*kube.NewKubeConfig(restConfig, namespace), &clientcmd.ConfigOverrides{})
+	shutdown, err := MaybeStartLocalServer(ctx, clientOpts, "", nil, nil, clientConfig)
+	require.NoError(t, err)
+	defer shutdown()
+
+	client, err := apiclient.NewClient(clientOpts)
+	require.NoError(t, err)
+
+	t.Run("AccountList", func(t *testing.T) {
+		conn, accountIf, err := client.NewAccountClient()
+		require.NoError(t, err)
+		defer utilio.Close(conn)
+		accounts, err := accountIf.ListAccounts(ctx, &accountpkg.ListAccountRequest{})
+		require.NoError(t, err)
+		var names []string
+		for _, account := range accounts.Items {
+			names = append(names, account.Name)
+		}
+		assert.Contains(t, names, "alice")
+	})
+
+	t.Run("AppList", func(t *testing.T) {
+		conn, appIf, err := client.NewApplicationClient()
+		require.NoError(t, err)
+		defer utilio.Close(conn)
+		apps, err := appIf.List(ctx, &applicationpkg.ApplicationQuery{})
+		require.NoError(t, err)
+		require.Len(t, apps.Items, 1)
+		assert.Equal(t, "guestbook", apps.Items[0].Name)
+	})
+}
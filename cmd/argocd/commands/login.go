@@ -4,8 +4,10 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/base64"
+	stderrors "errors"
 	"fmt"
 	"html"
+	"io"
 	"net/http"
 	"os"
 	"strconv"
@@ -41,10 +43,12 @@ func NewLoginCommand(globalClientOpts *argocdclient.ClientOptions) *cobra.Comman
 		ctxName          string
 		username         string
 		password         string
+		passwordFile     string
 		sso              bool
 		ssoPort          int
 		skipTestTLS      bool
 		ssoLaunchBrowser bool
+		deviceCode       bool
 	)
 	command := &cobra.Command{
 		Use:   "login SERVER",
@@ -53,9 +57,15 @@ func NewLoginCommand(globalClientOpts *argocdclient.ClientOptions) *cobra.Comman
 		Example: `# Login to Argo CD using a username and password
 argocd login cd.argoproj.io
 
+# Login to Argo CD reading the password from a file, or from stdin with "-"
+argocd login cd.argoproj.io --username admin --password-file /var/run/secrets/argocd/password
+
 # Login to Argo CD using SSO
 argocd login cd.argoproj.io --sso
 
+# Login to Argo CD using SSO from a machine without a browser, entering a code on another device
+argocd login cd.argoproj.io --sso --device-code
+
 # Configure direct access using Kubernetes API server
 argocd login cd.argoproj.io --core`,
 		Run: func(c *cobra.Command, args []string) {
@@ -68,6 +78,21 @@ argocd login cd.argoproj.io --core`,
 				os.Exit(1)
 			}
 
+			if deviceCode && !sso {
+				errors.Fatal(errors.ErrorGeneric, "--device-code requires --sso")
+			}
+
+			if !sso && !globalClientOpts.Core {
+				if passwordFile != "" {
+					var err error
+					password, err = cli.ReadPasswordFile(passwordFile)
+					errors.CheckError(err)
+				}
+				if (username == "" || password == "") && !cli.StdinIsTerminal() {
+					errors.Fatal(errors.ErrorGeneric, "--username and --password or --password-file must be provided when stdin is not a terminal")
+				}
+			}
+
 			switch {
 			case globalClientOpts.PortForward:
 				server = "port-forward"
@@ -138,7 +163,11 @@ argocd login cd.argoproj.io --core`,
 					errors.CheckError(err)
 					oauth2conf, provider, err := acdClient.OIDCConfig(ctx, acdSet)
 					errors.CheckError(err)
-					tokenString, refreshToken = oauth2Login(ctx, ssoPort, acdSet.GetOIDCConfig(), oauth2conf, provider, ssoLaunchBrowser)
+					if deviceCode {
+						tokenString, refreshToken = deviceCodeLogin(ctx, acdSet.GetOIDCConfig(), oauth2conf)
+					} else {
+						tokenString, refreshToken = oauth2Login(ctx, ssoPort, acdSet.GetOIDCConfig(), oauth2conf, provider, ssoLaunchBrowser)
+					}
 				}
 				parser := jwt.NewParser(jwt.WithoutClaimsValidation())
 				claims := jwt.MapClaims{}
@@ -183,10 +212,13 @@ argocd login cd.argoproj.io --core`,
 	command.Flags().StringVar(&ctxName, "name", "", "Name to use for the context")
 	command.Flags().StringVar(&username, "username", "", "The username of an account to authenticate")
 	command.Flags().StringVar(&password, "password", "", "The password of an account to authenticate")
+	command.Flags().StringVar(&passwordFile, "password-file", "", "Path to a file containing the password of an account to authenticate, or '-' to read it from stdin")
+	command.MarkFlagsMutuallyExclusive("password", "password-file")
 	command.Flags().BoolVar(&sso, "sso", false, "Perform SSO login")
 	command.Flags().IntVar(&ssoPort, "sso-port", DefaultSSOLocalPort, "Port to run local OAuth2 login application")
 	command.Flags().BoolVar(&skipTestTLS, "skip-test-tls", false, "Skip testing whether the server is configured with TLS (this can help when the command hangs for no apparent reason)")
 	command.Flags().BoolVar(&ssoLaunchBrowser, "sso-launch-browser", true, "Automatically launch the system default browser when performing SSO login")
+	command.Flags().BoolVar(&deviceCode, "device-code", false, "Perform SSO login using the OAuth2 device authorization grant, which does not require a browser or a local callback port on this machine")
 	return command
 }
 
@@ -352,6 +384,46 @@ func oauth2Login(
 	return tokenString, refreshToken
 }
 
+// deviceCodeLogin performs the OAuth2 device authorization grant (https://www.rfc-editor.org/rfc/rfc8628): it prints a
+// verification URL and user code to enter on any device, polls the token endpoint until the user completed the login
+// and returns the JWT token and a refresh token (if supported)
+func deviceCodeLogin(ctx context.Context, oidcSettings *settingspkg.OIDCConfig, oauth2conf *oauth2.Config) (string, string) {
+	tokenString, refreshToken, err := deviceCodeExchange(ctx, oidcSettings, oauth2conf, os.Stdout)
+	errors.CheckError(err)
+	fmt.Printf("Authentication successful\n")
+	log.Debugf("Token: %s", tokenString)
+	log.Debugf("Refresh Token: %s", refreshToken)
+	return tokenString, refreshToken
+}
+
+func deviceCodeExchange(ctx context.Context, oidcSettings *settingspkg.OIDCConfig, oauth2conf *oauth2.Config, out io.Writer) (string, string, error) {
+	if oauth2conf.Endpoint.DeviceAuthURL == "" {
+		return "", "", stderrors.New("the identity provider does not support the device authorization grant")
+	}
+	var opts []oauth2.AuthCodeOption
+	if claimsRequested := oidcSettings.GetIDTokenClaims(); claimsRequested != nil {
+		opts = oidcutil.AppendClaimsAuthenticationRequestParameter(opts, claimsRequested)
+	}
+	deviceAuth, err := oauth2conf.DeviceAuth(ctx, opts...)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to request device code: %w", err)
+	}
+	if deviceAuth.VerificationURIComplete != "" {
+		fmt.Fprintf(out, "To authenticate, open %s in a browser on any device and confirm the code %s\n", deviceAuth.VerificationURIComplete, deviceAuth.UserCode)
+	} else {
+		fmt.Fprintf(out, "To authenticate, open %s in a browser on any device and enter the code %s\n", deviceAuth.VerificationURI, deviceAuth.UserCode)
+	}
+	tok, err := oauth2conf.DeviceAccessToken(ctx, deviceAuth)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to complete device authorization: %w", err)
+	}
+	tokenString, ok := tok.Extra("id_token").(string)
+	if !ok {
+		return "", "", stderrors.New("no id_token in token response")
+	}
+	return tokenString, tok.RefreshToken, nil
+}
+
 func passwordLogin(ctx context.Context, acdClient argocdclient.Client, username, password string) string {
 	username, password = cli.PromptCredentials(username, password)
 	sessConn, sessionIf := acdClient.NewSessionClientOrDie()
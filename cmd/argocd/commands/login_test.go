@@ -1,7 +1,11 @@
 package commands
 
 import (
+	"bytes"
+	"encoding/json"
 	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 
@@ -9,6 +13,8 @@ import (
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
 )
 
 func captureStdout(callback func()) (string, error) {
@@ -77,3 +83,55 @@ func Test_ssoAuthFlow_ssoLaunchBrowser_false(t *testing.T) {
 
 	assert.Contains(t, out, "To authenticate, copy-and-paste the following URL into your preferred browser: http://test-sso-browser-flow.com")
 }
+
+func Test_deviceCodeExchange(t *testing.T) {
+	polls := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/device/code", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "argo-cd-cli", r.FormValue("client_id"))
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"device_code":      "device-code",
+			"user_code":        "ABCD-EFGH",
+			"verification_uri": "https://idp.example.com/device",
+			"expires_in":       60,
+			"interval":         1,
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "urn:ietf:params:oauth:grant-type:device_code", r.FormValue("grant_type"))
+		assert.Equal(t, "device-code", r.FormValue("device_code"))
+		w.Header().Set("Content-Type", "application/json")
+		polls++
+		if polls == 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string]any{"error": "authorization_pending"})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token":  "access-token",
+			"token_type":    "Bearer",
+			"refresh_token": "refresh-token",
+			"id_token":      "id-token",
+		})
+	})
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	oauth2conf := &oauth2.Config{
+		ClientID: "argo-cd-cli",
+		Endpoint: oauth2.Endpoint{DeviceAuthURL: ts.URL + "/device/code", TokenURL: ts.URL + "/token"},
+	}
+	var out bytes.Buffer
+	token, refreshToken, err := deviceCodeExchange(t.Context(), nil, oauth2conf, &out)
+	require.NoError(t, err)
+	assert.Equal(t, "id-token", token)
+	assert.Equal(t, "refresh-token", refreshToken)
+	assert.Equal(t, 2, polls)
+	assert.Contains(t, out.String(), "open https://idp.example.com/device in a browser on any device and enter the code ABCD-EFGH")
+}
+
+func Test_deviceCodeExchange_NotSupported(t *testing.T) {
+	_, _, err := deviceCodeExchange(t.Context(), nil, &oauth2.Config{}, io.Discard)
+	assert.ErrorContains(t, err, "does not support the device authorization grant")
+}
@@ -7,14 +7,18 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 
+	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/utils"
 	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	sessionpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/session"
 	"github.com/argoproj/argo-cd/v3/util/errors"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
 	"github.com/argoproj/argo-cd/v3/util/localconfig"
 )
 
 // NewLogoutCommand returns a new instance of `argocd logout` command
 func NewLogoutCommand(globalClientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var allSessions bool
 	command := &cobra.Command{
 		Use:   "logout CONTEXT",
 		Short: "Log out from Argo CD",
@@ -22,6 +26,9 @@ func NewLogoutCommand(globalClientOpts *argocdclient.ClientOptions) *cobra.Comma
 		Example: `# To log out of argocd
 $ argocd logout
 # This can be helpful for security reasons or when you want to switch between different Argo CD contexts or accounts.
+
+# To log out and revoke all sessions of the account on the server, e.g. if credentials might have leaked
+$ argocd logout cd.argoproj.io --all-sessions
 `,
 		Run: func(c *cobra.Command, args []string) {
 			if len(args) == 0 {
@@ -40,6 +47,17 @@ $ argocd logout
 
 			canLogout := promptUtil.Confirm(fmt.Sprintf("Are you sure you want to log out from '%s'?", context))
 			if canLogout {
+				if allSessions {
+					ctx := c.Context()
+					clientOpts := *globalClientOpts
+					clientOpts.Context = context
+					conn, sessionIf := headless.NewClientOrDie(&clientOpts, c).NewSessionClientOrDie()
+					defer utilio.Close(conn)
+					_, err := sessionIf.RevokeAll(ctx, &sessionpkg.SessionRevokeAllRequest{})
+					errors.CheckErrorWithContext(ctx, err)
+					fmt.Printf("Revoked all sessions of the account on '%s'\n", context)
+				}
+
 				ok := localCfg.RemoveToken(context)
 				if !ok {
 					log.Fatalf("Context %s does not exist", context)
@@ -58,5 +76,6 @@ $ argocd logout
 			}
 		},
 	}
+	command.Flags().BoolVar(&allSessions, "all-sessions", false, "Revoke all sessions of the account on the server, not only the local one. Only supported for local accounts.")
 	return command
 }
@@ -3,9 +3,11 @@ package commands
 import (
 	"context"
 	"encoding/json"
+	stderrors "errors"
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"slices"
 	"strings"
 	"text/tabwriter"
@@ -14,19 +16,28 @@ import (
 	humanize "github.com/dustin/go-humanize"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	yamlv3 "gopkg.in/yaml.v3"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/utils"
 	cmdutil "github.com/argoproj/argo-cd/v3/cmd/util"
 	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	applicationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	clusterpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/cluster"
 	projectpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/project"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/cli"
 	"github.com/argoproj/argo-cd/v3/util/errors"
 	"github.com/argoproj/argo-cd/v3/util/git"
+	"github.com/argoproj/argo-cd/v3/util/glob"
 	"github.com/argoproj/argo-cd/v3/util/gpg"
+	"github.com/argoproj/argo-cd/v3/util/grpc"
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
 	"github.com/argoproj/argo-cd/v3/util/templates"
 )
@@ -63,7 +74,10 @@ func NewProjectCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	}
 	command.AddCommand(NewProjectRoleCommand(clientOpts))
 	command.AddCommand(NewProjectCreateCommand(clientOpts))
+	command.AddCommand(NewProjectCloneCommand(clientOpts))
 	command.AddCommand(NewProjectGetCommand(clientOpts))
+	command.AddCommand(NewProjectUsageCommand(clientOpts))
+	command.AddCommand(NewProjectValidateCommand(clientOpts))
 	command.AddCommand(NewProjectDeleteCommand(clientOpts))
 	command.AddCommand(NewProjectListCommand(clientOpts))
 	command.AddCommand(NewProjectSetCommand(clientOpts))
@@ -72,12 +86,15 @@ func NewProjectCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command.AddCommand(NewProjectRemoveSignatureKeyCommand(clientOpts))
 	command.AddCommand(NewProjectAddDestinationCommand(clientOpts))
 	command.AddCommand(NewProjectRemoveDestinationCommand(clientOpts))
+	command.AddCommand(NewProjectDenyDestinationCommand(clientOpts))
+	command.AddCommand(NewProjectAllowDestinationCommand(clientOpts))
 	command.AddCommand(NewProjectAddSourceCommand(clientOpts))
 	command.AddCommand(NewProjectRemoveSourceCommand(clientOpts))
 	command.AddCommand(NewProjectAllowClusterResourceCommand(clientOpts))
 	command.AddCommand(NewProjectDenyClusterResourceCommand(clientOpts))
 	command.AddCommand(NewProjectAllowNamespaceResourceCommand(clientOpts))
 	command.AddCommand(NewProjectDenyNamespaceResourceCommand(clientOpts))
+	command.AddCommand(NewProjectSetResourceListsCommand(clientOpts))
 	command.AddCommand(NewProjectWindowsCommand(clientOpts))
 	command.AddCommand(NewProjectAddOrphanedIgnoreCommand(clientOpts))
 	command.AddCommand(NewProjectRemoveOrphanedIgnoreCommand(clientOpts))
@@ -85,6 +102,7 @@ func NewProjectCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command.AddCommand(NewProjectRemoveSourceNamespace(clientOpts))
 	command.AddCommand(NewProjectAddDestinationServiceAccountCommand(clientOpts))
 	command.AddCommand(NewProjectRemoveDestinationServiceAccountCommand(clientOpts))
+	command.AddCommand(NewProjectDestinationServiceAccountCommand(clientOpts))
 	return command
 }
 
@@ -103,9 +121,10 @@ func humanizeTimestamp(epoch int64) string {
 // NewProjectCreateCommand returns a new instance of an `argocd proj create` command
 func NewProjectCreateCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		opts    cmdutil.ProjectOpts
-		fileURL string
-		upsert  bool
+		opts     cmdutil.ProjectOpts
+		fileURL  string
+		upsert   bool
+		template string
 	)
 	command := &cobra.Command{
 		Use:   "create PROJECT",
@@ -116,6 +135,9 @@ func NewProjectCreateCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comm
 
 			# Create a new project with name PROJECT from a file or URL to a Kubernetes manifest
 			argocd proj create PROJECT -f FILE|URL
+
+			# Create a new project with name PROJECT which inherits the settings it does not set from the project template "standard"
+			argocd proj create PROJECT --from-template standard --description "Team A"
 		`),
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
@@ -125,11 +147,12 @@ func NewProjectCreateCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comm
 
 			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
 			defer utilio.Close(conn)
-			_, err = projIf.Create(ctx, &projectpkg.ProjectCreateRequest{Project: proj, Upsert: upsert})
+			_, err = projIf.Create(ctx, &projectpkg.ProjectCreateRequest{Project: proj, Upsert: upsert, Template: template})
 			errors.CheckError(err)
 		},
 	}
 	command.Flags().BoolVar(&upsert, "upsert", false, "Allows to override a project with the same name even if supplied project spec is different from existing spec")
+	command.Flags().StringVar(&template, "from-template", "", "Name of a project template configured in argocd-cm. The fields which are not set by the project are taken from the template")
 	command.Flags().StringVarP(&fileURL, "file", "f", "", "Filename or URL to Kubernetes manifests for the project")
 	err := command.Flags().SetAnnotation("file", cobra.BashCompFilenameExt, []string{"json", "yaml", "yml"})
 	if err != nil {
@@ -139,12 +162,143 @@ func NewProjectCreateCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comm
 	return command
 }
 
+// NewProjectCloneCommand returns a new instance of an `argocd proj clone` command
+func NewProjectCloneCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		opts   cmdutil.ProjectOpts
+		upsert bool
+		dryRun bool
+		output string
+	)
+	command := &cobra.Command{
+		Use:   "clone SOURCE DEST",
+		Short: "Create a project from the spec of an existing project",
+		Long: "Create a project from the spec of an existing project. Destinations, source repositories, roles, resource allow and deny lists, " +
+			"sync windows, signature keys and orphaned resources settings are copied. Role policies are rewritten for the new project " +
+			"and tokens issued for the roles of the source project are not copied.",
+		Example: templates.Examples(`
+			# Create project PROJECT-B with the same spec as PROJECT-A
+			argocd proj clone PROJECT-A PROJECT-B
+
+			# Clone a project with a different description and destination
+			argocd proj clone PROJECT-A PROJECT-B --description "Staging" --dest https://kubernetes.default.svc,staging
+
+			# Print the manifest of the cloned project without creating it
+			argocd proj clone PROJECT-A PROJECT-B --dry-run -o yaml
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 2 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			sourceName, destName := args[0], args[1]
+			if sourceName == destName {
+				errors.Fatal(errors.ErrorGeneric, "source and destination project must be different")
+			}
+
+			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
+			defer utilio.Close(conn)
+
+			source, err := projIf.Get(ctx, &projectpkg.ProjectQuery{Name: sourceName})
+			errors.CheckError(err)
+
+			proj := cloneProject(source, destName)
+			cmdutil.SetProjSpecOptions(c.Flags(), &proj.Spec, &opts)
+
+			if dryRun {
+				switch output {
+				case "yaml", "json":
+					err := PrintResource(proj, output)
+					errors.CheckError(err)
+				case "":
+					printProject(proj, nil, nil)
+				default:
+					errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+				}
+				return
+			}
+
+			_, err = projIf.Get(ctx, &projectpkg.ProjectQuery{Name: destName})
+			if err == nil && !upsert {
+				errors.Fatal(errors.ErrorGeneric, fmt.Sprintf("project '%s' already exists, use --upsert to replace its spec", destName))
+			}
+			if err != nil && grpc.UnwrapGRPCStatus(err).Code() != codes.NotFound {
+				errors.CheckError(err)
+			}
+
+			created, err := projIf.Create(ctx, &projectpkg.ProjectCreateRequest{Project: proj, Upsert: upsert})
+			errors.CheckError(err)
+			switch output {
+			case "yaml", "json":
+				err := PrintResource(created, output)
+				errors.CheckError(err)
+			case "":
+				fmt.Printf("Project '%s' cloned from '%s'\n", created.Name, sourceName)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().BoolVar(&upsert, "upsert", false, "Allows to override the destination project if it already exists")
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "Print the cloned project instead of creating it")
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: json|yaml")
+	cmdutil.AddProjFlags(command, &opts)
+	return command
+}
+
+// cloneProject returns a copy of the source project spec under the given name. Role tokens are
+// dropped since they were issued for the source project, and role policies are rewritten to
+// refer to the new project.
+func cloneProject(source *v1alpha1.AppProject, name string) *v1alpha1.AppProject {
+	proj := &v1alpha1.AppProject{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       application.AppProjectKind,
+			APIVersion: application.Group + "/v1alpha1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: source.Namespace,
+		},
+		Spec: *source.Spec.DeepCopy(),
+	}
+	for i := range proj.Spec.Roles {
+		role := &proj.Spec.Roles[i]
+		role.JWTTokens = nil
+		for j, policy := range role.Policies {
+			role.Policies[j] = retargetRolePolicy(policy, source.Name, name, role.Name)
+		}
+	}
+	return proj
+}
+
+// retargetRolePolicy rewrites the subject and object of a project role policy so that it refers to
+// the project dest instead of source. Policies which cannot be parsed are returned unchanged.
+func retargetRolePolicy(policy, source, dest, role string) string {
+	parts := strings.Split(policy, ",")
+	if len(parts) != 6 {
+		return policy
+	}
+	for i := range parts {
+		parts[i] = strings.TrimSpace(parts[i])
+	}
+	if parts[1] == fmt.Sprintf("proj:%s:%s", source, role) {
+		parts[1] = fmt.Sprintf("proj:%s:%s", dest, role)
+	}
+	if parts[4] == source || strings.HasPrefix(parts[4], source+"/") {
+		parts[4] = dest + strings.TrimPrefix(parts[4], source)
+	}
+	return strings.Join(parts, ", ")
+}
+
 // NewProjectSetCommand returns a new instance of an `argocd proj set` command
 func NewProjectSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var opts cmdutil.ProjectOpts
 	command := &cobra.Command{
-		Use:   "set PROJECT",
-		Short: "Set project parameters",
+		Use:               "set PROJECT",
+		ValidArgsFunction: completeProjectNames(clientOpts, 1),
+		Short:             "Set project parameters",
 		Example: templates.Examples(`
 			# Set project parameters with some allowed cluster resources [RES1,RES2,...] for project with name PROJECT
 			argocd proj set PROJECT --allow-cluster-resource [RES1,RES2,...]
@@ -183,8 +337,9 @@ func NewProjectSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 // NewProjectAddSignatureKeyCommand returns a new instance of an `argocd proj add-signature-key` command
 func NewProjectAddSignatureKeyCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command := &cobra.Command{
-		Use:   "add-signature-key PROJECT KEY-ID",
-		Short: "Add GnuPG signature key to project",
+		Use:               "add-signature-key PROJECT KEY-ID",
+		ValidArgsFunction: completeProjectNames(clientOpts, 1),
+		Short:             "Add GnuPG signature key to project",
 		Example: templates.Examples(`
 			# Add GnuPG signature key KEY-ID to project PROJECT
 			argocd proj add-signature-key PROJECT KEY-ID
@@ -225,8 +380,9 @@ func NewProjectAddSignatureKeyCommand(clientOpts *argocdclient.ClientOptions) *c
 // NewProjectRemoveSignatureKeyCommand returns a new instance of an `argocd proj remove-signature-key` command
 func NewProjectRemoveSignatureKeyCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command := &cobra.Command{
-		Use:   "remove-signature-key PROJECT KEY-ID",
-		Short: "Remove GnuPG signature key from project",
+		Use:               "remove-signature-key PROJECT KEY-ID",
+		ValidArgsFunction: completeProjectNames(clientOpts, 1),
+		Short:             "Remove GnuPG signature key from project",
 		Example: templates.Examples(`
 			# Remove GnuPG signature key KEY-ID from project PROJECT
 			argocd proj remove-signature-key PROJECT KEY-ID
@@ -278,8 +434,9 @@ func NewProjectAddDestinationCommand(clientOpts *argocdclient.ClientOptions) *co
 	}
 
 	command := &cobra.Command{
-		Use:   "add-destination PROJECT SERVER/NAME NAMESPACE",
-		Short: "Add project destination",
+		Use:               "add-destination PROJECT SERVER/NAME NAMESPACE",
+		ValidArgsFunction: completeProjectNames(clientOpts, 1),
+		Short:             "Add project destination",
 		Example: templates.Examples(`
 			# Add project destination using a server URL (SERVER) in the specified namespace (NAMESPACE) on the project with name PROJECT
 			argocd proj add-destination PROJECT SERVER NAMESPACE
@@ -322,8 +479,9 @@ func NewProjectAddDestinationCommand(clientOpts *argocdclient.ClientOptions) *co
 // NewProjectRemoveDestinationCommand returns a new instance of an `argocd proj remove-destination` command
 func NewProjectRemoveDestinationCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command := &cobra.Command{
-		Use:   "remove-destination PROJECT SERVER NAMESPACE",
-		Short: "Remove project destination",
+		Use:               "remove-destination PROJECT SERVER NAMESPACE",
+		ValidArgsFunction: completeProjectNames(clientOpts, 1),
+		Short:             "Remove project destination",
 		Example: templates.Examples(`
 			# Remove the destination (SERVER) from the specified namespace (NAMESPACE) on the project with name PROJECT
 			argocd proj remove-destination PROJECT SERVER NAMESPACE
@@ -363,12 +521,170 @@ func NewProjectRemoveDestinationCommand(clientOpts *argocdclient.ClientOptions)
 	return command
 }
 
+// NewProjectDenyDestinationCommand returns a new instance of an `argocd proj deny-destination` command
+func NewProjectDenyDestinationCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var nameInsteadServer bool
+	command := &cobra.Command{
+		Use:               "deny-destination PROJECT SERVER/NAME NAMESPACE",
+		ValidArgsFunction: completeProjectNames(clientOpts, 1),
+		Short:             "Deny deploying to a project destination",
+		Long: "Deny deploying to a project destination by adding a negated destination to the project. " +
+			"If NAMESPACE is '*', deploying to any namespace of the cluster is denied, otherwise deploying to NAMESPACE is denied. " +
+			"Note that a negated destination also matches the destinations it does not deny, so it should be combined with " +
+			"a permitted destination which covers the denied one.",
+		Example: templates.Examples(`
+			# Deny deploying to the kube-system namespace of a cluster which is otherwise permitted
+			argocd proj deny-destination PROJECT https://kubernetes.default.svc kube-system
+
+			# Deny deploying to the kube-system namespace of any cluster
+			argocd proj deny-destination PROJECT '*' kube-system
+
+			# Deny deploying to any namespace of the cluster with name NAME
+			argocd proj deny-destination PROJECT NAME '*' --name
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 3 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			projName := args[0]
+			destination, err := buildDenyDestination(args[1], args[2], nameInsteadServer)
+			errors.CheckError(err)
+
+			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
+			defer utilio.Close(conn)
+
+			proj, err := projIf.Get(ctx, &projectpkg.ProjectQuery{Name: projName})
+			errors.CheckError(err)
+
+			if slices.Contains(proj.Spec.Destinations, destination) {
+				log.Fatal("Specified destination is already denied in project")
+			}
+			if !isDenyDestinationEffective(proj.Spec.Destinations, destination) {
+				others := "all other namespaces of " + args[1]
+				if args[2] == "*" {
+					others = "all other clusters"
+				}
+				log.Warnf("No permitted destination of project '%s' matches %s,%s. Denying it has no effect other than permitting %s", projName, args[1], args[2], others)
+			}
+			proj.Spec.Destinations = append(proj.Spec.Destinations, destination)
+			_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
+			errors.CheckError(err)
+		},
+	}
+	command.Flags().BoolVar(&nameInsteadServer, "name", false, "Use name as destination instead server")
+	return command
+}
+
+// NewProjectAllowDestinationCommand returns a new instance of an `argocd proj allow-destination` command
+func NewProjectAllowDestinationCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var nameInsteadServer bool
+	command := &cobra.Command{
+		Use:               "allow-destination PROJECT SERVER/NAME NAMESPACE",
+		ValidArgsFunction: completeProjectNames(clientOpts, 1),
+		Short:             "Remove a denied project destination",
+		Long:              "Remove a denied project destination which was added with \"argocd proj deny-destination\". Use \"argocd proj add-destination\" to permit new destinations.",
+		Example: templates.Examples(`
+			# Allow deploying to the kube-system namespace of a cluster again
+			argocd proj allow-destination PROJECT https://kubernetes.default.svc kube-system
+
+			# Allow deploying to the cluster with name NAME again
+			argocd proj allow-destination PROJECT NAME '*' --name
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 3 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			projName := args[0]
+			destination, err := buildDenyDestination(args[1], args[2], nameInsteadServer)
+			errors.CheckError(err)
+
+			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
+			defer utilio.Close(conn)
+
+			proj, err := projIf.Get(ctx, &projectpkg.ProjectQuery{Name: projName})
+			errors.CheckError(err)
+
+			index := slices.Index(proj.Spec.Destinations, destination)
+			if index == -1 {
+				log.Fatal("Specified destination is not denied in project")
+			}
+			proj.Spec.Destinations = slices.Delete(proj.Spec.Destinations, index, index+1)
+			_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
+			errors.CheckError(err)
+		},
+	}
+	command.Flags().BoolVar(&nameInsteadServer, "name", false, "Use name as destination instead server")
+	return command
+}
+
+// buildDenyDestination returns the negated destination which denies deploying to the namespace of
+// a cluster. If namespace is '*', the cluster itself is negated so that all its namespaces are denied.
+func buildDenyDestination(destination string, namespace string, nameInsteadServer bool) (v1alpha1.ApplicationDestination, error) {
+	if strings.HasPrefix(destination, "!") || strings.HasPrefix(namespace, "!") {
+		return v1alpha1.ApplicationDestination{}, stderrors.New("destination and namespace must not be negated, the negation is added automatically")
+	}
+	if namespace == "*" {
+		if destination == "*" {
+			return v1alpha1.ApplicationDestination{}, stderrors.New("cannot deny all destinations, remove the permitted destinations instead")
+		}
+		destination = "!" + destination
+	} else {
+		namespace = "!" + namespace
+	}
+	if nameInsteadServer {
+		return v1alpha1.ApplicationDestination{Name: destination, Namespace: namespace}, nil
+	}
+	return v1alpha1.ApplicationDestination{Server: destination, Namespace: namespace}, nil
+}
+
+// isDenyDestination returns whether the destination is a negated destination
+func isDenyDestination(dest v1alpha1.ApplicationDestination) bool {
+	return strings.HasPrefix(dest.Server, "!") || strings.HasPrefix(dest.Name, "!") || strings.HasPrefix(dest.Namespace, "!")
+}
+
+// isDenyDestinationEffective returns whether any permitted destination matches the denied destination.
+// Otherwise, the denied destination is not permitted in the first place.
+func isDenyDestinationEffective(destinations []v1alpha1.ApplicationDestination, deny v1alpha1.ApplicationDestination) bool {
+	server, name, namespace := strings.TrimPrefix(deny.Server, "!"), strings.TrimPrefix(deny.Name, "!"), strings.TrimPrefix(deny.Namespace, "!")
+	for _, dest := range destinations {
+		if isDenyDestination(dest) {
+			continue
+		}
+		if !glob.Match(dest.Namespace, namespace) && dest.Namespace != namespace {
+			continue
+		}
+		if server != "" && dest.Server != "" && glob.Match(dest.Server, server) {
+			return true
+		}
+		if name != "" && dest.Name != "" && glob.Match(dest.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// formatDestination formats a destination as SERVER,NAMESPACE or NAME,NAMESPACE with the negation removed
+func formatDestination(dest v1alpha1.ApplicationDestination) string {
+	server := dest.Server
+	if server == "" {
+		server = dest.Name
+	}
+	return fmt.Sprintf("%s,%s", strings.TrimPrefix(server, "!"), strings.TrimPrefix(dest.Namespace, "!"))
+}
+
 // NewProjectAddOrphanedIgnoreCommand returns a new instance of an `argocd proj add-orphaned-ignore` command
 func NewProjectAddOrphanedIgnoreCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var name string
 	command := &cobra.Command{
-		Use:   "add-orphaned-ignore PROJECT GROUP KIND",
-		Short: "Add a resource to orphaned ignore list",
+		Use:               "add-orphaned-ignore PROJECT GROUP KIND",
+		ValidArgsFunction: completeProjectNames(clientOpts, 1),
+		Short:             "Add a resource to orphaned ignore list",
 		Example: templates.Examples(`
 		# Add a resource of the specified GROUP and KIND to orphaned ignore list on the project with name PROJECT
 		argocd proj add-orphaned-ignore PROJECT GROUP KIND
@@ -417,8 +733,9 @@ func NewProjectAddOrphanedIgnoreCommand(clientOpts *argocdclient.ClientOptions)
 func NewProjectRemoveOrphanedIgnoreCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var name string
 	command := &cobra.Command{
-		Use:   "remove-orphaned-ignore PROJECT GROUP KIND",
-		Short: "Remove a resource from orphaned ignore list",
+		Use:               "remove-orphaned-ignore PROJECT GROUP KIND",
+		ValidArgsFunction: completeProjectNames(clientOpts, 1),
+		Short:             "Remove a resource from orphaned ignore list",
 		Example: templates.Examples(`
 		# Remove a resource of the specified GROUP and KIND from orphaned ignore list on the project with name PROJECT
 		argocd proj remove-orphaned-ignore PROJECT GROUP KIND
@@ -469,8 +786,9 @@ func NewProjectRemoveOrphanedIgnoreCommand(clientOpts *argocdclient.ClientOption
 // NewProjectAddSourceCommand returns a new instance of an `argocd proj add-src` command
 func NewProjectAddSourceCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command := &cobra.Command{
-		Use:   "add-source PROJECT URL",
-		Short: "Add project source repository",
+		Use:               "add-source PROJECT URL",
+		ValidArgsFunction: completeProjectNames(clientOpts, 1),
+		Short:             "Add project source repository",
 		Example: templates.Examples(`
 			# Add a source repository (URL) to the project with name PROJECT
 			argocd proj add-source PROJECT URL
@@ -511,8 +829,9 @@ func NewProjectAddSourceCommand(clientOpts *argocdclient.ClientOptions) *cobra.C
 // NewProjectAddSourceNamespace returns a new instance of an `argocd proj add-source-namespace` command
 func NewProjectAddSourceNamespace(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command := &cobra.Command{
-		Use:   "add-source-namespace PROJECT NAMESPACE",
-		Short: "Add source namespace to the AppProject",
+		Use:               "add-source-namespace PROJECT NAMESPACE",
+		ValidArgsFunction: completeProjectNames(clientOpts, 1),
+		Short:             "Add source namespace to the AppProject",
 		Example: templates.Examples(`
 			# Add Kubernetes namespace as source namespace to the AppProject where application resources are allowed to be created in.
 			argocd proj add-source-namespace PROJECT NAMESPACE
@@ -549,8 +868,9 @@ func NewProjectAddSourceNamespace(clientOpts *argocdclient.ClientOptions) *cobra
 // NewProjectRemoveSourceNamespace returns a new instance of an `argocd proj remove-source-namespace` command
 func NewProjectRemoveSourceNamespace(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command := &cobra.Command{
-		Use:   "remove-source-namespace PROJECT NAMESPACE",
-		Short: "Removes the source namespace from the AppProject",
+		Use:               "remove-source-namespace PROJECT NAMESPACE",
+		ValidArgsFunction: completeProjectNames(clientOpts, 1),
+		Short:             "Removes the source namespace from the AppProject",
 		Example: templates.Examples(`
 			# Remove source NAMESPACE in PROJECT 
 			argocd proj remove-source-namespace PROJECT NAMESPACE
@@ -720,11 +1040,233 @@ func NewProjectAllowClusterResourceCommand(clientOpts *argocdclient.ClientOption
 	return modifyResourceListCmd(use, desc, examples, clientOpts, true, false)
 }
 
+// resourceListNames are the names of the project resource lists, in the order they are applied
+var resourceListNames = []string{"clusterResourceWhitelist", "clusterResourceBlacklist", "namespaceResourceWhitelist", "namespaceResourceBlacklist"}
+
+// NewProjectSetResourceListsCommand returns a new instance of an `argocd proj set-resource-lists` command
+func NewProjectSetResourceListsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		fromFile string
+		merge    bool
+		replace  bool
+		dryRun   bool
+	)
+	command := &cobra.Command{
+		Use:               "set-resource-lists PROJECT --from-file FILE",
+		ValidArgsFunction: completeProjectNames(clientOpts, 1),
+		Short:             "Set the allowed and denied resources of a project from a file",
+		Long: "Set the allowed and denied resources of a project from a file. The file may contain the clusterResourceWhitelist, " +
+			"clusterResourceBlacklist, namespaceResourceWhitelist and namespaceResourceBlacklist lists, each one a list of group and kind entries. " +
+			"By default, the entries are merged into the lists of the project. With --replace, each list in the file replaces the corresponding " +
+			"list of the project. Lists which are not in the file are left unchanged. All changes are applied in a single update of the project.",
+		Example: templates.Examples(`
+			# Merge the resource lists from a file into the lists of project PROJECT
+			argocd proj set-resource-lists PROJECT --from-file resources.yaml
+
+			# Replace the resource lists of project PROJECT with the lists from a file
+			argocd proj set-resource-lists PROJECT --from-file resources.yaml --replace
+
+			# Show the changes to the resource lists of project PROJECT without applying them
+			argocd proj set-resource-lists PROJECT --from-file resources.yaml --dry-run
+
+			# Example of a resources.yaml file
+			clusterResourceWhitelist:
+			- group: ""
+			  kind: Namespace
+			namespaceResourceBlacklist:
+			- group: ""
+			  kind: ResourceQuota
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 || fromFile == "" {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			projName := args[0]
+
+			data, err := os.ReadFile(fromFile)
+			errors.CheckError(err)
+			lists, err := parseResourceLists(data)
+			if err != nil {
+				errors.Fatal(errors.ErrorGeneric, fmt.Sprintf("%s: %v", fromFile, err))
+			}
+
+			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
+			defer utilio.Close(conn)
+
+			proj, err := projIf.Get(ctx, &projectpkg.ProjectQuery{Name: projName})
+			errors.CheckError(err)
+
+			original := proj.Spec.DeepCopy()
+			changes := applyResourceLists(&proj.Spec, lists, replace)
+			if len(changes) == 0 {
+				fmt.Printf("Resource lists of project '%s' are unchanged\n", projName)
+				return
+			}
+
+			if dryRun {
+				fmt.Printf("===== Changes to project %s =====\n", projName)
+				// diff exits with a non-zero code when there are differences, so its error is not relevant here
+				_ = cli.PrintDiff(projName, resourceListsObject(original), resourceListsObject(&proj.Spec))
+				return
+			}
+
+			_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
+			errors.CheckError(err)
+			for _, change := range changes {
+				fmt.Println(change)
+			}
+		},
+	}
+	command.Flags().StringVarP(&fromFile, "from-file", "f", "", "Path to a file containing the resource lists")
+	command.Flags().BoolVar(&merge, "merge", true, "Merge the entries from the file into the resource lists of the project")
+	command.Flags().BoolVar(&replace, "replace", false, "Replace the resource lists of the project with the lists from the file")
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "Print the changes to the resource lists without applying them")
+	command.MarkFlagsMutuallyExclusive("merge", "replace")
+	err := command.Flags().SetAnnotation("from-file", cobra.BashCompFilenameExt, []string{"json", "yaml", "yml"})
+	if err != nil {
+		log.Fatal(err)
+	}
+	return command
+}
+
+// parseResourceLists parses a document with project resource lists. Unknown lists and invalid
+// entries are rejected with a reference to their line in the document.
+func parseResourceLists(data []byte) (map[string][]metav1.GroupKind, error) {
+	var doc yamlv3.Node
+	if err := yamlv3.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	lists := make(map[string][]metav1.GroupKind)
+	if len(doc.Content) == 0 {
+		return lists, nil
+	}
+	root := doc.Content[0]
+	if root.Kind != yamlv3.MappingNode {
+		return nil, fmt.Errorf("line %d: expected a mapping of resource lists", root.Line)
+	}
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		key, value := root.Content[i], root.Content[i+1]
+		if !slices.Contains(resourceListNames, key.Value) {
+			return nil, fmt.Errorf("line %d: unknown resource list '%s', must be one of: %s", key.Line, key.Value, strings.Join(resourceListNames, ", "))
+		}
+		if value.Tag == "!!null" {
+			lists[key.Value] = nil
+			continue
+		}
+		if value.Kind != yamlv3.SequenceNode {
+			return nil, fmt.Errorf("line %d: %s must be a list of group and kind entries", value.Line, key.Value)
+		}
+		entries := make([]metav1.GroupKind, 0, len(value.Content))
+		for _, item := range value.Content {
+			var gk metav1.GroupKind
+			if err := item.Decode(&gk); err != nil {
+				return nil, fmt.Errorf("line %d: %w", item.Line, err)
+			}
+			if err := validateResourceListEntry(gk); err != nil {
+				return nil, fmt.Errorf("line %d: %w", item.Line, err)
+			}
+			entries = append(entries, gk)
+		}
+		lists[key.Value] = entries
+	}
+	return lists, nil
+}
+
+// validateResourceListEntry checks that the group and kind of an entry are valid patterns
+func validateResourceListEntry(gk metav1.GroupKind) error {
+	if gk.Kind == "" {
+		return fmt.Errorf("invalid resource '%s': kind must not be empty", formatGroupKind(gk))
+	}
+	for _, pattern := range []string{gk.Group, gk.Kind} {
+		if strings.ContainsAny(pattern, "/ \t") {
+			return fmt.Errorf("invalid resource '%s': group and kind must not contain '/' or whitespace", formatGroupKind(gk))
+		}
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid resource '%s': %w", formatGroupKind(gk), err)
+		}
+	}
+	return nil
+}
+
+func resourceList(spec *v1alpha1.AppProjectSpec, name string) *[]metav1.GroupKind {
+	switch name {
+	case "clusterResourceWhitelist":
+		return &spec.ClusterResourceWhitelist
+	case "clusterResourceBlacklist":
+		return &spec.ClusterResourceBlacklist
+	case "namespaceResourceWhitelist":
+		return &spec.NamespaceResourceWhitelist
+	case "namespaceResourceBlacklist":
+		return &spec.NamespaceResourceBlacklist
+	}
+	return nil
+}
+
+// applyResourceLists merges the given lists into the project spec, or replaces the lists of the
+// project if replace is set. Duplicate entries are removed. It returns a description of the
+// changes made to each list.
+func applyResourceLists(spec *v1alpha1.AppProjectSpec, lists map[string][]metav1.GroupKind, replace bool) []string {
+	var changes []string
+	for _, name := range resourceListNames {
+		entries, ok := lists[name]
+		if !ok {
+			continue
+		}
+		list := resourceList(spec, name)
+		var updated []metav1.GroupKind
+		if !replace {
+			updated = append(updated, *list...)
+		}
+		for _, gk := range entries {
+			if !slices.Contains(updated, gk) {
+				updated = append(updated, gk)
+			}
+		}
+		var added, removed int
+		for _, gk := range updated {
+			if !slices.Contains(*list, gk) {
+				added++
+			}
+		}
+		for _, gk := range *list {
+			if !slices.Contains(updated, gk) {
+				removed++
+			}
+		}
+		if added == 0 && removed == 0 {
+			continue
+		}
+		*list = updated
+		changes = append(changes, fmt.Sprintf("%s: %d added, %d removed", name, added, removed))
+	}
+	return changes
+}
+
+func resourceListsObject(spec *v1alpha1.AppProjectSpec) *unstructured.Unstructured {
+	obj := make(map[string]any)
+	for _, name := range resourceListNames {
+		list := *resourceList(spec, name)
+		if len(list) == 0 {
+			continue
+		}
+		items := make([]any, 0, len(list))
+		for _, gk := range list {
+			items = append(items, map[string]any{"group": gk.Group, "kind": gk.Kind})
+		}
+		obj[name] = items
+	}
+	return &unstructured.Unstructured{Object: obj}
+}
+
 // NewProjectRemoveSourceCommand returns a new instance of an `argocd proj remove-src` command
 func NewProjectRemoveSourceCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command := &cobra.Command{
-		Use:   "remove-source PROJECT URL",
-		Short: "Remove project source repository",
+		Use:               "remove-source PROJECT URL",
+		ValidArgsFunction: completeProjectNames(clientOpts, 1),
+		Short:             "Remove project source repository",
 		Example: templates.Examples(`
 			# Remove URL source repository to project PROJECT
 			argocd proj remove-source PROJECT URL
@@ -767,8 +1309,9 @@ func NewProjectRemoveSourceCommand(clientOpts *argocdclient.ClientOptions) *cobr
 // NewProjectDeleteCommand returns a new instance of an `argocd proj delete` command
 func NewProjectDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command := &cobra.Command{
-		Use:   "delete PROJECT",
-		Short: "Delete project",
+		Use:               "delete PROJECT",
+		ValidArgsFunction: completeProjectNames(clientOpts, 1),
+		Short:             "Delete project",
 		Example: templates.Examples(`
 			# Delete the project with name PROJECT
 			argocd proj delete PROJECT
@@ -806,19 +1349,74 @@ func printProjectNames(projects []v1alpha1.AppProject) {
 	}
 }
 
-// Print table of project info
-func printProjectTable(projects []v1alpha1.AppProject) {
+// Print table of project info. In wide mode, the number of applications of each project is
+// included and destinations and source repositories are summarized with their count.
+func printProjectTable(projects []v1alpha1.AppProject, wide bool, appCounts map[string]int) {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintf(w, "NAME\tDESCRIPTION\tDESTINATIONS\tSOURCES\tCLUSTER-RESOURCE-WHITELIST\tNAMESPACE-RESOURCE-BLACKLIST\tSIGNATURE-KEYS\tORPHANED-RESOURCES\tDESTINATION-SERVICE-ACCOUNTS\n")
+	if wide {
+		fmt.Fprintf(w, "NAME\tDESCRIPTION\tAPPS\tDESTINATIONS\tSOURCE REPOS\tCLUSTER-RESOURCE-WHITELIST\tNAMESPACE-RESOURCE-BLACKLIST\tSIGNATURE-KEYS\tORPHANED-RESOURCES\tDESTINATION-SERVICE-ACCOUNTS\n")
+	} else {
+		fmt.Fprintf(w, "NAME\tDESCRIPTION\tDESTINATIONS\tSOURCES\tCLUSTER-RESOURCE-WHITELIST\tNAMESPACE-RESOURCE-BLACKLIST\tSIGNATURE-KEYS\tORPHANED-RESOURCES\tDESTINATION-SERVICE-ACCOUNTS\n")
+	}
 	for _, p := range projects {
-		printProjectLine(w, &p)
+		printProjectLine(w, &p, wide, appCounts[p.Name])
 	}
 	_ = w.Flush()
 }
 
+func formatProjectDestinationsSummary(p *v1alpha1.AppProject) string {
+	if len(p.Spec.Destinations) == 0 {
+		return "0"
+	}
+	dest := p.Spec.Destinations[0]
+	server := dest.Server
+	if server == "" {
+		server = dest.Name
+	}
+	if len(p.Spec.Destinations) == 1 {
+		return fmt.Sprintf("1 (%s,%s)", server, dest.Namespace)
+	}
+	return fmt.Sprintf("%d (%s,%s, ...)", len(p.Spec.Destinations), server, dest.Namespace)
+}
+
+func formatProjectSourceReposSummary(p *v1alpha1.AppProject) string {
+	switch len(p.Spec.SourceRepos) {
+	case 0:
+		return "0"
+	case 1:
+		return fmt.Sprintf("1 (%s)", p.Spec.SourceRepos[0])
+	default:
+		return fmt.Sprintf("%d (%s, ...)", len(p.Spec.SourceRepos), p.Spec.SourceRepos[0])
+	}
+}
+
+// projectWithCounts is the output of `argocd proj list` in json or yaml format
+type projectWithCounts struct {
+	*v1alpha1.AppProject
+	Counts projectCounts `json:"counts"`
+}
+
+type projectCounts struct {
+	Applications int `json:"applications"`
+	Destinations int `json:"destinations"`
+	SourceRepos  int `json:"sourceRepos"`
+}
+
+// countApplicationsByProject returns the number of applications in each project
+func countApplicationsByProject(apps []v1alpha1.Application) map[string]int {
+	counts := make(map[string]int)
+	for _, app := range apps {
+		counts[app.Spec.GetProject()]++
+	}
+	return counts
+}
+
 // NewProjectListCommand returns a new instance of an `argocd proj list` command
 func NewProjectListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
-	var output string
+	var (
+		output string
+		empty  bool
+	)
 	command := &cobra.Command{
 		Use:   "list",
 		Short: "List projects",
@@ -828,28 +1426,66 @@ func NewProjectListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 
 			# List all available projects in yaml format
 			argocd proj list -o yaml
+
+			# List all available projects with their number of applications, destinations and source repositories
+			argocd proj list -o wide
+
+			# List the projects which have no applications
+			argocd proj list --empty
 		`),
 		Run: func(c *cobra.Command, _ []string) {
 			ctx := c.Context()
 
-			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
+			acdClient := headless.NewClientOrDie(clientOpts, c)
+			conn, projIf := acdClient.NewProjectClientOrDie()
 			defer utilio.Close(conn)
 			projects, err := projIf.List(ctx, &projectpkg.ProjectQuery{})
 			errors.CheckError(err)
+
+			// applications are listed once and grouped by project, instead of querying every project
+			var appCounts map[string]int
+			if empty || output == "wide" || output == "json" || output == "yaml" {
+				appConn, appIf := acdClient.NewApplicationClientOrDie()
+				defer utilio.Close(appConn)
+				apps, err := appIf.List(ctx, &applicationpkg.ApplicationQuery{})
+				errors.CheckError(err)
+				appCounts = countApplicationsByProject(apps.Items)
+			}
+
+			items := projects.Items
+			if empty {
+				items = slices.DeleteFunc(items, func(p v1alpha1.AppProject) bool {
+					return appCounts[p.Name] > 0
+				})
+			}
+
 			switch output {
 			case "yaml", "json":
-				err := PrintResourceList(projects.Items, output, false)
+				res := make([]projectWithCounts, 0, len(items))
+				for i := range items {
+					p := &items[i]
+					res = append(res, projectWithCounts{
+						AppProject: p,
+						Counts: projectCounts{
+							Applications: appCounts[p.Name],
+							Destinations: len(p.Spec.Destinations),
+							SourceRepos:  len(p.Spec.SourceRepos),
+						},
+					})
+				}
+				err := PrintResourceList(res, output, false)
 				errors.CheckError(err)
 			case "name":
-				printProjectNames(projects.Items)
+				printProjectNames(items)
 			case "wide", "":
-				printProjectTable(projects.Items)
+				printProjectTable(items, output == "wide", appCounts)
 			default:
 				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
 			}
 		},
 	}
-	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide|name")
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: json|yaml|wide|name")
+	command.Flags().BoolVar(&empty, "empty", false, "Only list projects which have no applications")
 	return command
 }
 
@@ -864,7 +1500,7 @@ func formatOrphanedResources(p *v1alpha1.AppProject) string {
 	return fmt.Sprintf("enabled (%s)", details)
 }
 
-func printProjectLine(w io.Writer, p *v1alpha1.AppProject) {
+func printProjectLine(w io.Writer, p *v1alpha1.AppProject, wide bool, appCount int) {
 	var destinations, destinationServiceAccounts, sourceRepos, clusterWhitelist, namespaceBlacklist, signatureKeys string
 	switch len(p.Spec.Destinations) {
 	case 0:
@@ -910,23 +1546,45 @@ func printProjectLine(w io.Writer, p *v1alpha1.AppProject) {
 	default:
 		signatureKeys = fmt.Sprintf("%d key(s)", len(p.Spec.SignatureKeys))
 	}
+	if wide {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%v\t%v\t%v\t%v\t%v\t%v\t%v\n", p.Name, p.Spec.Description, appCount, formatProjectDestinationsSummary(p), formatProjectSourceReposSummary(p), clusterWhitelist, namespaceBlacklist, signatureKeys, formatOrphanedResources(p), destinationServiceAccounts)
+		return
+	}
 	fmt.Fprintf(w, "%s\t%s\t%v\t%v\t%v\t%v\t%v\t%v\t%v\n", p.Name, p.Spec.Description, destinations, sourceRepos, clusterWhitelist, namespaceBlacklist, signatureKeys, formatOrphanedResources(p), destinationServiceAccounts)
 }
 
+func formatSyncWindow(w *v1alpha1.SyncWindow) string {
+	return fmt.Sprintf("%s:%s:%s (%s)", w.Kind, w.Schedule, w.Duration, formatTimeZone(w.TimeZone))
+}
+
 func printProject(p *v1alpha1.AppProject, scopedRepositories []*v1alpha1.Repository, scopedClusters []*v1alpha1.Cluster) {
 	const printProjFmtStr = "%-29s%s\n"
 
 	fmt.Printf(printProjFmtStr, "Name:", p.Name)
 	fmt.Printf(printProjFmtStr, "Description:", p.Spec.Description)
 
-	// Print destinations
+	// Print destinations, the denied destinations are printed separately
+	var destinations, deniedDestinations []string
+	for _, dest := range p.Spec.Destinations {
+		if isDenyDestination(dest) {
+			deniedDestinations = append(deniedDestinations, formatDestination(dest))
+		} else {
+			destinations = append(destinations, formatDestination(dest))
+		}
+	}
 	dest0 := "<none>"
-	if len(p.Spec.Destinations) > 0 {
-		dest0 = fmt.Sprintf("%s,%s", p.Spec.Destinations[0].Server, p.Spec.Destinations[0].Namespace)
+	if len(destinations) > 0 {
+		dest0 = destinations[0]
 	}
 	fmt.Printf(printProjFmtStr, "Destinations:", dest0)
-	for i := 1; i < len(p.Spec.Destinations); i++ {
-		fmt.Printf(printProjFmtStr, "", fmt.Sprintf("%s,%s", p.Spec.Destinations[i].Server, p.Spec.Destinations[i].Namespace))
+	for i := 1; i < len(destinations); i++ {
+		fmt.Printf(printProjFmtStr, "", destinations[i])
+	}
+	if len(deniedDestinations) > 0 {
+		fmt.Printf(printProjFmtStr, "Denied Destinations:", deniedDestinations[0])
+		for i := 1; i < len(deniedDestinations); i++ {
+			fmt.Printf(printProjFmtStr, "", deniedDestinations[i])
+		}
 	}
 
 	// Print sources
@@ -1000,15 +1658,30 @@ func printProject(p *v1alpha1.AppProject, scopedRepositories []*v1alpha1.Reposit
 	}
 	fmt.Printf(printProjFmtStr, "Signature keys:", signatureKeysStr)
 
+	// Print sync windows
+	sw0 := "<none>"
+	if len(p.Spec.SyncWindows) > 0 {
+		sw0 = formatSyncWindow(p.Spec.SyncWindows[0])
+	}
+	fmt.Printf(printProjFmtStr, "Sync Windows:", sw0)
+	for i := 1; i < len(p.Spec.SyncWindows); i++ {
+		fmt.Printf(printProjFmtStr, "", formatSyncWindow(p.Spec.SyncWindows[i]))
+	}
+
 	fmt.Printf(printProjFmtStr, "Orphaned Resources:", formatOrphanedResources(p))
 }
 
 // NewProjectGetCommand returns a new instance of an `argocd proj get` command
 func NewProjectGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
-	var output string
+	var (
+		output        string
+		detailed      bool
+		showInherited bool
+	)
 	command := &cobra.Command{
-		Use:   "get PROJECT",
-		Short: "Get project details",
+		Use:               "get PROJECT",
+		ValidArgsFunction: completeProjectNames(clientOpts, 1),
+		Short:             "Get project details",
 		Example: templates.Examples(`
 			# Get details from project PROJECT
 			argocd proj get PROJECT
@@ -1016,6 +1689,11 @@ func NewProjectGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 			# Get details from project PROJECT in yaml format
 			argocd proj get PROJECT -o yaml
 
+			# Get details from project PROJECT including a summary of what it can deploy and where
+			argocd proj get PROJECT --detailed
+
+			# Get details from project PROJECT including the restrictions inherited from global projects
+			argocd proj get PROJECT --show-inherited
 		`),
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
@@ -1029,19 +1707,533 @@ func NewProjectGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 
 			switch output {
 			case "yaml", "json":
-				err := PrintResource(detailedProject.Project, output)
+				var resource any = detailedProject.Project
+				if detailed || showInherited {
+					details := &projectDetails{AppProject: detailedProject.Project}
+					if detailed {
+						details.Effective = getProjectEffectiveAccess(detailedProject.Project)
+					}
+					if showInherited {
+						details.GlobalProjects = getProjectNames(detailedProject.GlobalProjects)
+						details.Origins = getProjectFieldOrigins(detailedProject.Project, detailedProject.GlobalProjects)
+					}
+					resource = details
+				}
+				err := PrintResource(resource, output)
 				errors.CheckError(err)
 			case "wide", "":
 				printProject(detailedProject.Project, detailedProject.Repositories, detailedProject.Clusters)
+				if detailed {
+					fmt.Println()
+					printProjectEffectiveAccess(os.Stdout, getProjectEffectiveAccess(detailedProject.Project))
+				}
+				if showInherited {
+					fmt.Println()
+					printProjectFieldOrigins(os.Stdout, getProjectNames(detailedProject.GlobalProjects), getProjectFieldOrigins(detailedProject.Project, detailedProject.GlobalProjects))
+				}
 			default:
 				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
 			}
 		},
 	}
 	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	command.Flags().BoolVarP(&detailed, "detailed", "d", false, "Show a summary of the sources, destinations and resources the project effectively permits")
+	command.Flags().BoolVar(&showInherited, "show-inherited", false, "Show the global projects the project inherits from and the origin of each restriction")
 	return command
 }
 
+// projectDetails is the output of `argocd proj get --detailed` or `--show-inherited` in json or yaml format
+type projectDetails struct {
+	*v1alpha1.AppProject
+	Effective      *projectEffectiveAccess `json:"effective,omitempty"`
+	GlobalProjects []string                `json:"globalProjects,omitempty"`
+	Origins        []projectFieldOrigin    `json:"origins,omitempty"`
+}
+
+// projectFieldOrigin is an entry of a list in the project spec merged with its global projects,
+// along with the project which it originates from
+type projectFieldOrigin struct {
+	Field     string `json:"field"`
+	Value     string `json:"value"`
+	Origin    string `json:"origin"`
+	Inherited bool   `json:"inherited"`
+}
+
+// projectEffectiveAccess summarizes what a project permits to deploy and where
+type projectEffectiveAccess struct {
+	SourceRepos         []string               `json:"sourceRepos"`
+	DeniedSourceRepos   []string               `json:"deniedSourceRepos,omitempty"`
+	SourceNamespaces    []string               `json:"sourceNamespaces,omitempty"`
+	Destinations        []effectiveDestination `json:"destinations"`
+	ClusterResources    effectiveResources     `json:"clusterResources"`
+	NamespacedResources effectiveResources     `json:"namespacedResources"`
+}
+
+// effectiveDestination summarizes the resources which may be deployed to a project destination
+type effectiveDestination struct {
+	Server                  string   `json:"server,omitempty"`
+	Name                    string   `json:"name,omitempty"`
+	Namespace               string   `json:"namespace"`
+	Denied                  bool     `json:"denied"`
+	ClusterResourcesAllowed bool     `json:"clusterResourcesAllowed"`
+	BlockedNamespacedKinds  []string `json:"blockedNamespacedKinds,omitempty"`
+}
+
+// effectiveResources lists the allowed and denied resources of a project, and the allowed entries
+// which are ineffective since a deny entry covers them
+type effectiveResources struct {
+	Allowed  []string           `json:"allowed"`
+	Denied   []string           `json:"denied,omitempty"`
+	Shadowed []shadowedResource `json:"shadowed,omitempty"`
+}
+
+type shadowedResource struct {
+	Resource   string `json:"resource"`
+	ShadowedBy string `json:"shadowedBy"`
+}
+
+func formatGroupKind(gk metav1.GroupKind) string {
+	return fmt.Sprintf("%s/%s", gk.Group, gk.Kind)
+}
+
+// getEffectiveResources evaluates an allow and deny list. If the allow list is nil then all
+// resources are allowed, which is the default for namespaced resources.
+func getEffectiveResources(allowList, denyList []metav1.GroupKind) effectiveResources {
+	res := effectiveResources{Allowed: []string{}}
+	if allowList == nil {
+		res.Allowed = append(res.Allowed, "*/*")
+	}
+	for _, deny := range denyList {
+		res.Denied = append(res.Denied, formatGroupKind(deny))
+	}
+	for _, allow := range allowList {
+		shadowedBy := ""
+		for _, deny := range denyList {
+			// the allow entry is only ineffective if the deny entry matches everything it matches
+			kindMatched, _ := filepath.Match(deny.Kind, allow.Kind)
+			groupMatched, _ := filepath.Match(deny.Group, allow.Group)
+			if kindMatched && groupMatched {
+				shadowedBy = formatGroupKind(deny)
+				break
+			}
+		}
+		if shadowedBy != "" {
+			res.Shadowed = append(res.Shadowed, shadowedResource{Resource: formatGroupKind(allow), ShadowedBy: shadowedBy})
+		} else {
+			res.Allowed = append(res.Allowed, formatGroupKind(allow))
+		}
+	}
+	return res
+}
+
+// getProjectEffectiveAccess evaluates the project spec into a summary of what the project
+// permits to deploy and where. It only depends on the project spec.
+func getProjectEffectiveAccess(p *v1alpha1.AppProject) *projectEffectiveAccess {
+	access := &projectEffectiveAccess{
+		SourceRepos:         []string{},
+		SourceNamespaces:    p.Spec.SourceNamespaces,
+		Destinations:        []effectiveDestination{},
+		ClusterResources:    getEffectiveResources(p.Spec.ClusterResourceWhitelist, p.Spec.ClusterResourceBlacklist),
+		NamespacedResources: getEffectiveResources(p.Spec.NamespaceResourceWhitelist, p.Spec.NamespaceResourceBlacklist),
+	}
+	// cluster scoped resources are denied unless explicitly allowed
+	if p.Spec.ClusterResourceWhitelist == nil {
+		access.ClusterResources.Allowed = []string{}
+	}
+	for _, repo := range p.Spec.SourceRepos {
+		if strings.HasPrefix(repo, "!") {
+			access.DeniedSourceRepos = append(access.DeniedSourceRepos, strings.TrimPrefix(repo, "!"))
+		} else {
+			access.SourceRepos = append(access.SourceRepos, repo)
+		}
+	}
+	for _, dest := range p.Spec.Destinations {
+		denied := isDenyDestination(dest)
+		effective := effectiveDestination{Server: dest.Server, Name: dest.Name, Namespace: dest.Namespace, Denied: denied}
+		if !denied {
+			effective.ClusterResourcesAllowed = len(access.ClusterResources.Allowed) > 0
+			effective.BlockedNamespacedKinds = access.NamespacedResources.Denied
+		}
+		access.Destinations = append(access.Destinations, effective)
+	}
+	return access
+}
+
+func printProjectEffectiveAccess(out io.Writer, access *projectEffectiveAccess) {
+	fmt.Fprintln(out, "Effective Access:")
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "DESTINATION\tNAMESPACE\tACCESS\tCLUSTER RESOURCES\tBLOCKED NAMESPACED KINDS\n")
+	for _, dest := range access.Destinations {
+		server := dest.Server
+		if server == "" {
+			server = dest.Name
+		}
+		if dest.Denied {
+			fmt.Fprintf(w, "%s\t%s\tdenied\t-\t-\n", server, dest.Namespace)
+			continue
+		}
+		clusterResources := "denied"
+		if dest.ClusterResourcesAllowed {
+			clusterResources = "allowed"
+		}
+		fmt.Fprintf(w, "%s\t%s\tallowed\t%s\t%s\n", server, dest.Namespace, clusterResources, formatListOutput(dest.BlockedNamespacedKinds))
+	}
+	_ = w.Flush()
+
+	if len(access.Destinations) == 0 {
+		fmt.Fprintln(out, "No destinations are permitted, applications of this project cannot be deployed")
+	}
+	if len(access.SourceRepos) == 0 {
+		fmt.Fprintln(out, "No source repositories are permitted, applications of this project cannot be deployed")
+	}
+	for _, shadowed := range access.ClusterResources.Shadowed {
+		fmt.Fprintf(out, "Ineffective allowed cluster resource %s: denied by %s\n", shadowed.Resource, shadowed.ShadowedBy)
+	}
+	for _, shadowed := range access.NamespacedResources.Shadowed {
+		fmt.Fprintf(out, "Ineffective allowed namespaced resource %s: denied by %s\n", shadowed.Resource, shadowed.ShadowedBy)
+	}
+}
+
+func getProjectNames(projects []*v1alpha1.AppProject) []string {
+	names := make([]string, 0, len(projects))
+	for _, p := range projects {
+		names = append(names, p.Name)
+	}
+	return names
+}
+
+// getProjectFieldOrigins returns the entries of the lists of a project which is merged with its global
+// projects, see argo.GetAppVirtualProject. The entries of the global projects are appended to the
+// entries of the project itself in the order of the global projects.
+func getProjectFieldOrigins(p *v1alpha1.AppProject, globalProjects []*v1alpha1.AppProject) []projectFieldOrigin {
+	origins := []projectFieldOrigin{}
+	addField := func(field string, values func(*v1alpha1.AppProject) []string) {
+		merged := values(p)
+		var inherited []projectFieldOrigin
+		for _, gp := range globalProjects {
+			for _, value := range values(gp) {
+				inherited = append(inherited, projectFieldOrigin{Field: field, Value: value, Origin: gp.Name, Inherited: true})
+			}
+		}
+		own := max(len(merged)-len(inherited), 0)
+		for i, value := range merged {
+			if i < own || i-own >= len(inherited) {
+				origins = append(origins, projectFieldOrigin{Field: field, Value: value, Origin: p.Name})
+			} else {
+				origins = append(origins, inherited[i-own])
+			}
+		}
+	}
+	groupKinds := func(gks []metav1.GroupKind) []string {
+		res := make([]string, 0, len(gks))
+		for _, gk := range gks {
+			res = append(res, formatGroupKind(gk))
+		}
+		return res
+	}
+
+	addField("Destinations", func(p *v1alpha1.AppProject) []string {
+		res := make([]string, 0, len(p.Spec.Destinations))
+		for _, dest := range p.Spec.Destinations {
+			server := dest.Server
+			if server == "" {
+				server = dest.Name
+			}
+			res = append(res, fmt.Sprintf("%s,%s", server, dest.Namespace))
+		}
+		return res
+	})
+	addField("Repositories", func(p *v1alpha1.AppProject) []string {
+		return p.Spec.SourceRepos
+	})
+	addField("Allowed Cluster Resources", func(p *v1alpha1.AppProject) []string {
+		return groupKinds(p.Spec.ClusterResourceWhitelist)
+	})
+	addField("Denied Cluster Resources", func(p *v1alpha1.AppProject) []string {
+		return groupKinds(p.Spec.ClusterResourceBlacklist)
+	})
+	addField("Allowed Namespaced Resources", func(p *v1alpha1.AppProject) []string {
+		return groupKinds(p.Spec.NamespaceResourceWhitelist)
+	})
+	addField("Denied Namespaced Resources", func(p *v1alpha1.AppProject) []string {
+		return groupKinds(p.Spec.NamespaceResourceBlacklist)
+	})
+	addField("Sync Windows", func(p *v1alpha1.AppProject) []string {
+		res := make([]string, 0, len(p.Spec.SyncWindows))
+		for _, w := range p.Spec.SyncWindows {
+			res = append(res, formatSyncWindow(w))
+		}
+		return res
+	})
+	return origins
+}
+
+func printProjectFieldOrigins(out io.Writer, globalProjects []string, origins []projectFieldOrigin) {
+	globalProjectsStr := "<none>"
+	if len(globalProjects) > 0 {
+		globalProjectsStr = strings.Join(globalProjects, ", ")
+	}
+	fmt.Fprintf(out, "%-29s%s\n", "Global Projects:", globalProjectsStr)
+	if len(origins) == 0 {
+		return
+	}
+	fmt.Fprintln(out)
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "FIELD\tVALUE\tORIGIN\n")
+	for _, origin := range origins {
+		originStr := origin.Origin
+		if origin.Inherited {
+			originStr = fmt.Sprintf("%s (global)", origin.Origin)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", origin.Field, origin.Value, originStr)
+	}
+	_ = w.Flush()
+}
+
+// NewProjectUsageCommand returns a new instance of an `argocd proj usage` command
+func NewProjectUsageCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var output string
+	command := &cobra.Command{
+		Use:               "usage PROJECT",
+		ValidArgsFunction: completeProjectNames(clientOpts, 1),
+		Short:             "Report how a project is used by its applications",
+		Example: templates.Examples(`
+			# Show the applications per destination, the referenced repositories, the unused destinations
+			# and the cluster resources outside the allow-list of project PROJECT
+			argocd proj usage PROJECT
+
+			# Get the usage of project PROJECT in json format
+			argocd proj usage PROJECT -o json
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
+			defer utilio.Close(conn)
+			usage, err := projIf.GetUsage(ctx, &projectpkg.ProjectQuery{Name: args[0]})
+			errors.CheckError(err)
+
+			switch output {
+			case "yaml", "json":
+				err := PrintResource(usage, output)
+				errors.CheckError(err)
+			case "wide", "":
+				printProjectUsage(os.Stdout, usage)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	return command
+}
+
+func printProjectUsage(out io.Writer, usage *projectpkg.ProjectUsageResponse) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "SERVER\tNAME\tNAMESPACE\tAPPLICATIONS\n")
+	for _, dest := range usage.Destinations {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\n", dest.Server, dest.Name, dest.Namespace, dest.Applications)
+	}
+	_ = w.Flush()
+	if len(usage.Destinations) == 0 {
+		fmt.Fprintln(out, "No applications belong to this project")
+	}
+
+	fmt.Fprintf(out, "\nRepositories:\n")
+	for _, repo := range usage.Repositories {
+		fmt.Fprintf(out, "  %s\n", repo)
+	}
+
+	if len(usage.UnusedDestinations) > 0 {
+		fmt.Fprintf(out, "\nUnused Destinations:\n")
+		for _, dest := range usage.UnusedDestinations {
+			fmt.Fprintf(out, "  %s\n", formatDestination(*dest))
+		}
+	}
+
+	if len(usage.DeniedClusterResources) > 0 {
+		fmt.Fprintf(out, "\nCluster Resources Outside The Allow-List:\n")
+		w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		fmt.Fprintf(w, "APPLICATION\tGROUP\tKIND\tNAME\n")
+		for _, res := range usage.DeniedClusterResources {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", res.Application, res.Group, res.Kind, res.Name)
+		}
+		_ = w.Flush()
+	}
+}
+
+// NewProjectValidateCommand returns a new instance of an `argocd proj validate` command
+func NewProjectValidateCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		output string
+		file   string
+	)
+	command := &cobra.Command{
+		Use:               "validate PROJECT",
+		ValidArgsFunction: completeProjectNames(clientOpts, 1),
+		Short:             "Validate the restrictions of a project against its applications",
+		Long: "Validate the source repositories, destinations and resource allow and deny lists of a project against the project's " +
+			"applications and their live resources, and report which applications would break if the restrictions were enforced " +
+			"today. Use --file to validate a changed project spec before applying it. The command exits with a non-zero status if " +
+			"any application violates the restrictions.",
+		Example: templates.Examples(`
+			# Validate the restrictions of project PROJECT against its applications
+			argocd proj validate PROJECT
+
+			# Validate a tightened spec of project PROJECT before applying it
+			argocd proj validate PROJECT --file project.yaml
+
+			# Get the violations in json format
+			argocd proj validate PROJECT -o json
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			projName := args[0]
+			acdClient := headless.NewClientOrDie(clientOpts, c)
+			conn, projIf := acdClient.NewProjectClientOrDie()
+			defer utilio.Close(conn)
+			proj, err := projIf.Get(ctx, &projectpkg.ProjectQuery{Name: projName})
+			errors.CheckError(err)
+			if file != "" {
+				candidate, err := readProjectFromFile(file)
+				errors.CheckError(err)
+				proj.Spec = candidate.Spec
+			}
+
+			appConn, appIf := acdClient.NewApplicationClientOrDie()
+			defer utilio.Close(appConn)
+			apps, err := appIf.List(ctx, &applicationpkg.ApplicationQuery{Projects: []string{projName}})
+			errors.CheckError(err)
+
+			clusterConn, clusterIf := acdClient.NewClusterClientOrDie()
+			defer utilio.Close(clusterConn)
+			clusters, err := clusterIf.List(ctx, &clusterpkg.ClusterQuery{})
+			errors.CheckError(err)
+
+			violations, unvalidated, err := validateProjectApplications(proj, apps.Items, clusters.Items)
+			errors.CheckError(err)
+			for _, appName := range unvalidated {
+				log.Warnf("The destination cluster of application '%s' is not visible to the current user, so its destination and resources were not validated", appName)
+			}
+			switch output {
+			case "yaml", "json":
+				err := PrintResourceList(violations, output, false)
+				errors.CheckError(err)
+			case "wide", "":
+				printProjectViolations(os.Stdout, proj.Name, len(apps.Items), violations)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+			if len(violations) > 0 {
+				os.Exit(1)
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	command.Flags().StringVarP(&file, "file", "f", "", "Validate the spec of the project in the given file instead of the current spec")
+	return command
+}
+
+// projectViolation is a part of an application which is not permitted by the restrictions of its project
+type projectViolation struct {
+	Application string `json:"application"`
+	// Type is one of Source, Destination or Resource
+	Type   string `json:"type"`
+	Reason string `json:"reason"`
+}
+
+// validateProjectApplications returns the sources, destinations and live resources of the applications which are
+// not permitted by the project. Destinations are resolved against the given clusters, which only include the clusters
+// the user is permitted to get, so the names of the applications whose destination cluster is not among them are
+// returned instead of reporting their destinations and resources.
+func validateProjectApplications(proj *v1alpha1.AppProject, apps []v1alpha1.Application, clusters []v1alpha1.Cluster) ([]projectViolation, []string, error) {
+	projectClusters := func(project string) ([]*v1alpha1.Cluster, error) {
+		var res []*v1alpha1.Cluster
+		for i := range clusters {
+			if clusters[i].Project == project {
+				res = append(res, &clusters[i])
+			}
+		}
+		return res, nil
+	}
+	violations := []projectViolation{}
+	var unvalidated []string
+	for _, app := range apps {
+		appName := app.QualifiedName()
+		for _, src := range app.Spec.GetSources() {
+			if !proj.IsSourcePermitted(src) {
+				violations = append(violations, projectViolation{
+					Application: appName,
+					Type:        "Source",
+					Reason:      fmt.Sprintf("repository '%s' is not permitted", src.RepoURL),
+				})
+			}
+		}
+
+		dest := app.Spec.Destination
+		var destCluster *v1alpha1.Cluster
+		for i := range clusters {
+			if (dest.Server != "" && clusters[i].Server == dest.Server) || (dest.Server == "" && clusters[i].Name == dest.Name) {
+				destCluster = &clusters[i]
+				break
+			}
+		}
+		if destCluster == nil {
+			unvalidated = append(unvalidated, appName)
+			continue
+		}
+		permitted, err := proj.IsDestinationPermitted(destCluster, dest.Namespace, projectClusters)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !permitted {
+			violations = append(violations, projectViolation{
+				Application: appName,
+				Type:        "Destination",
+				Reason:      fmt.Sprintf("destination '%s' is not permitted", formatDestination(dest)),
+			})
+		}
+
+		for _, res := range app.Status.Resources {
+			permitted, err := proj.IsResourcePermitted(schema.GroupKind{Group: res.Group, Kind: res.Kind}, res.Namespace, destCluster, projectClusters)
+			if err != nil {
+				return nil, nil, err
+			}
+			if permitted {
+				continue
+			}
+			reason := fmt.Sprintf("cluster resource %s/%s is not permitted", res.Kind, res.Name)
+			if res.Namespace != "" {
+				reason = fmt.Sprintf("resource %s/%s in namespace '%s' is not permitted", res.Kind, res.Name, res.Namespace)
+			}
+			violations = append(violations, projectViolation{Application: appName, Type: "Resource", Reason: reason})
+		}
+	}
+	return violations, unvalidated, nil
+}
+
+func printProjectViolations(out io.Writer, projName string, appCount int, violations []projectViolation) {
+	if len(violations) == 0 {
+		fmt.Fprintf(out, "All %d applications comply with the restrictions of project '%s'\n", appCount, projName)
+		return
+	}
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "APPLICATION\tTYPE\tREASON\n")
+	for _, v := range violations {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", v.Application, v.Type, v.Reason)
+	}
+	_ = w.Flush()
+}
+
 func getProject(ctx context.Context, c *cobra.Command, clientOpts *argocdclient.ClientOptions, projName string) *projectpkg.DetailedProjectsResponse {
 	conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
 	defer utilio.Close(conn)
@@ -1052,8 +2244,9 @@ func getProject(ctx context.Context, c *cobra.Command, clientOpts *argocdclient.
 
 func NewProjectEditCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command := &cobra.Command{
-		Use:   "edit PROJECT",
-		Short: "Edit project",
+		Use:               "edit PROJECT",
+		ValidArgsFunction: completeProjectNames(clientOpts, 1),
+		Short:             "Edit project",
 		Example: templates.Examples(`
 			# Edit the information on project with name PROJECT
 			argocd proj edit PROJECT
@@ -1121,8 +2314,9 @@ func NewProjectAddDestinationServiceAccountCommand(clientOpts *argocdclient.Clie
 	}
 
 	command := &cobra.Command{
-		Use:   "add-destination-service-account PROJECT SERVER NAMESPACE SERVICE_ACCOUNT",
-		Short: "Add project destination's default service account",
+		Use:               "add-destination-service-account PROJECT SERVER NAMESPACE SERVICE_ACCOUNT",
+		ValidArgsFunction: completeProjectNames(clientOpts, 1),
+		Short:             "Add project destination's default service account",
 		Example: templates.Examples(`
 			# Add project destination service account (SERVICE_ACCOUNT) for a server URL (SERVER) in the specified namespace (NAMESPACE) on the project with name PROJECT
 			argocd proj add-destination-service-account PROJECT SERVER NAMESPACE SERVICE_ACCOUNT
@@ -1176,8 +2370,9 @@ func NewProjectAddDestinationServiceAccountCommand(clientOpts *argocdclient.Clie
 // NewProjectRemoveDestinationCommand returns a new instance of an `argocd proj remove-destination-service-account` command
 func NewProjectRemoveDestinationServiceAccountCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command := &cobra.Command{
-		Use:   "remove-destination-service-account PROJECT SERVER NAMESPACE SERVICE_ACCOUNT",
-		Short: "Remove default destination service account from the project",
+		Use:               "remove-destination-service-account PROJECT SERVER NAMESPACE SERVICE_ACCOUNT",
+		ValidArgsFunction: completeProjectNames(clientOpts, 1),
+		Short:             "Remove default destination service account from the project",
 		Example: templates.Examples(`
 			# Remove the destination service account (SERVICE_ACCOUNT) from the specified destination (SERVER and NAMESPACE combination) on the project with name PROJECT
 			argocd proj remove-destination-service-account PROJECT SERVER NAMESPACE SERVICE_ACCOUNT
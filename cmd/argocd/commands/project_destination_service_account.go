@@ -0,0 +1,175 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
+	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	projectpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/project"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/errors"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/templates"
+)
+
+// NewProjectDestinationServiceAccountCommand returns a new instance of the `argocd proj destination-service-account` command
+func NewProjectDestinationServiceAccountCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "destination-service-account",
+		Short: "Manage the service accounts impersonated to sync to a project's destinations",
+		Example: templates.Examples(`
+			# List the default service accounts of the destinations of project PROJECT
+			argocd proj destination-service-account list PROJECT
+
+			# Sync to namespace guestbook of the in-cluster destination as service account guestbook-deployer
+			argocd proj destination-service-account add PROJECT https://kubernetes.default.svc guestbook guestbook-deployer
+
+			# Remove the default service account of a destination
+			argocd proj destination-service-account remove PROJECT https://kubernetes.default.svc guestbook
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+			os.Exit(1)
+		},
+	}
+	command.AddCommand(NewProjectDestinationServiceAccountAddCommand(clientOpts))
+	command.AddCommand(NewProjectDestinationServiceAccountListCommand(clientOpts))
+	command.AddCommand(NewProjectDestinationServiceAccountRemoveCommand(clientOpts))
+	return command
+}
+
+// NewProjectDestinationServiceAccountAddCommand returns a new instance of an `argocd proj destination-service-account add` command
+func NewProjectDestinationServiceAccountAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var serviceAccountNamespace string
+	command := &cobra.Command{
+		Use:               "add PROJECT SERVER NAMESPACE SERVICE_ACCOUNT",
+		ValidArgsFunction: completeProjectNames(clientOpts, 1),
+		Short:             "Add the default service account of a project destination",
+		Long:              "Add the service account which is impersonated to sync applications to the given destination. SERVER and NAMESPACE may be glob patterns, SERVICE_ACCOUNT must not contain wildcards.",
+		Example: templates.Examples(`
+			# Sync to namespace guestbook of the in-cluster destination as service account guestbook-deployer
+			argocd proj destination-service-account add PROJECT https://kubernetes.default.svc guestbook guestbook-deployer
+
+			# Use a service account from a different namespace for all namespaces starting with team-a-
+			argocd proj destination-service-account add PROJECT https://kubernetes.default.svc 'team-a-*' deployer --service-account-namespace argocd-deployers
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 4 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			projName, server, namespace := args[0], args[1], args[2]
+			serviceAccount := args[3]
+			if serviceAccountNamespace != "" {
+				serviceAccount = fmt.Sprintf("%s:%s", serviceAccountNamespace, serviceAccount)
+			}
+			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
+			defer utilio.Close(conn)
+
+			_, err := projIf.AddDestinationServiceAccount(ctx, &projectpkg.ProjectDestinationServiceAccountRequest{
+				Project:               projName,
+				Server:                server,
+				Namespace:             namespace,
+				DefaultServiceAccount: serviceAccount,
+			})
+			errors.CheckError(err)
+			fmt.Printf("Service account '%s' added for destination '%s/%s'\n", serviceAccount, server, namespace)
+		},
+	}
+	command.Flags().StringVar(&serviceAccountNamespace, "service-account-namespace", "", "Namespace of the service account, if it is not in the destination namespace")
+	return command
+}
+
+// NewProjectDestinationServiceAccountListCommand returns a new instance of an `argocd proj destination-service-account list` command
+func NewProjectDestinationServiceAccountListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var output string
+	command := &cobra.Command{
+		Use:               "list PROJECT",
+		ValidArgsFunction: completeProjectNames(clientOpts, 1),
+		Short:             "List the default service accounts of the destinations of a project",
+		Example: templates.Examples(`
+			# List the default service accounts of the destinations of project PROJECT
+			argocd proj destination-service-account list PROJECT
+
+			# List them in yaml format
+			argocd proj destination-service-account list PROJECT -o yaml
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
+			defer utilio.Close(conn)
+
+			res, err := projIf.ListDestinationServiceAccounts(ctx, &projectpkg.ProjectQuery{Name: args[0]})
+			errors.CheckError(err)
+			switch output {
+			case "yaml", "json":
+				err := PrintResourceList(res.Items, output, false)
+				errors.CheckError(err)
+			case "wide", "":
+				printDestinationServiceAccounts(os.Stdout, res.Items)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	return command
+}
+
+// NewProjectDestinationServiceAccountRemoveCommand returns a new instance of an `argocd proj destination-service-account remove` command
+func NewProjectDestinationServiceAccountRemoveCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:               "remove PROJECT SERVER NAMESPACE [SERVICE_ACCOUNT]",
+		ValidArgsFunction: completeProjectNames(clientOpts, 1),
+		Short:             "Remove the default service account of a project destination",
+		Long:              "Remove the default service account of the given destination. If SERVICE_ACCOUNT is given, the service account is only removed if it matches.",
+		Example: templates.Examples(`
+			# Remove the default service account of namespace guestbook of the in-cluster destination
+			argocd proj destination-service-account remove PROJECT https://kubernetes.default.svc guestbook
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 3 && len(args) != 4 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			req := &projectpkg.ProjectDestinationServiceAccountRequest{Project: args[0], Server: args[1], Namespace: args[2]}
+			if len(args) == 4 {
+				req.DefaultServiceAccount = args[3]
+			}
+			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
+			defer utilio.Close(conn)
+
+			_, err := projIf.DeleteDestinationServiceAccount(ctx, req)
+			errors.CheckError(err)
+			fmt.Printf("Service account of destination '%s/%s' removed\n", req.Server, req.Namespace)
+		},
+	}
+	return command
+}
+
+func printDestinationServiceAccounts(out io.Writer, items []*v1alpha1.ApplicationDestinationServiceAccount) {
+	if len(items) == 0 {
+		fmt.Fprintln(out, "No destination service accounts configured")
+		return
+	}
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "SERVER\tNAMESPACE\tSERVICE ACCOUNT\n")
+	for _, item := range items {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", item.Server, item.Namespace, item.DefaultServiceAccount)
+	}
+	_ = w.Flush()
+}
@@ -0,0 +1,26 @@
+package commands
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestPrintDestinationServiceAccounts(t *testing.T) {
+	out := &bytes.Buffer{}
+	printDestinationServiceAccounts(out, []*v1alpha1.ApplicationDestinationServiceAccount{
+		{Server: "https://kubernetes.default.svc", Namespace: "guestbook", DefaultServiceAccount: "deployer"},
+		{Server: "*", Namespace: "team-a-*", DefaultServiceAccount: "argocd:team-a"},
+	})
+	assert.Equal(t, `SERVER                          NAMESPACE  SERVICE ACCOUNT
+https://kubernetes.default.svc  guestbook  deployer
+*                               team-a-*   argocd:team-a
+`, out.String())
+
+	out.Reset()
+	printDestinationServiceAccounts(out, nil)
+	assert.Equal(t, "No destination service accounts configured\n", out.String())
+}
@@ -1,21 +1,33 @@
 package commands
 
 import (
+	"encoding/csv"
+	"encoding/json"
+	stderrors "errors"
 	"fmt"
+	"io"
 	"os"
+	"slices"
 	"strconv"
 	"text/tabwriter"
 	"time"
 
 	timeutil "github.com/argoproj/pkg/v2/time"
 	jwtgo "github.com/golang-jwt/jwt/v5"
+	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/utils"
+	"github.com/argoproj/argo-cd/v3/common"
 	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
 	projectpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/project"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applisters "github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/server/rbacpolicy"
+	"github.com/argoproj/argo-cd/v3/util/assets"
 	"github.com/argoproj/argo-cd/v3/util/errors"
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
 	"github.com/argoproj/argo-cd/v3/util/jwt"
@@ -44,10 +56,12 @@ func NewProjectRoleCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 	roleCommand.AddCommand(NewProjectRoleCreateTokenCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectRoleListTokensCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectRoleDeleteTokenCommand(clientOpts))
+	roleCommand.AddCommand(NewProjectRoleRotateTokenCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectRoleAddPolicyCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectRoleRemovePolicyCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectRoleAddGroupCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectRoleRemoveGroupCommand(clientOpts))
+	roleCommand.AddCommand(NewProjectRoleCanICommand(clientOpts))
 	return roleCommand
 }
 
@@ -55,8 +69,9 @@ func NewProjectRoleCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 func NewProjectRoleAddPolicyCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var opts policyOpts
 	command := &cobra.Command{
-		Use:   "add-policy PROJECT ROLE-NAME",
-		Short: "Add a policy to a project role",
+		Use:               "add-policy PROJECT ROLE-NAME",
+		ValidArgsFunction: completeProjectNames(clientOpts, 1),
+		Short:             "Add a policy to a project role",
 		Example: `# Before adding new policy
 $ argocd proj role get test-project test-role
 Role Name:     test-role
@@ -129,8 +144,9 @@ ID          ISSUED-AT                                EXPIRES-AT
 func NewProjectRoleRemovePolicyCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var opts policyOpts
 	command := &cobra.Command{
-		Use:   "remove-policy PROJECT ROLE-NAME",
-		Short: "Remove a policy from a role within a project",
+		Use:               "remove-policy PROJECT ROLE-NAME",
+		ValidArgsFunction: completeProjectNames(clientOpts, 1),
+		Short:             "Remove a policy from a role within a project",
 		Example: `List the policy of the test-role before removing a policy
 $ argocd proj role get test-project test-role
 Role Name:     test-role
@@ -221,8 +237,9 @@ ID          ISSUED-AT                                EXPIRES-AT
 func NewProjectRoleCreateCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var description string
 	command := &cobra.Command{
-		Use:   "create PROJECT ROLE-NAME",
-		Short: "Create a project role",
+		Use:               "create PROJECT ROLE-NAME",
+		ValidArgsFunction: completeProjectNames(clientOpts, 1),
+		Short:             "Create a project role",
 		Example: templates.Examples(`
   # Create a project role in the "my-project" project with the name "my-role".
   argocd proj role create my-project my-role --description "My project role description"
@@ -262,9 +279,10 @@ func NewProjectRoleCreateCommand(clientOpts *argocdclient.ClientOptions) *cobra.
 // NewProjectRoleDeleteCommand returns a new instance of an `argocd proj role delete` command
 func NewProjectRoleDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command := &cobra.Command{
-		Use:     "delete PROJECT ROLE-NAME",
-		Short:   "Delete a project role",
-		Example: `$ argocd proj role delete test-project test-role`,
+		Use:               "delete PROJECT ROLE-NAME",
+		ValidArgsFunction: completeProjectNames(clientOpts, 1),
+		Short:             "Delete a project role",
+		Example:           `$ argocd proj role delete test-project test-role`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
@@ -311,22 +329,72 @@ func tokenTimeToString(t int64) string {
 	return tokenTimeToString
 }
 
+// projectToken is the structured output of the `argocd proj role create-token` and `rotate-token` commands
+type projectToken struct {
+	Token     string `json:"token"`
+	ID        string `json:"id"`
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp"`
+	subject   string
+}
+
+// parseProjectToken extracts the metadata of a project token from its claims
+func parseProjectToken(tokenString string) (*projectToken, error) {
+	token, err := jwtgo.Parse(tokenString, nil)
+	if token == nil {
+		return nil, fmt.Errorf("received malformed token %w", err)
+	}
+	claims := token.Claims.(jwtgo.MapClaims)
+	issuedAt, _ := jwt.IssuedAt(claims)
+	return &projectToken{
+		Token:     tokenString,
+		ID:        jwt.StringField(claims, "jti"),
+		IssuedAt:  issuedAt,
+		ExpiresAt: int64(jwt.Float64Field(claims, "exp")),
+		subject:   jwt.GetUserIdentifier(claims),
+	}, nil
+}
+
+// printProjectToken prints a newly created project token in the given output format
+func printProjectToken(token *projectToken, action string, outputTokenOnly bool, output string) {
+	switch {
+	case outputTokenOnly:
+		fmt.Println(token.Token)
+	case output == "json" || output == "yaml":
+		err := PrintResource(token, output)
+		errors.CheckError(err)
+	case output == "":
+		fmt.Printf("%s token succeeded for %s.\n", action, token.subject)
+		fmt.Printf("  ID: %s\n  Issued At: %s\n  Expires At: %s\n",
+			token.ID, tokenTimeToString(token.IssuedAt), tokenTimeToString(token.ExpiresAt),
+		)
+		fmt.Println("  Token: " + token.Token)
+	default:
+		errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+	}
+}
+
 // NewProjectRoleCreateTokenCommand returns a new instance of an `argocd proj role create-token` command
 func NewProjectRoleCreateTokenCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
 		expiresIn       string
 		outputTokenOnly bool
 		tokenID         string
+		output          string
 	)
 	command := &cobra.Command{
-		Use:   "create-token PROJECT ROLE-NAME",
-		Short: "Create a project token",
+		Use:               "create-token PROJECT ROLE-NAME",
+		ValidArgsFunction: completeProjectNames(clientOpts, 1),
+		Short:             "Create a project token",
 		Example: `$ argocd proj role create-token test-project test-role
 Create token succeeded for proj:test-project:test-role.
   ID: f316c466-40bd-4cfd-8a8c-1392e92255d4
   Issued At: 2023-10-08T15:21:40+01:00
   Expires At: Never
   Token: xxx
+
+# Create a token expiring in 30 days and print it as JSON
+$ argocd proj role create-token test-project test-role --expires-in 30d -o json
 `,
 		Aliases: []string{"token-create"},
 		Run: func(c *cobra.Command, args []string) {
@@ -353,28 +421,9 @@ Create token succeeded for proj:test-project:test-role.
 			})
 			errors.CheckError(err)
 
-			token, err := jwtgo.Parse(tokenResponse.Token, nil)
-			if token == nil {
-				err = fmt.Errorf("received malformed token %w", err)
-				errors.CheckError(err)
-				return
-			}
-
-			claims := token.Claims.(jwtgo.MapClaims)
-
-			issuedAt, _ := jwt.IssuedAt(claims)
-			expiresAt := int64(jwt.Float64Field(claims, "exp"))
-			id := jwt.StringField(claims, "jti")
-			subject := jwt.GetUserIdentifier(claims)
-			if !outputTokenOnly {
-				fmt.Printf("Create token succeeded for %s.\n", subject)
-				fmt.Printf("  ID: %s\n  Issued At: %s\n  Expires At: %s\n",
-					id, tokenTimeToString(issuedAt), tokenTimeToString(expiresAt),
-				)
-				fmt.Println("  Token: " + tokenResponse.Token)
-			} else {
-				fmt.Println(tokenResponse.Token)
-			}
+			token, err := parseProjectToken(tokenResponse.Token)
+			errors.CheckError(err)
+			printProjectToken(token, "Create", outputTokenOnly, output)
 		},
 	}
 	command.Flags().StringVarP(&expiresIn, "expires-in", "e", "",
@@ -382,71 +431,335 @@ Create token succeeded for proj:test-project:test-role.
 	)
 	command.Flags().StringVarP(&tokenID, "id", "i", "", "Token unique identifier. (Default: Random UUID)")
 	command.Flags().BoolVarP(&outputTokenOnly, "token-only", "t", false, "Output token only - for use in scripts.")
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: json|yaml")
 
 	return command
 }
 
-func NewProjectRoleListTokensCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
-	var useUnixTime bool
+// findProjectRoleToken returns the token of a project role which has the given ID. For legacy tokens without ID,
+// the token may also be referenced by its issued-at timestamp.
+func findProjectRoleToken(proj *v1alpha1.AppProject, roleName string, tokenRef string) (*v1alpha1.JWTToken, error) {
+	issuedAt, err := strconv.ParseInt(tokenRef, 10, 64)
+	if err != nil {
+		issuedAt = -1
+	}
+	if token, _, err := proj.GetJWTToken(roleName, issuedAt, tokenRef); err == nil {
+		return token, nil
+	}
+	token, _, err := proj.GetJWTTokenFromSpec(roleName, issuedAt, tokenRef)
+	if err != nil {
+		return nil, fmt.Errorf("token '%s' does not exist for role '%s' in project '%s'", tokenRef, roleName, proj.Name)
+	}
+	return token, nil
+}
+
+// NewProjectRoleRotateTokenCommand returns a new instance of an `argocd proj role rotate-token` command
+func NewProjectRoleRotateTokenCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		expiresIn       string
+		overlap         string
+		outputTokenOnly bool
+		output          string
+	)
 	command := &cobra.Command{
-		Use:   "list-tokens PROJECT ROLE-NAME",
-		Short: "List tokens for a given role.",
-		Example: `$ argocd proj role list-tokens test-project test-role
-ID                                      ISSUED AT                    EXPIRES AT
-f316c466-40bd-4cfd-8a8c-1392e92255d4    2023-10-08T15:21:40+01:00    Never
-fa9d3517-c52d-434c-9bff-215b38508842    2023-10-08T11:08:18+01:00    Never
+		Use:               "rotate-token PROJECT ROLE-NAME [ID|ISSUED-AT]",
+		ValidArgsFunction: completeProjectNames(clientOpts, 1),
+		Short:             "Replace a project token with a newly created one",
+		Long: `Create a new token for a project role and revoke the given token. The token may be omitted if the role has a single unexpired token.
+Unless --expires-in is set, the new token is valid for as long as the replaced token was.
+With --overlap the replaced token stays valid for the given duration, so that the clients using it can switch to the new token without a hard cutover.`,
+		Example: `$ argocd proj role rotate-token test-project test-role f316c466-40bd-4cfd-8a8c-1392e92255d4
+Rotate token succeeded for proj:test-project:test-role.
+  ID: 2b1e2a8c-61a2-4b34-b1a4-7c6a2f0b8f0e
+  Issued At: 2023-11-08T15:21:40+01:00
+  Expires At: Never
+  Token: xxx
+
+# Rotate a legacy token without ID by its issued-at timestamp, and print the new token as JSON
+$ argocd proj role rotate-token test-project test-role 1696759698 -o json
+
+# Rotate the only token of a role, keeping the old token valid for another day
+$ argocd proj role rotate-token test-project test-role --overlap 24h
 `,
-		Aliases: []string{"list-token", "token-list"},
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
-			if len(args) != 2 {
+			if len(args) != 2 && len(args) != 3 {
 				c.HelpFunc()(c, args)
 				os.Exit(1)
 			}
-			projName := args[0]
-			roleName := args[1]
-
+			projName, roleName := args[0], args[1]
+			var overlapSeconds int64
+			if overlap != "" {
+				duration, err := timeutil.ParseDuration(overlap)
+				errors.CheckError(err)
+				overlapSeconds = int64(duration.Seconds())
+			}
 			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
 			defer utilio.Close(conn)
 
 			proj, err := projIf.Get(ctx, &projectpkg.ProjectQuery{Name: projName})
 			errors.CheckError(err)
-			role, _, err := proj.GetRoleByName(roleName)
+			var oldToken *v1alpha1.JWTToken
+			if len(args) == 3 {
+				oldToken, err = findProjectRoleToken(proj, roleName, args[2])
+			} else {
+				oldToken, err = findOnlyProjectRoleToken(proj, roleName, time.Now())
+			}
 			errors.CheckError(err)
 
-			if len(role.JWTTokens) == 0 {
-				fmt.Printf("No tokens for %s.%s\n", projName, roleName)
-				return
+			var expiresInSeconds int64
+			if expiresIn != "" {
+				duration, err := timeutil.ParseDuration(expiresIn)
+				errors.CheckError(err)
+				expiresInSeconds = int64(duration.Seconds())
+			} else if oldToken.ExpiresAt > 0 {
+				expiresInSeconds = oldToken.ExpiresAt - oldToken.IssuedAt
 			}
 
-			writer := tabwriter.NewWriter(os.Stdout, 0, 0, 4, ' ', 0)
-			_, err = fmt.Fprintf(writer, "ID\tISSUED AT\tEXPIRES AT\n")
+			tokenResponse, err := projIf.CreateToken(ctx, &projectpkg.ProjectTokenCreateRequest{
+				Project:   projName,
+				Role:      roleName,
+				ExpiresIn: expiresInSeconds,
+			})
 			errors.CheckError(err)
+			newToken, err := parseProjectToken(tokenResponse.Token)
+			errors.CheckError(err)
+
+			deleteReq := &projectpkg.ProjectTokenDeleteRequest{Project: projName, Role: roleName, Iat: oldToken.IssuedAt, Id: oldToken.ID, ExpiresIn: overlapSeconds}
+			if oldToken.ID != "" {
+				deleteReq.Iat = -1
+			}
+			_, err = projIf.DeleteToken(ctx, deleteReq)
+			errors.CheckError(err)
+
+			printProjectToken(newToken, "Rotate", outputTokenOnly, output)
+			if overlapSeconds > 0 && !outputTokenOnly && output == "" {
+				fmt.Printf("  Previous token %s is revoked at: %s\n", oldToken.ID, tokenTimeToString(time.Now().Unix()+overlapSeconds))
+			}
+		},
+	}
+	command.Flags().StringVarP(&expiresIn, "expires-in", "e", "",
+		"Duration before the new token will expire, e.g. \"12h\", \"7d\". (Default: Same lifetime as the rotated token)",
+	)
+	command.Flags().StringVar(&overlap, "overlap", "",
+		"Duration for which the rotated token stays valid, e.g. \"24h\". (Default: The rotated token is deleted immediately)",
+	)
+	command.Flags().BoolVarP(&outputTokenOnly, "token-only", "t", false, "Output token only - for use in scripts.")
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: json|yaml")
+	return command
+}
 
-			tokenRowFormat := "%s\t%v\t%v\n"
+// findOnlyProjectRoleToken returns the token of a project role if the role has exactly one unexpired token
+func findOnlyProjectRoleToken(proj *v1alpha1.AppProject, roleName string, now time.Time) (*v1alpha1.JWTToken, error) {
+	role, _, err := proj.GetRoleByName(roleName)
+	if err != nil {
+		return nil, err
+	}
+	tokens := proj.Status.JWTTokensByRole[roleName].Items
+	if len(tokens) == 0 {
+		tokens = role.JWTTokens
+	}
+	var found []v1alpha1.JWTToken
+	for _, token := range tokens {
+		if !tokenExpired(token.ExpiresAt, now) {
+			found = append(found, token)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("role '%s' in project '%s' has no token to rotate", roleName, proj.Name)
+	case 1:
+		return &found[0], nil
+	default:
+		return nil, fmt.Errorf("role '%s' in project '%s' has %d tokens, specify the ID of the token to rotate", roleName, proj.Name, len(found))
+	}
+}
+
+// tokenExpired returns whether a token with the given expiry has expired
+func tokenExpired(expiresAt int64, now time.Time) bool {
+	return expiresAt > 0 && time.Unix(expiresAt, 0).Before(now)
+}
+
+// projectRoleTokenRow is a row of the `argocd proj role list-tokens` output
+type projectRoleTokenRow struct {
+	Project   string `json:"project"`
+	Role      string `json:"role"`
+	ID        string `json:"id"`
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp"`
+	Expired   bool   `json:"expired"`
+}
+
+// collectProjectRoleTokens flattens the tokens of the roles of the given projects. If roleName is
+// set, only the tokens of that role are returned. If expiringWithin is set, only the tokens which
+// expire before now plus expiringWithin are returned, including the expired ones.
+func collectProjectRoleTokens(projects []*v1alpha1.AppProject, roleName string, now time.Time, expiringWithin time.Duration) []projectRoleTokenRow {
+	rows := make([]projectRoleTokenRow, 0)
+	for _, proj := range projects {
+		for _, role := range proj.Spec.Roles {
+			if roleName != "" && role.Name != roleName {
+				continue
+			}
 			for _, token := range role.JWTTokens {
-				if useUnixTime {
-					_, _ = fmt.Fprintf(writer, tokenRowFormat, token.ID, token.IssuedAt, token.ExpiresAt)
-				} else {
-					_, _ = fmt.Fprintf(writer, tokenRowFormat, token.ID, tokenTimeToString(token.IssuedAt), tokenTimeToString(token.ExpiresAt))
+				if expiringWithin > 0 && (token.ExpiresAt <= 0 || time.Unix(token.ExpiresAt, 0).After(now.Add(expiringWithin))) {
+					continue
 				}
+				rows = append(rows, projectRoleTokenRow{
+					Project:   proj.Name,
+					Role:      role.Name,
+					ID:        token.ID,
+					IssuedAt:  token.IssuedAt,
+					ExpiresAt: token.ExpiresAt,
+					Expired:   tokenExpired(token.ExpiresAt, now),
+				})
 			}
-			err = writer.Flush()
+		}
+	}
+	return rows
+}
+
+// printProjectRoleTokens prints token rows as a table, json or csv. The project and role columns
+// are only part of the table if showRole is set.
+func printProjectRoleTokens(out io.Writer, rows []projectRoleTokenRow, output string, useUnixTime bool, showRole bool) error {
+	formatTime := tokenTimeToString
+	if useUnixTime {
+		formatTime = func(t int64) string { return strconv.FormatInt(t, 10) }
+	}
+	switch output {
+	case "json":
+		jsonBytes, err := json.MarshalIndent(rows, "", "  ")
+		if err != nil {
+			return fmt.Errorf("unable to marshal tokens to json: %w", err)
+		}
+		_, err = fmt.Fprintln(out, string(jsonBytes))
+		return err
+	case "csv":
+		writer := csv.NewWriter(out)
+		_ = writer.Write([]string{"project", "role", "id", "issuedAt", "expiresAt", "expired"})
+		for _, row := range rows {
+			_ = writer.Write([]string{row.Project, row.Role, row.ID, formatTime(row.IssuedAt), formatTime(row.ExpiresAt), strconv.FormatBool(row.Expired)})
+		}
+		writer.Flush()
+		return writer.Error()
+	case "":
+		writer := tabwriter.NewWriter(out, 0, 0, 4, ' ', 0)
+		if showRole {
+			_, _ = fmt.Fprintf(writer, "PROJECT\tROLE\tID\tISSUED AT\tEXPIRES AT\tEXPIRED\n")
+		} else {
+			_, _ = fmt.Fprintf(writer, "ID\tISSUED AT\tEXPIRES AT\tEXPIRED\n")
+		}
+		for _, row := range rows {
+			if showRole {
+				_, _ = fmt.Fprintf(writer, "%s\t%s\t", row.Project, row.Role)
+			}
+			_, _ = fmt.Fprintf(writer, "%s\t%v\t%v\t%t\n", row.ID, formatTime(row.IssuedAt), formatTime(row.ExpiresAt), row.Expired)
+		}
+		return writer.Flush()
+	default:
+		return fmt.Errorf("unknown output format: %s", output)
+	}
+}
+
+func NewProjectRoleListTokensCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		useUnixTime    bool
+		allProjects    bool
+		projects       []string
+		expiringWithin string
+		output         string
+	)
+	command := &cobra.Command{
+		Use:               "list-tokens [PROJECT ROLE-NAME]",
+		ValidArgsFunction: completeProjectNames(clientOpts, 1),
+		Short:             "List tokens for a given role.",
+		Example: `$ argocd proj role list-tokens test-project test-role
+ID                                      ISSUED AT                    EXPIRES AT                   EXPIRED
+f316c466-40bd-4cfd-8a8c-1392e92255d4    2023-10-08T15:21:40+01:00    Never                        false
+fa9d3517-c52d-434c-9bff-215b38508842    2023-10-08T11:08:18+01:00    2023-10-09T11:08:18+01:00    true
+
+# List the tokens of all roles of all projects
+$ argocd proj role list-tokens --all-projects
+
+# List the tokens of the roles of some projects which expire within 30 days, in csv format
+$ argocd proj role list-tokens --project test-project --project other-project --expiring-within 30d -o csv
+`,
+		Aliases: []string{"list-token", "token-list"},
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			inventory := allProjects || len(projects) > 0
+			if (inventory && len(args) != 0) || (!inventory && len(args) != 2) {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			var within time.Duration
+			if expiringWithin != "" {
+				d, err := timeutil.ParseDuration(expiringWithin)
+				errors.CheckError(err)
+				within = *d
+			}
+
+			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
+			defer utilio.Close(conn)
+
+			var projs []*v1alpha1.AppProject
+			var roleName string
+			switch {
+			case allProjects:
+				list, err := projIf.List(ctx, &projectpkg.ProjectQuery{})
+				errors.CheckError(err)
+				for i := range list.Items {
+					if len(projects) == 0 || slices.Contains(projects, list.Items[i].Name) {
+						projs = append(projs, &list.Items[i])
+					}
+				}
+			case len(projects) > 0:
+				for _, projName := range projects {
+					proj, err := projIf.Get(ctx, &projectpkg.ProjectQuery{Name: projName})
+					if err != nil {
+						// skip the projects which cannot be read instead of failing the whole inventory
+						log.Warnf("Skipping project '%s': %v", projName, err)
+						continue
+					}
+					projs = append(projs, proj)
+				}
+			default:
+				projName := args[0]
+				roleName = args[1]
+				proj, err := projIf.Get(ctx, &projectpkg.ProjectQuery{Name: projName})
+				errors.CheckError(err)
+				role, _, err := proj.GetRoleByName(roleName)
+				errors.CheckError(err)
+
+				if len(role.JWTTokens) == 0 && output == "" {
+					fmt.Printf("No tokens for %s.%s\n", projName, roleName)
+					return
+				}
+				projs = append(projs, proj)
+			}
+
+			rows := collectProjectRoleTokens(projs, roleName, time.Now(), within)
+			err := printProjectRoleTokens(os.Stdout, rows, output, useUnixTime, inventory)
 			errors.CheckError(err)
 		},
 	}
 	command.Flags().BoolVarP(&useUnixTime, "unixtime", "u", false,
 		"Print timestamps as Unix time instead of converting. Useful for piping into delete-token.",
 	)
+	command.Flags().BoolVar(&allProjects, "all-projects", false, "List the tokens of all roles of all projects")
+	command.Flags().StringArrayVar(&projects, "project", []string{}, "List the tokens of all roles of the given project. This option may be specified repeatedly")
+	command.Flags().StringVar(&expiringWithin, "expiring-within", "", "Only list tokens which expire within the given duration (e.g. 30d, 12h), including expired tokens")
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: json|csv")
 	return command
 }
 
 // NewProjectRoleDeleteTokenCommand returns a new instance of an `argocd proj role delete-token` command
 func NewProjectRoleDeleteTokenCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command := &cobra.Command{
-		Use:   "delete-token PROJECT ROLE-NAME ISSUED-AT",
-		Short: "Delete a project token",
+		Use:               "delete-token PROJECT ROLE-NAME ID|ISSUED-AT",
+		ValidArgsFunction: completeProjectNames(clientOpts, 1),
+		Short:             "Delete a project token",
 		Example: `#Create project test-project
 $ argocd proj create test-project
 
@@ -473,6 +786,9 @@ ID          ISSUED-AT                                  EXPIRES-AT
 1696769937  2023-10-08T13:58:57+01:00 (6 minutes ago)  <none>
 
 $ argocd proj role delete-token test-project test-role 1696769937
+
+# Delete a token by its ID
+$ argocd proj role delete-token test-project test-role c312450e-12e1-4e0d-9f65-fac9cb027b32
 `,
 		Aliases: []string{"token-delete", "remove-token"},
 		Run: func(c *cobra.Command, args []string) {
@@ -485,8 +801,14 @@ $ argocd proj role delete-token test-project test-role 1696769937
 			projName := args[0]
 			roleName := args[1]
 			tokenId := args[2]
-			issuedAt, err := strconv.ParseInt(tokenId, 10, 64)
-			errors.CheckError(err)
+			deleteReq := &projectpkg.ProjectTokenDeleteRequest{Project: projName, Role: roleName}
+			// tokens without ID are referenced by their issued-at timestamp
+			if issuedAt, err := strconv.ParseInt(tokenId, 10, 64); err == nil {
+				deleteReq.Iat = issuedAt
+			} else {
+				deleteReq.Iat = -1
+				deleteReq.Id = tokenId
+			}
 
 			promptUtil := utils.NewPrompt(clientOpts.PromptsEnabled)
 
@@ -495,7 +817,7 @@ $ argocd proj role delete-token test-project test-role 1696769937
 
 			canDelete := promptUtil.Confirm(fmt.Sprintf("Are you sure you want to delete '%s' project token? [y/n]", tokenId))
 			if canDelete {
-				_, err = projIf.DeleteToken(ctx, &projectpkg.ProjectTokenDeleteRequest{Project: projName, Role: roleName, Iat: issuedAt})
+				_, err := projIf.DeleteToken(ctx, deleteReq)
 				errors.CheckError(err)
 			} else {
 				fmt.Printf("The command to delete project token '%s' was cancelled.\n", tokenId)
@@ -526,8 +848,9 @@ func printProjectRoleListTable(roles []v1alpha1.ProjectRole) {
 func NewProjectRoleListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var output string
 	command := &cobra.Command{
-		Use:   "list PROJECT",
-		Short: "List all the roles in a project",
+		Use:               "list PROJECT",
+		ValidArgsFunction: completeProjectNames(clientOpts, 1),
+		Short:             "List all the roles in a project",
 		Example: templates.Examples(`
   # This command will list all the roles in argocd-project in a default table format.
   argocd proj role list PROJECT
@@ -570,8 +893,9 @@ func NewProjectRoleListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 // NewProjectRoleGetCommand returns a new instance of an `argocd proj roles get` command
 func NewProjectRoleGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command := &cobra.Command{
-		Use:   "get PROJECT ROLE-NAME",
-		Short: "Get the details of a specific role",
+		Use:               "get PROJECT ROLE-NAME",
+		ValidArgsFunction: completeProjectNames(clientOpts, 1),
+		Short:             "Get the details of a specific role",
 		Example: `$ argocd proj role get test-project test-role
 Role Name:     test-role
 Description:
@@ -625,8 +949,9 @@ ID          ISSUED-AT                                  EXPIRES-AT
 // NewProjectRoleAddGroupCommand returns a new instance of an `argocd proj role add-group` command
 func NewProjectRoleAddGroupCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command := &cobra.Command{
-		Use:   "add-group PROJECT ROLE-NAME GROUP-CLAIM",
-		Short: "Add a group claim to a project role",
+		Use:               "add-group PROJECT ROLE-NAME GROUP-CLAIM",
+		ValidArgsFunction: completeProjectNames(clientOpts, 1),
+		Short:             "Add a group claim to a project role",
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
@@ -656,8 +981,9 @@ func NewProjectRoleAddGroupCommand(clientOpts *argocdclient.ClientOptions) *cobr
 // NewProjectRoleRemoveGroupCommand returns a new instance of an `argocd proj role remove-group` command
 func NewProjectRoleRemoveGroupCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command := &cobra.Command{
-		Use:   "remove-group PROJECT ROLE-NAME GROUP-CLAIM",
-		Short: "Remove a group claim from a role within a project",
+		Use:               "remove-group PROJECT ROLE-NAME GROUP-CLAIM",
+		ValidArgsFunction: completeProjectNames(clientOpts, 1),
+		Short:             "Remove a group claim from a role within a project",
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
@@ -692,3 +1018,116 @@ func NewProjectRoleRemoveGroupCommand(clientOpts *argocdclient.ClientOptions) *c
 	}
 	return command
 }
+
+// NewProjectRoleCanICommand returns a new instance of an `argocd proj role can-i` command
+func NewProjectRoleCanICommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		policyFile string
+		matchMode  string
+	)
+	command := &cobra.Command{
+		Use:               "can-i PROJECT ROLE-NAME ACTION RESOURCE SUBRESOURCE",
+		ValidArgsFunction: completeProjectNames(clientOpts, 1),
+		Short:             "Check whether a token of a project role is allowed to perform an action",
+		Long: `Check whether a token of a project role is allowed to perform an action. The API server evaluates the role with
+the RBAC policies of Argo CD, including the global policy, the default role and the match mode of argocd-rbac-cm.
+
+With --policy-file, the role is evaluated locally against the policies of the given project manifest only. The global
+policy, the default role and the match mode of argocd-rbac-cm are not taken into account then.`,
+		Example: fmt.Sprintf(`
+# Can tokens of the ci role sync applications of my-project?
+argocd proj role can-i my-project ci sync applications 'my-project/*'
+
+# Evaluate the role against the policies of an exported project manifest only, without connecting to Argo CD
+argocd proj role can-i my-project ci get applications 'my-project/guestbook' --policy-file my-project.yaml
+
+# Evaluate the regex policies of an exported project manifest
+argocd proj role can-i my-project ci get applications 'my-project/guestbook' --policy-file my-project.yaml --match-mode regex
+
+Actions: %v
+Resources: %v
+`, rbac.Actions, rbac.Resources),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 5 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			projName, roleName, action, resource, subresource := args[0], args[1], args[2], args[3], args[4]
+
+			var allowed bool
+			if policyFile != "" {
+				proj, err := readProjectFromFile(policyFile)
+				errors.CheckError(err)
+				if proj.Name != projName {
+					errors.CheckError(fmt.Errorf("project file %s defines project '%s', not '%s'", policyFile, proj.Name, projName))
+				}
+				allowed, err = canProjectRole(proj, roleName, action, resource, subresource, matchMode)
+				errors.CheckError(err)
+				log.Warn("The role was evaluated against the project policies only. The global policy, the default role and the match mode of argocd-rbac-cm were not taken into account.")
+			} else {
+				if c.Flags().Changed("match-mode") {
+					errors.CheckError(stderrors.New("--match-mode can only be used with --policy-file, the API server uses the match mode of argocd-rbac-cm"))
+				}
+				conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
+				defer utilio.Close(conn)
+				res, err := projIf.CanIRole(ctx, &projectpkg.ProjectRoleCanIRequest{
+					Project:     projName,
+					Role:        roleName,
+					Action:      action,
+					Resource:    resource,
+					Subresource: subresource,
+				})
+				errors.CheckError(err)
+				allowed = res.Allowed
+			}
+			if allowed {
+				fmt.Println("yes")
+			} else {
+				fmt.Println("no")
+			}
+		},
+	}
+	command.Flags().StringVar(&policyFile, "policy-file", "", "Path to an exported project manifest to evaluate the role against, instead of the project in Argo CD")
+	command.Flags().StringVar(&matchMode, "match-mode", rbac.GlobMatchMode, "Match mode of the policies of the --policy-file. One of: glob|regex")
+	return command
+}
+
+// readProjectFromFile reads an AppProject manifest from the given file
+func readProjectFromFile(path string) (*v1alpha1.AppProject, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading project file %s: %w", path, err)
+	}
+	var proj v1alpha1.AppProject
+	if err := yaml.Unmarshal(data, &proj); err != nil {
+		return nil, fmt.Errorf("error unmarshaling project file %s: %w", path, err)
+	}
+	return &proj, nil
+}
+
+// canProjectRole evaluates whether a token of the given project role is allowed to perform the action, using the
+// policies of the project only. Unlike the API server, the policies of argocd-rbac-cm are not known here.
+func canProjectRole(proj *v1alpha1.AppProject, roleName, action, resource, subresource, matchMode string) (bool, error) {
+	if matchMode != rbac.GlobMatchMode && matchMode != rbac.RegexMatchMode {
+		return false, fmt.Errorf("unknown match mode: %s", matchMode)
+	}
+	if _, _, err := proj.GetRoleByName(roleName); err != nil {
+		return false, err
+	}
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	if err := indexer.Add(proj); err != nil {
+		return false, fmt.Errorf("error indexing project: %w", err)
+	}
+	enf := rbac.NewEnforcer(nil, proj.Namespace, common.ArgoCDRBACConfigMapName, nil)
+	enf.SetMatchMode(matchMode)
+	if err := enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV); err != nil {
+		return false, fmt.Errorf("error setting built-in policy: %w", err)
+	}
+	policyEnf := rbacpolicy.NewRBACPolicyEnforcer(enf, applisters.NewAppProjectLister(indexer).AppProjects(proj.Namespace))
+	enf.SetClaimsEnforcerFunc(policyEnf.EnforceClaims)
+
+	claims := jwtgo.MapClaims{"sub": fmt.Sprintf("proj:%s:%s", proj.Name, roleName)}
+	return enf.Enforce(claims, resource, action, subresource), nil
+}
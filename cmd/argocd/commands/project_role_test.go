@@ -0,0 +1,239 @@
+package commands
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	jwtgo "github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestParseProjectToken(t *testing.T) {
+	tokenString, err := jwtgo.NewWithClaims(jwtgo.SigningMethodHS256, jwtgo.MapClaims{
+		"sub": "proj:test-project:test-role",
+		"jti": "f316c466-40bd-4cfd-8a8c-1392e92255d4",
+		"iat": 1696774900,
+		"exp": 1696861300,
+	}).SignedString([]byte("secret"))
+	require.NoError(t, err)
+
+	token, err := parseProjectToken(tokenString)
+	require.NoError(t, err)
+	assert.Equal(t, &projectToken{
+		Token:     tokenString,
+		ID:        "f316c466-40bd-4cfd-8a8c-1392e92255d4",
+		IssuedAt:  1696774900,
+		ExpiresAt: 1696861300,
+		subject:   "proj:test-project:test-role",
+	}, token)
+
+	_, err = parseProjectToken("not-a-token")
+	require.ErrorContains(t, err, "malformed token")
+}
+
+func TestFindProjectRoleToken(t *testing.T) {
+	proj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-project"},
+		Spec: v1alpha1.AppProjectSpec{
+			Roles: []v1alpha1.ProjectRole{{
+				Name:      "test-role",
+				JWTTokens: []v1alpha1.JWTToken{{IssuedAt: 1696759698}},
+			}},
+		},
+		Status: v1alpha1.AppProjectStatus{
+			JWTTokensByRole: map[string]v1alpha1.JWTTokens{
+				"test-role": {Items: []v1alpha1.JWTToken{{IssuedAt: 1696774900, ID: "f316c466"}}},
+			},
+		},
+	}
+
+	token, err := findProjectRoleToken(proj, "test-role", "f316c466")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1696774900), token.IssuedAt)
+
+	token, err = findProjectRoleToken(proj, "test-role", "1696759698")
+	require.NoError(t, err)
+	assert.Empty(t, token.ID)
+
+	_, err = findProjectRoleToken(proj, "test-role", "unknown")
+	require.ErrorContains(t, err, "does not exist")
+}
+
+func TestFindOnlyProjectRoleToken(t *testing.T) {
+	now := time.Now()
+	proj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-project"},
+		Spec:       v1alpha1.AppProjectSpec{Roles: []v1alpha1.ProjectRole{{Name: "test-role"}, {Name: "empty-role"}}},
+		Status: v1alpha1.AppProjectStatus{
+			JWTTokensByRole: map[string]v1alpha1.JWTTokens{
+				"test-role": {Items: []v1alpha1.JWTToken{
+					{IssuedAt: 1696774900, ID: "active"},
+					{IssuedAt: 1696759698, ID: "expired", ExpiresAt: now.Add(-time.Hour).Unix()},
+				}},
+			},
+		},
+	}
+
+	token, err := findOnlyProjectRoleToken(proj, "test-role", now)
+	require.NoError(t, err)
+	assert.Equal(t, "active", token.ID)
+
+	_, err = findOnlyProjectRoleToken(proj, "empty-role", now)
+	require.ErrorContains(t, err, "has no token to rotate")
+
+	proj.Status.JWTTokensByRole["test-role"].Items[1].ExpiresAt = 0
+	_, err = findOnlyProjectRoleToken(proj, "test-role", now)
+	require.ErrorContains(t, err, "has 2 tokens")
+}
+
+func TestTokenExpired(t *testing.T) {
+	now := time.Now()
+	assert.False(t, tokenExpired(0, now))
+	assert.False(t, tokenExpired(now.Add(time.Hour).Unix(), now))
+	assert.True(t, tokenExpired(now.Add(-time.Hour).Unix(), now))
+}
+
+func TestCanProjectRole(t *testing.T) {
+	newProject := func(policies ...string) *v1alpha1.AppProject {
+		return &v1alpha1.AppProject{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-project", Namespace: "argocd"},
+			Spec: v1alpha1.AppProjectSpec{
+				Roles: []v1alpha1.ProjectRole{{Name: "ci", Policies: policies}},
+			},
+		}
+	}
+
+	t.Run("Glob", func(t *testing.T) {
+		proj := newProject(
+			"p, proj:my-project:ci, applications, sync, my-project/guestbook-*, allow",
+			"p, proj:my-project:ci, applications, get, my-project/*, allow",
+			"p, proj:my-project:ci, applications, get, my-project/secret-app, deny",
+		)
+		for _, tc := range []struct {
+			action, resource, subresource string
+			expected                      bool
+		}{
+			{"sync", "applications", "my-project/guestbook-dev", true},
+			{"sync", "applications", "my-project/other", false},
+			{"get", "applications", "my-project/other", true},
+			{"get", "applications", "my-project/secret-app", false},
+			{"get", "applications", "other-project/guestbook-dev", false},
+			{"delete", "applications", "my-project/guestbook-dev", false},
+			{"get", "clusters", "*", false},
+		} {
+			allowed, err := canProjectRole(proj, "ci", tc.action, tc.resource, tc.subresource, "glob")
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, allowed, "%s %s %s", tc.action, tc.resource, tc.subresource)
+		}
+	})
+
+	t.Run("Regex", func(t *testing.T) {
+		proj := newProject("p, proj:my-project:ci, applications, sync, my-project/guestbook-(dev|staging), allow")
+		allowed, err := canProjectRole(proj, "ci", "sync", "applications", "my-project/guestbook-dev", "regex")
+		require.NoError(t, err)
+		assert.True(t, allowed)
+		allowed, err = canProjectRole(proj, "ci", "sync", "applications", "my-project/guestbook-prod", "regex")
+		require.NoError(t, err)
+		assert.False(t, allowed)
+		allowed, err = canProjectRole(proj, "ci", "sync", "applications", "my-project/guestbook-dev", "glob")
+		require.NoError(t, err)
+		assert.False(t, allowed)
+	})
+
+	t.Run("UnknownRole", func(t *testing.T) {
+		_, err := canProjectRole(newProject(), "unknown", "get", "applications", "my-project/*", "glob")
+		require.Error(t, err)
+	})
+
+	t.Run("UnknownMatchMode", func(t *testing.T) {
+		_, err := canProjectRole(newProject(), "ci", "get", "applications", "my-project/*", "exact")
+		require.ErrorContains(t, err, "unknown match mode")
+	})
+}
+
+func TestReadProjectFromFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "project.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`apiVersion: argoproj.io/v1alpha1
+kind: AppProject
+metadata:
+  name: my-project
+spec:
+  roles:
+  - name: ci
+    policies:
+    - p, proj:my-project:ci, applications, get, my-project/*, allow
+`), 0o644))
+	proj, err := readProjectFromFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "my-project", proj.Name)
+	allowed, err := canProjectRole(proj, "ci", "get", "applications", "my-project/guestbook", "glob")
+	require.NoError(t, err)
+	assert.True(t, allowed)
+}
+
+func TestCollectProjectRoleTokens(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	projects := []*v1alpha1.AppProject{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "a"},
+			Spec: v1alpha1.AppProjectSpec{Roles: []v1alpha1.ProjectRole{
+				{Name: "ci", JWTTokens: []v1alpha1.JWTToken{
+					{ID: "never", IssuedAt: 1},
+					{ID: "expired", IssuedAt: 2, ExpiresAt: now.Add(-time.Hour).Unix()},
+					{ID: "soon", IssuedAt: 3, ExpiresAt: now.Add(24 * time.Hour).Unix()},
+				}},
+				{Name: "deploy", JWTTokens: []v1alpha1.JWTToken{
+					{ID: "later", IssuedAt: 4, ExpiresAt: now.Add(90 * 24 * time.Hour).Unix()},
+				}},
+			}},
+		},
+		{ObjectMeta: metav1.ObjectMeta{Name: "b"}},
+	}
+
+	ids := func(rows []projectRoleTokenRow) []string {
+		var res []string
+		for _, row := range rows {
+			res = append(res, row.Project+"/"+row.Role+"/"+row.ID)
+		}
+		return res
+	}
+
+	rows := collectProjectRoleTokens(projects, "", now, 0)
+	assert.Equal(t, []string{"a/ci/never", "a/ci/expired", "a/ci/soon", "a/deploy/later"}, ids(rows))
+	assert.False(t, rows[0].Expired)
+	assert.True(t, rows[1].Expired)
+
+	assert.Equal(t, []string{"a/deploy/later"}, ids(collectProjectRoleTokens(projects, "deploy", now, 0)))
+	assert.Equal(t, []string{"a/ci/expired", "a/ci/soon"}, ids(collectProjectRoleTokens(projects, "", now, 30*24*time.Hour)))
+}
+
+func TestPrintProjectRoleTokens(t *testing.T) {
+	rows := []projectRoleTokenRow{{Project: "a", Role: "ci", ID: "id1", IssuedAt: 1700000000, ExpiresAt: 0}}
+
+	out := &bytes.Buffer{}
+	require.NoError(t, printProjectRoleTokens(out, rows, "csv", true, true))
+	assert.Equal(t, "project,role,id,issuedAt,expiresAt,expired\na,ci,id1,1700000000,0,false\n", out.String())
+
+	out.Reset()
+	require.NoError(t, printProjectRoleTokens(out, rows, "json", false, true))
+	assert.JSONEq(t, `[{"project":"a","role":"ci","id":"id1","iat":1700000000,"exp":0,"expired":false}]`, out.String())
+
+	out.Reset()
+	require.NoError(t, printProjectRoleTokens(out, rows, "", true, true))
+	assert.Contains(t, out.String(), "PROJECT")
+	assert.Contains(t, out.String(), "a          ci      id1    1700000000")
+
+	out.Reset()
+	require.NoError(t, printProjectRoleTokens(out, rows, "", false, false))
+	assert.NotContains(t, out.String(), "PROJECT")
+	assert.Contains(t, out.String(), "Never")
+
+	require.Error(t, printProjectRoleTokens(out, rows, "yaml", false, false))
+}
@@ -0,0 +1,411 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	projectpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/project"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestCloneProject(t *testing.T) {
+	source := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-a", Namespace: "argocd", ResourceVersion: "123", UID: "abc"},
+		Spec: v1alpha1.AppProjectSpec{
+			Description:  "Team A",
+			SourceRepos:  []string{"https://github.com/argoproj/argocd-example-apps"},
+			Destinations: []v1alpha1.ApplicationDestination{{Server: "https://kubernetes.default.svc", Namespace: "team-a"}},
+			Roles: []v1alpha1.ProjectRole{{
+				Name:      "ci",
+				Policies:  []string{"p, proj:team-a:ci, applications, sync, team-a/*, allow"},
+				JWTTokens: []v1alpha1.JWTToken{{IssuedAt: 1, ID: "token"}},
+				Groups:    []string{"ci-group"},
+			}},
+			SyncWindows:   v1alpha1.SyncWindows{{Kind: "deny", Schedule: "0 22 * * *", Duration: "1h", TimeZone: "Europe/Berlin"}},
+			SignatureKeys: []v1alpha1.SignatureKey{{KeyID: "4AEE18F83AFDEB23"}},
+		},
+	}
+
+	proj := cloneProject(source, "team-b")
+
+	assert.Equal(t, "team-b", proj.Name)
+	assert.Equal(t, "argocd", proj.Namespace)
+	assert.Empty(t, proj.ResourceVersion)
+	assert.Empty(t, proj.UID)
+	assert.Equal(t, "AppProject", proj.Kind)
+	assert.Equal(t, source.Spec.SourceRepos, proj.Spec.SourceRepos)
+	assert.Equal(t, source.Spec.Destinations, proj.Spec.Destinations)
+	assert.Equal(t, source.Spec.SyncWindows, proj.Spec.SyncWindows)
+	assert.Equal(t, source.Spec.SignatureKeys, proj.Spec.SignatureKeys)
+	assert.Empty(t, proj.Spec.Roles[0].JWTTokens)
+	assert.Equal(t, []string{"ci-group"}, proj.Spec.Roles[0].Groups)
+	assert.Equal(t, []string{"p, proj:team-b:ci, applications, sync, team-b/*, allow"}, proj.Spec.Roles[0].Policies)
+
+	// the source project must not be modified
+	assert.Len(t, source.Spec.Roles[0].JWTTokens, 1)
+	assert.Equal(t, "p, proj:team-a:ci, applications, sync, team-a/*, allow", source.Spec.Roles[0].Policies[0])
+}
+
+func TestRetargetRolePolicy(t *testing.T) {
+	tests := []struct {
+		policy   string
+		expected string
+	}{
+		{"p, proj:a:r, applications, get, a/*, allow", "p, proj:b:r, applications, get, b/*, allow"},
+		{"p,proj:a:r,applications,get,a/ns/app,deny", "p, proj:b:r, applications, get, b/ns/app, deny"},
+		{"p, proj:a:r, projects, get, a, allow", "p, proj:b:r, projects, get, b, allow"},
+		{"p, proj:a:r, applications, get, ab/*, allow", "p, proj:b:r, applications, get, ab/*, allow"},
+		{"p, proj:a:other, applications, get, a/*, allow", "p, proj:a:other, applications, get, b/*, allow"},
+		{"not a policy", "not a policy"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, retargetRolePolicy(tt.policy, "a", "b", "r"))
+	}
+}
+
+func TestGetProjectEffectiveAccess(t *testing.T) {
+	proj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-a"},
+		Spec: v1alpha1.AppProjectSpec{
+			SourceRepos: []string{"https://github.com/org/*", "!https://github.com/org/secret"},
+			Destinations: []v1alpha1.ApplicationDestination{
+				{Server: "https://kubernetes.default.svc", Namespace: "team-a"},
+				{Name: "prod", Namespace: "*"},
+				{Server: "https://kubernetes.default.svc", Namespace: "!kube-system"},
+			},
+			ClusterResourceWhitelist:   []metav1.GroupKind{{Group: "", Kind: "Namespace"}, {Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"}},
+			ClusterResourceBlacklist:   []metav1.GroupKind{{Group: "rbac.authorization.k8s.io", Kind: "*"}},
+			NamespaceResourceBlacklist: []metav1.GroupKind{{Group: "", Kind: "ResourceQuota"}},
+		},
+	}
+
+	access := getProjectEffectiveAccess(proj)
+
+	assert.Equal(t, []string{"https://github.com/org/*"}, access.SourceRepos)
+	assert.Equal(t, []string{"https://github.com/org/secret"}, access.DeniedSourceRepos)
+	assert.Equal(t, []string{"/Namespace"}, access.ClusterResources.Allowed)
+	assert.Equal(t, []shadowedResource{{Resource: "rbac.authorization.k8s.io/ClusterRole", ShadowedBy: "rbac.authorization.k8s.io/*"}}, access.ClusterResources.Shadowed)
+	assert.Equal(t, []string{"*/*"}, access.NamespacedResources.Allowed)
+	assert.Equal(t, []string{"/ResourceQuota"}, access.NamespacedResources.Denied)
+	require.Len(t, access.Destinations, 3)
+	assert.Equal(t, effectiveDestination{Server: "https://kubernetes.default.svc", Namespace: "team-a", ClusterResourcesAllowed: true, BlockedNamespacedKinds: []string{"/ResourceQuota"}}, access.Destinations[0])
+	assert.Equal(t, "prod", access.Destinations[1].Name)
+	assert.False(t, access.Destinations[1].Denied)
+	assert.True(t, access.Destinations[2].Denied)
+	assert.False(t, access.Destinations[2].ClusterResourcesAllowed)
+
+	out := &bytes.Buffer{}
+	printProjectEffectiveAccess(out, access)
+	assert.Contains(t, out.String(), "Ineffective allowed cluster resource rbac.authorization.k8s.io/ClusterRole: denied by rbac.authorization.k8s.io/*")
+}
+
+func TestGetProjectEffectiveAccess_NoClusterResources(t *testing.T) {
+	proj := &v1alpha1.AppProject{
+		Spec: v1alpha1.AppProjectSpec{
+			Destinations:               []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+			NamespaceResourceWhitelist: []metav1.GroupKind{{Group: "apps", Kind: "Deployment"}},
+		},
+	}
+
+	access := getProjectEffectiveAccess(proj)
+
+	assert.Empty(t, access.SourceRepos)
+	assert.Empty(t, access.ClusterResources.Allowed)
+	assert.False(t, access.Destinations[0].ClusterResourcesAllowed)
+	assert.Equal(t, []string{"apps/Deployment"}, access.NamespacedResources.Allowed)
+
+	out := &bytes.Buffer{}
+	printProjectEffectiveAccess(out, access)
+	assert.Contains(t, out.String(), "No source repositories are permitted")
+}
+
+func TestProjectDetails_JSON(t *testing.T) {
+	proj := &v1alpha1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}}
+	data, err := json.Marshal(&projectDetails{AppProject: proj, Effective: getProjectEffectiveAccess(proj)})
+	require.NoError(t, err)
+
+	var out map[string]any
+	require.NoError(t, json.Unmarshal(data, &out))
+	assert.Contains(t, out, "metadata")
+	assert.Contains(t, out, "spec")
+	assert.Contains(t, out, "effective")
+	assert.NotContains(t, out, "origins")
+}
+
+func TestGetProjectFieldOrigins(t *testing.T) {
+	globalProj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "global"},
+		Spec: v1alpha1.AppProjectSpec{
+			SourceRepos:                []string{"https://github.com/org/*"},
+			NamespaceResourceBlacklist: []metav1.GroupKind{{Group: "", Kind: "ResourceQuota"}},
+			SyncWindows:                v1alpha1.SyncWindows{{Kind: "deny", Schedule: "0 22 * * *", Duration: "1h"}},
+		},
+	}
+	// the project as returned by the API, merged with its global project
+	proj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-a"},
+		Spec: v1alpha1.AppProjectSpec{
+			SourceRepos:                []string{"https://github.com/team-a/*", "https://github.com/org/*"},
+			Destinations:               []v1alpha1.ApplicationDestination{{Server: "https://kubernetes.default.svc", Namespace: "team-a"}},
+			NamespaceResourceBlacklist: []metav1.GroupKind{{Group: "", Kind: "ResourceQuota"}},
+			SyncWindows:                v1alpha1.SyncWindows{{Kind: "deny", Schedule: "0 22 * * *", Duration: "1h"}},
+		},
+	}
+
+	origins := getProjectFieldOrigins(proj, []*v1alpha1.AppProject{globalProj})
+	assert.Equal(t, []projectFieldOrigin{
+		{Field: "Destinations", Value: "https://kubernetes.default.svc,team-a", Origin: "team-a"},
+		{Field: "Repositories", Value: "https://github.com/team-a/*", Origin: "team-a"},
+		{Field: "Repositories", Value: "https://github.com/org/*", Origin: "global", Inherited: true},
+		{Field: "Denied Namespaced Resources", Value: "/ResourceQuota", Origin: "global", Inherited: true},
+		{Field: "Sync Windows", Value: "deny:0 22 * * *:1h (UTC)", Origin: "global", Inherited: true},
+	}, origins)
+
+	out := &bytes.Buffer{}
+	printProjectFieldOrigins(out, getProjectNames([]*v1alpha1.AppProject{globalProj}), origins)
+	assert.Contains(t, out.String(), "Global Projects:             global\n")
+	assert.Contains(t, out.String(), "Repositories                 https://github.com/org/*               global (global)\n")
+
+	out.Reset()
+	printProjectFieldOrigins(out, nil, getProjectFieldOrigins(&v1alpha1.AppProject{}, nil))
+	assert.Equal(t, "Global Projects:             <none>\n", out.String())
+}
+
+func TestParseResourceLists(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		lists, err := parseResourceLists([]byte(`
+clusterResourceWhitelist:
+- group: ""
+  kind: Namespace
+- group: rbac.authorization.k8s.io
+  kind: "*"
+namespaceResourceBlacklist: []
+clusterResourceBlacklist:
+`))
+		require.NoError(t, err)
+		assert.Equal(t, map[string][]metav1.GroupKind{
+			"clusterResourceWhitelist":   {{Group: "", Kind: "Namespace"}, {Group: "rbac.authorization.k8s.io", Kind: "*"}},
+			"namespaceResourceBlacklist": {},
+			"clusterResourceBlacklist":   nil,
+		}, lists)
+	})
+	t.Run("Empty", func(t *testing.T) {
+		lists, err := parseResourceLists([]byte(""))
+		require.NoError(t, err)
+		assert.Empty(t, lists)
+	})
+	t.Run("UnknownList", func(t *testing.T) {
+		_, err := parseResourceLists([]byte("clusterResourceWhitelist: []\nnamespaceResourceAllowlist: []\n"))
+		require.ErrorContains(t, err, "line 2: unknown resource list 'namespaceResourceAllowlist'")
+	})
+	t.Run("MissingKind", func(t *testing.T) {
+		_, err := parseResourceLists([]byte("clusterResourceWhitelist:\n- group: apps\n  kind: Deployment\n- group: apps\n"))
+		require.ErrorContains(t, err, "line 4: invalid resource 'apps/': kind must not be empty")
+	})
+	t.Run("InvalidPattern", func(t *testing.T) {
+		_, err := parseResourceLists([]byte("namespaceResourceBlacklist:\n- group: apps\n  kind: \"Deploy[ment\"\n"))
+		require.ErrorContains(t, err, "line 2: invalid resource 'apps/Deploy[ment'")
+	})
+	t.Run("NotAList", func(t *testing.T) {
+		_, err := parseResourceLists([]byte("namespaceResourceBlacklist: apps/Deployment\n"))
+		require.ErrorContains(t, err, "line 1: namespaceResourceBlacklist must be a list")
+	})
+}
+
+func TestApplyResourceLists(t *testing.T) {
+	newSpec := func() *v1alpha1.AppProjectSpec {
+		return &v1alpha1.AppProjectSpec{
+			ClusterResourceWhitelist:   []metav1.GroupKind{{Group: "", Kind: "Namespace"}},
+			NamespaceResourceBlacklist: []metav1.GroupKind{{Group: "", Kind: "ResourceQuota"}},
+		}
+	}
+	lists := map[string][]metav1.GroupKind{
+		"clusterResourceWhitelist": {{Group: "", Kind: "Namespace"}, {Group: "storage.k8s.io", Kind: "StorageClass"}, {Group: "storage.k8s.io", Kind: "StorageClass"}},
+	}
+
+	t.Run("Merge", func(t *testing.T) {
+		spec := newSpec()
+		changes := applyResourceLists(spec, lists, false)
+		assert.Equal(t, []string{"clusterResourceWhitelist: 1 added, 0 removed"}, changes)
+		assert.Equal(t, []metav1.GroupKind{{Group: "", Kind: "Namespace"}, {Group: "storage.k8s.io", Kind: "StorageClass"}}, spec.ClusterResourceWhitelist)
+		assert.Equal(t, []metav1.GroupKind{{Group: "", Kind: "ResourceQuota"}}, spec.NamespaceResourceBlacklist)
+	})
+	t.Run("Replace", func(t *testing.T) {
+		spec := newSpec()
+		changes := applyResourceLists(spec, map[string][]metav1.GroupKind{
+			"clusterResourceWhitelist":   {{Group: "storage.k8s.io", Kind: "StorageClass"}},
+			"namespaceResourceBlacklist": nil,
+		}, true)
+		assert.Equal(t, []string{"clusterResourceWhitelist: 1 added, 1 removed", "namespaceResourceBlacklist: 0 added, 1 removed"}, changes)
+		assert.Equal(t, []metav1.GroupKind{{Group: "storage.k8s.io", Kind: "StorageClass"}}, spec.ClusterResourceWhitelist)
+		assert.Empty(t, spec.NamespaceResourceBlacklist)
+	})
+	t.Run("Unchanged", func(t *testing.T) {
+		spec := newSpec()
+		changes := applyResourceLists(spec, map[string][]metav1.GroupKind{"clusterResourceWhitelist": {{Group: "", Kind: "Namespace"}}}, false)
+		assert.Empty(t, changes)
+	})
+}
+
+func TestResourceListsObject(t *testing.T) {
+	obj := resourceListsObject(&v1alpha1.AppProjectSpec{
+		ClusterResourceWhitelist: []metav1.GroupKind{{Group: "", Kind: "Namespace"}},
+	})
+	assert.Equal(t, map[string]any{
+		"clusterResourceWhitelist": []any{map[string]any{"group": "", "kind": "Namespace"}},
+	}, obj.Object)
+}
+
+func TestCountApplicationsByProject(t *testing.T) {
+	apps := []v1alpha1.Application{
+		{Spec: v1alpha1.ApplicationSpec{Project: "team-a"}},
+		{Spec: v1alpha1.ApplicationSpec{Project: "team-a"}},
+		{Spec: v1alpha1.ApplicationSpec{}},
+	}
+	assert.Equal(t, map[string]int{"team-a": 2, "default": 1}, countApplicationsByProject(apps))
+}
+
+func TestFormatProjectSummaries(t *testing.T) {
+	proj := &v1alpha1.AppProject{}
+	assert.Equal(t, "0", formatProjectDestinationsSummary(proj))
+	assert.Equal(t, "0", formatProjectSourceReposSummary(proj))
+
+	proj.Spec.Destinations = []v1alpha1.ApplicationDestination{{Name: "in-cluster", Namespace: "default"}}
+	proj.Spec.SourceRepos = []string{"https://github.com/org/repo"}
+	assert.Equal(t, "1 (in-cluster,default)", formatProjectDestinationsSummary(proj))
+	assert.Equal(t, "1 (https://github.com/org/repo)", formatProjectSourceReposSummary(proj))
+
+	proj.Spec.Destinations = append(proj.Spec.Destinations, v1alpha1.ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "*"})
+	proj.Spec.SourceRepos = append(proj.Spec.SourceRepos, "*")
+	assert.Equal(t, "2 (in-cluster,default, ...)", formatProjectDestinationsSummary(proj))
+	assert.Equal(t, "2 (https://github.com/org/repo, ...)", formatProjectSourceReposSummary(proj))
+}
+
+func TestBuildDenyDestination(t *testing.T) {
+	dest, err := buildDenyDestination("https://kubernetes.default.svc", "kube-system", false)
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "!kube-system"}, dest)
+
+	dest, err = buildDenyDestination("prod", "*", true)
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.ApplicationDestination{Name: "!prod", Namespace: "*"}, dest)
+
+	_, err = buildDenyDestination("*", "*", false)
+	require.Error(t, err)
+	_, err = buildDenyDestination("https://kubernetes.default.svc", "!kube-system", false)
+	require.Error(t, err)
+}
+
+func TestIsDenyDestinationEffective(t *testing.T) {
+	destinations := []v1alpha1.ApplicationDestination{
+		{Server: "https://kubernetes.default.svc", Namespace: "*"},
+		{Name: "prod", Namespace: "team-*"},
+		{Server: "https://other", Namespace: "!kube-system"},
+	}
+
+	assert.True(t, isDenyDestinationEffective(destinations, v1alpha1.ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "!kube-system"}))
+	assert.True(t, isDenyDestinationEffective(destinations, v1alpha1.ApplicationDestination{Name: "prod", Namespace: "!team-a"}))
+	assert.True(t, isDenyDestinationEffective(destinations, v1alpha1.ApplicationDestination{Server: "!https://kubernetes.default.svc", Namespace: "*"}))
+	assert.False(t, isDenyDestinationEffective(destinations, v1alpha1.ApplicationDestination{Name: "prod", Namespace: "!kube-system"}))
+	assert.False(t, isDenyDestinationEffective(destinations, v1alpha1.ApplicationDestination{Server: "https://other", Namespace: "!default"}))
+	assert.False(t, isDenyDestinationEffective(destinations, v1alpha1.ApplicationDestination{Name: "!staging", Namespace: "*"}))
+}
+
+func TestFormatDestination(t *testing.T) {
+	assert.Equal(t, "https://kubernetes.default.svc,default", formatDestination(v1alpha1.ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "default"}))
+	assert.Equal(t, "prod,kube-system", formatDestination(v1alpha1.ApplicationDestination{Name: "prod", Namespace: "!kube-system"}))
+	assert.Equal(t, "https://other,*", formatDestination(v1alpha1.ApplicationDestination{Server: "!https://other", Namespace: "*"}))
+	assert.True(t, isDenyDestination(v1alpha1.ApplicationDestination{Name: "!prod", Namespace: "*"}))
+	assert.False(t, isDenyDestination(v1alpha1.ApplicationDestination{Name: "prod", Namespace: "*"}))
+}
+
+func TestPrintProjectUsage(t *testing.T) {
+	var out bytes.Buffer
+	printProjectUsage(&out, &projectpkg.ProjectUsageResponse{
+		Destinations:           []*projectpkg.ProjectDestinationUsage{{Server: "https://kubernetes.default.svc", Name: "in-cluster", Namespace: "team-a", Applications: 3}},
+		Repositories:           []string{"https://github.com/argoproj/argocd-example-apps"},
+		UnusedDestinations:     []*v1alpha1.ApplicationDestination{{Name: "prod", Namespace: "*"}},
+		DeniedClusterResources: []*projectpkg.ProjectClusterResourceUsage{{Application: "guestbook", Group: "rbac.authorization.k8s.io", Kind: "ClusterRole", Name: "guestbook"}},
+	})
+	expectation := "SERVER                          NAME        NAMESPACE  APPLICATIONS\n" +
+		"https://kubernetes.default.svc  in-cluster  team-a     3\n" +
+		"\nRepositories:\n" +
+		"  https://github.com/argoproj/argocd-example-apps\n" +
+		"\nUnused Destinations:\n" +
+		"  prod,*\n" +
+		"\nCluster Resources Outside The Allow-List:\n" +
+		"APPLICATION  GROUP                      KIND         NAME\n" +
+		"guestbook    rbac.authorization.k8s.io  ClusterRole  guestbook\n"
+	assert.Equal(t, expectation, out.String())
+
+	out.Reset()
+	printProjectUsage(&out, &projectpkg.ProjectUsageResponse{})
+	assert.Equal(t, "SERVER  NAME  NAMESPACE  APPLICATIONS\nNo applications belong to this project\n\nRepositories:\n", out.String())
+}
+
+func TestValidateProjectApplications(t *testing.T) {
+	proj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-a"},
+		Spec: v1alpha1.AppProjectSpec{
+			SourceRepos:              []string{"https://github.com/argoproj/*"},
+			Destinations:             []v1alpha1.ApplicationDestination{{Server: "https://kubernetes.default.svc", Namespace: "team-a"}},
+			ClusterResourceWhitelist: []metav1.GroupKind{{Group: "", Kind: "Namespace"}},
+		},
+	}
+	clusters := []v1alpha1.Cluster{{Name: "in-cluster", Server: "https://kubernetes.default.svc"}}
+	newApp := func(name, repoURL, namespace string, resources ...v1alpha1.ResourceStatus) v1alpha1.Application {
+		return v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: v1alpha1.ApplicationSpec{
+				Project:     "team-a",
+				Source:      &v1alpha1.ApplicationSource{RepoURL: repoURL},
+				Destination: v1alpha1.ApplicationDestination{Name: "in-cluster", Namespace: namespace},
+			},
+			Status: v1alpha1.ApplicationStatus{Resources: resources},
+		}
+	}
+	apps := []v1alpha1.Application{
+		newApp("compliant", "https://github.com/argoproj/argocd-example-apps", "team-a",
+			v1alpha1.ResourceStatus{Kind: "Namespace", Name: "team-a"},
+			v1alpha1.ResourceStatus{Kind: "ConfigMap", Name: "cm", Namespace: "team-a"}),
+		newApp("other-repo", "https://gitlab.com/team-a/apps", "team-a"),
+		newApp("other-namespace", "https://github.com/argoproj/argocd-example-apps", "team-b",
+			v1alpha1.ResourceStatus{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole", Name: "admin"},
+			v1alpha1.ResourceStatus{Kind: "ConfigMap", Name: "cm", Namespace: "team-b"}),
+	}
+
+	violations, unvalidated, err := validateProjectApplications(proj, apps, clusters)
+	require.NoError(t, err)
+	assert.Empty(t, unvalidated)
+	assert.Equal(t, []projectViolation{
+		{Application: "other-repo", Type: "Source", Reason: "repository 'https://gitlab.com/team-a/apps' is not permitted"},
+		{Application: "other-namespace", Type: "Destination", Reason: "destination 'in-cluster,team-b' is not permitted"},
+		{Application: "other-namespace", Type: "Resource", Reason: "cluster resource ClusterRole/admin is not permitted"},
+		{Application: "other-namespace", Type: "Resource", Reason: "resource ConfigMap/cm in namespace 'team-b' is not permitted"},
+	}, violations)
+
+	t.Run("ClusterNotVisible", func(t *testing.T) {
+		// the cluster list is filtered by RBAC, so a missing cluster is not reported as a violation
+		violations, unvalidated, err := validateProjectApplications(proj, apps[1:], nil)
+		require.NoError(t, err)
+		assert.Equal(t, []projectViolation{
+			{Application: "other-repo", Type: "Source", Reason: "repository 'https://gitlab.com/team-a/apps' is not permitted"},
+		}, violations)
+		assert.Equal(t, []string{"other-repo", "other-namespace"}, unvalidated)
+	})
+}
+
+func TestPrintProjectViolations(t *testing.T) {
+	out := &bytes.Buffer{}
+	printProjectViolations(out, "team-a", 2, nil)
+	assert.Equal(t, "All 2 applications comply with the restrictions of project 'team-a'\n", out.String())
+
+	out.Reset()
+	printProjectViolations(out, "team-a", 2, []projectViolation{{Application: "guestbook", Type: "Source", Reason: "repository 'x' is not permitted"}})
+	assert.Equal(t, "APPLICATION  TYPE    REASON\nguestbook    Source  repository 'x' is not permitted\n", out.String())
+}
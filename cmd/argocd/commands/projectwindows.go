@@ -2,18 +2,26 @@ package commands
 
 import (
 	"fmt"
+	"io"
+	"io/fs"
 	"os"
+	"slices"
+	"sort"
 	"strconv"
 	"strings"
 	"text/tabwriter"
+	"time"
 
+	"github.com/robfig/cron/v3"
 	"github.com/spf13/cobra"
 
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/utils"
 	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	applicationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
 	projectpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/project"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo"
 	"github.com/argoproj/argo-cd/v3/util/errors"
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
 )
@@ -34,7 +42,10 @@ argocd proj windows add my-project \
 argocd proj windows delete <project-name> <window-id>
 
 #List project sync windows
-argocd proj windows list <project-name>`,
+argocd proj windows list <project-name>
+
+#Preview whether an application could be synced at a given time
+argocd proj windows preview <project-name> --app <app-name> --at 2025-12-31T20:00:00Z`,
 		Run: func(c *cobra.Command, args []string) {
 			c.HelpFunc()(c, args)
 			os.Exit(1)
@@ -45,6 +56,7 @@ argocd proj windows list <project-name>`,
 	roleCommand.AddCommand(NewProjectWindowsAddWindowCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectWindowsDeleteCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectWindowsListCommand(clientOpts))
+	roleCommand.AddCommand(NewProjectWindowsPreviewCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectWindowsUpdateCommand(clientOpts))
 	return roleCommand
 }
@@ -52,9 +64,10 @@ argocd proj windows list <project-name>`,
 // NewProjectWindowsDisableManualSyncCommand returns a new instance of an `argocd proj windows disable-manual-sync` command
 func NewProjectWindowsDisableManualSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command := &cobra.Command{
-		Use:   "disable-manual-sync PROJECT ID",
-		Short: "Disable manual sync for a sync window",
-		Long:  "Disable manual sync for a sync window. Requires ID which can be found by running \"argocd proj windows list PROJECT\"",
+		Use:               "disable-manual-sync PROJECT ID",
+		ValidArgsFunction: completeProjectNames(clientOpts, 1),
+		Short:             "Disable manual sync for a sync window",
+		Long:              "Disable manual sync for a sync window. Requires ID which can be found by running \"argocd proj windows list PROJECT\"",
 		Example: `
 #Disable manual sync for a sync window for the Project
 argocd proj windows disable-manual-sync PROJECT ID
@@ -95,9 +108,10 @@ argocd proj windows disable-manual-sync default 0`,
 // NewProjectWindowsEnableManualSyncCommand returns a new instance of an `argocd proj windows enable-manual-sync` command
 func NewProjectWindowsEnableManualSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command := &cobra.Command{
-		Use:   "enable-manual-sync PROJECT ID",
-		Short: "Enable manual sync for a sync window",
-		Long:  "Enable manual sync for a sync window. Requires ID which can be found by running \"argocd proj windows list PROJECT\"",
+		Use:               "enable-manual-sync PROJECT ID",
+		ValidArgsFunction: completeProjectNames(clientOpts, 1),
+		Short:             "Enable manual sync for a sync window",
+		Long:              "Enable manual sync for a sync window. Requires ID which can be found by running \"argocd proj windows list PROJECT\"",
 		Example: `
 #Enabling manual sync for a general case
 argocd proj windows enable-manual-sync PROJECT ID
@@ -153,8 +167,9 @@ func NewProjectWindowsAddWindowCommand(clientOpts *argocdclient.ClientOptions) *
 		description  string
 	)
 	command := &cobra.Command{
-		Use:   "add PROJECT",
-		Short: "Add a sync window to a project",
+		Use:               "add PROJECT",
+		ValidArgsFunction: completeProjectNames(clientOpts, 1),
+		Short:             "Add a sync window to a project",
 		Example: `
 #Add a 1 hour allow sync window
 argocd proj windows add PROJECT \
@@ -174,6 +189,14 @@ argocd proj windows add PROJECT \
     --clusters "prod,staging" \
     --manual-sync \
     --description "Ticket 123"
+
+#Add a nightly allow sync window evaluated in New York local time, following daylight saving changes
+argocd proj windows add PROJECT \
+    --kind allow \
+    --schedule "0 22 * * *" \
+    --duration 2h \
+    --applications "*" \
+    --time-zone "America/New_York"
 	`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
@@ -183,6 +206,8 @@ argocd proj windows add PROJECT \
 				os.Exit(1)
 			}
 			projName := args[0]
+			errors.CheckError(validateTimeZone(timeZone))
+
 			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
 			defer utilio.Close(conn)
 
@@ -203,7 +228,7 @@ argocd proj windows add PROJECT \
 	command.Flags().StringSliceVar(&namespaces, "namespaces", []string{}, "Namespaces that the schedule will be applied to. Comma separated, wildcards supported (e.g. --namespaces default,\\*-prod)")
 	command.Flags().StringSliceVar(&clusters, "clusters", []string{}, "Clusters that the schedule will be applied to. Comma separated, wildcards supported (e.g. --clusters prod,staging)")
 	command.Flags().BoolVar(&manualSync, "manual-sync", false, "Allow manual syncs for both deny and allow windows")
-	command.Flags().StringVar(&timeZone, "time-zone", "UTC", "Time zone of the sync window")
+	command.Flags().StringVar(&timeZone, "time-zone", "UTC", "Time zone of the sync window as an IANA time zone name. (e.g. --time-zone \"America/New_York\")")
 	command.Flags().BoolVar(&andOperator, "use-and-operator", false, "Use AND operator for matching applications, namespaces and clusters instead of the default OR operator")
 	command.Flags().StringVar(&description, "description", "", `Sync window description`)
 
@@ -213,8 +238,9 @@ argocd proj windows add PROJECT \
 // NewProjectWindowsDeleteCommand returns a new instance of an `argocd proj windows delete` command
 func NewProjectWindowsDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command := &cobra.Command{
-		Use:   "delete PROJECT ID",
-		Short: "Delete a sync window from a project. Requires ID which can be found by running \"argocd proj windows list PROJECT\"",
+		Use:               "delete PROJECT ID",
+		ValidArgsFunction: completeProjectNames(clientOpts, 1),
+		Short:             "Delete a sync window from a project. Requires ID which can be found by running \"argocd proj windows list PROJECT\"",
 		Example: `
 #Delete a sync window from a project (default) with ID 0
 argocd proj windows delete default 0
@@ -267,12 +293,17 @@ func NewProjectWindowsUpdateCommand(clientOpts *argocdclient.ClientOptions) *cob
 		description  string
 	)
 	command := &cobra.Command{
-		Use:   "update PROJECT ID",
-		Short: "Update a project sync window",
-		Long:  "Update a project sync window. Requires ID which can be found by running \"argocd proj windows list PROJECT\"",
+		Use:               "update PROJECT ID",
+		ValidArgsFunction: completeProjectNames(clientOpts, 1),
+		Short:             "Update a project sync window",
+		Long:              "Update a project sync window. Requires ID which can be found by running \"argocd proj windows list PROJECT\"",
 		Example: `# Change a sync window's schedule
 argocd proj windows update PROJECT ID \
     --schedule "0 20 * * *"
+
+# Evaluate a sync window's schedule in Berlin local time
+argocd proj windows update PROJECT ID \
+    --time-zone "Europe/Berlin"
 `,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
@@ -285,6 +316,7 @@ argocd proj windows update PROJECT ID \
 			projName := args[0]
 			id, err := strconv.Atoi(args[1])
 			errors.CheckError(err)
+			errors.CheckError(validateTimeZone(timeZone))
 
 			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
 			defer utilio.Close(conn)
@@ -310,17 +342,22 @@ argocd proj windows update PROJECT ID \
 	command.Flags().StringSliceVar(&applications, "applications", []string{}, "Applications that the schedule will be applied to. Comma separated, wildcards supported (e.g. --applications prod-\\*,website)")
 	command.Flags().StringSliceVar(&namespaces, "namespaces", []string{}, "Namespaces that the schedule will be applied to. Comma separated, wildcards supported (e.g. --namespaces default,\\*-prod)")
 	command.Flags().StringSliceVar(&clusters, "clusters", []string{}, "Clusters that the schedule will be applied to. Comma separated, wildcards supported (e.g. --clusters prod,staging)")
-	command.Flags().StringVar(&timeZone, "time-zone", "UTC", "Time zone of the sync window. (e.g. --time-zone \"America/New_York\")")
+	command.Flags().StringVar(&timeZone, "time-zone", "", "Time zone of the sync window as an IANA time zone name. The current time zone is kept if not specified. (e.g. --time-zone \"America/New_York\")")
 	command.Flags().StringVar(&description, "description", "", "Sync window description")
 	return command
 }
 
 // NewProjectWindowsListCommand returns a new instance of an `argocd proj windows list` command
 func NewProjectWindowsListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
-	var output string
+	var (
+		output string
+		local  bool
+		at     string
+	)
 	command := &cobra.Command{
-		Use:   "list PROJECT",
-		Short: "List project sync windows",
+		Use:               "list PROJECT",
+		ValidArgsFunction: completeProjectNames(clientOpts, 1),
+		Short:             "List project sync windows",
 		Example: `
 #List project windows
 argocd proj windows list PROJECT
@@ -329,7 +366,13 @@ argocd proj windows list PROJECT
 argocd proj windows list PROJECT -o yaml
 
 #List project windows info for a project name (test-project)
-argocd proj windows list test-project`,
+argocd proj windows list test-project
+
+#List project windows with the next activation times shown in the local time zone
+argocd proj windows list test-project --local
+
+#List project windows as they would be on the evening of New Year's Eve
+argocd proj windows list test-project --at 2025-12-31T20:00:00Z`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
@@ -337,54 +380,455 @@ argocd proj windows list test-project`,
 				c.HelpFunc()(c, args)
 				os.Exit(1)
 			}
+			now := time.Now()
+			if at != "" {
+				var err error
+				now, err = time.Parse(time.RFC3339, at)
+				if err != nil {
+					errors.Fatalf(errors.ErrorGeneric, "invalid --at timestamp '%s': must be in RFC 3339 format, e.g. 2025-12-31T20:00:00Z", at)
+				}
+			}
 			projName := args[0]
 			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
 			defer utilio.Close(conn)
 
 			proj, err := projIf.Get(ctx, &projectpkg.ProjectQuery{Name: projName})
 			errors.CheckError(err)
+			statuses := make([]*syncWindowStatus, 0, len(proj.Spec.SyncWindows))
+			for i, window := range proj.Spec.SyncWindows {
+				statuses = append(statuses, newSyncWindowStatus(i, window, now))
+			}
 			switch output {
 			case "yaml", "json":
-				err := PrintResourceList(proj.Spec.SyncWindows, output, false)
+				err := PrintResourceList(statuses, output, false)
 				errors.CheckError(err)
 			case "wide", "":
-				printSyncWindows(proj)
+				var loc *time.Location
+				if local {
+					loc = time.Local
+				}
+				printSyncWindows(os.Stdout, statuses, loc)
 			default:
 				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
 			}
 		},
 	}
 	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	command.Flags().BoolVar(&local, "local", false, "Show the next activation times in the local time zone instead of the time zone of each window")
+	command.Flags().StringVar(&at, "at", "", "Evaluate the windows at the given RFC 3339 timestamp instead of now, e.g. 2025-12-31T20:00:00Z")
 	return command
 }
 
-// Print table of sync window data
-func printSyncWindows(proj *v1alpha1.AppProject) {
-	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+// Print table of sync window data. Next activation and end times are shown in the given location,
+// or in the time zone of each window if loc is nil. Windows which cannot be evaluated are listed below the table.
+func printSyncWindows(out io.Writer, statuses []*syncWindowStatus, loc *time.Location) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
 	var fmtStr string
-	headers := []any{"ID", "STATUS", "KIND", "SCHEDULE", "DURATION", "APPLICATIONS", "NAMESPACES", "CLUSTERS", "MANUALSYNC", "TIMEZONE"}
+	headers := []any{"ID", "STATUS", "KIND", "SCHEDULE", "DURATION", "APPLICATIONS", "NAMESPACES", "CLUSTERS", "MANUALSYNC", "TIMEZONE", "USEANDOPERATOR", "NEXT ACTIVE", "ENDS AT"}
 	fmtStr = strings.Repeat("%s\t", len(headers)) + "\n"
 	fmt.Fprintf(w, fmtStr, headers...)
-	if proj.Spec.SyncWindows.HasWindows() {
-		for i, window := range proj.Spec.SyncWindows {
-			isActive, _ := window.Active()
-			vals := []any{
-				strconv.Itoa(i),
-				formatBoolOutput(isActive),
-				window.Kind,
-				window.Schedule,
-				window.Duration,
-				formatListOutput(window.Applications),
-				formatListOutput(window.Namespaces),
-				formatListOutput(window.Clusters),
-				formatBoolEnabledOutput(window.ManualSync),
-				window.TimeZone,
-				formatBoolEnabledOutput(window.UseAndOperator),
+	for _, status := range statuses {
+		window := status.SyncWindow
+		vals := []any{
+			strconv.Itoa(status.ID),
+			formatSyncWindowStatus(status),
+			window.Kind,
+			window.Schedule,
+			window.Duration,
+			formatListOutput(window.Applications),
+			formatListOutput(window.Namespaces),
+			formatListOutput(window.Clusters),
+			formatBoolEnabledOutput(window.ManualSync),
+			formatTimeZone(window.TimeZone),
+			formatBoolEnabledOutput(window.UseAndOperator),
+			formatWindowTime(status.NextActive, loc),
+			formatWindowTime(status.EndsAt, loc),
+		}
+		fmt.Fprintf(w, fmtStr, vals...)
+	}
+	_ = w.Flush()
+	for _, status := range statuses {
+		if status.Error != "" {
+			fmt.Fprintf(out, "\nWindow %d is invalid: %s\n", status.ID, status.Error)
+		}
+	}
+}
+
+// NewProjectWindowsPreviewCommand returns a new instance of an `argocd proj windows preview` command
+func NewProjectWindowsPreviewCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		output  string
+		at      string
+		appName string
+	)
+	command := &cobra.Command{
+		Use:               "preview PROJECT",
+		ValidArgsFunction: completeProjectNames(clientOpts, 1),
+		Short:             "Preview whether sync windows allow syncs at a given time",
+		Long:              "Preview whether the sync windows of a project allow automated and manual syncs at a given time, which windows match and when the outcome changes next. If an application is given, only the windows which match the application are evaluated.",
+		Example: `
+#Preview whether syncs are allowed now
+argocd proj windows preview PROJECT
+
+#Preview whether the guestbook application could be synced on the evening of New Year's Eve
+argocd proj windows preview PROJECT --app guestbook --at 2025-12-31T20:00:00Z
+
+#Preview the sync windows in json format
+argocd proj windows preview PROJECT --app guestbook -o json`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			now := time.Now()
+			if at != "" {
+				var err error
+				now, err = time.Parse(time.RFC3339, at)
+				if err != nil {
+					errors.Fatalf(errors.ErrorGeneric, "invalid --at timestamp '%s': must be in RFC 3339 format, e.g. 2025-12-31T20:00:00Z", at)
+				}
 			}
-			fmt.Fprintf(w, fmtStr, vals...)
+			projName := args[0]
+			acdClient := headless.NewClientOrDie(clientOpts, c)
+			conn, projIf := acdClient.NewProjectClientOrDie()
+			defer utilio.Close(conn)
+
+			proj, err := projIf.Get(ctx, &projectpkg.ProjectQuery{Name: projName})
+			errors.CheckError(err)
+			var app *v1alpha1.Application
+			if appName != "" {
+				appConn, appIf := acdClient.NewApplicationClientOrDie()
+				defer utilio.Close(appConn)
+				name, appNs := argo.ParseFromQualifiedName(appName, "")
+				app, err = appIf.Get(ctx, &applicationpkg.ApplicationQuery{Name: &name, AppNamespace: &appNs})
+				errors.CheckError(err)
+				if app.Spec.GetProject() != proj.Name {
+					errors.Fatalf(errors.ErrorGeneric, "application '%s' does not belong to project '%s'", appName, proj.Name)
+				}
+			}
+			preview, err := newSyncWindowsPreview(proj, app, now)
+			errors.CheckError(err)
+			switch output {
+			case "yaml", "json":
+				err := PrintResource(preview, output)
+				errors.CheckError(err)
+			case "wide", "":
+				printSyncWindowsPreview(os.Stdout, preview)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	command.Flags().StringVar(&at, "at", "", "Evaluate the windows at the given RFC 3339 timestamp instead of now, e.g. 2025-12-31T20:00:00Z")
+	command.Flags().StringVar(&appName, "app", "", "Only evaluate the windows which match the given application")
+	return command
+}
+
+// syncWindowsPreview is the outcome of evaluating the sync windows of a project at a given time
+type syncWindowsPreview struct {
+	Project              string                  `json:"project"`
+	Application          string                  `json:"application,omitempty"`
+	At                   time.Time               `json:"at"`
+	AutomatedSyncAllowed bool                    `json:"automatedSyncAllowed"`
+	ManualSyncAllowed    bool                    `json:"manualSyncAllowed"`
+	Windows              []*syncWindowStatus     `json:"windows"`
+	Transitions          []*syncWindowTransition `json:"transitions,omitempty"`
+}
+
+// syncWindowTransition is the next time a window opens or closes, together with the outcome for syncs afterwards
+type syncWindowTransition struct {
+	At                   time.Time `json:"at"`
+	WindowID             int       `json:"windowId"`
+	Active               bool      `json:"active"`
+	AutomatedSyncAllowed bool      `json:"automatedSyncAllowed"`
+	ManualSyncAllowed    bool      `json:"manualSyncAllowed"`
+}
+
+// newSyncWindowsPreview evaluates the windows of the project which match the application, or all windows of the
+// project if app is nil, at the given time. The upcoming transitions contain the next time each window opens or closes.
+func newSyncWindowsPreview(proj *v1alpha1.AppProject, app *v1alpha1.Application, at time.Time) (*syncWindowsPreview, error) {
+	preview := &syncWindowsPreview{Project: proj.Name, At: at, Windows: []*syncWindowStatus{}}
+	windows := &proj.Spec.SyncWindows
+	if app != nil {
+		preview.Application = app.QualifiedName()
+		windows = windows.Matches(app)
+	}
+	var err error
+	if preview.AutomatedSyncAllowed, err = windows.CanSyncAt(false, at); err != nil {
+		return nil, err
+	}
+	if preview.ManualSyncAllowed, err = windows.CanSyncAt(true, at); err != nil {
+		return nil, err
+	}
+	if !windows.HasWindows() {
+		return preview, nil
+	}
+	for _, window := range *windows {
+		status := newSyncWindowStatus(slices.Index(proj.Spec.SyncWindows, window), window, at)
+		preview.Windows = append(preview.Windows, status)
+		transition := &syncWindowTransition{WindowID: status.ID, Active: !status.Active}
+		switch {
+		case status.Active && status.EndsAt != nil:
+			transition.At = *status.EndsAt
+		case !status.Active && status.NextActive != nil:
+			transition.At = *status.NextActive
+		default:
+			continue
+		}
+		preview.Transitions = append(preview.Transitions, transition)
+	}
+	sort.SliceStable(preview.Transitions, func(i, j int) bool {
+		return preview.Transitions[i].At.Before(preview.Transitions[j].At)
+	})
+	for _, transition := range preview.Transitions {
+		// A window is only considered active after its start time, so evaluate just after the transition
+		after := transition.At.Add(time.Second)
+		if transition.AutomatedSyncAllowed, err = windows.CanSyncAt(false, after); err != nil {
+			return nil, err
+		}
+		if transition.ManualSyncAllowed, err = windows.CanSyncAt(true, after); err != nil {
+			return nil, err
 		}
 	}
+	return preview, nil
+}
+
+// printSyncWindowsPreview prints whether syncs are allowed, the evaluated windows and the upcoming transitions
+func printSyncWindowsPreview(out io.Writer, preview *syncWindowsPreview) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "Project:\t%s\n", preview.Project)
+	if preview.Application != "" {
+		fmt.Fprintf(w, "Application:\t%s\n", preview.Application)
+	}
+	fmt.Fprintf(w, "Evaluated At:\t%s\n", preview.At.Format(time.RFC3339))
+	fmt.Fprintf(w, "Automated Sync:\t%s\n", formatSyncAllowed(preview.AutomatedSyncAllowed))
+	fmt.Fprintf(w, "Manual Sync:\t%s\n", formatSyncAllowed(preview.ManualSyncAllowed))
 	_ = w.Flush()
+
+	if len(preview.Windows) == 0 {
+		fmt.Fprintln(out, "\nNo sync windows match")
+		return
+	}
+	fmt.Fprintf(out, "\nMatching Windows:\n")
+	printSyncWindows(out, preview.Windows, nil)
+
+	if len(preview.Transitions) > 0 {
+		fmt.Fprintf(out, "\nUpcoming Transitions:\n")
+		w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		fmt.Fprintf(w, "TIME\tWINDOW\tBECOMES\tAUTOMATED SYNC\tMANUAL SYNC\n")
+		for _, transition := range preview.Transitions {
+			fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\n",
+				formatWindowTime(&transition.At, preview.At.Location()),
+				transition.WindowID,
+				formatBoolOutput(transition.Active),
+				formatSyncAllowed(transition.AutomatedSyncAllowed),
+				formatSyncAllowed(transition.ManualSyncAllowed))
+		}
+		_ = w.Flush()
+	}
+}
+
+func formatSyncAllowed(allowed bool) string {
+	if allowed {
+		return "Allowed"
+	}
+	return "Denied"
+}
+
+// maxMergedWindowActivations bounds the number of overlapping activations that are merged to find the end of an
+// active window, for schedules that keep a window open indefinitely
+const maxMergedWindowActivations = 1000
+
+// syncWindowStatus is a sync window together with its state evaluated at a given time
+type syncWindowStatus struct {
+	ID int `json:"id"`
+	*v1alpha1.SyncWindow
+	Active bool `json:"active"`
+	// NextActive is the next time the window opens after the evaluation time, or after the current activation ends
+	NextActive *time.Time `json:"nextActive,omitempty"`
+	// EndsAt is the time the current activation ends, or the time the next activation ends if the window is inactive
+	EndsAt *time.Time `json:"endsAt,omitempty"`
+	Error  string     `json:"error,omitempty"`
+}
+
+// newSyncWindowStatus evaluates the window at the given time. Windows which cannot be evaluated, e.g. because of an
+// invalid schedule, are returned with the error set instead of the computed fields.
+func newSyncWindowStatus(id int, window *v1alpha1.SyncWindow, at time.Time) *syncWindowStatus {
+	status := &syncWindowStatus{ID: id, SyncWindow: window}
+	active, nextActive, endsAt, err := evaluateSyncWindow(window, at)
+	if err != nil {
+		status.Error = err.Error()
+		return status
+	}
+	status.Active = active
+	status.NextActive = &nextActive
+	if !endsAt.IsZero() {
+		status.EndsAt = &endsAt
+	}
+	return status
+}
+
+// evaluateSyncWindow returns whether the window is active at the given time, when it opens next and when the current,
+// or else the next, activation ends. The schedule is evaluated in the window's time zone so that activations follow
+// daylight saving changes. Activations which overlap are merged. The end is zero if it is too far away to be found.
+func evaluateSyncWindow(window *v1alpha1.SyncWindow, at time.Time) (bool, time.Time, time.Time, error) {
+	loc, err := time.LoadLocation(window.TimeZone)
+	if err != nil {
+		return false, time.Time{}, time.Time{}, fmt.Errorf("invalid time zone '%s': %w", window.TimeZone, err)
+	}
+	specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+	schedule, err := specParser.Parse(window.Schedule)
+	if err != nil {
+		return false, time.Time{}, time.Time{}, fmt.Errorf("cannot parse schedule '%s': %w", window.Schedule, err)
+	}
+	duration, err := time.ParseDuration(window.Duration)
+	if err != nil {
+		return false, time.Time{}, time.Time{}, fmt.Errorf("cannot parse duration '%s': %w", window.Duration, err)
+	}
+	at = at.In(loc)
+	start := schedule.Next(at.Add(-duration))
+	if start.After(at) {
+		return false, start, start.Add(duration), nil
+	}
+	end := start.Add(duration)
+	for range maxMergedWindowActivations {
+		next := schedule.Next(start)
+		if next.After(end) {
+			return true, next, end, nil
+		}
+		start, end = next, next.Add(duration)
+	}
+	return true, schedule.Next(end), time.Time{}, nil
+}
+
+func formatSyncWindowStatus(status *syncWindowStatus) string {
+	if status.Error != "" {
+		return "Invalid"
+	}
+	return formatBoolOutput(status.Active)
+}
+
+// formatWindowTime formats the time in the given location, or in its own location if loc is nil
+func formatWindowTime(t *time.Time, loc *time.Location) string {
+	if t == nil {
+		return "-"
+	}
+	if loc != nil {
+		return t.In(loc).Format("2006-01-02 15:04 MST")
+	}
+	return t.Format("2006-01-02 15:04 MST")
+}
+
+func formatTimeZone(tz string) string {
+	if tz == "" {
+		return "UTC"
+	}
+	return tz
+}
+
+// validateTimeZone returns an error if tz is not a known IANA time zone name. The error suggests
+// the closest matching names from the local time zone database, if any are found.
+func validateTimeZone(tz string) error {
+	if tz == "" {
+		return nil
+	}
+	if _, err := time.LoadLocation(tz); err == nil {
+		return nil
+	}
+	suggestions := suggestTimeZones(tz, timeZoneNames(), 3)
+	if len(suggestions) == 0 {
+		return fmt.Errorf("unknown time zone '%s'", tz)
+	}
+	return fmt.Errorf("unknown time zone '%s', did you mean: %s", tz, strings.Join(suggestions, ", "))
+}
+
+// timeZoneNames returns the names of the time zones in the first time zone database found on this host
+func timeZoneNames() []string {
+	sources := []string{"/usr/share/zoneinfo", "/usr/share/lib/zoneinfo", "/usr/lib/locale/TZ"}
+	if dir := os.Getenv("ZONEINFO"); dir != "" {
+		sources = append([]string{dir}, sources...)
+	}
+	for _, source := range sources {
+		var names []string
+		_ = fs.WalkDir(os.DirFS(source), ".", func(path string, d fs.DirEntry, err error) error {
+			if err != nil || path == "." {
+				return nil
+			}
+			// Skip the posix/right variants and files such as zone.tab or leapseconds
+			name := d.Name()
+			if name[0] < 'A' || name[0] > 'Z' || strings.Contains(name, ".") {
+				if d.IsDir() {
+					return fs.SkipDir
+				}
+				return nil
+			}
+			if !d.IsDir() {
+				names = append(names, path)
+			}
+			return nil
+		})
+		if len(names) > 0 {
+			return names
+		}
+	}
+	return nil
+}
+
+// suggestTimeZones returns at most limit names that closely match tz, ordered by similarity. A name
+// matches if either the full name or its last element (e.g. the city) is within a small edit distance.
+func suggestTimeZones(tz string, names []string, limit int) []string {
+	type candidate struct {
+		name     string
+		distance int
+	}
+	needle := strings.ToLower(strings.ReplaceAll(tz, " ", "_"))
+	maxDistance := max(2, len(needle)/3)
+	var candidates []candidate
+	for _, name := range names {
+		lower := strings.ToLower(name)
+		distance := levenshtein(needle, lower)
+		if i := strings.LastIndex(lower, "/"); i >= 0 {
+			distance = min(distance, levenshtein(needle, lower[i+1:]))
+		}
+		if distance <= maxDistance {
+			candidates = append(candidates, candidate{name: name, distance: distance})
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].distance != candidates[j].distance {
+			return candidates[i].distance < candidates[j].distance
+		}
+		return candidates[i].name < candidates[j].name
+	})
+	var suggestions []string
+	for i := 0; i < len(candidates) && i < limit; i++ {
+		suggestions = append(suggestions, candidates[i].name)
+	}
+	return suggestions
+}
+
+// levenshtein returns the edit distance between a and b
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
 }
 
 func formatListOutput(list []string) string {
@@ -0,0 +1,184 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestValidateTimeZone(t *testing.T) {
+	require.NoError(t, validateTimeZone(""))
+	require.NoError(t, validateTimeZone("UTC"))
+	require.NoError(t, validateTimeZone("America/New_York"))
+	require.ErrorContains(t, validateTimeZone("Mars/Olympus_Mons"), "unknown time zone 'Mars/Olympus_Mons'")
+}
+
+func TestSuggestTimeZones(t *testing.T) {
+	names := []string{"America/New_York", "America/Chicago", "Europe/Berlin", "Europe/Bern", "Asia/Tokyo"}
+
+	assert.Equal(t, []string{"America/New_York"}, suggestTimeZones("america/new_york", names, 3))
+	assert.Equal(t, []string{"America/New_York"}, suggestTimeZones("New York", names, 3))
+	assert.Equal(t, []string{"Europe/Berlin", "Europe/Bern"}, suggestTimeZones("Europe/Berln", names, 3))
+	assert.Equal(t, []string{"Europe/Berlin"}, suggestTimeZones("Europe/Berln", names, 1))
+	assert.Empty(t, suggestTimeZones("Mars/Olympus_Mons", names, 3))
+}
+
+func TestLevenshtein(t *testing.T) {
+	assert.Equal(t, 0, levenshtein("tokyo", "tokyo"))
+	assert.Equal(t, 1, levenshtein("tokio", "tokyo"))
+	assert.Equal(t, 3, levenshtein("", "abc"))
+	assert.Equal(t, 3, levenshtein("kitten", "sitting"))
+}
+
+func TestEvaluateSyncWindow(t *testing.T) {
+	// 2024-03-10 is the day daylight saving time starts in New York
+	now := time.Date(2024, time.March, 10, 12, 0, 0, 0, time.UTC)
+
+	t.Run("WindowTimeZone", func(t *testing.T) {
+		active, next, ends, err := evaluateSyncWindow(&v1alpha1.SyncWindow{Schedule: "0 22 * * *", Duration: "1h", TimeZone: "America/New_York"}, now)
+		require.NoError(t, err)
+		assert.False(t, active)
+		assert.Equal(t, "2024-03-10 22:00 EDT", next.Format("2006-01-02 15:04 MST"))
+		assert.Equal(t, time.Date(2024, time.March, 11, 2, 0, 0, 0, time.UTC), next.UTC())
+		assert.Equal(t, time.Date(2024, time.March, 11, 3, 0, 0, 0, time.UTC), ends.UTC())
+	})
+	t.Run("DefaultsToUTC", func(t *testing.T) {
+		_, next, _, err := evaluateSyncWindow(&v1alpha1.SyncWindow{Schedule: "0 22 * * *", Duration: "1h"}, now)
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2024, time.March, 10, 22, 0, 0, 0, time.UTC), next.UTC())
+	})
+	t.Run("Active", func(t *testing.T) {
+		active, next, ends, err := evaluateSyncWindow(&v1alpha1.SyncWindow{Schedule: "0 11 * * *", Duration: "2h"}, now)
+		require.NoError(t, err)
+		assert.True(t, active)
+		assert.Equal(t, time.Date(2024, time.March, 10, 13, 0, 0, 0, time.UTC), ends.UTC())
+		assert.Equal(t, time.Date(2024, time.March, 11, 11, 0, 0, 0, time.UTC), next.UTC())
+	})
+	t.Run("OverlappingActivations", func(t *testing.T) {
+		active, next, ends, err := evaluateSyncWindow(&v1alpha1.SyncWindow{Schedule: "0 * * * *", Duration: "90m"}, now)
+		require.NoError(t, err)
+		assert.True(t, active)
+		assert.True(t, ends.IsZero())
+		assert.True(t, next.After(now))
+	})
+	t.Run("InvalidSchedule", func(t *testing.T) {
+		_, _, _, err := evaluateSyncWindow(&v1alpha1.SyncWindow{Schedule: "* * *", Duration: "1h"}, now)
+		require.ErrorContains(t, err, "cannot parse schedule")
+	})
+	t.Run("InvalidDuration", func(t *testing.T) {
+		_, _, _, err := evaluateSyncWindow(&v1alpha1.SyncWindow{Schedule: "0 22 * * *", Duration: "forever"}, now)
+		require.ErrorContains(t, err, "cannot parse duration")
+	})
+}
+
+func TestPrintSyncWindows(t *testing.T) {
+	now := time.Date(2024, time.March, 10, 12, 0, 0, 0, time.UTC)
+	statuses := []*syncWindowStatus{
+		newSyncWindowStatus(0, &v1alpha1.SyncWindow{Kind: "deny", Schedule: "0 11 * * *", Duration: "2h"}, now),
+		newSyncWindowStatus(1, &v1alpha1.SyncWindow{Kind: "allow", Schedule: "bad", Duration: "1h"}, now),
+	}
+	var buf bytes.Buffer
+	printSyncWindows(&buf, statuses, time.UTC)
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 5)
+	header := strings.Fields(lines[0])
+	assert.Equal(t, []string{"NEXT", "ACTIVE", "ENDS", "AT"}, header[len(header)-4:])
+	assert.Equal(t, []string{"0", "Active", "deny"}, strings.Fields(lines[1])[:3])
+	assert.Contains(t, lines[1], "2024-03-11 11:00 UTC")
+	assert.Contains(t, lines[1], "2024-03-10 13:00 UTC")
+	assert.Equal(t, []string{"1", "Invalid", "allow"}, strings.Fields(lines[2])[:3])
+	assert.Contains(t, lines[4], "Window 1 is invalid: cannot parse schedule 'bad'")
+}
+
+func TestSyncWindowStatusJSON(t *testing.T) {
+	now := time.Date(2024, time.March, 10, 12, 0, 0, 0, time.UTC)
+	data, err := json.Marshal(newSyncWindowStatus(0, &v1alpha1.SyncWindow{Kind: "deny", Schedule: "0 11 * * *", Duration: "2h"}, now))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":0,"kind":"deny","schedule":"0 11 * * *","duration":"2h","active":true,"nextActive":"2024-03-11T11:00:00Z","endsAt":"2024-03-10T13:00:00Z"}`, string(data))
+}
+
+func TestNewSyncWindowsPreview(t *testing.T) {
+	// 2024-03-10 is the day daylight saving time starts in New York
+	now := time.Date(2024, time.March, 10, 12, 0, 0, 0, time.UTC)
+	proj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-project"},
+		Spec: v1alpha1.AppProjectSpec{SyncWindows: v1alpha1.SyncWindows{
+			{Kind: "deny", Schedule: "0 11 * * *", Duration: "2h", Applications: []string{"guestbook"}, ManualSync: true},
+			{Kind: "allow", Schedule: "0 22 * * *", Duration: "1h", Applications: []string{"other"}, TimeZone: "America/New_York"},
+		}},
+	}
+	app := &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook"},
+		Spec:       v1alpha1.ApplicationSpec{Project: "my-project"},
+	}
+
+	t.Run("Application", func(t *testing.T) {
+		preview, err := newSyncWindowsPreview(proj, app, now)
+		require.NoError(t, err)
+		assert.Equal(t, "guestbook", preview.Application)
+		assert.False(t, preview.AutomatedSyncAllowed)
+		assert.True(t, preview.ManualSyncAllowed)
+		require.Len(t, preview.Windows, 1)
+		assert.Equal(t, 0, preview.Windows[0].ID)
+		require.Len(t, preview.Transitions, 1)
+		assert.Equal(t, &syncWindowTransition{
+			At:                   time.Date(2024, time.March, 10, 13, 0, 0, 0, time.UTC),
+			WindowID:             0,
+			Active:               false,
+			AutomatedSyncAllowed: true,
+			ManualSyncAllowed:    true,
+		}, preview.Transitions[0])
+	})
+	t.Run("AllWindows", func(t *testing.T) {
+		preview, err := newSyncWindowsPreview(proj, nil, now)
+		require.NoError(t, err)
+		assert.False(t, preview.AutomatedSyncAllowed)
+		require.Len(t, preview.Windows, 2)
+		require.Len(t, preview.Transitions, 2)
+		assert.Equal(t, 0, preview.Transitions[0].WindowID)
+		assert.Equal(t, 1, preview.Transitions[1].WindowID)
+		assert.True(t, preview.Transitions[1].Active)
+		assert.Equal(t, time.Date(2024, time.March, 11, 2, 0, 0, 0, time.UTC), preview.Transitions[1].At.UTC())
+		assert.True(t, preview.Transitions[1].AutomatedSyncAllowed)
+	})
+	t.Run("NoMatchingWindows", func(t *testing.T) {
+		preview, err := newSyncWindowsPreview(proj, &v1alpha1.Application{ObjectMeta: metav1.ObjectMeta{Name: "unknown"}}, now)
+		require.NoError(t, err)
+		assert.True(t, preview.AutomatedSyncAllowed)
+		assert.True(t, preview.ManualSyncAllowed)
+		assert.Empty(t, preview.Windows)
+		assert.Empty(t, preview.Transitions)
+	})
+}
+
+func TestPrintSyncWindowsPreview(t *testing.T) {
+	now := time.Date(2024, time.March, 10, 12, 0, 0, 0, time.UTC)
+	proj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-project"},
+		Spec: v1alpha1.AppProjectSpec{SyncWindows: v1alpha1.SyncWindows{
+			{Kind: "deny", Schedule: "0 11 * * *", Duration: "2h"},
+		}},
+	}
+	preview, err := newSyncWindowsPreview(proj, nil, now)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	printSyncWindowsPreview(&buf, preview)
+	out := buf.String()
+	assert.Contains(t, out, "Automated Sync:  Denied")
+	assert.Contains(t, out, "Manual Sync:     Denied")
+	assert.Contains(t, out, "Matching Windows:")
+	assert.Contains(t, out, "2024-03-10 13:00 UTC  0       Inactive  Allowed         Allowed")
+
+	buf.Reset()
+	printSyncWindowsPreview(&buf, &syncWindowsPreview{Project: "my-project", At: now, AutomatedSyncAllowed: true, ManualSyncAllowed: true})
+	assert.Contains(t, buf.String(), "No sync windows match")
+}
@@ -1,14 +1,26 @@
 package commands
 
 import (
+	"context"
+	"encoding/csv"
 	stderrors "errors"
 	"fmt"
+	"io"
 	"os"
+	"path"
+	"slices"
 	"strconv"
+	"strings"
+	"sync"
 	"text/tabwriter"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/utils"
@@ -16,6 +28,7 @@ import (
 	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
 	repositorypkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/repository"
 	appsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	repoapiclient "github.com/argoproj/argo-cd/v3/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/v3/util/cli"
 	"github.com/argoproj/argo-cd/v3/util/errors"
 	"github.com/argoproj/argo-cd/v3/util/git"
@@ -49,13 +62,18 @@ argocd repo rm https://github.com/yourusername/your-repo.git
 	command.AddCommand(NewRepoAddCommand(clientOpts))
 	command.AddCommand(NewRepoGetCommand(clientOpts))
 	command.AddCommand(NewRepoListCommand(clientOpts))
+	command.AddCommand(NewRepoUpdateCommand(clientOpts))
 	command.AddCommand(NewRepoRemoveCommand(clientOpts))
 	return command
 }
 
 // NewRepoAddCommand returns a new instance of an `argocd repo add` command
 func NewRepoAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
-	var repoOpts cmdutil.RepoOptions
+	var (
+		repoOpts           cmdutil.RepoOptions
+		githubAppCredsFile string
+		dockerConfigPath   string
+	)
 
 	// For better readability and easier formatting
 	repoAddExamples := `  # Add a Git repository via SSH using a private key for authentication, ignoring the server's host key:
@@ -94,12 +112,18 @@ func NewRepoAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
   # Add a private HTTP OCI repository named 'stable'
   argocd repo add oci://helm-oci-registry.cn-zhangjiakou.cr.aliyuncs.com --type oci --name stable --username test --password test --insecure-oci-force-http
 
+  # Add a private OCI repository using the registry credentials of a docker config.json
+  argocd repo add oci://registry.example.com/org/manifests --type oci --name manifests --docker-config-path ~/.docker/config.json
+
   # Add a private Git repository on GitHub.com via GitHub App
   argocd repo add https://git.example.com/repos/repo --github-app-id 1 --github-app-installation-id 2 --github-app-private-key-path test.private-key.pem
 
   # Add a private Git repository on GitHub Enterprise via GitHub App
   argocd repo add https://ghe.example.com/repos/repo --github-app-id 1 --github-app-installation-id 2 --github-app-private-key-path test.private-key.pem --github-app-enterprise-base-url https://ghe.example.com/api/v3
 
+  # Add a private Git repository via GitHub App, reading the app id, installation id and private key from a file
+  argocd repo add https://git.example.com/repos/repo --github-app-creds-file github-app.yaml
+
   # Add a private Git repository on Google Cloud Sources via GCP service account credentials
   argocd repo add https://source.developers.google.com/p/my-google-cloud-project/r/my-repo --gcp-service-account-key-path service-account-key.json
 `
@@ -154,6 +178,20 @@ func NewRepoAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 				}
 			}
 
+			// Specifying github-app-creds-file is only valid for HTTPS repositories
+			if githubAppCredsFile != "" {
+				errors.CheckError(checkGitHubAppCredsFileConflicts(c.Flags()))
+				if !git.IsHTTPSURL(repoOpts.Repo.Repo) {
+					errors.CheckError(stderrors.New("--github-app-creds-file is only supported for HTTPS repositories"))
+				}
+				creds, err := cmdutil.ReadGitHubAppCredsFile(githubAppCredsFile)
+				errors.CheckError(err)
+				repoOpts.GithubAppId = creds.AppID
+				repoOpts.GithubAppInstallationId = creds.InstallationID
+				repoOpts.GitHubAppEnterpriseBaseURL = creds.EnterpriseBaseURL
+				repoOpts.Repo.GithubAppPrivateKey = creds.PrivateKey
+			}
+
 			// Specifying github-app-private-key-path is only valid for HTTPS repositories
 			if repoOpts.GithubAppPrivateKeyPath != "" {
 				if git.IsHTTPSURL(repoOpts.Repo.Repo) {
@@ -196,10 +234,28 @@ func NewRepoAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 				errors.Fatal(errors.ErrorGeneric, "Must specify --name for repos of type 'helm'")
 			}
 
+			if repoOpts.Repo.Type == "oci" {
+				errors.CheckError(cmdutil.ValidateOCIRepoURL(repoOpts.Repo.Repo))
+			}
+
 			if repoOpts.Repo.Type == "oci" && repoOpts.InsecureOCIForceHTTP {
 				repoOpts.Repo.InsecureOCIForceHttp = repoOpts.InsecureOCIForceHTTP
 			}
 
+			// Specifying docker-config-path is only valid for OCI registries
+			if dockerConfigPath != "" {
+				if repoOpts.Repo.Type != "oci" && !repoOpts.Repo.EnableOCI {
+					errors.CheckError(stderrors.New("--docker-config-path is only supported for repositories of type oci or helm repositories with --enable-oci"))
+				}
+				if c.Flags().Changed("username") || c.Flags().Changed("password") {
+					errors.CheckError(stderrors.New("--docker-config-path cannot be combined with --username or --password"))
+				}
+				username, password, err := cmdutil.ReadDockerConfigCredentials(dockerConfigPath, repoOpts.Repo.Repo)
+				errors.CheckError(err)
+				repoOpts.Repo.Username = username
+				repoOpts.Repo.Password = password
+			}
+
 			conn, repoIf := headless.NewClientOrDie(clientOpts, c).NewRepoClientOrDie()
 			defer utilio.Close(conn)
 
@@ -261,9 +317,221 @@ func NewRepoAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	}
 	command.Flags().BoolVar(&repoOpts.Upsert, "upsert", false, "Override an existing repository with the same name even if the spec differs")
 	cmdutil.AddRepoFlags(command, &repoOpts)
+	command.Flags().StringVar(&githubAppCredsFile, "github-app-creds-file", "", "JSON or YAML file with the appId, installationId, privateKey or privateKeyPath and optional enterpriseBaseUrl of the GitHub Application")
+	command.Flags().StringVar(&dockerConfigPath, "docker-config-path", "", "path to a docker config.json to read the registry username and password from (only valid for OCI repositories)")
+	return command
+}
+
+// githubAppFlags are the flags which are replaced by --github-app-creds-file
+var githubAppFlags = []string{"github-app-id", "github-app-installation-id", "github-app-private-key-path", "github-app-enterprise-base-url"}
+
+// checkGitHubAppCredsFileConflicts returns an error if one of the individual GitHub App flags is used together with
+// --github-app-creds-file
+func checkGitHubAppCredsFileConflicts(flags *pflag.FlagSet) error {
+	for _, name := range githubAppFlags {
+		if flags.Changed(name) {
+			return fmt.Errorf("--%s cannot be used with --github-app-creds-file", name)
+		}
+	}
+	return nil
+}
+
+// NewRepoUpdateCommand returns a new instance of an `argocd repo update` command
+func NewRepoUpdateCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		repoOpts cmdutil.RepoOptions
+		test     bool
+	)
+
+	// For better readability and easier formatting
+	repoUpdateExamples := `  # Change the password of a private Git repository
+  argocd repo update https://git.example.com/repos/repo --username git --password new-secret
+
+  # Stop verifying the server's TLS certificate, keeping all other settings
+  argocd repo update https://git.example.com/repos/repo --insecure-skip-server-verification
+
+  # Move a repository to another project after verifying that it can still be accessed
+  argocd repo update https://git.example.com/repos/repo --project my-project --test
+`
+
+	command := &cobra.Command{
+		Use:   "update REPOURL",
+		Short: "Update git, oci or helm repository connection parameters",
+		Long: `Update the connection parameters of a configured repository. Only the settings of the given flags are changed,
+the others, including the stored password, private keys, TLS client certificate, bearer token and GCP service account
+key, are kept.`,
+		Example: repoUpdateExamples,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+
+			conn, repoIf := headless.NewClientOrDie(clientOpts, c).NewRepoClientOrDie()
+			defer utilio.Close(conn)
+
+			existing, err := repoIf.Get(ctx, &repositorypkg.RepoQuery{Repo: args[0]})
+			if status.Code(err) == codes.NotFound && repoOpts.Upsert {
+				existing, err = nil, nil
+			}
+			errors.CheckError(err)
+
+			repo, updatedFields, err := applyRepoUpdateFlags(args[0], existing, &repoOpts, c.Flags())
+			errors.CheckError(err)
+
+			// A new username needs a password, as does a new repository with a username
+			if repo.Username != "" && repo.Password == "" && (existing == nil || slices.Contains(updatedFields, "username")) {
+				repo.Password = cli.PromptPassword(repo.Password)
+				updatedFields = append(updatedFields, "password")
+			}
+
+			err = cmdutil.ValidateBearerTokenAndPasswordCombo(repo.BearerToken, repo.Password)
+			errors.CheckError(err)
+			err = cmdutil.ValidateBearerTokenForGitOnly(repo.BearerToken, repo.Type)
+			errors.CheckError(err)
+			err = cmdutil.ValidateBearerTokenForHTTPSRepoOnly(repo.BearerToken, git.IsHTTPSURL(repo.Repo))
+			errors.CheckError(err)
+
+			if existing == nil {
+				if test {
+					_, err = repoIf.ValidateAccess(ctx, &repositorypkg.RepoAccessQuery{
+						Repo:                       repo.Repo,
+						Type:                       repo.Type,
+						Name:                       repo.Name,
+						Username:                   repo.Username,
+						Password:                   repo.Password,
+						BearerToken:                repo.BearerToken,
+						SshPrivateKey:              repo.SSHPrivateKey,
+						TlsClientCertData:          repo.TLSClientCertData,
+						TlsClientCertKey:           repo.TLSClientCertKey,
+						Insecure:                   repo.IsInsecure(),
+						EnableOci:                  repo.EnableOCI,
+						GithubAppPrivateKey:        repo.GithubAppPrivateKey,
+						GithubAppID:                repo.GithubAppId,
+						GithubAppInstallationID:    repo.GithubAppInstallationId,
+						GithubAppEnterpriseBaseUrl: repo.GitHubAppEnterpriseBaseURL,
+						Proxy:                      repo.Proxy,
+						Project:                    repo.Project,
+						GcpServiceAccountKey:       repo.GCPServiceAccountKey,
+						ForceHttpBasicAuth:         repo.ForceHttpBasicAuth,
+						UseAzureWorkloadIdentity:   repo.UseAzureWorkloadIdentity,
+						InsecureOciForceHttp:       repo.InsecureOCIForceHttp,
+					})
+					errors.CheckError(err)
+				}
+				createdRepo, err := repoIf.CreateRepository(ctx, &repositorypkg.RepoCreateRequest{Repo: repo})
+				errors.CheckError(err)
+				fmt.Printf("Repository '%s' added\n", createdRepo.Repo)
+				return
+			}
+			if len(updatedFields) == 0 {
+				errors.Fatal(errors.ErrorGeneric, "no repository settings to update were given")
+			}
+			// the server merges the updated fields into the stored repository, which keeps the secrets it never returns
+			updatedRepo, err := repoIf.UpdateRepository(ctx, &repositorypkg.RepoUpdateRequest{Repo: repo, UpdatedFields: updatedFields, Test: test})
+			errors.CheckError(err)
+			fmt.Printf("Repository '%s' updated\n", updatedRepo.Repo)
+		},
+	}
+	cmdutil.AddRepoFlags(command, &repoOpts)
+	command.Flags().BoolVar(&repoOpts.Upsert, "upsert", false, "Add the repository if it does not exist")
+	command.Flags().BoolVar(&test, "test", false, "Verify that the repository can be accessed with the new settings before updating it")
 	return command
 }
 
+// applyRepoUpdateFlags returns a copy of the existing repository, or a new one if existing is nil, with the settings
+// of the changed flags applied, and the JSON names of the fields which have been changed
+func applyRepoUpdateFlags(repoURL string, existing *appsv1.Repository, opts *cmdutil.RepoOptions, flags *pflag.FlagSet) (*appsv1.Repository, []string, error) {
+	repo := &appsv1.Repository{Repo: repoURL}
+	if existing != nil {
+		repo = existing.DeepCopy()
+		repo.ConnectionState = appsv1.ConnectionState{}
+		repo.InheritedCreds = false
+	}
+	var updatedFields []string
+	changed := func(name, field string) bool {
+		if !flags.Changed(name) {
+			return false
+		}
+		updatedFields = append(updatedFields, field)
+		return true
+	}
+	if changed("type", "type") || repo.Type == "" {
+		repo.Type = opts.Repo.Type
+	}
+
+	setString := func(name, field string, target *string, value string) {
+		if changed(name, field) {
+			*target = value
+		}
+	}
+	setString("name", "name", &repo.Name, opts.Repo.Name)
+	setString("project", "project", &repo.Project, opts.Repo.Project)
+	setString("username", "username", &repo.Username, opts.Repo.Username)
+	setString("password", "password", &repo.Password, opts.Repo.Password)
+	setString("bearer-token", "bearerToken", &repo.BearerToken, opts.Repo.BearerToken)
+	setString("proxy", "proxy", &repo.Proxy, opts.Proxy)
+	setString("no-proxy", "noProxy", &repo.NoProxy, opts.NoProxy)
+	setString("github-app-enterprise-base-url", "githubAppEnterpriseBaseUrl", &repo.GitHubAppEnterpriseBaseURL, opts.GitHubAppEnterpriseBaseURL)
+
+	setBool := func(name, field string, target *bool, value bool) {
+		if changed(name, field) {
+			*target = value
+		}
+	}
+	setBool("insecure-skip-server-verification", "insecure", &repo.Insecure, opts.InsecureSkipServerVerification)
+	setBool("insecure-ignore-host-key", "insecureIgnoreHostKey", &repo.InsecureIgnoreHostKey, opts.InsecureIgnoreHostKey)
+	setBool("enable-lfs", "enableLfs", &repo.EnableLFS, opts.EnableLfs)
+	setBool("enable-oci", "enableOCI", &repo.EnableOCI, opts.EnableOci)
+	setBool("insecure-oci-force-http", "insecureOCIForceHttp", &repo.InsecureOCIForceHttp, opts.InsecureOCIForceHTTP)
+	setBool("force-http-basic-auth", "forceHttpBasicAuth", &repo.ForceHttpBasicAuth, opts.ForceHttpBasicAuth)
+	setBool("use-azure-workload-identity", "useAzureWorkloadIdentity", &repo.UseAzureWorkloadIdentity, opts.UseAzureWorkloadIdentity)
+
+	if changed("github-app-id", "githubAppID") {
+		repo.GithubAppId = opts.GithubAppId
+	}
+	if changed("github-app-installation-id", "githubAppInstallationID") {
+		repo.GithubAppInstallationId = opts.GithubAppInstallationId
+	}
+
+	if flags.Changed("tls-client-cert-path") != flags.Changed("tls-client-cert-key-path") {
+		return nil, nil, stderrors.New("--tls-client-cert-path and --tls-client-cert-key-path must be specified together")
+	}
+	for _, f := range []struct {
+		name   string
+		field  string
+		path   string
+		target *string
+	}{
+		{"ssh-private-key-path", "sshPrivateKey", opts.SshPrivateKeyPath, &repo.SSHPrivateKey},
+		{"tls-client-cert-path", "tlsClientCertData", opts.TlsClientCertPath, &repo.TLSClientCertData},
+		{"tls-client-cert-key-path", "tlsClientCertKey", opts.TlsClientCertKeyPath, &repo.TLSClientCertKey},
+		{"github-app-private-key-path", "githubAppPrivateKey", opts.GithubAppPrivateKeyPath, &repo.GithubAppPrivateKey},
+		{"gcp-service-account-key-path", "gcpServiceAccountKey", opts.GCPServiceAccountKeyPath, &repo.GCPServiceAccountKey},
+	} {
+		if !changed(f.name, f.field) {
+			continue
+		}
+		data, err := os.ReadFile(f.path)
+		if err != nil {
+			return nil, nil, err
+		}
+		*f.target = string(data)
+	}
+
+	if repo.Type == "helm" && repo.Name == "" {
+		return nil, nil, stderrors.New("must specify --name for repos of type 'helm'")
+	}
+	if repo.Type == "oci" {
+		if err := cmdutil.ValidateOCIRepoURL(repo.Repo); err != nil {
+			return nil, nil, err
+		}
+	}
+	return repo, updatedFields, nil
+}
+
 // NewRepoRemoveCommand returns a new instance of an `argocd repo rm` command
 func NewRepoRemoveCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var project string
@@ -298,9 +566,13 @@ func NewRepoRemoveCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 }
 
 // Print table of repo info
-func printRepoTable(repos appsv1.Repositories) {
-	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	_, _ = fmt.Fprintf(w, "TYPE\tNAME\tREPO\tINSECURE\tOCI\tLFS\tCREDS\tSTATUS\tMESSAGE\tPROJECT\n")
+func printRepoTable(out io.Writer, repos appsv1.Repositories, lastChecked bool) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "TYPE\tNAME\tREPO\tINSECURE\tOCI\tLFS\tCREDS\tSTATUS\tMESSAGE\tPROJECT")
+	if lastChecked {
+		_, _ = fmt.Fprintf(w, "\tLAST CHECKED")
+	}
+	_, _ = fmt.Fprintln(w)
 	for _, r := range repos {
 		var hasCreds string
 		if r.InheritedCreds {
@@ -309,7 +581,15 @@ func printRepoTable(repos appsv1.Repositories) {
 			hasCreds = strconv.FormatBool(r.HasCredentials())
 		}
 
-		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%v\t%v\t%v\t%s\t%s\t%s\t%s\n", r.Type, r.Name, r.Repo, r.IsInsecure(), r.EnableOCI, r.EnableLFS, hasCreds, r.ConnectionState.Status, r.ConnectionState.Message, r.Project)
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%v\t%v\t%v\t%s\t%s\t%s\t%s", r.Type, r.Name, r.Repo, r.IsInsecure(), r.EnableOCI, r.EnableLFS, hasCreds, r.ConnectionState.Status, r.ConnectionState.Message, r.Project)
+		if lastChecked {
+			checkedAt := "-"
+			if r.ConnectionState.ModifiedAt != nil {
+				checkedAt = r.ConnectionState.ModifiedAt.Format(time.RFC3339)
+			}
+			_, _ = fmt.Fprintf(w, "\t%s", checkedAt)
+		}
+		_, _ = fmt.Fprintln(w)
 	}
 	_ = w.Flush()
 }
@@ -321,15 +601,74 @@ func printRepoUrls(repos appsv1.Repositories) {
 	}
 }
 
+// printRepoCSV prints the columns of the repository table as CSV
+func printRepoCSV(out io.Writer, repos appsv1.Repositories, lastChecked bool) error {
+	writer := csv.NewWriter(out)
+	header := []string{"type", "name", "repo", "insecure", "oci", "lfs", "creds", "status", "message", "project"}
+	if lastChecked {
+		header = append(header, "lastChecked")
+	}
+	_ = writer.Write(header)
+	for _, r := range repos {
+		hasCreds := strconv.FormatBool(r.HasCredentials())
+		if r.InheritedCreds {
+			hasCreds = "inherited"
+		}
+		row := []string{r.Type, r.Name, r.Repo, strconv.FormatBool(r.IsInsecure()), strconv.FormatBool(r.EnableOCI), strconv.FormatBool(r.EnableLFS), hasCreds, r.ConnectionState.Status, r.ConnectionState.Message, r.Project}
+		if lastChecked {
+			checkedAt := ""
+			if r.ConnectionState.ModifiedAt != nil {
+				checkedAt = r.ConnectionState.ModifiedAt.Format(time.RFC3339)
+			}
+			row = append(row, checkedAt)
+		}
+		_ = writer.Write(row)
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// filterRepositories returns the repositories which belong to one of the projects, if any are given, and which are
+// configured to skip TLS or host key verification if insecureOnly is set
+func filterRepositories(repos appsv1.Repositories, projects []string, insecureOnly bool) appsv1.Repositories {
+	return repos.Filter(func(r *appsv1.Repository) bool {
+		if len(projects) > 0 && !slices.Contains(projects, r.Project) {
+			return false
+		}
+		return !insecureOnly || r.IsInsecure()
+	})
+}
+
+// repoRefreshConcurrency is the maximum number of repositories whose connection is re-tested in parallel
+const repoRefreshConcurrency = 5
+
 // NewRepoListCommand returns a new instance of an `argocd repo list` command
 func NewRepoListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		output  string
-		refresh string
+		output         string
+		refresh        string
+		refreshTimeout time.Duration
+		repoURL        string
+		projects       []string
+		insecureOnly   bool
 	)
 	command := &cobra.Command{
 		Use:   "list",
 		Short: "List configured repositories",
+		Example: `  # List repositories and their cached connection state
+  argocd repo list
+
+  # List the URLs of the repositories of the team-a and team-b projects
+  argocd repo list --project team-a --project team-b -o name
+
+  # List the repositories which skip TLS or SSH host key verification as CSV
+  argocd repo list --insecure-only -o csv
+
+  # Re-test the connection to every repository before listing them
+  argocd repo list --refresh
+
+  # Re-test the connection to a single repository
+  argocd repo list --refresh --repo https://github.com/argoproj/argocd-example-apps`,
 		Run: func(c *cobra.Command, _ []string) {
 			ctx := c.Context()
 
@@ -344,37 +683,104 @@ func NewRepoListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 				err := stderrors.New("--refresh must be one of: 'hard'")
 				errors.CheckError(err)
 			}
-			repos, err := repoIf.ListRepositories(ctx, &repositorypkg.RepoQuery{ForceRefresh: forceRefresh})
+			repos, err := repoIf.ListRepositories(ctx, &repositorypkg.RepoQuery{Repo: repoURL})
 			errors.CheckError(err)
+			items := repos.Items
+			if repoURL != "" {
+				// older servers ignore the repo of the query
+				items = items.Filter(func(r *appsv1.Repository) bool {
+					return git.SameURL(r.Repo, repoURL)
+				})
+			}
+			items = filterRepositories(items, projects, insecureOnly)
+			if forceRefresh {
+				items = refreshRepositories(ctx, items, repoRefreshConcurrency, refreshTimeout, func(ctx context.Context, r *appsv1.Repository) (*appsv1.Repository, error) {
+					return repoIf.Get(ctx, &repositorypkg.RepoQuery{Repo: r.Repo, AppProject: r.Project, ForceRefresh: true})
+				})
+			}
 			switch output {
 			case "yaml", "json":
-				err := PrintResourceList(repos.Items, output, false)
+				err := PrintResourceList(items, output, false)
 				errors.CheckError(err)
-			case "url":
-				printRepoUrls(repos.Items)
+			case "url", "name":
+				printRepoUrls(items)
+			case "csv":
+				errors.CheckError(printRepoCSV(os.Stdout, items, forceRefresh))
 				// wide is the default
 			case "wide", "":
-				printRepoTable(repos.Items)
+				printRepoTable(os.Stdout, items, forceRefresh)
 			default:
 				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
 			}
 		},
 	}
-	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide|url")
-	command.Flags().StringVar(&refresh, "refresh", "", "Force a cache refresh on connection status , must be one of: 'hard'")
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide|csv|url|name")
+	command.Flags().StringVar(&refresh, "refresh", "", "Re-test the connection to each repository before listing them, must be one of: 'hard'")
+	command.Flags().Lookup("refresh").NoOptDefVal = "hard"
+	command.Flags().DurationVar(&refreshTimeout, "refresh-timeout", 30*time.Second, "Maximum time to wait for the connection test of a single repository")
+	command.Flags().StringVar(&repoURL, "repo", "", "Only list the repository with this URL")
+	command.Flags().StringArrayVar(&projects, "project", nil, "Only list the repositories of this project. This option may be specified repeatedly")
+	command.Flags().BoolVar(&insecureOnly, "insecure-only", false, "Only list the repositories which skip TLS or SSH host key verification")
+	errors.CheckError(command.RegisterFlagCompletionFunc("project", completeProjectNames(clientOpts, 0)))
 	return command
 }
 
+// refreshRepositories re-tests the connection of each repository with get, with at most concurrency tests in flight
+// and each bounded by timeout. A repository which could not be re-tested is reported with a failed connection state.
+func refreshRepositories(ctx context.Context, repos appsv1.Repositories, concurrency int, timeout time.Duration, get func(ctx context.Context, r *appsv1.Repository) (*appsv1.Repository, error)) appsv1.Repositories {
+	refreshed := make(appsv1.Repositories, len(repos))
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for i, r := range repos {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			getCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			updated, err := get(getCtx, r)
+			if err != nil {
+				now := metav1.Now()
+				failed := *r
+				failed.ConnectionState = appsv1.ConnectionState{
+					Status:     appsv1.ConnectionStatusFailed,
+					Message:    "Unable to refresh connection state: " + status.Convert(err).Message(),
+					ModifiedAt: &now,
+				}
+				updated = &failed
+			}
+			refreshed[i] = updated
+		}()
+	}
+	wg.Wait()
+	return refreshed
+}
+
 // NewRepoGetCommand returns a new instance of an `argocd repo get` command
 func NewRepoGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		output  string
-		refresh string
-		project string
+		output    string
+		refresh   string
+		project   string
+		revisions bool
+		filter    string
+		chart     string
+		limit     int
 	)
 	command := &cobra.Command{
 		Use:   "get REPO",
 		Short: "Get a configured repository by URL",
+		Example: `  # Get a repository and its connection state
+  argocd repo get https://github.com/argoproj/argocd-example-apps
+
+  # List the release branches and tags of a Git repository
+  argocd repo get https://github.com/argoproj/argocd-example-apps --revisions --filter 'release-*'
+
+  # List the versions of a chart in a Helm repository
+  argocd repo get https://charts.helm.sh/stable --revisions --chart redis`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
@@ -396,8 +802,50 @@ func NewRepoGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 				err := stderrors.New("--refresh must be one of: 'hard'")
 				errors.CheckError(err)
 			}
+			if !revisions && (filter != "" || chart != "") {
+				errors.Fatal(errors.ErrorGeneric, "--filter and --chart require --revisions")
+			}
+			if _, err := path.Match(filter, ""); err != nil {
+				errors.Fatalf(errors.ErrorGeneric, "invalid --filter pattern %q: %v", filter, err)
+			}
 			repo, err := repoIf.Get(ctx, &repositorypkg.RepoQuery{Repo: repoURL, ForceRefresh: forceRefresh, AppProject: project})
 			errors.CheckError(err)
+			if revisions {
+				query := &repositorypkg.RepoQuery{Repo: repo.Repo, AppProject: repo.Project}
+				var revs []repoRevision
+				switch repo.Type {
+				case "helm":
+					if chart == "" {
+						errors.Fatal(errors.ErrorGeneric, "--chart is required to list the revisions of a Helm repository")
+					}
+					charts, err := repoIf.GetHelmCharts(ctx, query)
+					errors.CheckError(err)
+					revs, err = getHelmChartRevisions(charts.Items, chart)
+					errors.CheckError(err)
+				case "oci":
+					refs, err := repoIf.ListOCITags(ctx, query)
+					errors.CheckError(err)
+					revs = getRefRevisions(refs)
+				default:
+					refs, err := repoIf.ListRefs(ctx, query)
+					errors.CheckError(err)
+					revs = getRefRevisions(refs)
+				}
+				list := filterRepoRevisions(revs, filter, limit)
+				switch output {
+				case "yaml", "json":
+					err := PrintResource(list, output)
+					errors.CheckError(err)
+				case "wide", "":
+					printRepoRevisions(os.Stdout, list.Revisions)
+					if list.Truncated {
+						log.Warnf("showing %d of %d revisions, use --limit or --filter to see more", len(list.Revisions), list.Total)
+					}
+				default:
+					errors.CheckError(fmt.Errorf("unknown output format for --revisions: %s", output))
+				}
+				return
+			}
 			switch output {
 			case "yaml", "json":
 				err := PrintResource(repo, output)
@@ -406,7 +854,7 @@ func NewRepoGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 				fmt.Println(repo.Repo)
 				// wide is the default
 			case "wide", "":
-				printRepoTable(appsv1.Repositories{repo})
+				printRepoTable(os.Stdout, appsv1.Repositories{repo}, false)
 			default:
 				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
 			}
@@ -416,5 +864,90 @@ func NewRepoGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command.Flags().StringVar(&project, "project", "", "project of the repository")
 	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide|url")
 	command.Flags().StringVar(&refresh, "refresh", "", "Force a cache refresh on connection status , must be one of: 'hard'")
+	command.Flags().BoolVar(&revisions, "revisions", false, "List the branches and tags of the repository, or the versions of a chart of a Helm repository")
+	command.Flags().StringVar(&filter, "filter", "", "Only list the revisions whose name matches this glob pattern (e.g. 'release-*')")
+	command.Flags().StringVar(&chart, "chart", "", "Chart whose versions are listed, required for Helm repositories")
+	command.Flags().IntVar(&limit, "limit", 100, "Maximum number of revisions to list, 0 lists all")
 	return command
 }
+
+// repoRevision is a branch, tag or chart version of a repository
+type repoRevision struct {
+	Name      string `json:"name"`
+	Type      string `json:"type"`
+	TargetSHA string `json:"targetSHA,omitempty"`
+}
+
+// repoRevisionList is the output of `argocd repo get --revisions` in json or yaml format
+type repoRevisionList struct {
+	Revisions []repoRevision `json:"revisions"`
+	Total     int            `json:"total"`
+	Truncated bool           `json:"truncated"`
+}
+
+// getRefRevisions returns the branches followed by the tags, each sorted by name, with the commit SHA they point to
+// when the repo server reported it
+func getRefRevisions(refs *repoapiclient.Refs) []repoRevision {
+	branches := getNamedRevisions(refs.Branches, refs.BranchSHAs, "branch")
+	tags := getNamedRevisions(refs.Tags, refs.TagSHAs, "tag")
+	return append(branches, tags...)
+}
+
+// getNamedRevisions pairs the names with the SHAs at the same index and sorts them by name
+func getNamedRevisions(names []string, shas []string, revisionType string) []repoRevision {
+	revs := make([]repoRevision, 0, len(names))
+	for i, name := range names {
+		rev := repoRevision{Name: name, Type: revisionType}
+		if i < len(shas) {
+			rev.TargetSHA = shas[i]
+		}
+		revs = append(revs, rev)
+	}
+	slices.SortFunc(revs, func(a, b repoRevision) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return revs
+}
+
+// getHelmChartRevisions returns the versions of a chart in the order reported by the Helm repository
+func getHelmChartRevisions(charts []*repoapiclient.HelmChart, chart string) ([]repoRevision, error) {
+	for _, c := range charts {
+		if c.Name == chart {
+			revs := make([]repoRevision, 0, len(c.Versions))
+			for _, version := range c.Versions {
+				revs = append(revs, repoRevision{Name: version, Type: "chart-version"})
+			}
+			return revs, nil
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "chart '%s' not found in repository", chart)
+}
+
+// filterRepoRevisions returns the revisions matching the glob pattern, truncated to limit unless it is 0
+func filterRepoRevisions(revs []repoRevision, filter string, limit int) repoRevisionList {
+	list := repoRevisionList{Revisions: []repoRevision{}}
+	for _, rev := range revs {
+		if filter != "" {
+			if ok, _ := path.Match(filter, rev.Name); !ok {
+				continue
+			}
+		}
+		list.Total++
+		if limit > 0 && len(list.Revisions) >= limit {
+			list.Truncated = true
+			continue
+		}
+		list.Revisions = append(list.Revisions, rev)
+	}
+	return list
+}
+
+// printRepoRevisions prints the revisions of a repository as a table
+func printRepoRevisions(out io.Writer, revs []repoRevision) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "NAME\tTYPE\tTARGET SHA\n")
+	for _, rev := range revs {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", rev.Name, rev.Type, strWithDefault(rev.TargetSHA, "-"))
+	}
+	_ = w.Flush()
+}
@@ -0,0 +1,260 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmdutil "github.com/argoproj/argo-cd/v3/cmd/util"
+	appsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	repoapiclient "github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+)
+
+func Test_refreshRepositories(t *testing.T) {
+	checkedAt := metav1.NewTime(time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC))
+	repos := appsv1.Repositories{
+		{Repo: "https://github.com/argoproj/one", ConnectionState: appsv1.ConnectionState{Status: appsv1.ConnectionStatusFailed}},
+		{Repo: "https://github.com/argoproj/two", Project: "my-project"},
+		{Repo: "https://github.com/argoproj/slow"},
+	}
+	var inFlight, maxInFlight atomic.Int32
+	refreshed := refreshRepositories(t.Context(), repos, 2, 50*time.Millisecond, func(ctx context.Context, r *appsv1.Repository) (*appsv1.Repository, error) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			current := maxInFlight.Load()
+			if n <= current || maxInFlight.CompareAndSwap(current, n) {
+				break
+			}
+		}
+		switch r.Repo {
+		case "https://github.com/argoproj/two":
+			return nil, status.Error(codes.PermissionDenied, "permission denied")
+		case "https://github.com/argoproj/slow":
+			<-ctx.Done()
+			return nil, status.FromContextError(ctx.Err()).Err()
+		}
+		updated := *r
+		updated.ConnectionState = appsv1.ConnectionState{Status: appsv1.ConnectionStatusSuccessful, ModifiedAt: &checkedAt}
+		return &updated, nil
+	})
+
+	assert.LessOrEqual(t, maxInFlight.Load(), int32(2))
+	require.Len(t, refreshed, 3)
+	assert.Equal(t, appsv1.ConnectionState{Status: appsv1.ConnectionStatusSuccessful, ModifiedAt: &checkedAt}, refreshed[0].ConnectionState)
+
+	assert.Equal(t, "my-project", refreshed[1].Project)
+	assert.Equal(t, appsv1.ConnectionStatusFailed, refreshed[1].ConnectionState.Status)
+	assert.Equal(t, "Unable to refresh connection state: permission denied", refreshed[1].ConnectionState.Message)
+	assert.NotNil(t, refreshed[1].ConnectionState.ModifiedAt)
+	assert.Empty(t, repos[1].ConnectionState.Status, "listed repositories must not be modified")
+
+	assert.Equal(t, appsv1.ConnectionStatusFailed, refreshed[2].ConnectionState.Status)
+	assert.Equal(t, "Unable to refresh connection state: context deadline exceeded", refreshed[2].ConnectionState.Message)
+}
+
+func Test_printRepoTable(t *testing.T) {
+	checkedAt := metav1.NewTime(time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC))
+	repos := appsv1.Repositories{
+		{Type: "git", Repo: "https://github.com/argoproj/one", ConnectionState: appsv1.ConnectionState{Status: appsv1.ConnectionStatusSuccessful, ModifiedAt: &checkedAt}},
+		{Type: "git", Repo: "https://github.com/argoproj/two", ConnectionState: appsv1.ConnectionState{Status: appsv1.ConnectionStatusFailed, Message: "auth failed"}},
+	}
+
+	var buf bytes.Buffer
+	printRepoTable(&buf, repos, false)
+	assert.Equal(t, "TYPE  NAME  REPO                             INSECURE  OCI    LFS    CREDS  STATUS      MESSAGE      PROJECT\n", strings.SplitAfter(buf.String(), "\n")[0])
+	assert.NotContains(t, buf.String(), "LAST CHECKED")
+
+	buf.Reset()
+	printRepoTable(&buf, repos, true)
+	assert.Contains(t, buf.String(), "PROJECT  LAST CHECKED\n")
+	assert.Contains(t, buf.String(), "2024-03-01T10:00:00Z\n")
+	assert.Contains(t, buf.String(), "auth failed           -\n")
+}
+
+func Test_printRepoCSV(t *testing.T) {
+	checkedAt := metav1.NewTime(time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC))
+	repos := appsv1.Repositories{
+		{Type: "git", Repo: "https://github.com/argoproj/one", Project: "team-a", ConnectionState: appsv1.ConnectionState{Status: appsv1.ConnectionStatusSuccessful, ModifiedAt: &checkedAt}},
+		{Type: "git", Repo: "https://github.com/argoproj/two", Insecure: true, ConnectionState: appsv1.ConnectionState{Status: appsv1.ConnectionStatusFailed, Message: "auth failed, retrying"}},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, printRepoCSV(&buf, repos, true))
+	assert.Equal(t, `type,name,repo,insecure,oci,lfs,creds,status,message,project,lastChecked
+git,,https://github.com/argoproj/one,false,false,false,false,Successful,,team-a,2024-03-01T10:00:00Z
+git,,https://github.com/argoproj/two,true,false,false,false,Failed,"auth failed, retrying",,
+`, buf.String())
+}
+
+func Test_filterRepositories(t *testing.T) {
+	repos := appsv1.Repositories{
+		{Repo: "https://github.com/argoproj/global"},
+		{Repo: "https://github.com/argoproj/team-a", Project: "team-a"},
+		{Repo: "https://github.com/argoproj/team-b", Project: "team-b", InsecureIgnoreHostKey: true},
+		{Repo: "https://github.com/argoproj/insecure", Insecure: true},
+	}
+	urls := func(repos appsv1.Repositories) []string {
+		var res []string
+		for _, r := range repos {
+			res = append(res, r.Repo)
+		}
+		return res
+	}
+
+	assert.Len(t, filterRepositories(repos, nil, false), 4)
+	assert.Equal(t, []string{"https://github.com/argoproj/team-a", "https://github.com/argoproj/team-b"}, urls(filterRepositories(repos, []string{"team-a", "team-b"}, false)))
+	assert.Equal(t, []string{"https://github.com/argoproj/team-b", "https://github.com/argoproj/insecure"}, urls(filterRepositories(repos, nil, true)))
+	assert.Equal(t, []string{"https://github.com/argoproj/team-b"}, urls(filterRepositories(repos, []string{"team-b"}, true)))
+	assert.Empty(t, filterRepositories(repos, []string{"team-c"}, false))
+}
+
+func Test_getRefRevisions(t *testing.T) {
+	revs := getRefRevisions(&repoapiclient.Refs{
+		Branches:   []string{"main", "feature"},
+		BranchSHAs: []string{"a67038ae2e9cb9b9b16423702f98b41e36601001", "9d921f65f3c5373b682e2eb4b37afba6592e8f8b"},
+		Tags:       []string{"v1.1.0", "v1.0.0"},
+		TagSHAs:    []string{"c4b2a1e0f3d5b6a7c8d9e0f1a2b3c4d5e6f7a8b9", "f1e2d3c4b5a6978877665544332211ffeeddccbb"},
+	})
+	assert.Equal(t, []repoRevision{
+		{Name: "feature", Type: "branch", TargetSHA: "9d921f65f3c5373b682e2eb4b37afba6592e8f8b"},
+		{Name: "main", Type: "branch", TargetSHA: "a67038ae2e9cb9b9b16423702f98b41e36601001"},
+		{Name: "v1.0.0", Type: "tag", TargetSHA: "f1e2d3c4b5a6978877665544332211ffeeddccbb"},
+		{Name: "v1.1.0", Type: "tag", TargetSHA: "c4b2a1e0f3d5b6a7c8d9e0f1a2b3c4d5e6f7a8b9"},
+	}, revs)
+
+	// OCI tags and older repo servers come without SHAs
+	revs = getRefRevisions(&repoapiclient.Refs{Tags: []string{"1.0.0"}})
+	assert.Equal(t, []repoRevision{{Name: "1.0.0", Type: "tag"}}, revs)
+}
+
+func Test_getHelmChartRevisions(t *testing.T) {
+	charts := []*repoapiclient.HelmChart{
+		{Name: "redis", Versions: []string{"2.0.0", "1.0.0"}},
+		{Name: "nginx", Versions: []string{"3.0.0"}},
+	}
+	revs, err := getHelmChartRevisions(charts, "redis")
+	require.NoError(t, err)
+	assert.Equal(t, []repoRevision{{Name: "2.0.0", Type: "chart-version"}, {Name: "1.0.0", Type: "chart-version"}}, revs)
+
+	_, err = getHelmChartRevisions(charts, "postgres")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func Test_filterRepoRevisions(t *testing.T) {
+	revs := []repoRevision{
+		{Name: "main", Type: "branch"},
+		{Name: "release-1.0", Type: "branch"},
+		{Name: "release-2.0", Type: "branch"},
+		{Name: "v1.0.0", Type: "tag"},
+	}
+
+	list := filterRepoRevisions(revs, "", 100)
+	assert.Equal(t, repoRevisionList{Revisions: revs, Total: 4}, list)
+
+	list = filterRepoRevisions(revs, "release-*", 100)
+	assert.Equal(t, repoRevisionList{Revisions: revs[1:3], Total: 2}, list)
+
+	list = filterRepoRevisions(revs, "", 2)
+	assert.Equal(t, repoRevisionList{Revisions: revs[:2], Total: 4, Truncated: true}, list)
+
+	list = filterRepoRevisions(revs, "", 0)
+	assert.Len(t, list.Revisions, 4)
+	assert.False(t, list.Truncated)
+
+	list = filterRepoRevisions(revs, "nothing-*", 100)
+	assert.Empty(t, list.Revisions)
+	assert.NotNil(t, list.Revisions)
+}
+
+func Test_printRepoRevisions(t *testing.T) {
+	var buf bytes.Buffer
+	printRepoRevisions(&buf, []repoRevision{{Name: "main", Type: "branch", TargetSHA: "a67038ae2e9cb9b9b16423702f98b41e36601001"}, {Name: "1.0.0", Type: "chart-version"}})
+	assert.Equal(t, `NAME   TYPE           TARGET SHA
+main   branch         a67038ae2e9cb9b9b16423702f98b41e36601001
+1.0.0  chart-version  -
+`, buf.String())
+}
+
+func Test_applyRepoUpdateFlags(t *testing.T) {
+	newFlags := func(t *testing.T, args ...string) (*cmdutil.RepoOptions, *pflag.FlagSet) {
+		t.Helper()
+		var opts cmdutil.RepoOptions
+		command := &cobra.Command{}
+		cmdutil.AddRepoFlags(command, &opts)
+		require.NoError(t, command.Flags().Parse(args))
+		return &opts, command.Flags()
+	}
+	existing := &appsv1.Repository{
+		Repo:            "https://github.com/argoproj/argo-cd",
+		Type:            "git",
+		Name:            "argo-cd",
+		Username:        "git",
+		Project:         "default",
+		Proxy:           "http://proxy:3128",
+		ConnectionState: appsv1.ConnectionState{Status: appsv1.ConnectionStatusSuccessful},
+	}
+
+	t.Run("Patch", func(t *testing.T) {
+		opts, flags := newFlags(t, "--insecure-skip-server-verification", "--password", "secret")
+		repo, updatedFields, err := applyRepoUpdateFlags(existing.Repo, existing, opts, flags)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"password", "insecure"}, updatedFields)
+		assert.True(t, repo.Insecure)
+		assert.Equal(t, "secret", repo.Password)
+		assert.Equal(t, "git", repo.Type)
+		assert.Equal(t, "argo-cd", repo.Name)
+		assert.Equal(t, "git", repo.Username)
+		assert.Equal(t, "default", repo.Project)
+		assert.Equal(t, "http://proxy:3128", repo.Proxy)
+		assert.Empty(t, repo.ConnectionState.Status)
+		assert.False(t, existing.Insecure)
+	})
+	t.Run("ClearValue", func(t *testing.T) {
+		opts, flags := newFlags(t, "--proxy", "")
+		repo, updatedFields, err := applyRepoUpdateFlags(existing.Repo, existing, opts, flags)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"proxy"}, updatedFields)
+		assert.Empty(t, repo.Proxy)
+	})
+	t.Run("New", func(t *testing.T) {
+		opts, flags := newFlags(t, "--type", "helm", "--name", "stable")
+		repo, _, err := applyRepoUpdateFlags("https://charts.example.com", nil, opts, flags)
+		require.NoError(t, err)
+		assert.Equal(t, &appsv1.Repository{Repo: "https://charts.example.com", Type: "helm", Name: "stable"}, repo)
+	})
+	t.Run("PrivateKeys", func(t *testing.T) {
+		sshRepo := &appsv1.Repository{Repo: "git@github.com:argoproj/argo-cd.git", Type: "git"}
+		opts, flags := newFlags(t, "--enable-lfs")
+		repo, updatedFields, err := applyRepoUpdateFlags(sshRepo.Repo, sshRepo, opts, flags)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"enableLfs"}, updatedFields)
+		assert.True(t, repo.EnableLFS)
+
+		keyFile := filepath.Join(t.TempDir(), "id_rsa")
+		require.NoError(t, os.WriteFile(keyFile, []byte("private key"), 0o600))
+		opts, flags = newFlags(t, "--ssh-private-key-path", keyFile)
+		repo, updatedFields, err = applyRepoUpdateFlags(sshRepo.Repo, sshRepo, opts, flags)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"sshPrivateKey"}, updatedFields)
+		assert.Equal(t, "private key", repo.SSHPrivateKey)
+	})
+	t.Run("InvalidOCIURL", func(t *testing.T) {
+		opts, flags := newFlags(t, "--type", "oci")
+		_, _, err := applyRepoUpdateFlags(existing.Repo, existing, opts, flags)
+		require.ErrorContains(t, err, "must start with oci://")
+	})
+}
@@ -3,11 +3,15 @@ package commands
 import (
 	stderrors "errors"
 	"fmt"
+	"io"
 	"os"
+	"strings"
 	"text/tabwriter"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/utils"
@@ -15,6 +19,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/common"
 	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
 	repocredspkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/repocreds"
+	repositorypkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/repository"
 	appsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/cli"
 	"github.com/argoproj/argo-cd/v3/util/errors"
@@ -45,6 +50,7 @@ func NewRepoCredsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 	}
 
 	command.AddCommand(NewRepoCredsAddCommand(clientOpts))
+	command.AddCommand(NewRepoCredsGetCommand(clientOpts))
 	command.AddCommand(NewRepoCredsListCommand(clientOpts))
 	command.AddCommand(NewRepoCredsRemoveCommand(clientOpts))
 	return command
@@ -59,6 +65,7 @@ func NewRepoCredsAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comma
 		tlsClientCertPath        string
 		tlsClientCertKeyPath     string
 		githubAppPrivateKeyPath  string
+		githubAppCredsFile       string
 		gcpServiceAccountKeyPath string
 	)
 
@@ -78,6 +85,9 @@ func NewRepoCredsAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comma
   # Add credentials with GitHub App authentication to use for all repositories under https://ghe.example.com/repos
   argocd repocreds add https://ghe.example.com/repos/ --github-app-id 1 --github-app-installation-id 2 --github-app-private-key-path test.private-key.pem --github-app-enterprise-base-url https://ghe.example.com/api/v3
 
+  # Add credentials with GitHub App authentication, reading the app id, installation id and private key from a file
+  argocd repocreds add https://github.com/repos/ --github-app-creds-file github-app.yaml
+
   # Add credentials with helm oci registry so that these oci registry urls do not need to be added as repos individually.
   argocd repocreds add localhost:5000/myrepo --enable-oci --type helm 
 
@@ -134,6 +144,20 @@ func NewRepoCredsAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comma
 				}
 			}
 
+			// Specifying github-app-creds-file is only valid for HTTPS repositories
+			if githubAppCredsFile != "" {
+				errors.CheckError(checkGitHubAppCredsFileConflicts(c.Flags()))
+				if !git.IsHTTPSURL(repo.URL) {
+					errors.CheckError(stderrors.New("--github-app-creds-file is only supported for HTTPS repositories"))
+				}
+				creds, err := cmdutil.ReadGitHubAppCredsFile(githubAppCredsFile)
+				errors.CheckError(err)
+				repo.GithubAppId = creds.AppID
+				repo.GithubAppInstallationId = creds.InstallationID
+				repo.GitHubAppEnterpriseBaseURL = creds.EnterpriseBaseURL
+				repo.GithubAppPrivateKey = creds.PrivateKey
+			}
+
 			// Specifying github-app-private-key-path is only valid for HTTPS repositories
 			if githubAppPrivateKeyPath != "" {
 				if git.IsHTTPSURL(repo.URL) {
@@ -194,6 +218,7 @@ func NewRepoCredsAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comma
 	command.Flags().Int64Var(&repo.GithubAppInstallationId, "github-app-installation-id", 0, "installation id of the GitHub Application")
 	command.Flags().StringVar(&githubAppPrivateKeyPath, "github-app-private-key-path", "", "private key of the GitHub Application")
 	command.Flags().StringVar(&repo.GitHubAppEnterpriseBaseURL, "github-app-enterprise-base-url", "", "base url to use when using GitHub Enterprise (e.g. https://ghe.example.com/api/v3")
+	command.Flags().StringVar(&githubAppCredsFile, "github-app-creds-file", "", "JSON or YAML file with the appId, installationId, privateKey or privateKeyPath and optional enterpriseBaseUrl of the GitHub Application")
 	command.Flags().BoolVar(&upsert, "upsert", false, "Override an existing repository with the same name even if the spec differs")
 	command.Flags().BoolVar(&repo.EnableOCI, "enable-oci", false, "Specifies whether helm-oci support should be enabled for this repo")
 	command.Flags().StringVar(&repo.Type, "type", common.DefaultRepoType, "type of the repository, \"git\" or \"helm\"")
@@ -204,6 +229,159 @@ func NewRepoCredsAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comma
 	return command
 }
 
+// NewRepoCredsGetCommand returns a new instance of an `argocd repocreds get` command
+func NewRepoCredsGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		output  string
+		testURL string
+	)
+	command := &cobra.Command{
+		Use:   "get CREDSURL",
+		Short: "Get repository credentials",
+		Example: templates.Examples(`
+			# Get the credentials for the repositories with URL https://git.example.com/repos
+			argocd repocreds get https://git.example.com/repos/
+
+			# Check that the credentials can be used to access a repository
+			argocd repocreds get https://git.example.com/repos/ --test https://git.example.com/repos/my-app
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			acdClient := headless.NewClientOrDie(clientOpts, c)
+			conn, repoCredsIf := acdClient.NewRepoCredsClientOrDie()
+			defer utilio.Close(conn)
+
+			credsList, err := repoCredsIf.ListRepositoryCredentials(ctx, &repocredspkg.RepoCredsQuery{})
+			errors.CheckError(err)
+			creds, err := findRepoCreds(credsList.Items, args[0])
+			errors.CheckError(err)
+
+			var testResult *repoCredsTestResult
+			if testURL != "" {
+				if !strings.HasPrefix(git.NormalizeGitURL(testURL), git.NormalizeGitURL(creds.URL)) {
+					errors.Fatalf(errors.ErrorGeneric, "repository %s does not match the credentials URL %s", testURL, creds.URL)
+				}
+				if effective := getEffectiveRepoCreds(credsList.Items, testURL); effective != nil && effective.URL != creds.URL {
+					log.Warnf("repository %s uses the more specific credentials %s, which are tested instead", testURL, effective.URL)
+				}
+				repoConn, repoIf := acdClient.NewRepoClientOrDie()
+				defer utilio.Close(repoConn)
+				// no credentials are sent, so the server uses the credentials matching the repository
+				_, err := repoIf.ValidateAccess(ctx, &repositorypkg.RepoAccessQuery{
+					Repo:                 testURL,
+					Type:                 creds.Type,
+					EnableOci:            creds.EnableOCI,
+					Proxy:                creds.Proxy,
+					InsecureOciForceHttp: creds.InsecureOCIForceHttp,
+				})
+				testResult = &repoCredsTestResult{URL: testURL, Status: appsv1.ConnectionStatusSuccessful}
+				if err != nil {
+					testResult.Status = appsv1.ConnectionStatusFailed
+					testResult.Message = status.Convert(err).Message()
+				}
+			}
+
+			switch output {
+			case "yaml", "json":
+				err := PrintResource(repoCredsWithTest{RepoCreds: creds, Test: testResult}, output)
+				errors.CheckError(err)
+			case "wide", "":
+				printRepoCredsDetails(os.Stdout, creds, testResult)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+			if testResult != nil && testResult.Status != appsv1.ConnectionStatusSuccessful {
+				os.Exit(1)
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	command.Flags().StringVar(&testURL, "test", "", "Test the credentials by connecting to this repository URL, which must match the credentials URL")
+	return command
+}
+
+// repoCredsWithTest is the output of `argocd repocreds get` in json or yaml format
+type repoCredsWithTest struct {
+	*appsv1.RepoCreds
+	Test *repoCredsTestResult `json:"test,omitempty"`
+}
+
+// repoCredsTestResult is the outcome of testing repository credentials against a repository
+type repoCredsTestResult struct {
+	URL     string                  `json:"url"`
+	Status  appsv1.ConnectionStatus `json:"status"`
+	Message string                  `json:"message,omitempty"`
+}
+
+// findRepoCreds returns the credentials with the given URL, ignoring differences of the URL normalization
+func findRepoCreds(credsList []appsv1.RepoCreds, credsURL string) (*appsv1.RepoCreds, error) {
+	for i := range credsList {
+		if git.NormalizeGitURL(credsList[i].URL) == git.NormalizeGitURL(credsURL) {
+			return &credsList[i], nil
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "repository credentials for '%s' not found", credsURL)
+}
+
+// getEffectiveRepoCreds returns the credentials used for a repository, which are the ones with the longest URL
+// prefix of the repository URL
+func getEffectiveRepoCreds(credsList []appsv1.RepoCreds, repoURL string) *appsv1.RepoCreds {
+	var effective *appsv1.RepoCreds
+	normalized := git.NormalizeGitURL(repoURL)
+	for i := range credsList {
+		credsURL := git.NormalizeGitURL(credsList[i].URL)
+		if strings.HasPrefix(normalized, credsURL) && (effective == nil || len(credsURL) > len(git.NormalizeGitURL(effective.URL))) {
+			effective = &credsList[i]
+		}
+	}
+	return effective
+}
+
+// getRepoCredsAuthType returns how the credentials authenticate, as far as it can be told without their secrets
+func getRepoCredsAuthType(creds *appsv1.RepoCreds) string {
+	switch {
+	case creds.GithubAppId != 0:
+		return "github-app"
+	case creds.UseAzureWorkloadIdentity:
+		return "azure-workload-identity"
+	case creds.Username != "":
+		return "username/password"
+	}
+	if ok, _ := git.IsSSHURL(creds.URL); ok {
+		return "ssh"
+	}
+	return "unknown"
+}
+
+// printRepoCredsDetails prints the repository credentials, whose secrets were removed by the server
+func printRepoCredsDetails(out io.Writer, creds *appsv1.RepoCreds, testResult *repoCredsTestResult) {
+	_, _ = fmt.Fprintf(out, "URL pattern:               %s\n", creds.URL)
+	_, _ = fmt.Fprintf(out, "Type:                      %s\n", strWithDefault(creds.Type, common.DefaultRepoType))
+	_, _ = fmt.Fprintf(out, "Auth type:                 %s\n", getRepoCredsAuthType(creds))
+	_, _ = fmt.Fprintf(out, "Username:                  %s\n", strWithDefault(creds.Username, "-"))
+	_, _ = fmt.Fprintf(out, "Force HTTP basic auth:     %v\n", creds.ForceHttpBasicAuth)
+	_, _ = fmt.Fprintf(out, "OCI:                       %v\n", creds.EnableOCI)
+	_, _ = fmt.Fprintf(out, "Insecure OCI force HTTP:   %v\n", creds.InsecureOCIForceHttp)
+	_, _ = fmt.Fprintf(out, "Proxy:                     %s\n", strWithDefault(creds.Proxy, "-"))
+	_, _ = fmt.Fprintf(out, "No proxy:                  %s\n", strWithDefault(creds.NoProxy, "-"))
+	if creds.GithubAppId != 0 {
+		_, _ = fmt.Fprintf(out, "GitHub App ID:             %d\n", creds.GithubAppId)
+		_, _ = fmt.Fprintf(out, "GitHub App installation:   %d\n", creds.GithubAppInstallationId)
+		_, _ = fmt.Fprintf(out, "GitHub Enterprise URL:     %s\n", strWithDefault(creds.GitHubAppEnterpriseBaseURL, "-"))
+	}
+	if testResult != nil {
+		_, _ = fmt.Fprintf(out, "Connection test:           %s (%s)\n", testResult.Status, testResult.URL)
+		if testResult.Message != "" {
+			_, _ = fmt.Fprintf(out, "Connection test message:   %s\n", testResult.Message)
+		}
+	}
+}
+
 // NewRepoCredsRemoveCommand returns a new instance of an `argocd repocreds rm` command
 func NewRepoCredsRemoveCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command := &cobra.Command{
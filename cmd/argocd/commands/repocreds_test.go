@@ -0,0 +1,68 @@
+package commands
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	appsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func Test_findRepoCreds(t *testing.T) {
+	credsList := []appsv1.RepoCreds{
+		{URL: "https://github.com/argoproj/"},
+		{URL: "git@github.com:argoproj"},
+	}
+
+	creds, err := findRepoCreds(credsList, "https://github.com/argoproj/")
+	require.NoError(t, err)
+	assert.Equal(t, "https://github.com/argoproj/", creds.URL)
+
+	creds, err = findRepoCreds(credsList, "git@github.com:argoproj")
+	require.NoError(t, err)
+	assert.Equal(t, "git@github.com:argoproj", creds.URL)
+
+	_, err = findRepoCreds(credsList, "https://gitlab.com/argoproj/")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.ErrorContains(t, err, "repository credentials for 'https://gitlab.com/argoproj/' not found")
+}
+
+func Test_getEffectiveRepoCreds(t *testing.T) {
+	credsList := []appsv1.RepoCreds{
+		{URL: "https://github.com/"},
+		{URL: "https://github.com/argoproj/"},
+	}
+
+	assert.Equal(t, "https://github.com/argoproj/", getEffectiveRepoCreds(credsList, "https://github.com/argoproj/argo-cd").URL)
+	assert.Equal(t, "https://github.com/", getEffectiveRepoCreds(credsList, "https://github.com/other/repo").URL)
+	assert.Nil(t, getEffectiveRepoCreds(credsList, "https://gitlab.com/argoproj/argo-cd"))
+}
+
+func Test_getRepoCredsAuthType(t *testing.T) {
+	assert.Equal(t, "github-app", getRepoCredsAuthType(&appsv1.RepoCreds{URL: "https://github.com/argoproj/", GithubAppId: 1}))
+	assert.Equal(t, "azure-workload-identity", getRepoCredsAuthType(&appsv1.RepoCreds{URL: "https://dev.azure.com/org/", UseAzureWorkloadIdentity: true}))
+	assert.Equal(t, "username/password", getRepoCredsAuthType(&appsv1.RepoCreds{URL: "https://github.com/argoproj/", Username: "git"}))
+	assert.Equal(t, "ssh", getRepoCredsAuthType(&appsv1.RepoCreds{URL: "git@github.com:argoproj"}))
+	assert.Equal(t, "unknown", getRepoCredsAuthType(&appsv1.RepoCreds{URL: "https://github.com/argoproj/"}))
+}
+
+func Test_printRepoCredsDetails(t *testing.T) {
+	var buf bytes.Buffer
+	printRepoCredsDetails(&buf, &appsv1.RepoCreds{
+		URL:                     "https://github.com/argoproj/",
+		GithubAppId:             1,
+		GithubAppInstallationId: 2,
+	}, &repoCredsTestResult{URL: "https://github.com/argoproj/argo-cd", Status: appsv1.ConnectionStatusFailed, Message: "authentication required"})
+	out := buf.String()
+	assert.Contains(t, out, "URL pattern:               https://github.com/argoproj/\n")
+	assert.Contains(t, out, "Type:                      git\n")
+	assert.Contains(t, out, "Auth type:                 github-app\n")
+	assert.Contains(t, out, "GitHub App installation:   2\n")
+	assert.Contains(t, out, "GitHub Enterprise URL:     -\n")
+	assert.Contains(t, out, "Connection test:           Failed (https://github.com/argoproj/argo-cd)\n")
+	assert.Contains(t, out, "Connection test message:   authentication required\n")
+}
@@ -9,6 +9,7 @@ import (
 	"k8s.io/client-go/tools/clientcmd"
 
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/admin"
+	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/initialize"
 	cmdutil "github.com/argoproj/argo-cd/v3/cmd/util"
 	"github.com/argoproj/argo-cd/v3/common"
@@ -32,8 +33,9 @@ func initConfig() {
 // NewCommand returns a new instance of an argocd command
 func NewCommand() *cobra.Command {
 	var (
-		clientOpts argocdclient.ClientOptions
-		pathOpts   = clientcmd.NewDefaultPathOptions()
+		clientOpts    argocdclient.ClientOptions
+		pathOpts      = clientcmd.NewDefaultPathOptions()
+		verboseErrors bool
 	)
 
 	command := &cobra.Command{
@@ -42,6 +44,9 @@ func NewCommand() *cobra.Command {
 		Run: func(c *cobra.Command, args []string) {
 			c.HelpFunc()(c, args)
 		},
+		PersistentPreRun: func(c *cobra.Command, _ []string) {
+			c.SetContext(errors.WithVerboseErrors(c.Context(), verboseErrors))
+		},
 		DisableAutoGenTag: true,
 		SilenceUsage:      true,
 	}
@@ -90,8 +95,12 @@ func NewCommand() *cobra.Command {
 	command.PersistentFlags().StringVar(&clientOpts.RedisName, "redis-name", env.StringFromEnv(common.EnvRedisName, common.DefaultRedisName), fmt.Sprintf("Name of the Redis deployment; set this or the %s environment variable when the Redis's name label differs from the default, for example when installing via the Helm chart", common.EnvRedisName))
 	command.PersistentFlags().StringVar(&clientOpts.RepoServerName, "repo-server-name", env.StringFromEnv(common.EnvRepoServerName, common.DefaultRepoServerName), fmt.Sprintf("Name of the Argo CD Repo server; set this or the %s environment variable when the server's name label differs from the default, for example when installing via the Helm chart", common.EnvRepoServerName))
 	command.PersistentFlags().StringVar(&clientOpts.RedisCompression, "redis-compress", env.StringFromEnv("REDIS_COMPRESSION", string(cache.RedisCompressionGZip)), "Enable this if the application controller is configured with redis compression enabled. (possible values: gzip, none)")
+	command.PersistentFlags().StringVar(&clientOpts.RedisMode, "redis", headless.RedisModeAuto, fmt.Sprintf("How the core mode caches application state. '%s' port-forwards to the Argo CD Redis and falls back to an in-memory cache if it cannot be reached, '%s' always uses an in-memory cache. The in-memory cache does not contain the state computed by the application controller, such as resource trees", headless.RedisModeAuto, headless.RedisModeDisabled))
 	command.PersistentFlags().BoolVar(&clientOpts.PromptsEnabled, "prompts-enabled", localconfig.GetPromptsEnabled(true), "Force optional interactive prompts to be enabled or disabled, overriding local configuration. If not specified, the local configuration value will be used, which is false by default.")
 
+	command.PersistentFlags().BoolVar(&verboseErrors, "verbose-errors", env.ParseBoolFromEnv(common.EnvVerboseErrors, false), fmt.Sprintf("Print the full details of failed API requests, such as the gRPC status details and request ID; set this or the %s environment variable", common.EnvVerboseErrors))
+	command.PersistentFlags().BoolVar(&clientOpts.NoVersionWarning, "no-version-warning", config.GetBoolFlag("no-version-warning"), "Do not warn when the versions of the CLI and the Argo CD server differ by more than the supported skew")
+
 	clientOpts.KubeOverrides = &clientcmd.ConfigOverrides{}
 	command.PersistentFlags().StringVar(&clientOpts.KubeOverrides.CurrentContext, "kube-context", "", "Directs the command to the given kube-context")
 
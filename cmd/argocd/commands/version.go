@@ -19,9 +19,10 @@ import (
 // NewVersionCmd returns a new `version` command to be used as a sub-command to root
 func NewVersionCmd(clientOpts *argocdclient.ClientOptions, serverVersion *version.VersionMessage) *cobra.Command {
 	var (
-		short  bool
-		client bool
-		output string
+		short          bool
+		client         bool
+		output         string
+		failOnMismatch bool
 	)
 
 	versionCmd := cobra.Command{
@@ -38,11 +39,15 @@ func NewVersionCmd(clientOpts *argocdclient.ClientOptions, serverVersion *versio
 
   # Print only client and server core version strings in YAML format
   argocd version --short -o yaml
+
+  # Fail if the versions of client and server differ by more than the supported skew
+  argocd version --fail-on-mismatch
 `,
 		Run: func(cmd *cobra.Command, _ []string) {
 			ctx := cmd.Context()
 
 			cv := common.GetVersion()
+			compatible := true
 			switch output {
 			case "yaml", "json":
 				v := make(map[string]any)
@@ -66,6 +71,8 @@ func NewVersionCmd(clientOpts *argocdclient.ClientOptions, serverVersion *versio
 					} else {
 						v["server"] = sv
 					}
+					compatible, _ = argocdclient.CheckVersionCompatibility(cv.Version, sv.Version)
+					v["compatible"] = compatible
 				}
 
 				err := PrintResource(v, output)
@@ -80,15 +87,23 @@ func NewVersionCmd(clientOpts *argocdclient.ClientOptions, serverVersion *versio
 						sv = serverVersion
 					}
 					fmt.Fprint(cmd.OutOrStdout(), printServerVersion(sv, short || (output == "short")))
+					var msg string
+					if compatible, msg = argocdclient.CheckVersionCompatibility(cv.Version, sv.Version); !compatible {
+						fmt.Fprintf(cmd.ErrOrStderr(), "WARNING: %s\n", msg)
+					}
 				}
 			default:
 				log.Fatalf("unknown output format: %s", output)
 			}
+			if failOnMismatch && !compatible {
+				errors.Fatal(errors.ErrorGeneric, "client and server versions are not compatible")
+			}
 		},
 	}
 	versionCmd.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide|short")
 	versionCmd.Flags().BoolVar(&short, "short", false, "print just the version number")
 	versionCmd.Flags().BoolVar(&client, "client", false, "client version only (no server required)")
+	versionCmd.Flags().BoolVar(&failOnMismatch, "fail-on-mismatch", false, "exit with a non-zero code if the client and server versions differ by more than the supported skew")
 	return &versionCmd
 }
 
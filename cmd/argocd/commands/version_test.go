@@ -29,3 +29,16 @@ func TestShortVersion(t *testing.T) {
 	require.NoError(t, cmd.Execute(), "Failed to execute short version command")
 	assert.Equal(t, "argocd: v99.99.99+unknown\nargocd-server: v99.99.99+unknown\n", buf.String())
 }
+
+func TestVersionSkewWarning(t *testing.T) {
+	serverVersion := &version.VersionMessage{Version: "v99.1.0+unknown"}
+	out := new(bytes.Buffer)
+	errOut := new(bytes.Buffer)
+	cmd := NewVersionCmd(&argocdclient.ClientOptions{}, serverVersion)
+	cmd.SetOut(out)
+	cmd.SetErr(errOut)
+	cmd.SetArgs([]string{"argocd", "version", "--short"})
+	require.NoError(t, cmd.Execute(), "Failed to execute version command")
+	assert.Equal(t, "argocd: v99.99.99+unknown\nargocd-server: v99.1.0+unknown\n", out.String())
+	assert.Contains(t, errOut.String(), "WARNING: client version v99.99.99+unknown and server version v99.1.0+unknown differ by 98 minor versions")
+}
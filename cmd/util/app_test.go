@@ -260,6 +260,12 @@ func Test_setAppSpecOptions(t *testing.T) {
 		require.NoError(t, f.SetFlag("sync-policy", "none"))
 		assert.Nil(t, f.spec.SyncPolicy)
 	})
+	t.Run("OCIRepo", func(t *testing.T) {
+		require.NoError(t, f.SetFlag("repo", "oci://registry.example.com/org/manifests"))
+		require.NoError(t, f.SetFlag("path", "."))
+		assert.Equal(t, "oci://registry.example.com/org/manifests", f.spec.Source.RepoURL)
+		assert.True(t, f.spec.Source.IsOCI())
+	})
 	t.Run("SyncOptions", func(t *testing.T) {
 		require.NoError(t, f.SetFlag("sync-option", "a=1"))
 		assert.True(t, f.spec.SyncPolicy.SyncOptions.HasOption("a=1"))
@@ -1,13 +1,19 @@
 package util
 
 import (
+	"bytes"
 	"context"
+	"crypto/x509"
+	"encoding/pem"
 	stderrors "errors"
 	"fmt"
 	"os"
+	"path/filepath"
+	"slices"
 	"sort"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/spf13/cobra"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -149,6 +155,140 @@ func GetKubePublicEndpoint(client kubernetes.Interface) (string, []byte, error)
 	return endpoint, certificateAuthorityData, nil
 }
 
+// ReadBearerTokenFile reads a bearer token from a file, ignoring surrounding whitespace
+func ReadBearerTokenFile(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read bearer token file: %w", err)
+	}
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", fmt.Errorf("bearer token file %s is empty", path)
+	}
+	return token, nil
+}
+
+// ReadCADataFile reads PEM encoded certificate authority data from a file and verifies that it
+// contains at least one valid certificate
+func ReadCADataFile(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA data file: %w", err)
+	}
+	rest, count := data, 0
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			return nil, fmt.Errorf("CA data file %s contains an unexpected PEM block of type %s", path, block.Type)
+		}
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			return nil, fmt.Errorf("CA data file %s contains an invalid certificate: %w", path, err)
+		}
+		count++
+	}
+	if count == 0 {
+		return nil, fmt.Errorf("CA data file %s does not contain any PEM encoded certificate", path)
+	}
+	return data, nil
+}
+
+// SetStaticCredentials sets the server, bearer token and CA data of a rest config, which may have
+// been loaded from a kubeconfig context. An error is returned if the settings of the context
+// conflict with the given ones, instead of silently preferring one of them.
+func SetStaticCredentials(conf *rest.Config, server string, bearerToken string, caData []byte) error {
+	if server != "" {
+		if conf.Host != "" && strings.TrimSuffix(conf.Host, "/") != strings.TrimSuffix(server, "/") {
+			return fmt.Errorf("server %s conflicts with server %s of the kubeconfig context", server, conf.Host)
+		}
+		conf.Host = server
+	}
+	if conf.Host == "" {
+		return stderrors.New("server is required when no kubeconfig context is given")
+	}
+	if len(conf.CertData) > 0 || conf.CertFile != "" {
+		return stderrors.New("bearer token conflicts with the client certificate of the kubeconfig context")
+	}
+	if conf.BearerToken != "" && conf.BearerToken != bearerToken {
+		return stderrors.New("bearer token conflicts with the bearer token of the kubeconfig context")
+	}
+	conf.BearerToken = bearerToken
+	conf.BearerTokenFile = ""
+	if len(caData) > 0 {
+		if conf.Insecure {
+			return stderrors.New("CA data conflicts with insecure-skip-tls-verify of the kubeconfig context")
+		}
+		contextCAData := conf.CAData
+		if len(contextCAData) == 0 && conf.CAFile != "" {
+			data, err := os.ReadFile(conf.CAFile)
+			if err != nil {
+				return fmt.Errorf("failed to read CA file of the kubeconfig context: %w", err)
+			}
+			contextCAData = data
+		}
+		if len(contextCAData) > 0 && !bytes.Equal(bytes.TrimSpace(contextCAData), bytes.TrimSpace(caData)) {
+			return stderrors.New("CA data conflicts with the certificate authority of the kubeconfig context")
+		}
+		conf.CAData = caData
+		conf.CAFile = ""
+	}
+	return nil
+}
+
+// ExecProviderConfigFromRestConfig returns the exec credential plugin configuration of a rest config
+// loaded from a kubeconfig context, so that it can be stored with the cluster and invoked by Argo CD
+func ExecProviderConfigFromRestConfig(conf *rest.Config) (*argoappv1.ExecProviderConfig, error) {
+	if conf.ExecProvider == nil {
+		return nil, stderrors.New("the kubeconfig context does not use an exec credential plugin")
+	}
+	var env map[string]string
+	if len(conf.ExecProvider.Env) > 0 {
+		env = make(map[string]string, len(conf.ExecProvider.Env))
+		for _, e := range conf.ExecProvider.Env {
+			env[e.Name] = e.Value
+		}
+	}
+	return &argoappv1.ExecProviderConfig{
+		Command:     conf.ExecProvider.Command,
+		Args:        conf.ExecProvider.Args,
+		Env:         env,
+		APIVersion:  conf.ExecProvider.APIVersion,
+		InstallHint: conf.ExecProvider.InstallHint,
+	}, nil
+}
+
+// argoCDImageExecCommands are the credential plugins shipped in the Argo CD image
+var argoCDImageExecCommands = []string{"argocd", "argocd-k8s-auth"}
+
+// execCommandAlternatives suggest how to replace common credential plugins with ones available in the Argo CD image
+var execCommandAlternatives = map[string]string{
+	"aws":                    "use --aws-cluster-name, or argocd-k8s-auth aws as exec command",
+	"aws-iam-authenticator":  "use --aws-cluster-name, or argocd-k8s-auth aws as exec command",
+	"gke-gcloud-auth-plugin": "use --gcp-workload-identity, or argocd-k8s-auth gcp as exec command",
+	"kubelogin":              "use --azure-workload-identity, or argocd-k8s-auth azure as exec command",
+}
+
+// ExecCommandWarning returns a warning if the exec credential plugin command is not available in the Argo CD
+// image, in which case the application controller will fail to connect to the cluster. An empty string is
+// returned if the command is expected to be available.
+func ExecCommandWarning(command string) string {
+	name := filepath.Base(command)
+	if slices.Contains(argoCDImageExecCommands, name) && (!filepath.IsAbs(command) || filepath.Dir(command) == "/usr/local/bin") {
+		return ""
+	}
+	warning := fmt.Sprintf("exec command %q is not part of the Argo CD image, the application controller won't be able to connect to the cluster unless it is installed in a custom image", command)
+	if filepath.IsAbs(command) {
+		warning += "; absolute paths refer to the local machine, not to the Argo CD containers"
+	}
+	if alternative, ok := execCommandAlternatives[name]; ok {
+		warning += "; " + alternative
+	}
+	return warning
+}
+
 type ClusterOptions struct {
 	InCluster               bool
 	Upsert                  bool
@@ -156,6 +296,11 @@ type ClusterOptions struct {
 	AwsRoleArn              string
 	AwsProfile              string
 	AwsClusterName          string
+	GCPWorkloadIdentity     bool
+	AzureWorkloadIdentity   bool
+	AzureClientID           string
+	AzureTenantID           string
+	RotateAuthInterval      time.Duration
 	SystemNamespace         string
 	Namespaces              []string
 	ClusterResources        bool
@@ -172,6 +317,75 @@ type ClusterOptions struct {
 	ProxyUrl                string //nolint:revive //FIXME(var-naming)
 }
 
+// CredentialFlags returns the flags which are set to configure how Argo CD obtains credentials for the cluster
+// instead of a bearer token. At most one of them may be set.
+func (o ClusterOptions) CredentialFlags() []string {
+	var flags []string
+	if o.AwsClusterName != "" {
+		flags = append(flags, "--aws-cluster-name")
+	}
+	if o.GCPWorkloadIdentity {
+		flags = append(flags, "--gcp-workload-identity")
+	}
+	if o.AzureWorkloadIdentity {
+		flags = append(flags, "--azure-workload-identity")
+	}
+	if o.ExecProviderCommand != "" {
+		flags = append(flags, "--exec-command")
+	}
+	return flags
+}
+
+// ValidateCredentialFlags returns an error if the credential flags of the options conflict
+func (o ClusterOptions) ValidateCredentialFlags() error {
+	flags := o.CredentialFlags()
+	if len(flags) > 1 {
+		return fmt.Errorf("only one of --aws-cluster-name, --gcp-workload-identity, --azure-workload-identity or --exec-command can be used, got %s", strings.Join(flags, ", "))
+	}
+	if !o.AzureWorkloadIdentity && (o.AzureClientID != "" || o.AzureTenantID != "") {
+		return stderrors.New("--azure-client-id and --azure-tenant-id can only be used with --azure-workload-identity")
+	}
+	if o.RotateAuthInterval < 0 {
+		return stderrors.New("--rotate-auth-interval must be positive")
+	}
+	if o.RotateAuthInterval > 0 && len(flags) > 0 {
+		return fmt.Errorf("--rotate-auth-interval can only be used with bearer token authentication, not with %s", strings.Join(flags, ", "))
+	}
+	return nil
+}
+
+// WorkloadIdentityExecProviderConfig returns the exec provider configuration which makes Argo CD authenticate
+// to the cluster with the GCP or Azure workload identity of its own pods, using argocd-k8s-auth. Nil is
+// returned if neither --gcp-workload-identity nor --azure-workload-identity is set.
+func (o ClusterOptions) WorkloadIdentityExecProviderConfig() *argoappv1.ExecProviderConfig {
+	switch {
+	case o.GCPWorkloadIdentity:
+		return &argoappv1.ExecProviderConfig{
+			Command:    "argocd-k8s-auth",
+			Args:       []string{"gcp"},
+			APIVersion: workloadIdentityExecAPIVersion,
+		}
+	case o.AzureWorkloadIdentity:
+		// the client and tenant ID are injected into the Argo CD pods by the workload identity webhook, unless overridden
+		env := map[string]string{"AAD_LOGIN_METHOD": "workloadidentity"}
+		if o.AzureClientID != "" {
+			env["AZURE_CLIENT_ID"] = o.AzureClientID
+		}
+		if o.AzureTenantID != "" {
+			env["AZURE_TENANT_ID"] = o.AzureTenantID
+		}
+		return &argoappv1.ExecProviderConfig{
+			Command:    "argocd-k8s-auth",
+			Args:       []string{"azure"},
+			Env:        env,
+			APIVersion: workloadIdentityExecAPIVersion,
+		}
+	}
+	return nil
+}
+
+const workloadIdentityExecAPIVersion = "client.authentication.k8s.io/v1beta1"
+
 // InClusterEndpoint returns true if ArgoCD should reference the in-cluster
 // endpoint when registering the target cluster.
 func (o ClusterOptions) InClusterEndpoint() bool {
@@ -183,6 +397,10 @@ func AddClusterFlags(command *cobra.Command, opts *ClusterOptions) {
 	command.Flags().StringVar(&opts.AwsClusterName, "aws-cluster-name", "", "AWS Cluster name if set then aws cli eks token command will be used to access cluster")
 	command.Flags().StringVar(&opts.AwsRoleArn, "aws-role-arn", "", "Optional AWS role arn. If set then AWS IAM Authenticator assumes a role to perform cluster operations instead of the default AWS credential provider chain.")
 	command.Flags().StringVar(&opts.AwsProfile, "aws-profile", "", "Optional AWS profile. If set then AWS IAM Authenticator uses this profile to perform cluster operations instead of the default AWS credential provider chain.")
+	command.Flags().BoolVar(&opts.GCPWorkloadIdentity, "gcp-workload-identity", false, "Authenticate to the GKE cluster with the GCP workload identity of the Argo CD pods instead of a service account token")
+	command.Flags().BoolVar(&opts.AzureWorkloadIdentity, "azure-workload-identity", false, "Authenticate to the AKS cluster with the Azure workload identity of the Argo CD pods instead of a service account token")
+	command.Flags().StringVar(&opts.AzureClientID, "azure-client-id", "", "Optional client ID of the Azure managed identity. If not set then the client ID injected by the Azure workload identity webhook is used. Requires --azure-workload-identity")
+	command.Flags().StringVar(&opts.AzureTenantID, "azure-tenant-id", "", "Optional Azure tenant ID. If not set then the tenant ID injected by the Azure workload identity webhook is used. Requires --azure-workload-identity")
 	command.Flags().StringArrayVar(&opts.Namespaces, "namespace", nil, "List of namespaces which are allowed to manage")
 	command.Flags().BoolVar(&opts.ClusterResources, "cluster-resources", false, "Indicates if cluster level resources should be managed. The setting is used only if list of managed namespaces is not empty.")
 	command.Flags().StringVar(&opts.Name, "name", "", "Overwrite the cluster name")
@@ -195,4 +413,5 @@ func AddClusterFlags(command *cobra.Command, opts *ClusterOptions) {
 	command.Flags().StringVar(&opts.ExecProviderInstallHint, "exec-command-install-hint", "", "Text shown to the user when the --exec-command executable doesn't seem to be present")
 	command.Flags().StringVar(&opts.ClusterEndpoint, "cluster-endpoint", "", "Cluster endpoint to use. Can be one of the following: 'kubeconfig', 'kube-public', or 'internal'.")
 	command.Flags().BoolVar(&opts.DisableCompression, "disable-compression", false, "Bypasses automatic GZip compression requests to the server")
+	command.Flags().DurationVar(&opts.RotateAuthInterval, "rotate-auth-interval", 0, "Interval at which the application controller rotates the bearer token of the cluster, e.g. 720h. The token is not rotated automatically if not set")
 }
@@ -1,7 +1,17 @@
 package util
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -10,6 +20,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/rest"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	clientcmdapiv1 "k8s.io/client-go/tools/clientcmd/api/v1"
 	"sigs.k8s.io/yaml"
 
@@ -210,3 +221,179 @@ func kubeconfigFixture(endpoint string, certificateAuthorityData []byte) string
 	}
 	return string(configYAML)
 }
+
+func generateCACertPEM(t *testing.T) []byte {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func writeTempFile(t *testing.T, name string, data []byte) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+	return path
+}
+
+func TestReadBearerTokenFile(t *testing.T) {
+	token, err := ReadBearerTokenFile(writeTempFile(t, "token", []byte("  my-token\n")))
+	require.NoError(t, err)
+	assert.Equal(t, "my-token", token)
+
+	_, err = ReadBearerTokenFile(writeTempFile(t, "token", []byte("\n")))
+	require.ErrorContains(t, err, "is empty")
+
+	_, err = ReadBearerTokenFile(filepath.Join(t.TempDir(), "missing"))
+	require.ErrorContains(t, err, "failed to read bearer token file")
+}
+
+func TestReadCADataFile(t *testing.T) {
+	caData := generateCACertPEM(t)
+	data, err := ReadCADataFile(writeTempFile(t, "ca.crt", caData))
+	require.NoError(t, err)
+	assert.Equal(t, caData, data)
+
+	_, err = ReadCADataFile(writeTempFile(t, "ca.crt", []byte("not a certificate")))
+	require.ErrorContains(t, err, "does not contain any PEM encoded certificate")
+
+	_, err = ReadCADataFile(writeTempFile(t, "ca.crt", pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("garbage")})))
+	require.ErrorContains(t, err, "contains an invalid certificate")
+
+	_, err = ReadCADataFile(writeTempFile(t, "ca.crt", pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte("key")})))
+	require.ErrorContains(t, err, "unexpected PEM block of type PRIVATE KEY")
+}
+
+func TestSetStaticCredentials(t *testing.T) {
+	caData := generateCACertPEM(t)
+
+	t.Run("WithoutContext", func(t *testing.T) {
+		conf := &rest.Config{}
+		require.NoError(t, SetStaticCredentials(conf, "https://10.0.0.1:6443", "token", caData))
+		assert.Equal(t, "https://10.0.0.1:6443", conf.Host)
+		assert.Equal(t, "token", conf.BearerToken)
+		assert.Equal(t, caData, conf.CAData)
+	})
+	t.Run("ServerRequired", func(t *testing.T) {
+		require.ErrorContains(t, SetStaticCredentials(&rest.Config{}, "", "token", nil), "server is required")
+	})
+	t.Run("SameServerAsContext", func(t *testing.T) {
+		conf := &rest.Config{Host: "https://10.0.0.1:6443/"}
+		require.NoError(t, SetStaticCredentials(conf, "https://10.0.0.1:6443", "token", nil))
+	})
+	t.Run("ConflictingServer", func(t *testing.T) {
+		conf := &rest.Config{Host: "https://10.0.0.2:6443"}
+		require.ErrorContains(t, SetStaticCredentials(conf, "https://10.0.0.1:6443", "token", nil), "conflicts with server https://10.0.0.2:6443")
+	})
+	t.Run("ConflictingToken", func(t *testing.T) {
+		conf := &rest.Config{Host: "https://10.0.0.1:6443", BearerToken: "other"}
+		require.ErrorContains(t, SetStaticCredentials(conf, "", "token", nil), "conflicts with the bearer token")
+	})
+	t.Run("ConflictingClientCertificate", func(t *testing.T) {
+		conf := &rest.Config{Host: "https://10.0.0.1:6443", TLSClientConfig: rest.TLSClientConfig{CertData: []byte("cert")}}
+		require.ErrorContains(t, SetStaticCredentials(conf, "", "token", nil), "conflicts with the client certificate")
+	})
+	t.Run("ConflictingCAData", func(t *testing.T) {
+		conf := &rest.Config{Host: "https://10.0.0.1:6443", TLSClientConfig: rest.TLSClientConfig{CAData: generateCACertPEM(t)}}
+		require.ErrorContains(t, SetStaticCredentials(conf, "", "token", caData), "conflicts with the certificate authority")
+	})
+	t.Run("ConflictingInsecure", func(t *testing.T) {
+		conf := &rest.Config{Host: "https://10.0.0.1:6443", TLSClientConfig: rest.TLSClientConfig{Insecure: true}}
+		require.ErrorContains(t, SetStaticCredentials(conf, "", "token", caData), "conflicts with insecure-skip-tls-verify")
+	})
+	t.Run("SameCADataAsContext", func(t *testing.T) {
+		conf := &rest.Config{Host: "https://10.0.0.1:6443", TLSClientConfig: rest.TLSClientConfig{CAFile: writeTempFile(t, "ca.crt", caData)}}
+		require.NoError(t, SetStaticCredentials(conf, "", "token", caData))
+		assert.Empty(t, conf.CAFile)
+		assert.Equal(t, caData, conf.CAData)
+	})
+}
+
+func TestExecProviderConfigFromRestConfig(t *testing.T) {
+	_, err := ExecProviderConfigFromRestConfig(&rest.Config{Host: "https://my-cluster"})
+	require.ErrorContains(t, err, "does not use an exec credential plugin")
+
+	conf, err := ExecProviderConfigFromRestConfig(&rest.Config{
+		Host: "https://my-cluster",
+		ExecProvider: &clientcmdapi.ExecConfig{
+			Command:     "argocd-k8s-auth",
+			Args:        []string{"gcp"},
+			Env:         []clientcmdapi.ExecEnvVar{{Name: "CLOUDSDK_CORE_PROJECT", Value: "my-project"}},
+			APIVersion:  "client.authentication.k8s.io/v1beta1",
+			InstallHint: "install argocd",
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &v1alpha1.ExecProviderConfig{
+		Command:     "argocd-k8s-auth",
+		Args:        []string{"gcp"},
+		Env:         map[string]string{"CLOUDSDK_CORE_PROJECT": "my-project"},
+		APIVersion:  "client.authentication.k8s.io/v1beta1",
+		InstallHint: "install argocd",
+	}, conf)
+}
+
+func TestExecCommandWarning(t *testing.T) {
+	assert.Empty(t, ExecCommandWarning("argocd-k8s-auth"))
+	assert.Empty(t, ExecCommandWarning("/usr/local/bin/argocd-k8s-auth"))
+
+	warning := ExecCommandWarning("gke-gcloud-auth-plugin")
+	assert.Contains(t, warning, `exec command "gke-gcloud-auth-plugin" is not part of the Argo CD image`)
+	assert.Contains(t, warning, "use --gcp-workload-identity, or argocd-k8s-auth gcp as exec command")
+
+	warning = ExecCommandWarning("/opt/homebrew/bin/aws")
+	assert.Contains(t, warning, "absolute paths refer to the local machine")
+	assert.Contains(t, warning, "use --aws-cluster-name")
+
+	warning = ExecCommandWarning("/home/user/bin/argocd-k8s-auth")
+	assert.Contains(t, warning, "absolute paths refer to the local machine")
+}
+
+func TestClusterOptions_ValidateCredentialFlags(t *testing.T) {
+	require.NoError(t, ClusterOptions{}.ValidateCredentialFlags())
+	require.NoError(t, ClusterOptions{AzureWorkloadIdentity: true, AzureClientID: "client-id"}.ValidateCredentialFlags())
+
+	err := ClusterOptions{AwsClusterName: "my-cluster", GCPWorkloadIdentity: true}.ValidateCredentialFlags()
+	require.ErrorContains(t, err, "got --aws-cluster-name, --gcp-workload-identity")
+
+	err = ClusterOptions{AzureTenantID: "tenant-id"}.ValidateCredentialFlags()
+	require.ErrorContains(t, err, "can only be used with --azure-workload-identity")
+
+	require.NoError(t, ClusterOptions{RotateAuthInterval: 720 * time.Hour}.ValidateCredentialFlags())
+	err = ClusterOptions{RotateAuthInterval: 720 * time.Hour, GCPWorkloadIdentity: true}.ValidateCredentialFlags()
+	require.ErrorContains(t, err, "--rotate-auth-interval can only be used with bearer token authentication")
+}
+
+func TestClusterOptions_WorkloadIdentityExecProviderConfig(t *testing.T) {
+	assert.Nil(t, ClusterOptions{AwsClusterName: "my-cluster"}.WorkloadIdentityExecProviderConfig())
+
+	assert.Equal(t, &v1alpha1.ExecProviderConfig{
+		Command:    "argocd-k8s-auth",
+		Args:       []string{"gcp"},
+		APIVersion: "client.authentication.k8s.io/v1beta1",
+	}, ClusterOptions{GCPWorkloadIdentity: true}.WorkloadIdentityExecProviderConfig())
+
+	assert.Equal(t, &v1alpha1.ExecProviderConfig{
+		Command:    "argocd-k8s-auth",
+		Args:       []string{"azure"},
+		Env:        map[string]string{"AAD_LOGIN_METHOD": "workloadidentity"},
+		APIVersion: "client.authentication.k8s.io/v1beta1",
+	}, ClusterOptions{AzureWorkloadIdentity: true}.WorkloadIdentityExecProviderConfig())
+
+	conf := ClusterOptions{AzureWorkloadIdentity: true, AzureClientID: "client-id", AzureTenantID: "tenant-id"}.WorkloadIdentityExecProviderConfig()
+	assert.Equal(t, map[string]string{
+		"AAD_LOGIN_METHOD": "workloadidentity",
+		"AZURE_CLIENT_ID":  "client-id",
+		"AZURE_TENANT_ID":  "tenant-id",
+	}, conf.Env)
+}
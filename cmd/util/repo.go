@@ -1,7 +1,21 @@
 package util
 
 import (
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	stderrors "errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
 	"github.com/spf13/cobra"
+	"oras.land/oras-go/v2/registry"
+	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-cd/v3/common"
 	appsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
@@ -54,3 +68,160 @@ func AddRepoFlags(command *cobra.Command, opts *RepoOptions) {
 	command.Flags().BoolVar(&opts.UseAzureWorkloadIdentity, "use-azure-workload-identity", false, "whether to use azure workload identity for authentication")
 	command.Flags().BoolVar(&opts.InsecureOCIForceHTTP, "insecure-oci-force-http", false, "Use http when accessing an OCI repository")
 }
+
+// GitHubAppCreds are the GitHub App credentials read from a --github-app-creds-file
+type GitHubAppCreds struct {
+	AppID             int64  `json:"appId"`
+	InstallationID    int64  `json:"installationId"`
+	PrivateKey        string `json:"privateKey,omitempty"`
+	PrivateKeyPath    string `json:"privateKeyPath,omitempty"`
+	EnterpriseBaseURL string `json:"enterpriseBaseUrl,omitempty"`
+}
+
+// ReadGitHubAppCredsFile reads GitHub App credentials from a JSON or YAML file. The private key is either inline or
+// read from privateKeyPath, which is relative to the directory of the file, and must be an RSA key in PEM format.
+// Errors never include the contents of the files, since they hold secrets.
+func ReadGitHubAppCredsFile(path string) (*GitHubAppCreds, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read GitHub App credentials file: %w", err)
+	}
+	var creds GitHubAppCreds
+	// the decoding error is not wrapped as it may quote the offending value
+	if err := yaml.UnmarshalStrict(data, &creds); err != nil {
+		return nil, fmt.Errorf("GitHub App credentials file %s is not valid JSON or YAML with the fields appId, installationId, privateKey, privateKeyPath and enterpriseBaseUrl", path)
+	}
+	if creds.AppID <= 0 {
+		return nil, fmt.Errorf("GitHub App credentials file %s must set a positive appId", path)
+	}
+	if creds.InstallationID <= 0 {
+		return nil, fmt.Errorf("GitHub App credentials file %s must set a positive installationId", path)
+	}
+	switch {
+	case creds.PrivateKey != "" && creds.PrivateKeyPath != "":
+		return nil, fmt.Errorf("GitHub App credentials file %s must set only one of privateKey and privateKeyPath", path)
+	case creds.PrivateKeyPath != "":
+		keyPath := creds.PrivateKeyPath
+		if !filepath.IsAbs(keyPath) {
+			keyPath = filepath.Join(filepath.Dir(path), keyPath)
+		}
+		keyData, err := os.ReadFile(keyPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read GitHub App private key: %w", err)
+		}
+		creds.PrivateKey = string(keyData)
+		creds.PrivateKeyPath = ""
+	case creds.PrivateKey == "":
+		return nil, fmt.Errorf("GitHub App credentials file %s must set privateKey or privateKeyPath", path)
+	}
+	if err := validateRSAPrivateKey([]byte(creds.PrivateKey)); err != nil {
+		return nil, fmt.Errorf("invalid GitHub App private key in %s: %w", path, err)
+	}
+	return &creds, nil
+}
+
+// validateRSAPrivateKey checks that data is a PEM encoded RSA private key, in PKCS #1 or PKCS #8 form
+func validateRSAPrivateKey(data []byte) error {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return stderrors.New("not a PEM encoded key")
+	}
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		if _, err := x509.ParsePKCS1PrivateKey(block.Bytes); err != nil {
+			return stderrors.New("failed to parse PKCS #1 RSA private key")
+		}
+	case "PRIVATE KEY":
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return stderrors.New("failed to parse PKCS #8 private key")
+		}
+		if _, ok := key.(*rsa.PrivateKey); !ok {
+			return stderrors.New("not an RSA private key")
+		}
+	default:
+		return fmt.Errorf("unexpected PEM block of type %s, expected an RSA private key", block.Type)
+	}
+	return nil
+}
+
+// ValidateOCIRepoURL checks that repoURL is an oci:// reference to a registry or a repository in a registry, such
+// as oci://registry.example.com/org/repo. Tags and digests belong in the target revision, not in the URL.
+func ValidateOCIRepoURL(repoURL string) error {
+	if !strings.HasPrefix(repoURL, "oci://") {
+		return fmt.Errorf("repository URL %s of type oci must start with oci://", repoURL)
+	}
+	artifact := strings.TrimPrefix(repoURL, "oci://")
+	if !strings.Contains(artifact, "/") {
+		if err := (registry.Reference{Registry: artifact}).ValidateRegistry(); err != nil {
+			return fmt.Errorf("repository URL %s is not a valid OCI reference: %w", repoURL, err)
+		}
+		return nil
+	}
+	ref, err := registry.ParseReference(artifact)
+	if err != nil {
+		return fmt.Errorf("repository URL %s is not a valid OCI reference: %w", repoURL, err)
+	}
+	if ref.Reference != "" {
+		return fmt.Errorf("repository URL %s must not include a tag or digest, use the target revision instead", repoURL)
+	}
+	return nil
+}
+
+// dockerConfig is the subset of a docker config.json which holds the registry credentials
+type dockerConfig struct {
+	Auths map[string]dockerConfigAuth `json:"auths"`
+}
+
+type dockerConfigAuth struct {
+	Auth     string `json:"auth,omitempty"`
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+}
+
+// ReadDockerConfigCredentials returns the username and password stored for the registry of repoURL in the
+// "auths" section of a docker config.json. Credential stores and helpers are not supported. Errors never include
+// the contents of the file, since it holds secrets.
+func ReadDockerConfigCredentials(path, repoURL string) (string, string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to read docker config: %w", err)
+	}
+	var config dockerConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return "", "", fmt.Errorf("docker config %s is not valid JSON", path)
+	}
+	host := dockerConfigRegistryHost(repoURL)
+	for key, entry := range config.Auths {
+		if dockerConfigRegistryHost(key) != host {
+			continue
+		}
+		if entry.Auth != "" {
+			decoded, err := base64.StdEncoding.DecodeString(entry.Auth)
+			if err != nil {
+				return "", "", fmt.Errorf("docker config %s has an invalid auth value for registry %s", path, host)
+			}
+			username, password, ok := strings.Cut(string(decoded), ":")
+			if !ok {
+				return "", "", fmt.Errorf("docker config %s has an invalid auth value for registry %s", path, host)
+			}
+			return username, password, nil
+		}
+		if entry.Username != "" {
+			return entry.Username, entry.Password, nil
+		}
+	}
+	return "", "", fmt.Errorf("docker config %s has no auths entry with a username and password for registry %s", path, host)
+}
+
+// dockerConfigRegistryHost returns the registry host of a repository URL or a docker config auths key, which may
+// be a bare host or a URL such as https://registry.example.com/v1/
+func dockerConfigRegistryHost(s string) string {
+	if strings.Contains(s, "://") {
+		if u, err := url.Parse(s); err == nil {
+			return u.Host
+		}
+	}
+	host, _, _ := strings.Cut(s, "/")
+	return host
+}
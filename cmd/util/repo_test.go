@@ -0,0 +1,145 @@
+package util
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func generateRSAKeyPEM(t *testing.T, pkcs8 bool) string {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	if pkcs8 {
+		der, err := x509.MarshalPKCS8PrivateKey(key)
+		require.NoError(t, err)
+		return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+}
+
+func TestReadGitHubAppCredsFile(t *testing.T) {
+	keyPEM := generateRSAKeyPEM(t, false)
+
+	t.Run("json with inline key", func(t *testing.T) {
+		path := writeTempFile(t, "creds.json", []byte(`{"appId": 1, "installationId": 2, "privateKey": `+strconv.Quote(keyPEM)+`, "enterpriseBaseUrl": "https://ghe.example.com/api/v3"}`))
+		creds, err := ReadGitHubAppCredsFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, &GitHubAppCreds{AppID: 1, InstallationID: 2, PrivateKey: keyPEM, EnterpriseBaseURL: "https://ghe.example.com/api/v3"}, creds)
+	})
+
+	t.Run("yaml with key path relative to the file", func(t *testing.T) {
+		path := writeTempFile(t, "creds.yaml", []byte("appId: 1\ninstallationId: 2\nprivateKeyPath: app.pem\n"))
+		keyPath := filepath.Join(filepath.Dir(path), "app.pem")
+		require.NoError(t, os.WriteFile(keyPath, []byte(generateRSAKeyPEM(t, true)), 0o600))
+		creds, err := ReadGitHubAppCredsFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), creds.AppID)
+		assert.Equal(t, int64(2), creds.InstallationID)
+		assert.Contains(t, creds.PrivateKey, "BEGIN PRIVATE KEY")
+		assert.Empty(t, creds.PrivateKeyPath)
+	})
+
+	t.Run("invalid files", func(t *testing.T) {
+		ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		ecDER, err := x509.MarshalPKCS8PrivateKey(ecKey)
+		require.NoError(t, err)
+		ecPEM := string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: ecDER}))
+
+		for _, tc := range []struct {
+			name    string
+			content string
+			err     string
+		}{
+			{"not yaml", "appId: [", "is not valid JSON or YAML"},
+			{"wrong type", "appId: secret-value\ninstallationId: 2\n", "is not valid JSON or YAML"},
+			{"unknown field", "appId: 1\ninstallationId: 2\nsecret-value: x\n", "is not valid JSON or YAML"},
+			{"missing app id", "installationId: 2\n", "must set a positive appId"},
+			{"missing installation id", "appId: 1\n", "must set a positive installationId"},
+			{"missing key", "appId: 1\ninstallationId: 2\n", "must set privateKey or privateKeyPath"},
+			{"both keys", "appId: 1\ninstallationId: 2\nprivateKey: x\nprivateKeyPath: y\n", "must set only one of privateKey and privateKeyPath"},
+			{"not pem", "appId: 1\ninstallationId: 2\nprivateKey: secret-value\n", "not a PEM encoded key"},
+			{"not rsa", "appId: 1\ninstallationId: 2\nprivateKey: " + strconv.Quote(ecPEM) + "\n", "not an RSA private key"},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				path := writeTempFile(t, "creds.yaml", []byte(tc.content))
+				_, err := ReadGitHubAppCredsFile(path)
+				require.ErrorContains(t, err, tc.err)
+				assert.NotContains(t, err.Error(), "secret-value")
+			})
+		}
+	})
+}
+
+func TestValidateOCIRepoURL(t *testing.T) {
+	for _, repoURL := range []string{
+		"oci://registry.example.com/org/manifests",
+		"oci://registry.example.com:5000/charts",
+		"oci://helm-oci-registry.cn-zhangjiakou.cr.aliyuncs.com",
+	} {
+		require.NoError(t, ValidateOCIRepoURL(repoURL), repoURL)
+	}
+
+	err := ValidateOCIRepoURL("https://registry.example.com/org/manifests")
+	require.ErrorContains(t, err, "must start with oci://")
+
+	err = ValidateOCIRepoURL("oci://registry.example.com/org/manifests:1.0.0")
+	require.ErrorContains(t, err, "must not include a tag or digest")
+
+	err = ValidateOCIRepoURL("oci://registry.example.com/Org")
+	require.ErrorContains(t, err, "not a valid OCI reference")
+}
+
+func TestReadDockerConfigCredentials(t *testing.T) {
+	writeConfig := func(t *testing.T, content string) string {
+		t.Helper()
+		path := filepath.Join(t.TempDir(), "config.json")
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		return path
+	}
+	auth := base64.StdEncoding.EncodeToString([]byte("user:secret"))
+
+	t.Run("Auth", func(t *testing.T) {
+		path := writeConfig(t, `{"auths": {"registry.example.com": {"auth": "`+auth+`"}}}`)
+		username, password, err := ReadDockerConfigCredentials(path, "oci://registry.example.com/org/manifests")
+		require.NoError(t, err)
+		assert.Equal(t, "user", username)
+		assert.Equal(t, "secret", password)
+	})
+	t.Run("UsernamePasswordWithURLKey", func(t *testing.T) {
+		path := writeConfig(t, `{"auths": {"https://registry.example.com/v1/": {"username": "user", "password": "secret"}}}`)
+		username, password, err := ReadDockerConfigCredentials(path, "registry.example.com/charts")
+		require.NoError(t, err)
+		assert.Equal(t, "user", username)
+		assert.Equal(t, "secret", password)
+	})
+	t.Run("NoEntry", func(t *testing.T) {
+		path := writeConfig(t, `{"auths": {"other.example.com": {"auth": "`+auth+`"}}, "credsStore": "desktop"}`)
+		_, _, err := ReadDockerConfigCredentials(path, "oci://registry.example.com/org/manifests")
+		require.ErrorContains(t, err, "no auths entry")
+	})
+	t.Run("InvalidAuth", func(t *testing.T) {
+		path := writeConfig(t, `{"auths": {"registry.example.com": {"auth": "not-base64-secret"}}}`)
+		_, _, err := ReadDockerConfigCredentials(path, "oci://registry.example.com/org/manifests")
+		require.ErrorContains(t, err, "invalid auth value")
+		assert.NotContains(t, err.Error(), "not-base64-secret")
+	})
+	t.Run("InvalidJSON", func(t *testing.T) {
+		path := writeConfig(t, `{"auths": "secret`)
+		_, _, err := ReadDockerConfigCredentials(path, "oci://registry.example.com/org/manifests")
+		require.ErrorContains(t, err, "not valid JSON")
+		assert.NotContains(t, err.Error(), "secret")
+	})
+}
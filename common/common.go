@@ -149,6 +149,8 @@ const (
 	LogoutEndpoint = "/auth/logout"
 	// CallbackEndpoint is Argo CD's final callback endpoint we reach after OAuth 2.0 login flow has been completed
 	CallbackEndpoint = "/auth/callback"
+	// TokenEndpoint is Argo CD's OAuth 2.0 token endpoint which exchanges client credentials of local accounts for tokens
+	TokenEndpoint = "/auth/token"
 	// DexCallbackEndpoint is Argo CD's final callback endpoint when Dex is configured
 	DexCallbackEndpoint = "/api/dex/callback"
 	// ArgoCDClientAppName is name of the Oauth client app used when registering our web app to dex
@@ -178,6 +180,8 @@ const (
 	LabelKeySecretType = "argocd.argoproj.io/secret-type"
 	// LabelKeyClusterKubernetesVersion contains the kubernetes version of the cluster secret if it has been enabled
 	LabelKeyClusterKubernetesVersion = "argocd.argoproj.io/kubernetes-version"
+	// LabelKeyAccount contains the name of the local account an audit event is about
+	LabelKeyAccount = "argocd.argoproj.io/account"
 	// LabelValueSecretTypeCluster indicates a secret type of cluster
 	LabelValueSecretTypeCluster = "cluster"
 	// LabelValueSecretTypeRepository indicates a secret type of repository
@@ -203,6 +207,17 @@ const (
 	// can be disregarded.
 	AnnotationIgnoreHealthCheck = "argocd.argoproj.io/ignore-healthcheck"
 
+	// AnnotationDeletionPolicy when set on a resource of an Application overrides how the resource is deleted when the
+	// Application is deleted with cascade. One of DeletionPolicyOrphan, DeletionPolicyForeground or
+	// DeletionPolicyBackground.
+	AnnotationDeletionPolicy = "argocd.argoproj.io/deletion-policy"
+	// DeletionPolicyOrphan keeps the resource in the cluster when its Application is deleted
+	DeletionPolicyOrphan = "orphan"
+	// DeletionPolicyForeground deletes the resource with the foreground propagation policy
+	DeletionPolicyForeground = "foreground"
+	// DeletionPolicyBackground deletes the resource with the background propagation policy
+	DeletionPolicyBackground = "background"
+
 	// AnnotationKeyManagedBy is annotation name which indicates that k8s resource is managed by an application.
 	AnnotationKeyManagedBy = "managed-by"
 	// AnnotationValueManagedByArgoCD is a 'managed-by' annotation value for resources managed by Argo CD
@@ -221,6 +236,10 @@ const (
 	// AnnotationKeyAppSkipReconcile tells the Application to skip the Application controller reconcile.
 	// Skip reconcile when the value is "true" or any other string values that can be strconv.ParseBool() to be true.
 	AnnotationKeyAppSkipReconcile = "argocd.argoproj.io/skip-reconcile"
+	// AnnotationKeyClusterAuthRotatedAt holds the time the bearer token of a cluster secret has last been rotated
+	AnnotationKeyClusterAuthRotatedAt = "argocd.argoproj.io/auth-rotated-at"
+	// AnnotationKeyClusterAuthRotationError holds the error of the last failed scheduled bearer token rotation of a cluster secret
+	AnnotationKeyClusterAuthRotationError = "argocd.argoproj.io/auth-rotation-error"
 	// LabelKeyComponentRepoServer is the label key to identify the component as repo-server
 	LabelKeyComponentRepoServer = "app.kubernetes.io/component"
 	// LabelValueComponentRepoServer is the label value for the repo-server component
@@ -277,6 +296,8 @@ const (
 	EnvAppConfigPath = "ARGOCD_APP_CONF_PATH"
 	// EnvAuthToken is the environment variable name for the auth token used by the CLI
 	EnvAuthToken = "ARGOCD_AUTH_TOKEN"
+	// EnvVerboseErrors enables printing the full details of failed API requests, as the `--verbose-errors` option does
+	EnvVerboseErrors = "ARGOCD_VERBOSE_ERRORS"
 	// EnvLogFormat log format that is defined by `--logformat` option
 	EnvLogFormat = "ARGOCD_LOG_FORMAT"
 	// EnvLogLevel log level that is defined by `--loglevel` option
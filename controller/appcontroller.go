@@ -78,6 +78,8 @@ const (
 	defaultDeploymentInformerResyncDuration = 10 * time.Second
 	// orphanedIndex contains application which monitor orphaned resources by namespace
 	orphanedIndex = "orphaned"
+	// dependsOnIndex contains applications by the qualified names of the applications they depend on
+	dependsOnIndex = "dependsOn"
 )
 
 type CompareWith int
@@ -890,6 +892,7 @@ func (ctrl *ApplicationController) Run(ctx context.Context, statusProcessors int
 	defer ctrl.hydrationQueue.ShutDown()
 
 	ctrl.RegisterClusterSecretUpdater(ctx)
+	ctrl.RegisterClusterAuthRotator(ctx)
 	ctrl.metricsServer.RegisterClustersInfoSource(ctx, ctrl.stateCache, ctrl.db, ctrl.metricsClusterLabels)
 
 	if ctrl.dynamicClusterDistributionEnabled {
@@ -1163,7 +1166,28 @@ func (ctrl *ApplicationController) removeProjectFinalizer(proj *appv1.AppProject
 func (ctrl *ApplicationController) shouldBeDeleted(app *appv1.Application, obj *unstructured.Unstructured) bool {
 	return !kube.IsCRD(obj) && !isSelfReferencedApp(app, kube.GetObjectRef(obj)) &&
 		!resourceutil.HasAnnotationOption(obj, synccommon.AnnotationSyncOptions, synccommon.SyncOptionDisableDeletion) &&
-		!resourceutil.HasAnnotationOption(obj, helm.ResourcePolicyAnnotation, helm.ResourcePolicyKeep)
+		!resourceutil.HasAnnotationOption(obj, helm.ResourcePolicyAnnotation, helm.ResourcePolicyKeep) &&
+		obj.GetAnnotations()[common.AnnotationDeletionPolicy] != common.DeletionPolicyOrphan
+}
+
+// deletionPropagationPolicy returns the propagation policy to delete a resource of an application with, which is the
+// policy of the deletion-policy annotation of the resource if set, or else the policy of the application finalizer
+func deletionPropagationPolicy(app *appv1.Application, obj *unstructured.Unstructured) metav1.DeletionPropagation {
+	switch obj.GetAnnotations()[common.AnnotationDeletionPolicy] {
+	case common.DeletionPolicyForeground:
+		return metav1.DeletePropagationForeground
+	case common.DeletionPolicyBackground:
+		return metav1.DeletePropagationBackground
+	}
+	return appDeletionPropagationPolicy(app)
+}
+
+// appDeletionPropagationPolicy returns the propagation policy of the application finalizer
+func appDeletionPropagationPolicy(app *appv1.Application) metav1.DeletionPropagation {
+	if app.GetPropagationPolicy() == appv1.BackgroundPropagationPolicyFinalizer {
+		return metav1.DeletePropagationBackground
+	}
+	return metav1.DeletePropagationForeground
 }
 
 func (ctrl *ApplicationController) getPermittedAppLiveObjects(destCluster *appv1.Cluster, app *appv1.Application, proj *appv1.AppProject, projectClusters func(project string) ([]*appv1.Cluster, error)) (map[kube.ResourceKey]*unstructured.Unstructured, error) {
@@ -1245,14 +1269,12 @@ func (ctrl *ApplicationController) finalizeApplicationDeletion(app *appv1.Applic
 
 		filteredObjs := FilterObjectsForDeletion(objs)
 
-		propagationPolicy := metav1.DeletePropagationForeground
-		if app.GetPropagationPolicy() == appv1.BackgroundPropagationPolicyFinalizer {
-			propagationPolicy = metav1.DeletePropagationBackground
-		}
-		logCtx.Infof("Deleting application's resources with %s propagation policy", propagationPolicy)
+		logCtx.Infof("Deleting application's resources with %s propagation policy", appDeletionPropagationPolicy(app))
 
 		err = kube.RunAllAsync(len(filteredObjs), func(i int) error {
 			obj := filteredObjs[i]
+			// the propagation policy may be overridden for individual resources by the deletion-policy annotation
+			propagationPolicy := deletionPropagationPolicy(app, obj)
 			return ctrl.kubectl.DeleteResource(context.Background(), config, obj.GroupVersionKind(), obj.GetName(), obj.GetNamespace(), metav1.DeleteOptions{PropagationPolicy: &propagationPolicy})
 		})
 		if err != nil {
@@ -1436,6 +1458,23 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 	}
 	ts.AddCheckpoint("initial_operation_stage_ms")
 
+	if !terminating && state.SyncResult == nil {
+		// The sync has not been started yet, so it waits until the dependencies are synced and healthy. It is
+		// resumed when one of the dependencies changes.
+		message, err := ctrl.dependenciesPreventSync(app)
+		if err != nil {
+			state.Phase = synccommon.OperationError
+			state.Message = err.Error()
+			ctrl.setOperationState(app, state)
+			return
+		}
+		if message != "" {
+			state.Message = message
+			ctrl.setOperationState(app, state)
+			return
+		}
+	}
+
 	project, err := ctrl.getAppProj(app)
 	if err == nil {
 		// Start or resume the sync
@@ -1548,7 +1587,7 @@ func (ctrl *ApplicationController) setOperationState(app *appv1.Application, sta
 	if state.Phase.Completed() {
 		eventInfo := argo.EventInfo{Reason: argo.EventReasonOperationCompleted}
 		var messages []string
-		if state.Operation.Sync != nil && len(state.Operation.Sync.Resources) > 0 {
+		if state.Operation.Sync != nil && state.Operation.Sync.IsPartialSync() {
 			messages = []string{"Partial sync operation"}
 		} else {
 			messages = []string{"Sync operation"}
@@ -1771,6 +1810,11 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		app.Status.Summary = tree.GetSummary(app)
 	}
 
+	app.Status.SetConditions(
+		ctrl.dependencyConditions(app),
+		map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionDependencyError: true, appv1.ApplicationConditionDependencyWarning: true},
+	)
+
 	canSync, _ := project.Spec.SyncWindows.Matches(app).CanSync(false)
 	if canSync {
 		syncErrCond, opDuration := ctrl.autoSync(app, compareResult.syncStatus, compareResult.resources, compareResult.revisionsMayHaveChanges)
@@ -2098,6 +2142,11 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 		return nil, 0
 	}
 
+	if message, err := ctrl.dependenciesPreventSync(app); err != nil || message != "" {
+		logCtx.Infof("Skipping auto-sync: dependencies are not ready")
+		return nil, 0
+	}
+
 	if !app.Spec.SyncPolicy.Automated.Prune {
 		requirePruneOnly := true
 		for _, r := range resources {
@@ -2307,7 +2356,12 @@ func (ctrl *ApplicationController) selfHealBackoffCooldownElapsed(app *appv1.App
 // isAppNamespaceAllowed returns whether the application is allowed in the
 // namespace it's residing in.
 func (ctrl *ApplicationController) isAppNamespaceAllowed(app *appv1.Application) bool {
-	return app.Namespace == ctrl.namespace || glob.MatchStringInList(ctrl.applicationNamespaces, app.Namespace, glob.REGEXP)
+	return ctrl.isNamespaceAllowed(app.Namespace)
+}
+
+// isNamespaceAllowed returns whether applications in the given namespace are processed by the controller
+func (ctrl *ApplicationController) isNamespaceAllowed(namespace string) bool {
+	return namespace == ctrl.namespace || glob.MatchStringInList(ctrl.applicationNamespaces, namespace, glob.REGEXP)
 }
 
 func (ctrl *ApplicationController) canProcessApp(obj any) bool {
@@ -2416,6 +2470,13 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 				}
 				return nil, nil
 			},
+			dependsOnIndex: func(obj any) ([]string, error) {
+				app, ok := obj.(*appv1.Application)
+				if !ok || !ctrl.isAppNamespaceAllowed(app) {
+					return nil, nil
+				}
+				return dependencyKeys(app), nil
+			},
 		},
 	)
 	lister := applisters.NewApplicationLister(informer.GetIndexer())
@@ -2454,6 +2515,9 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 						log.WithFields(applog.GetAppLogFields(newApp)).Info("Enabled automated sync")
 						compareWith = CompareWithLatest.Pointer()
 					}
+					if oldApp.Status.Sync.Status != newApp.Status.Sync.Status || oldApp.Status.Health.Status != newApp.Status.Health.Status {
+						ctrl.requestDependentAppsRefresh(newApp)
+					}
 					if ctrl.statusRefreshJitter != 0 && oldApp.ResourceVersion == newApp.ResourceVersion {
 						// Handler is refreshing the apps, add a random jitter to spread the load and avoid spikes
 						jitter := time.Duration(float64(ctrl.statusRefreshJitter) * rand.Float64())
@@ -2512,6 +2576,11 @@ func (ctrl *ApplicationController) RegisterClusterSecretUpdater(ctx context.Cont
 	go updater.Run(ctx)
 }
 
+func (ctrl *ApplicationController) RegisterClusterAuthRotator(ctx context.Context) {
+	rotator := NewClusterAuthRotator(ctrl.db, ctrl.kubectl, ctrl.clusterSharding.IsManagedCluster)
+	go rotator.Run(ctx)
+}
+
 func isOperationInProgress(app *appv1.Application) bool {
 	return app.Status.OperationState != nil && !app.Status.OperationState.Phase.Completed()
 }
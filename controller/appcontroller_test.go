@@ -610,6 +610,152 @@ func TestAutoSync(t *testing.T) {
 	assert.False(t, app.Operation.Sync.Prune)
 }
 
+func TestAutoSyncWaitsForDependencies(t *testing.T) {
+	syncStatus := v1alpha1.SyncStatus{
+		Status:   v1alpha1.SyncStatusCodeOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+	resources := []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}
+	newDependency := func(syncStatus v1alpha1.SyncStatusCode) *v1alpha1.Application {
+		dependency := newFakeApp()
+		dependency.Name = "database"
+		dependency.Status.Sync.Status = syncStatus
+		dependency.Status.Health.Status = health.HealthStatusHealthy
+		return dependency
+	}
+
+	t.Run("DependencyOutOfSync", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.DependsOn = []string{"database"}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, newDependency(v1alpha1.SyncStatusCodeOutOfSync)}}, nil)
+		cond, _ := ctrl.autoSync(app, &syncStatus, resources, true)
+		assert.Nil(t, cond)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Nil(t, app.Operation)
+		conditions := ctrl.dependencyConditions(app)
+		require.Len(t, conditions, 1)
+		assert.Equal(t, v1alpha1.ApplicationConditionDependencyWarning, conditions[0].Type)
+		assert.Contains(t, conditions[0].Message, "database (OutOfSync, Healthy)")
+	})
+
+	t.Run("DependencyReady", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.DependsOn = []string{"database"}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, newDependency(v1alpha1.SyncStatusCodeSynced)}}, nil)
+		cond, _ := ctrl.autoSync(app, &syncStatus, resources, true)
+		assert.Nil(t, cond)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.NotNil(t, app.Operation)
+		assert.Empty(t, ctrl.dependencyConditions(app))
+	})
+}
+
+func TestCheckAppDependencies(t *testing.T) {
+	newApp := func(namespace, name string, syncStatus v1alpha1.SyncStatusCode, healthStatus health.HealthStatusCode, dependsOn ...string) *v1alpha1.Application {
+		return &v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Spec:       v1alpha1.ApplicationSpec{DependsOn: dependsOn},
+			Status: v1alpha1.ApplicationStatus{
+				Sync:   v1alpha1.SyncStatus{Status: syncStatus},
+				Health: v1alpha1.AppHealthStatus{Status: healthStatus},
+			},
+		}
+	}
+	getApp := func(apps ...*v1alpha1.Application) getAppFunc {
+		return func(namespace, name string) (*v1alpha1.Application, error) {
+			for _, app := range apps {
+				if app.Namespace == namespace && app.Name == name {
+					return app, nil
+				}
+			}
+			return nil, apierrors.NewNotFound(v1alpha1.SchemeGroupVersion.WithResource("applications").GroupResource(), name)
+		}
+	}
+
+	namespaceAllowed := func(namespace string) bool {
+		return namespace == "argocd" || namespace == "infra"
+	}
+
+	t.Run("Ready", func(t *testing.T) {
+		app := newApp("argocd", "app", "", "", "db", "infra/network")
+		pending, err := checkAppDependencies(app, namespaceAllowed, getApp(
+			newApp("argocd", "db", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy),
+			newApp("infra", "network", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy),
+		))
+		require.NoError(t, err)
+		assert.Empty(t, pending)
+	})
+
+	t.Run("Pending", func(t *testing.T) {
+		app := newApp("argocd", "app", "", "", "db", "infra/network", "cache")
+		pending, err := checkAppDependencies(app, namespaceAllowed, getApp(
+			newApp("argocd", "db", v1alpha1.SyncStatusCodeSynced, health.HealthStatusProgressing),
+			newApp("infra", "network", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy),
+		))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"db (Synced, Progressing)", "cache (not found)"}, pending)
+	})
+
+	t.Run("Cycle", func(t *testing.T) {
+		app := newApp("argocd", "app", "", "", "db")
+		_, err := checkAppDependencies(app, namespaceAllowed, getApp(
+			app,
+			newApp("argocd", "db", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy, "infra/network"),
+			newApp("infra", "network", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy, "argocd/app"),
+		))
+		require.EqualError(t, err, "dependencies form a cycle: argocd/app -> argocd/db -> infra/network -> argocd/app")
+	})
+
+	t.Run("NamespaceNotEnabled", func(t *testing.T) {
+		app := newApp("argocd", "app", "", "", "team/db")
+		_, err := checkAppDependencies(app, namespaceAllowed, getApp(
+			newApp("team", "db", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy),
+		))
+		require.EqualError(t, err, "dependency team/db is in namespace team, which is not enabled for applications")
+	})
+
+	t.Run("OtherProject", func(t *testing.T) {
+		app := newApp("argocd", "app", "", "", "db")
+		db := newApp("argocd", "db", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy)
+		db.Spec.Project = "other"
+		_, err := checkAppDependencies(app, namespaceAllowed, getApp(db))
+		require.EqualError(t, err, "dependency db does not belong to project default")
+	})
+
+	t.Run("CycleOfDependencies", func(t *testing.T) {
+		// a cycle which does not lead back to the application is reported by the applications which are part of it
+		app := newApp("argocd", "app", "", "", "db")
+		pending, err := checkAppDependencies(app, namespaceAllowed, getApp(
+			app,
+			newApp("argocd", "db", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusHealthy, "cache"),
+			newApp("argocd", "cache", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusHealthy, "db"),
+		))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"db (OutOfSync, Healthy)"}, pending)
+	})
+}
+
+func TestRequestDependentAppsRefresh(t *testing.T) {
+	database := newFakeApp()
+	database.Name = "database"
+	dependent := newFakeApp()
+	dependent.Name = "dependent"
+	dependent.Spec.DependsOn = []string{"database"}
+	other := newFakeApp()
+	other.Name = "other"
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{database, dependent, other}}, nil)
+
+	ctrl.requestDependentAppsRefresh(database)
+
+	isRequested, level := ctrl.isRefreshRequested(dependent.QualifiedName())
+	assert.True(t, isRequested)
+	assert.Equal(t, CompareWithRecent, level)
+	isRequested, _ = ctrl.isRefreshRequested(other.QualifiedName())
+	assert.False(t, isRequested)
+}
+
 func TestAutoSyncEnabledSetToTrue(t *testing.T) {
 	app := newFakeApp()
 	enable := true
@@ -2421,6 +2567,45 @@ func Test_syncDeleteOption(t *testing.T) {
 		cmObj.SetAnnotations(map[string]string{"helm.sh/resource-policy": "keep"})
 		assert.False(t, ctrl.shouldBeDeleted(app, cmObj))
 	})
+	t.Run("with deletion policy orphan object is retained", func(t *testing.T) {
+		cmObj := kube.MustToUnstructured(&cm)
+		cmObj.SetAnnotations(map[string]string{"argocd.argoproj.io/deletion-policy": "orphan"})
+		assert.False(t, ctrl.shouldBeDeleted(app, cmObj))
+	})
+	t.Run("with deletion policy background object is deleted", func(t *testing.T) {
+		cmObj := kube.MustToUnstructured(&cm)
+		cmObj.SetAnnotations(map[string]string{"argocd.argoproj.io/deletion-policy": "background"})
+		assert.True(t, ctrl.shouldBeDeleted(app, cmObj))
+	})
+}
+
+func Test_deletionPropagationPolicy(t *testing.T) {
+	cm := newFakeCM()
+	tests := []struct {
+		name       string
+		finalizer  string
+		annotation string
+		expected   metav1.DeletionPropagation
+	}{
+		{"foreground finalizer without annotation", "", "", metav1.DeletePropagationForeground},
+		{"background finalizer without annotation", v1alpha1.BackgroundPropagationPolicyFinalizer, "", metav1.DeletePropagationBackground},
+		{"foreground finalizer with background annotation", "", "background", metav1.DeletePropagationBackground},
+		{"background finalizer with foreground annotation", v1alpha1.BackgroundPropagationPolicyFinalizer, "foreground", metav1.DeletePropagationForeground},
+		{"unknown annotation value", v1alpha1.BackgroundPropagationPolicyFinalizer, "unknown", metav1.DeletePropagationBackground},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := newFakeApp()
+			if tt.finalizer != "" {
+				app.SetFinalizers([]string{tt.finalizer})
+			}
+			cmObj := kube.MustToUnstructured(&cm)
+			if tt.annotation != "" {
+				cmObj.SetAnnotations(map[string]string{"argocd.argoproj.io/deletion-policy": tt.annotation})
+			}
+			assert.Equal(t, tt.expected, deletionPropagationPolicy(app, cmObj))
+		})
+	}
 }
 
 func TestAddControllerNamespace(t *testing.T) {
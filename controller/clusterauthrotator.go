@@ -0,0 +1,152 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	log "github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/clusterauth"
+	"github.com/argoproj/argo-cd/v3/util/db"
+	"github.com/argoproj/argo-cd/v3/util/env"
+)
+
+const (
+	defaultClusterAuthRotationCheckInterval = 5 * time.Minute
+	// clusterAuthRotationForbiddenBackoff is the time a rotation which was not permitted to update the cluster secret is
+	// not attempted again, so that a new token is not created on the cluster at every check
+	clusterAuthRotationForbiddenBackoff = time.Hour
+
+	EnvClusterAuthRotationCheckInterval = "ARGOCD_CLUSTER_AUTH_ROTATION_CHECK_INTERVAL"
+)
+
+var clusterAuthRotationCheckInterval = env.ParseDurationFromEnv(EnvClusterAuthRotationCheckInterval, defaultClusterAuthRotationCheckInterval, 10*time.Second, 24*time.Hour)
+
+// clusterAuthRotator rotates the bearer tokens of the clusters which have a rotateAuthInterval once it elapsed,
+// the same way as `argocd cluster rotate-auth` does
+type clusterAuthRotator struct {
+	db            db.ArgoDB
+	clusterFilter func(cluster *appv1.Cluster) bool
+	// rotateAuth rotates the bearer token of the cluster. It returns the cluster as persisted, which is nil if the
+	// rotation failed before the cluster has been updated.
+	rotateAuth func(ctx context.Context, cluster *appv1.Cluster, now time.Time) (*appv1.Cluster, error)
+	// forbiddenUntil holds the servers of the clusters whose secrets the controller was not permitted to update, and
+	// until when their rotation is not attempted again
+	forbiddenUntil map[string]time.Time
+}
+
+func NewClusterAuthRotator(db db.ArgoDB, kubectl kube.Kubectl, clusterFilter func(cluster *appv1.Cluster) bool) *clusterAuthRotator {
+	return &clusterAuthRotator{
+		db:            db,
+		clusterFilter: clusterFilter,
+		rotateAuth: func(ctx context.Context, cluster *appv1.Cluster, now time.Time) (*appv1.Cluster, error) {
+			return rotateClusterAuth(ctx, db, kubectl, cluster, now)
+		},
+	}
+}
+
+func (r *clusterAuthRotator) Run(ctx context.Context) {
+	ticker := time.NewTicker(clusterAuthRotationCheckInterval)
+	for {
+		select {
+		case <-ctx.Done():
+			ticker.Stop()
+			return
+		case <-ticker.C:
+			r.rotateClusters(ctx, time.Now())
+		}
+	}
+}
+
+func (r *clusterAuthRotator) rotateClusters(ctx context.Context, now time.Time) {
+	clusters, err := r.db.ListClusters(ctx)
+	if err != nil {
+		log.Warnf("Failed to list clusters to rotate auth: %v", err)
+		return
+	}
+	for i := range clusters.Items {
+		cluster := clusters.Items[i]
+		if r.clusterFilter != nil && !r.clusterFilter(&cluster) {
+			continue
+		}
+		logCtx := log.WithField("cluster", cluster.Server)
+		due, err := clusterAuthRotationDue(&cluster, now)
+		if !due && err == nil {
+			continue
+		}
+		if now.Before(r.forbiddenUntil[cluster.Server]) {
+			continue
+		}
+		var updated *appv1.Cluster
+		if err == nil {
+			logCtx.Info("Rotating auth")
+			updated, err = r.rotateAuth(ctx, cluster.DeepCopy(), now)
+			if err == nil {
+				delete(r.forbiddenUntil, cluster.Server)
+				logCtx.Info("Rotated auth")
+				continue
+			}
+		}
+		if apierrors.IsForbidden(err) {
+			// the error can not be recorded in the cluster secret either
+			logCtx.Warnf("Failed to rotate auth, the application controller needs the permission to update the cluster secret: %v", err)
+			if r.forbiddenUntil == nil {
+				r.forbiddenUntil = map[string]time.Time{}
+			}
+			r.forbiddenUntil[cluster.Server] = now.Add(clusterAuthRotationForbiddenBackoff)
+			continue
+		}
+		logCtx.Warnf("Failed to rotate auth: %v", err)
+		if updated == nil {
+			updated = &cluster
+		}
+		if updated.AuthRotationError == err.Error() {
+			continue
+		}
+		updated.AuthRotationError = err.Error()
+		if _, err := r.db.UpdateCluster(ctx, updated); err != nil {
+			logCtx.Warnf("Failed to record auth rotation error: %v", err)
+		}
+	}
+}
+
+// clusterAuthRotationDue returns true if the bearer token of the cluster needs to be rotated, and an error if the
+// cluster has a rotateAuthInterval but its bearer token cannot be rotated
+func clusterAuthRotationDue(cluster *appv1.Cluster, now time.Time) (bool, error) {
+	interval, err := cluster.Config.GetRotateAuthInterval()
+	if err != nil || interval == 0 {
+		return false, err
+	}
+	if cluster.Config.BearerToken == "" {
+		return false, errors.New("cluster does not use bearer token authentication")
+	}
+	// the age of a token which has never been rotated is unknown, so it is rotated right away
+	return cluster.AuthRotatedAt == nil || !now.Before(cluster.AuthRotatedAt.Add(interval)), nil
+}
+
+// rotateClusterAuth replaces the bearer token of the cluster with the token of a new secret of the same service
+// account, see clusterauth.RotateClusterToken
+func rotateClusterAuth(ctx context.Context, argoDB db.ArgoDB, kubectl kube.Kubectl, cluster *appv1.Cluster, now time.Time) (*appv1.Cluster, error) {
+	var updated *appv1.Cluster
+	err := clusterauth.RotateClusterToken(cluster, func(config *rest.Config) error {
+		_, err := kubectl.GetServerVersion(config)
+		return err
+	}, func(cluster *appv1.Cluster) error {
+		cluster.AuthRotatedAt = &metav1.Time{Time: now}
+		cluster.AuthRotationError = ""
+		var err error
+		updated, err = argoDB.UpdateCluster(ctx, cluster)
+		if err != nil {
+			return fmt.Errorf("failed to update cluster in database: %w", err)
+		}
+		return nil
+	})
+	return updated, err
+}
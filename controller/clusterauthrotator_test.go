@@ -0,0 +1,121 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	dbmocks "github.com/argoproj/argo-cd/v3/util/db/mocks"
+)
+
+func TestClusterAuthRotationDue(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	rotatedAt := metav1.NewTime(now.Add(-24 * time.Hour))
+	newCluster := func(interval string, rotatedAt *metav1.Time) *v1alpha1.Cluster {
+		return &v1alpha1.Cluster{
+			Server:        "https://cluster",
+			Config:        v1alpha1.ClusterConfig{BearerToken: "token", RotateAuthInterval: interval},
+			AuthRotatedAt: rotatedAt,
+		}
+	}
+
+	due, err := clusterAuthRotationDue(newCluster("", nil), now)
+	require.NoError(t, err)
+	assert.False(t, due)
+
+	due, err = clusterAuthRotationDue(newCluster("720h", nil), now)
+	require.NoError(t, err)
+	assert.True(t, due)
+
+	due, err = clusterAuthRotationDue(newCluster("720h", &rotatedAt), now)
+	require.NoError(t, err)
+	assert.False(t, due)
+
+	due, err = clusterAuthRotationDue(newCluster("24h", &rotatedAt), now)
+	require.NoError(t, err)
+	assert.True(t, due)
+
+	_, err = clusterAuthRotationDue(newCluster("monthly", nil), now)
+	require.ErrorContains(t, err, "invalid rotateAuthInterval")
+
+	cluster := newCluster("720h", nil)
+	cluster.Config.BearerToken = ""
+	_, err = clusterAuthRotationDue(cluster, now)
+	require.ErrorContains(t, err, "does not use bearer token authentication")
+}
+
+func TestClusterAuthRotator_RotateClusters(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	clusters := &v1alpha1.ClusterList{Items: []v1alpha1.Cluster{
+		{Server: "https://due", Config: v1alpha1.ClusterConfig{BearerToken: "token", RotateAuthInterval: "720h"}},
+		{Server: "https://failing", Config: v1alpha1.ClusterConfig{BearerToken: "token", RotateAuthInterval: "720h"}},
+		{Server: "https://not-due", Config: v1alpha1.ClusterConfig{BearerToken: "token"}},
+		{Server: "https://other-shard", Config: v1alpha1.ClusterConfig{BearerToken: "token", RotateAuthInterval: "720h"}},
+		{Server: "https://exec", Config: v1alpha1.ClusterConfig{RotateAuthInterval: "720h"}, AuthRotationError: "cluster does not use bearer token authentication"},
+	}}
+	db := &dbmocks.ArgoDB{}
+	db.On("ListClusters", mock.Anything).Return(clusters, nil)
+	var recorded []*v1alpha1.Cluster
+	db.On("UpdateCluster", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		recorded = append(recorded, args.Get(1).(*v1alpha1.Cluster))
+	}).Return(nil, nil)
+
+	var rotated []string
+	rotator := &clusterAuthRotator{
+		db: db,
+		clusterFilter: func(cluster *v1alpha1.Cluster) bool {
+			return cluster.Server != "https://other-shard"
+		},
+		rotateAuth: func(_ context.Context, cluster *v1alpha1.Cluster, _ time.Time) (*v1alpha1.Cluster, error) {
+			rotated = append(rotated, cluster.Server)
+			if cluster.Server == "https://failing" {
+				return nil, errors.New("failed to verify the new token")
+			}
+			return cluster, nil
+		},
+	}
+	rotator.rotateClusters(t.Context(), now)
+
+	assert.Equal(t, []string{"https://due", "https://failing"}, rotated)
+	// the error of the failed rotation is recorded, the unchanged error of the exec cluster is not updated again
+	require.Len(t, recorded, 1)
+	assert.Equal(t, "https://failing", recorded[0].Server)
+	assert.Equal(t, "failed to verify the new token", recorded[0].AuthRotationError)
+	assert.Equal(t, "token", recorded[0].Config.BearerToken)
+}
+
+func TestClusterAuthRotator_RotateClusters_Forbidden(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	clusters := &v1alpha1.ClusterList{Items: []v1alpha1.Cluster{
+		{Server: "https://due", Config: v1alpha1.ClusterConfig{BearerToken: "token", RotateAuthInterval: "720h"}},
+	}}
+	db := &dbmocks.ArgoDB{}
+	db.On("ListClusters", mock.Anything).Return(clusters, nil)
+
+	attempts := 0
+	rotator := &clusterAuthRotator{
+		db: db,
+		rotateAuth: func(_ context.Context, _ *v1alpha1.Cluster, _ time.Time) (*v1alpha1.Cluster, error) {
+			attempts++
+			return nil, fmt.Errorf("failed to update cluster in database: %w", apierrors.NewForbidden(schema.GroupResource{Resource: "secrets"}, "cluster-due", errors.New("forbidden")))
+		},
+	}
+	rotator.rotateClusters(t.Context(), now)
+	// the rotation is not attempted again until the backoff elapsed, and the error is not recorded
+	rotator.rotateClusters(t.Context(), now.Add(clusterAuthRotationCheckInterval))
+	assert.Equal(t, 1, attempts)
+	db.AssertNotCalled(t, "UpdateCluster", mock.Anything, mock.Anything)
+
+	rotator.rotateClusters(t.Context(), now.Add(clusterAuthRotationForbiddenBackoff))
+	assert.Equal(t, 2, attempts)
+}
@@ -2,7 +2,10 @@ package controller
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/argoproj/argo-cd/v3/common"
@@ -12,6 +15,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/discovery"
 
 	"github.com/argoproj/argo-cd/v3/util/env"
 
@@ -159,6 +163,8 @@ func (c *clusterInfoUpdater) getUpdatedClusterInfo(ctx context.Context, apps []*
 		default:
 			clusterInfo.ConnectionState.Status = appv1.ConnectionStatusFailed
 			clusterInfo.ConnectionState.Message = info.SyncError.Error()
+			clusterInfo.FailedAPIGroups = failedAPIGroups(info.SyncError)
+			clusterInfo.ErrorChain = errorChain(info.SyncError)
 		}
 	} else {
 		clusterInfo.ConnectionState.Status = appv1.ConnectionStatusUnknown
@@ -170,6 +176,35 @@ func (c *clusterInfoUpdater) getUpdatedClusterInfo(ctx context.Context, apps []*
 	return clusterInfo
 }
 
+// failedAPIGroups returns the sorted API group versions whose discovery failed, if the error was caused by it
+func failedAPIGroups(err error) []string {
+	var discoveryErr *discovery.ErrGroupDiscoveryFailed
+	if !errors.As(err, &discoveryErr) {
+		return nil
+	}
+	groups := make([]string, 0, len(discoveryErr.Groups))
+	for gv := range discoveryErr.Groups {
+		groups = append(groups, gv.String())
+	}
+	sort.Strings(groups)
+	return groups
+}
+
+// errorChain returns the message each error in the chain of wrapped errors adds, outermost first
+func errorChain(err error) []string {
+	var chain []string
+	for err != nil {
+		next := errors.Unwrap(err)
+		msg := err.Error()
+		if next != nil {
+			msg = strings.TrimSuffix(msg, ": "+next.Error())
+		}
+		chain = append(chain, msg)
+		err = next
+	}
+	return chain
+}
+
 func updateClusterLabels(ctx context.Context, clusterInfo *cache.ClusterInfo, cluster appv1.Cluster, updateCluster func(context.Context, *appv1.Cluster) (*appv1.Cluster, error)) error {
 	if clusterInfo != nil && cluster.Labels[common.LabelKeyAutoLabelClusterInfo] == "true" && cluster.Labels[common.LabelKeyClusterKubernetesVersion] != clusterInfo.K8SVersion {
 		cluster.Labels[common.LabelKeyClusterKubernetesVersion] = clusterInfo.K8SVersion
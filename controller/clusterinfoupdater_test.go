@@ -9,6 +9,8 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
 
 	"github.com/argoproj/argo-cd/v3/common"
 
@@ -101,6 +103,25 @@ func TestClusterSecretUpdater(t *testing.T) {
 	}
 }
 
+func TestFailedAPIGroupsAndErrorChain(t *testing.T) {
+	discoveryErr := &discovery.ErrGroupDiscoveryFailed{Groups: map[schema.GroupVersion]error{
+		{Group: "metrics.k8s.io", Version: "v1beta1"}: errors.New("the server is currently unable to handle the request"),
+		{Group: "apps", Version: "v1"}:                errors.New("timeout"),
+	}}
+	err := fmt.Errorf("failed to sync cluster https://10.0.0.1: %w", fmt.Errorf("failed to get api resources: %w", discoveryErr))
+
+	assert.Equal(t, []string{"apps/v1", "metrics.k8s.io/v1beta1"}, failedAPIGroups(err))
+	assert.Equal(t, []string{
+		"failed to sync cluster https://10.0.0.1",
+		"failed to get api resources",
+		discoveryErr.Error(),
+	}, errorChain(err))
+
+	err = errors.New("connection refused")
+	assert.Nil(t, failedAPIGroups(err))
+	assert.Equal(t, []string{"connection refused"}, errorChain(err))
+}
+
 func TestUpdateClusterLabels(t *testing.T) {
 	shouldNotBeInvoked := func(_ context.Context, _ *v1alpha1.Cluster) (*v1alpha1.Cluster, error) {
 		shouldNotHappen := errors.New("if an error happens here, something's wrong")
@@ -0,0 +1,159 @@
+package controller
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	log "github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+)
+
+// getAppFunc returns the application with the given namespace and name
+type getAppFunc func(namespace, name string) (*appv1.Application, error)
+
+// dependencyKey returns the qualified name of the application referenced by the dependency of the given application
+func dependencyKey(app *appv1.Application, dependency string) string {
+	name, namespace := argo.ParseFromQualifiedName(dependency, app.Namespace)
+	return namespace + "/" + name
+}
+
+// dependencyKeys returns the qualified names of the applications the application directly depends on
+func dependencyKeys(app *appv1.Application) []string {
+	keys := make([]string, 0, len(app.Spec.DependsOn))
+	for _, dependency := range app.Spec.DependsOn {
+		keys = append(keys, dependencyKey(app, dependency))
+	}
+	return keys
+}
+
+// validateDependencies returns an error if the application depends on an application outside of its project or in a
+// namespace which is not enabled for applications, as the state of those applications must not control its syncs
+func validateDependencies(app *appv1.Application, getApp getAppFunc, namespaceAllowed func(namespace string) bool) error {
+	for _, dependency := range app.Spec.DependsOn {
+		name, namespace := argo.ParseFromQualifiedName(dependency, app.Namespace)
+		if !namespaceAllowed(namespace) {
+			return fmt.Errorf("dependency %s is in namespace %s, which is not enabled for applications", dependency, namespace)
+		}
+		dependencyApp, err := getApp(namespace, name)
+		if apierrors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return fmt.Errorf("failed to get dependency %s: %w", dependency, err)
+		}
+		if dependencyApp.Spec.GetProject() != app.Spec.GetProject() {
+			return fmt.Errorf("dependency %s does not belong to project %s", dependency, app.Spec.GetProject())
+		}
+	}
+	return nil
+}
+
+// findDependencyCycle returns the cycle of dependencies leading back to the application, or nil if its dependencies
+// do not form a cycle through it
+func findDependencyCycle(app *appv1.Application, getApp getAppFunc) ([]string, error) {
+	start := app.Namespace + "/" + app.Name
+	visited := map[string]bool{}
+	var visit func(current *appv1.Application, path []string) ([]string, error)
+	visit = func(current *appv1.Application, path []string) ([]string, error) {
+		for _, dependency := range current.Spec.DependsOn {
+			key := dependencyKey(current, dependency)
+			if key == start {
+				return append(path, key), nil
+			}
+			if visited[key] {
+				continue
+			}
+			visited[key] = true
+			name, namespace := argo.ParseFromQualifiedName(dependency, current.Namespace)
+			next, err := getApp(namespace, name)
+			if apierrors.IsNotFound(err) {
+				continue
+			} else if err != nil {
+				return nil, err
+			}
+			if cycle, err := visit(next, append(path, key)); cycle != nil || err != nil {
+				return cycle, err
+			}
+		}
+		return nil, nil
+	}
+	return visit(app, []string{start})
+}
+
+// checkAppDependencies returns the dependencies of the application which are not synced and healthy yet. An error is
+// returned if the dependencies cannot be resolved, e.g. because they form a cycle or are not in the project of the
+// application.
+func checkAppDependencies(app *appv1.Application, namespaceAllowed func(namespace string) bool, getApp getAppFunc) ([]string, error) {
+	if len(app.Spec.DependsOn) == 0 {
+		return nil, nil
+	}
+	if err := validateDependencies(app, getApp, namespaceAllowed); err != nil {
+		return nil, err
+	}
+	cycle, err := findDependencyCycle(app, getApp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve dependencies: %w", err)
+	}
+	if cycle != nil {
+		return nil, fmt.Errorf("dependencies form a cycle: %s", strings.Join(cycle, " -> "))
+	}
+	var pending []string
+	for _, dependency := range app.Spec.DependsOn {
+		name, namespace := argo.ParseFromQualifiedName(dependency, app.Namespace)
+		dependencyApp, err := getApp(namespace, name)
+		switch {
+		case apierrors.IsNotFound(err):
+			pending = append(pending, dependency+" (not found)")
+		case err != nil:
+			return nil, fmt.Errorf("failed to get dependency %s: %w", dependency, err)
+		case dependencyApp.Status.Sync.Status != appv1.SyncStatusCodeSynced || dependencyApp.Status.Health.Status != health.HealthStatusHealthy:
+			pending = append(pending, fmt.Sprintf("%s (%s, %s)", dependency, dependencyApp.Status.Sync.Status, dependencyApp.Status.Health.Status))
+		}
+	}
+	return pending, nil
+}
+
+// getAppFromLister returns the application with the given namespace and name from the informer cache
+func (ctrl *ApplicationController) getAppFromLister(namespace, name string) (*appv1.Application, error) {
+	return ctrl.appLister.Applications(namespace).Get(name)
+}
+
+// dependenciesPreventSync returns a message listing the dependencies the application waits for before it is synced,
+// or an empty string if all of them are synced and healthy
+func (ctrl *ApplicationController) dependenciesPreventSync(app *appv1.Application) (string, error) {
+	pending, err := checkAppDependencies(app, ctrl.isNamespaceAllowed, ctrl.getAppFromLister)
+	if err != nil || len(pending) == 0 {
+		return "", err
+	}
+	return "Waiting for dependencies to be synced and healthy: " + strings.Join(pending, ", "), nil
+}
+
+// dependencyConditions returns the conditions reporting the state of the dependencies of the application
+func (ctrl *ApplicationController) dependencyConditions(app *appv1.Application) []appv1.ApplicationCondition {
+	message, err := ctrl.dependenciesPreventSync(app)
+	switch {
+	case err != nil:
+		return []appv1.ApplicationCondition{{Type: appv1.ApplicationConditionDependencyError, Message: err.Error()}}
+	case message != "":
+		return []appv1.ApplicationCondition{{Type: appv1.ApplicationConditionDependencyWarning, Message: message}}
+	}
+	return []appv1.ApplicationCondition{}
+}
+
+// requestDependentAppsRefresh requests a refresh of the applications which depend on the given application, so that
+// their pending syncs are started as soon as it becomes synced and healthy
+func (ctrl *ApplicationController) requestDependentAppsRefresh(app *appv1.Application) {
+	objs, err := ctrl.appInformer.GetIndexer().ByIndex(dependsOnIndex, app.Namespace+"/"+app.Name)
+	if err != nil {
+		log.Warnf("Failed to list applications depending on %s: %v", app.QualifiedName(), err)
+		return
+	}
+	for _, obj := range objs {
+		if dependent, ok := obj.(*appv1.Application); ok && ctrl.canProcessApp(dependent) {
+			ctrl.requestAppRefresh(dependent.QualifiedName(), CompareWithRecent.Pointer(), nil)
+		}
+	}
+}
@@ -1062,6 +1062,7 @@ func (m *appStateManager) persistRevisionHistory(
 	hasMultipleSources bool,
 	startedAt metav1.Time,
 	initiatedBy v1alpha1.OperationInitiator,
+	reason string,
 ) error {
 	var nextID int64
 	if len(app.Status.History) > 0 {
@@ -1076,6 +1077,7 @@ func (m *appStateManager) persistRevisionHistory(
 			Sources:         sources,
 			Revisions:       revisions,
 			InitiatedBy:     initiatedBy,
+			Reason:          reason,
 		})
 	} else {
 		app.Status.History = append(app.Status.History, v1alpha1.RevisionHistory{
@@ -1085,6 +1087,7 @@ func (m *appStateManager) persistRevisionHistory(
 			ID:              nextID,
 			Source:          source,
 			InitiatedBy:     initiatedBy,
+			Reason:          reason,
 		})
 	}
 
@@ -21,6 +21,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/managedfields"
 	"k8s.io/client-go/kubernetes/scheme"
@@ -134,6 +135,16 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 
 	syncOp := *state.Operation.Sync
 
+	resourceSelector := labels.Everything()
+	if syncOp.ResourceSelector != "" {
+		resourceSelector, err = labels.Parse(syncOp.ResourceSelector)
+		if err != nil {
+			state.Phase = common.OperationError
+			state.Message = fmt.Sprintf("Invalid resource selector: %v", err)
+			return
+		}
+	}
+
 	if state.SyncResult == nil {
 		state.SyncResult = newSyncOperationResult(app, syncOp)
 	}
@@ -325,12 +336,13 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 			}
 			return nil
 		}),
-		sync.WithOperationSettings(syncOp.DryRun, syncOp.Prune, syncOp.SyncStrategy.Force(), syncOp.IsApplyStrategy() || len(syncOp.Resources) > 0),
+		sync.WithOperationSettings(syncOp.DryRun, syncOp.Prune, syncOp.SyncStrategy.Force(), syncOp.IsApplyStrategy() || syncOp.IsPartialSync()),
 		sync.WithInitialState(state.Phase, state.Message, initialResourcesRes, state.StartedAt),
 		sync.WithResourcesFilter(func(key kube.ResourceKey, target *unstructured.Unstructured, live *unstructured.Unstructured) bool {
 			return (len(syncOp.Resources) == 0 ||
 				isPostDeleteHook(target) ||
 				argo.ContainsSyncResource(key.Name, key.Namespace, schema.GroupVersionKind{Kind: key.Kind, Group: key.Group}, syncOp.Resources)) &&
+				(isPostDeleteHook(target) || matchesResourceSelector(resourceSelector, live, target)) &&
 				m.isSelfReferencedObj(live, target, app.GetName(), v1alpha1.TrackingMethod(trackingMethod), installationID)
 		}),
 		sync.WithManifestValidation(!syncOp.SyncOptions.HasOption(common.SyncOptionsDisableValidation)),
@@ -421,8 +433,8 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 
 	logEntry.WithField("duration", time.Since(start)).Info("sync/terminate complete")
 
-	if !syncOp.DryRun && len(syncOp.Resources) == 0 && state.Phase.Successful() {
-		err := m.persistRevisionHistory(app, compareResult.syncStatus.Revision, compareResult.syncStatus.ComparedTo.Source, compareResult.syncStatus.Revisions, compareResult.syncStatus.ComparedTo.Sources, isMultiSourceSync, state.StartedAt, state.Operation.InitiatedBy)
+	if !syncOp.DryRun && !syncOp.IsPartialSync() && state.Phase.Successful() {
+		err := m.persistRevisionHistory(app, compareResult.syncStatus.Revision, compareResult.syncStatus.ComparedTo.Source, compareResult.syncStatus.Revisions, compareResult.syncStatus.ComparedTo.Sources, isMultiSourceSync, state.StartedAt, state.Operation.InitiatedBy, syncOp.Reason)
 		if err != nil {
 			state.Phase = common.OperationError
 			state.Message = fmt.Sprintf("failed to record sync to history: %v", err)
@@ -555,6 +567,19 @@ func delayBetweenSyncWaves(_ common.SyncPhase, _ int, finalWave bool) error {
 	return nil
 }
 
+// matchesResourceSelector returns whether the labels of the target state of the resource match the selector. The labels
+// of the live state are used for resources which are going to be pruned.
+func matchesResourceSelector(selector labels.Selector, live *unstructured.Unstructured, target *unstructured.Unstructured) bool {
+	if selector.Empty() {
+		return true
+	}
+	obj := target
+	if obj == nil {
+		obj = live
+	}
+	return obj != nil && selector.Matches(labels.Set(obj.GetLabels()))
+}
+
 func syncWindowPreventsSync(app *v1alpha1.Application, proj *v1alpha1.AppProject) (bool, error) {
 	window := proj.Spec.SyncWindows.Matches(app)
 	isManual := false
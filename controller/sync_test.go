@@ -12,6 +12,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/argoproj/argo-cd/v3/common"
@@ -277,6 +278,38 @@ func TestAppStateManager_SyncAppState(t *testing.T) {
 		assert.Equal(t, synccommon.OperationFailed, opState.Phase)
 		assert.Contains(t, opState.Message, "ConfigMap/configmap1 is part of applications fake-argocd-ns/my-app and guestbook")
 	})
+
+	t.Run("will fail the sync if the resource selector is invalid", func(t *testing.T) {
+		t.Parallel()
+		f := setup(nil)
+		opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{
+			Sync: &v1alpha1.SyncOperation{
+				Source:           &v1alpha1.ApplicationSource{},
+				ResourceSelector: "tier in frontend",
+			},
+		}}
+
+		f.controller.appStateManager.SyncAppState(f.application, f.project, opState)
+
+		assert.Equal(t, synccommon.OperationError, opState.Phase)
+		assert.Contains(t, opState.Message, "Invalid resource selector")
+	})
+}
+
+func TestMatchesResourceSelector(t *testing.T) {
+	newObj := func(objLabels map[string]string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetLabels(objLabels)
+		return obj
+	}
+	selector, err := labels.Parse("tier=frontend")
+	require.NoError(t, err)
+
+	assert.True(t, matchesResourceSelector(selector, nil, newObj(map[string]string{"tier": "frontend"})))
+	assert.False(t, matchesResourceSelector(selector, newObj(map[string]string{"tier": "frontend"}), newObj(map[string]string{"tier": "backend"})), "the labels of the target state take precedence")
+	assert.True(t, matchesResourceSelector(selector, newObj(map[string]string{"tier": "frontend"}), nil), "resources which are going to be pruned are selected by their live labels")
+	assert.False(t, matchesResourceSelector(selector, nil, nil))
+	assert.True(t, matchesResourceSelector(labels.Everything(), nil, newObj(nil)))
 }
 
 func TestSyncWindowDeniesSync(t *testing.T) {
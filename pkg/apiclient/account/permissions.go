@@ -0,0 +1,20 @@
+package account
+
+// CanIListAll is used as the action, resource and subresource of a CanIRequest to request the effective permissions
+// of the caller instead of the evaluation of a single request. The value of the response is then the JSON encoded
+// list of Permission.
+const CanIListAll = "*"
+
+// Permission is a policy rule which applies to the caller, either directly or through a group or role
+type Permission struct {
+	Subject  string `json:"subject"`
+	Resource string `json:"resource"`
+	Action   string `json:"action"`
+	Object   string `json:"object"`
+	Effect   string `json:"effect"`
+}
+
+// IsListAll returns whether the request asks for the effective permissions of the caller
+func (r *CanIRequest) IsListAll() bool {
+	return r.Action == CanIListAll && r.Resource == CanIListAll && r.Subresource == CanIListAll
+}
@@ -10,6 +10,7 @@ import (
 	"math"
 	"net"
 	"net/http"
+	"net/url"
 	"os"
 	"strings"
 	"sync"
@@ -18,6 +19,7 @@ import (
 	"github.com/coreos/go-oidc/v3/oidc"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/gorilla/websocket"
 	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/retry"
 	"github.com/hashicorp/go-retryablehttp"
 	log "github.com/sirupsen/logrus"
@@ -47,6 +49,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/argo"
 	"github.com/argoproj/argo-cd/v3/util/env"
+	argocderrors "github.com/argoproj/argo-cd/v3/util/errors"
 	grpc_util "github.com/argoproj/argo-cd/v3/util/grpc"
 	http_util "github.com/argoproj/argo-cd/v3/util/http"
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
@@ -99,6 +102,9 @@ type Client interface {
 	NewAccountClient() (io.Closer, accountpkg.AccountServiceClient, error)
 	NewAccountClientOrDie() (io.Closer, accountpkg.AccountServiceClient)
 	WatchApplicationWithRetry(ctx context.Context, appName string, revision string) chan *v1alpha1.ApplicationWatchEvent
+	WatchApplicationsWithRetry(ctx context.Context, query *applicationpkg.ApplicationQuery) chan *v1alpha1.ApplicationWatchEvent
+	NewTerminalConn(ctx context.Context, query url.Values) (*websocket.Conn, error)
+	NewPortForwardConn(ctx context.Context, query url.Values) (*websocket.Conn, error)
 }
 
 // ClientOptions hold address, security, and other settings for the API client.
@@ -126,8 +132,10 @@ type ClientOptions struct {
 	RedisHaProxyName     string
 	RedisName            string
 	RedisCompression     string
+	RedisMode            string
 	RepoServerName       string
 	PromptsEnabled       bool
+	NoVersionWarning     bool
 }
 
 type client struct {
@@ -158,7 +166,7 @@ func NewClient(opts *ClientOptions) (Client, error) {
 		return nil, err
 	}
 	c.proxyMutex = &sync.Mutex{}
-	var ctxName string
+	var ctxName, ctxServerAddr string
 	if localCfg != nil {
 		configCtx, err := localCfg.ResolveContext(opts.Context)
 		if err != nil {
@@ -196,6 +204,7 @@ func NewClient(opts *ClientOptions) (Client, error) {
 			c.AuthToken = configCtx.User.AuthToken
 			c.RefreshToken = configCtx.User.RefreshToken
 			ctxName = configCtx.Name
+			ctxServerAddr = configCtx.Server.Server
 		}
 	}
 	if opts.UserAgent != "" {
@@ -220,6 +229,8 @@ func NewClient(opts *ClientOptions) (Client, error) {
 	if opts.ServerAddr != "" {
 		c.ServerAddr = opts.ServerAddr
 	}
+	// The server version cached in the context is only meaningful if we are talking to the context's server
+	serverFromContext := ctxName != "" && c.ServerAddr == ctxServerAddr
 	// Make sure we got the server address and auth token from somewhere
 	if c.ServerAddr == "" {
 		//nolint:staticcheck // First letter of error is intentionally capitalized.
@@ -305,12 +316,15 @@ func NewClient(opts *ClientOptions) (Client, error) {
 		}
 	}
 	if localCfg != nil {
-		err = c.refreshAuthToken(localCfg, ctxName, opts.ConfigPath)
+		err = c.refreshAuthToken(localCfg, ctxName, opts.ConfigPath, time.Now())
 		if err != nil {
 			return nil, err
 		}
 	}
 	c.Headers = opts.Headers
+	if localCfg != nil && serverFromContext && !opts.NoVersionWarning {
+		c.warnOnVersionSkew(localCfg, ctxName, opts.ConfigPath, time.Now())
+	}
 
 	return &c, nil
 }
@@ -389,8 +403,12 @@ func (c *client) HTTPClient() (*http.Client, error) {
 	}, nil
 }
 
-// refreshAuthToken refreshes a JWT auth token if it is invalid (e.g. expired)
-func (c *client) refreshAuthToken(localCfg *localconfig.LocalConfig, ctxName, configPath string) error {
+// authTokenRefreshLeeway is how long before its expiry an auth token is refreshed, so that it does not expire while a
+// command is running
+const authTokenRefreshLeeway = 5 * time.Minute
+
+// refreshAuthToken refreshes a JWT auth token if it is invalid (e.g. expired) or about to expire
+func (c *client) refreshAuthToken(localCfg *localconfig.LocalConfig, ctxName, configPath string, now time.Time) error {
 	if c.RefreshToken == "" {
 		// If we have no refresh token, there's no point in doing anything
 		return nil
@@ -399,21 +417,30 @@ func (c *client) refreshAuthToken(localCfg *localconfig.LocalConfig, ctxName, co
 	if err != nil {
 		return err
 	}
-	parser := jwt.NewParser(jwt.WithoutClaimsValidation())
-	var claims jwt.RegisteredClaims
-	_, _, err = parser.ParseUnverified(configCtx.User.AuthToken, &claims)
+	if c.AuthToken != configCtx.User.AuthToken {
+		// the auth token was given explicitly, so the refresh token of the context does not belong to it
+		return nil
+	}
+	invalid, expiresSoon, err := authTokenRefreshNeeded(configCtx.User.AuthToken, now)
 	if err != nil {
 		return err
 	}
-	validator := jwt.NewValidator()
-	if validator.Validate(claims) == nil {
-		// token is still valid
+	if !invalid && !expiresSoon {
 		return nil
 	}
 
-	log.Debug("Auth token no longer valid. Refreshing")
+	if invalid {
+		log.Debug("Auth token no longer valid. Refreshing")
+	} else {
+		log.Debug("Auth token about to expire. Refreshing")
+	}
 	rawIDToken, refreshToken, err := c.redeemRefreshToken()
 	if err != nil {
+		if !invalid {
+			// the current token is still usable, so don't fail the command
+			log.Warnf("Failed to refresh auth token: %v", err)
+			return nil
+		}
 		return err
 	}
 	c.AuthToken = rawIDToken
@@ -430,6 +457,22 @@ func (c *client) refreshAuthToken(localCfg *localconfig.LocalConfig, ctxName, co
 	return nil
 }
 
+// authTokenRefreshNeeded returns whether the given auth token must be refreshed because it is invalid, and whether it
+// should be refreshed because it expires within authTokenRefreshLeeway
+func authTokenRefreshNeeded(authToken string, now time.Time) (bool, bool, error) {
+	parser := jwt.NewParser(jwt.WithoutClaimsValidation())
+	var claims jwt.RegisteredClaims
+	_, _, err := parser.ParseUnverified(authToken, &claims)
+	if err != nil {
+		return false, false, err
+	}
+	validator := jwt.NewValidator(jwt.WithTimeFunc(func() time.Time { return now }))
+	if validator.Validate(claims) != nil {
+		return true, false, nil
+	}
+	return false, claims.ExpiresAt != nil && claims.ExpiresAt.Sub(now) < authTokenRefreshLeeway, nil
+}
+
 // redeemRefreshToken performs the exchange of a refresh_token for a new id_token and refresh_token
 func (c *client) redeemRefreshToken() (string, string, error) {
 	setConn, setIf, err := c.NewSettingsClient()
@@ -461,8 +504,8 @@ func (c *client) redeemRefreshToken() (string, string, error) {
 	if !ok {
 		return "", "", errors.New("no id_token in token response")
 	}
-	refreshToken, _ := token.Extra("refresh_token").(string)
-	return rawIDToken, refreshToken, nil
+	// the token source keeps the current refresh token if the provider does not rotate it
+	return rawIDToken, token.RefreshToken, nil
 }
 
 // NewClientOrDie creates a new API client from a set of config options, or fails fatally if the new client creation fails.
@@ -525,6 +568,7 @@ func (c *client) newConn() (*grpc.ClientConn, io.Closer, error) {
 	dialOpts = append(dialOpts, grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(MaxGRPCMessageSize), grpc.MaxCallSendMsgSize(MaxGRPCMessageSize)))
 	dialOpts = append(dialOpts, grpc.WithStreamInterceptor(grpc_util.RetryOnlyForServerStreamInterceptor(retryOpts...)))
 	dialOpts = append(dialOpts, grpc.WithUnaryInterceptor(grpc_retry.UnaryClientInterceptor(retryOpts...)))
+	dialOpts = append(dialOpts, grpc.WithChainUnaryInterceptor(requestIDUnaryClientInterceptor))
 	dialOpts = append(dialOpts, grpc.WithStatsHandler(otelgrpc.NewClientHandler()))
 
 	ctx := context.Background()
@@ -556,6 +600,40 @@ func (c *client) newConn() (*grpc.ClientConn, io.Closer, error) {
 	}), e
 }
 
+// requestIDMetadataKeys and traceIDMetadataKeys are the response metadata keys the request and trace ids of a failed
+// request are looked up in
+var (
+	requestIDMetadataKeys = []string{"x-request-id", "request-id"}
+	traceIDMetadataKeys   = []string{"x-trace-id", "trace-id", "traceparent"}
+)
+
+// requestIDUnaryClientInterceptor annotates errors with the request and trace ids found in the response metadata, so
+// that they can be reported when verbose errors are enabled
+func requestIDUnaryClientInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	var header, trailer metadata.MD
+	err := invoker(ctx, method, req, reply, cc, append(opts, grpc.Header(&header), grpc.Trailer(&trailer))...)
+	if err == nil {
+		return nil
+	}
+	requestID := firstMetadataValue(requestIDMetadataKeys, header, trailer)
+	traceID := firstMetadataValue(traceIDMetadataKeys, header, trailer)
+	if requestID == "" && traceID == "" {
+		return err
+	}
+	return &argocderrors.RequestError{Err: err, RequestID: requestID, TraceID: traceID}
+}
+
+func firstMetadataValue(keys []string, mds ...metadata.MD) string {
+	for _, md := range mds {
+		for _, key := range keys {
+			if values := md.Get(key); len(values) > 0 && values[0] != "" {
+				return values[0]
+			}
+		}
+	}
+	return ""
+}
+
 func (c *client) tlsConfig() (*tls.Config, error) {
 	var tlsConfig tls.Config
 	if len(c.CertPEMData) > 0 {
@@ -807,20 +885,30 @@ func (c *client) NewAccountClientOrDie() (io.Closer, accountpkg.AccountServiceCl
 // WatchApplicationWithRetry returns a channel of watch events for an application, retrying the
 // watch upon errors. Closes the returned channel when the context is cancelled.
 func (c *client) WatchApplicationWithRetry(ctx context.Context, appName string, revision string) chan *v1alpha1.ApplicationWatchEvent {
+	appName, appNs := argo.ParseFromQualifiedName(appName, "")
+	return c.WatchApplicationsWithRetry(ctx, &applicationpkg.ApplicationQuery{
+		Name:            &appName,
+		AppNamespace:    &appNs,
+		ResourceVersion: &revision,
+	})
+}
+
+// WatchApplicationsWithRetry returns a channel of watch events for the applications matching the
+// query, retrying the watch upon errors. The watch is resumed from the resource version of the last
+// received event. Closes the returned channel when the context is cancelled.
+func (c *client) WatchApplicationsWithRetry(ctx context.Context, query *applicationpkg.ApplicationQuery) chan *v1alpha1.ApplicationWatchEvent {
 	appEventsCh := make(chan *v1alpha1.ApplicationWatchEvent)
 	cancelled := false
-	appName, appNs := argo.ParseFromQualifiedName(appName, "")
+	revision := query.GetResourceVersion()
 	go func() {
 		defer close(appEventsCh)
 		for !cancelled {
 			conn, appIf, err := c.NewApplicationClient()
 			if err == nil {
 				var wc applicationpkg.ApplicationService_WatchClient
-				wc, err = appIf.Watch(ctx, &applicationpkg.ApplicationQuery{
-					Name:            &appName,
-					AppNamespace:    &appNs,
-					ResourceVersion: &revision,
-				})
+				q := *query
+				q.ResourceVersion = &revision
+				wc, err = appIf.Watch(ctx, &q)
 				if err == nil {
 					for {
 						var appEvent *v1alpha1.ApplicationWatchEvent
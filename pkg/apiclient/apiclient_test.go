@@ -1,10 +1,21 @@
 package apiclient
 
 import (
+	"context"
+	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	argocderrors "github.com/argoproj/argo-cd/v3/util/errors"
+	"github.com/argoproj/argo-cd/v3/util/localconfig"
 )
 
 func Test_parseHeaders(t *testing.T) {
@@ -39,3 +50,106 @@ func Test_parseGRPCHeaders(t *testing.T) {
 		assert.ErrorContains(t, err, "additional headers must be colon(:)-separated: foo")
 	})
 }
+
+func Test_requestIDUnaryClientInterceptor(t *testing.T) {
+	invoker := func(md metadata.MD, err error) grpc.UnaryInvoker {
+		return func(_ context.Context, _ string, _, _ any, _ *grpc.ClientConn, opts ...grpc.CallOption) error {
+			for _, opt := range opts {
+				if header, ok := opt.(grpc.HeaderCallOption); ok {
+					*header.HeaderAddr = md
+				}
+			}
+			return err
+		}
+	}
+	rpcErr := status.Error(codes.NotFound, "application not found")
+
+	t.Run("Success", func(t *testing.T) {
+		err := requestIDUnaryClientInterceptor(t.Context(), "/method", nil, nil, nil, invoker(metadata.Pairs("x-request-id", "abc"), nil))
+		require.NoError(t, err)
+	})
+
+	t.Run("ErrorWithoutRequestID", func(t *testing.T) {
+		err := requestIDUnaryClientInterceptor(t.Context(), "/method", nil, nil, nil, invoker(metadata.MD{}, rpcErr))
+		assert.Equal(t, rpcErr, err)
+	})
+
+	t.Run("ErrorWithRequestID", func(t *testing.T) {
+		err := requestIDUnaryClientInterceptor(t.Context(), "/method", nil, nil, nil, invoker(metadata.Pairs("x-request-id", "abc", "traceparent", "00-123-456-01"), rpcErr))
+		var reqErr *argocderrors.RequestError
+		require.ErrorAs(t, err, &reqErr)
+		assert.Equal(t, "abc", reqErr.RequestID)
+		assert.Equal(t, "00-123-456-01", reqErr.TraceID)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Equal(t, rpcErr.Error(), err.Error())
+	})
+}
+
+func newTestAuthToken(t *testing.T, expiresAt time.Time) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		Subject:   "alice",
+		ExpiresAt: jwt.NewNumericDate(expiresAt),
+	}).SignedString([]byte("secret"))
+	require.NoError(t, err)
+	return token
+}
+
+func Test_authTokenRefreshNeeded(t *testing.T) {
+	now := time.Now()
+
+	invalid, expiresSoon, err := authTokenRefreshNeeded(newTestAuthToken(t, now.Add(time.Hour)), now)
+	require.NoError(t, err)
+	assert.False(t, invalid)
+	assert.False(t, expiresSoon)
+
+	invalid, expiresSoon, err = authTokenRefreshNeeded(newTestAuthToken(t, now.Add(time.Minute)), now)
+	require.NoError(t, err)
+	assert.False(t, invalid)
+	assert.True(t, expiresSoon)
+
+	invalid, _, err = authTokenRefreshNeeded(newTestAuthToken(t, now.Add(-time.Minute)), now)
+	require.NoError(t, err)
+	assert.True(t, invalid)
+
+	_, _, err = authTokenRefreshNeeded("not-a-token", now)
+	assert.Error(t, err)
+}
+
+func Test_refreshAuthToken(t *testing.T) {
+	now := time.Now()
+	newLocalConfig := func(authToken string) *localconfig.LocalConfig {
+		return &localconfig.LocalConfig{
+			CurrentContext: "argocd",
+			Contexts:       []localconfig.ContextRef{{Name: "argocd", Server: "argocd.example.com", User: "argocd"}},
+			Servers:        []localconfig.Server{{Server: "argocd.example.com"}},
+			Users:          []localconfig.User{{Name: "argocd", AuthToken: authToken, RefreshToken: "refresh-token"}},
+		}
+	}
+	configPath := filepath.Join(t.TempDir(), "config")
+
+	t.Run("ValidToken", func(t *testing.T) {
+		authToken := newTestAuthToken(t, now.Add(time.Hour))
+		c := &client{ServerAddr: "127.0.0.1:1", PlainText: true, AuthToken: authToken, RefreshToken: "refresh-token"}
+		require.NoError(t, c.refreshAuthToken(newLocalConfig(authToken), "argocd", configPath, now))
+		assert.Equal(t, authToken, c.AuthToken)
+	})
+	t.Run("ExplicitToken", func(t *testing.T) {
+		authToken := newTestAuthToken(t, now.Add(-time.Hour))
+		c := &client{ServerAddr: "127.0.0.1:1", PlainText: true, AuthToken: "explicit", RefreshToken: "refresh-token"}
+		require.NoError(t, c.refreshAuthToken(newLocalConfig(authToken), "argocd", configPath, now))
+		assert.Equal(t, "explicit", c.AuthToken)
+	})
+	t.Run("ExpiringTokenRefreshFails", func(t *testing.T) {
+		// the server is unreachable, but the current token is still usable
+		authToken := newTestAuthToken(t, now.Add(time.Minute))
+		c := &client{ServerAddr: "127.0.0.1:1", PlainText: true, AuthToken: authToken, RefreshToken: "refresh-token"}
+		require.NoError(t, c.refreshAuthToken(newLocalConfig(authToken), "argocd", configPath, now))
+		assert.Equal(t, authToken, c.AuthToken)
+	})
+	t.Run("ExpiredTokenRefreshFails", func(t *testing.T) {
+		authToken := newTestAuthToken(t, now.Add(-time.Minute))
+		c := &client{ServerAddr: "127.0.0.1:1", PlainText: true, AuthToken: authToken, RefreshToken: "refresh-token"}
+		assert.Error(t, c.refreshAuthToken(newLocalConfig(authToken), "argocd", configPath, now))
+	})
+}
@@ -311,56 +311,209 @@ func (m *ClusterUpdateRequest) GetId() *ClusterID {
 	return nil
 }
 
+// ClusterDiagnosisCheck is the result of a single check of a cluster diagnosis
+type ClusterDiagnosisCheck struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Status               string   `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Message              string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Details              []string `protobuf:"bytes,4,rep,name=details,proto3" json:"details,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClusterDiagnosisCheck) Reset()         { *m = ClusterDiagnosisCheck{} }
+func (m *ClusterDiagnosisCheck) String() string { return proto.CompactTextString(m) }
+func (*ClusterDiagnosisCheck) ProtoMessage()    {}
+func (*ClusterDiagnosisCheck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6b5ba0b5aa57b32, []int{5}
+}
+func (m *ClusterDiagnosisCheck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterDiagnosisCheck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterDiagnosisCheck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClusterDiagnosisCheck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterDiagnosisCheck.Merge(m, src)
+}
+func (m *ClusterDiagnosisCheck) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterDiagnosisCheck) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterDiagnosisCheck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterDiagnosisCheck proto.InternalMessageInfo
+
+func (m *ClusterDiagnosisCheck) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ClusterDiagnosisCheck) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *ClusterDiagnosisCheck) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *ClusterDiagnosisCheck) GetDetails() []string {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
+// ClusterDiagnosisReport is the result of the connectivity and permission checks run against a cluster
+type ClusterDiagnosisReport struct {
+	Server               string                   `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	Name                 string                   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ServerVersion        string                   `protobuf:"bytes,3,opt,name=serverVersion,proto3" json:"serverVersion,omitempty"`
+	Checks               []*ClusterDiagnosisCheck `protobuf:"bytes,4,rep,name=checks,proto3" json:"checks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ClusterDiagnosisReport) Reset()         { *m = ClusterDiagnosisReport{} }
+func (m *ClusterDiagnosisReport) String() string { return proto.CompactTextString(m) }
+func (*ClusterDiagnosisReport) ProtoMessage()    {}
+func (*ClusterDiagnosisReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6b5ba0b5aa57b32, []int{6}
+}
+func (m *ClusterDiagnosisReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterDiagnosisReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterDiagnosisReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClusterDiagnosisReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterDiagnosisReport.Merge(m, src)
+}
+func (m *ClusterDiagnosisReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterDiagnosisReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterDiagnosisReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterDiagnosisReport proto.InternalMessageInfo
+
+func (m *ClusterDiagnosisReport) GetServer() string {
+	if m != nil {
+		return m.Server
+	}
+	return ""
+}
+
+func (m *ClusterDiagnosisReport) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ClusterDiagnosisReport) GetServerVersion() string {
+	if m != nil {
+		return m.ServerVersion
+	}
+	return ""
+}
+
+func (m *ClusterDiagnosisReport) GetChecks() []*ClusterDiagnosisCheck {
+	if m != nil {
+		return m.Checks
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ClusterID)(nil), "cluster.ClusterID")
 	proto.RegisterType((*ClusterQuery)(nil), "cluster.ClusterQuery")
 	proto.RegisterType((*ClusterResponse)(nil), "cluster.ClusterResponse")
 	proto.RegisterType((*ClusterCreateRequest)(nil), "cluster.ClusterCreateRequest")
 	proto.RegisterType((*ClusterUpdateRequest)(nil), "cluster.ClusterUpdateRequest")
+	proto.RegisterType((*ClusterDiagnosisCheck)(nil), "cluster.ClusterDiagnosisCheck")
+	proto.RegisterType((*ClusterDiagnosisReport)(nil), "cluster.ClusterDiagnosisReport")
 }
 
 func init() { proto.RegisterFile("server/cluster/cluster.proto", fileDescriptor_a6b5ba0b5aa57b32) }
 
 var fileDescriptor_a6b5ba0b5aa57b32 = []byte{
-	// 596 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x95, 0xcf, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0xe5, 0x6e, 0x74, 0xcc, 0x03, 0x06, 0xd6, 0x40, 0x51, 0xf7, 0x43, 0x25, 0x20, 0x28,
-	0x68, 0xb5, 0xd5, 0x76, 0x5c, 0xb8, 0xb1, 0x0e, 0x50, 0xa5, 0x5d, 0x08, 0xe2, 0xc2, 0x61, 0x93,
-	0x97, 0x3c, 0xa5, 0x66, 0x59, 0x62, 0x62, 0x27, 0xd2, 0x84, 0xb8, 0xec, 0xc4, 0x0d, 0x21, 0xae,
-	0x5c, 0xf9, 0x43, 0xb8, 0x21, 0x71, 0x41, 0xe2, 0x1f, 0x40, 0x15, 0x7f, 0x08, 0x8a, 0x93, 0xb4,
-	0xb4, 0xd3, 0xaa, 0x21, 0x15, 0x4e, 0xf5, 0x7b, 0xea, 0xf3, 0xf7, 0xf3, 0xbe, 0x7e, 0x8e, 0xf1,
-	0x9a, 0x82, 0x38, 0x85, 0x98, 0xb9, 0x41, 0xa2, 0xf4, 0xe8, 0x97, 0xca, 0x38, 0xd2, 0x11, 0x59,
-	0x28, 0xc2, 0xda, 0x9a, 0x1f, 0x45, 0x7e, 0x00, 0x8c, 0x4b, 0xc1, 0x78, 0x18, 0x46, 0x9a, 0x6b,
-	0x11, 0x85, 0x2a, 0xff, 0x5b, 0x6d, 0xd7, 0x17, 0xba, 0x9f, 0x1c, 0x50, 0x37, 0x3a, 0x62, 0x3c,
-	0xf6, 0x23, 0x19, 0x47, 0xaf, 0xcc, 0xa2, 0xe9, 0x7a, 0x2c, 0xed, 0x30, 0x79, 0xe8, 0x67, 0x95,
-	0x8a, 0x71, 0x29, 0x03, 0xe1, 0x9a, 0x5a, 0x96, 0xb6, 0x78, 0x20, 0xfb, 0xbc, 0xc5, 0x7c, 0x08,
-	0x21, 0xe6, 0x1a, 0xbc, 0x7c, 0x37, 0xfb, 0x01, 0x5e, 0xec, 0xe6, 0xb2, 0xbd, 0x1d, 0x42, 0xf0,
-	0xbc, 0x3e, 0x96, 0x60, 0xa1, 0x3a, 0x6a, 0x2c, 0x3a, 0x66, 0x4d, 0x56, 0xf0, 0x85, 0x94, 0x07,
-	0x09, 0x58, 0x15, 0x93, 0xcc, 0x03, 0x7b, 0x0f, 0x5f, 0x2a, 0xca, 0x9e, 0x25, 0x10, 0x1f, 0x93,
-	0x1b, 0xb8, 0x9a, 0xf7, 0x56, 0xd4, 0x16, 0x51, 0xb6, 0x63, 0xc8, 0x8f, 0xca, 0x62, 0xb3, 0x26,
-	0x36, 0xae, 0x08, 0xcf, 0x9a, 0xab, 0xa3, 0xc6, 0x52, 0x9b, 0xd0, 0xd2, 0x83, 0x21, 0x85, 0x53,
-	0x11, 0x9e, 0x7d, 0x0d, 0x2f, 0x17, 0x09, 0x07, 0x94, 0x8c, 0x42, 0x05, 0xf6, 0x7b, 0x84, 0x57,
-	0x8a, 0x5c, 0x37, 0x06, 0xae, 0xc1, 0x81, 0xd7, 0x09, 0x28, 0x4d, 0xf6, 0x71, 0xe9, 0x9c, 0x11,
-	0x5f, 0x6a, 0x3f, 0xa6, 0x23, 0x8b, 0x68, 0x69, 0x91, 0x59, 0xec, 0xbb, 0x1e, 0x4d, 0x3b, 0x54,
-	0x1e, 0xfa, 0x34, 0xb3, 0x88, 0xfe, 0x61, 0x11, 0x2d, 0x2d, 0x2a, 0x49, 0x9c, 0x72, 0xd7, 0xac,
-	0xb9, 0x44, 0x2a, 0x88, 0xb5, 0x69, 0xe3, 0xa2, 0x53, 0x44, 0xf6, 0x97, 0x11, 0xd1, 0x0b, 0xe9,
-	0xfd, 0x4f, 0xa2, 0xdb, 0xf8, 0x72, 0x62, 0x14, 0xbd, 0x27, 0x02, 0x02, 0x4f, 0x59, 0x95, 0xfa,
-	0x5c, 0x63, 0xd1, 0x19, 0x4f, 0x9e, 0xc7, 0xe8, 0xf6, 0xb7, 0x05, 0x7c, 0xa5, 0xc8, 0x3c, 0x87,
-	0x38, 0x15, 0x2e, 0x90, 0x13, 0x84, 0xe7, 0x77, 0x85, 0xd2, 0xe4, 0xfa, 0x64, 0x8d, 0x39, 0xeb,
-	0x5a, 0x6f, 0x26, 0xcd, 0x64, 0x0a, 0xb6, 0x75, 0xf2, 0xe3, 0xd7, 0xc7, 0x0a, 0x21, 0x57, 0xcd,
-	0xac, 0xa7, 0xad, 0xf2, 0x46, 0x28, 0xf2, 0x01, 0xe1, 0x6a, 0x7e, 0xcc, 0x64, 0x7d, 0x12, 0x63,
-	0xec, 0xf8, 0x6b, 0xb3, 0xf1, 0xd6, 0xbe, 0x69, 0x50, 0x56, 0xed, 0x53, 0x28, 0x0f, 0x87, 0xae,
-	0xbf, 0x43, 0x78, 0xee, 0x29, 0x9c, 0xe9, 0xcb, 0x8c, 0x40, 0x6e, 0x19, 0x90, 0x75, 0xb2, 0x3a,
-	0x09, 0xc2, 0xde, 0x08, 0x8f, 0x9a, 0xeb, 0xf7, 0x96, 0x7c, 0x42, 0xb8, 0x9a, 0xcf, 0xdc, 0x69,
-	0x7b, 0xc6, 0x66, 0x71, 0x56, 0x54, 0x9b, 0x86, 0xea, 0x4e, 0x6d, 0x1a, 0xd5, 0xc8, 0xa9, 0x3d,
-	0x5c, 0xdd, 0x81, 0x00, 0x34, 0x9c, 0xe5, 0x95, 0x35, 0x99, 0x1e, 0x5e, 0xf3, 0xa2, 0xfd, 0xfb,
-	0x53, 0xdb, 0x0f, 0x31, 0x76, 0xb2, 0xcf, 0x22, 0x3c, 0x4a, 0x74, 0xff, 0xef, 0x35, 0x98, 0xd1,
-	0xb8, 0x67, 0xdf, 0x9d, 0xa2, 0xc1, 0x62, 0x23, 0xd0, 0xe4, 0x99, 0xc2, 0x67, 0x84, 0x97, 0x7b,
-	0x61, 0xca, 0x03, 0x91, 0x59, 0xdb, 0xe5, 0x6e, 0x1f, 0xfe, 0xf1, 0x14, 0x6c, 0x19, 0x44, 0x6a,
-	0x6f, 0x4e, 0x43, 0x14, 0x43, 0xa4, 0xa6, 0x9b, 0x31, 0x6d, 0x6f, 0x7f, 0x1d, 0x6c, 0xa0, 0xef,
-	0x83, 0x0d, 0xf4, 0x73, 0xb0, 0x81, 0x5e, 0x6e, 0x9d, 0xef, 0xa5, 0x70, 0x03, 0x01, 0xa1, 0x2e,
-	0x05, 0x0e, 0xaa, 0xe6, 0x61, 0xe8, 0xfc, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xf8, 0x3f, 0x7b, 0x15,
-	0xad, 0x06, 0x00, 0x00,
+	// 716 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x41, 0x4f, 0xd4, 0x40,
+	0x14, 0xce, 0x2c, 0xb8, 0xb0, 0x83, 0x88, 0x4e, 0x80, 0x34, 0x0b, 0xac, 0x58, 0x89, 0xa2, 0x81,
+	0x36, 0x2c, 0xe8, 0xc1, 0x9b, 0x2c, 0x6a, 0x48, 0xb8, 0x58, 0xa3, 0x07, 0x0f, 0x90, 0xa1, 0x7d,
+	0xe9, 0x8e, 0x94, 0x76, 0xec, 0x4c, 0x6b, 0x88, 0xf1, 0xc2, 0xc9, 0x9b, 0x31, 0x5e, 0x3d, 0x99,
+	0xf8, 0x33, 0x3c, 0x78, 0xf3, 0x68, 0xe2, 0x1f, 0x30, 0xc4, 0x1f, 0x62, 0x3a, 0x9d, 0xee, 0xb2,
+	0x25, 0xbb, 0x81, 0x64, 0xf5, 0xd4, 0x79, 0xaf, 0x33, 0xef, 0xfb, 0xde, 0xf7, 0xe6, 0xbd, 0xc1,
+	0xf3, 0x02, 0xe2, 0x14, 0x62, 0xdb, 0x0d, 0x12, 0x21, 0xbb, 0x5f, 0x8b, 0xc7, 0x91, 0x8c, 0xc8,
+	0x98, 0x36, 0xeb, 0xf3, 0x7e, 0x14, 0xf9, 0x01, 0xd8, 0x94, 0x33, 0x9b, 0x86, 0x61, 0x24, 0xa9,
+	0x64, 0x51, 0x28, 0xf2, 0x6d, 0xf5, 0x1d, 0x9f, 0xc9, 0x76, 0xb2, 0x6f, 0xb9, 0xd1, 0xa1, 0x4d,
+	0x63, 0x3f, 0xe2, 0x71, 0xf4, 0x4a, 0x2d, 0x56, 0x5d, 0xcf, 0x4e, 0xd7, 0x6d, 0x7e, 0xe0, 0x67,
+	0x27, 0x85, 0x4d, 0x39, 0x0f, 0x98, 0xab, 0xce, 0xda, 0xe9, 0x1a, 0x0d, 0x78, 0x9b, 0xae, 0xd9,
+	0x3e, 0x84, 0x10, 0x53, 0x09, 0x5e, 0x1e, 0xcd, 0xbc, 0x87, 0x6b, 0xad, 0x1c, 0x76, 0x7b, 0x8b,
+	0x10, 0x3c, 0x2a, 0x8f, 0x38, 0x18, 0x68, 0x11, 0x2d, 0xd7, 0x1c, 0xb5, 0x26, 0xd3, 0xf8, 0x52,
+	0x4a, 0x83, 0x04, 0x8c, 0x8a, 0x72, 0xe6, 0x86, 0xb9, 0x8b, 0x2f, 0xeb, 0x63, 0x4f, 0x13, 0x88,
+	0x8f, 0xc8, 0x2c, 0xae, 0xe6, 0xb9, 0xe9, 0xb3, 0xda, 0xca, 0x22, 0x86, 0xf4, 0xb0, 0x38, 0xac,
+	0xd6, 0xc4, 0xc4, 0x15, 0xe6, 0x19, 0x23, 0x8b, 0x68, 0x79, 0xa2, 0x49, 0xac, 0x42, 0x83, 0x0e,
+	0x0b, 0xa7, 0xc2, 0x3c, 0xf3, 0x1a, 0x9e, 0xd2, 0x0e, 0x07, 0x04, 0x8f, 0x42, 0x01, 0xe6, 0x07,
+	0x84, 0xa7, 0xb5, 0xaf, 0x15, 0x03, 0x95, 0xe0, 0xc0, 0xeb, 0x04, 0x84, 0x24, 0x7b, 0xb8, 0x50,
+	0x4e, 0x81, 0x4f, 0x34, 0x1f, 0x59, 0x5d, 0x89, 0xac, 0x42, 0x22, 0xb5, 0xd8, 0x73, 0x3d, 0x2b,
+	0x5d, 0xb7, 0xf8, 0x81, 0x6f, 0x65, 0x12, 0x59, 0xa7, 0x24, 0xb2, 0x0a, 0x89, 0x0a, 0x26, 0x4e,
+	0x11, 0x35, 0x4b, 0x2e, 0xe1, 0x02, 0x62, 0xa9, 0xd2, 0x18, 0x77, 0xb4, 0x65, 0x7e, 0xef, 0x32,
+	0x7a, 0xce, 0xbd, 0xff, 0xc9, 0x68, 0x09, 0x4f, 0x26, 0x0a, 0xd1, 0x7b, 0xcc, 0x20, 0xf0, 0x84,
+	0x51, 0x59, 0x1c, 0x59, 0xae, 0x39, 0xbd, 0xce, 0x73, 0x09, 0xfd, 0x06, 0xcf, 0x68, 0xc7, 0x16,
+	0xa3, 0x7e, 0x18, 0x09, 0x26, 0x5a, 0x6d, 0x70, 0x0f, 0x3a, 0x95, 0x43, 0xa7, 0x2a, 0x97, 0x55,
+	0x59, 0x52, 0x99, 0x08, 0x5d, 0x4f, 0x6d, 0x11, 0x03, 0x8f, 0x1d, 0x82, 0x10, 0xd4, 0x07, 0x85,
+	0x56, 0x73, 0x0a, 0x33, 0xfb, 0xe3, 0x81, 0xa4, 0x2c, 0x10, 0xc6, 0xa8, 0xa2, 0x58, 0x98, 0xe6,
+	0x17, 0x84, 0x67, 0xcb, 0xc8, 0x0e, 0xf0, 0x28, 0x96, 0x17, 0xba, 0x4c, 0x4b, 0x78, 0x32, 0xff,
+	0xfb, 0x02, 0x62, 0xc1, 0xa2, 0x50, 0x13, 0xe8, 0x75, 0x92, 0xfb, 0xb8, 0xea, 0x66, 0x59, 0xe5,
+	0x2c, 0x26, 0x9a, 0x8d, 0xb2, 0x1a, 0xbd, 0xc9, 0x3b, 0x7a, 0x77, 0xf3, 0xdb, 0x38, 0xbe, 0xa2,
+	0x77, 0x3c, 0x83, 0x38, 0x65, 0x2e, 0x90, 0x63, 0x84, 0x47, 0x77, 0x98, 0x90, 0x64, 0xa6, 0x1c,
+	0x43, 0x75, 0x42, 0x7d, 0x7b, 0x28, 0xa5, 0xce, 0x10, 0x4c, 0xe3, 0xf8, 0xd7, 0x9f, 0x4f, 0x15,
+	0x42, 0xae, 0xaa, 0x49, 0x90, 0xae, 0x15, 0xf3, 0x42, 0x90, 0x8f, 0x08, 0x57, 0xf3, 0x26, 0x20,
+	0x0b, 0x65, 0x1a, 0x3d, 0xcd, 0x51, 0x1f, 0xce, 0xcd, 0x33, 0x6f, 0x28, 0x2a, 0x73, 0xe6, 0x19,
+	0x2a, 0x0f, 0x3a, 0x77, 0xf2, 0x3d, 0xc2, 0x23, 0x4f, 0xa0, 0xaf, 0x2e, 0x43, 0x22, 0x72, 0x53,
+	0x11, 0x59, 0x20, 0x73, 0x65, 0x22, 0xf6, 0x5b, 0xe6, 0x59, 0x6a, 0x38, 0xbd, 0x23, 0x9f, 0x11,
+	0xae, 0xe6, 0x1d, 0x79, 0x56, 0x9e, 0x9e, 0x4e, 0x1d, 0x16, 0xab, 0x15, 0xc5, 0xea, 0x56, 0x7d,
+	0x10, 0xab, 0xae, 0x52, 0xbb, 0xb8, 0xba, 0x05, 0x01, 0x48, 0xe8, 0xa7, 0x95, 0x51, 0x76, 0x77,
+	0x86, 0xa0, 0x4e, 0xff, 0xee, 0xc0, 0xf4, 0x43, 0x8c, 0x9d, 0xec, 0xd1, 0x80, 0x87, 0x89, 0x6c,
+	0x5f, 0x1c, 0xc3, 0x56, 0x18, 0x77, 0xcc, 0xdb, 0x03, 0x30, 0xec, 0x58, 0x01, 0xac, 0xd2, 0x0c,
+	0xe1, 0x2b, 0xc2, 0x53, 0xdb, 0x61, 0x4a, 0x03, 0x96, 0x49, 0xdb, 0xa2, 0x6e, 0x1b, 0xfe, 0xf1,
+	0x2d, 0xd8, 0x50, 0x14, 0x2d, 0x73, 0x65, 0x10, 0x45, 0xd6, 0xa1, 0xb4, 0xea, 0x2a, 0x4e, 0x1c,
+	0x8f, 0xeb, 0x3e, 0xef, 0xcb, 0xef, 0x7a, 0xdf, 0xc1, 0x90, 0xcf, 0xa6, 0xa2, 0xd2, 0x64, 0x69,
+	0x10, 0xb2, 0xa7, 0x51, 0x36, 0x37, 0x7f, 0x9c, 0x34, 0xd0, 0xcf, 0x93, 0x06, 0xfa, 0x7d, 0xd2,
+	0x40, 0x2f, 0x37, 0xce, 0xf7, 0x72, 0xbb, 0x01, 0x83, 0x50, 0x16, 0x81, 0xf7, 0xab, 0xea, 0xa1,
+	0x5e, 0xff, 0x3b, 0x00, 0xa6, 0xa5, 0x9b, 0xfb, 0x3d, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -389,6 +542,8 @@ type ClusterServiceClient interface {
 	RotateAuth(ctx context.Context, in *ClusterQuery, opts ...grpc.CallOption) (*ClusterResponse, error)
 	// InvalidateCache invalidates cluster cache
 	InvalidateCache(ctx context.Context, in *ClusterQuery, opts ...grpc.CallOption) (*v1alpha1.Cluster, error)
+	// Diagnose checks the connectivity, credentials and permissions of a cluster
+	Diagnose(ctx context.Context, in *ClusterQuery, opts ...grpc.CallOption) (*ClusterDiagnosisReport, error)
 }
 
 type clusterServiceClient struct {
@@ -462,6 +617,15 @@ func (c *clusterServiceClient) InvalidateCache(ctx context.Context, in *ClusterQ
 	return out, nil
 }
 
+func (c *clusterServiceClient) Diagnose(ctx context.Context, in *ClusterQuery, opts ...grpc.CallOption) (*ClusterDiagnosisReport, error) {
+	out := new(ClusterDiagnosisReport)
+	err := c.cc.Invoke(ctx, "/cluster.ClusterService/Diagnose", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ClusterServiceServer is the server API for ClusterService service.
 type ClusterServiceServer interface {
 	// List returns list of clusters
@@ -478,6 +642,8 @@ type ClusterServiceServer interface {
 	RotateAuth(context.Context, *ClusterQuery) (*ClusterResponse, error)
 	// InvalidateCache invalidates cluster cache
 	InvalidateCache(context.Context, *ClusterQuery) (*v1alpha1.Cluster, error)
+	// Diagnose checks the connectivity, credentials and permissions of a cluster
+	Diagnose(context.Context, *ClusterQuery) (*ClusterDiagnosisReport, error)
 }
 
 // UnimplementedClusterServiceServer can be embedded to have forward compatible implementations.
@@ -505,6 +671,9 @@ func (*UnimplementedClusterServiceServer) RotateAuth(ctx context.Context, req *C
 func (*UnimplementedClusterServiceServer) InvalidateCache(ctx context.Context, req *ClusterQuery) (*v1alpha1.Cluster, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InvalidateCache not implemented")
 }
+func (*UnimplementedClusterServiceServer) Diagnose(ctx context.Context, req *ClusterQuery) (*ClusterDiagnosisReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Diagnose not implemented")
+}
 
 func RegisterClusterServiceServer(s *grpc.Server, srv ClusterServiceServer) {
 	s.RegisterService(&_ClusterService_serviceDesc, srv)
@@ -636,6 +805,24 @@ func _ClusterService_InvalidateCache_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClusterService_Diagnose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).Diagnose(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cluster.ClusterService/Diagnose",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).Diagnose(ctx, req.(*ClusterQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ClusterService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cluster.ClusterService",
 	HandlerType: (*ClusterServiceServer)(nil),
@@ -668,6 +855,10 @@ var _ClusterService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InvalidateCache",
 			Handler:    _ClusterService_InvalidateCache_Handler,
 		},
+		{
+			MethodName: "Diagnose",
+			Handler:    _ClusterService_Diagnose_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "server/cluster/cluster.proto",
@@ -903,6 +1094,125 @@ func (m *ClusterUpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ClusterDiagnosisCheck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterDiagnosisCheck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterDiagnosisCheck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Details) > 0 {
+		for iNdEx := len(m.Details) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Details[iNdEx])
+			copy(dAtA[i:], m.Details[iNdEx])
+			i = encodeVarintCluster(dAtA, i, uint64(len(m.Details[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintCluster(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintCluster(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintCluster(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClusterDiagnosisReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterDiagnosisReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterDiagnosisReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Checks) > 0 {
+		for iNdEx := len(m.Checks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Checks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCluster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ServerVersion) > 0 {
+		i -= len(m.ServerVersion)
+		copy(dAtA[i:], m.ServerVersion)
+		i = encodeVarintCluster(dAtA, i, uint64(len(m.ServerVersion)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintCluster(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Server) > 0 {
+		i -= len(m.Server)
+		copy(dAtA[i:], m.Server)
+		i = encodeVarintCluster(dAtA, i, uint64(len(m.Server)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCluster(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCluster(v)
 	base := offset
@@ -1015,6 +1325,66 @@ func (m *ClusterUpdateRequest) Size() (n int) {
 	return n
 }
 
+func (m *ClusterDiagnosisCheck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCluster(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovCluster(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovCluster(uint64(l))
+	}
+	if len(m.Details) > 0 {
+		for _, s := range m.Details {
+			l = len(s)
+			n += 1 + l + sovCluster(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ClusterDiagnosisReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Server)
+	if l > 0 {
+		n += 1 + l + sovCluster(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCluster(uint64(l))
+	}
+	l = len(m.ServerVersion)
+	if l > 0 {
+		n += 1 + l + sovCluster(uint64(l))
+	}
+	if len(m.Checks) > 0 {
+		for _, e := range m.Checks {
+			l = e.Size()
+			n += 1 + l + sovCluster(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovCluster(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1600,6 +1970,366 @@ func (m *ClusterUpdateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ClusterDiagnosisCheck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCluster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterDiagnosisCheck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterDiagnosisCheck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Details = append(m.Details, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCluster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterDiagnosisReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCluster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterDiagnosisReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterDiagnosisReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Server = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checks = append(m.Checks, &ClusterDiagnosisCheck{})
+			if err := m.Checks[len(m.Checks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCluster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCluster(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -175,6 +175,74 @@ func (_c *ClusterServiceServer_Delete_Call) RunAndReturn(run func(context1 conte
 	return _c
 }
 
+// Diagnose provides a mock function for the type ClusterServiceServer
+func (_mock *ClusterServiceServer) Diagnose(context1 context.Context, clusterQuery *cluster.ClusterQuery) (*cluster.ClusterDiagnosisReport, error) {
+	ret := _mock.Called(context1, clusterQuery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Diagnose")
+	}
+
+	var r0 *cluster.ClusterDiagnosisReport
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *cluster.ClusterQuery) (*cluster.ClusterDiagnosisReport, error)); ok {
+		return returnFunc(context1, clusterQuery)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *cluster.ClusterQuery) *cluster.ClusterDiagnosisReport); ok {
+		r0 = returnFunc(context1, clusterQuery)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cluster.ClusterDiagnosisReport)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *cluster.ClusterQuery) error); ok {
+		r1 = returnFunc(context1, clusterQuery)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ClusterServiceServer_Diagnose_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Diagnose'
+type ClusterServiceServer_Diagnose_Call struct {
+	*mock.Call
+}
+
+// Diagnose is a helper method to define mock.On call
+//   - context1 context.Context
+//   - clusterQuery *cluster.ClusterQuery
+func (_e *ClusterServiceServer_Expecter) Diagnose(context1 interface{}, clusterQuery interface{}) *ClusterServiceServer_Diagnose_Call {
+	return &ClusterServiceServer_Diagnose_Call{Call: _e.mock.On("Diagnose", context1, clusterQuery)}
+}
+
+func (_c *ClusterServiceServer_Diagnose_Call) Run(run func(context1 context.Context, clusterQuery *cluster.ClusterQuery)) *ClusterServiceServer_Diagnose_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *cluster.ClusterQuery
+		if args[1] != nil {
+			arg1 = args[1].(*cluster.ClusterQuery)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *ClusterServiceServer_Diagnose_Call) Return(clusterDiagnosisReport *cluster.ClusterDiagnosisReport, err error) *ClusterServiceServer_Diagnose_Call {
+	_c.Call.Return(clusterDiagnosisReport, err)
+	return _c
+}
+
+func (_c *ClusterServiceServer_Diagnose_Call) RunAndReturn(run func(context1 context.Context, clusterQuery *cluster.ClusterQuery) (*cluster.ClusterDiagnosisReport, error)) *ClusterServiceServer_Diagnose_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Get provides a mock function for the type ClusterServiceServer
 func (_mock *ClusterServiceServer) Get(context1 context.Context, clusterQuery *cluster.ClusterQuery) (*v1alpha1.Cluster, error) {
 	ret := _mock.Called(context1, clusterQuery)
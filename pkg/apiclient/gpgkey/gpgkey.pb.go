@@ -35,7 +35,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Message to query the server for configured GPG public keys
 type GnuPGPublicKeyQuery struct {
 	// The GPG key ID to query for
-	KeyID                string   `protobuf:"bytes,1,opt,name=keyID,proto3" json:"keyID,omitempty"`
+	KeyID string `protobuf:"bytes,1,opt,name=keyID,proto3" json:"keyID,omitempty"`
+	// Whether to keep the key data of the keys in the list result
+	WithKeyData          bool     `protobuf:"varint,2,opt,name=withKeyData,proto3" json:"withKeyData,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -81,6 +83,13 @@ func (m *GnuPGPublicKeyQuery) GetKeyID() string {
 	return ""
 }
 
+func (m *GnuPGPublicKeyQuery) GetWithKeyData() bool {
+	if m != nil {
+		return m.WithKeyData
+	}
+	return false
+}
+
 // Request to create one or more public keys on the server
 type GnuPGPublicKeyCreateRequest struct {
 	// Raw key data of the GPG key(s) to create
@@ -247,38 +256,39 @@ func init() {
 func init() { proto.RegisterFile("server/gpgkey/gpgkey.proto", fileDescriptor_8ba55a5eb76dc6fd) }
 
 var fileDescriptor_8ba55a5eb76dc6fd = []byte{
-	// 487 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x41, 0x8b, 0xd3, 0x40,
-	0x14, 0xc7, 0x99, 0xee, 0xda, 0xb5, 0x23, 0x22, 0x8e, 0xb2, 0x1b, 0xb3, 0xa5, 0x96, 0xe8, 0xa1,
-	0x28, 0xce, 0xd0, 0xed, 0xcd, 0x9b, 0xba, 0x10, 0x64, 0x7b, 0xa8, 0xf1, 0xe6, 0x41, 0x49, 0x93,
-	0x47, 0x76, 0x9a, 0x98, 0x19, 0x67, 0x26, 0x91, 0x20, 0x5e, 0xbc, 0x2b, 0x88, 0x9f, 0x40, 0xf0,
-	0xc3, 0x78, 0x14, 0xfc, 0x02, 0x52, 0xfc, 0x20, 0xd2, 0x49, 0xea, 0x6e, 0x4b, 0x59, 0x3d, 0xf4,
-	0x94, 0x79, 0x99, 0x79, 0xef, 0xff, 0x9b, 0xf7, 0xfe, 0x83, 0x5d, 0x0d, 0xaa, 0x04, 0xc5, 0x12,
-	0x99, 0xa4, 0x50, 0x35, 0x1f, 0x2a, 0x95, 0x30, 0x82, 0xb4, 0xeb, 0xc8, 0xed, 0x26, 0x42, 0x24,
-	0x19, 0xb0, 0x50, 0x72, 0x16, 0xe6, 0xb9, 0x30, 0xa1, 0xe1, 0x22, 0xd7, 0xf5, 0x29, 0x77, 0x9c,
-	0x70, 0x73, 0x5a, 0x4c, 0x69, 0x24, 0x5e, 0xb3, 0x50, 0x25, 0x42, 0x2a, 0x31, 0xb3, 0x8b, 0x07,
-	0x51, 0xcc, 0xca, 0x11, 0x93, 0x69, 0xb2, 0xc8, 0xd4, 0x2c, 0x94, 0x32, 0xe3, 0x91, 0xcd, 0x65,
-	0xe5, 0x30, 0xcc, 0xe4, 0x69, 0x38, 0x64, 0x09, 0xe4, 0xa0, 0x42, 0x03, 0x71, 0x5d, 0xcd, 0xbb,
-	0x8f, 0x6f, 0xf8, 0x79, 0x31, 0xf1, 0x27, 0xc5, 0x34, 0xe3, 0xd1, 0x09, 0x54, 0xcf, 0x0a, 0x50,
-	0x15, 0xb9, 0x89, 0x2f, 0xa5, 0x50, 0x3d, 0x3d, 0x76, 0x50, 0x1f, 0x0d, 0x3a, 0x41, 0x1d, 0x78,
-	0x5f, 0x11, 0x3e, 0x5c, 0x3d, 0xfd, 0x44, 0x41, 0x68, 0x20, 0x80, 0x37, 0x05, 0x68, 0x43, 0x66,
-	0xb8, 0x23, 0xed, 0x4e, 0x0a, 0x95, 0xcd, 0xbc, 0x72, 0x34, 0xa6, 0x67, 0xb8, 0x74, 0x89, 0x6b,
-	0x17, 0xaf, 0xa2, 0x98, 0x96, 0x23, 0x2a, 0xd3, 0x84, 0x2e, 0x70, 0xe9, 0x39, 0x5c, 0xba, 0xc4,
-	0xa5, 0xab, 0x6a, 0xc1, 0x59, 0x79, 0xb2, 0x8f, 0xdb, 0x85, 0xd4, 0xa0, 0x8c, 0xd3, 0xea, 0xa3,
-	0xc1, 0xe5, 0xa0, 0x89, 0xbc, 0x6f, 0x08, 0x77, 0x37, 0x33, 0x6a, 0x29, 0x72, 0x0d, 0x64, 0x86,
-	0xf7, 0x22, 0xfb, 0x27, 0x6e, 0x10, 0x27, 0xdb, 0x44, 0x1c, 0x73, 0x6d, 0x82, 0xa5, 0x00, 0x71,
-	0xf0, 0x9e, 0x4e, 0xb9, 0x94, 0x10, 0x3b, 0xad, 0xfe, 0xce, 0xa0, 0x13, 0x2c, 0x43, 0xcf, 0xc1,
-	0xfb, 0x6b, 0x77, 0x6b, 0xf8, 0x8e, 0x3e, 0xee, 0xe2, 0xab, 0xfe, 0xc4, 0x3f, 0x81, 0xea, 0x39,
-	0xa8, 0x92, 0x47, 0x40, 0x3e, 0x21, 0xbc, 0xbb, 0xa8, 0x4b, 0x0e, 0x69, 0xe3, 0x97, 0x0d, 0x23,
-	0x73, 0xb7, 0x7e, 0x0d, 0xef, 0xe0, 0xc3, 0xcf, 0xdf, 0x5f, 0x5a, 0xd7, 0xc9, 0x35, 0xeb, 0xc4,
-	0x72, 0xd8, 0xb8, 0x55, 0x93, 0xcf, 0x08, 0xef, 0xf8, 0xf0, 0x0f, 0x9e, 0xad, 0x4e, 0xde, 0xbb,
-	0x6d, 0x59, 0x6e, 0x91, 0x83, 0x35, 0x16, 0xf6, 0xce, 0x5a, 0xf3, 0x3d, 0x79, 0x8b, 0xdb, 0xf5,
-	0xa0, 0xc9, 0x9d, 0xcd, 0x54, 0x2b, 0x56, 0x75, 0xef, 0x5e, 0x7c, 0xa8, 0x9e, 0x85, 0xe7, 0x59,
-	0xd5, 0xae, 0xb7, 0xde, 0x81, 0x87, 0xe7, 0x8c, 0xf8, 0x12, 0xb7, 0x8f, 0x21, 0x03, 0x03, 0x17,
-	0xb7, 0xa3, 0xb7, 0x79, 0xf3, 0xaf, 0x54, 0xd3, 0xec, 0x7b, 0xeb, 0x52, 0x8f, 0x1f, 0x7d, 0x9f,
-	0xf7, 0xd0, 0x8f, 0x79, 0x0f, 0xfd, 0x9a, 0xf7, 0xd0, 0x8b, 0xd1, 0xff, 0xbd, 0xfe, 0x28, 0xe3,
-	0x90, 0x9b, 0xa6, 0xc6, 0xb4, 0x6d, 0xdf, 0xfa, 0xe8, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x44,
-	0x30, 0xc0, 0xc7, 0x7d, 0x04, 0x00, 0x00,
+	// 498 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x8a, 0x13, 0x31,
+	0x18, 0x27, 0xbb, 0x6b, 0xd7, 0x66, 0x11, 0x31, 0xca, 0x6e, 0xed, 0x96, 0x5a, 0x46, 0x0f, 0x45,
+	0x30, 0xa1, 0xdb, 0x9b, 0x37, 0xb5, 0x50, 0xa4, 0x15, 0xea, 0x78, 0xf3, 0xa0, 0xa4, 0xd3, 0x8f,
+	0x69, 0x3a, 0xe3, 0x24, 0x26, 0x99, 0x59, 0x06, 0xf1, 0xe2, 0x5d, 0x41, 0x7c, 0x02, 0xc1, 0x87,
+	0xf1, 0x28, 0xf8, 0x02, 0x52, 0x7c, 0x10, 0x69, 0x66, 0xea, 0xb6, 0xa5, 0xac, 0x1e, 0x7a, 0x9a,
+	0xf9, 0xf2, 0xe7, 0xf7, 0xe7, 0xfb, 0x7e, 0x04, 0xd7, 0x0d, 0xe8, 0x0c, 0x34, 0x0b, 0x55, 0x18,
+	0x41, 0x5e, 0x7e, 0xa8, 0xd2, 0xd2, 0x4a, 0x52, 0x29, 0xaa, 0x7a, 0x23, 0x94, 0x32, 0x8c, 0x81,
+	0x71, 0x25, 0x18, 0x4f, 0x12, 0x69, 0xb9, 0x15, 0x32, 0x31, 0xc5, 0xa9, 0xfa, 0x30, 0x14, 0x76,
+	0x9a, 0x8e, 0x69, 0x20, 0xdf, 0x30, 0xae, 0x43, 0xa9, 0xb4, 0x9c, 0xb9, 0x9f, 0x07, 0xc1, 0x84,
+	0x65, 0x5d, 0xa6, 0xa2, 0x70, 0x71, 0xd3, 0x30, 0xae, 0x54, 0x2c, 0x02, 0x77, 0x97, 0x65, 0x1d,
+	0x1e, 0xab, 0x29, 0xef, 0xb0, 0x10, 0x12, 0xd0, 0xdc, 0xc2, 0xa4, 0x40, 0xf3, 0x9e, 0xe1, 0x9b,
+	0xfd, 0x24, 0x1d, 0xf5, 0x47, 0xe9, 0x38, 0x16, 0xc1, 0x00, 0xf2, 0xe7, 0x29, 0xe8, 0x9c, 0xdc,
+	0xc2, 0x57, 0x22, 0xc8, 0x9f, 0xf6, 0x6a, 0xa8, 0x85, 0xda, 0x55, 0xbf, 0x28, 0x48, 0x0b, 0x1f,
+	0x9d, 0x0b, 0x3b, 0x1d, 0x40, 0xde, 0xe3, 0x96, 0xd7, 0xf6, 0x5a, 0xa8, 0x7d, 0xd5, 0x5f, 0x5d,
+	0xf2, 0xbe, 0x22, 0x7c, 0xba, 0x8e, 0xf7, 0x44, 0x03, 0xb7, 0xe0, 0xc3, 0xdb, 0x14, 0x8c, 0x25,
+	0x33, 0x5c, 0x55, 0x6e, 0x27, 0x82, 0xdc, 0x61, 0x1f, 0x9d, 0x0d, 0xe9, 0x85, 0x21, 0xba, 0x34,
+	0xe4, 0x7e, 0x5e, 0x07, 0x13, 0x9a, 0x75, 0xa9, 0x8a, 0x42, 0xba, 0x30, 0x44, 0x57, 0x0c, 0xd1,
+	0xa5, 0x21, 0xba, 0xce, 0xe6, 0x5f, 0xc0, 0x93, 0x63, 0x5c, 0x49, 0x95, 0x01, 0x6d, 0x4b, 0xa1,
+	0x65, 0xe5, 0x7d, 0x43, 0xb8, 0xb1, 0x5d, 0xa3, 0x51, 0x32, 0x31, 0x40, 0x66, 0xf8, 0x30, 0x70,
+	0x2b, 0x93, 0x52, 0xe2, 0x68, 0x97, 0x12, 0x87, 0xc2, 0x58, 0x7f, 0x49, 0x40, 0x6a, 0xf8, 0xd0,
+	0x44, 0x42, 0x29, 0x98, 0xd4, 0xf6, 0x5a, 0xfb, 0xed, 0xaa, 0xbf, 0x2c, 0xbd, 0x1a, 0x3e, 0xde,
+	0xf0, 0x56, 0xea, 0x3b, 0xfb, 0x78, 0x80, 0xaf, 0xf5, 0x47, 0xfd, 0x01, 0xe4, 0x2f, 0x40, 0x67,
+	0x22, 0x00, 0xf2, 0x09, 0xe1, 0x83, 0x05, 0x2e, 0x39, 0xa5, 0x65, 0xa2, 0xb6, 0x0c, 0xb5, 0xbe,
+	0x73, 0x1b, 0xde, 0xc9, 0x87, 0x9f, 0xbf, 0xbf, 0xec, 0xdd, 0x20, 0xd7, 0x5d, 0x56, 0xb3, 0x4e,
+	0x99, 0x67, 0x43, 0x3e, 0x23, 0xbc, 0xdf, 0x87, 0x7f, 0xe8, 0xd9, 0xe9, 0xe4, 0xbd, 0x3b, 0x4e,
+	0xcb, 0x6d, 0x72, 0xb2, 0xa1, 0x85, 0xbd, 0x73, 0xe1, 0x7d, 0x4f, 0xce, 0x71, 0xa5, 0x18, 0x34,
+	0xb9, 0xbb, 0x5d, 0xd5, 0x5a, 0x54, 0xeb, 0xf7, 0x2e, 0x3f, 0x54, 0xcc, 0xc2, 0xf3, 0x1c, 0x6b,
+	0xc3, 0xdb, 0xec, 0xc0, 0xc3, 0x95, 0x20, 0xbe, 0xc2, 0x95, 0x1e, 0xc4, 0x60, 0xe1, 0xf2, 0x76,
+	0x34, 0xb7, 0x6f, 0xfe, 0xa5, 0x2a, 0x9b, 0x7d, 0x7f, 0x93, 0xea, 0xf1, 0xa3, 0xef, 0xf3, 0x26,
+	0xfa, 0x31, 0x6f, 0xa2, 0x5f, 0xf3, 0x26, 0x7a, 0xd9, 0xfd, 0xbf, 0xf7, 0x21, 0x88, 0x05, 0x24,
+	0xb6, 0xc4, 0x18, 0x57, 0xdc, 0x6b, 0xd0, 0xfd, 0x33, 0x00, 0xdb, 0x01, 0xd7, 0x4b, 0x9f, 0x04,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -501,6 +511,16 @@ func (m *GnuPGPublicKeyQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.WithKeyData {
+		i--
+		if m.WithKeyData {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.KeyID) > 0 {
 		i -= len(m.KeyID)
 		copy(dAtA[i:], m.KeyID)
@@ -656,6 +676,9 @@ func (m *GnuPGPublicKeyQuery) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGpgkey(uint64(l))
 	}
+	if m.WithKeyData {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -782,6 +805,26 @@ func (m *GnuPGPublicKeyQuery) Unmarshal(dAtA []byte) error {
 			}
 			m.KeyID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithKeyData", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpgkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithKeyData = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGpgkey(dAtA[iNdEx:])
@@ -694,10 +694,14 @@ func (m *RepoCreateRequest) GetCredsOnly() bool {
 }
 
 type RepoUpdateRequest struct {
-	Repo                 *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Repo *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	// UpdatedFields are the JSON names of the repository fields to update. The other fields, including the stored secrets, are kept. All fields are replaced if empty.
+	UpdatedFields []string `protobuf:"bytes,2,rep,name=updatedFields,proto3" json:"updatedFields,omitempty"`
+	// Test verifies that the repository can be accessed with the updated settings before updating it.
+	Test                 bool     `protobuf:"varint,3,opt,name=test,proto3" json:"test,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *RepoUpdateRequest) Reset()         { *m = RepoUpdateRequest{} }
@@ -740,6 +744,20 @@ func (m *RepoUpdateRequest) GetRepo() *v1alpha1.Repository {
 	return nil
 }
 
+func (m *RepoUpdateRequest) GetUpdatedFields() []string {
+	if m != nil {
+		return m.UpdatedFields
+	}
+	return nil
+}
+
+func (m *RepoUpdateRequest) GetTest() bool {
+	if m != nil {
+		return m.Test
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*RepoAppsQuery)(nil), "repository.RepoAppsQuery")
 	proto.RegisterType((*AppInfo)(nil), "repository.AppInfo")
@@ -757,94 +775,96 @@ func init() {
 }
 
 var fileDescriptor_8d38260443475705 = []byte{
-	// 1392 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xdf, 0x6e, 0x1b, 0xc5,
-	0x17, 0xd6, 0x26, 0x8d, 0x9b, 0x4c, 0x9a, 0xd6, 0x9d, 0x24, 0xed, 0xfe, 0xdc, 0x34, 0xcd, 0x6f,
-	0x5b, 0xa2, 0x34, 0x6a, 0xd7, 0x4d, 0x0a, 0xa2, 0x2a, 0x02, 0xc9, 0x4d, 0x4a, 0x6b, 0x11, 0x91,
-	0xb2, 0x6d, 0xa9, 0x84, 0x40, 0x68, 0xb2, 0x3e, 0xb1, 0xb7, 0xd9, 0xec, 0x4e, 0x67, 0xc6, 0x6e,
-	0x4d, 0xd5, 0x1b, 0x84, 0x10, 0x12, 0xdc, 0x20, 0x04, 0xe2, 0x0e, 0x2e, 0x90, 0x90, 0xe0, 0x12,
-	0x89, 0x67, 0xe0, 0x12, 0x89, 0x17, 0x40, 0x15, 0x0f, 0xc1, 0x25, 0x9a, 0x33, 0xeb, 0xf5, 0x3a,
-	0xf1, 0x9f, 0x44, 0x4d, 0x73, 0x37, 0x73, 0xce, 0xec, 0xf9, 0xbe, 0xf3, 0xcd, 0x99, 0x33, 0x63,
-	0x13, 0x47, 0x82, 0x68, 0x80, 0x28, 0x0a, 0xe0, 0xb1, 0x0c, 0x54, 0x2c, 0x9a, 0x99, 0xa1, 0xcb,
-	0x45, 0xac, 0x62, 0x4a, 0xda, 0x96, 0xc2, 0x4c, 0x35, 0x8e, 0xab, 0x21, 0x14, 0x19, 0x0f, 0x8a,
-	0x2c, 0x8a, 0x62, 0xc5, 0x54, 0x10, 0x47, 0xd2, 0xac, 0x2c, 0xac, 0x55, 0x03, 0x55, 0xab, 0x6f,
-	0xb8, 0x7e, 0xbc, 0x5d, 0x64, 0xa2, 0x1a, 0x73, 0x11, 0x3f, 0xc4, 0xc1, 0x65, 0xbf, 0x52, 0x6c,
-	0x5c, 0x2d, 0xf2, 0xad, 0xaa, 0xfe, 0x52, 0x16, 0x19, 0xe7, 0x61, 0xe0, 0xe3, 0xb7, 0xc5, 0xc6,
-	0x12, 0x0b, 0x79, 0x8d, 0x2d, 0x15, 0xab, 0x10, 0x81, 0x60, 0x0a, 0x2a, 0x49, 0xb4, 0x9b, 0x03,
-	0xa2, 0x21, 0xad, 0x81, 0xf4, 0x9d, 0x26, 0x99, 0xf0, 0x80, 0xc7, 0x25, 0xce, 0xe5, 0x7b, 0x75,
-	0x10, 0x4d, 0x4a, 0xc9, 0x11, 0xbd, 0xc8, 0xb6, 0xe6, 0xac, 0x85, 0x31, 0x0f, 0xc7, 0xb4, 0x40,
-	0x46, 0x05, 0x34, 0x02, 0x19, 0xc4, 0x91, 0x3d, 0x84, 0xf6, 0x74, 0x4e, 0x6d, 0x72, 0x94, 0x71,
-	0xfe, 0x2e, 0xdb, 0x06, 0x7b, 0x18, 0x5d, 0xad, 0x29, 0x9d, 0x25, 0x84, 0x71, 0x7e, 0x47, 0xc4,
-	0x0f, 0xc1, 0x57, 0xf6, 0x11, 0x74, 0x66, 0x2c, 0xce, 0x12, 0x39, 0x5a, 0xe2, 0xbc, 0x1c, 0x6d,
-	0xc6, 0x1a, 0x54, 0x35, 0x39, 0xb4, 0x40, 0xf5, 0x58, 0xdb, 0x38, 0x53, 0xb5, 0x04, 0x10, 0xc7,
-	0xce, 0xbf, 0x16, 0x99, 0x4c, 0xe8, 0xae, 0x82, 0x62, 0x41, 0x98, 0x90, 0xae, 0x92, 0x9c, 0x8c,
-	0xeb, 0xc2, 0x37, 0x11, 0xc6, 0x97, 0xd7, 0xdd, 0xb6, 0x3a, 0x6e, 0x4b, 0x1d, 0x1c, 0x7c, 0xec,
-	0x57, 0xdc, 0xc6, 0x55, 0x97, 0x6f, 0x55, 0x5d, 0xad, 0xb5, 0x9b, 0xd1, 0xda, 0x6d, 0x69, 0xed,
-	0x96, 0xda, 0xc6, 0xbb, 0x18, 0xd6, 0x4b, 0xc2, 0x67, 0xb3, 0x1d, 0xea, 0x97, 0xed, 0xf0, 0xce,
-	0x6c, 0xe9, 0x1c, 0x19, 0x37, 0x31, 0xca, 0x51, 0x05, 0x9e, 0xa0, 0x1c, 0x23, 0x5e, 0xd6, 0x44,
-	0x67, 0xc8, 0x58, 0x03, 0x84, 0x16, 0xb5, 0x5c, 0xb1, 0x47, 0xd0, 0xdf, 0x36, 0x38, 0x6f, 0x92,
-	0x7c, 0x6b, 0xa3, 0x3c, 0x90, 0x3c, 0x8e, 0x24, 0xd0, 0x8b, 0x64, 0x24, 0x50, 0xb0, 0x2d, 0x6d,
-	0x6b, 0x6e, 0x78, 0x61, 0x7c, 0x79, 0xd2, 0xcd, 0x6c, 0x6f, 0x22, 0xad, 0x67, 0x56, 0x38, 0x3e,
-	0x19, 0xd3, 0x9f, 0xf7, 0xde, 0x63, 0x87, 0x1c, 0xdb, 0x8c, 0x75, 0xaa, 0xb0, 0x29, 0x40, 0x1a,
-	0xd9, 0x47, 0xbd, 0x0e, 0xdb, 0xa0, 0x1c, 0x9d, 0xdf, 0x72, 0xe4, 0x04, 0x92, 0xf4, 0x7d, 0x90,
-	0xfd, 0xeb, 0xa9, 0x2e, 0x41, 0x44, 0x6d, 0x19, 0xd3, 0xb9, 0xf6, 0x71, 0x26, 0xe5, 0xe3, 0x58,
-	0x54, 0x12, 0x84, 0x74, 0x4e, 0x2f, 0x90, 0x09, 0x29, 0x6b, 0x77, 0x44, 0xd0, 0x60, 0x0a, 0xde,
-	0x81, 0x66, 0x52, 0x54, 0x9d, 0x46, 0x1d, 0x21, 0x88, 0x24, 0xf8, 0x75, 0x01, 0x28, 0xe3, 0xa8,
-	0x97, 0xce, 0xe9, 0x25, 0x72, 0x52, 0x85, 0x72, 0x25, 0x0c, 0x20, 0x52, 0x2b, 0x20, 0xd4, 0x2a,
-	0x53, 0xcc, 0xce, 0x61, 0x94, 0xdd, 0x0e, 0xba, 0x48, 0xf2, 0x1d, 0x46, 0x0d, 0x79, 0x14, 0x17,
-	0xef, 0xb2, 0xa7, 0x25, 0x3c, 0xd6, 0x59, 0xc2, 0x98, 0x23, 0x31, 0x36, 0xcc, 0x6f, 0x86, 0x8c,
-	0x41, 0xc4, 0x36, 0x42, 0x58, 0xf7, 0x03, 0x7b, 0x1c, 0xe9, 0xb5, 0x0d, 0xf4, 0x0a, 0x99, 0x34,
-	0x95, 0x5b, 0xd2, 0xaa, 0xa6, 0x79, 0x1e, 0xc3, 0x00, 0xdd, 0x5c, 0xba, 0xae, 0x52, 0x73, 0x79,
-	0xd5, 0x9e, 0x98, 0xb3, 0x16, 0x86, 0xbd, 0xac, 0x89, 0x5e, 0x23, 0xa7, 0xdb, 0xd3, 0x48, 0x2a,
-	0x16, 0x86, 0x58, 0xda, 0xe5, 0x55, 0xfb, 0x38, 0xae, 0xee, 0xe5, 0xa6, 0x6f, 0x91, 0x42, 0xea,
-	0xba, 0x19, 0x29, 0x10, 0x5c, 0x04, 0x12, 0x6e, 0x30, 0x09, 0xf7, 0x45, 0x68, 0x9f, 0x40, 0x52,
-	0x7d, 0x56, 0xd0, 0x29, 0x32, 0xc2, 0x45, 0xfc, 0xa4, 0x69, 0xe7, 0x71, 0xa9, 0x99, 0xe8, 0x33,
-	0xc4, 0x93, 0x12, 0x3a, 0x69, 0xce, 0x50, 0x32, 0xa5, 0xcb, 0x64, 0xaa, 0xea, 0xf3, 0xbb, 0x20,
-	0x1a, 0x81, 0x0f, 0x25, 0xdf, 0x8f, 0xeb, 0x11, 0x6a, 0x4e, 0x71, 0x59, 0x57, 0x1f, 0x75, 0x09,
-	0xc5, 0x1a, 0xbd, 0xad, 0x14, 0xbf, 0xc1, 0x64, 0xe0, 0x97, 0xea, 0xaa, 0x66, 0x4f, 0xa2, 0xb0,
-	0x5d, 0x3c, 0xf4, 0x3a, 0xb1, 0xeb, 0x12, 0x4a, 0x9f, 0xd4, 0x05, 0x3c, 0x88, 0xc5, 0x56, 0x18,
-	0xb3, 0x4a, 0xb9, 0x02, 0x91, 0x0a, 0x54, 0xd3, 0x9e, 0xc2, 0xaf, 0x7a, 0xfa, 0xb5, 0xd6, 0x1b,
-	0xc0, 0x04, 0x88, 0x7b, 0xf1, 0x16, 0x44, 0xf6, 0x34, 0xd2, 0xca, 0x9a, 0x74, 0x06, 0xad, 0x5a,
-	0x5b, 0xf7, 0x83, 0xb7, 0x5b, 0xf0, 0xf6, 0x29, 0x8c, 0xdc, 0xd5, 0xe7, 0x1c, 0x27, 0xc7, 0xf4,
-	0xa1, 0x69, 0x9d, 0x6a, 0xe7, 0x67, 0x8b, 0x9c, 0xd4, 0x86, 0x15, 0x01, 0x4c, 0x81, 0x07, 0x8f,
-	0xea, 0x20, 0x15, 0xfd, 0x30, 0x73, 0x8e, 0xc6, 0x97, 0x6f, 0xbf, 0x58, 0x83, 0xf3, 0xd2, 0x3e,
-	0x91, 0x9c, 0xc8, 0x53, 0x24, 0x57, 0xe7, 0x12, 0x84, 0x4a, 0xce, 0x7d, 0x32, 0xd3, 0xd5, 0xea,
-	0x0b, 0xa8, 0xc8, 0xf5, 0x28, 0x6c, 0xe2, 0x71, 0x1c, 0xf5, 0xda, 0x06, 0xe7, 0x91, 0x21, 0x7a,
-	0x9f, 0x57, 0x0e, 0x8b, 0xe8, 0xf2, 0x67, 0xa7, 0x0d, 0xa6, 0x31, 0x26, 0xe5, 0x40, 0xbf, 0xb2,
-	0xc8, 0x91, 0xb5, 0x40, 0x2a, 0x3a, 0x9d, 0x6d, 0x81, 0x69, 0xc3, 0x2b, 0xac, 0x1d, 0x14, 0x0b,
-	0x0d, 0xe2, 0x9c, 0xfb, 0xf4, 0xaf, 0x7f, 0xbe, 0x19, 0x3a, 0x45, 0xa7, 0xf0, 0xa2, 0x6f, 0x2c,
-	0xb5, 0x6f, 0xd5, 0x00, 0xe4, 0x17, 0x43, 0x16, 0xfd, 0xd2, 0x22, 0xc3, 0xb7, 0xa0, 0x27, 0x9b,
-	0x03, 0xd3, 0xc4, 0x39, 0x8f, 0x4c, 0xce, 0xd2, 0x33, 0xdd, 0x98, 0x14, 0x9f, 0xea, 0xd9, 0x33,
-	0xfa, 0x9d, 0x45, 0x46, 0x6f, 0x81, 0x7a, 0x20, 0x02, 0x05, 0x2f, 0x9f, 0xd2, 0x45, 0xa4, 0x74,
-	0x9e, 0xfe, 0xbf, 0x45, 0xe9, 0xb1, 0xc6, 0xbd, 0xdc, 0x8d, 0xd8, 0xb7, 0x16, 0xc9, 0x6b, 0x41,
-	0xbd, 0x8c, 0xef, 0x70, 0x76, 0x70, 0xa6, 0xdf, 0x0e, 0xd2, 0x1f, 0x2d, 0x32, 0xad, 0x97, 0xa1,
-	0x62, 0x87, 0x4f, 0xce, 0x41, 0x72, 0x33, 0xb4, 0xd0, 0x5b, 0x41, 0xfa, 0x11, 0x19, 0x35, 0xca,
-	0x6d, 0xf6, 0x24, 0x95, 0xef, 0x34, 0x6f, 0x4a, 0x67, 0x01, 0x03, 0x3b, 0x74, 0xae, 0x4f, 0xb5,
-	0x14, 0x85, 0x0e, 0x59, 0x21, 0xe3, 0x3a, 0xfc, 0xfa, 0x4a, 0xf9, 0x1e, 0xab, 0xee, 0x03, 0xe1,
-	0x12, 0x22, 0xcc, 0xd3, 0x0b, 0xfd, 0x10, 0x62, 0x3f, 0xb8, 0xac, 0x74, 0xd8, 0x6d, 0x93, 0x84,
-	0x7e, 0xd2, 0xd0, 0xff, 0xed, 0x84, 0x48, 0x5f, 0xa4, 0x85, 0x99, 0x6e, 0xae, 0xb4, 0x5b, 0xee,
-	0x29, 0x29, 0xa6, 0x21, 0xbe, 0xb6, 0xc8, 0xc4, 0x2d, 0x50, 0xed, 0xb7, 0x23, 0x3d, 0xd7, 0x25,
-	0x72, 0xf6, 0x5d, 0x59, 0x70, 0x7a, 0x2f, 0x48, 0x09, 0xbc, 0x81, 0x04, 0x5e, 0x73, 0xae, 0x74,
-	0x27, 0x60, 0x5e, 0x78, 0x18, 0xe7, 0xbe, 0xb7, 0x86, 0x54, 0x2a, 0x26, 0xc2, 0x75, 0x6b, 0x91,
-	0x36, 0x90, 0xd2, 0x6d, 0x08, 0xb7, 0x57, 0x6a, 0x4c, 0xa8, 0x9e, 0x52, 0xcf, 0x66, 0xcd, 0xed,
-	0xe5, 0x29, 0x09, 0x17, 0x49, 0x2c, 0xd0, 0xf9, 0x7e, 0x2a, 0xd4, 0x20, 0xdc, 0xf6, 0x0d, 0xcc,
-	0xf7, 0x16, 0xc9, 0x99, 0xfb, 0x85, 0x9e, 0xdd, 0x89, 0xd8, 0x71, 0xef, 0x1c, 0x60, 0x67, 0x78,
-	0xc5, 0xd4, 0xb5, 0xd3, 0xf5, 0xd0, 0x5d, 0xc7, 0xf6, 0xae, 0x9b, 0xe7, 0x0f, 0x16, 0xc9, 0xb7,
-	0x28, 0xb4, 0xbe, 0x3d, 0x3c, 0x92, 0xce, 0x60, 0x92, 0xf4, 0x17, 0x8b, 0x4c, 0x1b, 0xfc, 0xce,
-	0x0e, 0x71, 0x88, 0x34, 0x93, 0xaa, 0x77, 0xfa, 0xf4, 0x88, 0x84, 0xec, 0x4f, 0x16, 0xc9, 0x99,
-	0x0b, 0x7a, 0x37, 0xbb, 0x8e, 0x8b, 0xfb, 0x00, 0xd9, 0x2d, 0x99, 0x6a, 0x2c, 0xf4, 0x39, 0x93,
-	0x48, 0xe5, 0x59, 0x7b, 0xd7, 0x7f, 0xb5, 0x48, 0xbe, 0x45, 0xa7, 0xb7, 0x9c, 0x2f, 0x8b, 0xb0,
-	0xbb, 0x3f, 0xc2, 0xf4, 0x77, 0x8b, 0x4c, 0x1b, 0x2e, 0x03, 0x2b, 0xe0, 0x65, 0x51, 0x7e, 0x15,
-	0x29, 0xbb, 0x85, 0xf9, 0x41, 0xf7, 0x6c, 0x07, 0x71, 0x46, 0x72, 0xab, 0x10, 0x42, 0xef, 0x87,
-	0x80, 0xbd, 0xd3, 0x9c, 0xb6, 0x98, 0x79, 0xf3, 0xd6, 0x58, 0xec, 0xf7, 0xd6, 0xd0, 0x3b, 0x59,
-	0x23, 0x79, 0x03, 0x91, 0x51, 0x65, 0xdf, 0x60, 0xe7, 0xf7, 0x00, 0x46, 0x25, 0x99, 0x36, 0x48,
-	0x3b, 0x37, 0x61, 0xdf, 0x70, 0xc9, 0xa3, 0x65, 0x71, 0x0f, 0x8f, 0x96, 0xa7, 0xe4, 0xf8, 0xfb,
-	0x2c, 0x0c, 0xf4, 0xa6, 0x9a, 0x9f, 0xb9, 0xf4, 0xcc, 0xae, 0x4b, 0xa2, 0xfd, 0xf3, 0xb7, 0x0f,
-	0xe6, 0x32, 0x62, 0x5e, 0x72, 0xfa, 0xde, 0x95, 0x8d, 0x04, 0x2a, 0xd9, 0xbe, 0xcf, 0x2d, 0x32,
-	0xd9, 0x42, 0xc7, 0xa4, 0x5f, 0x8c, 0xc2, 0x35, 0xa4, 0xb0, 0xec, 0x2c, 0x0e, 0x4c, 0x7b, 0x07,
-	0x91, 0x1b, 0x37, 0xff, 0x78, 0x3e, 0x6b, 0xfd, 0xf9, 0x7c, 0xd6, 0xfa, 0xfb, 0xf9, 0xac, 0xf5,
-	0xc1, 0xeb, 0x7b, 0xfb, 0x67, 0xcb, 0xc7, 0x1f, 0xcc, 0x99, 0xff, 0xa0, 0x36, 0x72, 0xf8, 0x27,
-	0xd4, 0xd5, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xbc, 0x0b, 0xd2, 0x3f, 0x69, 0x13, 0x00, 0x00,
+	// 1413 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4f, 0x6f, 0xdc, 0x44,
+	0x14, 0x97, 0x93, 0x66, 0x9b, 0x4c, 0x9a, 0x76, 0x33, 0x49, 0x5a, 0xb3, 0x4d, 0xd3, 0xe0, 0x96,
+	0x28, 0x8d, 0x5a, 0x6f, 0x93, 0x82, 0xa8, 0x8a, 0x40, 0x4a, 0x93, 0xfe, 0x59, 0x11, 0x91, 0xe2,
+	0xb6, 0x54, 0x42, 0x20, 0x34, 0xb1, 0x5f, 0x76, 0xdd, 0x38, 0xf6, 0x30, 0x33, 0xbb, 0xed, 0x52,
+	0xf5, 0x82, 0x10, 0x42, 0x82, 0x0b, 0x42, 0x20, 0x6e, 0x70, 0x40, 0x42, 0xa2, 0x47, 0x24, 0x3e,
+	0x03, 0x47, 0x24, 0xbe, 0x00, 0xaa, 0xf8, 0x10, 0x1c, 0xd1, 0x3c, 0x7b, 0x6d, 0x6f, 0xb2, 0x7f,
+	0x12, 0x35, 0xcd, 0x6d, 0xe6, 0xbd, 0xf1, 0xfb, 0xfd, 0xde, 0x6f, 0xde, 0xcc, 0xbc, 0x5d, 0x62,
+	0x49, 0x10, 0x0d, 0x10, 0x65, 0x01, 0x3c, 0x92, 0xbe, 0x8a, 0x44, 0x33, 0x37, 0xb4, 0xb9, 0x88,
+	0x54, 0x44, 0x49, 0x66, 0x29, 0x4d, 0x57, 0xa3, 0xa8, 0x1a, 0x40, 0x99, 0x71, 0xbf, 0xcc, 0xc2,
+	0x30, 0x52, 0x4c, 0xf9, 0x51, 0x28, 0xe3, 0x95, 0xa5, 0xb5, 0xaa, 0xaf, 0x6a, 0xf5, 0x0d, 0xdb,
+	0x8d, 0xb6, 0xcb, 0x4c, 0x54, 0x23, 0x2e, 0xa2, 0x87, 0x38, 0xb8, 0xe4, 0x7a, 0xe5, 0xc6, 0x95,
+	0x32, 0xdf, 0xaa, 0xea, 0x2f, 0x65, 0x99, 0x71, 0x1e, 0xf8, 0x2e, 0x7e, 0x5b, 0x6e, 0x2c, 0xb2,
+	0x80, 0xd7, 0xd8, 0x62, 0xb9, 0x0a, 0x21, 0x08, 0xa6, 0xc0, 0x4b, 0xa2, 0xdd, 0xe8, 0x13, 0x0d,
+	0x69, 0xf5, 0xa5, 0x6f, 0x35, 0xc9, 0x98, 0x03, 0x3c, 0x5a, 0xe6, 0x5c, 0xbe, 0x5f, 0x07, 0xd1,
+	0xa4, 0x94, 0x1c, 0xd1, 0x8b, 0x4c, 0x63, 0xd6, 0x98, 0x1f, 0x71, 0x70, 0x4c, 0x4b, 0x64, 0x58,
+	0x40, 0xc3, 0x97, 0x7e, 0x14, 0x9a, 0x03, 0x68, 0x4f, 0xe7, 0xd4, 0x24, 0x47, 0x19, 0xe7, 0xef,
+	0xb1, 0x6d, 0x30, 0x07, 0xd1, 0xd5, 0x9a, 0xd2, 0x19, 0x42, 0x18, 0xe7, 0x77, 0x44, 0xf4, 0x10,
+	0x5c, 0x65, 0x1e, 0x41, 0x67, 0xce, 0x62, 0x2d, 0x92, 0xa3, 0xcb, 0x9c, 0x57, 0xc2, 0xcd, 0x48,
+	0x83, 0xaa, 0x26, 0x87, 0x16, 0xa8, 0x1e, 0x6b, 0x1b, 0x67, 0xaa, 0x96, 0x00, 0xe2, 0xd8, 0xfa,
+	0xcf, 0x20, 0x13, 0x09, 0xdd, 0x55, 0x50, 0xcc, 0x0f, 0x12, 0xd2, 0x55, 0x52, 0x90, 0x51, 0x5d,
+	0xb8, 0x71, 0x84, 0xd1, 0xa5, 0x75, 0x3b, 0x53, 0xc7, 0x6e, 0xa9, 0x83, 0x83, 0x4f, 0x5c, 0xcf,
+	0x6e, 0x5c, 0xb1, 0xf9, 0x56, 0xd5, 0xd6, 0x5a, 0xdb, 0x39, 0xad, 0xed, 0x96, 0xd6, 0xf6, 0x72,
+	0x66, 0xbc, 0x8b, 0x61, 0x9d, 0x24, 0x7c, 0x3e, 0xdb, 0x81, 0x5e, 0xd9, 0x0e, 0xee, 0xcc, 0x96,
+	0xce, 0x92, 0xd1, 0x38, 0x46, 0x25, 0xf4, 0xe0, 0x31, 0xca, 0x31, 0xe4, 0xe4, 0x4d, 0x74, 0x9a,
+	0x8c, 0x34, 0x40, 0x68, 0x51, 0x2b, 0x9e, 0x39, 0x84, 0xfe, 0xcc, 0x60, 0xbd, 0x4d, 0x8a, 0xad,
+	0x8d, 0x72, 0x40, 0xf2, 0x28, 0x94, 0x40, 0x2f, 0x90, 0x21, 0x5f, 0xc1, 0xb6, 0x34, 0x8d, 0xd9,
+	0xc1, 0xf9, 0xd1, 0xa5, 0x09, 0x3b, 0xb7, 0xbd, 0x89, 0xb4, 0x4e, 0xbc, 0xc2, 0x72, 0xc9, 0x88,
+	0xfe, 0xbc, 0xfb, 0x1e, 0x5b, 0xe4, 0xd8, 0x66, 0xa4, 0x53, 0x85, 0x4d, 0x01, 0x32, 0x96, 0x7d,
+	0xd8, 0x69, 0xb3, 0xf5, 0xcb, 0xd1, 0xfa, 0xbd, 0x40, 0x4e, 0x20, 0x49, 0xd7, 0x05, 0xd9, 0xbb,
+	0x9e, 0xea, 0x12, 0x44, 0x98, 0xc9, 0x98, 0xce, 0xb5, 0x8f, 0x33, 0x29, 0x1f, 0x45, 0xc2, 0x4b,
+	0x10, 0xd2, 0x39, 0x3d, 0x4f, 0xc6, 0xa4, 0xac, 0xdd, 0x11, 0x7e, 0x83, 0x29, 0x78, 0x17, 0x9a,
+	0x49, 0x51, 0xb5, 0x1b, 0x75, 0x04, 0x3f, 0x94, 0xe0, 0xd6, 0x05, 0xa0, 0x8c, 0xc3, 0x4e, 0x3a,
+	0xa7, 0x17, 0xc9, 0xb8, 0x0a, 0xe4, 0x4a, 0xe0, 0x43, 0xa8, 0x56, 0x40, 0xa8, 0x55, 0xa6, 0x98,
+	0x59, 0xc0, 0x28, 0xbb, 0x1d, 0x74, 0x81, 0x14, 0xdb, 0x8c, 0x1a, 0xf2, 0x28, 0x2e, 0xde, 0x65,
+	0x4f, 0x4b, 0x78, 0xa4, 0xbd, 0x84, 0x31, 0x47, 0x12, 0xdb, 0x30, 0xbf, 0x69, 0x32, 0x02, 0x21,
+	0xdb, 0x08, 0x60, 0xdd, 0xf5, 0xcd, 0x51, 0xa4, 0x97, 0x19, 0xe8, 0x65, 0x32, 0x11, 0x57, 0xee,
+	0x32, 0xe7, 0x59, 0x4a, 0xe6, 0x31, 0x0c, 0xd0, 0xc9, 0xa5, 0xeb, 0x2a, 0x35, 0x57, 0x56, 0xcd,
+	0xb1, 0x59, 0x63, 0x7e, 0xd0, 0xc9, 0x9b, 0xe8, 0x55, 0x72, 0x2a, 0x9b, 0x86, 0x52, 0xb1, 0x20,
+	0xc0, 0xd2, 0xae, 0xac, 0x9a, 0xc7, 0x71, 0x75, 0x37, 0x37, 0x7d, 0x87, 0x94, 0x52, 0xd7, 0x8d,
+	0x50, 0x81, 0xe0, 0xc2, 0x97, 0x70, 0x9d, 0x49, 0xb8, 0x2f, 0x02, 0xf3, 0x04, 0x92, 0xea, 0xb1,
+	0x82, 0x4e, 0x92, 0x21, 0x2e, 0xa2, 0xc7, 0x4d, 0xb3, 0x88, 0x4b, 0xe3, 0x89, 0x3e, 0x43, 0x3c,
+	0x29, 0xa1, 0xf1, 0xf8, 0x0c, 0x25, 0x53, 0xba, 0x44, 0x26, 0xab, 0x2e, 0xbf, 0x0b, 0xa2, 0xe1,
+	0xbb, 0xb0, 0xec, 0xba, 0x51, 0x3d, 0x44, 0xcd, 0x29, 0x2e, 0xeb, 0xe8, 0xa3, 0x36, 0xa1, 0x58,
+	0xa3, 0xb7, 0x95, 0xe2, 0xd7, 0x99, 0xf4, 0xdd, 0xe5, 0xba, 0xaa, 0x99, 0x13, 0x28, 0x6c, 0x07,
+	0x0f, 0xbd, 0x46, 0xcc, 0xba, 0x84, 0xe5, 0xcf, 0xea, 0x02, 0x1e, 0x44, 0x62, 0x2b, 0x88, 0x98,
+	0x57, 0xf1, 0x20, 0x54, 0xbe, 0x6a, 0x9a, 0x93, 0xf8, 0x55, 0x57, 0xbf, 0xd6, 0x7a, 0x03, 0x98,
+	0x00, 0x71, 0x2f, 0xda, 0x82, 0xd0, 0x9c, 0x42, 0x5a, 0x79, 0x93, 0xce, 0xa0, 0x55, 0x6b, 0xeb,
+	0xae, 0x7f, 0xb3, 0x05, 0x6f, 0x9e, 0xc4, 0xc8, 0x1d, 0x7d, 0xd6, 0x71, 0x72, 0x4c, 0x1f, 0x9a,
+	0xd6, 0xa9, 0xb6, 0x7e, 0x35, 0xc8, 0xb8, 0x36, 0xac, 0x08, 0x60, 0x0a, 0x1c, 0xf8, 0xb4, 0x0e,
+	0x52, 0xd1, 0x8f, 0x72, 0xe7, 0x68, 0x74, 0xe9, 0xf6, 0x8b, 0x5d, 0x70, 0x4e, 0x7a, 0x4f, 0x24,
+	0x27, 0xf2, 0x24, 0x29, 0xd4, 0xb9, 0x04, 0xa1, 0x92, 0x73, 0x9f, 0xcc, 0x74, 0xb5, 0xba, 0x02,
+	0x3c, 0xb9, 0x1e, 0x06, 0x4d, 0x3c, 0x8e, 0xc3, 0x4e, 0x66, 0xb0, 0x9e, 0x25, 0x4c, 0xef, 0x73,
+	0xef, 0xd0, 0x98, 0x9e, 0x27, 0x63, 0x75, 0x84, 0xf3, 0x6e, 0xfa, 0x10, 0x78, 0xd2, 0x1c, 0x98,
+	0x1d, 0xd4, 0x77, 0x40, 0x9b, 0x11, 0x4f, 0x23, 0x48, 0x95, 0x50, 0xc6, 0xf1, 0xd2, 0x17, 0xa7,
+	0xc8, 0x78, 0x16, 0x2e, 0xa9, 0x24, 0xfa, 0x8d, 0x41, 0x8e, 0xac, 0xf9, 0x52, 0xd1, 0xa9, 0xfc,
+	0xed, 0x99, 0xde, 0x95, 0xa5, 0xb5, 0x83, 0xe2, 0xaf, 0x41, 0xac, 0xb3, 0x9f, 0xff, 0xfd, 0xef,
+	0x77, 0x03, 0x27, 0xe9, 0x24, 0xf6, 0x08, 0x8d, 0xc5, 0xec, 0x41, 0xf6, 0x41, 0x7e, 0x35, 0x60,
+	0xd0, 0xaf, 0x0d, 0x32, 0x78, 0x0b, 0xba, 0xb2, 0x39, 0x30, 0x35, 0xad, 0x73, 0xc8, 0xe4, 0x0c,
+	0x3d, 0xdd, 0x89, 0x49, 0xf9, 0x89, 0x9e, 0x3d, 0xa5, 0x3f, 0x18, 0x64, 0xf8, 0x16, 0xa8, 0x07,
+	0xc2, 0x57, 0xf0, 0xf2, 0x29, 0x5d, 0x40, 0x4a, 0xe7, 0xe8, 0xab, 0x2d, 0x4a, 0x8f, 0x34, 0xee,
+	0xa5, 0x4e, 0xc4, 0xbe, 0x37, 0x48, 0x51, 0x0b, 0xea, 0xe4, 0x7c, 0x87, 0xb3, 0x83, 0xd3, 0xbd,
+	0x76, 0x90, 0xfe, 0x6c, 0x90, 0x29, 0xbd, 0x0c, 0x15, 0x3b, 0x7c, 0x72, 0x16, 0x92, 0x9b, 0xa6,
+	0xa5, 0xee, 0x0a, 0xd2, 0x8f, 0xc9, 0x70, 0xac, 0xdc, 0x66, 0x57, 0x52, 0xc5, 0x76, 0xf3, 0xa6,
+	0xb4, 0xe6, 0x31, 0xb0, 0x45, 0x67, 0x7b, 0x54, 0x4b, 0x59, 0xe8, 0x90, 0x1e, 0x19, 0xd5, 0xe1,
+	0xd7, 0x57, 0x2a, 0xf7, 0x58, 0x75, 0x1f, 0x08, 0x17, 0x11, 0x61, 0x8e, 0x9e, 0xef, 0x85, 0x10,
+	0xb9, 0xfe, 0x25, 0xa5, 0xc3, 0x6e, 0xc7, 0x49, 0xe8, 0x6e, 0x88, 0xbe, 0xb2, 0x13, 0x22, 0x6d,
+	0x66, 0x4b, 0xd3, 0x9d, 0x5c, 0xe9, 0x45, 0xbb, 0xa7, 0xa4, 0x98, 0x86, 0xf8, 0xd6, 0x20, 0x63,
+	0xb7, 0x40, 0x65, 0x6d, 0x27, 0x3d, 0xdb, 0x21, 0x72, 0xbe, 0x25, 0x2d, 0x59, 0xdd, 0x17, 0xa4,
+	0x04, 0xde, 0x42, 0x02, 0x6f, 0x58, 0x97, 0x3b, 0x13, 0x88, 0x9b, 0x43, 0x8c, 0x73, 0xdf, 0x59,
+	0x43, 0x2a, 0x5e, 0x1c, 0xe1, 0x9a, 0xb1, 0x40, 0x1b, 0x48, 0xe9, 0x36, 0x04, 0xdb, 0x2b, 0x35,
+	0x26, 0x54, 0x57, 0xa9, 0x67, 0xf2, 0xe6, 0x6c, 0x79, 0x4a, 0xc2, 0x46, 0x12, 0xf3, 0x74, 0xae,
+	0x97, 0x0a, 0x35, 0x08, 0xb6, 0xdd, 0x18, 0xe6, 0x47, 0x83, 0x14, 0xe2, 0xa7, 0x89, 0x9e, 0xd9,
+	0x89, 0xd8, 0xf6, 0x64, 0x1d, 0xe0, 0xcd, 0xf0, 0x5a, 0x5c, 0xd7, 0x56, 0xc7, 0x43, 0x77, 0x0d,
+	0x1f, 0x06, 0x7d, 0x79, 0xfe, 0x64, 0x90, 0x62, 0x8b, 0x42, 0xeb, 0xdb, 0xc3, 0x23, 0x69, 0xf5,
+	0x27, 0x49, 0x7f, 0x33, 0xc8, 0x54, 0x8c, 0xdf, 0x7e, 0x43, 0x1c, 0x22, 0xcd, 0xa4, 0xea, 0xad,
+	0x1e, 0x77, 0x44, 0x42, 0xf6, 0x17, 0x83, 0x14, 0xe2, 0xa7, 0x7d, 0x37, 0xbb, 0xb6, 0x27, 0xff,
+	0x00, 0xd9, 0x2d, 0xc6, 0xd5, 0x58, 0xea, 0x71, 0x26, 0x91, 0xca, 0xd3, 0x6c, 0xd7, 0x9f, 0x19,
+	0xa4, 0xd8, 0xa2, 0xd3, 0x5d, 0xce, 0x97, 0x45, 0xd8, 0xde, 0x1f, 0x61, 0xfa, 0x87, 0x41, 0xa6,
+	0x62, 0x2e, 0x7d, 0x2b, 0xe0, 0x65, 0x51, 0x7e, 0x1d, 0x29, 0xdb, 0xa5, 0xb9, 0x7e, 0xef, 0x6c,
+	0x1b, 0x71, 0x46, 0x0a, 0xab, 0x10, 0x40, 0xf7, 0x46, 0xc0, 0xdc, 0x69, 0x4e, 0xaf, 0x98, 0xb9,
+	0xb8, 0xd7, 0x58, 0xe8, 0xd5, 0x6b, 0xe8, 0x9d, 0xac, 0x91, 0x62, 0x0c, 0x91, 0x53, 0x65, 0xdf,
+	0x60, 0xe7, 0xf6, 0x00, 0x46, 0x25, 0x99, 0x8a, 0x91, 0x76, 0x6e, 0xc2, 0xbe, 0xe1, 0x92, 0xa6,
+	0x65, 0x61, 0x0f, 0x4d, 0xcb, 0x13, 0x72, 0xfc, 0x03, 0x16, 0xf8, 0x7a, 0x53, 0xe3, 0x5f, 0xc8,
+	0xf4, 0xf4, 0xae, 0x47, 0x22, 0xfb, 0xe5, 0xdc, 0x03, 0x73, 0x09, 0x31, 0x2f, 0x5a, 0x3d, 0xdf,
+	0xca, 0x46, 0x02, 0x95, 0x6c, 0xdf, 0x97, 0x06, 0x99, 0x68, 0xa1, 0x63, 0xd2, 0x2f, 0x46, 0xe1,
+	0x2a, 0x52, 0x58, 0xb2, 0x16, 0xfa, 0xa6, 0xbd, 0x83, 0xc8, 0xf5, 0x1b, 0x7f, 0x3e, 0x9f, 0x31,
+	0xfe, 0x7a, 0x3e, 0x63, 0xfc, 0xf3, 0x7c, 0xc6, 0xf8, 0xf0, 0xcd, 0xbd, 0xfd, 0x29, 0xe6, 0xe2,
+	0x6f, 0xed, 0x2c, 0xcf, 0xe6, 0x46, 0x01, 0xff, 0xbf, 0xba, 0xf2, 0xff, 0x00, 0xd4, 0x3b, 0x0b,
+	0xa9, 0xa4, 0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2249,6 +2269,25 @@ func (m *RepoUpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Test {
+		i--
+		if m.Test {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.UpdatedFields) > 0 {
+		for iNdEx := len(m.UpdatedFields) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UpdatedFields[iNdEx])
+			copy(dAtA[i:], m.UpdatedFields[iNdEx])
+			i = encodeVarintRepository(dAtA, i, uint64(len(m.UpdatedFields[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if m.Repo != nil {
 		{
 			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
@@ -2527,6 +2566,15 @@ func (m *RepoUpdateRequest) Size() (n int) {
 		l = m.Repo.Size()
 		n += 1 + l + sovRepository(uint64(l))
 	}
+	if len(m.UpdatedFields) > 0 {
+		for _, s := range m.UpdatedFields {
+			l = len(s)
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.Test {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4122,6 +4170,58 @@ func (m *RepoUpdateRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedFields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedFields = append(m.UpdatedFields, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Test", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Test = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -295,44 +295,87 @@ func (m *GetUserInfoResponse) GetGroups() []string {
 	return nil
 }
 
+// SessionRevokeAllRequest is for revoking all sessions of the current account.
+type SessionRevokeAllRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SessionRevokeAllRequest) Reset()         { *m = SessionRevokeAllRequest{} }
+func (m *SessionRevokeAllRequest) String() string { return proto.CompactTextString(m) }
+func (*SessionRevokeAllRequest) ProtoMessage()    {}
+func (*SessionRevokeAllRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87870a51a62685ed, []int{5}
+}
+func (m *SessionRevokeAllRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SessionRevokeAllRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SessionRevokeAllRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SessionRevokeAllRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionRevokeAllRequest.Merge(m, src)
+}
+func (m *SessionRevokeAllRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SessionRevokeAllRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionRevokeAllRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SessionRevokeAllRequest proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*SessionCreateRequest)(nil), "session.SessionCreateRequest")
 	proto.RegisterType((*SessionDeleteRequest)(nil), "session.SessionDeleteRequest")
 	proto.RegisterType((*SessionResponse)(nil), "session.SessionResponse")
 	proto.RegisterType((*GetUserInfoRequest)(nil), "session.GetUserInfoRequest")
 	proto.RegisterType((*GetUserInfoResponse)(nil), "session.GetUserInfoResponse")
+	proto.RegisterType((*SessionRevokeAllRequest)(nil), "session.SessionRevokeAllRequest")
 }
 
 func init() { proto.RegisterFile("server/session/session.proto", fileDescriptor_87870a51a62685ed) }
 
 var fileDescriptor_87870a51a62685ed = []byte{
-	// 404 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x41, 0x8e, 0xd3, 0x30,
-	0x18, 0x85, 0x95, 0x14, 0x42, 0xc7, 0x48, 0x0c, 0x98, 0x68, 0x88, 0x42, 0xa9, 0xaa, 0x6c, 0x18,
-	0x8d, 0x44, 0x23, 0x18, 0x56, 0x2c, 0x0b, 0x12, 0xea, 0x36, 0x15, 0x9b, 0x4a, 0x2c, 0xd2, 0xe4,
-	0xc7, 0xb8, 0x4d, 0xfd, 0x07, 0xdb, 0x49, 0xf7, 0x5c, 0x81, 0xc3, 0x70, 0x05, 0x96, 0x48, 0x5c,
-	0x00, 0x55, 0x1c, 0x04, 0x25, 0x4e, 0x42, 0x9b, 0x56, 0xac, 0xe2, 0xe7, 0xdf, 0xf9, 0xde, 0xb3,
-	0x9e, 0xc9, 0x48, 0x81, 0x2c, 0x41, 0x86, 0x0a, 0x94, 0xe2, 0x28, 0xda, 0xef, 0x34, 0x97, 0xa8,
-	0x91, 0xde, 0x6b, 0xa4, 0x3f, 0x62, 0x88, 0x2c, 0x83, 0x30, 0xce, 0x79, 0x18, 0x0b, 0x81, 0x3a,
-	0xd6, 0x1c, 0x85, 0x32, 0xc7, 0x82, 0x94, 0xb8, 0x0b, 0x73, 0xf0, 0xad, 0x84, 0x58, 0x43, 0x04,
-	0x5f, 0x0a, 0x50, 0x9a, 0xfa, 0x64, 0x58, 0x28, 0x90, 0x22, 0xde, 0x82, 0x67, 0x4d, 0xac, 0xeb,
-	0x8b, 0xa8, 0xd3, 0xd5, 0x2c, 0x8f, 0x95, 0xda, 0xa1, 0x4c, 0x3d, 0xdb, 0xcc, 0x5a, 0x4d, 0x5d,
-	0x72, 0x57, 0xe3, 0x06, 0x84, 0x37, 0xa8, 0x07, 0x46, 0x04, 0x57, 0x9d, 0xcb, 0x3b, 0xc8, 0xa0,
-	0x73, 0x09, 0x9e, 0x93, 0xcb, 0x66, 0x3f, 0x02, 0x95, 0xa3, 0x50, 0xf0, 0x0f, 0x60, 0x1d, 0x02,
-	0x5c, 0x42, 0xdf, 0x83, 0xfe, 0xa0, 0x40, 0xce, 0xc5, 0x27, 0x6c, 0x7f, 0xdf, 0x91, 0xc7, 0x47,
-	0xbb, 0x0d, 0xc2, 0x27, 0xc3, 0x0c, 0x19, 0x83, 0x74, 0x6e, 0x28, 0xc3, 0xa8, 0xd3, 0x47, 0xf7,
-	0xb2, 0x7b, 0xf7, 0x7a, 0x48, 0x06, 0x5c, 0xa9, 0x26, 0x79, 0xb5, 0xa4, 0x57, 0xc4, 0x61, 0x12,
-	0x8b, 0x5c, 0x79, 0x77, 0x26, 0x83, 0xeb, 0x8b, 0xa8, 0x51, 0xaf, 0xbe, 0xdb, 0xe4, 0x41, 0x13,
-	0x7c, 0x01, 0xb2, 0xe4, 0x09, 0xd0, 0x35, 0xb9, 0x7f, 0x90, 0x85, 0x3e, 0x9d, 0xb6, 0x75, 0x9c,
-	0xe6, 0xf6, 0x47, 0xe7, 0x87, 0x26, 0x7e, 0x30, 0xf9, 0xfa, 0xeb, 0xcf, 0x37, 0xdb, 0xa7, 0x5e,
-	0x5d, 0x59, 0xf9, 0xb2, 0x2b, 0xb8, 0x0a, 0xca, 0x2b, 0xf8, 0x47, 0xe2, 0x98, 0xb6, 0xe8, 0xb3,
-	0x8e, 0x74, 0xae, 0x45, 0xdf, 0xeb, 0x8f, 0x3b, 0x13, 0xbf, 0x36, 0x71, 0x83, 0xcb, 0x9e, 0xc9,
-	0x1b, 0xeb, 0x86, 0x2e, 0x89, 0x63, 0x6a, 0x3a, 0xc5, 0x1f, 0xd5, 0xf7, 0x1f, 0xfc, 0x93, 0x1a,
-	0xff, 0xe8, 0xa6, 0x8f, 0x9f, 0xcd, 0x7e, 0xec, 0xc7, 0xd6, 0xcf, 0xfd, 0xd8, 0xfa, 0xbd, 0x1f,
-	0x5b, 0xcb, 0xd7, 0x8c, 0xeb, 0xcf, 0xc5, 0x6a, 0x9a, 0xe0, 0x36, 0x8c, 0x25, 0xc3, 0x5c, 0xe2,
-	0xba, 0x5e, 0xbc, 0x48, 0xd2, 0xb0, 0xbc, 0x0d, 0xf3, 0x0d, 0xab, 0x00, 0x49, 0xc6, 0x41, 0xe8,
-	0x96, 0xb1, 0x72, 0xea, 0xa7, 0x7b, 0xfb, 0x37, 0x00, 0x00, 0xff, 0xff, 0x0b, 0x96, 0x29, 0xbd,
-	0x01, 0x03, 0x00, 0x00,
+	// 440 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x95, 0x13, 0x08, 0xc9, 0x20, 0x51, 0x58, 0xa2, 0xd4, 0xb8, 0x21, 0x0a, 0xbe, 0x50, 0x55,
+	0x22, 0x16, 0x94, 0x13, 0x37, 0x0a, 0x12, 0xea, 0xd5, 0x15, 0x97, 0x4a, 0x1c, 0xdc, 0x64, 0x58,
+	0x36, 0x71, 0x77, 0xcc, 0xee, 0xc6, 0xbd, 0xf3, 0x0b, 0x7c, 0x02, 0x3f, 0xc3, 0x11, 0x89, 0x1f,
+	0x40, 0x11, 0x1f, 0x82, 0xbc, 0x6b, 0x2f, 0x4d, 0x5c, 0xf5, 0xe4, 0x9d, 0x7d, 0x3b, 0xef, 0xcd,
+	0x9b, 0x27, 0xc3, 0x58, 0xa3, 0x2a, 0x51, 0x25, 0x1a, 0xb5, 0x16, 0x24, 0x9b, 0xef, 0xac, 0x50,
+	0x64, 0x88, 0xdd, 0xab, 0xcb, 0x68, 0xcc, 0x89, 0x78, 0x8e, 0x49, 0x56, 0x88, 0x24, 0x93, 0x92,
+	0x4c, 0x66, 0x04, 0x49, 0xed, 0x9e, 0xc5, 0x0b, 0x18, 0x9e, 0xb9, 0x87, 0xef, 0x14, 0x66, 0x06,
+	0x53, 0xfc, 0xba, 0x46, 0x6d, 0x58, 0x04, 0xfd, 0xb5, 0x46, 0x25, 0xb3, 0x4b, 0x0c, 0x83, 0x69,
+	0x70, 0x38, 0x48, 0x7d, 0x5d, 0x61, 0x45, 0xa6, 0xf5, 0x15, 0xa9, 0x45, 0xd8, 0x71, 0x58, 0x53,
+	0xb3, 0x21, 0xdc, 0x35, 0xb4, 0x42, 0x19, 0x76, 0x2d, 0xe0, 0x8a, 0x78, 0xe4, 0x55, 0xde, 0x63,
+	0x8e, 0x5e, 0x25, 0x7e, 0x0e, 0x7b, 0xf5, 0x7d, 0x8a, 0xba, 0x20, 0xa9, 0xf1, 0x3f, 0x41, 0x70,
+	0x9d, 0x60, 0x08, 0xec, 0x03, 0x9a, 0x8f, 0x1a, 0xd5, 0xa9, 0xfc, 0x4c, 0x4d, 0xfb, 0x15, 0x3c,
+	0xde, 0xba, 0xad, 0x29, 0x22, 0xe8, 0xe7, 0xc4, 0x39, 0x2e, 0x4e, 0x1d, 0x4b, 0x3f, 0xf5, 0xf5,
+	0x96, 0xaf, 0xce, 0x8e, 0xaf, 0x87, 0xd0, 0x15, 0x5a, 0xd7, 0x93, 0x57, 0x47, 0x36, 0x82, 0x1e,
+	0x57, 0xb4, 0x2e, 0x74, 0x78, 0x67, 0xda, 0x3d, 0x1c, 0xa4, 0x75, 0x15, 0x3f, 0x81, 0x7d, 0x3f,
+	0x77, 0x49, 0x2b, 0x7c, 0x9b, 0xe7, 0xf5, 0x4c, 0xaf, 0x7e, 0x74, 0xe1, 0x41, 0x8d, 0x9d, 0xa1,
+	0x2a, 0xc5, 0x1c, 0xd9, 0x12, 0xee, 0x5f, 0x1b, 0x93, 0x1d, 0xcc, 0x9a, 0xa4, 0xda, 0x96, 0xa2,
+	0xf1, 0xcd, 0xa0, 0x73, 0x16, 0x4f, 0xbf, 0xfd, 0xfe, 0xfb, 0xbd, 0x13, 0xb1, 0xd0, 0xa6, 0x59,
+	0xbe, 0xf4, 0xd9, 0x57, 0x1e, 0x44, 0x45, 0xfe, 0x09, 0x7a, 0x2e, 0x48, 0xf6, 0xd4, 0x33, 0xdd,
+	0x14, 0x70, 0x14, 0xee, 0xc2, 0x5e, 0x24, 0xb2, 0x22, 0xc3, 0x37, 0xc1, 0x51, 0xbc, 0xb7, 0xa3,
+	0xc3, 0xce, 0xa1, 0xe7, 0x12, 0x6c, 0xd3, 0x6f, 0x25, 0x7b, 0x0b, 0xfd, 0xbe, 0xa5, 0x7f, 0x74,
+	0xd4, 0xe2, 0x5e, 0xc2, 0xc0, 0x6f, 0x93, 0x4d, 0xdb, 0xfd, 0xdb, 0x8b, 0xbe, 0x45, 0xe1, 0x99,
+	0x55, 0x38, 0xa8, 0x0c, 0x8c, 0x76, 0x17, 0xa5, 0x2c, 0xcd, 0xc9, 0xc9, 0xf9, 0x6b, 0x2e, 0xcc,
+	0x97, 0xf5, 0xc5, 0x6c, 0x4e, 0x97, 0x49, 0xa6, 0x38, 0x15, 0x8a, 0x96, 0xf6, 0xf0, 0x62, 0xbe,
+	0x48, 0xca, 0xe3, 0xa4, 0x58, 0xf1, 0xaa, 0x77, 0x9e, 0x0b, 0x94, 0xa6, 0x69, 0xff, 0xb9, 0x99,
+	0x04, 0xbf, 0x36, 0x93, 0xe0, 0xcf, 0x66, 0x12, 0x5c, 0xf4, 0xec, 0x1f, 0x74, 0xfc, 0x6f, 0x00,
+	0xd9, 0xfd, 0x43, 0xef, 0x88, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -353,6 +396,8 @@ type SessionServiceClient interface {
 	Create(ctx context.Context, in *SessionCreateRequest, opts ...grpc.CallOption) (*SessionResponse, error)
 	// Delete an existing JWT cookie if using HTTP
 	Delete(ctx context.Context, in *SessionDeleteRequest, opts ...grpc.CallOption) (*SessionResponse, error)
+	// RevokeAll revokes all session tokens issued to the current local account
+	RevokeAll(ctx context.Context, in *SessionRevokeAllRequest, opts ...grpc.CallOption) (*SessionResponse, error)
 }
 
 type sessionServiceClient struct {
@@ -390,6 +435,15 @@ func (c *sessionServiceClient) Delete(ctx context.Context, in *SessionDeleteRequ
 	return out, nil
 }
 
+func (c *sessionServiceClient) RevokeAll(ctx context.Context, in *SessionRevokeAllRequest, opts ...grpc.CallOption) (*SessionResponse, error) {
+	out := new(SessionResponse)
+	err := c.cc.Invoke(ctx, "/session.SessionService/RevokeAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SessionServiceServer is the server API for SessionService service.
 type SessionServiceServer interface {
 	// Get the current user's info
@@ -398,6 +452,8 @@ type SessionServiceServer interface {
 	Create(context.Context, *SessionCreateRequest) (*SessionResponse, error)
 	// Delete an existing JWT cookie if using HTTP
 	Delete(context.Context, *SessionDeleteRequest) (*SessionResponse, error)
+	// RevokeAll revokes all session tokens issued to the current local account
+	RevokeAll(context.Context, *SessionRevokeAllRequest) (*SessionResponse, error)
 }
 
 // UnimplementedSessionServiceServer can be embedded to have forward compatible implementations.
@@ -413,6 +469,9 @@ func (*UnimplementedSessionServiceServer) Create(ctx context.Context, req *Sessi
 func (*UnimplementedSessionServiceServer) Delete(ctx context.Context, req *SessionDeleteRequest) (*SessionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (*UnimplementedSessionServiceServer) RevokeAll(ctx context.Context, req *SessionRevokeAllRequest) (*SessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAll not implemented")
+}
 
 func RegisterSessionServiceServer(s *grpc.Server, srv SessionServiceServer) {
 	s.RegisterService(&_SessionService_serviceDesc, srv)
@@ -472,6 +531,24 @@ func _SessionService_Delete_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SessionService_RevokeAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionRevokeAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).RevokeAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/session.SessionService/RevokeAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).RevokeAll(ctx, req.(*SessionRevokeAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _SessionService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "session.SessionService",
 	HandlerType: (*SessionServiceServer)(nil),
@@ -488,6 +565,10 @@ var _SessionService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _SessionService_Delete_Handler,
 		},
+		{
+			MethodName: "RevokeAll",
+			Handler:    _SessionService_RevokeAll_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "server/session/session.proto",
@@ -689,6 +770,33 @@ func (m *GetUserInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SessionRevokeAllRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SessionRevokeAllRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SessionRevokeAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSession(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSession(v)
 	base := offset
@@ -793,6 +901,18 @@ func (m *GetUserInfoResponse) Size() (n int) {
 	return n
 }
 
+func (m *SessionRevokeAllRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovSession(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1298,6 +1418,57 @@ func (m *GetUserInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SessionRevokeAllRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSession
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionRevokeAllRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionRevokeAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSession(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSession
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSession(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
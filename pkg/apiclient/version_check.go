@@ -0,0 +1,114 @@
+package apiclient
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/golang/protobuf/ptypes/empty"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/localconfig"
+)
+
+const (
+	// MaxSupportedMinorVersionSkew is the maximum difference between the minor versions of the CLI and the API server
+	// which is supported
+	MaxSupportedMinorVersionSkew = 1
+	// versionCheckTTL is how long the server version cached in the local config is used before asking the server again
+	versionCheckTTL = 24 * time.Hour
+	// versionCheckFailureTTL is how long a failed attempt to get the server version is remembered in the local config,
+	// so an unreachable server does not slow down every CLI invocation
+	versionCheckFailureTTL = time.Hour
+	// versionCheckTimeout is how long the CLI waits for the server version
+	versionCheckTimeout = 2 * time.Second
+)
+
+// versionWarningOnce makes sure the version skew warning is only printed once per CLI invocation, even if several
+// clients are created
+var versionWarningOnce sync.Once
+
+// CheckVersionCompatibility returns whether the client and server versions are within the supported skew, and a
+// message describing the skew if they are not. Versions which cannot be parsed are considered compatible.
+func CheckVersionCompatibility(clientVersion, serverVersion string) (bool, string) {
+	cv, err := semver.NewVersion(clientVersion)
+	if err != nil {
+		return true, ""
+	}
+	sv, err := semver.NewVersion(serverVersion)
+	if err != nil {
+		return true, ""
+	}
+	if cv.Major() != sv.Major() {
+		return false, fmt.Sprintf("client version %s and server version %s have different major versions", clientVersion, serverVersion)
+	}
+	skew := int64(cv.Minor()) - int64(sv.Minor())
+	if skew < 0 {
+		skew = -skew
+	}
+	if skew > MaxSupportedMinorVersionSkew {
+		return false, fmt.Sprintf("client version %s and server version %s differ by %d minor versions, at most %d is supported", clientVersion, serverVersion, skew, MaxSupportedMinorVersionSkew)
+	}
+	return true, ""
+}
+
+// warnOnVersionSkew prints a warning to stderr if the version of the server differs too much from the version of the
+// CLI. The server version is cached in the context of the local config, so the server is only asked once per TTL.
+func (c *client) warnOnVersionSkew(localCfg *localconfig.LocalConfig, ctxName, configPath string, now time.Time) {
+	serverVersion, err := c.cachedServerVersion(localCfg, ctxName, configPath, now)
+	if err != nil {
+		log.Debugf("Failed to check server version: %v", err)
+		return
+	}
+	if compatible, msg := CheckVersionCompatibility(common.GetVersion().Version, serverVersion); !compatible {
+		versionWarningOnce.Do(func() {
+			fmt.Fprintf(os.Stderr, "WARNING: %s. Use --no-version-warning to suppress this warning.\n", msg)
+		})
+	}
+}
+
+// cachedServerVersion returns the server version cached in the context, refreshing it if it is older than the TTL. A
+// failure to get the version is cached as an empty version for a shorter TTL.
+func (c *client) cachedServerVersion(localCfg *localconfig.LocalConfig, ctxName, configPath string, now time.Time) (string, error) {
+	ctxRef, err := localCfg.GetContext(ctxName)
+	if err != nil {
+		return "", err
+	}
+	if checkedAt := ctxRef.ServerVersionCheckedAt; checkedAt != nil {
+		if ctxRef.ServerVersion != "" && now.Sub(*checkedAt) < versionCheckTTL {
+			return ctxRef.ServerVersion, nil
+		}
+		if ctxRef.ServerVersion == "" && now.Sub(*checkedAt) < versionCheckFailureTTL {
+			return "", nil
+		}
+	}
+	version, err := c.getServerVersion()
+	ctxRef.ServerVersion = version
+	ctxRef.ServerVersionCheckedAt = &now
+	localCfg.UpsertContext(*ctxRef)
+	if err := localconfig.WriteLocalConfig(*localCfg, configPath); err != nil {
+		log.Debugf("Failed to cache server version in local config: %v", err)
+	}
+	return version, err
+}
+
+// getServerVersion asks the server for its version, giving up after versionCheckTimeout
+func (c *client) getServerVersion() (string, error) {
+	conn, versionIf, err := c.NewVersionClient()
+	if err != nil {
+		return "", err
+	}
+	defer utilio.Close(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), versionCheckTimeout)
+	defer cancel()
+	v, err := versionIf.Version(ctx, &empty.Empty{})
+	if err != nil {
+		return "", err
+	}
+	return v.Version, nil
+}
@@ -0,0 +1,83 @@
+package apiclient
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/util/localconfig"
+)
+
+func TestCheckVersionCompatibility(t *testing.T) {
+	tests := []struct {
+		client     string
+		server     string
+		compatible bool
+	}{
+		{client: "v3.1.0+abcdef0", server: "v3.1.2+1234567", compatible: true},
+		{client: "v3.1.0", server: "v3.0.5", compatible: true},
+		{client: "v3.0.0", server: "v3.2.0", compatible: false},
+		{client: "v2.14.0", server: "v3.0.0", compatible: false},
+		{client: "unknown", server: "v3.0.0", compatible: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.client+"/"+tt.server, func(t *testing.T) {
+			compatible, msg := CheckVersionCompatibility(tt.client, tt.server)
+			assert.Equal(t, tt.compatible, compatible)
+			if tt.compatible {
+				assert.Empty(t, msg)
+			} else {
+				assert.Contains(t, msg, tt.server)
+			}
+		})
+	}
+}
+
+func TestCachedServerVersion(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	checkedAt := now.Add(-time.Hour)
+	localCfg := &localconfig.LocalConfig{
+		CurrentContext: "argocd",
+		Contexts:       []localconfig.ContextRef{{Name: "argocd", Server: "argocd.example.com", User: "argocd", ServerVersion: "v3.0.1", ServerVersionCheckedAt: &checkedAt}},
+	}
+	// The cached version is returned without contacting the server, which is unreachable
+	c := &client{ServerAddr: "127.0.0.1:1", PlainText: true}
+	version, err := c.cachedServerVersion(localCfg, "argocd", filepath.Join(t.TempDir(), "config"), now)
+	require.NoError(t, err)
+	assert.Equal(t, "v3.0.1", version)
+
+	_, err = c.cachedServerVersion(localCfg, "unknown", filepath.Join(t.TempDir(), "config"), now)
+	require.ErrorContains(t, err, "Context 'unknown' undefined")
+}
+
+func TestCachedServerVersion_Failure(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	checkedAt := now.Add(-48 * time.Hour)
+	localCfg := &localconfig.LocalConfig{
+		CurrentContext: "argocd",
+		Contexts:       []localconfig.ContextRef{{Name: "argocd", Server: "argocd.example.com", User: "argocd", ServerVersion: "v3.0.1", ServerVersionCheckedAt: &checkedAt}},
+	}
+	configPath := filepath.Join(t.TempDir(), "config")
+	c := &client{ServerAddr: "127.0.0.1:1", PlainText: true}
+
+	// The stale version is refreshed from the server, which is unreachable
+	start := time.Now()
+	_, err := c.cachedServerVersion(localCfg, "argocd", configPath, now)
+	require.Error(t, err)
+	assert.Less(t, time.Since(start), versionCheckTimeout+time.Second)
+
+	// The failure is cached, so the server is not asked again until the failure TTL passed
+	ctxRef, err := localCfg.GetContext("argocd")
+	require.NoError(t, err)
+	assert.Empty(t, ctxRef.ServerVersion)
+	assert.Equal(t, now, *ctxRef.ServerVersionCheckedAt)
+	version, err := c.cachedServerVersion(localCfg, "argocd", configPath, now.Add(versionCheckFailureTTL/2))
+	require.NoError(t, err)
+	assert.Empty(t, version)
+
+	_, err = c.cachedServerVersion(localCfg, "argocd", configPath, now.Add(versionCheckFailureTTL))
+	require.Error(t, err)
+}
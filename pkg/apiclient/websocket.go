@@ -0,0 +1,82 @@
+package apiclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/argoproj/argo-cd/v3/common"
+)
+
+// NewTerminalConn opens a WebSocket connection to the terminal endpoint of the Argo CD server, which executes a shell
+// or command in a pod of an application. The query holds the parameters of the terminal endpoint.
+func (c *client) NewTerminalConn(ctx context.Context, query url.Values) (*websocket.Conn, error) {
+	conn, err := c.dialWebSocket(ctx, "/terminal", query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open terminal: %w", err)
+	}
+	return conn, nil
+}
+
+// NewPortForwardConn opens a WebSocket connection to the port forwarding endpoint of the Argo CD server, which forwards
+// the binary messages of the connection to a port of a pod or service of an application. The query holds the
+// parameters of the port forwarding endpoint.
+func (c *client) NewPortForwardConn(ctx context.Context, query url.Values) (*websocket.Conn, error) {
+	conn, err := c.dialWebSocket(ctx, "/port-forward", query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to forward port: %w", err)
+	}
+	return conn, nil
+}
+
+// dialWebSocket opens a WebSocket connection to the given path of the Argo CD server
+func (c *client) dialWebSocket(ctx context.Context, path string, query url.Values) (*websocket.Conn, error) {
+	tlsConfig, err := c.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+	headers, err := parseHeaders(c.Headers)
+	if err != nil {
+		return nil, err
+	}
+	if c.UserAgent != "" {
+		headers.Set("User-Agent", c.UserAgent)
+	}
+	if c.AuthToken != "" {
+		headers.Add("Cookie", (&http.Cookie{Name: common.AuthCookieName, Value: c.AuthToken}).String())
+	}
+
+	wsURL := url.URL{Scheme: "wss", Host: c.ServerAddr, Path: path, RawQuery: query.Encode()}
+	if c.PlainText {
+		wsURL.Scheme = "ws"
+	}
+	if rootPath := strings.Trim(c.GRPCWebRootPath, "/"); rootPath != "" {
+		wsURL.Path = "/" + rootPath + wsURL.Path
+	}
+
+	dialer := websocket.Dialer{
+		Proxy:            http.ProxyFromEnvironment,
+		TLSClientConfig:  tlsConfig,
+		HandshakeTimeout: 30 * time.Second,
+	}
+	conn, resp, err := dialer.DialContext(ctx, wsURL.String(), headers)
+	if err != nil {
+		if resp == nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		if message := strings.TrimSpace(string(body)); message != "" {
+			return nil, fmt.Errorf("%s: %s", resp.Status, message)
+		}
+		return nil, errors.New(resp.Status)
+	}
+	return conn, nil
+}
@@ -157,6 +157,33 @@ func (proj AppProject) RemoveJWTToken(roleIndex int, issuedAt int64, id string)
 	return err2
 }
 
+// ExpireJWTToken shortens the lifetime of the specified JWT of an AppProject so that it expires at the given time. The
+// expiry of a token which already expires earlier is kept.
+func (proj *AppProject) ExpireJWTToken(roleIndex int, issuedAt int64, id string, expiresAt int64) error {
+	expire := func(tokens []JWTToken, index int) {
+		if tokens[index].ExpiresAt == 0 || tokens[index].ExpiresAt > expiresAt {
+			tokens[index].ExpiresAt = expiresAt
+		}
+	}
+	roleName := proj.Spec.Roles[roleIndex].Name
+	// For backward compatibility
+	_, jwtTokenIndex, err1 := proj.GetJWTTokenFromSpec(roleName, issuedAt, id)
+	if err1 == nil {
+		expire(proj.Spec.Roles[roleIndex].JWTTokens, jwtTokenIndex)
+	}
+
+	// New location for storing JWTToken
+	_, jwtTokenIndex, err2 := proj.GetJWTToken(roleName, issuedAt, id)
+	if err2 == nil {
+		expire(proj.Status.JWTTokensByRole[roleName].Items, jwtTokenIndex)
+	}
+
+	if err1 == nil || err2 == nil {
+		return nil
+	}
+	return err2
+}
+
 // TODO: document this method
 func (proj *AppProject) ValidateJWTTokenID(roleName string, id string) error {
 	role, _, err := proj.GetRoleByName(roleName)
@@ -4943,783 +4943,784 @@ func init() {
 }
 
 var fileDescriptor_c078c3c476799f44 = []byte{
-	// 12403 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6d, 0x70, 0x24, 0x49,
-	0x56, 0xd8, 0x55, 0xb7, 0x5a, 0xea, 0x7e, 0xfa, 0x1a, 0xe5, 0xcc, 0xec, 0x6a, 0x66, 0x3f, 0x34,
-	0xd4, 0x1e, 0x7b, 0x87, 0x6f, 0x4f, 0x62, 0x67, 0x77, 0x8f, 0x35, 0x0b, 0x07, 0xfa, 0x98, 0x0f,
-	0xcd, 0x48, 0x23, 0x6d, 0xb6, 0x66, 0x86, 0xdb, 0x63, 0x6f, 0xaf, 0x54, 0x9d, 0x92, 0x6a, 0x54,
-	0x5d, 0xd5, 0x5b, 0x55, 0xad, 0x51, 0x2f, 0xcb, 0x71, 0xc7, 0xdd, 0xc1, 0xc1, 0x7d, 0xad, 0xc1,
-	0x61, 0x16, 0xdb, 0xe0, 0xc3, 0xe0, 0xaf, 0x70, 0x5c, 0x80, 0xcd, 0x0f, 0x13, 0x01, 0x04, 0xc1,
-	0x47, 0x10, 0xe0, 0x2f, 0x30, 0x81, 0x01, 0x1b, 0x90, 0xef, 0xc6, 0x76, 0x40, 0x38, 0xc2, 0x44,
-	0x80, 0xfd, 0xc3, 0x31, 0x76, 0x10, 0x8e, 0xfc, 0xce, 0xaa, 0xae, 0x96, 0x5a, 0xa3, 0xd2, 0xcc,
-	0x1c, 0xec, 0xbf, 0xee, 0x7c, 0xaf, 0xde, 0xcb, 0xca, 0xca, 0x7c, 0xef, 0xe5, 0xcb, 0xf7, 0x5e,
-	0xc2, 0xd2, 0xa6, 0x97, 0x6c, 0xb5, 0xd7, 0xa7, 0xdd, 0xb0, 0x39, 0xe3, 0x44, 0x9b, 0x61, 0x2b,
-	0x0a, 0x6f, 0xb1, 0x1f, 0xef, 0x77, 0x1b, 0x33, 0x3b, 0xcf, 0xcd, 0xb4, 0xb6, 0x37, 0x67, 0x9c,
-	0x96, 0x17, 0xcf, 0x38, 0xad, 0x96, 0xef, 0xb9, 0x4e, 0xe2, 0x85, 0xc1, 0xcc, 0xce, 0xb3, 0x8e,
-	0xdf, 0xda, 0x72, 0x9e, 0x9d, 0xd9, 0x24, 0x01, 0x89, 0x9c, 0x84, 0x34, 0xa6, 0x5b, 0x51, 0x98,
-	0x84, 0xe8, 0x5b, 0x34, 0xb5, 0x69, 0x49, 0x8d, 0xfd, 0x78, 0xcd, 0x6d, 0x4c, 0xef, 0x3c, 0x37,
-	0xdd, 0xda, 0xde, 0x9c, 0xa6, 0xd4, 0xa6, 0x0d, 0x6a, 0xd3, 0x92, 0xda, 0xd9, 0xf7, 0x1b, 0x7d,
-	0xd9, 0x0c, 0x37, 0xc3, 0x19, 0x46, 0x74, 0xbd, 0xbd, 0xc1, 0xfe, 0xb1, 0x3f, 0xec, 0x17, 0x67,
-	0x76, 0xd6, 0xde, 0x7e, 0x31, 0x9e, 0xf6, 0x42, 0xda, 0xbd, 0x19, 0x37, 0x8c, 0xc8, 0xcc, 0x4e,
-	0x57, 0x87, 0xce, 0x5e, 0xd6, 0x38, 0x64, 0x37, 0x21, 0x41, 0xec, 0x85, 0x41, 0xfc, 0x7e, 0xda,
-	0x05, 0x12, 0xed, 0x90, 0xc8, 0x7c, 0x3d, 0x03, 0x21, 0x8f, 0xd2, 0xf3, 0x9a, 0x52, 0xd3, 0x71,
-	0xb7, 0xbc, 0x80, 0x44, 0x1d, 0xfd, 0x78, 0x93, 0x24, 0x4e, 0xde, 0x53, 0x33, 0xbd, 0x9e, 0x8a,
-	0xda, 0x41, 0xe2, 0x35, 0x49, 0xd7, 0x03, 0x1f, 0x38, 0xe8, 0x81, 0xd8, 0xdd, 0x22, 0x4d, 0xa7,
-	0xeb, 0xb9, 0xe7, 0x7a, 0x3d, 0xd7, 0x4e, 0x3c, 0x7f, 0xc6, 0x0b, 0x92, 0x38, 0x89, 0xb2, 0x0f,
-	0xd9, 0x7f, 0xdf, 0x82, 0xd1, 0xd9, 0x9b, 0xf5, 0xd9, 0x76, 0xb2, 0x35, 0x1f, 0x06, 0x1b, 0xde,
-	0x26, 0x7a, 0x01, 0x86, 0x5d, 0xbf, 0x1d, 0x27, 0x24, 0xba, 0xe6, 0x34, 0xc9, 0xa4, 0x75, 0xce,
-	0x7a, 0x6f, 0x6d, 0xee, 0xe4, 0x6f, 0xec, 0x4d, 0xbd, 0xeb, 0xce, 0xde, 0xd4, 0xf0, 0xbc, 0x06,
-	0x61, 0x13, 0x0f, 0x7d, 0x03, 0x0c, 0x45, 0xa1, 0x4f, 0x66, 0xf1, 0xb5, 0xc9, 0x12, 0x7b, 0x64,
-	0x5c, 0x3c, 0x32, 0x84, 0x79, 0x33, 0x96, 0x70, 0x8a, 0xda, 0x8a, 0xc2, 0x0d, 0xcf, 0x27, 0x93,
-	0xe5, 0x34, 0xea, 0x2a, 0x6f, 0xc6, 0x12, 0x6e, 0xff, 0x68, 0x09, 0xc6, 0x67, 0x5b, 0xad, 0xcb,
-	0xc4, 0xf1, 0x93, 0xad, 0x7a, 0xe2, 0x24, 0xed, 0x18, 0x6d, 0xc2, 0x60, 0xcc, 0x7e, 0x89, 0xbe,
-	0xad, 0x88, 0xa7, 0x07, 0x39, 0xfc, 0xee, 0xde, 0xd4, 0xb7, 0xe6, 0xcd, 0xe8, 0x4d, 0x2f, 0x09,
-	0x5b, 0xf1, 0xfb, 0x49, 0xb0, 0xe9, 0x05, 0x84, 0x8d, 0xcb, 0x16, 0xa3, 0x3a, 0x6d, 0x12, 0x9f,
-	0x0f, 0x1b, 0x04, 0x0b, 0xf2, 0xb4, 0x9f, 0x4d, 0x12, 0xc7, 0xce, 0x26, 0xc9, 0xbe, 0xd2, 0x32,
-	0x6f, 0xc6, 0x12, 0x8e, 0x22, 0x40, 0xbe, 0x13, 0x27, 0x6b, 0x91, 0x13, 0xc4, 0x1e, 0x9d, 0xd2,
-	0x6b, 0x5e, 0x93, 0xbf, 0xdd, 0xf0, 0xf9, 0xbf, 0x31, 0xcd, 0x3f, 0xcc, 0xb4, 0xf9, 0x61, 0xf4,
-	0x3a, 0xa0, 0xf3, 0x66, 0x7a, 0xe7, 0xd9, 0x69, 0xfa, 0xc4, 0xdc, 0x23, 0x77, 0xf6, 0xa6, 0xd0,
-	0x52, 0x17, 0x25, 0x9c, 0x43, 0xdd, 0xfe, 0xbd, 0x12, 0xc0, 0x6c, 0xab, 0xb5, 0x1a, 0x85, 0xb7,
-	0x88, 0x9b, 0xa0, 0x8f, 0x42, 0x95, 0x92, 0x6a, 0x38, 0x89, 0xc3, 0x06, 0x66, 0xf8, 0xfc, 0x37,
-	0xf6, 0xc7, 0x78, 0x65, 0x9d, 0x3e, 0xbf, 0x4c, 0x12, 0x67, 0x0e, 0x89, 0x17, 0x04, 0xdd, 0x86,
-	0x15, 0x55, 0x14, 0xc0, 0x40, 0xdc, 0x22, 0x2e, 0x1b, 0x8c, 0xe1, 0xf3, 0x4b, 0xd3, 0x47, 0x59,
-	0xe9, 0xd3, 0xba, 0xe7, 0xf5, 0x16, 0x71, 0xe7, 0x46, 0x04, 0xe7, 0x01, 0xfa, 0x0f, 0x33, 0x3e,
-	0x68, 0x47, 0x7d, 0x68, 0x3e, 0x90, 0xd7, 0x0a, 0xe3, 0xc8, 0xa8, 0xce, 0x8d, 0xa5, 0x27, 0x8e,
-	0xfc, 0xee, 0xf6, 0x1f, 0x5b, 0x30, 0xa6, 0x91, 0x97, 0xbc, 0x38, 0x41, 0xdf, 0xd9, 0x35, 0xb8,
-	0xd3, 0xfd, 0x0d, 0x2e, 0x7d, 0x9a, 0x0d, 0xed, 0x09, 0xc1, 0xac, 0x2a, 0x5b, 0x8c, 0x81, 0x6d,
-	0x42, 0xc5, 0x4b, 0x48, 0x33, 0x9e, 0x2c, 0x9d, 0x2b, 0xbf, 0x77, 0xf8, 0xfc, 0xe5, 0xa2, 0xde,
-	0x73, 0x6e, 0x54, 0x30, 0xad, 0x2c, 0x52, 0xf2, 0x98, 0x73, 0xb1, 0xff, 0x62, 0xd4, 0x7c, 0x3f,
-	0x3a, 0xe0, 0xe8, 0x59, 0x18, 0x8e, 0xc3, 0x76, 0xe4, 0x12, 0x4c, 0x5a, 0x21, 0x5d, 0x58, 0x65,
-	0x3a, 0xdd, 0xe9, 0x82, 0xaf, 0xeb, 0x66, 0x6c, 0xe2, 0xa0, 0x2f, 0x58, 0x30, 0xd2, 0x20, 0x71,
-	0xe2, 0x05, 0x8c, 0xbf, 0xec, 0xfc, 0xda, 0x91, 0x3b, 0x2f, 0x1b, 0x17, 0x34, 0xf1, 0xb9, 0x53,
-	0xe2, 0x45, 0x46, 0x8c, 0xc6, 0x18, 0xa7, 0xf8, 0x53, 0xc1, 0xd5, 0x20, 0xb1, 0x1b, 0x79, 0x2d,
-	0xfa, 0x5f, 0x88, 0x16, 0x25, 0xb8, 0x16, 0x34, 0x08, 0x9b, 0x78, 0x28, 0x80, 0x0a, 0x15, 0x4c,
-	0xf1, 0xe4, 0x00, 0xeb, 0xff, 0xe2, 0xd1, 0xfa, 0x2f, 0x06, 0x95, 0xca, 0x3c, 0x3d, 0xfa, 0xf4,
-	0x5f, 0x8c, 0x39, 0x1b, 0xf4, 0x79, 0x0b, 0x26, 0x85, 0xe0, 0xc4, 0x84, 0x0f, 0xe8, 0xcd, 0x2d,
-	0x2f, 0x21, 0xbe, 0x17, 0x27, 0x93, 0x15, 0xd6, 0x87, 0x99, 0xfe, 0xe6, 0xd6, 0xa5, 0x28, 0x6c,
-	0xb7, 0xae, 0x7a, 0x41, 0x63, 0xee, 0x9c, 0xe0, 0x34, 0x39, 0xdf, 0x83, 0x30, 0xee, 0xc9, 0x12,
-	0xfd, 0xb0, 0x05, 0x67, 0x03, 0xa7, 0x49, 0xe2, 0x96, 0x43, 0x3f, 0x2d, 0x07, 0xcf, 0xf9, 0x8e,
-	0xbb, 0xcd, 0x7a, 0x34, 0x78, 0x6f, 0x3d, 0xb2, 0x45, 0x8f, 0xce, 0x5e, 0xeb, 0x49, 0x1a, 0xef,
-	0xc3, 0x16, 0xfd, 0xa4, 0x05, 0x13, 0x61, 0xd4, 0xda, 0x72, 0x02, 0xd2, 0x90, 0xd0, 0x78, 0x72,
-	0x88, 0x2d, 0xbd, 0x8f, 0x1c, 0xed, 0x13, 0xad, 0x64, 0xc9, 0x2e, 0x87, 0x81, 0x97, 0x84, 0x51,
-	0x9d, 0x24, 0x89, 0x17, 0x6c, 0xc6, 0x73, 0xa7, 0xef, 0xec, 0x4d, 0x4d, 0x74, 0x61, 0xe1, 0xee,
-	0xfe, 0xa0, 0xef, 0x82, 0xe1, 0xb8, 0x13, 0xb8, 0x37, 0xbd, 0xa0, 0x11, 0xde, 0x8e, 0x27, 0xab,
-	0x45, 0x2c, 0xdf, 0xba, 0x22, 0x28, 0x16, 0xa0, 0x66, 0x80, 0x4d, 0x6e, 0xf9, 0x1f, 0x4e, 0x4f,
-	0xa5, 0x5a, 0xd1, 0x1f, 0x4e, 0x4f, 0xa6, 0x7d, 0xd8, 0xa2, 0xef, 0xb7, 0x60, 0x34, 0xf6, 0x36,
-	0x03, 0x27, 0x69, 0x47, 0xe4, 0x2a, 0xe9, 0xc4, 0x93, 0xc0, 0x3a, 0x72, 0xe5, 0x88, 0xa3, 0x62,
-	0x90, 0x9c, 0x3b, 0x2d, 0xfa, 0x38, 0x6a, 0xb6, 0xc6, 0x38, 0xcd, 0x37, 0x6f, 0xa1, 0xe9, 0x69,
-	0x3d, 0x5c, 0xec, 0x42, 0xd3, 0x93, 0xba, 0x27, 0x4b, 0xf4, 0xed, 0x70, 0x82, 0x37, 0xa9, 0x91,
-	0x8d, 0x27, 0x47, 0x98, 0xa0, 0x3d, 0x75, 0x67, 0x6f, 0xea, 0x44, 0x3d, 0x03, 0xc3, 0x5d, 0xd8,
-	0xe8, 0x75, 0x98, 0x6a, 0x91, 0xa8, 0xe9, 0x25, 0x2b, 0x81, 0xdf, 0x91, 0xe2, 0xdb, 0x0d, 0x5b,
-	0xa4, 0x21, 0xba, 0x13, 0x4f, 0x8e, 0x9e, 0xb3, 0xde, 0x5b, 0x9d, 0x7b, 0x8f, 0xe8, 0xe6, 0xd4,
-	0xea, 0xfe, 0xe8, 0xf8, 0x20, 0x7a, 0xe8, 0xd7, 0x2d, 0x38, 0x6b, 0x48, 0xd9, 0x3a, 0x89, 0x76,
-	0x3c, 0x97, 0xcc, 0xba, 0x6e, 0xd8, 0x0e, 0x92, 0x78, 0x72, 0x8c, 0x0d, 0xe3, 0xfa, 0x71, 0xc8,
-	0xfc, 0x34, 0x2b, 0x3d, 0x2f, 0x7b, 0xa2, 0xc4, 0x78, 0x9f, 0x9e, 0xda, 0xbf, 0x59, 0x82, 0x13,
-	0x59, 0x0b, 0x00, 0xfd, 0x63, 0x0b, 0xc6, 0x6f, 0xdd, 0x4e, 0xd6, 0xc2, 0x6d, 0x12, 0xc4, 0x73,
-	0x1d, 0x2a, 0xa7, 0x99, 0xee, 0x1b, 0x3e, 0xef, 0x16, 0x6b, 0x6b, 0x4c, 0x5f, 0x49, 0x73, 0xb9,
-	0x10, 0x24, 0x51, 0x67, 0xee, 0x51, 0xf1, 0x4e, 0xe3, 0x57, 0x6e, 0xae, 0x99, 0x50, 0x9c, 0xed,
-	0xd4, 0xd9, 0xcf, 0x5a, 0x70, 0x2a, 0x8f, 0x04, 0x3a, 0x01, 0xe5, 0x6d, 0xd2, 0xe1, 0x96, 0x30,
-	0xa6, 0x3f, 0xd1, 0xab, 0x50, 0xd9, 0x71, 0xfc, 0x36, 0x11, 0x66, 0xda, 0xa5, 0xa3, 0xbd, 0x88,
-	0xea, 0x19, 0xe6, 0x54, 0xbf, 0xb9, 0xf4, 0xa2, 0x65, 0xff, 0x56, 0x19, 0x86, 0x8d, 0x8f, 0x76,
-	0x1f, 0x4c, 0xcf, 0x30, 0x65, 0x7a, 0x2e, 0x17, 0x36, 0xdf, 0x7a, 0xda, 0x9e, 0xb7, 0x33, 0xb6,
-	0xe7, 0x4a, 0x71, 0x2c, 0xf7, 0x35, 0x3e, 0x51, 0x02, 0xb5, 0xb0, 0x45, 0xb7, 0x68, 0xd4, 0x86,
-	0x19, 0x28, 0xe2, 0x13, 0xae, 0x48, 0x72, 0x73, 0xa3, 0x77, 0xf6, 0xa6, 0x6a, 0xea, 0x2f, 0xd6,
-	0x8c, 0xec, 0xdf, 0xb7, 0xe0, 0x94, 0xd1, 0xc7, 0xf9, 0x30, 0x68, 0xb0, 0x8d, 0x06, 0x3a, 0x07,
-	0x03, 0x49, 0xa7, 0x25, 0xb7, 0x81, 0x6a, 0xa4, 0xd6, 0x3a, 0x2d, 0x82, 0x19, 0xe4, 0x61, 0xdf,
-	0x25, 0xfd, 0xb0, 0x05, 0x8f, 0xe4, 0x0b, 0x18, 0xf4, 0x34, 0x0c, 0x72, 0x1f, 0x80, 0x78, 0x3b,
-	0xfd, 0x49, 0x58, 0x2b, 0x16, 0x50, 0x34, 0x03, 0x35, 0xa5, 0xf0, 0xc4, 0x3b, 0x4e, 0x08, 0xd4,
-	0x9a, 0xd6, 0x92, 0x1a, 0x87, 0x0e, 0x1a, 0xfd, 0x23, 0x4c, 0x50, 0x35, 0x68, 0x6c, 0xd3, 0xcc,
-	0x20, 0xf6, 0xef, 0x5a, 0xf0, 0xee, 0x7e, 0xc4, 0xde, 0xf1, 0xf5, 0xb1, 0x0e, 0xa7, 0x1b, 0x64,
-	0xc3, 0x69, 0xfb, 0x49, 0x9a, 0xa3, 0xe8, 0xf4, 0x13, 0xe2, 0xe1, 0xd3, 0x0b, 0x79, 0x48, 0x38,
-	0xff, 0x59, 0xfb, 0xbf, 0x58, 0x6c, 0xbb, 0x2e, 0x5f, 0xeb, 0x3e, 0x6c, 0x9d, 0x82, 0xf4, 0xd6,
-	0x69, 0xb1, 0xb0, 0x65, 0xda, 0x63, 0xef, 0xf4, 0x79, 0x0b, 0xce, 0x1a, 0x58, 0xcb, 0x4e, 0xe2,
-	0x6e, 0x5d, 0xd8, 0x6d, 0x45, 0x24, 0x8e, 0xe9, 0x94, 0x7a, 0xc2, 0x10, 0xc7, 0x73, 0xc3, 0x82,
-	0x42, 0xf9, 0x2a, 0xe9, 0x70, 0xd9, 0xfc, 0x0c, 0x54, 0xf9, 0x9a, 0x0b, 0x23, 0xf1, 0x91, 0xd4,
-	0xbb, 0xad, 0x88, 0x76, 0xac, 0x30, 0x90, 0x0d, 0x83, 0x4c, 0xe6, 0x52, 0x19, 0x44, 0xcd, 0x04,
-	0xa0, 0xdf, 0xfd, 0x06, 0x6b, 0xc1, 0x02, 0x62, 0xc7, 0xa9, 0xee, 0xac, 0x46, 0x84, 0xcd, 0x87,
-	0xc6, 0x45, 0x8f, 0xf8, 0x8d, 0x98, 0x6e, 0xeb, 0x9c, 0x20, 0x08, 0x13, 0xb1, 0x43, 0x33, 0xb6,
-	0x75, 0xb3, 0xba, 0x19, 0x9b, 0x38, 0x94, 0xa9, 0xef, 0xac, 0x13, 0x9f, 0x8f, 0xa8, 0x60, 0xba,
-	0xc4, 0x5a, 0xb0, 0x80, 0xd8, 0x77, 0x4a, 0x6c, 0x03, 0xa9, 0x24, 0x1a, 0xb9, 0x1f, 0xde, 0x87,
-	0x28, 0xa5, 0x02, 0x56, 0x8b, 0x93, 0xc7, 0xa4, 0xb7, 0x07, 0xe2, 0x8d, 0x8c, 0x16, 0xc0, 0x85,
-	0x72, 0xdd, 0xdf, 0x0b, 0xf1, 0xf1, 0x32, 0x4c, 0xa5, 0x1f, 0xe8, 0x52, 0x22, 0x74, 0xcb, 0x6b,
-	0x30, 0xca, 0xfa, 0xea, 0x0c, 0x7c, 0x6c, 0xe2, 0xf5, 0x90, 0xc3, 0xa5, 0xe3, 0x94, 0xc3, 0xa6,
-	0x9a, 0x28, 0x1f, 0xa0, 0x26, 0x9e, 0x56, 0xa3, 0x3e, 0x90, 0x91, 0x79, 0x69, 0x55, 0x79, 0x0e,
-	0x06, 0xe2, 0x84, 0xb4, 0x26, 0x2b, 0x69, 0x31, 0x5b, 0x4f, 0x48, 0x0b, 0x33, 0x08, 0xfa, 0x56,
-	0x18, 0x4f, 0x9c, 0x68, 0x93, 0x24, 0x11, 0xd9, 0xf1, 0x98, 0x5f, 0x97, 0xed, 0x67, 0x6b, 0x73,
-	0x27, 0xa9, 0xd5, 0xb5, 0xc6, 0x40, 0x58, 0x82, 0x70, 0x16, 0xd7, 0xfe, 0x1f, 0x25, 0x78, 0x34,
-	0xfd, 0x09, 0xb4, 0x62, 0xfc, 0xb6, 0x94, 0x62, 0x7c, 0x9f, 0xa9, 0x18, 0xef, 0xee, 0x4d, 0x3d,
-	0xd6, 0xe3, 0xb1, 0xaf, 0x19, 0xbd, 0x89, 0x2e, 0x65, 0x3e, 0xc2, 0x4c, 0x97, 0x97, 0xf5, 0x89,
-	0x1e, 0xef, 0x98, 0xf9, 0x4a, 0x4f, 0xc3, 0x60, 0x44, 0x9c, 0x38, 0x0c, 0xc4, 0x77, 0x52, 0x5f,
-	0x13, 0xb3, 0x56, 0x2c, 0xa0, 0xf6, 0xef, 0xd4, 0xb2, 0x83, 0x7d, 0x89, 0xfb, 0xaa, 0xc3, 0x08,
-	0x79, 0x30, 0xc0, 0x76, 0x6d, 0x5c, 0xb2, 0x5c, 0x3d, 0xda, 0x2a, 0xa4, 0x5a, 0x44, 0x91, 0x9e,
-	0xab, 0xd2, 0xaf, 0x46, 0x9b, 0x30, 0x63, 0x81, 0x76, 0xa1, 0xea, 0xca, 0xcd, 0x54, 0xa9, 0x08,
-	0xb7, 0xa3, 0xd8, 0x4a, 0x69, 0x8e, 0x23, 0x54, 0xdc, 0xab, 0x1d, 0x98, 0xe2, 0x86, 0x08, 0x94,
-	0x37, 0xbd, 0x44, 0x7c, 0xd6, 0x23, 0x6e, 0x97, 0x2f, 0x79, 0xc6, 0x2b, 0x0e, 0x51, 0x1d, 0x74,
-	0xc9, 0x4b, 0x30, 0xa5, 0x8f, 0x3e, 0x6d, 0xc1, 0x70, 0xec, 0x36, 0x57, 0xa3, 0x70, 0xc7, 0x6b,
-	0x90, 0x48, 0xd8, 0x98, 0x47, 0x94, 0x6c, 0xf5, 0xf9, 0x65, 0x49, 0x50, 0xf3, 0xe5, 0xee, 0x0b,
-	0x0d, 0xc1, 0x26, 0x5f, 0xba, 0xf7, 0x7a, 0x54, 0xbc, 0xfb, 0x02, 0x71, 0xd9, 0x8a, 0x93, 0x7b,
-	0x66, 0x36, 0x53, 0x8e, 0x6c, 0x73, 0x2f, 0xb4, 0xdd, 0x6d, 0xba, 0xde, 0x74, 0x87, 0x1e, 0xbb,
-	0xb3, 0x37, 0xf5, 0xe8, 0x7c, 0x3e, 0x4f, 0xdc, 0xab, 0x33, 0x6c, 0xc0, 0x5a, 0x6d, 0xdf, 0xc7,
-	0xe4, 0xf5, 0x36, 0x61, 0x1e, 0xb1, 0x02, 0x06, 0x6c, 0x55, 0x13, 0xcc, 0x0c, 0x98, 0x01, 0xc1,
-	0x26, 0x5f, 0xf4, 0x3a, 0x0c, 0x36, 0x9d, 0x24, 0xf2, 0x76, 0x85, 0x1b, 0xec, 0x88, 0xbb, 0xa0,
-	0x65, 0x46, 0x4b, 0x33, 0x67, 0x8a, 0x9e, 0x37, 0x62, 0xc1, 0x08, 0x35, 0xa1, 0xd2, 0x24, 0xd1,
-	0x26, 0x99, 0xac, 0x16, 0xe1, 0xf2, 0x5f, 0xa6, 0xa4, 0x34, 0xc3, 0x1a, 0x35, 0xae, 0x58, 0x1b,
-	0xe6, 0x5c, 0xd0, 0xab, 0x50, 0x8d, 0x89, 0x4f, 0x5c, 0x6a, 0x1e, 0xd5, 0x18, 0xc7, 0xe7, 0xfa,
-	0x34, 0x15, 0xa9, 0x5d, 0x52, 0x17, 0x8f, 0xf2, 0x05, 0x26, 0xff, 0x61, 0x45, 0x92, 0x0e, 0x60,
-	0xcb, 0x6f, 0x6f, 0x7a, 0xc1, 0x24, 0x14, 0x31, 0x80, 0xab, 0x8c, 0x56, 0x66, 0x00, 0x79, 0x23,
-	0x16, 0x8c, 0xec, 0xff, 0x6e, 0x01, 0x4a, 0x0b, 0xb5, 0xfb, 0x60, 0x13, 0xbf, 0x9e, 0xb6, 0x89,
-	0x97, 0x8a, 0x34, 0x5a, 0x7a, 0x98, 0xc5, 0x3f, 0x5f, 0x83, 0x8c, 0x3a, 0xb8, 0x46, 0xe2, 0x84,
-	0x34, 0xde, 0x11, 0xe1, 0xef, 0x88, 0xf0, 0x77, 0x44, 0xb8, 0x12, 0xe1, 0xeb, 0x19, 0x11, 0xfe,
-	0x41, 0x63, 0xd5, 0xeb, 0xd8, 0x83, 0xd7, 0x54, 0x70, 0x82, 0xd9, 0x03, 0x03, 0x81, 0x4a, 0x82,
-	0x2b, 0xf5, 0x95, 0x6b, 0xb9, 0x32, 0xfb, 0xb5, 0xb4, 0xcc, 0x3e, 0x2a, 0x8b, 0xbf, 0x0e, 0x52,
-	0xfa, 0xd7, 0x2d, 0x78, 0x4f, 0x5a, 0x7a, 0xc9, 0x99, 0xb3, 0xb8, 0x19, 0x84, 0x11, 0x59, 0xf0,
-	0x36, 0x36, 0x48, 0x44, 0x02, 0x97, 0xc4, 0xca, 0xb7, 0x63, 0xf5, 0xf2, 0xed, 0xa0, 0xe7, 0x61,
-	0xe4, 0x56, 0x1c, 0x06, 0xab, 0xa1, 0x17, 0x08, 0x11, 0x44, 0x77, 0x1c, 0x27, 0xee, 0xec, 0x4d,
-	0x8d, 0xd0, 0x11, 0x95, 0xed, 0x38, 0x85, 0x85, 0xe6, 0x61, 0xe2, 0xd6, 0xeb, 0xab, 0x4e, 0x62,
-	0x78, 0x13, 0xe4, 0xbe, 0x9f, 0x9d, 0x47, 0x5d, 0x79, 0x39, 0x03, 0xc4, 0xdd, 0xf8, 0xf6, 0xdf,
-	0x2b, 0xc1, 0x99, 0xcc, 0x8b, 0x84, 0xbe, 0x1f, 0xb6, 0x13, 0xba, 0x27, 0x42, 0x3f, 0x6e, 0xc1,
-	0x89, 0x66, 0xda, 0x61, 0x11, 0x0b, 0x77, 0xf7, 0x77, 0x14, 0xa6, 0x23, 0x32, 0x1e, 0x91, 0xb9,
-	0x49, 0x31, 0x42, 0x27, 0x32, 0x80, 0x18, 0x77, 0xf5, 0x05, 0xbd, 0x0a, 0xb5, 0xa6, 0xb3, 0x7b,
-	0xbd, 0xd5, 0x70, 0x12, 0xb9, 0x1d, 0xed, 0xed, 0x45, 0x68, 0x27, 0x9e, 0x3f, 0xcd, 0xa3, 0x5a,
-	0xa6, 0x17, 0x83, 0x64, 0x25, 0xaa, 0x27, 0x91, 0x17, 0x6c, 0x72, 0x27, 0xe7, 0xb2, 0x24, 0x83,
-	0x35, 0x45, 0xfb, 0xc7, 0xac, 0xac, 0x92, 0x52, 0xa3, 0x13, 0x39, 0x09, 0xd9, 0xec, 0xa0, 0x37,
-	0xa1, 0x42, 0xf7, 0x8d, 0x72, 0x54, 0x6e, 0x16, 0xa9, 0x39, 0x8d, 0x2f, 0xa1, 0x95, 0x28, 0xfd,
-	0x17, 0x63, 0xce, 0xd4, 0xfe, 0xf1, 0x5a, 0xd6, 0x58, 0x60, 0x67, 0xf3, 0xe7, 0x01, 0x36, 0xc3,
-	0x35, 0xd2, 0x6c, 0xf9, 0x74, 0x58, 0x2c, 0x76, 0xc0, 0xa3, 0x5c, 0x25, 0x97, 0x14, 0x04, 0x1b,
-	0x58, 0xe8, 0x07, 0x2c, 0x80, 0x4d, 0x39, 0xe7, 0xa5, 0x21, 0x70, 0xbd, 0xc8, 0xd7, 0xd1, 0x2b,
-	0x4a, 0xf7, 0x45, 0x31, 0xc4, 0x06, 0x73, 0xf4, 0xbd, 0x16, 0x54, 0x13, 0xd9, 0x7d, 0xae, 0x1a,
-	0xd7, 0x8a, 0xec, 0x89, 0x7c, 0x69, 0x6d, 0x13, 0xa9, 0x21, 0x51, 0x7c, 0xd1, 0xf7, 0x59, 0x00,
-	0x71, 0x27, 0x70, 0x57, 0x43, 0xdf, 0x73, 0x3b, 0x42, 0x63, 0xde, 0x28, 0xd4, 0x9d, 0xa3, 0xa8,
-	0xcf, 0x8d, 0xd1, 0xd1, 0xd0, 0xff, 0xb1, 0xc1, 0x19, 0x7d, 0x0c, 0xaa, 0xb1, 0x98, 0x6e, 0x42,
-	0x47, 0xae, 0x15, 0xeb, 0x54, 0xe2, 0xb4, 0x85, 0x78, 0x15, 0xff, 0xb0, 0xe2, 0x89, 0x7e, 0xc4,
-	0x82, 0xf1, 0x56, 0xda, 0x4d, 0x28, 0xd4, 0x61, 0x71, 0x32, 0x20, 0xe3, 0x86, 0xe4, 0xde, 0x96,
-	0x4c, 0x23, 0xce, 0xf6, 0x82, 0x4a, 0x40, 0x3d, 0x83, 0x57, 0x5a, 0xdc, 0x65, 0x39, 0xa4, 0x25,
-	0xe0, 0xa5, 0x2c, 0x10, 0x77, 0xe3, 0xa3, 0x55, 0x38, 0x45, 0x7b, 0xd7, 0xe1, 0xe6, 0xa7, 0x54,
-	0x2f, 0x31, 0x53, 0x86, 0xd5, 0xb9, 0xc7, 0xc5, 0x0c, 0x61, 0x67, 0x1d, 0x59, 0x1c, 0x9c, 0xfb,
-	0x24, 0xfa, 0x2d, 0x0b, 0x1e, 0xf7, 0x98, 0x1a, 0x30, 0x1d, 0xf6, 0x5a, 0x23, 0x88, 0x83, 0x76,
-	0x52, 0xa8, 0xac, 0xe8, 0xa5, 0x7e, 0xe6, 0xde, 0x2d, 0xde, 0xe0, 0xf1, 0xc5, 0x7d, 0xba, 0x84,
-	0xf7, 0xed, 0x30, 0xfa, 0x26, 0x18, 0x95, 0xeb, 0x62, 0x95, 0x8a, 0x60, 0xa6, 0x68, 0x6b, 0x73,
-	0x13, 0x77, 0xf6, 0xa6, 0x46, 0xd7, 0x4c, 0x00, 0x4e, 0xe3, 0xd9, 0xff, 0xba, 0x9c, 0x3a, 0x25,
-	0x52, 0x3e, 0x4c, 0x26, 0x6e, 0x5c, 0xe9, 0xff, 0x91, 0xd2, 0xb3, 0x50, 0x71, 0xa3, 0xbc, 0x4b,
-	0x5a, 0xdc, 0xa8, 0xa6, 0x18, 0x1b, 0xcc, 0xa9, 0x51, 0x3a, 0xe1, 0x64, 0x3d, 0xa5, 0x42, 0x02,
-	0xbe, 0x5a, 0x64, 0x97, 0xba, 0xcf, 0xf4, 0xce, 0x88, 0xae, 0x4d, 0x74, 0x81, 0x70, 0x77, 0x97,
-	0xd0, 0x77, 0x43, 0x2d, 0x52, 0x91, 0x2d, 0xe5, 0x22, 0xb6, 0x6a, 0x72, 0xda, 0x88, 0xee, 0xa8,
-	0x03, 0x20, 0x1d, 0xc3, 0xa2, 0x39, 0xda, 0x9f, 0x29, 0xa5, 0x0e, 0xc6, 0x0c, 0xd9, 0xd1, 0xc7,
-	0xa1, 0xdf, 0x17, 0x2c, 0x18, 0x8e, 0x42, 0xdf, 0xf7, 0x82, 0x4d, 0x2a, 0xe7, 0x84, 0xb2, 0xfe,
-	0xf0, 0xb1, 0xe8, 0x4b, 0x21, 0xd0, 0x98, 0x65, 0x8d, 0x35, 0x4f, 0x6c, 0x76, 0x00, 0xbd, 0x04,
-	0xa3, 0x0d, 0xe2, 0x13, 0xfa, 0xec, 0x4a, 0x44, 0xf7, 0x44, 0xdc, 0xc9, 0xac, 0x22, 0x45, 0x16,
-	0x4c, 0x20, 0x4e, 0xe3, 0xda, 0x7f, 0x6c, 0xc1, 0x64, 0x2f, 0x61, 0x8e, 0x08, 0x3c, 0x26, 0x25,
-	0x95, 0x1a, 0xc7, 0x95, 0x40, 0xd2, 0x13, 0xfa, 0xf8, 0x29, 0xc1, 0xe7, 0xb1, 0xd5, 0xde, 0xa8,
-	0x78, 0x3f, 0x3a, 0xe8, 0x15, 0x38, 0x61, 0x0c, 0x4a, 0xac, 0x46, 0xb5, 0x36, 0x37, 0x4d, 0xad,
-	0xa7, 0xd9, 0x0c, 0xec, 0xee, 0xde, 0xd4, 0x23, 0xd9, 0x36, 0xa1, 0x6d, 0xba, 0xe8, 0xd8, 0x3f,
-	0xd5, 0xf5, 0xa9, 0x95, 0xa1, 0xf0, 0xb6, 0xd5, 0xe5, 0x8a, 0xf8, 0x8e, 0xe3, 0x50, 0xce, 0xcc,
-	0x69, 0xa1, 0x62, 0x38, 0x7a, 0xe3, 0x3c, 0xc0, 0x33, 0x7f, 0xfb, 0xdf, 0x0e, 0xc0, 0x3e, 0x3d,
-	0xeb, 0xc3, 0xf2, 0x3f, 0xf4, 0x21, 0xec, 0xe7, 0x2c, 0x75, 0xda, 0xc6, 0x05, 0x40, 0xe3, 0xb8,
-	0xc6, 0x9e, 0x6f, 0xbe, 0x62, 0x1e, 0x77, 0xa2, 0x5c, 0xf0, 0xe9, 0x73, 0x3d, 0xf4, 0x25, 0x2b,
-	0x7d, 0x5e, 0xc8, 0x23, 0x22, 0xbd, 0x63, 0xeb, 0x93, 0x71, 0x08, 0xc9, 0x3b, 0xa6, 0x8f, 0xae,
-	0x7a, 0x1d, 0x4f, 0x4e, 0x03, 0x6c, 0x78, 0x81, 0xe3, 0x7b, 0x6f, 0xd0, 0xad, 0x55, 0x85, 0x59,
-	0x07, 0xcc, 0xdc, 0xba, 0xa8, 0x5a, 0xb1, 0x81, 0x71, 0xf6, 0x6f, 0xc2, 0xb0, 0xf1, 0xe6, 0x39,
-	0xe1, 0x32, 0xa7, 0xcc, 0x70, 0x99, 0x9a, 0x11, 0xe5, 0x72, 0xf6, 0x83, 0x70, 0x22, 0xdb, 0xc1,
-	0xc3, 0x3c, 0x6f, 0xff, 0x9f, 0xa1, 0xec, 0x01, 0xde, 0x1a, 0x89, 0x9a, 0xb4, 0x6b, 0xef, 0x78,
-	0xc5, 0xde, 0xf1, 0x8a, 0xbd, 0xe3, 0x15, 0x33, 0x0f, 0x36, 0x84, 0xc7, 0x67, 0xe8, 0x3e, 0x79,
-	0x7c, 0x52, 0x3e, 0xac, 0x6a, 0xe1, 0x3e, 0x2c, 0xfb, 0xd3, 0x5d, 0x6e, 0xff, 0xb5, 0x88, 0x10,
-	0x14, 0x42, 0x25, 0x08, 0x1b, 0x44, 0x1a, 0xc8, 0x57, 0x8a, 0xb1, 0xf6, 0xae, 0x85, 0x0d, 0x23,
-	0xd6, 0x9c, 0xfe, 0x8b, 0x31, 0xe7, 0x63, 0x7f, 0x6a, 0x10, 0x52, 0xb6, 0x28, 0xff, 0xee, 0xdf,
-	0x00, 0x43, 0x11, 0x69, 0x85, 0xd7, 0xf1, 0x92, 0xd0, 0x65, 0x3a, 0x55, 0x87, 0x37, 0x63, 0x09,
-	0xa7, 0x3a, 0xaf, 0xe5, 0x24, 0x5b, 0x42, 0x99, 0x29, 0x9d, 0xb7, 0xea, 0x24, 0x5b, 0x98, 0x41,
-	0xd0, 0x07, 0x61, 0x2c, 0x49, 0x9d, 0xa3, 0x8b, 0xf3, 0xe2, 0x47, 0x04, 0xee, 0x58, 0xfa, 0x94,
-	0x1d, 0x67, 0xb0, 0xd1, 0xeb, 0x30, 0xb0, 0x45, 0xfc, 0xa6, 0xf8, 0xf4, 0xf5, 0xe2, 0x74, 0x0d,
-	0x7b, 0xd7, 0xcb, 0xc4, 0x6f, 0x72, 0x49, 0x48, 0x7f, 0x61, 0xc6, 0x8a, 0xce, 0xfb, 0xda, 0x76,
-	0x3b, 0x4e, 0xc2, 0xa6, 0xf7, 0x86, 0x74, 0x93, 0x7e, 0x47, 0xc1, 0x8c, 0xaf, 0x4a, 0xfa, 0xdc,
-	0x1f, 0xa5, 0xfe, 0x62, 0xcd, 0x99, 0xf5, 0xa3, 0xe1, 0x45, 0x6c, 0xca, 0x74, 0x84, 0xb7, 0xb3,
-	0xe8, 0x7e, 0x2c, 0x48, 0xfa, 0xbc, 0x1f, 0xea, 0x2f, 0xd6, 0x9c, 0x51, 0x47, 0xad, 0xbf, 0x61,
-	0xd6, 0x87, 0xeb, 0x05, 0xf7, 0x81, 0xaf, 0xbd, 0xdc, 0x75, 0xf8, 0x14, 0x54, 0xdc, 0x2d, 0x27,
-	0x4a, 0x26, 0x47, 0xd8, 0xa4, 0x51, 0xb3, 0x78, 0x9e, 0x36, 0x62, 0x0e, 0x43, 0x4f, 0x40, 0x39,
-	0x22, 0x1b, 0x2c, 0xb4, 0xd9, 0x08, 0xaa, 0xc2, 0x64, 0x03, 0xd3, 0x76, 0x65, 0x97, 0x8d, 0xf5,
-	0x8c, 0xb6, 0xfb, 0x89, 0x52, 0xda, 0xb0, 0x4b, 0x8f, 0x0c, 0x5f, 0x0f, 0x6e, 0x3b, 0x8a, 0xa5,
-	0x77, 0xcd, 0x58, 0x0f, 0xac, 0x19, 0x4b, 0x38, 0xfa, 0x84, 0x05, 0x43, 0xb7, 0xe2, 0x30, 0x08,
-	0x48, 0x22, 0x94, 0xe8, 0x8d, 0x82, 0x07, 0xeb, 0x0a, 0xa7, 0xae, 0xfb, 0x20, 0x1a, 0xb0, 0xe4,
-	0x4b, 0xbb, 0x4b, 0x76, 0x5d, 0xbf, 0xdd, 0xe8, 0x8a, 0xa4, 0xb9, 0xc0, 0x9b, 0xb1, 0x84, 0x53,
-	0x54, 0x2f, 0xe0, 0xa8, 0x03, 0x69, 0xd4, 0xc5, 0x40, 0xa0, 0x0a, 0xb8, 0xfd, 0xb3, 0x55, 0x38,
-	0x9d, 0xbb, 0x7c, 0xa8, 0xc9, 0xc5, 0x8c, 0x9a, 0x8b, 0x9e, 0x4f, 0x64, 0x0c, 0x19, 0x33, 0xb9,
-	0x6e, 0xa8, 0x56, 0x6c, 0x60, 0xa0, 0xef, 0x01, 0x68, 0x39, 0x91, 0xd3, 0x24, 0xca, 0xfb, 0x7d,
-	0x64, 0xcb, 0x86, 0xf6, 0x63, 0x55, 0xd2, 0xd4, 0x1e, 0x00, 0xd5, 0x14, 0x63, 0x83, 0x25, 0x7a,
-	0x01, 0x86, 0x23, 0xe2, 0x13, 0x27, 0x66, 0xb1, 0xf3, 0xd9, 0x44, 0x20, 0xac, 0x41, 0xd8, 0xc4,
-	0x43, 0x4f, 0xab, 0x70, 0xbb, 0x4c, 0xd8, 0x51, 0x3a, 0xe4, 0x0e, 0x7d, 0xd1, 0x82, 0xb1, 0x0d,
-	0xcf, 0x27, 0x9a, 0xbb, 0x48, 0xdb, 0x59, 0x39, 0xfa, 0x4b, 0x5e, 0x34, 0xe9, 0x6a, 0x19, 0x9a,
-	0x6a, 0x8e, 0x71, 0x86, 0x3d, 0xfd, 0xcc, 0x3b, 0x24, 0x62, 0xc2, 0x77, 0x30, 0xfd, 0x99, 0x6f,
-	0xf0, 0x66, 0x2c, 0xe1, 0x68, 0x16, 0xc6, 0x5b, 0x4e, 0x1c, 0xcf, 0x47, 0xa4, 0x41, 0x82, 0xc4,
-	0x73, 0x7c, 0x9e, 0x54, 0x53, 0xd5, 0xb1, 0xe8, 0xab, 0x69, 0x30, 0xce, 0xe2, 0xa3, 0x0f, 0xc1,
-	0xa3, 0xdc, 0xbd, 0xb4, 0xec, 0xc5, 0xb1, 0x17, 0x6c, 0xea, 0x69, 0x20, 0xbc, 0x6c, 0x53, 0x82,
-	0xd4, 0xa3, 0x8b, 0xf9, 0x68, 0xb8, 0xd7, 0xf3, 0xe8, 0x19, 0xa8, 0xc6, 0xdb, 0x5e, 0x6b, 0x3e,
-	0x6a, 0xc4, 0xec, 0x68, 0xa9, 0xaa, 0x7d, 0xba, 0x75, 0xd1, 0x8e, 0x15, 0x06, 0x72, 0x61, 0x84,
-	0x7f, 0x12, 0x1e, 0x2f, 0x28, 0x24, 0xe8, 0xfb, 0x7b, 0x2a, 0x72, 0x91, 0x3f, 0x3b, 0x8d, 0x9d,
-	0xdb, 0x17, 0xe4, 0x41, 0x17, 0x3f, 0x97, 0xb9, 0x61, 0x90, 0xc1, 0x29, 0xa2, 0xe9, 0x3d, 0xdd,
-	0x70, 0x1f, 0x7b, 0xba, 0x17, 0x60, 0x78, 0xbb, 0xbd, 0x4e, 0xc4, 0xc8, 0x0b, 0xc1, 0xa6, 0x66,
-	0xdf, 0x55, 0x0d, 0xc2, 0x26, 0x1e, 0x0b, 0xd5, 0x6c, 0x79, 0xe2, 0x5f, 0x3c, 0x39, 0x6a, 0x84,
-	0x6a, 0xae, 0x2e, 0xca, 0x66, 0x6c, 0xe2, 0xd0, 0xae, 0xd1, 0xb1, 0x58, 0x23, 0x31, 0xcb, 0xc4,
-	0xa0, 0xc3, 0xa5, 0xba, 0x56, 0x97, 0x00, 0xac, 0x71, 0xd0, 0x2a, 0x9c, 0xa2, 0x7f, 0xea, 0x2c,
-	0x7f, 0xf8, 0x86, 0xe3, 0x7b, 0x0d, 0x1e, 0x37, 0x38, 0x9e, 0x76, 0x8e, 0xd6, 0x73, 0x70, 0x70,
-	0xee, 0x93, 0xf6, 0x8f, 0x96, 0xd2, 0x9e, 0x13, 0x53, 0x84, 0xa1, 0x98, 0x0a, 0xaa, 0xe4, 0x86,
-	0x13, 0x49, 0x83, 0xe7, 0x88, 0x99, 0x51, 0x82, 0xee, 0x0d, 0x27, 0x32, 0x45, 0x1e, 0x63, 0x80,
-	0x25, 0x27, 0x74, 0x0b, 0x06, 0x12, 0xdf, 0x29, 0x28, 0x95, 0xd2, 0xe0, 0xa8, 0xbd, 0x60, 0x4b,
-	0xb3, 0x31, 0x66, 0x3c, 0xd0, 0xe3, 0x74, 0xf7, 0xb6, 0x2e, 0x8f, 0xe9, 0xc4, 0x86, 0x6b, 0x3d,
-	0xc6, 0xac, 0xd5, 0xfe, 0xdb, 0xa3, 0x39, 0x5a, 0x47, 0x19, 0x02, 0xe8, 0x3c, 0x00, 0x9d, 0x34,
-	0xab, 0x11, 0xd9, 0xf0, 0x76, 0x85, 0x21, 0xa6, 0x24, 0xdb, 0x35, 0x05, 0xc1, 0x06, 0x96, 0x7c,
-	0xa6, 0xde, 0xde, 0xa0, 0xcf, 0x94, 0xba, 0x9f, 0xe1, 0x10, 0x6c, 0x60, 0xa1, 0xe7, 0x61, 0xd0,
-	0x6b, 0x3a, 0x9b, 0x2a, 0x8a, 0xf8, 0x71, 0x2a, 0xd2, 0x16, 0x59, 0xcb, 0xdd, 0xbd, 0xa9, 0x31,
-	0xd5, 0x21, 0xd6, 0x84, 0x05, 0x2e, 0xfa, 0x29, 0x0b, 0x46, 0xdc, 0xb0, 0xd9, 0x0c, 0x03, 0xbe,
-	0x7d, 0x16, 0xbe, 0x80, 0x5b, 0xc7, 0x65, 0x26, 0x4d, 0xcf, 0x1b, 0xcc, 0xb8, 0x33, 0x40, 0xe5,
-	0x7c, 0x9a, 0x20, 0x9c, 0xea, 0x95, 0x29, 0xf9, 0x2a, 0x07, 0x48, 0xbe, 0x9f, 0xb3, 0x60, 0x82,
-	0x3f, 0x6b, 0xec, 0xea, 0x45, 0x7a, 0x63, 0x78, 0xcc, 0xaf, 0xd5, 0xe5, 0xe8, 0x50, 0x9e, 0xe2,
-	0x2e, 0x38, 0xee, 0xee, 0x24, 0xba, 0x04, 0x13, 0x1b, 0x61, 0xe4, 0x12, 0x73, 0x20, 0x84, 0xd8,
-	0x56, 0x84, 0x2e, 0x66, 0x11, 0x70, 0xf7, 0x33, 0xe8, 0x06, 0x3c, 0x62, 0x34, 0x9a, 0xe3, 0xc0,
-	0x25, 0xf7, 0x93, 0x82, 0xda, 0x23, 0x17, 0x73, 0xb1, 0x70, 0x8f, 0xa7, 0xd3, 0x42, 0xb2, 0xd6,
-	0x87, 0x90, 0x7c, 0x0d, 0xce, 0xb8, 0xdd, 0x23, 0xb3, 0x13, 0xb7, 0xd7, 0x63, 0x2e, 0xc7, 0xab,
-	0x73, 0x5f, 0x27, 0x08, 0x9c, 0x99, 0xef, 0x85, 0x88, 0x7b, 0xd3, 0x40, 0x6f, 0x42, 0x35, 0x22,
-	0xec, 0xab, 0xc4, 0x22, 0xd7, 0xef, 0x88, 0xde, 0x0e, 0x6d, 0xc1, 0x73, 0xb2, 0x5a, 0x33, 0x89,
-	0x86, 0x18, 0x2b, 0x8e, 0xe8, 0x36, 0x0c, 0xb5, 0x9c, 0xc4, 0xdd, 0x12, 0x19, 0x7e, 0x47, 0x76,
-	0xec, 0x2b, 0xe6, 0xec, 0x1c, 0xc6, 0xa8, 0x97, 0xc0, 0x99, 0x60, 0xc9, 0x8d, 0xda, 0x6a, 0x6e,
-	0xd8, 0x6c, 0x85, 0x01, 0x09, 0x12, 0xa9, 0x44, 0xc6, 0xf8, 0x61, 0x89, 0x6c, 0xc5, 0x06, 0x46,
-	0x97, 0x2e, 0xd7, 0x68, 0x93, 0x13, 0xfb, 0xe8, 0x72, 0x83, 0x5a, 0xaf, 0xe7, 0xa9, 0xb2, 0x61,
-	0x6e, 0xc5, 0x9b, 0x5e, 0xb2, 0x15, 0xb6, 0x13, 0xb9, 0x4b, 0x16, 0x8a, 0x4a, 0x29, 0x9b, 0xa5,
-	0x1c, 0x1c, 0x9c, 0xfb, 0x64, 0x56, 0xb3, 0x8e, 0xdf, 0x9b, 0x66, 0x3d, 0xd1, 0x87, 0x66, 0xad,
-	0xc3, 0x69, 0xd6, 0x03, 0x61, 0x25, 0x4b, 0xa7, 0x65, 0x3c, 0x89, 0x58, 0xe7, 0x55, 0x72, 0xcc,
-	0x52, 0x1e, 0x12, 0xce, 0x7f, 0xf6, 0xec, 0xb7, 0xc1, 0x44, 0x97, 0x90, 0x3b, 0x94, 0x43, 0x72,
-	0x01, 0x1e, 0xc9, 0x17, 0x27, 0x87, 0x72, 0x4b, 0xfe, 0x6c, 0x26, 0xa8, 0xdd, 0xd8, 0xa2, 0xf5,
-	0xe1, 0xe2, 0x76, 0xa0, 0x4c, 0x82, 0x1d, 0xa1, 0x5d, 0x2f, 0x1e, 0x6d, 0x56, 0x5f, 0x08, 0x76,
-	0xb8, 0x34, 0x64, 0x7e, 0xbc, 0x0b, 0xc1, 0x0e, 0xa6, 0xb4, 0xd1, 0x0f, 0x59, 0xa9, 0x0d, 0x04,
-	0x77, 0x8c, 0x7f, 0xe4, 0x58, 0xf6, 0xa4, 0x7d, 0xef, 0x29, 0xec, 0x7f, 0x57, 0x82, 0x73, 0x07,
-	0x11, 0xe9, 0x63, 0xf8, 0x9e, 0x82, 0xc1, 0x98, 0x85, 0xa9, 0x08, 0x75, 0x35, 0x4c, 0x57, 0x31,
-	0x0f, 0x5c, 0x79, 0x0d, 0x0b, 0x10, 0xf2, 0xa1, 0xdc, 0x74, 0x5a, 0xc2, 0x5f, 0xba, 0x78, 0xd4,
-	0xe4, 0x3f, 0xfa, 0xdf, 0xf1, 0x97, 0x9d, 0x16, 0x9f, 0xf3, 0x46, 0x03, 0xa6, 0x6c, 0x50, 0x02,
-	0x15, 0x27, 0x8a, 0x1c, 0x19, 0x13, 0x71, 0xb5, 0x18, 0x7e, 0xb3, 0x94, 0x24, 0x3f, 0x52, 0x4e,
-	0x35, 0x61, 0xce, 0xcc, 0xfe, 0x91, 0x6a, 0x2a, 0x53, 0x8c, 0x05, 0xba, 0xc4, 0x30, 0x28, 0xdc,
-	0xa4, 0x56, 0xd1, 0x39, 0x97, 0x3c, 0x15, 0x9b, 0x79, 0x20, 0x44, 0x41, 0x0b, 0xc1, 0x0a, 0x7d,
-	0xd6, 0x62, 0x65, 0x23, 0x64, 0xfa, 0x9d, 0xd8, 0xd5, 0x1f, 0x4f, 0x15, 0x0b, 0xb3, 0x18, 0x85,
-	0x6c, 0xc4, 0x26, 0x77, 0x51, 0x1a, 0x87, 0xed, 0x66, 0xba, 0x4b, 0xe3, 0xb0, 0xdd, 0x89, 0x84,
-	0xa3, 0xdd, 0x9c, 0x80, 0x96, 0x02, 0x4a, 0x0f, 0xf4, 0x11, 0xc2, 0xf2, 0x25, 0x0b, 0x26, 0xbc,
-	0x6c, 0x64, 0x82, 0xd8, 0x03, 0xdf, 0x2c, 0xc6, 0xa7, 0xd9, 0x1d, 0xf8, 0xa0, 0x0c, 0x9d, 0x2e,
-	0x10, 0xee, 0xee, 0x0c, 0x6a, 0xc0, 0x80, 0x17, 0x6c, 0x84, 0xc2, 0xbc, 0x9b, 0x3b, 0x5a, 0xa7,
-	0x16, 0x83, 0x8d, 0x50, 0xaf, 0x66, 0xfa, 0x0f, 0x33, 0xea, 0x68, 0x09, 0x4e, 0xc9, 0x64, 0xa1,
-	0xcb, 0x5e, 0x9c, 0x84, 0x51, 0x67, 0xc9, 0x6b, 0x7a, 0x09, 0x33, 0xcd, 0xca, 0x73, 0x93, 0x54,
-	0xbd, 0xe1, 0x1c, 0x38, 0xce, 0x7d, 0x0a, 0xbd, 0x01, 0x43, 0x32, 0x1a, 0xa0, 0x5a, 0x84, 0x3f,
-	0xa1, 0x7b, 0xfe, 0xab, 0xc9, 0x54, 0x17, 0xe1, 0x00, 0x92, 0x21, 0xfa, 0x8c, 0x05, 0x63, 0xfc,
-	0xf7, 0xe5, 0x4e, 0x83, 0xe7, 0x27, 0xd6, 0x8a, 0x08, 0xf9, 0xaf, 0xa7, 0x68, 0xce, 0xa1, 0x3b,
-	0x7b, 0x53, 0x63, 0xe9, 0x36, 0x9c, 0xe1, 0x6b, 0xff, 0x93, 0x11, 0xe8, 0x8e, 0x9f, 0x48, 0x07,
-	0x4b, 0x58, 0xf7, 0x3b, 0x58, 0x82, 0xee, 0x2a, 0x63, 0x1d, 0xe7, 0x50, 0xc0, 0x32, 0x13, 0x5c,
-	0xf5, 0x31, 0x74, 0x27, 0x70, 0x31, 0xe3, 0x81, 0xda, 0x30, 0xc8, 0x2b, 0x53, 0x09, 0x0d, 0x70,
-	0xf4, 0x93, 0x6f, 0xb3, 0xc2, 0x95, 0x76, 0x6b, 0xf1, 0x56, 0x2c, 0x98, 0xa1, 0x5d, 0x18, 0xda,
-	0xe2, 0xd3, 0x51, 0xec, 0xf5, 0x96, 0x8f, 0x3a, 0xbe, 0xa9, 0x39, 0xae, 0x27, 0x9f, 0x68, 0xc0,
-	0x92, 0x1d, 0x8b, 0xcd, 0x33, 0xa2, 0x87, 0xb8, 0x20, 0x29, 0x2e, 0xd5, 0xb2, 0xff, 0xd0, 0xa1,
-	0x8f, 0xc2, 0x48, 0x44, 0xdc, 0x30, 0x70, 0x3d, 0x9f, 0x34, 0x66, 0xe5, 0x81, 0xd8, 0x61, 0x32,
-	0xec, 0x98, 0x37, 0x09, 0x1b, 0x34, 0x70, 0x8a, 0x22, 0x5b, 0x67, 0x2a, 0xeb, 0x9e, 0x7e, 0x10,
-	0x22, 0x0e, 0x3e, 0x96, 0x0a, 0xca, 0xf1, 0x67, 0x34, 0xf9, 0x3a, 0x4b, 0xb7, 0xe1, 0x0c, 0x5f,
-	0xf4, 0x0a, 0x40, 0xb8, 0xce, 0x03, 0xf0, 0x66, 0x13, 0x71, 0x0a, 0x72, 0x98, 0x57, 0x1d, 0xe3,
-	0x99, 0xba, 0x92, 0x02, 0x36, 0xa8, 0xa1, 0xab, 0x00, 0x7c, 0xe5, 0xac, 0x75, 0x5a, 0x72, 0x43,
-	0x28, 0x53, 0x24, 0xa1, 0xae, 0x20, 0x77, 0xf7, 0xa6, 0xba, 0x7d, 0xce, 0x2c, 0xca, 0xc8, 0x78,
-	0x1c, 0x7d, 0x17, 0x0c, 0xc5, 0xed, 0x66, 0xd3, 0x51, 0x67, 0x24, 0x05, 0xe6, 0xfe, 0x72, 0xba,
-	0x86, 0x60, 0xe4, 0x0d, 0x58, 0x72, 0x44, 0xb7, 0xa8, 0x88, 0x17, 0x12, 0x8a, 0xaf, 0x22, 0x6e,
-	0xa1, 0x70, 0x4f, 0xe0, 0x07, 0xe4, 0x2e, 0x06, 0xe7, 0xe0, 0xdc, 0xdd, 0x9b, 0x7a, 0x24, 0xdd,
-	0xbe, 0x14, 0x8a, 0x6c, 0xdc, 0x5c, 0x9a, 0xe8, 0x8a, 0x2c, 0xc2, 0x45, 0x5f, 0x5b, 0xd6, 0x86,
-	0x79, 0xaf, 0x2e, 0xc2, 0xc5, 0x9a, 0x7b, 0x8f, 0x99, 0xf9, 0x30, 0x5a, 0x86, 0x93, 0x6e, 0x18,
-	0x24, 0x51, 0xe8, 0xfb, 0xbc, 0x40, 0x1f, 0xdf, 0x9b, 0xf3, 0x33, 0x94, 0xc7, 0x44, 0xb7, 0x4f,
-	0xce, 0x77, 0xa3, 0xe0, 0xbc, 0xe7, 0xa8, 0x4d, 0x9e, 0xd5, 0x0f, 0x63, 0x85, 0x1c, 0xaf, 0xa7,
-	0x68, 0x0a, 0x09, 0xa5, 0xdc, 0xde, 0x07, 0x68, 0x8a, 0x20, 0x7d, 0xc8, 0x2a, 0xbe, 0xd8, 0xf3,
-	0x30, 0x42, 0x76, 0x13, 0x12, 0x05, 0x8e, 0x7f, 0x1d, 0x2f, 0xc9, 0x03, 0x0b, 0xb6, 0x30, 0x2f,
-	0x18, 0xed, 0x38, 0x85, 0x85, 0x6c, 0xe5, 0x25, 0x33, 0xd2, 0xde, 0xb9, 0x97, 0x4c, 0xfa, 0xc4,
-	0xec, 0x9f, 0x29, 0xa7, 0x6c, 0xd6, 0x07, 0x72, 0xa4, 0xcb, 0xea, 0x2b, 0xc9, 0x42, 0x54, 0x0c,
-	0x20, 0xf6, 0x62, 0x45, 0x72, 0x56, 0x51, 0x73, 0x2b, 0x26, 0x23, 0x9c, 0xe6, 0x8b, 0xb6, 0xa1,
-	0xb2, 0x15, 0xc6, 0x89, 0xdc, 0xa1, 0x1d, 0x71, 0x33, 0x78, 0x39, 0x8c, 0x13, 0x66, 0x68, 0xa9,
-	0xd7, 0xa6, 0x2d, 0x31, 0xe6, 0x3c, 0xe8, 0xde, 0x3f, 0xde, 0x72, 0xa2, 0x46, 0x3c, 0xcf, 0x8a,
-	0x54, 0x0c, 0x30, 0x0b, 0x4b, 0xd9, 0xd3, 0x75, 0x0d, 0xc2, 0x26, 0x9e, 0xfd, 0x27, 0x56, 0xea,
-	0x54, 0xeb, 0x26, 0xcb, 0x38, 0xd8, 0x21, 0x01, 0x15, 0x51, 0x66, 0x8c, 0xe3, 0x37, 0x65, 0xf2,
-	0xb7, 0xdf, 0xd3, 0xab, 0x96, 0xe6, 0x6d, 0x4a, 0x61, 0x9a, 0x91, 0x30, 0xc2, 0x21, 0x3f, 0x6e,
-	0xa5, 0x13, 0xf1, 0x4b, 0x45, 0x6c, 0xdd, 0xcc, 0x62, 0x14, 0x07, 0xe6, 0xf4, 0xdb, 0x3f, 0x64,
-	0xc1, 0xd0, 0x9c, 0xe3, 0x6e, 0x87, 0x1b, 0x1b, 0xe8, 0x19, 0xa8, 0x36, 0xda, 0x91, 0x59, 0x13,
-	0x40, 0x39, 0xab, 0x16, 0x44, 0x3b, 0x56, 0x18, 0x74, 0xea, 0x6f, 0x38, 0xae, 0x2c, 0x49, 0x51,
-	0xe6, 0x53, 0xff, 0x22, 0x6b, 0xc1, 0x02, 0x42, 0x87, 0xbf, 0xe9, 0xec, 0xca, 0x87, 0xb3, 0x47,
-	0x6a, 0xcb, 0x1a, 0x84, 0x4d, 0x3c, 0xfb, 0xd7, 0x2c, 0x98, 0x9c, 0x73, 0x62, 0xcf, 0x9d, 0x6d,
-	0x27, 0x5b, 0x73, 0x5e, 0xb2, 0xde, 0x76, 0xb7, 0x49, 0xc2, 0x4b, 0x97, 0xd0, 0x5e, 0xb6, 0x63,
-	0xba, 0x02, 0xd5, 0x8e, 0x59, 0xf5, 0xf2, 0xba, 0x68, 0xc7, 0x0a, 0x03, 0xbd, 0x01, 0xc3, 0x2d,
-	0x27, 0x8e, 0x6f, 0x87, 0x51, 0x03, 0x93, 0x8d, 0x62, 0x8a, 0x1b, 0xd5, 0x89, 0x1b, 0x91, 0x04,
-	0x93, 0x0d, 0x11, 0xa0, 0xa2, 0xe9, 0x63, 0x93, 0x99, 0xfd, 0x03, 0x16, 0x9c, 0x9a, 0x23, 0x4e,
-	0x44, 0x22, 0x56, 0x0b, 0x49, 0xbd, 0x08, 0x7a, 0x1d, 0xaa, 0x09, 0x6d, 0xa1, 0x3d, 0xb2, 0x8a,
-	0xed, 0x11, 0x0b, 0x2d, 0x59, 0x13, 0xc4, 0xb1, 0x62, 0x63, 0x7f, 0xc1, 0x82, 0x33, 0x79, 0x7d,
-	0x99, 0xf7, 0xc3, 0x76, 0xe3, 0x41, 0x74, 0xe8, 0xef, 0x5a, 0x30, 0xc2, 0x8e, 0xeb, 0x17, 0x48,
-	0xe2, 0x78, 0x7e, 0x57, 0x1d, 0x46, 0xab, 0xcf, 0x3a, 0x8c, 0xe7, 0x60, 0x60, 0x2b, 0x6c, 0x92,
-	0x6c, 0xa8, 0xc9, 0xe5, 0xb0, 0x49, 0x30, 0x83, 0xa0, 0x67, 0xe9, 0x24, 0xf4, 0x82, 0xc4, 0xa1,
-	0xcb, 0x51, 0x1e, 0x67, 0x8c, 0xf3, 0x09, 0xa8, 0x9a, 0xb1, 0x89, 0x63, 0xff, 0x72, 0x0d, 0x86,
-	0x44, 0x5c, 0x54, 0xdf, 0xa5, 0x74, 0xa4, 0x17, 0xa7, 0xd4, 0xd3, 0x8b, 0x13, 0xc3, 0xa0, 0xcb,
-	0x8a, 0xe5, 0x0a, 0x0b, 0xfd, 0x6a, 0x21, 0x81, 0x74, 0xbc, 0xfe, 0xae, 0xee, 0x16, 0xff, 0x8f,
-	0x05, 0x2b, 0xf4, 0x96, 0x05, 0xe3, 0x6e, 0x18, 0x04, 0xc4, 0xd5, 0xb6, 0xe3, 0x40, 0x11, 0x1b,
-	0x84, 0xf9, 0x34, 0x51, 0x7d, 0x12, 0x9c, 0x01, 0xe0, 0x2c, 0x7b, 0xf4, 0x12, 0x8c, 0xf2, 0x31,
-	0xbb, 0x91, 0x3a, 0x83, 0xd1, 0xe5, 0xf9, 0x4c, 0x20, 0x4e, 0xe3, 0xa2, 0x69, 0x7e, 0x96, 0x25,
-	0x0a, 0xe1, 0x0d, 0x6a, 0x57, 0xb5, 0x51, 0x02, 0xcf, 0xc0, 0x40, 0x11, 0xa0, 0x88, 0x6c, 0x44,
-	0x24, 0xde, 0x12, 0x71, 0x63, 0xcc, 0x6e, 0x1d, 0xba, 0xb7, 0x22, 0x18, 0xb8, 0x8b, 0x12, 0xce,
-	0xa1, 0x8e, 0xb6, 0x85, 0x1b, 0xa1, 0x5a, 0x84, 0x3c, 0x17, 0x9f, 0xb9, 0xa7, 0x37, 0x61, 0x0a,
-	0x2a, 0x4c, 0x75, 0x31, 0x7b, 0xb9, 0xcc, 0x13, 0x2f, 0x99, 0x62, 0xc3, 0xbc, 0x1d, 0x2d, 0xc0,
-	0x89, 0x4c, 0x71, 0xc1, 0x58, 0x9c, 0x95, 0xa8, 0x24, 0xbb, 0x4c, 0x59, 0xc2, 0x18, 0x77, 0x3d,
-	0x61, 0xba, 0x98, 0x86, 0x0f, 0x70, 0x31, 0x75, 0x54, 0x74, 0x32, 0x3f, 0xc5, 0x78, 0xb9, 0x90,
-	0x01, 0xe8, 0x2b, 0x14, 0xf9, 0xf3, 0x99, 0x50, 0xe4, 0x51, 0xd6, 0x81, 0x1b, 0xc5, 0x74, 0xe0,
-	0xf0, 0x71, 0xc7, 0x0f, 0x32, 0x8e, 0xf8, 0x7f, 0x5b, 0x20, 0xbf, 0xeb, 0xbc, 0xe3, 0x6e, 0x11,
-	0x3a, 0x65, 0xd0, 0x07, 0x61, 0x4c, 0x79, 0x27, 0xb8, 0x49, 0x64, 0xb1, 0x59, 0xa3, 0x6c, 0x67,
-	0x9c, 0x82, 0xe2, 0x0c, 0x36, 0x9a, 0x81, 0x1a, 0x1d, 0x27, 0xfe, 0x28, 0xd7, 0xfb, 0xca, 0x03,
-	0x32, 0xbb, 0xba, 0x28, 0x9e, 0xd2, 0x38, 0x28, 0x84, 0x09, 0xdf, 0x89, 0x13, 0xd6, 0x83, 0x7a,
-	0x27, 0x70, 0xef, 0xb1, 0x04, 0x0d, 0xcb, 0xe4, 0x5a, 0xca, 0x12, 0xc2, 0xdd, 0xb4, 0xed, 0xff,
-	0x50, 0x81, 0xd1, 0x94, 0x64, 0x3c, 0xa4, 0xc1, 0xf0, 0x0c, 0x54, 0xa5, 0x0e, 0xcf, 0xd6, 0xda,
-	0x52, 0x8a, 0x5e, 0x61, 0x50, 0xa5, 0xb5, 0xae, 0xb5, 0x6a, 0xd6, 0xc0, 0x31, 0x14, 0x2e, 0x36,
-	0xf1, 0x98, 0x50, 0x4e, 0xfc, 0x78, 0xde, 0xf7, 0x48, 0x90, 0xf0, 0x6e, 0x16, 0x23, 0x94, 0xd7,
-	0x96, 0xea, 0x26, 0x51, 0x2d, 0x94, 0x33, 0x00, 0x9c, 0x65, 0x8f, 0x3e, 0x65, 0xc1, 0xa8, 0x73,
-	0x3b, 0xd6, 0x15, 0xdd, 0x45, 0xd0, 0xf1, 0x11, 0x95, 0x54, 0xaa, 0x48, 0x3c, 0x77, 0xec, 0xa7,
-	0x9a, 0x70, 0x9a, 0x29, 0x7a, 0xdb, 0x02, 0x44, 0x76, 0x89, 0x2b, 0xc3, 0xa2, 0x45, 0x5f, 0x06,
-	0x8b, 0xd8, 0xc1, 0x5f, 0xe8, 0xa2, 0xcb, 0xa5, 0x7a, 0x77, 0x3b, 0xce, 0xe9, 0x03, 0xba, 0x02,
-	0xa8, 0xe1, 0xc5, 0xce, 0xba, 0x4f, 0xe6, 0xc3, 0xa6, 0xcc, 0x3e, 0x16, 0xe7, 0xe9, 0x67, 0xc5,
-	0x38, 0xa3, 0x85, 0x2e, 0x0c, 0x9c, 0xf3, 0x14, 0x9b, 0x65, 0x51, 0xb8, 0xdb, 0xb9, 0x1e, 0xf9,
-	0x4c, 0x4b, 0x98, 0xb3, 0x4c, 0xb4, 0x63, 0x85, 0x61, 0xff, 0x69, 0x59, 0x2d, 0x65, 0x9d, 0x03,
-	0xe0, 0x18, 0xb1, 0xc8, 0xd6, 0xbd, 0xc7, 0x22, 0xeb, 0x48, 0xa9, 0xee, 0x9c, 0xfa, 0x54, 0x0a,
-	0x6e, 0xe9, 0x01, 0xa5, 0xe0, 0x7e, 0xaf, 0x95, 0xaa, 0x67, 0x37, 0x7c, 0xfe, 0x95, 0x62, 0xf3,
-	0x0f, 0xa6, 0x79, 0x14, 0x57, 0x46, 0xaf, 0x64, 0x82, 0xf7, 0x9e, 0x81, 0xea, 0x86, 0xef, 0xb0,
-	0x2a, 0x2c, 0x6c, 0xa1, 0x1a, 0x11, 0x66, 0x17, 0x45, 0x3b, 0x56, 0x18, 0x54, 0xea, 0x1b, 0x44,
-	0x0f, 0x25, 0xb5, 0xff, 0x73, 0x19, 0x86, 0x0d, 0x8d, 0x9f, 0x6b, 0xbe, 0x59, 0x0f, 0x99, 0xf9,
-	0x56, 0x3a, 0x84, 0xf9, 0xf6, 0x3d, 0x50, 0x73, 0xa5, 0x36, 0x2a, 0xa6, 0x3e, 0x7f, 0x56, 0xc7,
-	0x69, 0x85, 0xa4, 0x9a, 0xb0, 0xe6, 0x89, 0x2e, 0xa5, 0xd2, 0x3c, 0x53, 0x7e, 0x81, 0xbc, 0x3c,
-	0x4c, 0xa1, 0xd1, 0xba, 0x9f, 0xc9, 0xc6, 0x07, 0x54, 0x0e, 0x8e, 0x0f, 0xb0, 0x7f, 0xdf, 0x52,
-	0x1f, 0xf7, 0x3e, 0xd4, 0xf3, 0xb9, 0x95, 0xae, 0xe7, 0x73, 0xa1, 0x90, 0x61, 0xee, 0x51, 0xc8,
-	0xe7, 0x1a, 0x0c, 0xcd, 0x87, 0xcd, 0xa6, 0x13, 0x34, 0xd0, 0xd7, 0xc3, 0x90, 0xcb, 0x7f, 0x0a,
-	0x1f, 0x1a, 0x3b, 0xac, 0x16, 0x50, 0x2c, 0x61, 0xe8, 0x71, 0x18, 0x70, 0xa2, 0x4d, 0xe9, 0x37,
-	0x63, 0x41, 0x70, 0xb3, 0xd1, 0x66, 0x8c, 0x59, 0xab, 0xfd, 0xe7, 0x16, 0x8c, 0xd1, 0x47, 0x3c,
-	0xf6, 0x52, 0xec, 0x75, 0x9e, 0x86, 0x41, 0xa7, 0x9d, 0x6c, 0x85, 0x5d, 0xfb, 0xb0, 0x59, 0xd6,
-	0x8a, 0x05, 0x94, 0xee, 0xc3, 0x54, 0x21, 0x08, 0x63, 0x1f, 0xb6, 0x40, 0xe7, 0x32, 0x83, 0x50,
-	0x53, 0x36, 0x6e, 0xaf, 0xe7, 0x9d, 0x96, 0xd6, 0x79, 0x33, 0x96, 0x70, 0x4a, 0x6c, 0x3d, 0x6c,
-	0x74, 0x44, 0x68, 0xaf, 0x22, 0x36, 0x17, 0x36, 0x3a, 0x98, 0x41, 0xd0, 0x13, 0x50, 0x8e, 0xb7,
-	0x1c, 0x79, 0x2e, 0x2f, 0xa3, 0xcc, 0xeb, 0x97, 0x67, 0x31, 0x6d, 0x57, 0x49, 0x13, 0x91, 0x9f,
-	0x8d, 0xb1, 0x4d, 0x27, 0x4d, 0x44, 0xbe, 0xfd, 0x2f, 0x07, 0x80, 0xc5, 0xdb, 0x38, 0x11, 0x69,
-	0xac, 0x85, 0xac, 0x94, 0xf0, 0xb1, 0x1e, 0x6b, 0xeb, 0x8d, 0xec, 0xc3, 0x7c, 0xb4, 0x6d, 0x1c,
-	0x6f, 0x96, 0xef, 0xf7, 0xf1, 0x66, 0xfe, 0x89, 0xf5, 0xc0, 0x43, 0x74, 0x62, 0x6d, 0x7f, 0xce,
-	0x02, 0xa4, 0xa2, 0xa7, 0x74, 0x48, 0xc9, 0x0c, 0xd4, 0x54, 0xb8, 0x96, 0x58, 0x2f, 0x5a, 0x2c,
-	0x4a, 0x00, 0xd6, 0x38, 0x7d, 0x78, 0x2f, 0x9e, 0x92, 0x3a, 0xab, 0x9c, 0xce, 0xb9, 0x60, 0x9a,
-	0x4e, 0xa8, 0x30, 0xfb, 0x57, 0x4a, 0xf0, 0x08, 0x37, 0x97, 0x96, 0x9d, 0xc0, 0xd9, 0x24, 0x4d,
-	0xda, 0xab, 0x7e, 0x83, 0x84, 0x5c, 0xba, 0x6d, 0xf6, 0x64, 0x86, 0xc4, 0x51, 0xe5, 0x15, 0x97,
-	0x33, 0x5c, 0xb2, 0x2c, 0x06, 0x5e, 0x82, 0x19, 0x71, 0x14, 0x43, 0x55, 0x5e, 0x66, 0x24, 0xf4,
-	0x4f, 0x41, 0x8c, 0x94, 0x28, 0x16, 0x96, 0x05, 0xc1, 0x8a, 0x11, 0x35, 0x1f, 0xfc, 0xd0, 0xdd,
-	0xa6, 0x4b, 0x3e, 0x6b, 0x3e, 0x2c, 0x89, 0x76, 0xac, 0x30, 0xec, 0x26, 0x8c, 0xcb, 0x31, 0x6c,
-	0x5d, 0x25, 0x1d, 0x4c, 0x36, 0xa8, 0xce, 0x75, 0x65, 0x93, 0x71, 0xbf, 0x92, 0xd2, 0xb9, 0xf3,
-	0x26, 0x10, 0xa7, 0x71, 0x65, 0x75, 0xe1, 0x52, 0x7e, 0x75, 0x61, 0xfb, 0x57, 0x2c, 0xc8, 0x2a,
-	0x7d, 0xa3, 0x96, 0xaa, 0xb5, 0x6f, 0x2d, 0xd5, 0x43, 0x54, 0x23, 0xfd, 0x4e, 0x18, 0x76, 0x12,
-	0x6a, 0xd5, 0x71, 0x0f, 0x4c, 0xf9, 0xde, 0x4e, 0x0e, 0x97, 0xc3, 0x86, 0xb7, 0xe1, 0x31, 0xcf,
-	0x8b, 0x49, 0xce, 0x7e, 0xdb, 0x82, 0xda, 0x42, 0xd4, 0x39, 0x7c, 0xaa, 0x5a, 0x77, 0x22, 0x5a,
-	0xe9, 0x50, 0x89, 0x68, 0x32, 0xd5, 0xad, 0xdc, 0x2b, 0xd5, 0xcd, 0xfe, 0x8b, 0x01, 0x98, 0xe8,
-	0xca, 0xbd, 0x44, 0x2f, 0xc2, 0x88, 0xfa, 0x4a, 0xd2, 0xed, 0x5a, 0x33, 0x83, 0x97, 0x35, 0x0c,
-	0xa7, 0x30, 0xfb, 0x58, 0xaa, 0x8b, 0x70, 0x32, 0x22, 0xaf, 0xb7, 0x49, 0x9b, 0xcc, 0x6e, 0x24,
-	0x24, 0xaa, 0x13, 0x37, 0x0c, 0x1a, 0xbc, 0x18, 0x71, 0x79, 0xee, 0xd1, 0x3b, 0x7b, 0x53, 0x27,
-	0x71, 0x37, 0x18, 0xe7, 0x3d, 0x83, 0x5a, 0x30, 0xea, 0x9b, 0xfb, 0x05, 0xb1, 0x4d, 0xbd, 0xa7,
-	0xad, 0x86, 0x9a, 0xad, 0xa9, 0x66, 0x9c, 0x66, 0x90, 0xde, 0x74, 0x54, 0x1e, 0xd0, 0xa6, 0xe3,
-	0x93, 0x7a, 0xd3, 0xc1, 0x63, 0x81, 0x3e, 0x5c, 0x70, 0xee, 0x6d, 0x3f, 0xbb, 0x8e, 0xa3, 0xec,
-	0x23, 0x5e, 0x86, 0xaa, 0x8c, 0x93, 0xec, 0x2b, 0xbe, 0xd0, 0xa4, 0xd3, 0x43, 0xb6, 0x3f, 0x0d,
-	0xef, 0xbe, 0x10, 0x45, 0xc6, 0x60, 0x5e, 0x0b, 0x93, 0x59, 0xdf, 0x0f, 0x6f, 0x53, 0x73, 0xe5,
-	0x7a, 0x4c, 0x84, 0x1f, 0xd0, 0xbe, 0x5b, 0x82, 0x9c, 0x2d, 0x35, 0x5d, 0x93, 0xda, 0x2e, 0x4c,
-	0xad, 0xc9, 0xc3, 0xd9, 0x86, 0x68, 0x97, 0xc7, 0x92, 0x72, 0x6b, 0xe0, 0x43, 0x45, 0xbb, 0x04,
-	0x74, 0x78, 0xa9, 0x92, 0x94, 0x2a, 0xc4, 0xf4, 0x3c, 0x80, 0x36, 0xe7, 0x85, 0x4d, 0xa8, 0x82,
-	0x43, 0xb4, 0xd5, 0x8f, 0x0d, 0x2c, 0xf4, 0x02, 0x0c, 0x7b, 0x41, 0x9c, 0x38, 0xbe, 0x7f, 0xd9,
-	0x0b, 0x12, 0x61, 0x27, 0x2a, 0xb3, 0x67, 0x51, 0x83, 0xb0, 0x89, 0x77, 0xf6, 0x03, 0xc6, 0xf7,
-	0x3b, 0xcc, 0x77, 0xdf, 0x82, 0x33, 0x97, 0xbc, 0x44, 0x25, 0x29, 0xaa, 0xf9, 0x46, 0xad, 0x75,
-	0x25, 0xab, 0xac, 0x9e, 0x69, 0xb9, 0x46, 0x92, 0x60, 0x29, 0x9d, 0xd3, 0x98, 0x4d, 0x12, 0xb4,
-	0x5d, 0x38, 0x75, 0xc9, 0x4b, 0x2e, 0x7a, 0x3e, 0x39, 0x46, 0x26, 0xbf, 0x34, 0x08, 0x23, 0x66,
-	0xee, 0xfe, 0x61, 0x24, 0xfb, 0x17, 0xa8, 0x1d, 0x2b, 0x06, 0xc2, 0x53, 0x07, 0xde, 0x37, 0x8f,
-	0x5c, 0x48, 0x20, 0x7f, 0x70, 0x0d, 0x53, 0x56, 0xf3, 0xc4, 0x66, 0x07, 0xd0, 0x6d, 0xa8, 0x6c,
-	0xb0, 0x7c, 0xb7, 0x72, 0x11, 0xa1, 0x4a, 0x79, 0x83, 0xaf, 0x57, 0x2e, 0xcf, 0x98, 0xe3, 0xfc,
-	0xa8, 0xf9, 0x11, 0xa5, 0xd3, 0xac, 0x8d, 0x2c, 0x04, 0xa1, 0xd7, 0x14, 0x46, 0x2f, 0xed, 0x51,
-	0xb9, 0x07, 0xed, 0x91, 0x92, 0xe5, 0x83, 0x0f, 0x48, 0x96, 0xb3, 0xdc, 0xc5, 0x64, 0x8b, 0x19,
-	0xc7, 0x22, 0x6d, 0x6a, 0x88, 0x0d, 0x82, 0x91, 0xbb, 0x98, 0x02, 0xe3, 0x2c, 0x3e, 0xfa, 0x98,
-	0xd2, 0x06, 0xd5, 0x22, 0x0e, 0x14, 0xcc, 0x19, 0x7d, 0xdc, 0x8a, 0xe0, 0x73, 0x25, 0x18, 0xbb,
-	0x14, 0xb4, 0x57, 0x2f, 0xad, 0xb6, 0xd7, 0x7d, 0xcf, 0xbd, 0x4a, 0x3a, 0x54, 0xda, 0x6f, 0x93,
-	0xce, 0xe2, 0x82, 0x58, 0x41, 0x6a, 0xce, 0x5c, 0xa5, 0x8d, 0x98, 0xc3, 0xa8, 0xdc, 0xda, 0xf0,
-	0x82, 0x4d, 0x12, 0xb5, 0x22, 0x4f, 0xf8, 0xfa, 0x0d, 0xb9, 0x75, 0x51, 0x83, 0xb0, 0x89, 0x47,
-	0x69, 0x87, 0xb7, 0x03, 0x55, 0x48, 0x49, 0xd1, 0x5e, 0xa1, 0x8d, 0x98, 0xc3, 0x28, 0x52, 0x12,
-	0xb5, 0x85, 0x2b, 0xcd, 0x40, 0x5a, 0xa3, 0x8d, 0x98, 0xc3, 0xc4, 0x2e, 0x9d, 0x45, 0x82, 0x55,
-	0xba, 0x76, 0xe9, 0x2c, 0x88, 0x42, 0xc2, 0x29, 0xea, 0x36, 0xe9, 0x2c, 0x38, 0x89, 0x93, 0xdd,
-	0x64, 0x5f, 0xe5, 0xcd, 0x58, 0xc2, 0x59, 0x65, 0xe5, 0xf4, 0x70, 0x7c, 0xcd, 0x55, 0x56, 0x4e,
-	0x77, 0xbf, 0x87, 0x43, 0xe6, 0xef, 0x94, 0x60, 0xe4, 0x9d, 0xeb, 0x4f, 0x73, 0x2e, 0xf6, 0xb9,
-	0x09, 0x13, 0x5d, 0x19, 0xd3, 0x7d, 0x58, 0x48, 0x07, 0x56, 0xb4, 0xb0, 0x31, 0x0c, 0x53, 0xc2,
-	0xb2, 0xa2, 0xe0, 0x3c, 0x4c, 0xf0, 0xc5, 0x4b, 0x39, 0xb1, 0x04, 0x58, 0x95, 0x05, 0xcf, 0x0e,
-	0xb3, 0x6e, 0x64, 0x81, 0xb8, 0x1b, 0xdf, 0xfe, 0xbc, 0x05, 0xa3, 0xa9, 0x24, 0xf6, 0x82, 0x6c,
-	0x39, 0xb6, 0xba, 0x43, 0x16, 0xc5, 0xcc, 0xb2, 0x4a, 0xca, 0x4c, 0x0d, 0xeb, 0xd5, 0xad, 0x41,
-	0xd8, 0xc4, 0xb3, 0x7f, 0xb3, 0x0c, 0x55, 0x19, 0x71, 0xd5, 0x47, 0x57, 0x3e, 0x6b, 0xc1, 0xa8,
-	0x3a, 0x40, 0x64, 0x1e, 0xdf, 0x52, 0x11, 0x39, 0x75, 0xb4, 0x07, 0xca, 0x7f, 0x12, 0x6c, 0x84,
-	0x7a, 0x63, 0x81, 0x4d, 0x66, 0x38, 0xcd, 0x1b, 0xdd, 0x00, 0x88, 0x3b, 0x71, 0x42, 0x9a, 0x86,
-	0xef, 0xd9, 0x36, 0x66, 0xd9, 0xb4, 0x1b, 0x46, 0x84, 0xce, 0xa9, 0x6b, 0x61, 0x83, 0xd4, 0x15,
-	0xa6, 0xb6, 0xf0, 0x74, 0x1b, 0x36, 0x28, 0xa1, 0x37, 0xd4, 0x71, 0xf7, 0x40, 0x11, 0x7a, 0x5d,
-	0x8e, 0x6f, 0x3f, 0xe7, 0xdd, 0x47, 0x38, 0x5f, 0xb6, 0x7f, 0xba, 0x04, 0x27, 0xb2, 0x23, 0x89,
-	0x3e, 0x0c, 0x23, 0x72, 0xd0, 0x0c, 0x37, 0x83, 0x0c, 0x73, 0x1b, 0xc1, 0x06, 0xec, 0xee, 0xde,
-	0xd4, 0x54, 0xf7, 0x3d, 0xda, 0xd3, 0x26, 0x0a, 0x4e, 0x11, 0xe3, 0x87, 0xcf, 0x22, 0x4a, 0x62,
-	0xae, 0x33, 0xdb, 0x6a, 0x89, 0x13, 0x64, 0xe3, 0xf0, 0xd9, 0x84, 0xe2, 0x0c, 0x36, 0x5a, 0x85,
-	0x53, 0x46, 0xcb, 0x35, 0xe2, 0x6d, 0x6e, 0xad, 0x87, 0x91, 0xdc, 0xd7, 0x3e, 0xae, 0x83, 0x6a,
-	0xbb, 0x71, 0x70, 0xee, 0x93, 0xd4, 0x30, 0x72, 0x9d, 0x96, 0xe3, 0x7a, 0x49, 0x47, 0x9c, 0x01,
-	0x28, 0x31, 0x3e, 0x2f, 0xda, 0xb1, 0xc2, 0xb0, 0xff, 0xe1, 0x00, 0x9c, 0xe0, 0x51, 0xa4, 0x44,
-	0x05, 0x49, 0xa3, 0x0f, 0x43, 0x2d, 0x4e, 0x9c, 0x88, 0x3b, 0x35, 0xac, 0x43, 0x8b, 0x2e, 0x9d,
-	0x79, 0x2f, 0x89, 0x60, 0x4d, 0x0f, 0xbd, 0xc2, 0xca, 0x96, 0x79, 0xf1, 0x16, 0xa3, 0x5e, 0xba,
-	0x37, 0x97, 0xc9, 0x45, 0x45, 0x01, 0x1b, 0xd4, 0xd0, 0xb7, 0x40, 0xa5, 0xb5, 0xe5, 0xc4, 0xd2,
-	0x9f, 0xf7, 0xb4, 0x94, 0x13, 0xab, 0xb4, 0xf1, 0xee, 0xde, 0xd4, 0xe9, 0xec, 0xab, 0x32, 0x00,
-	0xe6, 0x0f, 0x99, 0x52, 0x7e, 0xe0, 0xe0, 0x7b, 0x79, 0x1a, 0x51, 0xa7, 0x7e, 0x79, 0x36, 0x7b,
-	0x93, 0xcb, 0x02, 0x6b, 0xc5, 0x02, 0x4a, 0x65, 0xd2, 0x16, 0x67, 0xd9, 0xa0, 0xc8, 0x83, 0x69,
-	0x8b, 0xe3, 0xb2, 0x06, 0x61, 0x13, 0x0f, 0x7d, 0xae, 0x3b, 0xc6, 0x78, 0xe8, 0x18, 0x72, 0x50,
-	0xfa, 0x8d, 0x2e, 0xbe, 0x00, 0x35, 0xd1, 0xd5, 0xb5, 0x10, 0xbd, 0x08, 0x23, 0xdc, 0x5d, 0x34,
-	0x17, 0x39, 0x81, 0xbb, 0x95, 0x75, 0xf2, 0xac, 0x19, 0x30, 0x9c, 0xc2, 0xb4, 0x97, 0x61, 0xa0,
-	0x4f, 0x21, 0xdb, 0xd7, 0xde, 0xfd, 0x65, 0xa8, 0x52, 0x72, 0x72, 0x83, 0x56, 0x04, 0xc9, 0x10,
-	0xaa, 0xf2, 0x96, 0x47, 0x64, 0x43, 0xd9, 0x73, 0x64, 0x2c, 0x89, 0x5a, 0x42, 0x8b, 0x71, 0xdc,
-	0x66, 0xd3, 0x8e, 0x02, 0xd1, 0x53, 0x50, 0x26, 0xbb, 0xad, 0x6c, 0xd0, 0xc8, 0x85, 0xdd, 0x96,
-	0x17, 0x91, 0x98, 0x22, 0x91, 0xdd, 0x16, 0x3a, 0x0b, 0x25, 0xaf, 0x21, 0x66, 0x24, 0x08, 0x9c,
-	0xd2, 0xe2, 0x02, 0x2e, 0x79, 0x0d, 0x7b, 0x17, 0x6a, 0xea, 0x5a, 0x49, 0xb4, 0x2d, 0x4d, 0x2a,
-	0xab, 0x88, 0x28, 0x62, 0x49, 0xb7, 0x87, 0x31, 0xd5, 0x06, 0xd0, 0x25, 0x1d, 0x8a, 0x52, 0xc1,
-	0xe7, 0x60, 0xc0, 0x0d, 0x45, 0x31, 0x9e, 0xaa, 0x26, 0xc3, 0x6c, 0x29, 0x06, 0xb1, 0x6f, 0xc2,
-	0xd8, 0xd5, 0x20, 0xbc, 0xcd, 0x6e, 0x7f, 0x62, 0xc5, 0x8e, 0x29, 0xe1, 0x0d, 0xfa, 0x23, 0x6b,
-	0xb9, 0x33, 0x28, 0xe6, 0x30, 0x55, 0x86, 0xb5, 0xd4, 0xab, 0x0c, 0xab, 0xfd, 0x71, 0x0b, 0x46,
-	0x54, 0x6e, 0xf8, 0xa5, 0x9d, 0x6d, 0x4a, 0x77, 0x33, 0x0a, 0xdb, 0xad, 0x2c, 0x5d, 0x76, 0x83,
-	0x2d, 0xe6, 0x30, 0xb3, 0x68, 0x42, 0xe9, 0x80, 0xa2, 0x09, 0xe7, 0x60, 0x60, 0xdb, 0x0b, 0x1a,
-	0x59, 0xa7, 0xe8, 0x55, 0x2f, 0x68, 0x60, 0x06, 0xb1, 0xff, 0xd2, 0x82, 0x13, 0xaa, 0x0b, 0xd2,
-	0x66, 0x7a, 0x11, 0x46, 0xd6, 0xdb, 0x9e, 0xdf, 0x90, 0x55, 0x9c, 0x33, 0xcb, 0x65, 0xce, 0x80,
-	0xe1, 0x14, 0x26, 0x3a, 0x0f, 0xb0, 0xee, 0x05, 0x4e, 0xd4, 0x59, 0xd5, 0x46, 0x9a, 0xd2, 0xdb,
-	0x73, 0x0a, 0x82, 0x0d, 0x2c, 0xf4, 0x26, 0x54, 0x77, 0xe4, 0xe9, 0x6d, 0xb9, 0xd0, 0x5c, 0x7f,
-	0x31, 0x1e, 0x7a, 0x25, 0xa8, 0xe3, 0x60, 0xc5, 0xd1, 0xfe, 0x62, 0x19, 0xc6, 0xd2, 0xf9, 0xf9,
-	0x7d, 0x78, 0x4e, 0x9e, 0x82, 0x0a, 0x4b, 0xd9, 0xcf, 0x4e, 0x2c, 0x5e, 0x76, 0x99, 0xc3, 0x50,
-	0x0c, 0x83, 0x5c, 0x94, 0x14, 0x73, 0x07, 0xa9, 0xea, 0xa4, 0xf2, 0xe3, 0xb2, 0x48, 0x6f, 0xe1,
-	0x16, 0x17, 0xac, 0xd0, 0xa7, 0x2c, 0x18, 0x0a, 0x5b, 0x66, 0xfd, 0xcf, 0x0f, 0x15, 0x59, 0xbb,
-	0x40, 0x24, 0x08, 0x0b, 0x6b, 0x48, 0x4d, 0x3c, 0x39, 0x19, 0x24, 0xeb, 0xb3, 0xdf, 0x0c, 0x23,
-	0x26, 0xe6, 0x41, 0x06, 0x51, 0xd5, 0x34, 0x88, 0x3e, 0x6b, 0x4e, 0x49, 0x51, 0x9d, 0xa1, 0x8f,
-	0xc5, 0x7e, 0x1d, 0x2a, 0xae, 0x0a, 0x87, 0xbb, 0xa7, 0x9b, 0x07, 0x54, 0xf5, 0x32, 0x16, 0x6a,
-	0xc0, 0xa9, 0xd9, 0xbf, 0x6f, 0x19, 0xf3, 0x03, 0x93, 0x78, 0xb1, 0x81, 0x22, 0x28, 0x6f, 0xee,
-	0x6c, 0x0b, 0x23, 0xe3, 0x4a, 0x41, 0xc3, 0x7b, 0x69, 0x67, 0x5b, 0xaf, 0x30, 0xb3, 0x15, 0x53,
-	0x66, 0x7d, 0x1c, 0x36, 0xa4, 0x8a, 0x78, 0x94, 0x0f, 0x2e, 0xe2, 0x61, 0xbf, 0x5d, 0x82, 0x89,
-	0xae, 0x49, 0x85, 0xde, 0x80, 0x4a, 0x44, 0xdf, 0x52, 0xbc, 0xde, 0x52, 0x61, 0x65, 0x37, 0xe2,
-	0xc5, 0x86, 0x56, 0xde, 0xe9, 0x76, 0xcc, 0x59, 0xa2, 0x2b, 0x80, 0x74, 0xd0, 0xa6, 0x3a, 0xe9,
-	0xe0, 0xaf, 0xac, 0x22, 0xbb, 0x66, 0xbb, 0x30, 0x70, 0xce, 0x53, 0xe8, 0xa5, 0xec, 0x81, 0x49,
-	0xa6, 0xa2, 0xf4, 0x7e, 0x67, 0x1f, 0xf6, 0x5b, 0xe6, 0x14, 0xbc, 0xa1, 0x85, 0xe9, 0x51, 0x37,
-	0xa7, 0x5d, 0x92, 0xb5, 0xdc, 0xaf, 0x64, 0xb5, 0x7f, 0xa1, 0x04, 0xa3, 0xa9, 0x0a, 0xb1, 0xc8,
-	0x87, 0x2a, 0xf1, 0xd9, 0xc9, 0xae, 0xd4, 0xbe, 0x47, 0xbd, 0x2c, 0x46, 0xc9, 0xc9, 0x0b, 0x82,
-	0x2e, 0x56, 0x1c, 0x1e, 0x8e, 0x18, 0xb4, 0x17, 0x61, 0x44, 0x76, 0xe8, 0x43, 0x4e, 0xd3, 0xcf,
-	0x0e, 0xdf, 0x05, 0x03, 0x86, 0x53, 0x98, 0xf6, 0xaf, 0x96, 0x61, 0x92, 0x1f, 0x85, 0x37, 0xd4,
-	0x62, 0x50, 0x21, 0x2d, 0x3f, 0xa8, 0xeb, 0x38, 0x5b, 0x45, 0xdc, 0x88, 0xde, 0x8b, 0x51, 0x5f,
-	0xa1, 0xd3, 0x3f, 0x9e, 0x09, 0x9d, 0xe6, 0x5b, 0xf5, 0xcd, 0x63, 0xea, 0xd1, 0xd7, 0x56, 0x2c,
-	0xf5, 0x3f, 0x2d, 0xc1, 0x78, 0xe6, 0xe2, 0x3b, 0xf4, 0xc5, 0xf4, 0x5d, 0x29, 0x56, 0x11, 0xc7,
-	0x84, 0xfb, 0xde, 0x85, 0x76, 0xb8, 0x1b, 0x53, 0x1e, 0xd0, 0x52, 0xb1, 0x7f, 0xb7, 0x04, 0x63,
-	0xe9, 0x1b, 0xfb, 0x1e, 0xc2, 0x91, 0x7a, 0x1f, 0xd4, 0xd8, 0xa5, 0x54, 0x57, 0x49, 0x47, 0x9e,
-	0x32, 0xf2, 0xfb, 0x7f, 0x64, 0x23, 0xd6, 0xf0, 0x87, 0xe2, 0x22, 0x1a, 0xfb, 0x9f, 0x5b, 0x70,
-	0x9a, 0xbf, 0x65, 0x76, 0x1e, 0xfe, 0xad, 0xbc, 0xd1, 0x7d, 0xb5, 0xd8, 0x0e, 0x66, 0xea, 0x8f,
-	0x1f, 0x34, 0xbe, 0xec, 0x5e, 0x78, 0xd1, 0xdb, 0xf4, 0x54, 0x78, 0x08, 0x3b, 0x7b, 0xa8, 0xc9,
-	0x60, 0xff, 0xc7, 0x12, 0x0c, 0xaf, 0xcc, 0x2f, 0x2a, 0x11, 0x3e, 0x03, 0x35, 0x37, 0x22, 0x8e,
-	0x76, 0xff, 0x98, 0x81, 0x56, 0x12, 0x80, 0x35, 0x0e, 0xdd, 0x45, 0xf1, 0x40, 0xc5, 0x38, 0xbb,
-	0x8b, 0xe2, 0x71, 0x8c, 0x31, 0x96, 0x70, 0xf4, 0x0c, 0x54, 0x59, 0x0a, 0xf1, 0xf5, 0x48, 0x6a,
-	0x1c, 0xbd, 0xb5, 0x66, 0xed, 0x78, 0x09, 0x2b, 0x0c, 0x4a, 0xb8, 0x11, 0xba, 0x31, 0x45, 0xce,
-	0x78, 0x64, 0x16, 0x68, 0x33, 0x5e, 0xc2, 0x12, 0xce, 0x2a, 0x40, 0x32, 0xaf, 0x05, 0x45, 0xae,
-	0xa4, 0x3b, 0xcd, 0xdd, 0x1b, 0x14, 0x5d, 0xe3, 0x1c, 0xa6, 0x52, 0x68, 0x26, 0x8d, 0x6f, 0xa8,
-	0xbf, 0x34, 0x3e, 0xfb, 0x77, 0xcb, 0x50, 0xd3, 0x4e, 0x35, 0x4f, 0xd4, 0xcd, 0x28, 0xa4, 0xbe,
-	0x7d, 0xbd, 0x13, 0xb8, 0x8a, 0x34, 0x8f, 0x26, 0x30, 0xca, 0x66, 0x7c, 0xbf, 0x05, 0xc3, 0x5e,
-	0xe0, 0x25, 0x9e, 0xc3, 0x7c, 0x83, 0xc5, 0xdc, 0x13, 0xae, 0xd8, 0x2d, 0x72, 0xca, 0x61, 0x64,
-	0x1e, 0xf9, 0x2b, 0x66, 0xd8, 0xe4, 0x8c, 0x3e, 0x2a, 0xb2, 0xc6, 0xca, 0x85, 0x15, 0x9f, 0xa9,
-	0x66, 0x52, 0xc5, 0x5a, 0xd4, 0xc6, 0x4e, 0xa2, 0x82, 0x6a, 0x36, 0x61, 0x4a, 0x4a, 0xdd, 0xb3,
-	0xa2, 0x76, 0x31, 0xac, 0x19, 0x73, 0x46, 0x76, 0x0c, 0xa8, 0x7b, 0x2c, 0x0e, 0x99, 0x91, 0x33,
-	0x03, 0x35, 0xa7, 0x9d, 0x84, 0x4d, 0x3a, 0x4c, 0x22, 0x60, 0x40, 0xe7, 0x1c, 0x49, 0x00, 0xd6,
-	0x38, 0xf6, 0x17, 0x2b, 0x90, 0xa9, 0x62, 0x81, 0x76, 0xa1, 0xa6, 0xea, 0x58, 0x14, 0x93, 0xe1,
-	0xaa, 0x67, 0x94, 0xea, 0x8c, 0x6a, 0xc2, 0x9a, 0x19, 0xda, 0x94, 0x6e, 0x56, 0xbe, 0xda, 0x5f,
-	0xce, 0xba, 0x59, 0xbf, 0xbd, 0xbf, 0x53, 0x37, 0x3a, 0x57, 0x67, 0x78, 0xdd, 0xc2, 0xe9, 0x03,
-	0x3d, 0xb2, 0x07, 0xdd, 0x94, 0xfe, 0x09, 0x71, 0xab, 0x19, 0x26, 0x71, 0xdb, 0x4f, 0xc4, 0x6c,
-	0x78, 0xb9, 0xc0, 0x55, 0xc6, 0x09, 0xeb, 0x6a, 0x50, 0xfc, 0x3f, 0x36, 0x98, 0xa6, 0xfd, 0xe6,
-	0x83, 0xc7, 0xea, 0x37, 0x1f, 0x2a, 0xd4, 0x6f, 0x7e, 0x1e, 0x80, 0xcd, 0x6d, 0x9e, 0x39, 0x50,
-	0x65, 0xee, 0x4c, 0xa5, 0x62, 0xb0, 0x82, 0x60, 0x03, 0xcb, 0xfe, 0x46, 0x48, 0x97, 0x33, 0x43,
-	0x53, 0xb2, 0x7a, 0x1a, 0x3f, 0x11, 0x64, 0x49, 0x9b, 0xa9, 0x42, 0x67, 0x3f, 0x67, 0x81, 0x59,
-	0x73, 0x0d, 0xbd, 0xce, 0x8b, 0xbb, 0x59, 0x45, 0x9c, 0x30, 0x19, 0x74, 0xa7, 0x97, 0x9d, 0x56,
-	0x26, 0xda, 0x49, 0x56, 0x78, 0x3b, 0xfb, 0x01, 0xa8, 0x4a, 0xe8, 0xa1, 0x8c, 0xe5, 0x8f, 0xc1,
-	0x49, 0x59, 0x00, 0x42, 0x1e, 0x06, 0x89, 0xa8, 0x83, 0x83, 0x7d, 0x8c, 0xd2, 0x71, 0x58, 0xea,
-	0xe5, 0x38, 0x54, 0xbb, 0xe1, 0x72, 0xcf, 0xb2, 0xed, 0x3f, 0x6f, 0xc1, 0xb9, 0x6c, 0x07, 0xe2,
-	0xe5, 0x30, 0xf0, 0x92, 0x30, 0xaa, 0x93, 0x24, 0xf1, 0x82, 0x4d, 0x56, 0x83, 0xf7, 0xb6, 0x13,
-	0xc9, 0x7b, 0x98, 0x98, 0xa0, 0xbc, 0xe9, 0x44, 0x01, 0x66, 0xad, 0xa8, 0x03, 0x83, 0x3c, 0xd4,
-	0x5a, 0xec, 0x82, 0x8e, 0xb8, 0x36, 0x72, 0x86, 0x43, 0x6f, 0xc3, 0x78, 0x98, 0x37, 0x16, 0x0c,
-	0xed, 0xaf, 0x58, 0x80, 0x56, 0x76, 0x48, 0x14, 0x79, 0x0d, 0x23, 0x38, 0x9c, 0xdd, 0x0e, 0x6a,
-	0xdc, 0x02, 0x6a, 0x96, 0x27, 0xc9, 0xdc, 0x0e, 0x6a, 0xfc, 0xcb, 0xbf, 0x1d, 0xb4, 0x74, 0xb8,
-	0xdb, 0x41, 0xd1, 0x0a, 0x9c, 0x6e, 0xf2, 0x6d, 0x1c, 0xbf, 0x71, 0x8f, 0xef, 0xe9, 0x54, 0x26,
-	0xfd, 0x99, 0x3b, 0x7b, 0x53, 0xa7, 0x97, 0xf3, 0x10, 0x70, 0xfe, 0x73, 0xf6, 0x07, 0x00, 0xf1,
-	0x98, 0xf0, 0xf9, 0xbc, 0xb0, 0xd6, 0x9e, 0x6e, 0x0e, 0xfb, 0xc7, 0x2a, 0x30, 0x9e, 0xb9, 0xa5,
-	0x83, 0x6e, 0xa1, 0xbb, 0xe3, 0x68, 0x8f, 0xac, 0xbf, 0xbb, 0xbb, 0xd7, 0x57, 0x64, 0x6e, 0x00,
-	0x15, 0x2f, 0x68, 0xb5, 0x93, 0x62, 0x0a, 0x79, 0xf0, 0x4e, 0x2c, 0x52, 0x82, 0xc6, 0xb9, 0x04,
-	0xfd, 0x8b, 0x39, 0x9b, 0x22, 0xe3, 0x7c, 0x53, 0x9b, 0x9c, 0x81, 0x07, 0xe4, 0x66, 0xf9, 0x84,
-	0x8e, 0xba, 0xad, 0x14, 0xe1, 0x43, 0xce, 0x4c, 0x96, 0xe3, 0x0e, 0xb5, 0xfa, 0x99, 0x12, 0x0c,
-	0x1b, 0x1f, 0x0d, 0xfd, 0x44, 0xba, 0x22, 0xa9, 0x55, 0xdc, 0x2b, 0x31, 0xfa, 0xd3, 0xba, 0xe6,
-	0x28, 0x7f, 0xa5, 0xa7, 0xbb, 0x8b, 0x91, 0xde, 0xdd, 0x9b, 0x3a, 0x91, 0x29, 0x37, 0x9a, 0x2a,
-	0x50, 0x7a, 0xf6, 0xbb, 0x61, 0x3c, 0x43, 0x26, 0xe7, 0x95, 0xd7, 0xcc, 0x57, 0x3e, 0xb2, 0xbb,
-	0xcf, 0x1c, 0xb2, 0x2f, 0xd3, 0x21, 0x13, 0xf5, 0x03, 0x42, 0x9f, 0xf4, 0xe1, 0xeb, 0xcc, 0xec,
-	0x2f, 0x4a, 0x7d, 0x96, 0x09, 0x79, 0x2f, 0x54, 0x5b, 0xa1, 0xef, 0xb9, 0x9e, 0x2a, 0x68, 0xce,
-	0x0a, 0x93, 0xac, 0x8a, 0x36, 0xac, 0xa0, 0xe8, 0x36, 0xd4, 0x6e, 0xdd, 0x4e, 0xf8, 0x31, 0xa3,
-	0x38, 0xca, 0x28, 0xea, 0x74, 0x51, 0x19, 0x2d, 0xea, 0x1c, 0x13, 0x6b, 0x5e, 0xc8, 0x86, 0x41,
-	0xa6, 0x04, 0x65, 0x2e, 0x21, 0x3b, 0x66, 0x61, 0xda, 0x31, 0xc6, 0x02, 0x62, 0xff, 0xfb, 0x61,
-	0x38, 0x95, 0x77, 0x55, 0x12, 0x7a, 0x13, 0x06, 0x79, 0x1f, 0x8b, 0xb9, 0x8d, 0x2f, 0x8f, 0xc7,
-	0x25, 0x46, 0x50, 0x74, 0x8b, 0xfd, 0xc6, 0x82, 0xa7, 0xe0, 0xee, 0x3b, 0xeb, 0x62, 0x86, 0x1c,
-	0x0f, 0xf7, 0x25, 0x47, 0x73, 0x5f, 0x72, 0x38, 0x77, 0xdf, 0x59, 0x47, 0xbb, 0x50, 0xd9, 0xf4,
-	0x12, 0xe2, 0x08, 0xe7, 0xcc, 0xcd, 0x63, 0x61, 0x4e, 0x1c, 0x6e, 0xa5, 0xb1, 0x9f, 0x98, 0x33,
-	0x44, 0x5f, 0xb2, 0x60, 0x7c, 0x3d, 0x5d, 0x9f, 0x48, 0x08, 0x4f, 0xe7, 0x18, 0xae, 0xc3, 0x4a,
-	0x33, 0xe2, 0xd7, 0xe3, 0x66, 0x1a, 0x71, 0xb6, 0x3b, 0xe8, 0x93, 0x16, 0x0c, 0x6d, 0x78, 0xbe,
-	0x71, 0xdf, 0xc8, 0x31, 0x7c, 0x9c, 0x8b, 0x8c, 0x81, 0xde, 0x71, 0xf0, 0xff, 0x31, 0x96, 0x9c,
-	0x7b, 0x69, 0xaa, 0xc1, 0xa3, 0x6a, 0xaa, 0xa1, 0x07, 0xa4, 0xa9, 0x3e, 0x63, 0x41, 0x4d, 0x8d,
-	0xb4, 0xa8, 0xf3, 0xf2, 0xe1, 0x63, 0xfc, 0xe4, 0xdc, 0x23, 0xa5, 0xfe, 0x62, 0xcd, 0x1c, 0xbd,
-	0x65, 0xc1, 0xb0, 0xf3, 0x46, 0x3b, 0x22, 0x0d, 0xb2, 0x13, 0xb6, 0x62, 0x51, 0x80, 0xf5, 0xd5,
-	0xe2, 0x3b, 0x33, 0x4b, 0x99, 0x2c, 0x90, 0x9d, 0x95, 0x56, 0x2c, 0xf2, 0x9c, 0x75, 0x03, 0x36,
-	0xbb, 0x80, 0xbe, 0x4f, 0xeb, 0x71, 0x28, 0xa2, 0x0c, 0x77, 0x5e, 0x6f, 0xfa, 0x4a, 0xdb, 0x27,
-	0xf0, 0x98, 0x1b, 0x06, 0x89, 0x17, 0xb4, 0xc9, 0x4a, 0x80, 0x49, 0x2b, 0xbc, 0x16, 0x26, 0x17,
-	0xc3, 0x76, 0xd0, 0xb8, 0x10, 0x45, 0x61, 0xc4, 0x0a, 0xd9, 0x18, 0x97, 0xb0, 0xce, 0xf7, 0x46,
-	0xc5, 0xfb, 0xd1, 0x39, 0x8a, 0xcd, 0xb0, 0x57, 0x82, 0xa9, 0x03, 0x06, 0x1b, 0xbd, 0x08, 0x23,
-	0x61, 0xb4, 0xe9, 0x04, 0xde, 0x1b, 0x66, 0x6d, 0x36, 0x65, 0x90, 0xae, 0x18, 0x30, 0x9c, 0xc2,
-	0x34, 0x8b, 0xf6, 0x94, 0x0e, 0x28, 0xda, 0x73, 0x0e, 0x06, 0x22, 0xd2, 0x0a, 0xb3, 0xfb, 0x2a,
-	0x96, 0x9a, 0xc8, 0x20, 0xe8, 0x09, 0x28, 0x3b, 0x2d, 0x4f, 0x38, 0x17, 0xd5, 0x76, 0x71, 0x76,
-	0x75, 0x11, 0xd3, 0xf6, 0x54, 0x0d, 0xb1, 0xca, 0x7d, 0xa9, 0x21, 0x46, 0x35, 0xa6, 0x38, 0x3e,
-	0x1b, 0xd4, 0x1a, 0x33, 0x7d, 0xac, 0x65, 0xbf, 0x5d, 0x86, 0x27, 0xf6, 0x5d, 0x5a, 0x3a, 0x64,
-	0xdd, 0xda, 0x27, 0x64, 0x5d, 0x0e, 0x4f, 0xe9, 0xa0, 0xe1, 0x29, 0xf7, 0x18, 0x9e, 0x4f, 0x52,
-	0x89, 0x21, 0x6b, 0xda, 0x15, 0x73, 0x91, 0x7c, 0xaf, 0x12, 0x79, 0x42, 0x58, 0x48, 0x28, 0xd6,
-	0x7c, 0xe9, 0x76, 0x29, 0x55, 0xb0, 0xa6, 0x52, 0x84, 0xc6, 0xec, 0x59, 0x57, 0x8e, 0x8b, 0x89,
-	0x5e, 0x55, 0x70, 0xec, 0x5f, 0x1c, 0x80, 0xa7, 0xfa, 0x50, 0x74, 0xe6, 0x2c, 0xb6, 0xfa, 0x9c,
-	0xc5, 0x5f, 0xe3, 0x9f, 0xe9, 0xd3, 0xb9, 0x9f, 0x09, 0x17, 0xff, 0x99, 0xf6, 0xff, 0x42, 0xec,
-	0x04, 0x22, 0x88, 0x89, 0xdb, 0x8e, 0x78, 0xfa, 0x8e, 0x91, 0xb7, 0xbc, 0x28, 0xda, 0xb1, 0xc2,
-	0xa0, 0xdb, 0x5f, 0xd7, 0xa1, 0xcb, 0x7f, 0xa8, 0xa0, 0x02, 0x25, 0x66, 0x0a, 0x34, 0xb7, 0xbe,
-	0xe6, 0x67, 0xa9, 0x04, 0xe0, 0x6c, 0xec, 0x5f, 0xb3, 0xe0, 0x6c, 0x6f, 0x6b, 0x04, 0x3d, 0x0b,
-	0xc3, 0xeb, 0x2c, 0x98, 0x72, 0x99, 0x85, 0x4c, 0x89, 0xa9, 0xc3, 0xde, 0x57, 0x37, 0x63, 0x13,
-	0x07, 0xcd, 0xc3, 0x84, 0x19, 0x85, 0xb9, 0x6c, 0xc4, 0x5a, 0x31, 0x7f, 0xc9, 0x5a, 0x16, 0x88,
-	0xbb, 0xf1, 0xd1, 0x34, 0x40, 0xe2, 0x25, 0x3e, 0xe1, 0x4f, 0xf3, 0x89, 0xc6, 0x1c, 0x8a, 0x6b,
-	0xaa, 0x15, 0x1b, 0x18, 0xf6, 0x57, 0xcb, 0xf9, 0xaf, 0xc1, 0xad, 0xdc, 0xc3, 0xcc, 0x7e, 0x31,
-	0xb7, 0x4b, 0x7d, 0x48, 0xe8, 0xf2, 0xfd, 0x96, 0xd0, 0x03, 0xbd, 0x24, 0x34, 0x5a, 0x80, 0x13,
-	0xc6, 0xb5, 0xae, 0xbc, 0xc4, 0x0d, 0x3f, 0x94, 0x52, 0xf5, 0xe9, 0x56, 0x33, 0x70, 0xdc, 0xf5,
-	0xc4, 0x43, 0x3e, 0x55, 0x7f, 0xbd, 0x04, 0x67, 0x7a, 0x6e, 0x2c, 0xee, 0x93, 0x06, 0x32, 0x3f,
-	0xff, 0xc0, 0xfd, 0xf9, 0xfc, 0xe6, 0x47, 0xa9, 0x1c, 0xf8, 0x51, 0xfa, 0x51, 0xe7, 0xbf, 0x57,
-	0xea, 0xb9, 0x58, 0xe8, 0x46, 0xf4, 0xaf, 0xec, 0x48, 0xbe, 0x04, 0xa3, 0x4e, 0xab, 0xc5, 0xf1,
-	0x58, 0x66, 0x46, 0xa6, 0x66, 0xe6, 0xac, 0x09, 0xc4, 0x69, 0xdc, 0xbe, 0x06, 0xf6, 0x8f, 0x2c,
-	0xa8, 0x61, 0xb2, 0xc1, 0x25, 0x1c, 0xba, 0x25, 0x86, 0xc8, 0x2a, 0xe2, 0xe2, 0x02, 0x3a, 0xb0,
-	0xb1, 0xc7, 0xaa, 0xf9, 0xe7, 0x0d, 0xf6, 0x51, 0x2b, 0x30, 0xa8, 0xcb, 0x60, 0xcb, 0xbd, 0x2f,
-	0x83, 0xb5, 0x7f, 0xa9, 0x46, 0x5f, 0xaf, 0x15, 0xce, 0x47, 0xa4, 0x11, 0xd3, 0xef, 0xdb, 0x8e,
-	0x7c, 0x31, 0x49, 0xd4, 0xf7, 0xbd, 0x8e, 0x97, 0x30, 0x6d, 0x4f, 0x9d, 0x4f, 0x96, 0x0e, 0x55,
-	0x31, 0xb0, 0x7c, 0x60, 0xc5, 0xc0, 0x97, 0x60, 0x34, 0x8e, 0xb7, 0x56, 0x23, 0x6f, 0xc7, 0x49,
-	0xc8, 0x55, 0x22, 0x4b, 0x0b, 0xe9, 0xea, 0x59, 0xf5, 0xcb, 0x1a, 0x88, 0xd3, 0xb8, 0xe8, 0x12,
-	0x4c, 0xe8, 0xba, 0x7d, 0x24, 0x4a, 0x58, 0xca, 0x23, 0x9f, 0x09, 0xaa, 0x6c, 0x8c, 0xae, 0xf4,
-	0x27, 0x10, 0x70, 0xf7, 0x33, 0x54, 0xe6, 0xa6, 0x1a, 0x69, 0x47, 0x06, 0xd3, 0x32, 0x37, 0x45,
-	0x87, 0xf6, 0xa5, 0xeb, 0x09, 0xb4, 0x0c, 0x27, 0xf9, 0xc4, 0x98, 0x6d, 0xb5, 0x8c, 0x37, 0x1a,
-	0x4a, 0x57, 0x8b, 0xbf, 0xd4, 0x8d, 0x82, 0xf3, 0x9e, 0x43, 0x2f, 0xc0, 0xb0, 0x6a, 0x5e, 0x5c,
-	0x10, 0x47, 0x6b, 0xca, 0xb5, 0xa7, 0xc8, 0x2c, 0x36, 0xb0, 0x89, 0x87, 0x3e, 0x04, 0x8f, 0xea,
-	0xbf, 0x3c, 0x85, 0x9e, 0x9f, 0x37, 0x2f, 0x88, 0x92, 0xa8, 0xea, 0x32, 0xb2, 0x4b, 0xb9, 0x68,
-	0x0d, 0xdc, 0xeb, 0x79, 0xb4, 0x0e, 0x67, 0x15, 0xe8, 0x42, 0x90, 0xb0, 0x24, 0xd7, 0x98, 0xcc,
-	0x39, 0x31, 0x8b, 0x9c, 0x00, 0xf6, 0x9e, 0xb6, 0xa0, 0x7e, 0xf6, 0x92, 0x97, 0x5c, 0xce, 0xc3,
-	0xc4, 0x4b, 0x78, 0x1f, 0x2a, 0x68, 0x06, 0x6a, 0x24, 0x70, 0xd6, 0x7d, 0xb2, 0x32, 0xbf, 0x28,
-	0x76, 0xa4, 0x3a, 0x3b, 0x42, 0x02, 0xb0, 0xc6, 0x51, 0xf1, 0xfd, 0x23, 0xbd, 0xe2, 0xfb, 0xd1,
-	0x2a, 0x9c, 0xda, 0x74, 0x5b, 0xd4, 0xca, 0xf4, 0x5c, 0x32, 0xeb, 0xb2, 0x80, 0x62, 0xfa, 0x61,
-	0x78, 0x19, 0x7f, 0x95, 0x28, 0x75, 0x69, 0x7e, 0xb5, 0x0b, 0x07, 0xe7, 0x3e, 0xc9, 0x02, 0xcf,
-	0xa3, 0x70, 0xb7, 0x33, 0x79, 0x32, 0x13, 0x78, 0x4e, 0x1b, 0x31, 0x87, 0xa1, 0x2b, 0x80, 0x58,
-	0xb2, 0xe0, 0xe5, 0x24, 0x69, 0x29, 0xb3, 0x76, 0xf2, 0x54, 0xba, 0x40, 0xe2, 0xc5, 0x2e, 0x0c,
-	0x9c, 0xf3, 0x14, 0xb5, 0x7a, 0x82, 0x90, 0x51, 0x9f, 0x7c, 0x34, 0x6d, 0xf5, 0x5c, 0xe3, 0xcd,
-	0x58, 0xc2, 0xd1, 0x77, 0xc2, 0x64, 0x3b, 0x26, 0x6c, 0xc3, 0x7c, 0x33, 0x8c, 0xb6, 0xfd, 0xd0,
-	0x69, 0x2c, 0xb2, 0x5b, 0x67, 0x93, 0xce, 0xe4, 0x24, 0x63, 0x7e, 0x4e, 0x3c, 0x3b, 0x79, 0xbd,
-	0x07, 0x1e, 0xee, 0x49, 0x21, 0x5b, 0xe1, 0xf3, 0x4c, 0x9f, 0x15, 0x3e, 0x57, 0xe1, 0x94, 0xd4,
-	0x6b, 0x2b, 0xf3, 0x8b, 0xea, 0xa5, 0x27, 0xcf, 0xa6, 0xaf, 0xb1, 0x5b, 0xcc, 0xc1, 0xc1, 0xb9,
-	0x4f, 0xda, 0x7f, 0x68, 0xc1, 0xa8, 0x92, 0x60, 0xf7, 0x21, 0x69, 0xd9, 0x4f, 0x27, 0x2d, 0x5f,
-	0x3a, 0xba, 0x0e, 0x60, 0x3d, 0xef, 0x91, 0x62, 0xf3, 0x23, 0xa3, 0x00, 0x5a, 0x4f, 0x28, 0x15,
-	0x6d, 0xf5, 0x54, 0xd1, 0x0f, 0xad, 0x8c, 0xce, 0xab, 0xd8, 0x58, 0x79, 0xb0, 0x15, 0x1b, 0xeb,
-	0x70, 0x5a, 0x4e, 0x29, 0x7e, 0xa4, 0x7c, 0x39, 0x8c, 0x95, 0xc8, 0x37, 0xee, 0x25, 0x5c, 0xcc,
-	0x43, 0xc2, 0xf9, 0xcf, 0xa6, 0x6c, 0xbb, 0xa1, 0x03, 0x6d, 0x3b, 0x25, 0xe5, 0x96, 0x36, 0xe4,
-	0xad, 0xa1, 0x19, 0x29, 0xb7, 0x74, 0xb1, 0x8e, 0x35, 0x4e, 0xbe, 0xaa, 0xab, 0x15, 0xa4, 0xea,
-	0xe0, 0xd0, 0xaa, 0x4e, 0x0a, 0xdd, 0xe1, 0x9e, 0x42, 0x57, 0x1e, 0x5d, 0x8d, 0xf4, 0x3c, 0xba,
-	0xfa, 0x20, 0x8c, 0x79, 0xc1, 0x16, 0x89, 0xbc, 0x84, 0x34, 0xd8, 0x5a, 0x60, 0x02, 0xb9, 0xaa,
-	0x0d, 0x9d, 0xc5, 0x14, 0x14, 0x67, 0xb0, 0xd3, 0x9a, 0x62, 0xac, 0x0f, 0x4d, 0xd1, 0x43, 0x3f,
-	0x8f, 0x17, 0xa3, 0x9f, 0x4f, 0x1c, 0x5d, 0x3f, 0x4f, 0x1c, 0xab, 0x7e, 0x46, 0x85, 0xe8, 0xe7,
-	0xbe, 0x54, 0x9f, 0xb1, 0x49, 0x3f, 0x75, 0xc0, 0x26, 0xbd, 0x97, 0x72, 0x3e, 0x7d, 0xcf, 0xca,
-	0x39, 0x5f, 0xef, 0x3e, 0xf2, 0x8e, 0xde, 0x2d, 0x44, 0xef, 0x7e, 0xa6, 0x04, 0xa7, 0xb5, 0x66,
-	0xa2, 0xf2, 0xc0, 0xdb, 0xa0, 0xb2, 0x99, 0x5d, 0xc5, 0xcd, 0x0f, 0xbc, 0x8d, 0x54, 0x79, 0x5d,
-	0x2c, 0x40, 0x41, 0xb0, 0x81, 0xc5, 0x32, 0xce, 0x49, 0xc4, 0x2e, 0x81, 0xc9, 0xaa, 0xad, 0x79,
-	0xd1, 0x8e, 0x15, 0x06, 0x1d, 0x04, 0xfa, 0x5b, 0x14, 0x3c, 0xc9, 0x96, 0x17, 0x9f, 0xd7, 0x20,
-	0x6c, 0xe2, 0xa1, 0xf7, 0x72, 0x26, 0x4c, 0x64, 0x52, 0xd5, 0x35, 0xc2, 0xb7, 0x95, 0x4a, 0x4a,
-	0x2a, 0xa8, 0xec, 0x0e, 0xab, 0x88, 0x50, 0xe9, 0xee, 0x0e, 0x8b, 0x1d, 0x55, 0x18, 0xf6, 0xff,
-	0xb2, 0xe0, 0x4c, 0xee, 0x50, 0xdc, 0x07, 0x73, 0x64, 0x37, 0x6d, 0x8e, 0xd4, 0x8b, 0xda, 0x92,
-	0x1a, 0x6f, 0xd1, 0xc3, 0x34, 0xf9, 0x4f, 0x16, 0x8c, 0x69, 0xfc, 0xfb, 0xf0, 0xaa, 0x5e, 0xfa,
-	0x55, 0x8b, 0xdb, 0x7d, 0xd7, 0xba, 0xde, 0xed, 0x57, 0x4b, 0xa0, 0x4a, 0xfe, 0xcf, 0xba, 0x49,
-	0x7f, 0xe9, 0x66, 0x1d, 0x18, 0x64, 0x11, 0x24, 0x71, 0x31, 0xd1, 0x71, 0x69, 0xfe, 0x2c, 0x1a,
-	0x45, 0x1f, 0xe8, 0xb1, 0xbf, 0x31, 0x16, 0x0c, 0xd9, 0x15, 0x45, 0xbc, 0x9a, 0x7a, 0x43, 0x24,
-	0x4e, 0xeb, 0x2b, 0x8a, 0x44, 0x3b, 0x56, 0x18, 0x54, 0x61, 0x7a, 0x6e, 0x18, 0xcc, 0xfb, 0x4e,
-	0x1c, 0x0b, 0x1b, 0x4e, 0x29, 0xcc, 0x45, 0x09, 0xc0, 0x1a, 0x87, 0x05, 0x97, 0x78, 0x71, 0xcb,
-	0x77, 0x3a, 0x86, 0x8f, 0xc5, 0x28, 0xec, 0xa5, 0x40, 0xd8, 0xc4, 0xb3, 0x9b, 0x30, 0x99, 0x7e,
-	0x89, 0x05, 0xb2, 0xc1, 0x22, 0xbb, 0xfb, 0x1a, 0xce, 0x19, 0xa8, 0x39, 0xec, 0xa9, 0xa5, 0xb6,
-	0x23, 0x64, 0x82, 0x8e, 0x6f, 0x96, 0x00, 0xac, 0x71, 0xec, 0x7f, 0x66, 0xc1, 0xc9, 0x9c, 0x41,
-	0x2b, 0x30, 0x31, 0x3d, 0xd1, 0xd2, 0x26, 0xcf, 0xd4, 0xf9, 0x06, 0x18, 0x6a, 0x90, 0x0d, 0x47,
-	0xc6, 0x0e, 0x9b, 0xa9, 0x06, 0xbc, 0x19, 0x4b, 0xb8, 0xfd, 0x0b, 0x25, 0x18, 0x4f, 0xf7, 0x35,
-	0x66, 0xe9, 0x96, 0x7c, 0x98, 0xbc, 0xd8, 0x0d, 0x77, 0x48, 0xd4, 0xa1, 0x6f, 0x6e, 0x65, 0xd2,
-	0x2d, 0xbb, 0x30, 0x70, 0xce, 0x53, 0xec, 0xc2, 0x8f, 0x86, 0x1a, 0x6d, 0x39, 0x23, 0x6f, 0x14,
-	0x39, 0x23, 0xf5, 0xc7, 0x34, 0xe3, 0x8c, 0x14, 0x4b, 0x6c, 0xf2, 0xa7, 0x26, 0x17, 0x4b, 0x16,
-	0x99, 0x6b, 0x7b, 0x7e, 0xe2, 0x05, 0xe2, 0x95, 0xc5, 0x5c, 0x55, 0x26, 0xd7, 0x72, 0x37, 0x0a,
-	0xce, 0x7b, 0xce, 0xfe, 0xca, 0x00, 0xa8, 0xa2, 0x2b, 0x2c, 0x0e, 0xb4, 0xa0, 0x28, 0xda, 0xc3,
-	0x26, 0xed, 0xaa, 0xb9, 0x35, 0xb0, 0x5f, 0x60, 0x16, 0x77, 0xcc, 0x99, 0x1e, 0x7c, 0x35, 0x60,
-	0x6b, 0x1a, 0x84, 0x4d, 0x3c, 0xda, 0x13, 0xdf, 0xdb, 0x21, 0xfc, 0xa1, 0xc1, 0x74, 0x4f, 0x96,
-	0x24, 0x00, 0x6b, 0x1c, 0x56, 0xdf, 0xdb, 0xdb, 0xd8, 0x10, 0x5e, 0x26, 0x5d, 0xdf, 0xdb, 0xdb,
-	0xd8, 0xc0, 0x0c, 0xc2, 0xaf, 0x84, 0x0a, 0xb7, 0xc5, 0x36, 0xc3, 0xb8, 0x12, 0x2a, 0xdc, 0xc6,
-	0x0c, 0x42, 0xbf, 0x52, 0x10, 0x46, 0x4d, 0xc7, 0xf7, 0xde, 0x20, 0x0d, 0xc5, 0x45, 0x6c, 0x2f,
-	0xd4, 0x57, 0xba, 0xd6, 0x8d, 0x82, 0xf3, 0x9e, 0xa3, 0x13, 0xba, 0x15, 0x91, 0x86, 0xe7, 0x26,
-	0x26, 0x35, 0x48, 0x4f, 0xe8, 0xd5, 0x2e, 0x0c, 0x9c, 0xf3, 0x14, 0x9a, 0x85, 0x71, 0x59, 0x34,
-	0x47, 0x16, 0x9a, 0x1c, 0x4e, 0x57, 0xab, 0xc3, 0x69, 0x30, 0xce, 0xe2, 0x53, 0x21, 0xd9, 0x14,
-	0x65, 0x72, 0xd9, 0x6e, 0xc4, 0x10, 0x92, 0xb2, 0x7c, 0x2e, 0x56, 0x18, 0xf6, 0x27, 0xca, 0x54,
-	0xa9, 0xf7, 0xa8, 0x46, 0x7d, 0xdf, 0xa2, 0xb6, 0xd3, 0x33, 0x72, 0xa0, 0x8f, 0x19, 0xf9, 0x3c,
-	0x8c, 0xdc, 0x8a, 0xc3, 0x40, 0x45, 0x44, 0x57, 0x7a, 0x46, 0x44, 0x1b, 0x58, 0xf9, 0x11, 0xd1,
-	0x83, 0x45, 0x45, 0x44, 0x0f, 0xdd, 0x63, 0x44, 0xf4, 0xbf, 0xa9, 0x80, 0xba, 0xf3, 0xf3, 0x1a,
-	0x49, 0x6e, 0x87, 0xd1, 0xb6, 0x17, 0x6c, 0xb2, 0x02, 0x30, 0x5f, 0xb2, 0x64, 0x0d, 0x99, 0x25,
-	0x33, 0x53, 0x78, 0xa3, 0xa0, 0x7b, 0x1b, 0x53, 0xcc, 0xa6, 0xd7, 0x0c, 0x46, 0x3c, 0xb2, 0x26,
-	0x53, 0xab, 0x46, 0x1c, 0x1a, 0xa4, 0x7a, 0x84, 0xbe, 0x1b, 0x40, 0xba, 0xe4, 0x37, 0xa4, 0x04,
-	0x5e, 0x2c, 0xa6, 0x7f, 0x98, 0x6c, 0x68, 0x93, 0x7a, 0x4d, 0x31, 0xc1, 0x06, 0x43, 0xf4, 0x19,
-	0x9d, 0x45, 0xcd, 0x53, 0xa7, 0x3e, 0x7a, 0x2c, 0x63, 0xd3, 0x4f, 0x0e, 0x35, 0x86, 0x21, 0x2f,
-	0xd8, 0xa4, 0xf3, 0x44, 0x44, 0x8e, 0xbe, 0x27, 0xaf, 0xbe, 0xd8, 0x52, 0xe8, 0x34, 0xe6, 0x1c,
-	0xdf, 0x09, 0x5c, 0x12, 0x2d, 0x72, 0x74, 0xad, 0x41, 0x45, 0x03, 0x96, 0x84, 0xba, 0x2e, 0x26,
-	0xad, 0xf4, 0x73, 0x31, 0xe9, 0xd9, 0x6f, 0x83, 0x89, 0xae, 0x8f, 0x79, 0xa8, 0x94, 0xe9, 0x23,
-	0x54, 0x16, 0xfb, 0xc5, 0x41, 0xad, 0xb4, 0xae, 0x85, 0x0d, 0x7e, 0xcf, 0x65, 0xa4, 0xbf, 0xa8,
-	0x30, 0x99, 0x0b, 0x9c, 0x22, 0x4a, 0xcd, 0x18, 0x8d, 0xd8, 0x64, 0x49, 0xe7, 0x68, 0xcb, 0x89,
-	0x48, 0x70, 0xdc, 0x73, 0x74, 0x55, 0x31, 0xc1, 0x06, 0x43, 0xb4, 0x95, 0xca, 0xed, 0xbb, 0x78,
-	0xf4, 0xdc, 0x3e, 0x56, 0xed, 0x35, 0xef, 0x3a, 0xb8, 0xb7, 0x2c, 0x18, 0x0b, 0x52, 0x33, 0xb7,
-	0x98, 0x70, 0xfe, 0xfc, 0x55, 0xc1, 0xaf, 0x8c, 0x4e, 0xb7, 0xe1, 0x0c, 0xff, 0x3c, 0x95, 0x56,
-	0x39, 0xa4, 0x4a, 0xd3, 0xf7, 0xec, 0x0e, 0xf6, 0xba, 0x67, 0x17, 0x05, 0xea, 0x02, 0xf4, 0xa1,
-	0x22, 0x2a, 0xa4, 0xa4, 0x6e, 0x3f, 0x87, 0x9c, 0x9b, 0xcf, 0x6f, 0x9a, 0xa9, 0xbf, 0x87, 0xbf,
-	0x08, 0x7b, 0xb4, 0x57, 0x8a, 0xb0, 0xfd, 0x7f, 0x07, 0xe0, 0x84, 0x1c, 0x11, 0x99, 0x0a, 0x44,
-	0xf5, 0x23, 0xe7, 0xab, 0x6d, 0x65, 0xa5, 0x1f, 0x2f, 0x4b, 0x00, 0xd6, 0x38, 0xd4, 0x1e, 0x6b,
-	0xc7, 0x64, 0xa5, 0x45, 0x82, 0x25, 0x6f, 0x3d, 0x16, 0xc7, 0xef, 0x6a, 0xa1, 0x5c, 0xd7, 0x20,
-	0x6c, 0xe2, 0xb1, 0xfc, 0x64, 0xd7, 0x2c, 0x12, 0xa2, 0xf3, 0x93, 0x85, 0xa1, 0x2a, 0xe1, 0xe8,
-	0x47, 0x73, 0xaf, 0xc7, 0x28, 0x26, 0x81, 0xb6, 0x2b, 0x03, 0xea, 0x70, 0xf7, 0x62, 0xa0, 0x7f,
-	0x64, 0xc1, 0x69, 0xde, 0x2a, 0x47, 0xf2, 0x7a, 0xab, 0xe1, 0x24, 0x24, 0x2e, 0xe6, 0x2a, 0xb1,
-	0x9c, 0xfe, 0x69, 0x2f, 0x7a, 0x1e, 0x5b, 0x9c, 0xdf, 0x1b, 0xf4, 0x45, 0x0b, 0xc6, 0xb7, 0x53,
-	0x45, 0xbe, 0xa4, 0xea, 0x38, 0x6a, 0x05, 0x9c, 0x14, 0x51, 0xbd, 0xd4, 0xd2, 0xed, 0x31, 0xce,
-	0x72, 0xb7, 0xff, 0xdc, 0x02, 0x53, 0x8c, 0xde, 0xff, 0xda, 0x60, 0x87, 0x37, 0x05, 0xa5, 0x75,
-	0x59, 0xe9, 0x69, 0x5d, 0x3e, 0x01, 0xe5, 0xb6, 0xd7, 0x10, 0xfb, 0x0b, 0x7d, 0xe0, 0xbf, 0xb8,
-	0x80, 0x69, 0xbb, 0xfd, 0xc7, 0x15, 0xed, 0x06, 0x11, 0xf9, 0xa9, 0x7f, 0x25, 0x5e, 0x7b, 0x43,
-	0x15, 0xfd, 0xe5, 0x6f, 0x7e, 0xad, 0xab, 0xe8, 0xef, 0xb7, 0x1c, 0x3e, 0xfd, 0x98, 0x0f, 0x50,
-	0xaf, 0x9a, 0xbf, 0x43, 0x07, 0xe4, 0x1e, 0xdf, 0x82, 0x2a, 0xdd, 0x82, 0x31, 0x7f, 0x66, 0x35,
-	0xd5, 0xa9, 0xea, 0x65, 0xd1, 0x7e, 0x77, 0x6f, 0xea, 0x9b, 0x0f, 0xdf, 0x2d, 0xf9, 0x34, 0x56,
-	0xf4, 0x51, 0x0c, 0x35, 0xfa, 0x9b, 0xa5, 0x49, 0x8b, 0xcd, 0xdd, 0x75, 0x25, 0x33, 0x25, 0xa0,
-	0x90, 0x1c, 0x6c, 0xcd, 0x07, 0x05, 0x50, 0xa3, 0x88, 0x9c, 0x29, 0xdf, 0x03, 0xae, 0xaa, 0x64,
-	0x65, 0x09, 0xb8, 0xbb, 0x37, 0xf5, 0xd2, 0xe1, 0x99, 0xaa, 0xc7, 0xb1, 0x66, 0x61, 0xa8, 0xc6,
-	0xe1, 0x9e, 0x57, 0xd0, 0xff, 0xbf, 0x01, 0x3d, 0xbf, 0x45, 0x3d, 0xe8, 0xbf, 0x12, 0xf3, 0xfb,
-	0xc5, 0xcc, 0xfc, 0x3e, 0xd7, 0x35, 0xbf, 0xc7, 0xe8, 0x98, 0xe5, 0x54, 0xa9, 0xbe, 0xdf, 0xc6,
-	0xc2, 0xc1, 0x3e, 0x09, 0x66, 0x25, 0xbd, 0xde, 0xf6, 0x22, 0x12, 0xaf, 0x46, 0xed, 0xc0, 0x0b,
-	0x36, 0xd9, 0x94, 0xad, 0x9a, 0x56, 0x52, 0x0a, 0x8c, 0xb3, 0xf8, 0x74, 0xe3, 0x4f, 0xe7, 0xc5,
-	0x4d, 0x67, 0x87, 0xcf, 0x3c, 0xa3, 0x16, 0x67, 0x5d, 0xb4, 0x63, 0x85, 0x81, 0xb6, 0xe0, 0x71,
-	0x49, 0x60, 0x81, 0xf8, 0x84, 0xbe, 0x10, 0x0b, 0x64, 0x8c, 0x9a, 0x3c, 0xcd, 0x80, 0xc7, 0xa2,
-	0xbc, 0x5b, 0x50, 0x78, 0x1c, 0xef, 0x83, 0x8b, 0xf7, 0xa5, 0x64, 0x7f, 0x99, 0x85, 0x2e, 0x18,
-	0xd5, 0x22, 0xe8, 0xec, 0xf3, 0xbd, 0xa6, 0x27, 0x4b, 0x86, 0xaa, 0xd9, 0xb7, 0x44, 0x1b, 0x31,
-	0x87, 0xa1, 0xdb, 0x30, 0xb4, 0xce, 0xaf, 0xa6, 0x2f, 0xe6, 0x4a, 0x28, 0x71, 0xcf, 0x3d, 0x2b,
-	0x17, 0x2e, 0x2f, 0xbd, 0xbf, 0xab, 0x7f, 0x62, 0xc9, 0xcd, 0xfe, 0x9d, 0x0a, 0x8c, 0xcb, 0xf0,
-	0xb2, 0xcb, 0x5e, 0xcc, 0x22, 0x12, 0xcc, 0x3b, 0x14, 0x4a, 0x07, 0xde, 0xa1, 0xf0, 0x11, 0x80,
-	0x06, 0x69, 0xf9, 0x61, 0x87, 0x19, 0x87, 0x03, 0x87, 0x36, 0x0e, 0xd5, 0x7e, 0x62, 0x41, 0x51,
-	0xc1, 0x06, 0x45, 0x51, 0x27, 0x95, 0x5f, 0xc9, 0x90, 0xa9, 0x93, 0x6a, 0x5c, 0x1c, 0x37, 0x78,
-	0x7f, 0x2f, 0x8e, 0xf3, 0x60, 0x9c, 0x77, 0x51, 0xd5, 0x64, 0xb8, 0x87, 0xd2, 0x0b, 0x2c, 0xab,
-	0x6d, 0x21, 0x4d, 0x06, 0x67, 0xe9, 0x9a, 0xb7, 0xc2, 0x55, 0xef, 0xf7, 0xad, 0x70, 0xef, 0x83,
-	0x9a, 0xfc, 0xce, 0xf1, 0x64, 0x4d, 0xd7, 0x0b, 0x92, 0xd3, 0x20, 0xc6, 0x1a, 0xde, 0x55, 0x5e,
-	0x06, 0x1e, 0x54, 0x79, 0x19, 0xfb, 0xad, 0x32, 0xdd, 0x55, 0xf0, 0x7e, 0x1d, 0xfa, 0x52, 0xc5,
-	0xcb, 0xc6, 0xa5, 0x8a, 0x87, 0xfb, 0x9e, 0xd5, 0xcc, 0xe5, 0x8b, 0x8f, 0xc3, 0x40, 0xe2, 0x6c,
-	0xca, 0x24, 0x5c, 0x06, 0x5d, 0x73, 0x36, 0x63, 0xcc, 0x5a, 0x0f, 0x53, 0x56, 0xfa, 0x25, 0x18,
-	0x8d, 0xbd, 0xcd, 0xc0, 0x49, 0xda, 0x11, 0x31, 0xce, 0x2f, 0x75, 0x90, 0x8e, 0x09, 0xc4, 0x69,
-	0x5c, 0xf4, 0x49, 0x0b, 0x20, 0x22, 0x6a, 0xcf, 0x32, 0x58, 0xc4, 0x1c, 0x52, 0x62, 0x40, 0xd2,
-	0x35, 0xcb, 0x82, 0xa8, 0xbd, 0x8a, 0xc1, 0xd6, 0xfe, 0xb4, 0x05, 0x13, 0x5d, 0x4f, 0xa1, 0x16,
-	0x0c, 0xba, 0xec, 0xea, 0xcb, 0x62, 0x4a, 0x61, 0xa6, 0xaf, 0xd1, 0xe4, 0xca, 0x89, 0xb7, 0x61,
-	0xc1, 0xc7, 0xfe, 0xa5, 0x11, 0x38, 0x55, 0x9f, 0x5f, 0x96, 0x17, 0x21, 0x1d, 0x5b, 0x56, 0x71,
-	0x1e, 0x8f, 0xfb, 0x97, 0x55, 0xdc, 0x83, 0xbb, 0x6f, 0x64, 0x15, 0xfb, 0x46, 0x56, 0x71, 0x3a,
-	0xc5, 0xb3, 0x5c, 0x44, 0x8a, 0x67, 0x5e, 0x0f, 0xfa, 0x49, 0xf1, 0x3c, 0xb6, 0x34, 0xe3, 0x7d,
-	0x3b, 0x74, 0xa8, 0x34, 0x63, 0x95, 0x83, 0x5d, 0x48, 0x46, 0x59, 0x8f, 0x4f, 0x95, 0x9b, 0x83,
-	0xad, 0xf2, 0x5f, 0x79, 0xb6, 0xa4, 0x50, 0x7a, 0xaf, 0x16, 0xdf, 0x81, 0x3e, 0xf2, 0x5f, 0x45,
-	0xc2, 0xa6, 0x99, 0x73, 0x3d, 0x54, 0x44, 0xce, 0x75, 0x5e, 0x77, 0x0e, 0xcc, 0xb9, 0x7e, 0x09,
-	0x46, 0x5d, 0x3f, 0x0c, 0xc8, 0x6a, 0x14, 0x26, 0xa1, 0x1b, 0xca, 0x8b, 0xc6, 0xf5, 0x9d, 0x91,
-	0x26, 0x10, 0xa7, 0x71, 0x7b, 0x25, 0x6c, 0xd7, 0x8e, 0x9a, 0xb0, 0x0d, 0x0f, 0x28, 0x61, 0xdb,
-	0x48, 0x49, 0x1e, 0x2e, 0x22, 0x25, 0x39, 0xef, 0x8b, 0xf4, 0x95, 0x92, 0xfc, 0x36, 0xbf, 0x67,
-	0x9f, 0x6e, 0x46, 0xb8, 0x14, 0x66, 0x47, 0x74, 0xc3, 0xe7, 0x5f, 0x3b, 0x86, 0x09, 0x7b, 0xb3,
-	0xae, 0xd9, 0xa8, 0xbb, 0xf7, 0x75, 0x13, 0x4e, 0x77, 0xe4, 0x28, 0x69, 0xcc, 0x3f, 0x56, 0x82,
-	0xaf, 0x3b, 0xb0, 0x0b, 0xe8, 0x36, 0x40, 0xe2, 0x6c, 0x8a, 0x89, 0x2a, 0x0e, 0xb2, 0x8e, 0x18,
-	0x57, 0xbc, 0x26, 0xe9, 0x89, 0x14, 0x3b, 0x45, 0x1e, 0x1b, 0xac, 0x58, 0x38, 0x71, 0xe8, 0x77,
-	0x55, 0xb1, 0xc6, 0xa1, 0x4f, 0x30, 0x83, 0x50, 0x43, 0x28, 0x22, 0x9b, 0xd4, 0xb8, 0x2f, 0xa7,
-	0x0d, 0x21, 0xcc, 0x5a, 0xb1, 0x80, 0xa2, 0x17, 0x60, 0xd8, 0xf1, 0x7d, 0x9e, 0xee, 0x47, 0x62,
-	0x71, 0x99, 0xab, 0xae, 0x5d, 0xab, 0x41, 0xd8, 0xc4, 0xb3, 0xff, 0xac, 0x04, 0x53, 0x07, 0xc8,
-	0x94, 0xae, 0x34, 0xef, 0x4a, 0xdf, 0x69, 0xde, 0x22, 0x5d, 0x69, 0xb0, 0x47, 0xba, 0xd2, 0x0b,
-	0x30, 0x9c, 0x10, 0xa7, 0x29, 0x22, 0x11, 0xb3, 0x25, 0x19, 0xd7, 0x34, 0x08, 0x9b, 0x78, 0x54,
-	0x8a, 0x8d, 0x39, 0xae, 0x4b, 0xe2, 0x58, 0xe6, 0x23, 0x09, 0x2f, 0x77, 0x61, 0xc9, 0x4e, 0xec,
-	0xf0, 0x60, 0x36, 0xc5, 0x02, 0x67, 0x58, 0x66, 0x07, 0xbc, 0xd6, 0xe7, 0x80, 0xff, 0x64, 0x09,
-	0x9e, 0xd8, 0x57, 0xbb, 0xf5, 0x9d, 0x2a, 0xd6, 0x8e, 0x49, 0x94, 0x9d, 0x38, 0xd7, 0x63, 0x12,
-	0x61, 0x06, 0xe1, 0xa3, 0xd4, 0x6a, 0xa9, 0x28, 0xf2, 0xe2, 0x73, 0x2b, 0xf9, 0x28, 0xa5, 0x58,
-	0xe0, 0x0c, 0xcb, 0x7b, 0x9d, 0x96, 0xbf, 0x33, 0x00, 0x4f, 0xf5, 0x61, 0x03, 0x14, 0x98, 0x83,
-	0x9a, 0xce, 0xaf, 0x2e, 0x3f, 0xa0, 0xfc, 0xea, 0x7b, 0x1b, 0xae, 0x77, 0xd2, 0xb2, 0xfb, 0xca,
-	0x75, 0xfd, 0x72, 0x09, 0xce, 0xf6, 0x36, 0x58, 0xd0, 0xb7, 0xc2, 0x78, 0xa4, 0x42, 0x12, 0xcd,
-	0xd4, 0xec, 0x93, 0xdc, 0xc7, 0x95, 0x02, 0xe1, 0x2c, 0x2e, 0x9a, 0x06, 0x68, 0x39, 0xc9, 0x56,
-	0x7c, 0x61, 0xd7, 0x8b, 0x13, 0x51, 0xcb, 0x6e, 0x8c, 0x9f, 0xbc, 0xca, 0x56, 0x6c, 0x60, 0x50,
-	0x76, 0xec, 0xdf, 0x42, 0x78, 0x2d, 0x4c, 0xf8, 0x43, 0x7c, 0xeb, 0x79, 0x52, 0xde, 0xfc, 0x68,
-	0x80, 0x70, 0x16, 0x97, 0xb2, 0x63, 0x67, 0xfb, 0xbc, 0xa3, 0x03, 0x3a, 0x99, 0x7b, 0x49, 0xb5,
-	0x62, 0x03, 0x23, 0x9b, 0x74, 0x5e, 0x39, 0x38, 0xe9, 0xdc, 0xfe, 0x57, 0x25, 0x38, 0xd3, 0xd3,
-	0xe0, 0xed, 0x4f, 0x4c, 0x3d, 0x7c, 0x89, 0xdf, 0xf7, 0xb8, 0xc2, 0x0e, 0x95, 0x30, 0x6c, 0xff,
-	0x51, 0x8f, 0x99, 0x26, 0x92, 0x81, 0xef, 0xbd, 0x6e, 0xca, 0xc3, 0x37, 0x9e, 0x5d, 0xf9, 0xbf,
-	0x03, 0x87, 0xc8, 0xff, 0xcd, 0x7c, 0x8c, 0x4a, 0x9f, 0xda, 0xe1, 0xbf, 0x0d, 0xf4, 0x1c, 0x5e,
-	0xba, 0x41, 0xee, 0xeb, 0x04, 0x61, 0x01, 0x4e, 0x78, 0x01, 0xbb, 0xcb, 0xb7, 0xde, 0x5e, 0x17,
-	0xe5, 0xcd, 0x78, 0x0d, 0x5f, 0x95, 0x7d, 0xb3, 0x98, 0x81, 0xe3, 0xae, 0x27, 0x1e, 0xc2, 0x7c,
-	0xec, 0x7b, 0x1b, 0xd2, 0x43, 0x4a, 0xee, 0x15, 0x38, 0x2d, 0x87, 0x62, 0xcb, 0x89, 0x48, 0x43,
-	0x28, 0xdb, 0x58, 0xe4, 0x5b, 0x9d, 0xe1, 0x39, 0x5b, 0x39, 0x08, 0x38, 0xff, 0x39, 0x76, 0xf1,
-	0x6a, 0xd8, 0xf2, 0x5c, 0xb1, 0x15, 0xd4, 0x17, 0xaf, 0xd2, 0x46, 0xcc, 0x61, 0x5a, 0x5f, 0xd4,
-	0xee, 0x8f, 0xbe, 0xf8, 0x08, 0xd4, 0xd4, 0x78, 0xf3, 0x9c, 0x0a, 0x35, 0xc9, 0xbb, 0x72, 0x2a,
-	0xd4, 0x0c, 0x37, 0xb0, 0xe8, 0xec, 0xa0, 0x1b, 0x95, 0xcc, 0x6a, 0xa5, 0xfc, 0x68, 0xbb, 0xfd,
-	0x1c, 0x8c, 0x28, 0x5f, 0x60, 0xbf, 0xd7, 0xdf, 0xda, 0x7f, 0x59, 0x82, 0xcc, 0x4d, 0x6f, 0x68,
-	0x17, 0x6a, 0x8d, 0xa8, 0xc3, 0x1b, 0x8b, 0xa9, 0x21, 0xbd, 0x20, 0xc9, 0xe9, 0x83, 0x30, 0xd5,
-	0x84, 0x35, 0x33, 0xf4, 0x26, 0x2f, 0xd7, 0x2c, 0x58, 0x97, 0x8a, 0xc8, 0xc9, 0xaf, 0x2b, 0x7a,
-	0xe6, 0xfd, 0x96, 0xb2, 0x0d, 0x1b, 0xfc, 0x50, 0x02, 0xb5, 0x2d, 0x79, 0xa3, 0x5d, 0x31, 0xe2,
-	0x4e, 0x5d, 0x90, 0xc7, 0x4d, 0x34, 0xf5, 0x17, 0x6b, 0x46, 0xf6, 0x1f, 0x96, 0xe0, 0x54, 0xfa,
-	0x03, 0x88, 0x83, 0xcb, 0x9f, 0xb6, 0xe0, 0x51, 0xdf, 0x89, 0x93, 0x7a, 0x9b, 0x6d, 0x14, 0x36,
-	0xda, 0xfe, 0x4a, 0xa6, 0xb2, 0xf7, 0x51, 0x9d, 0x2d, 0x8a, 0x70, 0xf6, 0x06, 0xc4, 0xb9, 0xc7,
-	0xee, 0xec, 0x4d, 0x3d, 0xba, 0x94, 0xcf, 0x1c, 0xf7, 0xea, 0x15, 0x7a, 0xcb, 0x82, 0x13, 0x6e,
-	0x3b, 0x8a, 0x48, 0x90, 0xe8, 0xae, 0xf2, 0xaf, 0x78, 0xad, 0x90, 0x81, 0xd4, 0x1d, 0x3c, 0x45,
-	0x05, 0xea, 0x7c, 0x86, 0x17, 0xee, 0xe2, 0x6e, 0xff, 0x20, 0xd5, 0x9c, 0x3d, 0xdf, 0xf3, 0xaf,
-	0xd9, 0x95, 0x8d, 0x7f, 0x32, 0x08, 0xa3, 0xa9, 0xf2, 0xe5, 0xa9, 0xc3, 0x3e, 0xeb, 0xc0, 0xc3,
-	0x3e, 0x96, 0x21, 0xd8, 0x0e, 0xe4, 0x6d, 0xf6, 0x46, 0x86, 0x60, 0x3b, 0x20, 0x98, 0xc3, 0xc4,
-	0x90, 0xe2, 0x76, 0x20, 0x72, 0x01, 0xcc, 0x21, 0xc5, 0xed, 0x00, 0x0b, 0x28, 0xfa, 0xb8, 0x05,
-	0x23, 0x6c, 0xf1, 0x89, 0xa3, 0x52, 0xa1, 0xd0, 0xae, 0x14, 0xb0, 0xdc, 0x65, 0xa9, 0x7e, 0x16,
-	0x3b, 0x6a, 0xb6, 0xe0, 0x14, 0x47, 0xf4, 0x29, 0x0b, 0x6a, 0xea, 0xea, 0x5c, 0x71, 0x36, 0x52,
-	0x2f, 0xb6, 0x3a, 0x7c, 0x46, 0xea, 0xa9, 0x32, 0xdd, 0x58, 0x33, 0x46, 0xb1, 0x3a, 0xc7, 0x1c,
-	0x3a, 0x9e, 0x73, 0x4c, 0xc8, 0x39, 0xc3, 0x7c, 0x1f, 0xd4, 0x9a, 0x4e, 0xe0, 0x6d, 0x90, 0x38,
-	0xe1, 0x47, 0x8b, 0xf2, 0x32, 0x10, 0xd9, 0x88, 0x35, 0x9c, 0x1a, 0xfb, 0x31, 0x7b, 0xb1, 0xc4,
-	0x38, 0x0b, 0x64, 0xc6, 0x7e, 0x5d, 0x37, 0x63, 0x13, 0xc7, 0x3c, 0xb8, 0x84, 0x07, 0x7a, 0x70,
-	0x39, 0x7c, 0xc0, 0xc1, 0x65, 0x1d, 0x4e, 0x3b, 0xed, 0x24, 0xbc, 0x4c, 0x1c, 0x7f, 0x36, 0x49,
-	0x48, 0xb3, 0x95, 0xc4, 0xbc, 0xe2, 0xfd, 0x08, 0x73, 0x01, 0xab, 0x68, 0xb7, 0x3a, 0xf1, 0x37,
-	0xba, 0x90, 0x70, 0xfe, 0xb3, 0xf6, 0xbf, 0xb0, 0xe0, 0x74, 0xee, 0x54, 0x78, 0x78, 0xf3, 0x0c,
-	0xec, 0x1f, 0xae, 0xc0, 0xc9, 0x9c, 0xcb, 0x0d, 0x50, 0xc7, 0x5c, 0x24, 0x56, 0x11, 0x21, 0x7b,
-	0xe9, 0x08, 0x34, 0xf9, 0x6d, 0x72, 0x56, 0xc6, 0xe1, 0x62, 0x11, 0x74, 0x3c, 0x40, 0xf9, 0xfe,
-	0xc6, 0x03, 0x18, 0x73, 0x7d, 0xe0, 0x81, 0xce, 0xf5, 0xca, 0x01, 0x73, 0xfd, 0x67, 0x2c, 0x98,
-	0x6c, 0xf6, 0xb8, 0xa9, 0x4c, 0x9c, 0x27, 0xdd, 0x38, 0x9e, 0x7b, 0xd0, 0xe6, 0x1e, 0xbf, 0xb3,
-	0x37, 0xd5, 0xf3, 0x82, 0x38, 0xdc, 0xb3, 0x57, 0xf6, 0x57, 0xca, 0xc0, 0xec, 0x35, 0x56, 0xc0,
-	0xba, 0x83, 0x3e, 0x66, 0xde, 0x91, 0x62, 0x15, 0x75, 0x9f, 0x07, 0x27, 0xae, 0xee, 0x58, 0xe1,
-	0x23, 0x98, 0x77, 0xe5, 0x4a, 0x56, 0x12, 0x96, 0xfa, 0x90, 0x84, 0xbe, 0xbc, 0x8c, 0xa6, 0x5c,
-	0xfc, 0x65, 0x34, 0xb5, 0xec, 0x45, 0x34, 0xfb, 0x7f, 0xe2, 0x81, 0x87, 0xf2, 0x13, 0xff, 0xb2,
-	0xc5, 0x05, 0x4f, 0xe6, 0x2b, 0x68, 0x73, 0xc3, 0xda, 0xc7, 0xdc, 0x78, 0x06, 0xaa, 0xb1, 0x90,
-	0xcc, 0xc2, 0x2c, 0xd1, 0xa1, 0x60, 0xa2, 0x1d, 0x2b, 0x0c, 0xba, 0xeb, 0x72, 0x7c, 0x3f, 0xbc,
-	0x7d, 0xa1, 0xd9, 0x4a, 0x3a, 0xc2, 0x40, 0x51, 0xdb, 0x82, 0x59, 0x05, 0xc1, 0x06, 0x16, 0x7a,
-	0x0a, 0x06, 0x79, 0xa5, 0x09, 0xe1, 0xdc, 0x19, 0xa6, 0xeb, 0x90, 0x97, 0xa1, 0x68, 0x60, 0x01,
-	0xb2, 0xb7, 0xc0, 0xd8, 0x55, 0xdc, 0xfb, 0x75, 0xd8, 0x07, 0xdf, 0x70, 0x69, 0xff, 0x83, 0x92,
-	0x60, 0xc5, 0x77, 0x09, 0x3a, 0x32, 0xd0, 0x3a, 0x64, 0x64, 0xe0, 0x9b, 0x00, 0x6e, 0xd8, 0x6c,
-	0xd1, 0x7d, 0xf3, 0x5a, 0x58, 0xcc, 0x66, 0x6b, 0x5e, 0xd1, 0xd3, 0xa3, 0xaa, 0xdb, 0xb0, 0xc1,
-	0x2f, 0x25, 0xda, 0xcb, 0x07, 0x8a, 0xf6, 0x94, 0x94, 0x1b, 0xd8, 0x5f, 0xca, 0xd9, 0x7f, 0x66,
-	0x41, 0xca, 0xea, 0x43, 0x2d, 0xa8, 0xd0, 0xee, 0x76, 0x84, 0xc0, 0x58, 0x29, 0xce, 0xc4, 0xa4,
-	0x92, 0x5a, 0xac, 0x42, 0xf6, 0x13, 0x73, 0x46, 0xc8, 0x17, 0x51, 0x90, 0x85, 0x6c, 0x7e, 0x4c,
-	0x86, 0x97, 0xc3, 0x70, 0x9b, 0x07, 0x13, 0xe9, 0x88, 0x4a, 0xfb, 0x45, 0x98, 0xe8, 0xea, 0x14,
-	0xbb, 0x42, 0x3b, 0x94, 0x3b, 0x78, 0x63, 0xf5, 0xb0, 0x82, 0x0f, 0x98, 0xc3, 0xec, 0x2f, 0x5b,
-	0x70, 0x22, 0x4b, 0x1e, 0xbd, 0x6d, 0xc1, 0x44, 0x9c, 0xa5, 0x77, 0x5c, 0x63, 0xa7, 0xb2, 0x1d,
-	0xba, 0x40, 0xb8, 0xbb, 0x13, 0xf6, 0xff, 0x14, 0xda, 0xe0, 0xa6, 0x17, 0x34, 0xc2, 0xdb, 0xca,
-	0x4e, 0xb2, 0x7a, 0xda, 0x49, 0x54, 0x3c, 0xb8, 0x5b, 0xa4, 0xd1, 0xf6, 0xbb, 0xca, 0x50, 0xd4,
-	0x45, 0x3b, 0x56, 0x18, 0x2c, 0xeb, 0xbe, 0x2d, 0xf6, 0xad, 0x99, 0x49, 0xb9, 0x20, 0xda, 0xb1,
-	0xc2, 0x40, 0xcf, 0xc3, 0x88, 0xf1, 0x92, 0x72, 0x5e, 0xb2, 0x4d, 0x87, 0xa1, 0xc1, 0x63, 0x9c,
-	0xc2, 0x42, 0xd3, 0x00, 0xca, 0xe6, 0x92, 0x1a, 0x9b, 0x39, 0xda, 0x95, 0x60, 0x8c, 0xb1, 0x81,
-	0xc1, 0x6a, 0x5c, 0xf8, 0xed, 0x98, 0x9d, 0x24, 0x0f, 0xea, 0x0b, 0x1d, 0xe6, 0x45, 0x1b, 0x56,
-	0x50, 0x2a, 0xdc, 0x9a, 0x4e, 0xd0, 0x76, 0x7c, 0x3a, 0x42, 0xc2, 0x75, 0xa6, 0x96, 0xe1, 0xb2,
-	0x82, 0x60, 0x03, 0x8b, 0xbe, 0x71, 0xe2, 0x35, 0xc9, 0x2b, 0x61, 0x20, 0xa3, 0xd4, 0x75, 0x70,
-	0x81, 0x68, 0xc7, 0x0a, 0x03, 0xbd, 0x08, 0xc3, 0x4e, 0xd0, 0xe0, 0x06, 0x62, 0x18, 0x89, 0x33,
-	0x4a, 0xb5, 0xfb, 0xbc, 0x1e, 0x93, 0x59, 0x0d, 0xc5, 0x26, 0x6a, 0xf6, 0x36, 0x0b, 0xe8, 0xf3,
-	0xb6, 0xbc, 0x3f, 0xb5, 0x60, 0x5c, 0x17, 0x2d, 0x62, 0x1e, 0xb6, 0x94, 0x6b, 0xd1, 0x3a, 0xd0,
-	0xb5, 0x98, 0xae, 0x5d, 0x52, 0xea, 0xab, 0x76, 0x89, 0x59, 0x56, 0xa4, 0xbc, 0x6f, 0x59, 0x91,
-	0xaf, 0x87, 0xa1, 0x6d, 0xd2, 0x31, 0xea, 0x8f, 0x30, 0xe5, 0x70, 0x95, 0x37, 0x61, 0x09, 0x43,
-	0x36, 0x0c, 0xba, 0x8e, 0xaa, 0x61, 0x38, 0x22, 0x62, 0xd3, 0x66, 0x19, 0x92, 0x80, 0xd8, 0x2b,
-	0x50, 0x53, 0x87, 0xfa, 0xd2, 0xd3, 0x67, 0xe5, 0x7b, 0xfa, 0xfa, 0x2a, 0x6f, 0x30, 0xb7, 0xfe,
-	0x1b, 0x5f, 0x7d, 0xf2, 0x5d, 0xbf, 0xfd, 0xd5, 0x27, 0xdf, 0xf5, 0x07, 0x5f, 0x7d, 0xf2, 0x5d,
-	0x1f, 0xbf, 0xf3, 0xa4, 0xf5, 0x1b, 0x77, 0x9e, 0xb4, 0x7e, 0xfb, 0xce, 0x93, 0xd6, 0x1f, 0xdc,
-	0x79, 0xd2, 0xfa, 0xca, 0x9d, 0x27, 0xad, 0xb7, 0xfe, 0xeb, 0x93, 0xef, 0x7a, 0x25, 0x37, 0x2f,
-	0x82, 0xfe, 0x78, 0xbf, 0xdb, 0x98, 0xd9, 0x79, 0x8e, 0x85, 0xe6, 0xd3, 0xf5, 0x3c, 0x63, 0x4c,
-	0xe2, 0x19, 0xb9, 0x9e, 0xff, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x87, 0xd1, 0xb1, 0x14, 0x1c,
-	0x01, 0x01, 0x00,
+	// 12426 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x7b, 0x70, 0x25, 0xd9,
+	0x59, 0x18, 0xee, 0xbe, 0x0f, 0x49, 0xf7, 0xe8, 0x35, 0xea, 0x99, 0xd9, 0xbd, 0x33, 0xfb, 0xd0,
+	0xd0, 0x6b, 0xd6, 0xfe, 0x81, 0x57, 0x83, 0x77, 0x8d, 0xd9, 0x1f, 0x60, 0x83, 0x1e, 0xf3, 0xd0,
+	0x8e, 0x34, 0xd2, 0x7e, 0x57, 0x33, 0x83, 0x6d, 0xd6, 0xeb, 0xd6, 0xbd, 0x47, 0x52, 0xaf, 0xfa,
+	0x76, 0xdf, 0xed, 0xee, 0xab, 0x19, 0x2d, 0xc6, 0xd8, 0x80, 0x83, 0xc1, 0x3c, 0x1c, 0x48, 0x05,
+	0x93, 0x04, 0x02, 0x81, 0xbc, 0x8a, 0xa2, 0x20, 0xa1, 0x52, 0xa1, 0x8a, 0x50, 0x14, 0x90, 0x72,
+	0xd9, 0x79, 0x41, 0x51, 0x0e, 0x21, 0x05, 0x4c, 0xec, 0x49, 0x52, 0xa1, 0xa8, 0x0a, 0x55, 0x21,
+	0xf9, 0x23, 0xb5, 0x49, 0x51, 0xa9, 0xef, 0xbc, 0xfb, 0x71, 0xa5, 0xab, 0x51, 0x6b, 0x66, 0x6c,
+	0xf6, 0x2f, 0xe9, 0x9e, 0xef, 0xeb, 0xef, 0x3b, 0x7d, 0xfa, 0x9c, 0xef, 0x7c, 0xe7, 0x7b, 0x1d,
+	0xb2, 0xb2, 0xed, 0x25, 0x3b, 0xfd, 0xcd, 0xb9, 0x76, 0xd8, 0xbd, 0xe8, 0x46, 0xdb, 0x61, 0x2f,
+	0x0a, 0x5f, 0x63, 0xff, 0x3c, 0xd7, 0xee, 0x5c, 0xdc, 0x7b, 0xe1, 0x62, 0x6f, 0x77, 0xfb, 0xa2,
+	0xdb, 0xf3, 0xe2, 0x8b, 0x6e, 0xaf, 0xe7, 0x7b, 0x6d, 0x37, 0xf1, 0xc2, 0xe0, 0xe2, 0xde, 0xbb,
+	0x5d, 0xbf, 0xb7, 0xe3, 0xbe, 0xfb, 0xe2, 0x36, 0x0d, 0x68, 0xe4, 0x26, 0xb4, 0x33, 0xd7, 0x8b,
+	0xc2, 0x24, 0xb4, 0xbf, 0x55, 0x53, 0x9b, 0x93, 0xd4, 0xd8, 0x3f, 0xaf, 0xb6, 0x3b, 0x73, 0x7b,
+	0x2f, 0xcc, 0xf5, 0x76, 0xb7, 0xe7, 0x90, 0xda, 0x9c, 0x41, 0x6d, 0x4e, 0x52, 0x3b, 0xff, 0x9c,
+	0xd1, 0x97, 0xed, 0x70, 0x3b, 0xbc, 0xc8, 0x88, 0x6e, 0xf6, 0xb7, 0xd8, 0x2f, 0xf6, 0x83, 0xfd,
+	0xc7, 0x99, 0x9d, 0x77, 0x76, 0x5f, 0x8c, 0xe7, 0xbc, 0x10, 0xbb, 0x77, 0xb1, 0x1d, 0x46, 0xf4,
+	0xe2, 0x5e, 0xae, 0x43, 0xe7, 0xaf, 0x6a, 0x1c, 0x7a, 0x27, 0xa1, 0x41, 0xec, 0x85, 0x41, 0xfc,
+	0x1c, 0x76, 0x81, 0x46, 0x7b, 0x34, 0x32, 0x5f, 0xcf, 0x40, 0x28, 0xa2, 0xf4, 0x1e, 0x4d, 0xa9,
+	0xeb, 0xb6, 0x77, 0xbc, 0x80, 0x46, 0xfb, 0xfa, 0xf1, 0x2e, 0x4d, 0xdc, 0xa2, 0xa7, 0x2e, 0x0e,
+	0x7a, 0x2a, 0xea, 0x07, 0x89, 0xd7, 0xa5, 0xb9, 0x07, 0xde, 0x7b, 0xd8, 0x03, 0x71, 0x7b, 0x87,
+	0x76, 0xdd, 0xdc, 0x73, 0x2f, 0x0c, 0x7a, 0xae, 0x9f, 0x78, 0xfe, 0x45, 0x2f, 0x48, 0xe2, 0x24,
+	0xca, 0x3e, 0xe4, 0xfc, 0x1d, 0x8b, 0x4c, 0xce, 0xdf, 0x6a, 0xcd, 0xf7, 0x93, 0x9d, 0xc5, 0x30,
+	0xd8, 0xf2, 0xb6, 0xed, 0x6f, 0x24, 0xe3, 0x6d, 0xbf, 0x1f, 0x27, 0x34, 0xba, 0xee, 0x76, 0x69,
+	0xd3, 0xba, 0x60, 0xbd, 0xb3, 0xb1, 0x70, 0xfa, 0xf3, 0x77, 0x67, 0xdf, 0x76, 0xef, 0xee, 0xec,
+	0xf8, 0xa2, 0x06, 0x81, 0x89, 0x67, 0xff, 0x7f, 0x64, 0x34, 0x0a, 0x7d, 0x3a, 0x0f, 0xd7, 0x9b,
+	0x15, 0xf6, 0xc8, 0xb4, 0x78, 0x64, 0x14, 0x78, 0x33, 0x48, 0x38, 0xa2, 0xf6, 0xa2, 0x70, 0xcb,
+	0xf3, 0x69, 0xb3, 0x9a, 0x46, 0x5d, 0xe7, 0xcd, 0x20, 0xe1, 0xce, 0x4f, 0x55, 0xc8, 0xf4, 0x7c,
+	0xaf, 0x77, 0x95, 0xba, 0x7e, 0xb2, 0xd3, 0x4a, 0xdc, 0xa4, 0x1f, 0xdb, 0xdb, 0x64, 0x24, 0x66,
+	0xff, 0x89, 0xbe, 0xad, 0x89, 0xa7, 0x47, 0x38, 0xfc, 0xcd, 0xbb, 0xb3, 0xef, 0x2b, 0x9a, 0xd1,
+	0xdb, 0x5e, 0x12, 0xf6, 0xe2, 0xe7, 0x68, 0xb0, 0xed, 0x05, 0x94, 0x8d, 0xcb, 0x0e, 0xa3, 0x3a,
+	0x67, 0x12, 0x5f, 0x0c, 0x3b, 0x14, 0x04, 0x79, 0xec, 0x67, 0x97, 0xc6, 0xb1, 0xbb, 0x4d, 0xb3,
+	0xaf, 0xb4, 0xca, 0x9b, 0x41, 0xc2, 0xed, 0x88, 0xd8, 0xbe, 0x1b, 0x27, 0x1b, 0x91, 0x1b, 0xc4,
+	0x1e, 0x4e, 0xe9, 0x0d, 0xaf, 0xcb, 0xdf, 0x6e, 0xfc, 0xf9, 0xaf, 0x9b, 0xe3, 0x1f, 0x66, 0xce,
+	0xfc, 0x30, 0x7a, 0x1d, 0xe0, 0xbc, 0x99, 0xdb, 0x7b, 0xf7, 0x1c, 0x3e, 0xb1, 0xf0, 0xd8, 0xbd,
+	0xbb, 0xb3, 0xf6, 0x4a, 0x8e, 0x12, 0x14, 0x50, 0x77, 0xfe, 0xa0, 0x42, 0xc8, 0x7c, 0xaf, 0xb7,
+	0x1e, 0x85, 0xaf, 0xd1, 0x76, 0x62, 0x7f, 0x84, 0x8c, 0x21, 0xa9, 0x8e, 0x9b, 0xb8, 0x6c, 0x60,
+	0xc6, 0x9f, 0xff, 0x86, 0xe1, 0x18, 0xaf, 0x6d, 0xe2, 0xf3, 0xab, 0x34, 0x71, 0x17, 0x6c, 0xf1,
+	0x82, 0x44, 0xb7, 0x81, 0xa2, 0x6a, 0x07, 0xa4, 0x16, 0xf7, 0x68, 0x9b, 0x0d, 0xc6, 0xf8, 0xf3,
+	0x2b, 0x73, 0xc7, 0x59, 0xe9, 0x73, 0xba, 0xe7, 0xad, 0x1e, 0x6d, 0x2f, 0x4c, 0x08, 0xce, 0x35,
+	0xfc, 0x05, 0x8c, 0x8f, 0xbd, 0xa7, 0x3e, 0x34, 0x1f, 0xc8, 0xeb, 0xa5, 0x71, 0x64, 0x54, 0x17,
+	0xa6, 0xd2, 0x13, 0x47, 0x7e, 0x77, 0xe7, 0x4f, 0x2c, 0x32, 0xa5, 0x91, 0x57, 0xbc, 0x38, 0xb1,
+	0xbf, 0x33, 0x37, 0xb8, 0x73, 0xc3, 0x0d, 0x2e, 0x3e, 0xcd, 0x86, 0xf6, 0x94, 0x60, 0x36, 0x26,
+	0x5b, 0x8c, 0x81, 0xed, 0x92, 0xba, 0x97, 0xd0, 0x6e, 0xdc, 0xac, 0x5c, 0xa8, 0xbe, 0x73, 0xfc,
+	0xf9, 0xab, 0x65, 0xbd, 0xe7, 0xc2, 0xa4, 0x60, 0x5a, 0x5f, 0x46, 0xf2, 0xc0, 0xb9, 0x38, 0x7f,
+	0x31, 0x69, 0xbe, 0x1f, 0x0e, 0xb8, 0xfd, 0x6e, 0x32, 0x1e, 0x87, 0xfd, 0xa8, 0x4d, 0x81, 0xf6,
+	0x42, 0x5c, 0x58, 0x55, 0x9c, 0xee, 0xb8, 0xe0, 0x5b, 0xba, 0x19, 0x4c, 0x1c, 0xfb, 0x47, 0x2d,
+	0x32, 0xd1, 0xa1, 0x71, 0xe2, 0x05, 0x8c, 0xbf, 0xec, 0xfc, 0xc6, 0xb1, 0x3b, 0x2f, 0x1b, 0x97,
+	0x34, 0xf1, 0x85, 0x33, 0xe2, 0x45, 0x26, 0x8c, 0xc6, 0x18, 0x52, 0xfc, 0x51, 0x70, 0x75, 0x68,
+	0xdc, 0x8e, 0xbc, 0x1e, 0xfe, 0x6e, 0x56, 0xd3, 0x82, 0x6b, 0x49, 0x83, 0xc0, 0xc4, 0xb3, 0x03,
+	0x52, 0x47, 0xc1, 0x14, 0x37, 0x6b, 0xac, 0xff, 0xcb, 0xc7, 0xeb, 0xbf, 0x18, 0x54, 0x94, 0x79,
+	0x7a, 0xf4, 0xf1, 0x57, 0x0c, 0x9c, 0x8d, 0xfd, 0x23, 0x16, 0x69, 0x0a, 0xc1, 0x09, 0x94, 0x0f,
+	0xe8, 0xad, 0x1d, 0x2f, 0xa1, 0xbe, 0x17, 0x27, 0xcd, 0x3a, 0xeb, 0xc3, 0xc5, 0xe1, 0xe6, 0xd6,
+	0x95, 0x28, 0xec, 0xf7, 0xae, 0x79, 0x41, 0x67, 0xe1, 0x82, 0xe0, 0xd4, 0x5c, 0x1c, 0x40, 0x18,
+	0x06, 0xb2, 0xb4, 0x7f, 0xc2, 0x22, 0xe7, 0x03, 0xb7, 0x4b, 0xe3, 0x9e, 0xdb, 0xa6, 0x12, 0xbc,
+	0xe0, 0xbb, 0xed, 0x5d, 0xd6, 0xa3, 0x91, 0xfb, 0xeb, 0x91, 0x23, 0x7a, 0x74, 0xfe, 0xfa, 0x40,
+	0xd2, 0x70, 0x00, 0x5b, 0xfb, 0xe7, 0x2d, 0x32, 0x13, 0x46, 0xbd, 0x1d, 0x37, 0xa0, 0x1d, 0x09,
+	0x8d, 0x9b, 0xa3, 0x6c, 0xe9, 0x7d, 0xf8, 0x78, 0x9f, 0x68, 0x2d, 0x4b, 0x76, 0x35, 0x0c, 0xbc,
+	0x24, 0x8c, 0x5a, 0x34, 0x49, 0xbc, 0x60, 0x3b, 0x5e, 0x38, 0x7b, 0xef, 0xee, 0xec, 0x4c, 0x0e,
+	0x0b, 0xf2, 0xfd, 0xb1, 0xbf, 0x8b, 0x8c, 0xc7, 0xfb, 0x41, 0xfb, 0x96, 0x17, 0x74, 0xc2, 0xdb,
+	0x71, 0x73, 0xac, 0x8c, 0xe5, 0xdb, 0x52, 0x04, 0xc5, 0x02, 0xd4, 0x0c, 0xc0, 0xe4, 0x56, 0xfc,
+	0xe1, 0xf4, 0x54, 0x6a, 0x94, 0xfd, 0xe1, 0xf4, 0x64, 0x3a, 0x80, 0xad, 0xfd, 0x03, 0x16, 0x99,
+	0x8c, 0xbd, 0xed, 0xc0, 0x4d, 0xfa, 0x11, 0xbd, 0x46, 0xf7, 0xe3, 0x26, 0x61, 0x1d, 0x79, 0xe9,
+	0x98, 0xa3, 0x62, 0x90, 0x5c, 0x38, 0x2b, 0xfa, 0x38, 0x69, 0xb6, 0xc6, 0x90, 0xe6, 0x5b, 0xb4,
+	0xd0, 0xf4, 0xb4, 0x1e, 0x2f, 0x77, 0xa1, 0xe9, 0x49, 0x3d, 0x90, 0xa5, 0xfd, 0xed, 0xe4, 0x14,
+	0x6f, 0x52, 0x23, 0x1b, 0x37, 0x27, 0x98, 0xa0, 0x3d, 0x73, 0xef, 0xee, 0xec, 0xa9, 0x56, 0x06,
+	0x06, 0x39, 0x6c, 0xfb, 0x75, 0x32, 0xdb, 0xa3, 0x51, 0xd7, 0x4b, 0xd6, 0x02, 0x7f, 0x5f, 0x8a,
+	0xef, 0x76, 0xd8, 0xa3, 0x1d, 0xd1, 0x9d, 0xb8, 0x39, 0x79, 0xc1, 0x7a, 0xe7, 0xd8, 0xc2, 0x3b,
+	0x44, 0x37, 0x67, 0xd7, 0x0f, 0x46, 0x87, 0xc3, 0xe8, 0xd9, 0x9f, 0xb3, 0xc8, 0x79, 0x43, 0xca,
+	0xb6, 0x68, 0xb4, 0xe7, 0xb5, 0xe9, 0x7c, 0xbb, 0x1d, 0xf6, 0x83, 0x24, 0x6e, 0x4e, 0xb1, 0x61,
+	0xdc, 0x3c, 0x09, 0x99, 0x9f, 0x66, 0xa5, 0xe7, 0xe5, 0x40, 0x94, 0x18, 0x0e, 0xe8, 0xa9, 0xf3,
+	0x85, 0x0a, 0x39, 0x95, 0xd5, 0x00, 0xec, 0x7f, 0x60, 0x91, 0xe9, 0xd7, 0x6e, 0x27, 0x1b, 0xe1,
+	0x2e, 0x0d, 0xe2, 0x85, 0x7d, 0x94, 0xd3, 0x6c, 0xef, 0x1b, 0x7f, 0xbe, 0x5d, 0xae, 0xae, 0x31,
+	0xf7, 0x52, 0x9a, 0xcb, 0xa5, 0x20, 0x89, 0xf6, 0x17, 0x1e, 0x17, 0xef, 0x34, 0xfd, 0xd2, 0xad,
+	0x0d, 0x13, 0x0a, 0xd9, 0x4e, 0x9d, 0xff, 0xb4, 0x45, 0xce, 0x14, 0x91, 0xb0, 0x4f, 0x91, 0xea,
+	0x2e, 0xdd, 0xe7, 0x9a, 0x30, 0xe0, 0xbf, 0xf6, 0x2b, 0xa4, 0xbe, 0xe7, 0xfa, 0x7d, 0x2a, 0xd4,
+	0xb4, 0x2b, 0xc7, 0x7b, 0x11, 0xd5, 0x33, 0xe0, 0x54, 0xbf, 0xb9, 0xf2, 0xa2, 0xe5, 0xfc, 0x6e,
+	0x95, 0x8c, 0x1b, 0x1f, 0xed, 0x01, 0xa8, 0x9e, 0x61, 0x4a, 0xf5, 0x5c, 0x2d, 0x6d, 0xbe, 0x0d,
+	0xd4, 0x3d, 0x6f, 0x67, 0x74, 0xcf, 0xb5, 0xf2, 0x58, 0x1e, 0xa8, 0x7c, 0xda, 0x09, 0x69, 0x84,
+	0x3d, 0x1a, 0x31, 0xd4, 0x66, 0xad, 0x8c, 0x4f, 0xb8, 0x26, 0xc9, 0x2d, 0x4c, 0xde, 0xbb, 0x3b,
+	0xdb, 0x50, 0x3f, 0x41, 0x33, 0x72, 0xfe, 0x83, 0x45, 0xce, 0x18, 0x7d, 0x5c, 0x0c, 0x83, 0x0e,
+	0x3b, 0x68, 0xd8, 0x17, 0x48, 0x2d, 0xd9, 0xef, 0xc9, 0x63, 0xa0, 0x1a, 0xa9, 0x8d, 0xfd, 0x1e,
+	0x05, 0x06, 0x79, 0xd4, 0x4f, 0x49, 0x3f, 0x61, 0x91, 0xc7, 0x8a, 0x05, 0x8c, 0xfd, 0x2c, 0x19,
+	0xe1, 0x36, 0x00, 0xf1, 0x76, 0xfa, 0x93, 0xb0, 0x56, 0x10, 0x50, 0xfb, 0x22, 0x69, 0xa8, 0x0d,
+	0x4f, 0xbc, 0xe3, 0x8c, 0x40, 0x6d, 0xe8, 0x5d, 0x52, 0xe3, 0xe0, 0xa0, 0x05, 0xae, 0x78, 0x33,
+	0x63, 0xd0, 0x10, 0x17, 0x18, 0xc4, 0xf9, 0xa2, 0x45, 0xde, 0x3e, 0x8c, 0xd8, 0x3b, 0xb9, 0x3e,
+	0xb6, 0xc8, 0xd9, 0x0e, 0xdd, 0x72, 0xfb, 0x7e, 0x92, 0xe6, 0x28, 0x3a, 0xfd, 0x94, 0x78, 0xf8,
+	0xec, 0x52, 0x11, 0x12, 0x14, 0x3f, 0xeb, 0xfc, 0x27, 0x8b, 0x4c, 0x1b, 0xaf, 0xf5, 0x00, 0x8e,
+	0x4e, 0x41, 0xfa, 0xe8, 0xb4, 0x5c, 0xda, 0x32, 0x1d, 0x70, 0x76, 0xfa, 0x11, 0x8b, 0x9c, 0x37,
+	0xb0, 0x56, 0xdd, 0xa4, 0xbd, 0x73, 0xe9, 0x4e, 0x2f, 0xa2, 0x71, 0x8c, 0x53, 0xea, 0x29, 0x43,
+	0x1c, 0x2f, 0x8c, 0x0b, 0x0a, 0xd5, 0x6b, 0x74, 0x9f, 0xcb, 0xe6, 0x77, 0x91, 0x31, 0xbe, 0xe6,
+	0xc2, 0x48, 0x7c, 0x24, 0xf5, 0x6e, 0x6b, 0xa2, 0x1d, 0x14, 0x86, 0xed, 0x90, 0x11, 0x26, 0x73,
+	0x51, 0x06, 0xa1, 0x9a, 0x40, 0xf0, 0xbb, 0xdf, 0x64, 0x2d, 0x20, 0x20, 0x4e, 0x9c, 0xea, 0xce,
+	0x7a, 0x44, 0xd9, 0x7c, 0xe8, 0x5c, 0xf6, 0xa8, 0xdf, 0x89, 0xf1, 0x58, 0xe7, 0x06, 0x41, 0x98,
+	0x88, 0x13, 0x9a, 0x71, 0xac, 0x9b, 0xd7, 0xcd, 0x60, 0xe2, 0x20, 0x53, 0xdf, 0xdd, 0xa4, 0x3e,
+	0x1f, 0x51, 0xc1, 0x74, 0x85, 0xb5, 0x80, 0x80, 0x38, 0xf7, 0x2a, 0x64, 0xca, 0xe0, 0xda, 0xa2,
+	0x0f, 0xc2, 0xfa, 0x10, 0xa5, 0xb6, 0x80, 0xf5, 0xf2, 0xe4, 0x31, 0x1d, 0x6c, 0x81, 0x78, 0x23,
+	0xb3, 0x0b, 0x40, 0xa9, 0x5c, 0x0f, 0xb6, 0x42, 0x7c, 0xbc, 0x4a, 0x66, 0xd3, 0x0f, 0xe4, 0x36,
+	0x11, 0x3c, 0xf2, 0x1a, 0x8c, 0xb2, 0xb6, 0x3a, 0x03, 0x1f, 0x4c, 0xbc, 0x01, 0x72, 0xb8, 0x72,
+	0x92, 0x72, 0xd8, 0xdc, 0x26, 0xaa, 0x87, 0x6c, 0x13, 0xcf, 0xaa, 0x51, 0xaf, 0x65, 0x64, 0x5e,
+	0x7a, 0xab, 0xbc, 0x40, 0x6a, 0x71, 0x42, 0x7b, 0xcd, 0x7a, 0x5a, 0xcc, 0xb6, 0x12, 0xda, 0x03,
+	0x06, 0xb1, 0xdf, 0x47, 0xa6, 0x13, 0x37, 0xda, 0xa6, 0x49, 0x44, 0xf7, 0x3c, 0x66, 0xd7, 0x65,
+	0xe7, 0xd9, 0xc6, 0xc2, 0x69, 0xd4, 0xba, 0x36, 0x18, 0x08, 0x24, 0x08, 0xb2, 0xb8, 0xce, 0x9f,
+	0x55, 0xc8, 0xe3, 0xe9, 0x4f, 0xa0, 0x37, 0xc6, 0x6f, 0x4b, 0x6d, 0x8c, 0x5f, 0x6f, 0x6e, 0x8c,
+	0x6f, 0xde, 0x9d, 0x7d, 0x62, 0xc0, 0x63, 0x5f, 0x31, 0xfb, 0xa6, 0x7d, 0x25, 0xf3, 0x11, 0x2e,
+	0xe6, 0xac, 0xac, 0x4f, 0x0d, 0x78, 0xc7, 0xcc, 0x57, 0x7a, 0x96, 0x8c, 0x44, 0xd4, 0x8d, 0xc3,
+	0xa0, 0x59, 0x4f, 0x7f, 0x4d, 0x60, 0xad, 0x20, 0xa0, 0xce, 0xef, 0x37, 0xb2, 0x83, 0x7d, 0x85,
+	0xdb, 0xaa, 0xc3, 0xc8, 0xf6, 0x48, 0x8d, 0x9d, 0xda, 0xb8, 0x64, 0xb9, 0x76, 0xbc, 0x55, 0x88,
+	0xbb, 0x88, 0x22, 0xbd, 0x30, 0x86, 0x5f, 0x0d, 0x9b, 0x80, 0xb1, 0xb0, 0xef, 0x90, 0xb1, 0xb6,
+	0x3c, 0x4c, 0x55, 0xca, 0x30, 0x3b, 0x8a, 0xa3, 0x94, 0xe6, 0x38, 0x81, 0xe2, 0x5e, 0x9d, 0xc0,
+	0x14, 0x37, 0x9b, 0x92, 0xea, 0xb6, 0x97, 0x88, 0xcf, 0x7a, 0xcc, 0xe3, 0xf2, 0x15, 0xcf, 0x78,
+	0xc5, 0x51, 0xdc, 0x83, 0xae, 0x78, 0x09, 0x20, 0x7d, 0xfb, 0x93, 0x16, 0x19, 0x8f, 0xdb, 0xdd,
+	0xf5, 0x28, 0xdc, 0xf3, 0x3a, 0x34, 0x6a, 0xd6, 0xca, 0x90, 0x6c, 0xad, 0xc5, 0x55, 0x49, 0x50,
+	0xf3, 0xe5, 0xe6, 0x0b, 0x0d, 0x01, 0x93, 0x2f, 0x9e, 0xbd, 0x1e, 0x17, 0xef, 0xbe, 0x44, 0xdb,
+	0x6c, 0xc5, 0xc9, 0x33, 0x73, 0xb3, 0x5e, 0x86, 0xce, 0xbd, 0xd4, 0x6f, 0xef, 0xe2, 0x7a, 0xd3,
+	0x1d, 0x7a, 0xe2, 0xde, 0xdd, 0xd9, 0xc7, 0x17, 0x8b, 0x79, 0xc2, 0xa0, 0xce, 0xb0, 0x01, 0xeb,
+	0xf5, 0x7d, 0x1f, 0xe8, 0xeb, 0x7d, 0xca, 0x2c, 0x62, 0x25, 0x0c, 0xd8, 0xba, 0x26, 0x98, 0x19,
+	0x30, 0x03, 0x02, 0x26, 0x5f, 0xfb, 0x75, 0x32, 0xd2, 0x75, 0x93, 0xc8, 0xbb, 0xd3, 0x1c, 0x2d,
+	0xe3, 0x14, 0xb4, 0xca, 0x68, 0x69, 0xe6, 0x6c, 0xa3, 0xe7, 0x8d, 0x20, 0x18, 0xa1, 0x61, 0xba,
+	0x4b, 0xa3, 0x6d, 0xda, 0x1c, 0x2b, 0xc3, 0xe4, 0xbf, 0x8a, 0xa4, 0x34, 0xc3, 0x06, 0x2a, 0x57,
+	0xac, 0x0d, 0x38, 0x17, 0xfb, 0x15, 0x32, 0x16, 0x53, 0x9f, 0xb6, 0x51, 0x3d, 0x6a, 0x30, 0x8e,
+	0x2f, 0x0c, 0xa9, 0x2a, 0xa2, 0x5e, 0xd2, 0x12, 0x8f, 0xf2, 0x05, 0x26, 0x7f, 0x81, 0x22, 0x89,
+	0x03, 0xd8, 0xf3, 0xfb, 0xdb, 0x5e, 0xd0, 0x24, 0x65, 0x0c, 0xe0, 0x3a, 0xa3, 0x95, 0x19, 0x40,
+	0xde, 0x08, 0x82, 0x91, 0xf3, 0x5f, 0x2d, 0x62, 0xa7, 0x85, 0xda, 0x03, 0xd0, 0x89, 0x5f, 0x4f,
+	0xeb, 0xc4, 0x2b, 0x65, 0x2a, 0x2d, 0x03, 0xd4, 0xe2, 0x5f, 0x6f, 0x90, 0xcc, 0x76, 0x70, 0x9d,
+	0xc6, 0x09, 0xed, 0xbc, 0x25, 0xc2, 0xdf, 0x12, 0xe1, 0x6f, 0x89, 0x70, 0xf9, 0xc3, 0xde, 0xcc,
+	0x88, 0xf0, 0xf7, 0x1b, 0xab, 0x5e, 0xc7, 0x1e, 0xbc, 0xaa, 0x82, 0x13, 0xcc, 0x1e, 0x18, 0x08,
+	0x28, 0x09, 0x5e, 0x6a, 0xad, 0x5d, 0x2f, 0x94, 0xd9, 0xaf, 0xa6, 0x65, 0xf6, 0x71, 0x59, 0xfc,
+	0x55, 0x90, 0xd2, 0x9f, 0xb3, 0xc8, 0x3b, 0xd2, 0xd2, 0x4b, 0xce, 0x9c, 0xe5, 0xed, 0x20, 0x8c,
+	0xe8, 0x92, 0xb7, 0xb5, 0x45, 0x23, 0x1a, 0xa0, 0x0d, 0x5e, 0xda, 0x76, 0xac, 0x41, 0xb6, 0x1d,
+	0xfb, 0x3d, 0x64, 0xe2, 0xb5, 0x38, 0x0c, 0xd6, 0x43, 0x2f, 0x10, 0x22, 0x08, 0x4f, 0x1c, 0xa7,
+	0xd0, 0x7b, 0x89, 0x23, 0x2a, 0xdb, 0x21, 0x85, 0x65, 0x2f, 0x92, 0x99, 0xd7, 0x5e, 0x5f, 0x77,
+	0x13, 0xc3, 0x9a, 0x20, 0xcf, 0xfd, 0xcc, 0x1f, 0xf5, 0xd2, 0xcb, 0x19, 0x20, 0xe4, 0xf1, 0x9d,
+	0xbf, 0x5d, 0x21, 0xe7, 0x32, 0x2f, 0x12, 0xfa, 0x7e, 0xd8, 0x4f, 0xf0, 0x4c, 0x64, 0xff, 0x8c,
+	0x45, 0x4e, 0x75, 0xd3, 0x06, 0x8b, 0x58, 0x98, 0xbb, 0xbf, 0xa3, 0xb4, 0x3d, 0x22, 0x63, 0x11,
+	0x59, 0x68, 0x8a, 0x11, 0x3a, 0x95, 0x01, 0xc4, 0x90, 0xeb, 0x8b, 0xfd, 0x0a, 0x69, 0x74, 0xdd,
+	0x3b, 0x37, 0x7a, 0x1d, 0x37, 0x91, 0xc7, 0xd1, 0xc1, 0x56, 0x84, 0x7e, 0xe2, 0xf9, 0x73, 0x3c,
+	0xaa, 0x65, 0x6e, 0x39, 0x48, 0xd6, 0xa2, 0x56, 0x12, 0x79, 0xc1, 0x36, 0x37, 0x72, 0xae, 0x4a,
+	0x32, 0xa0, 0x29, 0x3a, 0x3f, 0x6d, 0x91, 0xa7, 0x06, 0x8c, 0x4e, 0xe4, 0x26, 0x74, 0x7b, 0xdf,
+	0xfe, 0x28, 0xa9, 0xe3, 0xb9, 0x51, 0x8e, 0xca, 0xad, 0x32, 0x77, 0x4e, 0xe3, 0x4b, 0xe8, 0x4d,
+	0x14, 0x7f, 0xc5, 0xc0, 0x99, 0x3a, 0x3f, 0xd3, 0xc8, 0x2a, 0x0b, 0xcc, 0x37, 0xff, 0x3c, 0x21,
+	0xdb, 0xe1, 0x06, 0xed, 0xf6, 0x7c, 0x37, 0xe1, 0xf3, 0x6e, 0x4c, 0x9b, 0x4a, 0xae, 0x28, 0x08,
+	0x18, 0x58, 0xf6, 0x0f, 0x5a, 0x84, 0x6c, 0xcb, 0x39, 0x2f, 0x15, 0x81, 0x1b, 0x65, 0xbe, 0x8e,
+	0x5e, 0x51, 0xba, 0x2f, 0x8a, 0x21, 0x18, 0xcc, 0xed, 0xef, 0xb5, 0xc8, 0x58, 0x22, 0xbb, 0xcf,
+	0xb7, 0xc6, 0x8d, 0x32, 0x7b, 0x22, 0x5f, 0x5a, 0xeb, 0x44, 0x6a, 0x48, 0x14, 0x5f, 0xfb, 0xaf,
+	0x59, 0x84, 0xa0, 0xf3, 0x74, 0x3d, 0xf4, 0xbd, 0xf6, 0xbe, 0xd8, 0x31, 0x6f, 0x96, 0x6a, 0xce,
+	0x51, 0xd4, 0x17, 0xa6, 0x70, 0x34, 0xf4, 0x6f, 0x30, 0x38, 0xdb, 0x1f, 0x23, 0x63, 0xb1, 0x98,
+	0x6e, 0xcd, 0x7a, 0xf9, 0x83, 0x21, 0xa7, 0xb2, 0x10, 0xaf, 0xe2, 0x17, 0x28, 0x9e, 0xf6, 0x4f,
+	0x5a, 0x64, 0xba, 0x97, 0x36, 0x13, 0x8a, 0xed, 0xb0, 0x3c, 0x19, 0x90, 0x31, 0x43, 0x72, 0x6b,
+	0x4b, 0xa6, 0x11, 0xb2, 0xbd, 0x40, 0x09, 0xa8, 0x67, 0xf0, 0x5a, 0x8f, 0x9b, 0x2c, 0x47, 0xb5,
+	0x04, 0xbc, 0x92, 0x05, 0x42, 0x1e, 0xdf, 0x5e, 0x27, 0x67, 0xb0, 0x77, 0xfb, 0x5c, 0xfd, 0x94,
+	0xdb, 0x4b, 0xcc, 0x36, 0xc3, 0xb1, 0x85, 0x27, 0xc5, 0x0c, 0x39, 0x33, 0x5f, 0x80, 0x03, 0x85,
+	0x4f, 0xda, 0xbf, 0x6b, 0x91, 0x27, 0x3d, 0xb6, 0x0d, 0x98, 0x06, 0x7b, 0xbd, 0x23, 0x08, 0x47,
+	0x3b, 0x2d, 0x55, 0x56, 0x0c, 0xda, 0x7e, 0x16, 0xde, 0x2e, 0xde, 0xe0, 0xc9, 0xe5, 0x03, 0xba,
+	0x04, 0x07, 0x76, 0xd8, 0xfe, 0x26, 0x32, 0x29, 0xd7, 0xc5, 0x3a, 0x8a, 0x60, 0xb6, 0xd1, 0x36,
+	0x16, 0x66, 0xd0, 0xa3, 0xbe, 0x61, 0x02, 0x20, 0x8d, 0xe7, 0xfc, 0xab, 0x2a, 0x39, 0x93, 0x9d,
+	0x6e, 0xcc, 0xc6, 0x83, 0xe2, 0xa6, 0x2d, 0xed, 0x3f, 0x52, 0x7a, 0x96, 0x2a, 0x6e, 0x94, 0x75,
+	0x49, 0x8b, 0x1b, 0xd5, 0x14, 0x83, 0xc1, 0x1c, 0x95, 0xd2, 0x19, 0x37, 0x6b, 0x29, 0x15, 0x12,
+	0xf0, 0x95, 0x32, 0xbb, 0x94, 0xf7, 0xe9, 0x9d, 0x13, 0x5d, 0x9b, 0xc9, 0x81, 0x20, 0xdf, 0x25,
+	0xfb, 0xbb, 0x49, 0x23, 0x52, 0x91, 0x2d, 0xd5, 0x32, 0x8e, 0x6a, 0x72, 0xda, 0x88, 0xee, 0x28,
+	0x07, 0x90, 0x8e, 0x61, 0xd1, 0x1c, 0x9d, 0x4f, 0x55, 0xc8, 0x63, 0xd9, 0x8f, 0x29, 0x64, 0xc4,
+	0xe1, 0x4e, 0xbf, 0x1f, 0xb5, 0xc8, 0x78, 0x14, 0xfa, 0xbe, 0x17, 0x6c, 0xa3, 0x9c, 0x13, 0x9b,
+	0xf5, 0x87, 0x4e, 0x64, 0xbf, 0x14, 0x02, 0x8d, 0x69, 0xd6, 0xa0, 0x79, 0x82, 0xd9, 0x01, 0xfb,
+	0x5b, 0xc8, 0x64, 0x87, 0xfa, 0x14, 0x9f, 0x5d, 0x8b, 0xf0, 0x4c, 0xc4, 0x8d, 0xcc, 0x2a, 0x52,
+	0x64, 0xc9, 0x04, 0x42, 0x1a, 0x17, 0x03, 0xfe, 0x9a, 0x83, 0x84, 0xb9, 0x4d, 0xc9, 0x13, 0x52,
+	0x52, 0xa9, 0x71, 0x5c, 0x0b, 0x24, 0x3d, 0xb1, 0x1f, 0x3f, 0x23, 0xf8, 0x3c, 0xb1, 0x3e, 0x18,
+	0x15, 0x0e, 0xa2, 0x63, 0x7f, 0x90, 0x9c, 0x32, 0x06, 0x25, 0x56, 0xa3, 0xda, 0x58, 0x98, 0x43,
+	0xed, 0x69, 0x3e, 0x03, 0x7b, 0xf3, 0xee, 0xec, 0x63, 0xd9, 0x36, 0xb1, 0xdb, 0xe4, 0xe8, 0x38,
+	0xbf, 0x90, 0xfb, 0xd4, 0x4a, 0x51, 0xf8, 0xac, 0x95, 0x33, 0x45, 0x7c, 0xc7, 0x49, 0x6c, 0xce,
+	0xcc, 0x68, 0xa1, 0x62, 0x38, 0x06, 0xe3, 0x3c, 0x44, 0x9f, 0xbf, 0xf3, 0x6f, 0x6a, 0xe4, 0x80,
+	0x9e, 0x0d, 0xa1, 0xf9, 0x1f, 0xd9, 0x09, 0xfb, 0xc3, 0x96, 0xf2, 0xb6, 0x71, 0x01, 0xd0, 0x39,
+	0xa9, 0xb1, 0xe7, 0x87, 0xaf, 0x98, 0xc7, 0x9d, 0x28, 0x13, 0x7c, 0xda, 0xaf, 0x67, 0xff, 0xac,
+	0x95, 0xf6, 0x17, 0xf2, 0x88, 0x48, 0xef, 0xc4, 0xfa, 0x64, 0x38, 0x21, 0x79, 0xc7, 0xb4, 0xeb,
+	0x6a, 0x90, 0x7b, 0x72, 0x8e, 0x90, 0x2d, 0x2f, 0x70, 0x7d, 0xef, 0x0d, 0x3c, 0x5a, 0xd5, 0x99,
+	0x76, 0xc0, 0xd4, 0xad, 0xcb, 0xaa, 0x15, 0x0c, 0x8c, 0xf3, 0xff, 0x3f, 0x19, 0x37, 0xde, 0xbc,
+	0x20, 0x5c, 0xe6, 0x8c, 0x19, 0x2e, 0xd3, 0x30, 0xa2, 0x5c, 0xce, 0xbf, 0x9f, 0x9c, 0xca, 0x76,
+	0xf0, 0x28, 0xcf, 0x3b, 0xff, 0x7b, 0x34, 0xeb, 0xc0, 0xdb, 0xa0, 0x51, 0x17, 0xbb, 0xf6, 0x96,
+	0x55, 0xec, 0x2d, 0xab, 0xd8, 0x5b, 0x56, 0x31, 0xd3, 0xb1, 0x21, 0x2c, 0x3e, 0xa3, 0x0f, 0xc8,
+	0xe2, 0x93, 0xb2, 0x61, 0x8d, 0x95, 0x6e, 0xc3, 0x72, 0x3e, 0x99, 0x33, 0xfb, 0x6f, 0x44, 0x94,
+	0xda, 0x21, 0xa9, 0x07, 0x61, 0x87, 0x4a, 0x05, 0xf9, 0xa5, 0x72, 0xb4, 0xbd, 0xeb, 0x61, 0xc7,
+	0x88, 0x35, 0xc7, 0x5f, 0x31, 0x70, 0x3e, 0xce, 0xf7, 0x8f, 0x90, 0x94, 0x2e, 0xca, 0xbf, 0x3b,
+	0xa6, 0xea, 0xd0, 0x5e, 0x78, 0x03, 0x56, 0x9a, 0x56, 0xda, 0xf3, 0x0c, 0xbc, 0x19, 0x24, 0x1c,
+	0xf7, 0xbc, 0x9e, 0x9b, 0xec, 0x34, 0x2b, 0xe9, 0x3d, 0x0f, 0xed, 0x4e, 0xc0, 0x20, 0xf6, 0xfb,
+	0xc9, 0x54, 0x92, 0xf2, 0xa3, 0x0b, 0x7f, 0xf1, 0x63, 0x02, 0x77, 0x2a, 0xed, 0x65, 0x87, 0x0c,
+	0xb6, 0xfd, 0x3a, 0xa9, 0xed, 0x50, 0xbf, 0x2b, 0x3e, 0x7d, 0xab, 0xbc, 0xbd, 0x86, 0xbd, 0xeb,
+	0x55, 0xea, 0x77, 0xb9, 0x24, 0xc4, 0xff, 0x80, 0xb1, 0xc2, 0x79, 0xdf, 0xd8, 0xed, 0xc7, 0x49,
+	0xd8, 0xf5, 0xde, 0x90, 0x66, 0xd2, 0xef, 0x28, 0x99, 0xf1, 0x35, 0x49, 0x9f, 0xdb, 0xa3, 0xd4,
+	0x4f, 0xd0, 0x9c, 0x59, 0x3f, 0x3a, 0x5e, 0xc4, 0xa6, 0xcc, 0x7e, 0x93, 0x9c, 0x48, 0x3f, 0x96,
+	0x24, 0x7d, 0xde, 0x0f, 0xf5, 0x13, 0x34, 0x67, 0x7b, 0x5f, 0xad, 0xbf, 0xf1, 0x0b, 0x56, 0xb9,
+	0x07, 0x37, 0xd6, 0x07, 0xbe, 0xf6, 0x0a, 0xd7, 0xe1, 0x33, 0xa4, 0xde, 0xde, 0x71, 0xa3, 0xa4,
+	0x39, 0xc1, 0x26, 0x8d, 0x9a, 0xc5, 0x8b, 0xd8, 0x08, 0x1c, 0x86, 0x41, 0x55, 0x11, 0xdd, 0x6a,
+	0x4e, 0xa6, 0x83, 0xaa, 0x80, 0x6e, 0x01, 0xb6, 0x2b, 0xbd, 0x6c, 0x6a, 0x60, 0xb4, 0xdd, 0xcf,
+	0x55, 0xc8, 0xf9, 0x5c, 0xaf, 0xd4, 0x50, 0xf0, 0xf5, 0xd0, 0xee, 0x47, 0xb1, 0xb4, 0xae, 0x19,
+	0xeb, 0x81, 0x35, 0x83, 0x84, 0xdb, 0x9f, 0xb0, 0xc8, 0x28, 0x9a, 0x6d, 0x03, 0x9a, 0x34, 0x2b,
+	0x65, 0xdb, 0x90, 0x58, 0xb7, 0x5e, 0xe2, 0xd4, 0x75, 0x1f, 0x44, 0x03, 0x48, 0xbe, 0xd8, 0x5d,
+	0x7a, 0xa7, 0xed, 0xf7, 0x3b, 0xb9, 0x48, 0x9a, 0x4b, 0xbc, 0x19, 0x24, 0x1c, 0x51, 0xbd, 0x80,
+	0xa3, 0xd6, 0xd2, 0xa8, 0xcb, 0x81, 0x40, 0x15, 0x70, 0xe7, 0x57, 0xc7, 0xc8, 0xd9, 0xc2, 0xe5,
+	0x83, 0x2a, 0x17, 0x53, 0x6a, 0x2e, 0x7b, 0x3e, 0x95, 0x31, 0x64, 0x4c, 0xe5, 0xba, 0xa9, 0x5a,
+	0xc1, 0xc0, 0xb0, 0xbf, 0x87, 0x90, 0x9e, 0x1b, 0xb9, 0x5d, 0xaa, 0xac, 0xdf, 0xc7, 0xd6, 0x6c,
+	0xb0, 0x1f, 0xeb, 0x92, 0xa6, 0xb6, 0x00, 0xa8, 0xa6, 0x18, 0x0c, 0x96, 0x18, 0x15, 0x15, 0x51,
+	0x9f, 0xba, 0x31, 0x8b, 0x9d, 0xcf, 0x26, 0x02, 0x81, 0x06, 0x81, 0x89, 0x87, 0x81, 0x2a, 0x22,
+	0xdc, 0x2e, 0x13, 0x76, 0x94, 0x0e, 0xb9, 0xb3, 0x7f, 0xcc, 0x22, 0x53, 0x98, 0x9c, 0xa8, 0xb9,
+	0x8b, 0xb4, 0x9d, 0xb5, 0xe3, 0xbf, 0xe4, 0x65, 0x93, 0xae, 0x96, 0xa1, 0xa9, 0xe6, 0x18, 0x32,
+	0xec, 0xf1, 0x33, 0xef, 0xd1, 0x88, 0x09, 0xdf, 0x91, 0xf4, 0x67, 0xbe, 0xc9, 0x9b, 0x41, 0xc2,
+	0xed, 0x79, 0x32, 0xdd, 0x73, 0xe3, 0x78, 0x31, 0xa2, 0x1d, 0x1a, 0x24, 0x9e, 0xeb, 0xf3, 0xa4,
+	0x9a, 0x31, 0x1d, 0x8b, 0xbe, 0x9e, 0x06, 0x43, 0x16, 0xdf, 0xfe, 0x00, 0x79, 0x9c, 0x9b, 0x97,
+	0x56, 0xbd, 0x38, 0xf6, 0x82, 0x6d, 0x3d, 0x0d, 0x84, 0x95, 0x6d, 0x56, 0x90, 0x7a, 0x7c, 0xb9,
+	0x18, 0x0d, 0x06, 0x3d, 0x8f, 0xf1, 0x91, 0xf1, 0xae, 0xd7, 0x5b, 0x8c, 0x3a, 0x31, 0x73, 0x2d,
+	0x8d, 0x69, 0x9b, 0x6e, 0x4b, 0xb4, 0x83, 0xc2, 0xb0, 0xdb, 0x64, 0x82, 0x7f, 0x12, 0x1e, 0x2f,
+	0x28, 0x24, 0xe8, 0x73, 0x03, 0x37, 0x72, 0x91, 0x3f, 0x3b, 0x07, 0xee, 0xed, 0x4b, 0xd2, 0xd1,
+	0xc5, 0xfd, 0x32, 0x37, 0x0d, 0x32, 0x90, 0x22, 0x9a, 0x3e, 0xd3, 0x8d, 0x0f, 0x71, 0xa6, 0xfb,
+	0x46, 0x32, 0xbe, 0xdb, 0xdf, 0xa4, 0x62, 0xe4, 0x9b, 0x13, 0xe9, 0xd9, 0x77, 0x4d, 0x83, 0xc0,
+	0xc4, 0x63, 0xa1, 0x9a, 0x3d, 0x4f, 0xfc, 0xc2, 0x3c, 0x0e, 0x1d, 0xaa, 0xb9, 0xbe, 0x2c, 0x9b,
+	0xc1, 0xc4, 0xc1, 0xae, 0xe1, 0x58, 0x6c, 0xd0, 0x98, 0x65, 0x62, 0xe0, 0x70, 0xa9, 0xae, 0xb5,
+	0x24, 0x00, 0x34, 0x0e, 0x1a, 0x47, 0xf1, 0x47, 0x8b, 0xe5, 0x0f, 0xdf, 0x74, 0x7d, 0xaf, 0xc3,
+	0xe3, 0x06, 0xa7, 0xd3, 0xc6, 0xd1, 0x56, 0x01, 0x0e, 0x14, 0x3e, 0x89, 0xf9, 0xb9, 0xcd, 0x41,
+	0x22, 0xcc, 0x8e, 0x51, 0x50, 0x25, 0x37, 0xdd, 0x48, 0x2a, 0x3c, 0xc7, 0xcc, 0x8c, 0x12, 0x74,
+	0x6f, 0xba, 0x91, 0x29, 0xf2, 0x18, 0x03, 0x90, 0x9c, 0xec, 0xd7, 0x48, 0x2d, 0xf1, 0xdd, 0x92,
+	0x52, 0x29, 0x0d, 0x8e, 0xda, 0x0a, 0xb6, 0x32, 0x1f, 0x03, 0xe3, 0x61, 0x3f, 0x89, 0xa7, 0xb7,
+	0x4d, 0xe9, 0xa6, 0x13, 0x07, 0xae, 0xcd, 0x18, 0x58, 0xab, 0xf3, 0x37, 0x26, 0x0b, 0x76, 0x1d,
+	0xa5, 0x08, 0xa0, 0x5b, 0x07, 0x27, 0xcd, 0x7a, 0x44, 0xb7, 0xbc, 0x3b, 0x42, 0x11, 0x53, 0x92,
+	0xed, 0xba, 0x82, 0x80, 0x81, 0x25, 0x9f, 0x69, 0xf5, 0xb7, 0xf0, 0x99, 0x4a, 0xfe, 0x19, 0x0e,
+	0x01, 0x03, 0xcb, 0x7e, 0x0f, 0x19, 0xf1, 0xba, 0xee, 0xb6, 0x8a, 0x22, 0x7e, 0x12, 0x45, 0xda,
+	0x32, 0x6b, 0x79, 0xf3, 0xee, 0xec, 0x94, 0xea, 0x10, 0x6b, 0x02, 0x81, 0x6b, 0xff, 0x82, 0x45,
+	0x26, 0xda, 0x61, 0xb7, 0x1b, 0x06, 0xfc, 0xf8, 0x2c, 0x6c, 0x01, 0xaf, 0x9d, 0x94, 0x9a, 0x34,
+	0xb7, 0x68, 0x30, 0xe3, 0xc6, 0x00, 0x95, 0xf3, 0x69, 0x82, 0x20, 0xd5, 0x2b, 0x53, 0xf2, 0xd5,
+	0x0f, 0x91, 0x7c, 0xbf, 0x66, 0x91, 0x19, 0xfe, 0xac, 0x71, 0xaa, 0x17, 0xe9, 0x8d, 0xe1, 0x09,
+	0xbf, 0x56, 0xce, 0xd0, 0xa1, 0x2c, 0xc5, 0x39, 0x38, 0xe4, 0x3b, 0x69, 0x5f, 0x21, 0x33, 0x5b,
+	0x61, 0xd4, 0xa6, 0xe6, 0x40, 0x08, 0xb1, 0xad, 0x08, 0x5d, 0xce, 0x22, 0x40, 0xfe, 0x19, 0xfb,
+	0x26, 0x79, 0xcc, 0x68, 0x34, 0xc7, 0x81, 0x4b, 0xee, 0xa7, 0x05, 0xb5, 0xc7, 0x2e, 0x17, 0x62,
+	0xc1, 0x80, 0xa7, 0xd3, 0x42, 0xb2, 0x31, 0x84, 0x90, 0x7c, 0x95, 0x9c, 0x6b, 0xe7, 0x47, 0x66,
+	0x2f, 0xee, 0x6f, 0xc6, 0x5c, 0x8e, 0x8f, 0x2d, 0x7c, 0x8d, 0x20, 0x70, 0x6e, 0x71, 0x10, 0x22,
+	0x0c, 0xa6, 0x61, 0x7f, 0x94, 0x8c, 0x45, 0x94, 0x7d, 0x95, 0x58, 0xe4, 0xfa, 0x1d, 0xd3, 0xda,
+	0xa1, 0x35, 0x78, 0x4e, 0x56, 0xef, 0x4c, 0xa2, 0x21, 0x06, 0xc5, 0xd1, 0xbe, 0x4d, 0x46, 0x7b,
+	0xe8, 0x31, 0x11, 0x19, 0x7e, 0xc7, 0x36, 0xec, 0x2b, 0xe6, 0xcc, 0x0f, 0x63, 0xd4, 0x4b, 0xe0,
+	0x4c, 0x40, 0x72, 0x43, 0x5d, 0xad, 0x1d, 0x76, 0x7b, 0x61, 0x40, 0x83, 0x44, 0x6e, 0x22, 0x53,
+	0xdc, 0x59, 0x22, 0x5b, 0xc1, 0xc0, 0xc8, 0xed, 0xe5, 0x1a, 0xad, 0x39, 0x73, 0xc0, 0x5e, 0x6e,
+	0x50, 0x1b, 0xf4, 0x3c, 0x6e, 0x36, 0xcc, 0xac, 0x78, 0xcb, 0x4b, 0x76, 0xd0, 0x8e, 0x2f, 0x8f,
+	0xdb, 0x53, 0xe9, 0xcd, 0x66, 0xa5, 0x00, 0x07, 0x0a, 0x9f, 0xcc, 0xee, 0xac, 0xd3, 0xf7, 0xb7,
+	0xb3, 0x9e, 0x1a, 0x62, 0x67, 0x6d, 0x91, 0xb3, 0xac, 0x07, 0x42, 0x4b, 0x96, 0x46, 0xcb, 0xb8,
+	0x69, 0xb3, 0xce, 0xab, 0xe4, 0x98, 0x95, 0x22, 0x24, 0x28, 0x7e, 0xf6, 0xfc, 0xb7, 0x91, 0x99,
+	0x9c, 0x90, 0x3b, 0x92, 0x41, 0x72, 0x89, 0x3c, 0x56, 0x2c, 0x4e, 0x8e, 0x64, 0x96, 0xfc, 0xd5,
+	0x4c, 0x50, 0xbb, 0x71, 0x44, 0x1b, 0xc2, 0xc4, 0xed, 0x92, 0x2a, 0x0d, 0xf6, 0xc4, 0xee, 0x7a,
+	0xf9, 0x78, 0xb3, 0xfa, 0x52, 0xb0, 0xc7, 0xa5, 0x21, 0xb3, 0xe3, 0x5d, 0x0a, 0xf6, 0x00, 0x69,
+	0xdb, 0x3f, 0x6e, 0xa5, 0x0e, 0x10, 0xdc, 0x30, 0xfe, 0xe1, 0x13, 0x39, 0x93, 0x0e, 0x7d, 0xa6,
+	0x70, 0xfe, 0x6d, 0x85, 0x5c, 0x38, 0x8c, 0xc8, 0x10, 0xc3, 0xf7, 0x0c, 0x46, 0xd5, 0x63, 0x98,
+	0x8a, 0xd8, 0xae, 0xc6, 0x71, 0x15, 0xf3, 0xc0, 0x95, 0x57, 0x41, 0x80, 0x6c, 0x9f, 0x54, 0xbb,
+	0x6e, 0x4f, 0xd8, 0x4b, 0x97, 0x8f, 0x9b, 0xfc, 0x87, 0xbf, 0x5d, 0x7f, 0xd5, 0xed, 0xf1, 0x39,
+	0x6f, 0x34, 0x00, 0xb2, 0xb1, 0x13, 0x52, 0x77, 0xa3, 0xc8, 0x95, 0x31, 0x11, 0xd7, 0xca, 0xe1,
+	0x37, 0x8f, 0x24, 0xb9, 0x4b, 0x39, 0xd5, 0x04, 0x9c, 0x99, 0xf3, 0xcf, 0xc6, 0x52, 0x99, 0x62,
+	0x2c, 0xd0, 0x25, 0x26, 0x23, 0xc2, 0x4c, 0x6a, 0x95, 0x9d, 0x73, 0xc9, 0xc8, 0x72, 0x0b, 0x04,
+	0xff, 0x1f, 0x04, 0x2b, 0xfb, 0xd3, 0x16, 0x2b, 0x1b, 0x21, 0xd3, 0xef, 0x9a, 0x95, 0x92, 0x63,
+	0x32, 0xcc, 0x2a, 0x16, 0x66, 0x31, 0x0a, 0xd9, 0x08, 0x26, 0x77, 0x51, 0x1a, 0x87, 0x9d, 0x66,
+	0xf2, 0xa5, 0x71, 0xb0, 0x19, 0x24, 0xdc, 0xbe, 0x53, 0x10, 0xd0, 0x52, 0x42, 0xe9, 0x81, 0x21,
+	0x42, 0x58, 0x7e, 0xd6, 0x22, 0x33, 0x5e, 0x36, 0x32, 0xa1, 0x59, 0x2f, 0x23, 0x64, 0x6a, 0x70,
+	0xe0, 0x83, 0x52, 0x74, 0x72, 0x20, 0xc8, 0x77, 0xc6, 0xee, 0x90, 0x9a, 0x17, 0x6c, 0x85, 0x42,
+	0xbd, 0x5b, 0x38, 0x5e, 0xa7, 0x96, 0x83, 0xad, 0x50, 0xaf, 0x66, 0xfc, 0x05, 0x8c, 0xba, 0xbd,
+	0x42, 0xce, 0xc8, 0x64, 0xa1, 0xab, 0x5e, 0x8c, 0xb6, 0xa4, 0x15, 0xaf, 0xeb, 0x25, 0x4c, 0x35,
+	0xab, 0x2e, 0x34, 0x71, 0x7b, 0x83, 0x02, 0x38, 0x14, 0x3e, 0x65, 0xbf, 0x41, 0x46, 0x65, 0x34,
+	0xc0, 0x58, 0x19, 0xf6, 0x84, 0xfc, 0xfc, 0x57, 0x93, 0x89, 0xff, 0x8e, 0x41, 0x32, 0xb4, 0x3f,
+	0x65, 0x91, 0x29, 0xfe, 0xff, 0xd5, 0xfd, 0x0e, 0xcf, 0x4f, 0x6c, 0x94, 0x11, 0xf2, 0xdf, 0x4a,
+	0xd1, 0x5c, 0xb0, 0xd1, 0x98, 0x91, 0x6e, 0x83, 0x0c, 0x5f, 0xfb, 0x49, 0xd2, 0xe8, 0xd0, 0x1e,
+	0x0d, 0x3a, 0xf1, 0x5a, 0xc0, 0x6a, 0x47, 0x34, 0x40, 0x37, 0x38, 0xff, 0x70, 0x82, 0xcc, 0xcc,
+	0x1f, 0x1c, 0x4a, 0x61, 0x3d, 0xe8, 0x50, 0x0a, 0x3c, 0x73, 0xc6, 0x3a, 0x0a, 0xa2, 0x84, 0x45,
+	0x28, 0xb8, 0x6a, 0x27, 0x35, 0xc6, 0x3b, 0x30, 0x1e, 0x76, 0x9f, 0x8c, 0xf0, 0xba, 0x55, 0xcd,
+	0x6a, 0x19, 0xce, 0x92, 0x4c, 0x71, 0x2d, 0x6d, 0xf4, 0xe2, 0xad, 0x20, 0x98, 0xd9, 0x77, 0xc8,
+	0xe8, 0x0e, 0x9f, 0xac, 0xe2, 0x24, 0xb8, 0x7a, 0xdc, 0xf1, 0x4d, 0xad, 0x00, 0x3d, 0x35, 0x45,
+	0x03, 0x48, 0x76, 0x2c, 0x72, 0xcf, 0x88, 0x2d, 0xe2, 0x62, 0xa6, 0xbc, 0x44, 0xcc, 0xe1, 0x03,
+	0x8b, 0x3e, 0x42, 0x26, 0x22, 0xda, 0x0e, 0x83, 0xb6, 0xe7, 0xd3, 0xce, 0xbc, 0x74, 0x97, 0x1d,
+	0x25, 0xff, 0x8e, 0xd9, 0x9a, 0xc0, 0xa0, 0x01, 0x29, 0x8a, 0x6c, 0x15, 0xaa, 0x9c, 0x7c, 0xfc,
+	0x20, 0x54, 0xb8, 0x45, 0x56, 0x4a, 0xaa, 0x00, 0xc0, 0x68, 0xf2, 0x55, 0x98, 0x6e, 0x83, 0x0c,
+	0x5f, 0xfb, 0x83, 0x84, 0x84, 0x9b, 0x3c, 0x3c, 0x6f, 0x3e, 0x69, 0x8e, 0x1d, 0xf9, 0x55, 0xa7,
+	0x78, 0x1e, 0xaf, 0xa4, 0x00, 0x06, 0x35, 0xfb, 0x1a, 0x21, 0x7c, 0xe5, 0xa0, 0x13, 0xb3, 0xd9,
+	0x48, 0x25, 0x50, 0x92, 0x96, 0x82, 0xbc, 0x79, 0x77, 0x36, 0x6f, 0x91, 0x46, 0x00, 0x18, 0x8f,
+	0xdb, 0xdf, 0x45, 0x46, 0xe3, 0x7e, 0xb7, 0xeb, 0x2a, 0x0f, 0x4a, 0x89, 0x99, 0xc1, 0x9c, 0xae,
+	0x21, 0x36, 0x79, 0x03, 0x48, 0x8e, 0xf6, 0x6b, 0xb8, 0x01, 0x08, 0xf9, 0xc5, 0x57, 0x11, 0xfb,
+	0x5f, 0xd8, 0x09, 0xdf, 0x2b, 0xcf, 0x38, 0x50, 0x80, 0x83, 0x01, 0x3c, 0xe9, 0xf6, 0x95, 0xb0,
+	0x2d, 0x4c, 0x6d, 0x45, 0x34, 0xed, 0x97, 0xc8, 0xb8, 0x7e, 0x6d, 0x59, 0x39, 0xe6, 0x9d, 0xba,
+	0x44, 0x17, 0x6b, 0x1e, 0x3c, 0x66, 0xe6, 0xc3, 0xf6, 0x2a, 0x39, 0xdd, 0x0e, 0x83, 0x24, 0x0a,
+	0x7d, 0x9f, 0x97, 0xef, 0xe3, 0x27, 0x77, 0xee, 0x61, 0x79, 0x42, 0x74, 0xfb, 0xf4, 0x62, 0x1e,
+	0x05, 0x8a, 0x9e, 0x43, 0x8d, 0x3d, 0xbb, 0x7b, 0x4c, 0x95, 0xe2, 0x7c, 0x4f, 0xd1, 0x14, 0x12,
+	0x4a, 0x19, 0xc5, 0x0f, 0xde, 0x47, 0x9c, 0x20, 0xed, 0x82, 0x15, 0x5f, 0xec, 0x3d, 0x64, 0x02,
+	0x93, 0x1c, 0xa2, 0xc0, 0xf5, 0x6f, 0xc0, 0x8a, 0x74, 0x67, 0xb0, 0x85, 0x79, 0xc9, 0x68, 0x87,
+	0x14, 0x16, 0x26, 0xc5, 0x0b, 0x1b, 0x9a, 0x91, 0x14, 0xcf, 0x6d, 0x68, 0xd2, 0x62, 0xe6, 0xfc,
+	0x4a, 0x35, 0xa5, 0xd1, 0x3e, 0x14, 0x87, 0x2f, 0xab, 0xbe, 0x24, 0xcb, 0x54, 0x31, 0x40, 0xb3,
+	0x52, 0x3a, 0x67, 0x15, 0x53, 0xb7, 0x66, 0x32, 0x82, 0x34, 0x5f, 0x7b, 0x97, 0xd4, 0x77, 0xc2,
+	0x38, 0x91, 0xe7, 0xb7, 0x63, 0x1e, 0x15, 0xaf, 0x86, 0x71, 0xc2, 0xd4, 0x30, 0xf5, 0xda, 0xd8,
+	0x12, 0x03, 0xe7, 0x81, 0x96, 0x81, 0x78, 0xc7, 0x8d, 0x3a, 0xf1, 0x22, 0x2b, 0x61, 0x51, 0x63,
+	0xfa, 0x97, 0xd2, 0xb6, 0x5b, 0x1a, 0x04, 0x26, 0x9e, 0xf3, 0xdf, 0xac, 0x94, 0xcf, 0xeb, 0x16,
+	0xcb, 0x47, 0xd8, 0xa3, 0x01, 0x8a, 0x28, 0x33, 0x02, 0xf2, 0x9b, 0x32, 0xd9, 0xdd, 0xef, 0x18,
+	0x54, 0x69, 0xf3, 0x36, 0x52, 0x98, 0x63, 0x24, 0x8c, 0x60, 0xc9, 0x8f, 0x5b, 0xe9, 0x34, 0xfd,
+	0x4a, 0x19, 0x07, 0x3b, 0xa3, 0xdf, 0x87, 0x67, 0xfc, 0x3b, 0x3f, 0x6e, 0x91, 0xd1, 0x05, 0xb7,
+	0xbd, 0x1b, 0x6e, 0x6d, 0xa1, 0x93, 0xa5, 0xd3, 0x8f, 0xcc, 0x8a, 0x01, 0xca, 0x94, 0xb5, 0x24,
+	0xda, 0x41, 0x61, 0xe0, 0xd4, 0xdf, 0x72, 0xdb, 0xb2, 0x60, 0x45, 0x95, 0x4f, 0xfd, 0xcb, 0xac,
+	0x05, 0x04, 0x04, 0x87, 0xbf, 0xeb, 0xde, 0x91, 0x0f, 0x67, 0x1d, 0x6e, 0xab, 0x1a, 0x04, 0x26,
+	0x9e, 0xf3, 0x2f, 0x2d, 0xd2, 0x5c, 0x70, 0x63, 0xaf, 0x8d, 0xd5, 0x47, 0x17, 0xbc, 0x64, 0xb3,
+	0xdf, 0xde, 0xa5, 0x09, 0x2f, 0x6c, 0x82, 0xbd, 0xec, 0xc7, 0x34, 0x32, 0xce, 0xd3, 0xaa, 0x97,
+	0x37, 0x44, 0x3b, 0x28, 0x0c, 0xfb, 0x0d, 0x32, 0x8e, 0x6e, 0xaa, 0xdb, 0x61, 0xd4, 0x01, 0xba,
+	0x55, 0x4e, 0xe9, 0xa3, 0x16, 0x6d, 0x47, 0x34, 0x01, 0xba, 0x25, 0xc2, 0x57, 0x34, 0x7d, 0x30,
+	0x99, 0x39, 0x3f, 0x68, 0x91, 0x33, 0x0b, 0xd4, 0x8d, 0x68, 0xc4, 0x2a, 0x25, 0xa9, 0x17, 0xb1,
+	0x5f, 0x27, 0x63, 0x09, 0xb6, 0x60, 0x8f, 0xac, 0x72, 0x7b, 0xc4, 0x02, 0x4f, 0x36, 0x04, 0x71,
+	0x50, 0x6c, 0x9c, 0x1f, 0xb5, 0xc8, 0xb9, 0xa2, 0xbe, 0x2c, 0xfa, 0x61, 0xbf, 0xf3, 0x30, 0x3a,
+	0xf4, 0xb7, 0x2c, 0x32, 0xc1, 0x9c, 0xf9, 0x4b, 0x34, 0x71, 0x3d, 0x3f, 0x57, 0xa5, 0xd1, 0x1a,
+	0xb2, 0x4a, 0xe3, 0x05, 0x52, 0xdb, 0x09, 0xbb, 0x34, 0x1b, 0x88, 0x72, 0x35, 0x44, 0xd3, 0x0a,
+	0x42, 0xd0, 0xcc, 0xd7, 0x75, 0xbd, 0x20, 0x71, 0x71, 0x39, 0x4a, 0x67, 0xc7, 0x34, 0x9f, 0x80,
+	0xaa, 0x19, 0x4c, 0x1c, 0xe7, 0xb7, 0x08, 0x19, 0x15, 0x51, 0x53, 0x43, 0x17, 0xda, 0x91, 0x36,
+	0x9e, 0xca, 0x40, 0x1b, 0x4f, 0x4c, 0x46, 0xda, 0xac, 0x94, 0x6e, 0xb3, 0x5a, 0x86, 0x45, 0x45,
+	0x74, 0x90, 0x57, 0xe7, 0xd5, 0xdd, 0xe2, 0xbf, 0x41, 0xb0, 0xb2, 0x3f, 0x63, 0x91, 0xe9, 0x76,
+	0x18, 0x04, 0xb4, 0xad, 0x75, 0xc7, 0x5a, 0x19, 0x07, 0x84, 0xc5, 0x34, 0x51, 0xed, 0x27, 0xce,
+	0x00, 0x20, 0xcb, 0x1e, 0x43, 0xb2, 0xf9, 0x98, 0xdd, 0x4c, 0x79, 0x68, 0x74, 0xf1, 0x3e, 0x13,
+	0x08, 0x69, 0x5c, 0x34, 0x64, 0x07, 0xba, 0x4c, 0xde, 0x88, 0x36, 0x64, 0x1b, 0x05, 0xf2, 0x0c,
+	0x0c, 0x2c, 0x91, 0x11, 0xd1, 0xad, 0x88, 0xc6, 0x3b, 0x22, 0xaa, 0x8c, 0xe9, 0xad, 0xa3, 0xf7,
+	0x57, 0x22, 0x03, 0x72, 0x94, 0xa0, 0x80, 0xba, 0xbd, 0x2b, 0x8c, 0x0c, 0x63, 0x65, 0xc8, 0x73,
+	0xf1, 0x99, 0x07, 0xda, 0x1a, 0x66, 0x49, 0x9d, 0x6d, 0x5d, 0x4c, 0x5f, 0xae, 0xf2, 0xb4, 0x4c,
+	0xb6, 0xb1, 0x01, 0x6f, 0xb7, 0x97, 0xc8, 0xa9, 0x4c, 0xe9, 0xc1, 0x58, 0x78, 0x52, 0x54, 0x0a,
+	0x5e, 0xa6, 0x68, 0x61, 0x0c, 0xb9, 0x27, 0x4c, 0x03, 0xd4, 0xf8, 0x21, 0x06, 0xa8, 0x7d, 0x15,
+	0xbb, 0xcc, 0x7d, 0x1c, 0x2f, 0x97, 0x32, 0x00, 0x43, 0x05, 0x2a, 0xff, 0x48, 0x26, 0x50, 0x79,
+	0xf2, 0x42, 0xf5, 0xf8, 0xa1, 0x38, 0xb2, 0x03, 0xf7, 0x11, 0x95, 0xbc, 0x4e, 0x26, 0xdd, 0x7e,
+	0xb2, 0x03, 0xd8, 0xc0, 0x26, 0xde, 0xd4, 0x51, 0x27, 0x1e, 0xa4, 0x09, 0xd8, 0xef, 0x22, 0x33,
+	0xaa, 0xc1, 0x0b, 0x83, 0x4b, 0x51, 0x14, 0x46, 0xdc, 0xe3, 0x01, 0x79, 0xc0, 0xc3, 0x8c, 0x72,
+	0xfe, 0x5f, 0x16, 0x91, 0xf3, 0x6a, 0xd1, 0x6d, 0xef, 0x50, 0x9c, 0xb2, 0x18, 0x14, 0xa8, 0xac,
+	0x23, 0x5c, 0x25, 0xb3, 0xd8, 0xac, 0x55, 0xba, 0x3b, 0xa4, 0xa0, 0x90, 0xc1, 0x46, 0x7f, 0x22,
+	0x8e, 0x10, 0x7f, 0x94, 0xeb, 0x1d, 0xca, 0x02, 0x33, 0xbf, 0xbe, 0x2c, 0x9e, 0xd2, 0x38, 0x76,
+	0x48, 0x66, 0x7c, 0x37, 0x4e, 0x58, 0x0f, 0xd0, 0x58, 0x72, 0x9f, 0x05, 0x72, 0x58, 0x9e, 0xd9,
+	0x4a, 0x96, 0x10, 0xe4, 0x69, 0x3b, 0x9f, 0x18, 0x21, 0x93, 0x29, 0xc9, 0x7c, 0x44, 0x85, 0xe5,
+	0x5d, 0x64, 0x4c, 0xea, 0x10, 0xd9, 0x4a, 0x60, 0x4a, 0xd1, 0x50, 0x18, 0xb8, 0x69, 0x6e, 0xea,
+	0x5d, 0x3d, 0xab, 0x60, 0x19, 0x1b, 0x3e, 0x98, 0x78, 0x6c, 0x53, 0x48, 0xfc, 0x78, 0xd1, 0xf7,
+	0x68, 0x90, 0xf0, 0x6e, 0x96, 0xb3, 0x29, 0x6c, 0xac, 0xb4, 0x4c, 0xa2, 0x7a, 0x53, 0xc8, 0x00,
+	0x20, 0xcb, 0xde, 0xfe, 0x7e, 0x8b, 0x4c, 0xba, 0xb7, 0x63, 0x5d, 0x6f, 0xbe, 0x59, 0x2f, 0x63,
+	0x93, 0x4c, 0x95, 0xb0, 0xe7, 0x6e, 0x87, 0x54, 0x13, 0xa4, 0x99, 0x62, 0xda, 0x8b, 0x4d, 0xef,
+	0xd0, 0xb6, 0x0c, 0xda, 0x16, 0x7d, 0x19, 0x29, 0xc3, 0x82, 0x70, 0x29, 0x47, 0x97, 0xef, 0x2a,
+	0xf9, 0x76, 0x28, 0xe8, 0x83, 0xfd, 0x12, 0xb1, 0x3b, 0x5e, 0xec, 0x6e, 0xfa, 0xe8, 0x67, 0x97,
+	0xb9, 0xd1, 0xc2, 0xdb, 0x7f, 0x5e, 0x8c, 0xb3, 0xbd, 0x94, 0xc3, 0x80, 0x82, 0xa7, 0xd8, 0x2c,
+	0x8b, 0xc2, 0x3b, 0xfb, 0x37, 0x22, 0xbf, 0x39, 0x96, 0x99, 0x65, 0xa2, 0x1d, 0x14, 0x86, 0x3d,
+	0x47, 0xec, 0x88, 0x09, 0x20, 0x1c, 0xa8, 0xe5, 0x20, 0xa1, 0xd1, 0x9e, 0xeb, 0x73, 0xfb, 0x0c,
+	0x14, 0x40, 0x9c, 0x3f, 0xad, 0xaa, 0xa5, 0xaf, 0x33, 0x1a, 0x5c, 0x23, 0xb2, 0xda, 0xba, 0xff,
+	0xc8, 0x6a, 0x1d, 0xf7, 0x95, 0xaf, 0x10, 0x90, 0x4a, 0x28, 0xae, 0x3c, 0xa4, 0x84, 0xe2, 0xef,
+	0xb5, 0x52, 0xd5, 0xf9, 0xc6, 0x9f, 0xff, 0x60, 0xb9, 0xd9, 0x14, 0x73, 0x3c, 0x26, 0x2d, 0xb3,
+	0x0f, 0x66, 0x42, 0x11, 0xdf, 0x45, 0xc6, 0xb6, 0x7c, 0x97, 0xd5, 0x94, 0x69, 0xd6, 0xd2, 0xf1,
+	0x72, 0x97, 0x45, 0x3b, 0x28, 0x0c, 0xdc, 0x25, 0x0c, 0xa2, 0x47, 0x92, 0xf2, 0x5f, 0xa8, 0x91,
+	0x71, 0x43, 0x43, 0x29, 0x54, 0x37, 0xad, 0x47, 0x4c, 0xdd, 0xac, 0x1c, 0x41, 0xdd, 0xfc, 0x1e,
+	0xd2, 0x68, 0xcb, 0xdd, 0xab, 0x9c, 0xdb, 0x06, 0xb2, 0x7b, 0xa2, 0xde, 0xc0, 0x54, 0x13, 0x68,
+	0x9e, 0x18, 0xe2, 0x63, 0x90, 0x49, 0xd9, 0x31, 0x8a, 0xb2, 0x4a, 0xc5, 0x0e, 0x98, 0x7f, 0x26,
+	0x1b, 0xed, 0x50, 0x1f, 0x22, 0xda, 0xe1, 0x7d, 0x64, 0x7a, 0xcb, 0x65, 0x26, 0xe8, 0xf5, 0x65,
+	0x56, 0xea, 0x3a, 0x55, 0x25, 0xef, 0x72, 0x1a, 0x04, 0x59, 0x5c, 0x54, 0xd5, 0x29, 0x6a, 0x21,
+	0x8b, 0x3b, 0x2e, 0x4b, 0xe1, 0x50, 0xaa, 0xfa, 0x25, 0xd5, 0x0a, 0x06, 0x06, 0xd6, 0x9a, 0x95,
+	0x73, 0xe9, 0x01, 0x14, 0x43, 0x7a, 0x2d, 0x5d, 0x0c, 0xe9, 0x52, 0x29, 0x5f, 0x75, 0x40, 0x15,
+	0xa4, 0xeb, 0x64, 0x14, 0x03, 0x34, 0xdc, 0xa0, 0x63, 0x7f, 0x2d, 0x19, 0x6d, 0xf3, 0x7f, 0x85,
+	0x89, 0x91, 0x79, 0xfa, 0x05, 0x14, 0x24, 0x0c, 0x23, 0x08, 0xdd, 0x68, 0x5b, 0x9a, 0x15, 0x59,
+	0x04, 0xe1, 0x7c, 0xb4, 0x1d, 0x03, 0x6b, 0x75, 0xfe, 0x87, 0x45, 0xa6, 0xf0, 0x11, 0x2f, 0x59,
+	0x95, 0xaf, 0xf3, 0x2c, 0x19, 0x41, 0xf5, 0x2f, 0xcc, 0x1d, 0x53, 0xe7, 0x59, 0x2b, 0x08, 0x28,
+	0x1e, 0x53, 0x55, 0x15, 0x0d, 0xe3, 0x98, 0xba, 0x84, 0x4b, 0x87, 0x41, 0x50, 0xd3, 0x8f, 0xfb,
+	0x9b, 0x45, 0xae, 0xe6, 0x16, 0x6f, 0x06, 0x09, 0x47, 0x62, 0x9b, 0x61, 0x67, 0xbf, 0x59, 0x4b,
+	0x13, 0x5b, 0x08, 0x3b, 0xfb, 0xc0, 0x20, 0x18, 0xa2, 0x1f, 0xef, 0xb8, 0x32, 0xa8, 0x41, 0x20,
+	0x54, 0x5b, 0x57, 0xe7, 0x01, 0xdb, 0x55, 0xc6, 0x49, 0xe4, 0x37, 0x47, 0x0e, 0xca, 0x38, 0x89,
+	0x7c, 0xe7, 0x9f, 0xd6, 0x08, 0x0b, 0x56, 0x72, 0x23, 0xda, 0xd9, 0x08, 0x59, 0x1d, 0xe6, 0x13,
+	0x8d, 0x09, 0xd0, 0xe7, 0xfc, 0x47, 0x39, 0x2e, 0xc0, 0xf0, 0x0d, 0x57, 0x1f, 0xb4, 0x6f, 0xb8,
+	0xd8, 0xdd, 0x5f, 0x7b, 0x84, 0xdc, 0xfd, 0xce, 0x0f, 0x5b, 0xc4, 0x56, 0xa1, 0x67, 0x3a, 0x1e,
+	0xe7, 0x22, 0x69, 0xa8, 0x58, 0x37, 0xb1, 0x5e, 0xb4, 0x14, 0x96, 0x00, 0xd0, 0x38, 0x43, 0x18,
+	0x77, 0x9e, 0x91, 0x5b, 0x64, 0x35, 0x9d, 0xb0, 0xc2, 0x36, 0x56, 0xb1, 0x63, 0x3a, 0xbf, 0x5d,
+	0x21, 0x8f, 0x71, 0x6d, 0x6e, 0xd5, 0x0d, 0xdc, 0x6d, 0xda, 0xc5, 0x5e, 0x0d, 0x1b, 0x61, 0xd5,
+	0x46, 0xab, 0x82, 0x27, 0xd3, 0x4b, 0x8e, 0x2b, 0xaf, 0xb8, 0x9c, 0xe1, 0x92, 0x65, 0x39, 0xf0,
+	0x12, 0x60, 0xc4, 0xed, 0x98, 0x8c, 0xc9, 0x9b, 0xa0, 0x9a, 0xd5, 0x32, 0x19, 0x29, 0x51, 0x2c,
+	0x14, 0x19, 0x0a, 0x8a, 0x11, 0x6a, 0x2b, 0x7e, 0xd8, 0xde, 0xc5, 0x25, 0x9f, 0xd5, 0x56, 0x56,
+	0x44, 0x3b, 0x28, 0x0c, 0xa7, 0x4b, 0xa6, 0xe5, 0x18, 0xf6, 0xb0, 0x80, 0x32, 0xdd, 0xc2, 0x2d,
+	0xbe, 0x2d, 0x9b, 0x8c, 0xcb, 0xa9, 0xd4, 0x16, 0xbf, 0x68, 0x02, 0x21, 0x8d, 0x2b, 0x4b, 0x33,
+	0x57, 0x8a, 0x4b, 0x33, 0x3b, 0xbf, 0x6d, 0x91, 0xac, 0x8e, 0x61, 0x14, 0xa2, 0xb5, 0x0e, 0x2c,
+	0x44, 0x7b, 0x84, 0x52, 0xae, 0xdf, 0x49, 0xc6, 0xdd, 0x04, 0x95, 0x48, 0x6e, 0x27, 0xa8, 0xde,
+	0x9f, 0x63, 0x75, 0x35, 0xec, 0x78, 0x5b, 0x1e, 0x52, 0x00, 0x93, 0x9c, 0xf3, 0x59, 0x8b, 0x34,
+	0x96, 0xa2, 0xfd, 0xa3, 0xe7, 0xf9, 0xe5, 0xb3, 0xf8, 0x2a, 0x47, 0xca, 0xe2, 0x93, 0x79, 0x82,
+	0xd5, 0x41, 0x79, 0x82, 0xce, 0x5f, 0xd4, 0xc8, 0x4c, 0x2e, 0x71, 0xd5, 0x7e, 0x91, 0x4c, 0xa8,
+	0xaf, 0x24, 0xad, 0xd2, 0x0d, 0x33, 0xf2, 0x5b, 0xc3, 0x20, 0x85, 0x39, 0xc4, 0x52, 0x5d, 0x26,
+	0xa7, 0x23, 0xb4, 0xd6, 0xf5, 0xe9, 0xfc, 0x56, 0x42, 0xa3, 0x16, 0x45, 0x5f, 0x3e, 0xaf, 0xe4,
+	0x5c, 0x5d, 0x78, 0x1c, 0x1d, 0x9c, 0x90, 0x07, 0x43, 0xd1, 0x33, 0x76, 0x8f, 0x4c, 0xfa, 0xe6,
+	0xf1, 0xa4, 0x59, 0xbb, 0xff, 0x93, 0x8d, 0x9a, 0xad, 0xa9, 0x66, 0x48, 0x33, 0x48, 0x9f, 0x71,
+	0xea, 0x0f, 0xe9, 0x8c, 0xf3, 0x7d, 0xfa, 0x8c, 0xc3, 0x03, 0xa9, 0x3e, 0x54, 0x72, 0xe2, 0xf2,
+	0x30, 0x87, 0x9c, 0xe3, 0x1c, 0x5b, 0x5e, 0x26, 0x63, 0x32, 0xc8, 0x74, 0xa8, 0xe0, 0x4c, 0x93,
+	0xce, 0x00, 0xd9, 0xfe, 0x2c, 0x79, 0xfb, 0xa5, 0x28, 0x32, 0x06, 0xf3, 0x7a, 0x98, 0xcc, 0xfb,
+	0x7e, 0x78, 0x1b, 0xd5, 0x95, 0x1b, 0x31, 0x15, 0x66, 0x52, 0xe7, 0xcd, 0x0a, 0x29, 0x38, 0xf1,
+	0xe3, 0x9a, 0xd4, 0x7a, 0x61, 0x6a, 0x4d, 0x1e, 0x4d, 0x37, 0xb4, 0xef, 0xf0, 0x40, 0x5c, 0xae,
+	0x0d, 0x7c, 0xa0, 0x6c, 0x8b, 0x85, 0x8e, 0xcd, 0x55, 0x92, 0x52, 0xc5, 0xe7, 0x3e, 0x4f, 0x88,
+	0x3e, 0x3d, 0x08, 0x9d, 0x50, 0xc5, 0xce, 0xe8, 0x43, 0x06, 0x18, 0x58, 0x68, 0xc0, 0xf2, 0x82,
+	0x38, 0x71, 0x7d, 0xff, 0xaa, 0x17, 0x24, 0x42, 0x4f, 0x54, 0x6a, 0xcf, 0xb2, 0x06, 0x81, 0x89,
+	0x77, 0xfe, 0xbd, 0xc6, 0xf7, 0x3b, 0xca, 0x77, 0xdf, 0x21, 0xe7, 0xae, 0x78, 0x89, 0xca, 0xf0,
+	0x54, 0xf3, 0x0d, 0xb5, 0x75, 0x25, 0xab, 0xac, 0x81, 0x39, 0xcd, 0x46, 0x86, 0x65, 0x25, 0x9d,
+	0x10, 0x9a, 0xcd, 0xb0, 0x74, 0xda, 0xe4, 0xcc, 0x15, 0x2f, 0xc1, 0xec, 0xb5, 0x13, 0x64, 0xf2,
+	0x9b, 0x23, 0x64, 0xc2, 0x2c, 0x7c, 0x70, 0x14, 0xc9, 0x8e, 0x95, 0x7a, 0x64, 0xaa, 0xaf, 0xa7,
+	0xe2, 0x01, 0x6e, 0x1d, 0xbb, 0x0a, 0x43, 0xf1, 0xe0, 0x1a, 0xaa, 0xac, 0xe6, 0x09, 0x66, 0x07,
+	0xec, 0xdb, 0xa4, 0xbe, 0xc5, 0x92, 0x05, 0xab, 0x65, 0x44, 0x72, 0x15, 0x0d, 0xbe, 0x5e, 0xb9,
+	0x3c, 0xdd, 0x90, 0xf3, 0x43, 0xf5, 0x23, 0x4a, 0xe7, 0xa8, 0x1b, 0x29, 0x1c, 0xbc, 0x1d, 0x14,
+	0xc6, 0xa0, 0xdd, 0xa3, 0x7e, 0x1f, 0xbb, 0x47, 0x4a, 0x96, 0x8f, 0x3c, 0x24, 0x59, 0xce, 0x12,
+	0x3f, 0x93, 0x1d, 0xa6, 0x1c, 0x8b, 0x9c, 0xb3, 0x51, 0x36, 0x08, 0x46, 0xe2, 0x67, 0x0a, 0x0c,
+	0x59, 0x7c, 0xfb, 0x63, 0x6a, 0x37, 0x18, 0x2b, 0xc3, 0xdf, 0x62, 0xce, 0xe8, 0x93, 0xde, 0x08,
+	0x7e, 0xb8, 0x42, 0xa6, 0xae, 0x04, 0xfd, 0xf5, 0x2b, 0xeb, 0xfd, 0x4d, 0xdf, 0x6b, 0x5f, 0xa3,
+	0xfb, 0x28, 0xed, 0x77, 0xe9, 0xfe, 0xf2, 0x92, 0x58, 0x41, 0x6a, 0xce, 0x5c, 0xc3, 0x46, 0xe0,
+	0x30, 0x94, 0x5b, 0x5b, 0x5e, 0xb0, 0x4d, 0xa3, 0x5e, 0xe4, 0x09, 0x57, 0x84, 0x21, 0xb7, 0x2e,
+	0x6b, 0x10, 0x98, 0x78, 0x48, 0x3b, 0xbc, 0x1d, 0xa8, 0x2a, 0x54, 0x8a, 0xf6, 0x1a, 0x36, 0x02,
+	0x87, 0x21, 0x52, 0x12, 0xf5, 0x85, 0xe5, 0xce, 0x40, 0xda, 0xc0, 0x46, 0xe0, 0x30, 0x71, 0x4a,
+	0x67, 0x81, 0x72, 0xf5, 0xdc, 0x29, 0x1d, 0x9b, 0x41, 0xc2, 0x11, 0x75, 0x97, 0xee, 0x2f, 0xb9,
+	0x89, 0x9b, 0x3d, 0x64, 0x5f, 0xe3, 0xcd, 0x20, 0xe1, 0xac, 0x2c, 0x75, 0x7a, 0x38, 0xbe, 0xe2,
+	0xca, 0x52, 0xa7, 0xbb, 0x3f, 0xc0, 0x20, 0xf3, 0x37, 0x2b, 0x64, 0xe2, 0xad, 0xbb, 0x63, 0xf3,
+	0xd4, 0x9d, 0x5b, 0x64, 0x26, 0x97, 0x6e, 0x3e, 0x84, 0x86, 0x74, 0x68, 0x39, 0x10, 0x07, 0xc8,
+	0x38, 0x12, 0x96, 0xe5, 0x18, 0x17, 0xc9, 0x0c, 0x5f, 0xbc, 0xc8, 0x89, 0x65, 0x0f, 0xab, 0x12,
+	0x02, 0xcc, 0xd7, 0x76, 0x33, 0x0b, 0x84, 0x3c, 0x3e, 0xde, 0xb9, 0x33, 0x99, 0xaa, 0x00, 0x50,
+	0x92, 0x2e, 0xc7, 0x56, 0x77, 0xc8, 0x82, 0xbc, 0x59, 0x4a, 0x4e, 0x95, 0x6d, 0xc3, 0x7a, 0x75,
+	0x6b, 0x10, 0x98, 0x78, 0xce, 0x17, 0xaa, 0x64, 0x4c, 0x06, 0xa4, 0x0d, 0xd1, 0x95, 0x4f, 0x5b,
+	0x64, 0x52, 0xf9, 0x37, 0xf1, 0x19, 0xb1, 0x00, 0xae, 0x1f, 0x3f, 0x24, 0x4e, 0xd9, 0x4f, 0xd0,
+	0xc0, 0xac, 0x0e, 0x16, 0x60, 0x32, 0x83, 0x34, 0x6f, 0xfb, 0x26, 0xa6, 0x8d, 0xc4, 0x09, 0xed,
+	0x1a, 0xa6, 0x6e, 0xc7, 0x98, 0x65, 0x73, 0xed, 0x30, 0xa2, 0x38, 0xa7, 0x30, 0x8c, 0xaf, 0xa5,
+	0x30, 0xb5, 0x86, 0xa7, 0xdb, 0xc0, 0xa0, 0x84, 0x57, 0xe5, 0xf8, 0x66, 0xa6, 0x30, 0x94, 0x13,
+	0xf0, 0x37, 0x4c, 0x38, 0xc0, 0x31, 0xdc, 0xdf, 0xce, 0x2f, 0x57, 0xc8, 0xa9, 0xec, 0x48, 0xda,
+	0x1f, 0xc2, 0x48, 0x6f, 0x7d, 0xfb, 0x62, 0x26, 0x0a, 0x70, 0x02, 0x0c, 0xd8, 0x9b, 0x77, 0x67,
+	0x67, 0xf3, 0x97, 0x90, 0xcf, 0x99, 0x28, 0x90, 0x22, 0xc6, 0x7d, 0xe3, 0x22, 0x88, 0x64, 0x61,
+	0x7f, 0xbe, 0xd7, 0x13, 0x0e, 0x6e, 0xc3, 0x37, 0x6e, 0x42, 0x21, 0x83, 0x8d, 0x79, 0x95, 0x46,
+	0xcb, 0x75, 0xea, 0x6d, 0xef, 0x6c, 0x86, 0x91, 0x3c, 0xd7, 0x3e, 0xa9, 0x63, 0x8e, 0xf3, 0x38,
+	0x50, 0xf8, 0x24, 0x2a, 0x46, 0x6d, 0xb7, 0xe7, 0xb6, 0xbd, 0x64, 0x5f, 0xb8, 0x1c, 0x94, 0x18,
+	0x5f, 0x14, 0xed, 0xa0, 0x30, 0x9c, 0xbf, 0x57, 0x23, 0xa7, 0x78, 0x90, 0x2d, 0x55, 0x31, 0xe4,
+	0xf6, 0x87, 0x48, 0x23, 0x4e, 0xdc, 0x88, 0x1b, 0x35, 0xac, 0x23, 0x8b, 0x2e, 0x5d, 0xb6, 0x40,
+	0x12, 0x01, 0x4d, 0x0f, 0x63, 0xd1, 0xb7, 0xbc, 0xc0, 0x8b, 0x77, 0x18, 0xf5, 0xca, 0xfd, 0x99,
+	0x4c, 0x2e, 0x2b, 0x0a, 0x60, 0x50, 0xb3, 0xbf, 0x95, 0xd4, 0x7b, 0x3b, 0x6e, 0x2c, 0xed, 0x79,
+	0xcf, 0x4a, 0x39, 0xb1, 0x8e, 0x8d, 0x18, 0x4d, 0x9d, 0x7d, 0x55, 0x06, 0x00, 0xfe, 0x90, 0x29,
+	0xe5, 0x6b, 0x87, 0x5f, 0x6a, 0xd4, 0x89, 0xf6, 0x5b, 0x57, 0xe7, 0xb3, 0xd7, 0xe0, 0x2c, 0xb1,
+	0x56, 0x10, 0x50, 0x94, 0x49, 0x3b, 0x9c, 0x65, 0x07, 0x91, 0x47, 0xd2, 0x1a, 0xc7, 0x55, 0x0d,
+	0x02, 0x13, 0x0f, 0x2b, 0x09, 0x66, 0x43, 0xb0, 0x47, 0x4f, 0x20, 0x81, 0x67, 0xd8, 0xe0, 0xeb,
+	0x4b, 0xa4, 0xc1, 0xff, 0xa7, 0x1b, 0x21, 0x1a, 0x79, 0xb8, 0xb9, 0x68, 0x21, 0x72, 0x83, 0xf6,
+	0x4e, 0xd6, 0xc8, 0xb3, 0x61, 0xc0, 0x20, 0x85, 0xe9, 0xac, 0x92, 0xda, 0x90, 0x42, 0x76, 0xa8,
+	0xb3, 0xfb, 0xcb, 0x64, 0x0c, 0xc9, 0xc9, 0x03, 0x5a, 0x19, 0x24, 0x43, 0x32, 0x26, 0xaf, 0xc8,
+	0xb4, 0x1d, 0x52, 0xf5, 0x5c, 0x19, 0xea, 0xa2, 0x96, 0xd0, 0x72, 0x1c, 0xf7, 0xd9, 0xb4, 0x43,
+	0xa0, 0xfd, 0x0c, 0xa9, 0xd2, 0x3b, 0xbd, 0x6c, 0x4c, 0xcb, 0xa5, 0x3b, 0x3d, 0x2f, 0xa2, 0x31,
+	0x22, 0xd1, 0x3b, 0x3d, 0xfb, 0x3c, 0xa9, 0x78, 0x1d, 0x31, 0x23, 0x89, 0xc0, 0xa9, 0x2c, 0x2f,
+	0x41, 0xc5, 0xeb, 0x38, 0x77, 0x48, 0x43, 0x32, 0x64, 0x41, 0xd6, 0x5c, 0xa5, 0xb2, 0xca, 0x08,
+	0xb2, 0x96, 0x74, 0x07, 0x28, 0x53, 0x7d, 0x42, 0x74, 0x3d, 0x8c, 0xb2, 0xb6, 0xe0, 0x0b, 0xa4,
+	0xd6, 0x0e, 0x45, 0x25, 0xa3, 0x31, 0x4d, 0x86, 0xe9, 0x52, 0x0c, 0xe2, 0xdc, 0x22, 0x53, 0xd7,
+	0x82, 0xf0, 0x36, 0xbb, 0x3a, 0x8b, 0x55, 0x8a, 0x46, 0xc2, 0x5b, 0xf8, 0x4f, 0x56, 0x73, 0x67,
+	0x50, 0xe0, 0x30, 0x55, 0xc3, 0xb6, 0x32, 0xa8, 0x86, 0xad, 0xf3, 0x71, 0x8b, 0x4c, 0xa8, 0xc4,
+	0xfa, 0x2b, 0x7b, 0xbb, 0x48, 0x77, 0x1b, 0x5d, 0x9a, 0x59, 0xba, 0xcc, 0xcf, 0x09, 0x1c, 0x66,
+	0x56, 0x9c, 0xa8, 0x1c, 0x52, 0x71, 0xe2, 0x02, 0xa9, 0xed, 0x7a, 0x41, 0x27, 0x6b, 0x14, 0xc5,
+	0x8b, 0x84, 0x81, 0x41, 0x9c, 0xbf, 0xb4, 0xc8, 0x29, 0xd5, 0x05, 0xa9, 0x33, 0xbd, 0x48, 0x26,
+	0x36, 0xfb, 0x9e, 0xdf, 0x11, 0xbf, 0xb3, 0xcb, 0x65, 0xc1, 0x80, 0x41, 0x0a, 0x13, 0x2d, 0x33,
+	0x9b, 0x5e, 0xe0, 0x46, 0xfb, 0xeb, 0x5a, 0x49, 0x53, 0xfb, 0xf6, 0x82, 0x82, 0x80, 0x81, 0x85,
+	0x85, 0x12, 0xf6, 0xa4, 0xb3, 0xb8, 0x5a, 0x6a, 0xa1, 0x04, 0x31, 0x1e, 0x7a, 0x25, 0x28, 0xef,
+	0xb3, 0xe2, 0xe8, 0xfc, 0x58, 0x95, 0x4c, 0xa5, 0x8b, 0x1b, 0x0c, 0x61, 0x39, 0x79, 0x86, 0xd4,
+	0x59, 0xbd, 0x83, 0xec, 0xc4, 0x62, 0xcf, 0x03, 0x87, 0x61, 0x14, 0x2e, 0x17, 0x25, 0xe5, 0x5c,
+	0xe0, 0xaa, 0x3a, 0xa9, 0xec, 0xb8, 0x2c, 0x10, 0x5e, 0x98, 0xc5, 0x05, 0x2b, 0x8c, 0x6e, 0x1a,
+	0x0d, 0x7b, 0x66, 0xf1, 0xd4, 0x0f, 0x94, 0x59, 0xf8, 0x41, 0x64, 0x57, 0x0b, 0x6d, 0x48, 0x4d,
+	0x3c, 0x39, 0x19, 0x24, 0xeb, 0xf3, 0xdf, 0x4c, 0x26, 0x4c, 0xcc, 0xc3, 0x14, 0xa2, 0x31, 0x53,
+	0x21, 0xfa, 0xb4, 0x39, 0x25, 0x45, 0x69, 0x8b, 0x21, 0x16, 0xfb, 0x0d, 0x52, 0x6f, 0xab, 0x68,
+	0xbd, 0xfb, 0xba, 0xb6, 0x41, 0x95, 0x7e, 0x43, 0x32, 0xc0, 0xa9, 0x61, 0xac, 0xc0, 0x94, 0xd1,
+	0x9b, 0x78, 0xb9, 0x63, 0x47, 0xa4, 0xba, 0xbd, 0xb7, 0x2b, 0x94, 0x8c, 0x97, 0x4a, 0x1a, 0xde,
+	0x2b, 0x7b, 0xbb, 0x7a, 0x85, 0x99, 0xad, 0x80, 0xcc, 0x86, 0x70, 0x36, 0xa4, 0x2a, 0xa0, 0x54,
+	0x0f, 0xaf, 0x80, 0xe2, 0x7c, 0xb6, 0x42, 0x66, 0x72, 0x93, 0xca, 0x7e, 0x83, 0xd4, 0x23, 0x7c,
+	0xcb, 0xa6, 0x55, 0xc6, 0xe6, 0x9d, 0x1e, 0x39, 0xbd, 0x79, 0xa7, 0xdb, 0x81, 0xb3, 0xc4, 0xc0,
+	0x33, 0x1d, 0xd3, 0xaa, 0x3c, 0x1d, 0xfc, 0x95, 0x55, 0xe0, 0xd9, 0x7c, 0x0e, 0x03, 0x0a, 0x9e,
+	0x42, 0x4f, 0x5d, 0xda, 0x61, 0x92, 0x29, 0xc7, 0x7d, 0x90, 0xef, 0xc3, 0xf9, 0x8c, 0x39, 0x05,
+	0x6f, 0x6a, 0x61, 0x7a, 0xdc, 0xc3, 0x69, 0x4e, 0xb2, 0x56, 0x87, 0x95, 0xac, 0xce, 0xbf, 0xa8,
+	0x90, 0xc9, 0x54, 0x79, 0x5d, 0xdb, 0x27, 0x63, 0xd4, 0x67, 0x9e, 0x5d, 0xb9, 0xfb, 0x1e, 0xf7,
+	0xa6, 0x1d, 0x25, 0x27, 0x2f, 0x09, 0xba, 0xa0, 0x38, 0x3c, 0x1a, 0x21, 0x6f, 0x2f, 0x92, 0x09,
+	0xd9, 0xa1, 0x0f, 0xb8, 0x5d, 0x3f, 0x3b, 0x7c, 0x97, 0x0c, 0x18, 0xa4, 0x30, 0x9d, 0xdf, 0xa9,
+	0x92, 0x26, 0x77, 0x85, 0x77, 0xd4, 0x62, 0x50, 0x21, 0x2d, 0x3f, 0xa4, 0x8b, 0x60, 0x5b, 0x65,
+	0x5c, 0x27, 0x3f, 0x88, 0xd1, 0x50, 0x91, 0xe5, 0x3f, 0x93, 0x89, 0x2c, 0xe7, 0x47, 0xf5, 0xed,
+	0x13, 0xea, 0xd1, 0xd1, 0x43, 0xcd, 0x1f, 0x66, 0xa8, 0xf7, 0x3f, 0xaa, 0x90, 0xe9, 0xcc, 0xad,
+	0x81, 0x58, 0x0c, 0xd1, 0xbc, 0x68, 0xc6, 0x2a, 0xc3, 0x4d, 0x78, 0xe0, 0x45, 0x72, 0x47, 0xbb,
+	0x6e, 0xe6, 0x21, 0x2d, 0x15, 0xe7, 0x8b, 0x15, 0x32, 0x95, 0xbe, 0xee, 0xf0, 0x11, 0x1c, 0xa9,
+	0xaf, 0x27, 0x0d, 0x76, 0xa3, 0xd7, 0x35, 0xba, 0x2f, 0xbd, 0x8c, 0xfc, 0xf2, 0x24, 0xd9, 0x08,
+	0x1a, 0xfe, 0x48, 0xdc, 0xe2, 0xe3, 0xfc, 0xa2, 0x45, 0xce, 0xf2, 0xb7, 0xcc, 0xce, 0xc3, 0xbf,
+	0x5e, 0x34, 0xba, 0xaf, 0x94, 0xdb, 0xc1, 0x4c, 0xf1, 0xf6, 0xc3, 0xc6, 0x97, 0x5d, 0xaa, 0x2f,
+	0x7a, 0x9b, 0x9e, 0x0a, 0x8f, 0x60, 0x67, 0x8f, 0x34, 0x19, 0x9c, 0x7f, 0x5f, 0x21, 0xe3, 0x6b,
+	0x8b, 0xcb, 0x4a, 0x84, 0x63, 0xa0, 0x55, 0x44, 0x5d, 0x6d, 0xfe, 0x31, 0x03, 0xad, 0x24, 0x00,
+	0x34, 0x0e, 0x9e, 0xa2, 0x78, 0xa0, 0x62, 0x9c, 0x3d, 0x45, 0xf1, 0x38, 0xc6, 0x18, 0x24, 0x1c,
+	0xad, 0x53, 0x2c, 0xc3, 0x1a, 0x83, 0x07, 0xab, 0x69, 0xb7, 0x1d, 0xcb, 0xc0, 0x46, 0x6f, 0xa7,
+	0xc2, 0x40, 0xc2, 0x9d, 0xb0, 0x1d, 0x23, 0x72, 0xc6, 0x22, 0xb3, 0x84, 0xcd, 0xe8, 0x19, 0x15,
+	0x70, 0xec, 0x34, 0xb7, 0x5a, 0x20, 0x72, 0x3d, 0xdd, 0x69, 0x6e, 0xde, 0x40, 0x74, 0x8d, 0x73,
+	0x94, 0x32, 0xab, 0x99, 0x2c, 0xc7, 0xd1, 0xe1, 0xb2, 0x1c, 0x9d, 0x2f, 0x56, 0x49, 0x43, 0x1b,
+	0xd5, 0x3c, 0x51, 0x56, 0xa4, 0x94, 0xcb, 0x01, 0x30, 0x73, 0x45, 0x91, 0xe6, 0xd1, 0x04, 0x46,
+	0x55, 0x91, 0x1f, 0xb0, 0xd0, 0x41, 0xef, 0x25, 0x9e, 0xcb, 0x6c, 0x83, 0xe5, 0x5c, 0xb2, 0xae,
+	0xd8, 0x2d, 0x73, 0xca, 0x61, 0x64, 0xba, 0xfc, 0x15, 0x33, 0x30, 0x39, 0xdb, 0x1f, 0x11, 0x49,
+	0x75, 0xd5, 0xd2, 0x2a, 0xf7, 0x8c, 0x65, 0x32, 0xe9, 0x7a, 0xa8, 0x63, 0x27, 0x51, 0x49, 0x05,
+	0xaf, 0x00, 0x49, 0xa9, 0x4b, 0x6a, 0xd4, 0x29, 0x86, 0x35, 0x03, 0x67, 0xe4, 0xc4, 0xc4, 0xce,
+	0x8f, 0xc5, 0x11, 0x13, 0x86, 0x30, 0x25, 0xaa, 0x9f, 0x84, 0x5d, 0x1c, 0x26, 0x11, 0x30, 0xa0,
+	0x53, 0xa2, 0x24, 0x00, 0x34, 0x8e, 0xf3, 0x63, 0x75, 0x92, 0x29, 0xf2, 0x61, 0xdf, 0x21, 0x0d,
+	0x55, 0xe6, 0xa3, 0x9c, 0x04, 0x60, 0x3d, 0xa3, 0x54, 0x67, 0x54, 0x13, 0x68, 0x66, 0xf6, 0xb6,
+	0x34, 0xb3, 0xf2, 0xd5, 0xfe, 0x72, 0xd6, 0xcc, 0xfa, 0xed, 0xc3, 0x79, 0xdd, 0x70, 0xae, 0x5e,
+	0xe4, 0x45, 0x1f, 0xe7, 0x0e, 0xb5, 0xc8, 0x1e, 0x76, 0xcd, 0xfc, 0x27, 0xc4, 0x95, 0x70, 0x40,
+	0xe3, 0xbe, 0x9f, 0x88, 0xd9, 0xf0, 0x72, 0x89, 0xab, 0x8c, 0x13, 0xd6, 0xa5, 0xb4, 0xf8, 0x6f,
+	0x30, 0x98, 0xa6, 0xed, 0xe6, 0x23, 0x27, 0x6a, 0x37, 0x1f, 0x2d, 0xd5, 0x6e, 0xfe, 0x3c, 0x21,
+	0x6c, 0x6e, 0xf3, 0x44, 0x85, 0x31, 0x66, 0xce, 0x54, 0x5b, 0x0c, 0x28, 0x08, 0x18, 0x58, 0xce,
+	0x37, 0x90, 0x74, 0x2d, 0x38, 0xcc, 0x69, 0xe5, 0xa5, 0xe7, 0xb8, 0x47, 0x90, 0xe5, 0xb4, 0xa6,
+	0xaa, 0xc4, 0xfd, 0x9a, 0x45, 0xcc, 0x82, 0x75, 0xf6, 0xeb, 0xbc, 0x32, 0x9e, 0x55, 0x86, 0x87,
+	0xc9, 0xa0, 0x3b, 0xb7, 0xea, 0xf6, 0x32, 0xd1, 0x4e, 0xb2, 0x3c, 0x1e, 0x86, 0x20, 0x49, 0xe8,
+	0x91, 0x94, 0xe5, 0x8f, 0x91, 0xd3, 0xb2, 0x3e, 0x86, 0x74, 0x06, 0x89, 0xa8, 0x83, 0xc3, 0x6d,
+	0x8c, 0xd2, 0x70, 0x58, 0x19, 0x64, 0x38, 0x54, 0xa7, 0xe1, 0xea, 0xc0, 0x9a, 0xf7, 0xbf, 0x6e,
+	0x91, 0x0b, 0xd9, 0x0e, 0xc4, 0xab, 0x61, 0xe0, 0x25, 0x61, 0xd4, 0xa2, 0x49, 0xe2, 0x05, 0xdb,
+	0xac, 0x80, 0xf1, 0x6d, 0x37, 0x92, 0x97, 0x58, 0x31, 0x41, 0x79, 0xcb, 0x8d, 0x02, 0x60, 0xad,
+	0x98, 0xe0, 0xcb, 0x43, 0xad, 0xc5, 0x29, 0xe8, 0x98, 0x6b, 0xa3, 0x60, 0x38, 0xf4, 0x31, 0x8c,
+	0x87, 0x79, 0x83, 0x60, 0xe8, 0x7c, 0xc9, 0x22, 0xf6, 0xda, 0x1e, 0x8d, 0x22, 0xaf, 0x63, 0x04,
+	0x87, 0xb3, 0xab, 0x55, 0x8d, 0x2b, 0x54, 0xcd, 0xea, 0x2d, 0x99, 0xab, 0x55, 0x8d, 0x5f, 0xc5,
+	0x57, 0xab, 0x56, 0x8e, 0x76, 0xb5, 0xaa, 0xbd, 0x46, 0xce, 0x76, 0xf9, 0x31, 0x8e, 0x5f, 0x57,
+	0xc8, 0xcf, 0x74, 0xaa, 0xd0, 0xc0, 0x39, 0x2c, 0x07, 0xba, 0x5a, 0x84, 0x00, 0xc5, 0xcf, 0x39,
+	0xef, 0x25, 0x36, 0x8f, 0x09, 0x5f, 0x2c, 0x0a, 0x6b, 0x1d, 0x68, 0xe6, 0x70, 0x7e, 0xba, 0x4e,
+	0xa6, 0x33, 0x57, 0x9c, 0xe0, 0x11, 0x3a, 0x1f, 0x47, 0x7b, 0xec, 0xfd, 0x3b, 0xdf, 0xbd, 0xa1,
+	0x22, 0x73, 0x03, 0x52, 0xf7, 0x82, 0x5e, 0x3f, 0x29, 0xa7, 0xce, 0x09, 0xef, 0xc4, 0x32, 0x12,
+	0x34, 0xfc, 0x12, 0xf8, 0x13, 0x38, 0x9b, 0x32, 0xe3, 0x7c, 0x53, 0x87, 0x9c, 0xda, 0x43, 0x32,
+	0xb3, 0x7c, 0x42, 0x47, 0xdd, 0xd6, 0xcb, 0xb0, 0x21, 0x67, 0x26, 0xcb, 0x49, 0x87, 0x5a, 0xfd,
+	0x4a, 0x85, 0x8c, 0x1b, 0x1f, 0xcd, 0xfe, 0xb9, 0x74, 0x39, 0x57, 0xab, 0xbc, 0x57, 0x62, 0xf4,
+	0xe7, 0x74, 0xc1, 0x56, 0xfe, 0x4a, 0xcf, 0xe6, 0x2b, 0xb9, 0xbe, 0x79, 0x77, 0xf6, 0x54, 0xa6,
+	0x56, 0x6b, 0xaa, 0xba, 0xeb, 0xf9, 0xef, 0x26, 0xd3, 0x19, 0x32, 0x05, 0xaf, 0xbc, 0x61, 0xbe,
+	0xf2, 0xb1, 0xcd, 0x7d, 0xe6, 0x90, 0xfd, 0x12, 0x0e, 0x99, 0x28, 0xaf, 0x10, 0xfa, 0x74, 0x08,
+	0x5b, 0x67, 0xe6, 0x7c, 0x51, 0x19, 0xb2, 0x8a, 0xca, 0x3b, 0xc9, 0x58, 0x2f, 0xf4, 0xbd, 0xb6,
+	0xa7, 0xaa, 0xc1, 0xb3, 0xba, 0x2d, 0xeb, 0xa2, 0x0d, 0x14, 0xd4, 0xbe, 0x4d, 0x1a, 0xaf, 0xdd,
+	0x4e, 0xb8, 0x9b, 0xb1, 0x59, 0x2b, 0xd5, 0xbb, 0xa8, 0x94, 0x16, 0xd9, 0x12, 0x83, 0xe6, 0x85,
+	0xf5, 0x86, 0xb6, 0x79, 0x0e, 0x62, 0x5d, 0x97, 0xda, 0x12, 0xa9, 0x87, 0x02, 0xe2, 0xfc, 0xbb,
+	0x71, 0x72, 0xa6, 0xe8, 0x9e, 0x29, 0xfb, 0xa3, 0x64, 0x84, 0xf7, 0xb1, 0x9c, 0xab, 0x0c, 0x8b,
+	0x78, 0x5c, 0x61, 0x04, 0x45, 0xb7, 0xd8, 0xff, 0x20, 0x78, 0x0a, 0xee, 0xbe, 0xbb, 0xd9, 0xac,
+	0x9c, 0x20, 0xf7, 0x15, 0x57, 0x73, 0x5f, 0x71, 0x39, 0x77, 0xdf, 0xdd, 0xb4, 0xef, 0x90, 0xfa,
+	0xb6, 0x97, 0x50, 0x57, 0x18, 0x67, 0x6e, 0x9d, 0x08, 0x73, 0xea, 0x72, 0x2d, 0x8d, 0xfd, 0x0b,
+	0x9c, 0x21, 0x26, 0x88, 0x4d, 0x6f, 0xa6, 0xcb, 0x37, 0x09, 0xe1, 0xe9, 0x96, 0xdf, 0x89, 0x4c,
+	0x9d, 0x28, 0x9e, 0xa3, 0x9a, 0x69, 0x84, 0x6c, 0x77, 0x30, 0x93, 0x61, 0x74, 0xcb, 0xf3, 0x8d,
+	0xcb, 0x5a, 0x4e, 0xe0, 0xe3, 0x5c, 0x66, 0x0c, 0xf4, 0x89, 0x83, 0xff, 0x8e, 0x41, 0x72, 0x1e,
+	0xb4, 0x53, 0x8d, 0x1c, 0x77, 0xa7, 0x1a, 0x7d, 0x48, 0x3b, 0xd5, 0xa7, 0x2c, 0xd2, 0x50, 0x23,
+	0x2d, 0xca, 0xe0, 0x7c, 0xe8, 0x04, 0x3f, 0x39, 0xb7, 0x48, 0xa9, 0x9f, 0xa0, 0x99, 0x63, 0x42,
+	0xfa, 0xb8, 0xfb, 0x46, 0x3f, 0xa2, 0x1d, 0xba, 0x17, 0xf6, 0x62, 0x51, 0xbd, 0xf6, 0x95, 0xf2,
+	0x3b, 0x33, 0x8f, 0x4c, 0x96, 0xe8, 0xde, 0x5a, 0x2f, 0x16, 0x69, 0xd5, 0xba, 0x01, 0xcc, 0x2e,
+	0x60, 0xe1, 0x52, 0xb9, 0x8f, 0x93, 0x32, 0x6a, 0x98, 0x17, 0xf5, 0x66, 0xa8, 0x2a, 0x01, 0x94,
+	0x3c, 0xd1, 0x0e, 0x83, 0xc4, 0x0b, 0xfa, 0x74, 0x2d, 0x00, 0xda, 0x0b, 0xaf, 0x87, 0xc9, 0xe5,
+	0xb0, 0x1f, 0x74, 0x78, 0x55, 0x99, 0xf1, 0xf4, 0x0d, 0xb6, 0x8b, 0x83, 0x51, 0xe1, 0x20, 0x3a,
+	0xc7, 0xd1, 0x19, 0xee, 0x56, 0xc8, 0xec, 0x21, 0x83, 0x8d, 0xde, 0xa7, 0x30, 0xda, 0x76, 0x03,
+	0xef, 0x0d, 0xb3, 0x74, 0x9d, 0x52, 0x48, 0xd7, 0x0c, 0x18, 0xa4, 0x30, 0xcd, 0x9a, 0x46, 0x95,
+	0x43, 0x6a, 0x1a, 0x5d, 0x20, 0xb5, 0x88, 0xf6, 0xc2, 0xec, 0xb9, 0x0a, 0x5f, 0x16, 0x18, 0x04,
+	0xd3, 0x08, 0xdd, 0x9e, 0x27, 0x8c, 0x8b, 0xea, 0xb8, 0x38, 0xbf, 0xbe, 0x0c, 0xd8, 0x9e, 0x2a,
+	0xb1, 0x56, 0x7f, 0x20, 0x25, 0xd6, 0x70, 0xc7, 0x14, 0xee, 0xb3, 0x11, 0xbd, 0x63, 0xa6, 0xdd,
+	0x5a, 0xce, 0x67, 0xab, 0xe4, 0xa9, 0x03, 0x97, 0x96, 0x0e, 0x59, 0xb7, 0x0e, 0x08, 0x59, 0x97,
+	0xc3, 0x53, 0x39, 0x6c, 0x78, 0xaa, 0x03, 0x86, 0xe7, 0xfb, 0x50, 0x62, 0xc8, 0x92, 0x7f, 0xe5,
+	0xdc, 0xc2, 0x3f, 0xa8, 0x82, 0xa0, 0x10, 0x16, 0x12, 0x0a, 0x9a, 0x2f, 0x1e, 0x97, 0x52, 0xf5,
+	0x74, 0xea, 0x65, 0xec, 0x98, 0x03, 0xcb, 0xee, 0x71, 0x31, 0x31, 0xa8, 0x48, 0x8f, 0xf3, 0x1b,
+	0x35, 0xf2, 0xcc, 0x10, 0x1b, 0x9d, 0x39, 0x8b, 0xad, 0x21, 0x67, 0xf1, 0x57, 0xf8, 0x67, 0xfa,
+	0x64, 0xe1, 0x67, 0x82, 0xf2, 0x3f, 0xd3, 0xc1, 0x5f, 0x88, 0x79, 0x20, 0x82, 0x98, 0xb6, 0xfb,
+	0x11, 0x4f, 0xdf, 0x31, 0xf2, 0x96, 0x97, 0x45, 0x3b, 0x28, 0x0c, 0x3c, 0xfe, 0xb6, 0x5d, 0x5c,
+	0xfe, 0xa3, 0x25, 0xd5, 0x43, 0x31, 0x53, 0xa0, 0xb9, 0xf6, 0xb5, 0x38, 0x8f, 0x12, 0x80, 0xb3,
+	0xc1, 0x2a, 0x9a, 0xe7, 0x07, 0x6b, 0x23, 0x58, 0x0f, 0x64, 0x93, 0x05, 0x53, 0xae, 0xb2, 0x90,
+	0x29, 0x31, 0x75, 0xd8, 0xfb, 0xea, 0x66, 0x30, 0x71, 0xd0, 0x5e, 0x62, 0x46, 0x61, 0xae, 0x1a,
+	0xb1, 0x56, 0xcc, 0x5e, 0xb2, 0x91, 0x05, 0x42, 0x1e, 0x1f, 0xab, 0x82, 0x24, 0x5e, 0xe2, 0x53,
+	0xfe, 0x34, 0x9f, 0x68, 0xcc, 0xa0, 0xb8, 0xa1, 0x5a, 0xc1, 0xc0, 0x70, 0xbe, 0x5c, 0x2d, 0x7e,
+	0x0d, 0xae, 0xe5, 0x1e, 0x65, 0xf6, 0x8b, 0xb9, 0x5d, 0x19, 0x42, 0x42, 0x57, 0x1f, 0xb4, 0x84,
+	0xae, 0x0d, 0x92, 0xd0, 0x58, 0xbe, 0xcf, 0xb8, 0x13, 0x97, 0x57, 0xd4, 0xe1, 0x4e, 0x29, 0x55,
+	0xbe, 0x6f, 0x3d, 0x03, 0x87, 0xdc, 0x13, 0x8f, 0xf8, 0x54, 0xfd, 0x5c, 0x85, 0x9c, 0x1b, 0x78,
+	0xb0, 0x78, 0x40, 0x3b, 0x90, 0xf9, 0xf9, 0x6b, 0x0f, 0xe6, 0xf3, 0x9b, 0x1f, 0xa5, 0x7e, 0xe8,
+	0x47, 0x19, 0x66, 0x3b, 0xff, 0x83, 0xca, 0xc0, 0xc5, 0x82, 0x07, 0xd1, 0xaf, 0xda, 0x91, 0xfc,
+	0x16, 0x32, 0xe9, 0xf6, 0x7a, 0x1c, 0x8f, 0x65, 0x66, 0x64, 0x4a, 0x8a, 0xce, 0x9b, 0x40, 0x48,
+	0xe3, 0x0e, 0x35, 0xb0, 0x7f, 0x6c, 0x91, 0x06, 0xd0, 0x2d, 0x2e, 0xe1, 0xf0, 0x5e, 0x07, 0x36,
+	0x44, 0x56, 0x19, 0xf7, 0x3a, 0xe0, 0xc0, 0xc6, 0x1e, 0xbb, 0xec, 0xa0, 0x68, 0xb0, 0x8f, 0x5b,
+	0x81, 0x41, 0xdd, 0xa4, 0x5b, 0x1d, 0x7c, 0x93, 0xae, 0xf3, 0x9b, 0x0d, 0x7c, 0xbd, 0x5e, 0x88,
+	0xd7, 0x79, 0xc6, 0xf8, 0x7d, 0xfb, 0x91, 0xdf, 0xb4, 0xd2, 0xdf, 0x17, 0x9d, 0xde, 0xd8, 0x9e,
+	0xf2, 0x4f, 0x56, 0x8e, 0x54, 0xd0, 0xb0, 0x7a, 0x68, 0x41, 0x43, 0x2c, 0xd6, 0x15, 0xef, 0xac,
+	0x47, 0xde, 0x9e, 0x9b, 0xa0, 0x23, 0xa0, 0x59, 0x4b, 0x7f, 0xc8, 0x56, 0xeb, 0xaa, 0x06, 0x42,
+	0x1a, 0x17, 0x6b, 0x65, 0xe9, 0xb2, 0x82, 0x34, 0x4a, 0x58, 0xca, 0x23, 0x9f, 0x09, 0xaa, 0x6c,
+	0x8c, 0x2e, 0x44, 0x28, 0x10, 0x20, 0xff, 0x0c, 0xca, 0xdc, 0x54, 0x23, 0x76, 0x64, 0x24, 0x2d,
+	0x73, 0x53, 0x74, 0xb0, 0x2f, 0xb9, 0x27, 0xb0, 0x98, 0x3e, 0x9f, 0x18, 0xf3, 0xbd, 0x9e, 0xf1,
+	0x46, 0xa3, 0xe9, 0x62, 0xfa, 0x57, 0xf2, 0x28, 0x50, 0xf4, 0x1c, 0x9a, 0xf6, 0x54, 0xf3, 0xf2,
+	0x92, 0x70, 0xad, 0x29, 0xd3, 0x9e, 0x22, 0xb3, 0xdc, 0x01, 0x13, 0x0f, 0x6f, 0x72, 0xd3, 0x3f,
+	0x79, 0x0a, 0x3d, 0xf7, 0x37, 0x2f, 0x89, 0x8a, 0xb1, 0xea, 0x26, 0xb7, 0x2b, 0x85, 0x68, 0x1d,
+	0x18, 0xf4, 0xbc, 0xbd, 0x49, 0xce, 0x2b, 0xd0, 0xa5, 0x20, 0x61, 0x49, 0xae, 0x31, 0x5d, 0x70,
+	0x63, 0x16, 0x39, 0x41, 0xd8, 0x7b, 0x3a, 0x82, 0xfa, 0xf9, 0x2b, 0x5e, 0x72, 0xb5, 0x08, 0x13,
+	0x56, 0xe0, 0x00, 0x2a, 0xe8, 0xde, 0xa6, 0x81, 0xbb, 0xe9, 0xd3, 0xb5, 0xc5, 0x65, 0x71, 0x22,
+	0xd5, 0xd9, 0x11, 0x12, 0x00, 0x1a, 0x47, 0xc5, 0xf7, 0x4f, 0x0c, 0x8a, 0xef, 0xc7, 0x44, 0xa9,
+	0xed, 0x76, 0x0f, 0xb5, 0x4c, 0xaf, 0x4d, 0xe7, 0xdb, 0x2c, 0xa0, 0x18, 0x3f, 0x0c, 0xbf, 0xe5,
+	0x40, 0x25, 0x4a, 0x5d, 0x59, 0x5c, 0xcf, 0xe1, 0x40, 0xe1, 0x93, 0x2c, 0xf0, 0x1c, 0x8b, 0x25,
+	0x36, 0x4f, 0x67, 0x02, 0xcf, 0xb1, 0x11, 0x38, 0x0c, 0xc3, 0x68, 0x59, 0xb2, 0xe0, 0xd5, 0x24,
+	0xe9, 0x29, 0xb5, 0xb6, 0x79, 0x26, 0x5d, 0xbf, 0xf1, 0x72, 0x0e, 0x03, 0x0a, 0x9e, 0x42, 0xad,
+	0x27, 0x08, 0x19, 0xf5, 0xe6, 0xe3, 0x69, 0xad, 0xe7, 0x3a, 0x6f, 0x06, 0x09, 0xb7, 0xbf, 0x93,
+	0x34, 0xfb, 0x31, 0x65, 0x07, 0xe6, 0x5b, 0x61, 0xb4, 0xeb, 0x87, 0x6e, 0x67, 0x99, 0x5d, 0xd9,
+	0x9b, 0xec, 0x37, 0x9b, 0x8c, 0xf9, 0x05, 0xf1, 0x6c, 0xf3, 0xc6, 0x00, 0x3c, 0x18, 0x48, 0x21,
+	0x5b, 0x80, 0xf4, 0xdc, 0x90, 0x05, 0x48, 0xd7, 0xc9, 0x19, 0xb9, 0xaf, 0xad, 0x2d, 0x2e, 0xab,
+	0x97, 0x6e, 0x9e, 0x4f, 0xdf, 0x01, 0xb8, 0x5c, 0x80, 0x03, 0x85, 0x4f, 0x3a, 0x7f, 0x64, 0x91,
+	0x49, 0x25, 0xc1, 0x1e, 0x40, 0xd2, 0xb2, 0x9f, 0x4e, 0x5a, 0xbe, 0x72, 0xfc, 0x3d, 0x80, 0xf5,
+	0x7c, 0x40, 0x8a, 0xcd, 0x4f, 0x4e, 0x12, 0xa2, 0xf7, 0x09, 0xb5, 0x45, 0x5b, 0x03, 0xb7, 0xe8,
+	0x47, 0x56, 0x46, 0x17, 0x15, 0x88, 0xac, 0x3f, 0xdc, 0x02, 0x91, 0x2d, 0x72, 0x56, 0x4e, 0x29,
+	0xee, 0x52, 0xc6, 0xbc, 0x4f, 0x29, 0xf2, 0x8d, 0x4b, 0x1d, 0x97, 0x8b, 0x90, 0xa0, 0xf8, 0xd9,
+	0x94, 0x6e, 0x37, 0x7a, 0xa8, 0x6e, 0xa7, 0xa4, 0xdc, 0xca, 0x96, 0xbc, 0x72, 0x35, 0x23, 0xe5,
+	0x56, 0x2e, 0xb7, 0x40, 0xe3, 0x14, 0x6f, 0x75, 0x8d, 0x92, 0xb6, 0x3a, 0x72, 0xe4, 0xad, 0x4e,
+	0x0a, 0xdd, 0xf1, 0x81, 0x42, 0x57, 0xba, 0xae, 0x26, 0x06, 0xba, 0xae, 0xde, 0x4f, 0xa6, 0xbc,
+	0x60, 0x87, 0x46, 0x5e, 0x42, 0x3b, 0x6c, 0x2d, 0x30, 0x81, 0x3c, 0xa6, 0x15, 0x9d, 0xe5, 0x14,
+	0x14, 0x32, 0xd8, 0xe9, 0x9d, 0x62, 0x6a, 0x88, 0x9d, 0x62, 0xc0, 0xfe, 0x3c, 0x5d, 0xce, 0xfe,
+	0x7c, 0xea, 0xf8, 0xfb, 0xf3, 0xcc, 0x89, 0xee, 0xcf, 0x76, 0x29, 0xfb, 0xf3, 0x50, 0x5b, 0x9f,
+	0x71, 0x48, 0x3f, 0x73, 0xc8, 0x21, 0x7d, 0xd0, 0xe6, 0x7c, 0xf6, 0xbe, 0x37, 0xe7, 0xe2, 0x7d,
+	0xf7, 0xb1, 0xb7, 0xf6, 0xdd, 0x52, 0xf6, 0xdd, 0x4f, 0x55, 0xc8, 0x59, 0xbd, 0x33, 0xa1, 0x3c,
+	0xf0, 0xb6, 0x50, 0x36, 0xb3, 0x7b, 0xcc, 0xb9, 0xc3, 0xdb, 0x48, 0x95, 0xd7, 0xc5, 0x02, 0x14,
+	0x04, 0x0c, 0x2c, 0x96, 0x71, 0x4e, 0x23, 0x76, 0x47, 0x4e, 0x76, 0xdb, 0x5a, 0x14, 0xed, 0xa0,
+	0x30, 0x70, 0x10, 0xf0, 0x7f, 0x51, 0xf0, 0x24, 0x5b, 0xfd, 0x7c, 0x51, 0x83, 0xc0, 0xc4, 0x43,
+	0x67, 0x77, 0x5b, 0x8a, 0x4c, 0xdc, 0xba, 0x26, 0xf8, 0xb1, 0x52, 0x49, 0x49, 0x05, 0x95, 0xdd,
+	0x61, 0x15, 0x11, 0xea, 0xf9, 0xee, 0x60, 0x3b, 0x28, 0x0c, 0xe7, 0x7f, 0x5a, 0xe4, 0x5c, 0xe1,
+	0x50, 0x3c, 0x00, 0x75, 0xe4, 0x4e, 0x5a, 0x1d, 0x69, 0x95, 0x75, 0x24, 0x35, 0xde, 0x62, 0x80,
+	0x6a, 0xf2, 0x1f, 0x2d, 0x32, 0xa5, 0xf1, 0x1f, 0xc0, 0xab, 0x7a, 0xe9, 0x57, 0x2d, 0xef, 0xf4,
+	0xdd, 0xc8, 0xbd, 0xdb, 0xef, 0x54, 0x88, 0xba, 0x91, 0x60, 0xbe, 0x9d, 0x0c, 0x97, 0x6e, 0xb6,
+	0x4f, 0x46, 0x58, 0x04, 0x49, 0x5c, 0x4e, 0x74, 0x5c, 0x9a, 0x3f, 0x8b, 0x46, 0xd1, 0x0e, 0x3d,
+	0xf6, 0x33, 0x06, 0xc1, 0x90, 0xdd, 0xe0, 0xc4, 0x8b, 0xbd, 0x77, 0x44, 0xe2, 0xb4, 0xbe, 0xc1,
+	0x49, 0xb4, 0x83, 0xc2, 0xc0, 0x0d, 0xd3, 0x6b, 0x87, 0xc1, 0xa2, 0xef, 0xc6, 0xb1, 0xd0, 0xe1,
+	0xd4, 0x86, 0xb9, 0x2c, 0x01, 0xa0, 0x71, 0x58, 0x70, 0x89, 0x17, 0xf7, 0x7c, 0x77, 0xdf, 0xb0,
+	0xb1, 0x18, 0x85, 0xbd, 0x14, 0x08, 0x4c, 0x3c, 0xa7, 0x4b, 0x9a, 0xe9, 0x97, 0x58, 0xa2, 0x5b,
+	0x2c, 0xb2, 0x7b, 0xa8, 0xe1, 0xc4, 0xf8, 0x66, 0xf6, 0xd4, 0x4a, 0xdf, 0x6d, 0x56, 0xd2, 0xbd,
+	0x9c, 0x97, 0x00, 0xd0, 0x38, 0xce, 0x3f, 0xb6, 0xc8, 0xe9, 0x82, 0x41, 0x2b, 0x31, 0x31, 0x3d,
+	0xd1, 0xd2, 0xa6, 0x48, 0xd5, 0xc1, 0x54, 0x03, 0xba, 0xe5, 0xca, 0xd8, 0x61, 0x33, 0xd5, 0x80,
+	0x37, 0x83, 0x84, 0x63, 0xfa, 0xe0, 0x74, 0xba, 0xaf, 0x31, 0x4b, 0xb7, 0xe4, 0xc3, 0xe4, 0xc5,
+	0xed, 0x70, 0x8f, 0x46, 0xfb, 0xf8, 0xe6, 0x56, 0x26, 0xdd, 0x32, 0x87, 0x01, 0x05, 0x4f, 0xb1,
+	0xfb, 0x50, 0x3a, 0x6a, 0xb4, 0xe5, 0x8c, 0xbc, 0x59, 0xe6, 0x8c, 0xd4, 0x1f, 0xd3, 0x98, 0x0a,
+	0x9a, 0x25, 0x98, 0xfc, 0x51, 0xe5, 0x62, 0xc9, 0x22, 0x98, 0x51, 0x99, 0x78, 0x81, 0x78, 0x65,
+	0x31, 0x57, 0x95, 0xca, 0xb5, 0x9a, 0x47, 0x81, 0xa2, 0xe7, 0x9c, 0x2f, 0xd5, 0x88, 0x2a, 0xba,
+	0xc2, 0xe2, 0x40, 0x4b, 0x8a, 0xa2, 0x3d, 0x6a, 0xd2, 0xae, 0x9a, 0x5b, 0xb5, 0x83, 0x02, 0xb3,
+	0xb8, 0x61, 0xce, 0xb4, 0xe0, 0xab, 0x01, 0xdb, 0xd0, 0x20, 0x30, 0xf1, 0xb0, 0x27, 0xbe, 0xb7,
+	0x47, 0xf9, 0x43, 0x23, 0xe9, 0x9e, 0xac, 0x48, 0x00, 0x68, 0x1c, 0xec, 0x49, 0xc7, 0xdb, 0xda,
+	0x6a, 0x8e, 0xa6, 0x7b, 0x82, 0xa3, 0x03, 0x0c, 0xc2, 0x6f, 0xcc, 0x0a, 0x77, 0xc5, 0x31, 0xc3,
+	0xb8, 0x31, 0x2b, 0xdc, 0x05, 0x06, 0xc1, 0xaf, 0x14, 0x84, 0x51, 0xd7, 0xf5, 0xbd, 0x37, 0x68,
+	0x47, 0x71, 0x11, 0xc7, 0x0b, 0xf5, 0x95, 0xae, 0xe7, 0x51, 0xa0, 0xe8, 0x39, 0x9c, 0xd0, 0xbd,
+	0x88, 0x76, 0xbc, 0x76, 0x62, 0x52, 0x23, 0xe9, 0x09, 0xbd, 0x9e, 0xc3, 0x80, 0x82, 0xa7, 0xb0,
+	0x5a, 0x9d, 0x2c, 0x9a, 0x23, 0x0b, 0x4d, 0x8e, 0xa7, 0xab, 0xd5, 0x41, 0x1a, 0x0c, 0x59, 0x7c,
+	0x14, 0x92, 0x5d, 0x51, 0x26, 0xb7, 0x39, 0x91, 0x16, 0x92, 0xb2, 0x7c, 0x2e, 0x28, 0x0c, 0xe7,
+	0x13, 0x55, 0xdc, 0xd4, 0x07, 0x54, 0xa3, 0x7e, 0x60, 0x51, 0xdb, 0xe9, 0x19, 0x59, 0x1b, 0x62,
+	0x46, 0x62, 0x44, 0x74, 0x1c, 0x06, 0x2a, 0x22, 0xba, 0x3e, 0x30, 0x22, 0xda, 0xc0, 0x2a, 0x8e,
+	0x88, 0x1e, 0x29, 0x2b, 0x22, 0x7a, 0xf4, 0x3e, 0x23, 0xa2, 0xff, 0x75, 0x9d, 0xa8, 0x2b, 0x51,
+	0xaf, 0xd3, 0xe4, 0x76, 0x18, 0xed, 0x7a, 0xc1, 0x36, 0x2b, 0x00, 0xf3, 0xb3, 0x96, 0xac, 0x21,
+	0xb3, 0x62, 0x66, 0x0a, 0x6f, 0x95, 0x74, 0xad, 0x65, 0x8a, 0xd9, 0xdc, 0x86, 0xc1, 0x88, 0x47,
+	0xd6, 0x64, 0x6a, 0xd5, 0x70, 0x10, 0xa4, 0x7a, 0x64, 0x7f, 0x37, 0x21, 0xd2, 0x24, 0xbf, 0x25,
+	0x25, 0xf0, 0x72, 0x39, 0xfd, 0x43, 0x97, 0x88, 0x52, 0xa9, 0x37, 0x14, 0x13, 0x30, 0x18, 0x62,
+	0x2c, 0x96, 0x74, 0x6f, 0xf0, 0xd4, 0xa9, 0x8f, 0x9c, 0xc8, 0xd8, 0x0c, 0x93, 0x43, 0x0d, 0x64,
+	0xd4, 0x0b, 0xb6, 0x71, 0x9e, 0x88, 0xc8, 0xd1, 0x77, 0x14, 0xd5, 0x17, 0x5b, 0x09, 0xdd, 0xce,
+	0x82, 0xeb, 0xbb, 0x41, 0x1b, 0xef, 0x14, 0x61, 0xe8, 0x7a, 0x07, 0x15, 0x0d, 0x20, 0x09, 0xe5,
+	0xee, 0x6d, 0xad, 0x0f, 0x73, 0x6f, 0xeb, 0xf9, 0x6f, 0x23, 0x33, 0xb9, 0x8f, 0x79, 0xa4, 0x94,
+	0xe9, 0x63, 0x54, 0x16, 0xfb, 0x8d, 0x11, 0xbd, 0x69, 0x61, 0x2d, 0x35, 0x76, 0x0d, 0x68, 0xa4,
+	0xbf, 0xa8, 0x50, 0x99, 0x4b, 0x9c, 0x22, 0x6a, 0x9b, 0x31, 0x1a, 0xc1, 0x64, 0x89, 0x73, 0xb4,
+	0xe7, 0x46, 0x34, 0x38, 0xe9, 0x39, 0xba, 0xae, 0x98, 0x80, 0xc1, 0xd0, 0xde, 0x49, 0xe5, 0xf6,
+	0x5d, 0x3e, 0x7e, 0x6e, 0x1f, 0xab, 0xf6, 0x5a, 0x74, 0x5b, 0xde, 0x67, 0x2c, 0x32, 0x15, 0xa4,
+	0x66, 0x6e, 0x39, 0xe1, 0xfc, 0xc5, 0xab, 0x82, 0xdf, 0xa8, 0x9d, 0x6e, 0x83, 0x0c, 0xff, 0xa2,
+	0x2d, 0xad, 0x7e, 0xc4, 0x2d, 0x4d, 0x5f, 0x43, 0x3c, 0x32, 0xe8, 0x1a, 0x62, 0x3b, 0x50, 0xf7,
+	0xc3, 0x8f, 0x96, 0x51, 0x21, 0x25, 0x75, 0x39, 0x3c, 0x29, 0xb8, 0x18, 0xfe, 0x96, 0x99, 0xfa,
+	0x7b, 0xf4, 0x7b, 0xc2, 0x27, 0x07, 0xa5, 0x08, 0x3b, 0xff, 0xa7, 0x46, 0x4e, 0xc9, 0x11, 0x91,
+	0xa9, 0x40, 0xb8, 0x3f, 0x72, 0xbe, 0x5a, 0x57, 0x56, 0xfb, 0xe3, 0x55, 0x09, 0x00, 0x8d, 0x83,
+	0xfa, 0x58, 0x3f, 0xc6, 0xea, 0x6d, 0xc1, 0x8a, 0xb7, 0x19, 0x0b, 0xf7, 0xbb, 0x5a, 0x28, 0x37,
+	0x34, 0x08, 0x4c, 0x3c, 0x96, 0x9f, 0xdc, 0x36, 0x8b, 0x84, 0xe8, 0xfc, 0xe4, 0xb6, 0x28, 0xb6,
+	0x23, 0xe0, 0xf6, 0x4f, 0x15, 0x5e, 0x8f, 0x51, 0x4e, 0x02, 0x6d, 0x2e, 0x03, 0xea, 0x68, 0xf7,
+	0x62, 0xd8, 0x7f, 0xdf, 0x22, 0x67, 0x79, 0xab, 0x1c, 0xc9, 0x1b, 0xbd, 0x8e, 0x9b, 0xd0, 0xb8,
+	0x39, 0x72, 0x42, 0xfd, 0xd3, 0x56, 0xf4, 0x22, 0xb6, 0x50, 0xdc, 0x1b, 0xac, 0x8d, 0x30, 0xbd,
+	0x9b, 0x2a, 0xf2, 0x25, 0xb7, 0x8e, 0xe3, 0x56, 0xc0, 0x49, 0x11, 0xd5, 0x4b, 0x2d, 0xdd, 0x1e,
+	0x43, 0x96, 0x3b, 0x5e, 0xbd, 0x63, 0x8a, 0xd1, 0x07, 0x5f, 0x1b, 0xec, 0xe8, 0xaa, 0xa0, 0xd4,
+	0x2e, 0xeb, 0x03, 0xb5, 0x4b, 0x74, 0xf8, 0x7b, 0x9d, 0xe6, 0x48, 0xc6, 0xe1, 0xbf, 0xbc, 0x04,
+	0xd8, 0xee, 0xfc, 0x49, 0x5d, 0x9b, 0x41, 0x44, 0x7e, 0xea, 0x57, 0xc5, 0x6b, 0x6f, 0xa9, 0xa2,
+	0xbf, 0xfc, 0xcd, 0xaf, 0xe7, 0x8a, 0xfe, 0x7e, 0xeb, 0xd1, 0xd3, 0x8f, 0xf9, 0x00, 0x0d, 0xaa,
+	0xf9, 0x3b, 0x7a, 0x48, 0xee, 0xf1, 0x6b, 0x64, 0x0c, 0x8f, 0x60, 0xcc, 0x9e, 0x39, 0x96, 0xea,
+	0xd4, 0xd8, 0x55, 0xd1, 0xfe, 0xe6, 0xdd, 0xd9, 0x6f, 0x3e, 0x7a, 0xb7, 0xe4, 0xd3, 0xa0, 0xe8,
+	0xdb, 0x31, 0x69, 0xe0, 0xff, 0x2c, 0x4d, 0x5a, 0x1c, 0xee, 0x6e, 0x28, 0x99, 0x29, 0x01, 0xa5,
+	0xe4, 0x60, 0x6b, 0x3e, 0x76, 0x40, 0x1a, 0x88, 0xc8, 0x99, 0xf2, 0x33, 0xe0, 0xba, 0x64, 0xda,
+	0x92, 0x80, 0x37, 0xef, 0xce, 0x7e, 0xcb, 0xd1, 0x99, 0xaa, 0xc7, 0x41, 0xb3, 0x30, 0xb6, 0xc6,
+	0xf1, 0x81, 0x37, 0xf4, 0xff, 0xdf, 0x9a, 0x9e, 0xdf, 0xfc, 0xd3, 0x7f, 0x75, 0xcc, 0xef, 0x17,
+	0x33, 0xf3, 0xfb, 0x42, 0x6e, 0x7e, 0x4f, 0xe1, 0x98, 0x15, 0x54, 0xa9, 0x7e, 0xd0, 0xca, 0xc2,
+	0xe1, 0x36, 0x09, 0xa6, 0x25, 0xbd, 0xde, 0xf7, 0x22, 0x1a, 0xaf, 0x47, 0xfd, 0x00, 0xcb, 0x32,
+	0x37, 0x18, 0xb2, 0xa1, 0x25, 0xa5, 0xc0, 0x90, 0xc5, 0xc7, 0x83, 0x3f, 0xce, 0x8b, 0x5b, 0xee,
+	0x1e, 0x9f, 0x79, 0x46, 0x2d, 0xce, 0x96, 0x68, 0x07, 0x85, 0x61, 0xef, 0x90, 0x27, 0x25, 0x81,
+	0x25, 0xea, 0x53, 0x7c, 0x21, 0x16, 0xc8, 0x18, 0x75, 0xdd, 0x44, 0x9a, 0x1d, 0xc6, 0x16, 0xde,
+	0x2e, 0x28, 0x3c, 0x09, 0x07, 0xe0, 0xc2, 0x81, 0x94, 0x9c, 0x5f, 0x62, 0xa1, 0x0b, 0x46, 0xb5,
+	0x08, 0x9c, 0x7d, 0xbe, 0xd7, 0xf5, 0x64, 0xc9, 0x50, 0x35, 0xfb, 0x56, 0xb0, 0x11, 0x38, 0xcc,
+	0xbe, 0x4d, 0x46, 0x37, 0xf9, 0xcd, 0xfd, 0xe5, 0x5c, 0x09, 0xb5, 0xc0, 0x89, 0xb1, 0x72, 0xe1,
+	0xa3, 0xe2, 0xc7, 0x9b, 0xfa, 0x5f, 0x90, 0xdc, 0x9c, 0x3f, 0xab, 0x93, 0x69, 0x19, 0x5e, 0x76,
+	0xd5, 0x8b, 0x59, 0x44, 0x82, 0x79, 0x87, 0x42, 0xe5, 0xd0, 0x3b, 0x14, 0x3e, 0x4c, 0x48, 0x87,
+	0xf6, 0xfc, 0x70, 0x9f, 0x29, 0x87, 0xb5, 0x23, 0x2b, 0x87, 0xea, 0x3c, 0xb1, 0xa4, 0xa8, 0x80,
+	0x41, 0x51, 0xd4, 0x49, 0xe5, 0x57, 0x32, 0x64, 0xea, 0xa4, 0x1a, 0x17, 0xc7, 0x8d, 0x3c, 0xd8,
+	0x8b, 0xe3, 0x3c, 0x32, 0xcd, 0xbb, 0xa8, 0x6a, 0x32, 0xdc, 0x47, 0xe9, 0x05, 0x96, 0xd5, 0xb6,
+	0x94, 0x26, 0x03, 0x59, 0xba, 0xe6, 0xad, 0x70, 0x63, 0x0f, 0xfa, 0x56, 0xb8, 0xaf, 0x27, 0x0d,
+	0xf9, 0x9d, 0x31, 0xdb, 0x4a, 0xd5, 0x0b, 0x92, 0xd3, 0x20, 0x06, 0x0d, 0xcf, 0x95, 0x97, 0x21,
+	0x0f, 0xad, 0xbc, 0xcc, 0x63, 0x64, 0x24, 0xa2, 0x6e, 0x2c, 0xed, 0x89, 0x20, 0x7e, 0x39, 0x9f,
+	0xa9, 0xe2, 0x69, 0x83, 0xf7, 0xf7, 0xc8, 0x97, 0x2d, 0x5e, 0x35, 0x2e, 0x5b, 0x3c, 0xda, 0x77,
+	0x1e, 0xcb, 0x5c, 0xca, 0xf8, 0x24, 0xa9, 0x25, 0xee, 0xb6, 0x4c, 0xce, 0x65, 0xd0, 0x0d, 0x17,
+	0xef, 0xfc, 0xc1, 0xd6, 0xa3, 0x94, 0x9b, 0xc6, 0xe0, 0x1d, 0x6f, 0x3b, 0x70, 0x13, 0x8c, 0x58,
+	0xd1, 0x7e, 0x4d, 0x1d, 0xbc, 0x63, 0x02, 0x21, 0x8d, 0x8b, 0xe9, 0x1f, 0x24, 0xa2, 0xea, 0x2c,
+	0x33, 0x52, 0xc6, 0xdc, 0x52, 0xe2, 0x41, 0xd2, 0x35, 0xcb, 0x85, 0xa8, 0x33, 0x8c, 0xc1, 0xd6,
+	0xf9, 0xa4, 0x45, 0x66, 0x72, 0x4f, 0xd9, 0x3d, 0x32, 0xd2, 0x66, 0x57, 0x62, 0x96, 0x53, 0x22,
+	0x33, 0x7d, 0xbd, 0x26, 0xdf, 0xb4, 0x78, 0x1b, 0x08, 0x3e, 0xce, 0x6f, 0x4e, 0x90, 0x33, 0xad,
+	0xc5, 0x55, 0x79, 0x41, 0xd2, 0x89, 0x65, 0x1b, 0x17, 0xf1, 0x78, 0x70, 0xd9, 0xc6, 0x03, 0xb8,
+	0xfb, 0x46, 0xb6, 0xb1, 0x6f, 0x64, 0x1b, 0xa7, 0x53, 0x3f, 0xab, 0x65, 0xa4, 0x7e, 0x16, 0xf5,
+	0x60, 0x98, 0xd4, 0xcf, 0x13, 0x4b, 0x3f, 0x3e, 0xb0, 0x43, 0x47, 0x4a, 0x3f, 0x56, 0xb9, 0xd9,
+	0xa5, 0x64, 0x9a, 0x0d, 0xf8, 0x54, 0x85, 0xb9, 0xd9, 0x2a, 0x2f, 0x96, 0x67, 0x51, 0x36, 0x47,
+	0xca, 0xc8, 0x8b, 0x2d, 0xea, 0xc0, 0x10, 0x79, 0xb1, 0xfc, 0x47, 0x2a, 0x17, 0x7b, 0xb4, 0x8c,
+	0x5c, 0xec, 0xa2, 0xee, 0x1c, 0x9a, 0x8b, 0x8d, 0x77, 0x49, 0xfa, 0x61, 0x80, 0xf7, 0xb5, 0x25,
+	0x61, 0x3b, 0x94, 0xf7, 0xa3, 0xeb, 0xbb, 0x24, 0x4d, 0x20, 0xa4, 0x71, 0x07, 0x25, 0x72, 0x37,
+	0x8e, 0x9b, 0xc8, 0x4d, 0x1e, 0x52, 0x22, 0xb7, 0x91, 0xaa, 0x3c, 0x5e, 0x46, 0xaa, 0x72, 0xd1,
+	0x17, 0x19, 0x2a, 0x55, 0xf9, 0xb3, 0x16, 0xc1, 0x9b, 0xfa, 0xf1, 0x90, 0xc2, 0xa5, 0x30, 0x73,
+	0xdd, 0x8d, 0x3f, 0xff, 0xea, 0x09, 0x4c, 0xd8, 0x5b, 0x2d, 0xcd, 0x66, 0x61, 0x86, 0xa5, 0x8f,
+	0x98, 0x4d, 0x90, 0xee, 0xc8, 0x71, 0xd2, 0x9b, 0x7f, 0xba, 0x42, 0xbe, 0xe6, 0xd0, 0x2e, 0xd8,
+	0xb7, 0xd1, 0x81, 0xb4, 0x2d, 0x26, 0x6a, 0xd3, 0x2a, 0x23, 0xde, 0x78, 0x43, 0xd2, 0x13, 0xa9,
+	0x77, 0x8a, 0x3c, 0x18, 0xac, 0x58, 0x98, 0x71, 0xe8, 0xe7, 0xaa, 0x5b, 0x43, 0xe8, 0x53, 0x60,
+	0x10, 0x54, 0x84, 0x22, 0xba, 0x8d, 0x4a, 0x7f, 0x35, 0xad, 0x08, 0x01, 0x6b, 0x05, 0x01, 0x45,
+	0x6b, 0xab, 0xeb, 0xfb, 0x3c, 0x0d, 0x90, 0xc6, 0xe2, 0x92, 0x57, 0x5d, 0xd3, 0x56, 0x83, 0xc0,
+	0xc4, 0x73, 0xfe, 0xbc, 0x42, 0x66, 0x0f, 0x91, 0x29, 0xb9, 0xf4, 0xef, 0xfa, 0xd0, 0xe9, 0xdf,
+	0x22, 0x8d, 0x69, 0x64, 0x40, 0x1a, 0x13, 0x7a, 0xec, 0x29, 0xde, 0x71, 0xc6, 0x03, 0x17, 0x33,
+	0xa5, 0x1a, 0x37, 0x34, 0x08, 0x4c, 0x3c, 0x94, 0x62, 0x53, 0x6e, 0xbb, 0x4d, 0xe3, 0x58, 0xe6,
+	0x29, 0x09, 0xeb, 0x77, 0x69, 0x49, 0x50, 0xcc, 0xa9, 0x30, 0x9f, 0x62, 0x01, 0x19, 0x96, 0xd9,
+	0x01, 0x6f, 0x0c, 0x39, 0xe0, 0x3f, 0x5f, 0x21, 0x4f, 0x1d, 0xb8, 0xbb, 0x0d, 0x9d, 0x42, 0x86,
+	0xb1, 0xe5, 0xd9, 0x89, 0x83, 0x91, 0xe7, 0xc0, 0x20, 0x7c, 0x94, 0x7a, 0x3d, 0x15, 0x5d, 0x5e,
+	0x7e, 0xce, 0x25, 0x1f, 0xa5, 0x14, 0x0b, 0xc8, 0xb0, 0xbc, 0xdf, 0x69, 0xf9, 0xfb, 0x35, 0xf2,
+	0xcc, 0x10, 0x3a, 0x40, 0x89, 0xb9, 0xa9, 0xe9, 0xbc, 0xeb, 0xea, 0x43, 0xca, 0xbb, 0xbe, 0xbf,
+	0xe1, 0x7a, 0x2b, 0x5d, 0x7b, 0xa8, 0x1c, 0xd8, 0x5f, 0xaa, 0x90, 0xf3, 0x83, 0x15, 0x16, 0xfb,
+	0x7d, 0x68, 0xff, 0x92, 0xa1, 0x8a, 0x66, 0xca, 0xf6, 0x69, 0x6e, 0xfb, 0x4a, 0x81, 0x20, 0x8b,
+	0x8b, 0x59, 0xd7, 0x3d, 0x37, 0xd9, 0x89, 0x2f, 0xdd, 0xf1, 0xe2, 0x44, 0xd4, 0xb8, 0x9b, 0xe2,
+	0x1e, 0x59, 0xd9, 0x0a, 0x06, 0x06, 0xb2, 0x63, 0xbf, 0x96, 0xb0, 0x96, 0x07, 0x7f, 0x88, 0x1f,
+	0x3d, 0x4f, 0xcb, 0x1b, 0x21, 0x0d, 0x10, 0x64, 0x71, 0x91, 0x1d, 0xf3, 0xf9, 0xf3, 0x8e, 0xd6,
+	0x74, 0x92, 0xf7, 0x8a, 0x6a, 0x05, 0x03, 0x23, 0x9b, 0x8c, 0x5e, 0x3f, 0x3c, 0x19, 0xdd, 0xf9,
+	0xe7, 0x15, 0x72, 0x6e, 0xa0, 0xc2, 0x3b, 0x9c, 0x98, 0x7a, 0xf4, 0x12, 0xc2, 0xef, 0x73, 0x85,
+	0x1d, 0x29, 0x91, 0xd8, 0xf9, 0xe3, 0x01, 0x33, 0x4d, 0x24, 0x09, 0xdf, 0x7f, 0x3d, 0x95, 0x47,
+	0x6f, 0x3c, 0x73, 0x79, 0xc1, 0xb5, 0x23, 0xe4, 0x05, 0x67, 0x3e, 0x46, 0x7d, 0xc8, 0xdd, 0xe1,
+	0xbf, 0xd4, 0x06, 0x0e, 0x2f, 0x1e, 0x90, 0x87, 0xf2, 0x2c, 0x2c, 0x91, 0x53, 0x5e, 0xc0, 0xee,
+	0xf8, 0x6d, 0xf5, 0x37, 0x45, 0xd9, 0x33, 0x5e, 0xdb, 0x57, 0x65, 0xe5, 0x2c, 0x67, 0xe0, 0x90,
+	0x7b, 0xe2, 0x11, 0xcc, 0xd3, 0xbe, 0xbf, 0x21, 0x3d, 0xa2, 0xe4, 0x5e, 0x23, 0x67, 0xe5, 0x50,
+	0xec, 0xb8, 0x11, 0xed, 0x88, 0xcd, 0x36, 0x16, 0x79, 0x58, 0xe7, 0x78, 0x2e, 0x57, 0x01, 0x02,
+	0x14, 0x3f, 0x87, 0x9f, 0x2c, 0x09, 0x7b, 0x5e, 0xbb, 0x39, 0x96, 0xfe, 0x64, 0x1b, 0xd8, 0x08,
+	0x1c, 0xa6, 0xf7, 0x8b, 0xc6, 0x83, 0xd9, 0x2f, 0x3e, 0x4c, 0x1a, 0x6a, 0xbc, 0x79, 0xae, 0x85,
+	0x9a, 0xe4, 0xb9, 0x5c, 0x0b, 0x35, 0xc3, 0x0d, 0x2c, 0xfb, 0x29, 0x7e, 0x50, 0xc9, 0xac, 0x56,
+	0xe4, 0x87, 0xed, 0xce, 0x0b, 0x64, 0x42, 0xd9, 0x02, 0x87, 0xbd, 0x16, 0xd7, 0xf9, 0xcb, 0x0a,
+	0xc9, 0xdc, 0x00, 0x87, 0xb5, 0xa5, 0xf1, 0x06, 0x3b, 0xd6, 0x58, 0x4e, 0x6d, 0xe9, 0x25, 0x49,
+	0x4e, 0x3b, 0xc8, 0x54, 0x13, 0x68, 0x66, 0xf6, 0x47, 0x79, 0x19, 0x67, 0xc1, 0xba, 0x52, 0x46,
+	0xae, 0x7e, 0x4b, 0xd1, 0x33, 0xef, 0xbd, 0x94, 0x6d, 0x60, 0xf0, 0xb3, 0x13, 0xd2, 0xd8, 0x91,
+	0x37, 0xdd, 0x95, 0x23, 0xee, 0xd4, 0xc5, 0x79, 0x5c, 0x45, 0x53, 0x3f, 0x41, 0x33, 0x72, 0xfe,
+	0xa8, 0x42, 0xce, 0xa4, 0x3f, 0x80, 0x70, 0x68, 0xfe, 0xb2, 0x45, 0x1e, 0xf7, 0xdd, 0x38, 0x69,
+	0xf5, 0xd9, 0x41, 0x61, 0xab, 0xef, 0xaf, 0x65, 0x2a, 0x7e, 0x1f, 0xd7, 0xd8, 0xa2, 0x08, 0x67,
+	0x6f, 0x46, 0x5c, 0x78, 0x02, 0xb3, 0xd7, 0x56, 0x8a, 0x99, 0xc3, 0xa0, 0x5e, 0xa1, 0x85, 0xea,
+	0x54, 0xbb, 0x1f, 0x45, 0x34, 0x48, 0x74, 0x57, 0xf9, 0x57, 0xbc, 0x5e, 0xca, 0x40, 0xea, 0x0e,
+	0x9e, 0x41, 0x81, 0xba, 0x98, 0xe1, 0x05, 0x39, 0xee, 0xce, 0x0f, 0xe1, 0xce, 0x39, 0xf0, 0x3d,
+	0xff, 0x8a, 0x5d, 0xe5, 0xf8, 0x8b, 0xa3, 0x64, 0x32, 0x55, 0xd6, 0x3c, 0xe5, 0x04, 0xb4, 0x0e,
+	0x75, 0x02, 0xb2, 0xcc, 0xc1, 0x7e, 0x20, 0x6f, 0xb9, 0x37, 0x32, 0x07, 0xfb, 0x01, 0x96, 0x6d,
+	0xc7, 0x3f, 0x62, 0x48, 0xa1, 0x1f, 0x88, 0x1c, 0x01, 0x73, 0x48, 0xa1, 0x1f, 0x80, 0x80, 0x62,
+	0x0c, 0xe5, 0x04, 0x5b, 0x7c, 0xc2, 0x85, 0xda, 0xac, 0x95, 0xe1, 0xb7, 0x6e, 0x19, 0x14, 0x79,
+	0x4c, 0xa9, 0xd9, 0x02, 0x29, 0x8e, 0x78, 0xc7, 0x5b, 0x43, 0x5d, 0xa9, 0xdb, 0x1c, 0x29, 0x23,
+	0x0f, 0x2b, 0x5b, 0x35, 0x3e, 0x23, 0xf5, 0x64, 0x0b, 0x73, 0xa9, 0x89, 0x7f, 0xf1, 0x7e, 0x3b,
+	0xfe, 0xaf, 0x98, 0x1c, 0xa5, 0xbb, 0xfe, 0x48, 0x81, 0x6f, 0x13, 0x2f, 0x09, 0x71, 0x03, 0x6f,
+	0x8b, 0xc6, 0x09, 0x77, 0x39, 0xca, 0x4b, 0x42, 0x64, 0x23, 0x68, 0x38, 0x2a, 0xfb, 0x31, 0x7b,
+	0xb1, 0xc4, 0xf0, 0x11, 0x32, 0x65, 0xbf, 0xa5, 0x9b, 0xc1, 0xc4, 0x31, 0x1d, 0x9a, 0xe4, 0xa1,
+	0x3a, 0x34, 0xc7, 0x0f, 0x71, 0x68, 0xb6, 0xc8, 0x59, 0xb7, 0x9f, 0x84, 0x18, 0xde, 0x30, 0x9f,
+	0xa0, 0x19, 0x35, 0x89, 0x79, 0x25, 0xfc, 0x09, 0x66, 0x02, 0x56, 0x51, 0x70, 0x2d, 0xea, 0x6f,
+	0xe5, 0x90, 0xa0, 0xf8, 0x59, 0xfb, 0xeb, 0xc8, 0x29, 0xf9, 0x7d, 0xd5, 0xbd, 0x69, 0xac, 0x58,
+	0x05, 0xe4, 0xda, 0x0d, 0x3f, 0xe6, 0x54, 0xca, 0x8f, 0xf9, 0x4f, 0x2c, 0x72, 0xb6, 0x70, 0x3a,
+	0x3d, 0xba, 0x39, 0x0c, 0xce, 0x4f, 0xd4, 0xc9, 0xe9, 0x82, 0x8b, 0x13, 0xec, 0x7d, 0x73, 0xa1,
+	0x59, 0x65, 0x84, 0x03, 0xa6, 0xa3, 0xdb, 0xe4, 0xf7, 0x2d, 0x58, 0x5d, 0x47, 0x8b, 0x73, 0xd0,
+	0xb1, 0x06, 0xd5, 0x07, 0x1b, 0x6b, 0x60, 0xac, 0x97, 0xda, 0x43, 0x5d, 0x2f, 0xf5, 0x43, 0xd6,
+	0xcb, 0xaf, 0x58, 0xa4, 0xd9, 0x1d, 0x70, 0x0b, 0x5a, 0x73, 0xa4, 0x0c, 0x3b, 0xd7, 0xa0, 0x3b,
+	0xd6, 0x16, 0x9e, 0xc4, 0xd4, 0xeb, 0x41, 0x50, 0x18, 0xd8, 0x2b, 0xe7, 0x4b, 0x55, 0xc2, 0x74,
+	0x3e, 0x56, 0x1c, 0x7b, 0xdf, 0xfe, 0x98, 0x79, 0xff, 0x8a, 0x55, 0xd6, 0x5d, 0x21, 0x9c, 0xb8,
+	0xba, 0xbf, 0x85, 0x8f, 0x60, 0xd1, 0x75, 0x2e, 0x59, 0x69, 0x5a, 0x19, 0x42, 0x9a, 0xfa, 0xf2,
+	0xa2, 0x9b, 0x6a, 0xf9, 0x17, 0xdd, 0x34, 0xb2, 0x97, 0xdc, 0x1c, 0xfc, 0x89, 0x6b, 0x8f, 0xe4,
+	0x27, 0xfe, 0x2d, 0x8b, 0x9c, 0x2e, 0xf8, 0x0a, 0x5a, 0x65, 0xb1, 0x0e, 0x50, 0x59, 0x30, 0xcc,
+	0x4c, 0x48, 0x77, 0xa1, 0xda, 0xe8, 0x30, 0x33, 0xd1, 0x0e, 0x0a, 0x03, 0x4f, 0x6e, 0xae, 0xef,
+	0x87, 0xb7, 0x2f, 0x75, 0x7b, 0xc9, 0xbe, 0x50, 0x72, 0xd4, 0xd1, 0x62, 0x5e, 0x41, 0xc0, 0xc0,
+	0xb2, 0x9f, 0x21, 0x23, 0xbc, 0x8a, 0x85, 0x30, 0x10, 0x8d, 0xe3, 0x3a, 0xe4, 0x25, 0x2e, 0x3a,
+	0x20, 0x40, 0xce, 0x0e, 0x31, 0x4e, 0x26, 0xf7, 0x7f, 0xd5, 0xf6, 0xe1, 0xb7, 0x67, 0x3a, 0x7f,
+	0xb7, 0x22, 0x58, 0xf1, 0x93, 0x86, 0x8e, 0x3a, 0xb4, 0x8e, 0x18, 0x75, 0xf8, 0x51, 0x42, 0xda,
+	0x61, 0xb7, 0x87, 0x67, 0xef, 0x8d, 0xb0, 0x9c, 0x03, 0xdb, 0xa2, 0xa2, 0xa7, 0x47, 0x55, 0xb7,
+	0x81, 0xc1, 0x2f, 0x25, 0xda, 0xab, 0x87, 0x8a, 0xf6, 0x94, 0x94, 0xab, 0x1d, 0x2c, 0xe5, 0x9c,
+	0x3f, 0xb7, 0x48, 0x4a, 0x73, 0xc4, 0xab, 0xa6, 0xb0, 0xbb, 0xfb, 0x42, 0x60, 0xac, 0x95, 0xa7,
+	0xa6, 0xa2, 0xa4, 0x16, 0xab, 0x90, 0xfd, 0x0b, 0x9c, 0x91, 0xed, 0x8b, 0x08, 0xcb, 0x52, 0x0e,
+	0x50, 0x26, 0x43, 0x8c, 0xd1, 0xe4, 0x01, 0x49, 0x3a, 0x5a, 0xd3, 0x79, 0x91, 0xcc, 0xe4, 0x3a,
+	0xc5, 0xae, 0xe7, 0x0e, 0xa3, 0x76, 0x6e, 0xf5, 0xb0, 0x62, 0x12, 0xc0, 0x61, 0x18, 0x0c, 0x79,
+	0x2a, 0x4b, 0x1e, 0xbd, 0xbf, 0x33, 0x71, 0x96, 0xde, 0x49, 0x8d, 0x9d, 0xca, 0xa4, 0xc8, 0x81,
+	0x20, 0xdf, 0x09, 0xe7, 0xbf, 0x8b, 0xdd, 0xe0, 0x96, 0x17, 0x74, 0xc2, 0xdb, 0x4a, 0x4f, 0xb2,
+	0x06, 0xea, 0x49, 0x28, 0x1e, 0xda, 0x3b, 0xb4, 0xd3, 0xf7, 0x73, 0x25, 0x2e, 0x5a, 0xa2, 0x1d,
+	0x14, 0x06, 0x62, 0x77, 0xfa, 0xe2, 0xec, 0x9b, 0x99, 0x94, 0x4b, 0xa2, 0x1d, 0x14, 0x06, 0x26,
+	0xc3, 0x19, 0x2f, 0x29, 0xe7, 0x25, 0x3b, 0xb8, 0x18, 0x3b, 0x78, 0x0c, 0x29, 0x2c, 0x34, 0xd6,
+	0x2b, 0x9d, 0x4b, 0xee, 0xd8, 0xcc, 0x58, 0xaf, 0x04, 0x63, 0x0c, 0x06, 0x06, 0xab, 0x9f, 0xe1,
+	0xf7, 0x63, 0xe6, 0x8d, 0x1e, 0xd1, 0x97, 0x45, 0x2c, 0x8a, 0x36, 0x50, 0x50, 0x14, 0x6e, 0x5d,
+	0x37, 0xe8, 0xbb, 0x3e, 0x8e, 0x90, 0x30, 0xbf, 0xa9, 0x65, 0xb8, 0xaa, 0x20, 0x60, 0x60, 0xe1,
+	0x1b, 0x27, 0x5e, 0x97, 0x7e, 0x30, 0x0c, 0x64, 0x04, 0xbc, 0x0e, 0x50, 0x10, 0xed, 0xa0, 0x30,
+	0xec, 0x17, 0xf1, 0x56, 0xd6, 0x0e, 0x57, 0x10, 0xc3, 0x48, 0xf8, 0x39, 0xd5, 0x09, 0x16, 0x0b,
+	0xab, 0x68, 0x28, 0x98, 0xa8, 0xd9, 0x9b, 0x32, 0xc8, 0x90, 0x37, 0xf1, 0xfd, 0xa9, 0x45, 0xa6,
+	0x75, 0x41, 0x24, 0x66, 0xa5, 0x4b, 0x99, 0x27, 0xad, 0x43, 0xcd, 0x93, 0xe9, 0xba, 0x28, 0x95,
+	0xa1, 0xea, 0xa2, 0x98, 0x25, 0x4b, 0xaa, 0x07, 0x96, 0x2c, 0xf9, 0x5a, 0x32, 0xba, 0x4b, 0xf7,
+	0x8d, 0xda, 0x26, 0x6c, 0x73, 0xb8, 0xc6, 0x9b, 0x40, 0xc2, 0x30, 0x2c, 0xbe, 0xed, 0xaa, 0xfa,
+	0x88, 0x13, 0x22, 0xbe, 0x6d, 0x9e, 0x21, 0x09, 0x88, 0xb3, 0x46, 0x1a, 0x2a, 0x30, 0x40, 0x5a,
+	0x0b, 0xad, 0x62, 0x6b, 0xe1, 0x50, 0xa5, 0x13, 0x16, 0x36, 0x3f, 0xff, 0xe5, 0xa7, 0xdf, 0xf6,
+	0x7b, 0x5f, 0x7e, 0xfa, 0x6d, 0x7f, 0xf8, 0xe5, 0xa7, 0xdf, 0xf6, 0xf1, 0x7b, 0x4f, 0x5b, 0x9f,
+	0xbf, 0xf7, 0xb4, 0xf5, 0x7b, 0xf7, 0x9e, 0xb6, 0xfe, 0xf0, 0xde, 0xd3, 0xd6, 0x97, 0xee, 0x3d,
+	0x6d, 0x7d, 0xe6, 0x3f, 0x3f, 0xfd, 0xb6, 0x0f, 0x16, 0xe6, 0x5c, 0xe0, 0x3f, 0xcf, 0xb5, 0x3b,
+	0x17, 0xf7, 0x5e, 0x60, 0x61, 0xff, 0xb8, 0x9e, 0x2f, 0x1a, 0x93, 0xf8, 0xa2, 0x5c, 0xcf, 0xff,
+	0x6f, 0x00, 0x9a, 0x96, 0xde, 0x1c, 0xb5, 0x02, 0x01, 0x00,
 }
 
 func (m *AWSAuthConfig) Marshal() (dAtA []byte, err error) {
@@ -8366,6 +8367,15 @@ func (m *ApplicationSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DependsOn) > 0 {
+		for iNdEx := len(m.DependsOn) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DependsOn[iNdEx])
+			copy(dAtA[i:], m.DependsOn[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.DependsOn[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if m.SourceHydrator != nil {
 		{
 			size, err := m.SourceHydrator.MarshalToSizedBuffer(dAtA[:i])
@@ -8989,6 +8999,23 @@ func (m *Cluster) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.AuthRotationError)
+	copy(dAtA[i:], m.AuthRotationError)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.AuthRotationError)))
+	i--
+	dAtA[i] = 0x7a
+	if m.AuthRotatedAt != nil {
+		{
+			size, err := m.AuthRotatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
 	if len(m.Annotations) > 0 {
 		keysForAnnotations := make([]string, 0, len(m.Annotations))
 		for k := range m.Annotations {
@@ -9185,6 +9212,11 @@ func (m *ClusterConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.RotateAuthInterval)
+	copy(dAtA[i:], m.RotateAuthInterval)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RotateAuthInterval)))
+	i--
+	dAtA[i] = 0x4a
 	i -= len(m.ProxyUrl)
 	copy(dAtA[i:], m.ProxyUrl)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ProxyUrl)))
@@ -9345,6 +9377,24 @@ func (m *ClusterInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ErrorChain) > 0 {
+		for iNdEx := len(m.ErrorChain) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ErrorChain[iNdEx])
+			copy(dAtA[i:], m.ErrorChain[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.ErrorChain[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.FailedAPIGroups) > 0 {
+		for iNdEx := len(m.FailedAPIGroups) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FailedAPIGroups[iNdEx])
+			copy(dAtA[i:], m.FailedAPIGroups[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.FailedAPIGroups[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.APIVersions) > 0 {
 		for iNdEx := len(m.APIVersions) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.APIVersions[iNdEx])
@@ -14299,6 +14349,11 @@ func (m *RevisionHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Reason)
+	copy(dAtA[i:], m.Reason)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reason)))
+	i--
+	dAtA[i] = 0x5a
 	{
 		size, err := m.InitiatedBy.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -15402,6 +15457,16 @@ func (m *SyncOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Reason)
+	copy(dAtA[i:], m.Reason)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reason)))
+	i--
+	dAtA[i] = 0x72
+	i -= len(m.ResourceSelector)
+	copy(dAtA[i:], m.ResourceSelector)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ResourceSelector)))
+	i--
+	dAtA[i] = 0x6a
 	i = encodeVarintGenerated(dAtA, i, uint64(m.SelfHealAttemptsCount))
 	i--
 	dAtA[i] = 0x60
@@ -17174,6 +17239,12 @@ func (m *ApplicationSpec) Size() (n int) {
 		l = m.SourceHydrator.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.DependsOn) > 0 {
+		for _, s := range m.DependsOn {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -17424,6 +17495,12 @@ func (m *Cluster) Size() (n int) {
 			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
+	if m.AuthRotatedAt != nil {
+		l = m.AuthRotatedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.AuthRotationError)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -17467,6 +17544,8 @@ func (m *ClusterConfig) Size() (n int) {
 	n += 2
 	l = len(m.ProxyUrl)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.RotateAuthInterval)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -17511,6 +17590,18 @@ func (m *ClusterInfo) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.FailedAPIGroups) > 0 {
+		for _, s := range m.FailedAPIGroups {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.ErrorChain) > 0 {
+		for _, s := range m.ErrorChain {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -19363,6 +19454,8 @@ func (m *RevisionHistory) Size() (n int) {
 	}
 	l = m.InitiatedBy.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Reason)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -19776,6 +19869,10 @@ func (m *SyncOperation) Size() (n int) {
 		}
 	}
 	n += 1 + sovGenerated(uint64(m.SelfHealAttemptsCount))
+	l = len(m.ResourceSelector)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Reason)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -20758,6 +20855,7 @@ func (this *ApplicationSpec) String() string {
 		`RevisionHistoryLimit:` + valueToStringGenerated(this.RevisionHistoryLimit) + `,`,
 		`Sources:` + repeatedStringForSources + `,`,
 		`SourceHydrator:` + strings.Replace(this.SourceHydrator.String(), "SourceHydrator", "SourceHydrator", 1) + `,`,
+		`DependsOn:` + fmt.Sprintf("%v", this.DependsOn) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20943,6 +21041,8 @@ func (this *Cluster) String() string {
 		`Project:` + fmt.Sprintf("%v", this.Project) + `,`,
 		`Labels:` + mapStringForLabels + `,`,
 		`Annotations:` + mapStringForAnnotations + `,`,
+		`AuthRotatedAt:` + strings.Replace(fmt.Sprintf("%v", this.AuthRotatedAt), "Time", "v1.Time", 1) + `,`,
+		`AuthRotationError:` + fmt.Sprintf("%v", this.AuthRotationError) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20972,6 +21072,7 @@ func (this *ClusterConfig) String() string {
 		`ExecProviderConfig:` + strings.Replace(this.ExecProviderConfig.String(), "ExecProviderConfig", "ExecProviderConfig", 1) + `,`,
 		`DisableCompression:` + fmt.Sprintf("%v", this.DisableCompression) + `,`,
 		`ProxyUrl:` + fmt.Sprintf("%v", this.ProxyUrl) + `,`,
+		`RotateAuthInterval:` + fmt.Sprintf("%v", this.RotateAuthInterval) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21009,6 +21110,8 @@ func (this *ClusterInfo) String() string {
 		`CacheInfo:` + strings.Replace(strings.Replace(this.CacheInfo.String(), "ClusterCacheInfo", "ClusterCacheInfo", 1), `&`, ``, 1) + `,`,
 		`ApplicationsCount:` + fmt.Sprintf("%v", this.ApplicationsCount) + `,`,
 		`APIVersions:` + fmt.Sprintf("%v", this.APIVersions) + `,`,
+		`FailedAPIGroups:` + fmt.Sprintf("%v", this.FailedAPIGroups) + `,`,
+		`ErrorChain:` + fmt.Sprintf("%v", this.ErrorChain) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22443,6 +22546,7 @@ func (this *RevisionHistory) String() string {
 		`Sources:` + repeatedStringForSources + `,`,
 		`Revisions:` + fmt.Sprintf("%v", this.Revisions) + `,`,
 		`InitiatedBy:` + strings.Replace(strings.Replace(this.InitiatedBy.String(), "OperationInitiator", "OperationInitiator", 1), `&`, ``, 1) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22716,6 +22820,8 @@ func (this *SyncOperation) String() string {
 		`Sources:` + repeatedStringForSources + `,`,
 		`Revisions:` + fmt.Sprintf("%v", this.Revisions) + `,`,
 		`SelfHealAttemptsCount:` + fmt.Sprintf("%v", this.SelfHealAttemptsCount) + `,`,
+		`ResourceSelector:` + fmt.Sprintf("%v", this.ResourceSelector) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -31337,6 +31443,38 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependsOn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DependsOn = append(m.DependsOn, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -33466,6 +33604,74 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 			}
 			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthRotatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AuthRotatedAt == nil {
+				m.AuthRotatedAt = &v1.Time{}
+			}
+			if err := m.AuthRotatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthRotationError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthRotationError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -33893,6 +34099,38 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 			}
 			m.ProxyUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RotateAuthInterval", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RotateAuthInterval = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -34355,6 +34593,70 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.APIVersions = append(m.APIVersions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedAPIGroups", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedAPIGroups = append(m.FailedAPIGroups, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorChain = append(m.ErrorChain, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -51593,6 +51895,38 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -55175,6 +55509,70 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceSelector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceSelector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
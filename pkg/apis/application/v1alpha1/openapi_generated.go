@@ -2330,6 +2330,21 @@ func schema_pkg_apis_application_v1alpha1_ApplicationSpec(ref common.ReferenceCa
 							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SourceHydrator"),
 						},
 					},
+					"dependsOn": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DependsOn is a list of applications which have to be synced and healthy before this application is synced. Applications in another namespace are referenced as <namespace>/<name>.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"destination", "project"},
 			},
@@ -2882,6 +2897,19 @@ func schema_pkg_apis_application_v1alpha1_Cluster(ref common.ReferenceCallback)
 							},
 						},
 					},
+					"authRotatedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AuthRotatedAt holds the time the bearer token of the cluster has last been rotated",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"authRotationError": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AuthRotationError holds the error of the last scheduled bearer token rotation, if it failed",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"server", "name", "config"},
 			},
@@ -2972,6 +3000,13 @@ func schema_pkg_apis_application_v1alpha1_ClusterConfig(ref common.ReferenceCall
 							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ExecProviderConfig"),
 						},
 					},
+					"rotateAuthInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RotateAuthInterval is the interval at which the application controller rotates the bearer token of the cluster, e.g. 720h. The bearer token is not rotated automatically if empty.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"tlsClientConfig"},
 			},
@@ -3083,6 +3118,36 @@ func schema_pkg_apis_application_v1alpha1_ClusterInfo(ref common.ReferenceCallba
 							},
 						},
 					},
+					"failedAPIGroups": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailedAPIGroups contains the API group versions whose discovery failed during the last cache synchronization",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"errorChain": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ErrorChain contains the messages of the wrapped errors of the last failed cache synchronization, outermost first",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"applicationsCount"},
 			},
@@ -7147,6 +7212,13 @@ func schema_pkg_apis_application_v1alpha1_RevisionHistory(ref common.ReferenceCa
 							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OperationInitiator"),
 						},
 					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is the free-form description of why the sync operation was initiated",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"deployedAt", "id"},
 			},
@@ -8032,6 +8104,20 @@ func schema_pkg_apis_application_v1alpha1_SyncOperation(ref common.ReferenceCall
 							},
 						},
 					},
+					"resourceSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceSelector is a label selector which restricts the sync to the resources whose labels match it",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is a free-form description of why the sync was initiated, e.g. a reference to a change record",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
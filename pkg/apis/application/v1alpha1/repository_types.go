@@ -363,6 +363,24 @@ func (repo *Repository) Sanitized() *Repository {
 	}
 }
 
+// Sanitized returns a copy of the RepoCreds with sensitive information removed.
+func (c *RepoCreds) Sanitized() *RepoCreds {
+	return &RepoCreds{
+		URL:                        c.URL,
+		Username:                   c.Username,
+		GithubAppId:                c.GithubAppId,
+		GithubAppInstallationId:    c.GithubAppInstallationId,
+		GitHubAppEnterpriseBaseURL: c.GitHubAppEnterpriseBaseURL,
+		EnableOCI:                  c.EnableOCI,
+		Type:                       c.Type,
+		Proxy:                      c.Proxy,
+		ForceHttpBasicAuth:         c.ForceHttpBasicAuth,
+		NoProxy:                    c.NoProxy,
+		UseAzureWorkloadIdentity:   c.UseAzureWorkloadIdentity,
+		InsecureOCIForceHttp:       c.InsecureOCIForceHttp,
+	}
+}
+
 func (repo *Repository) Normalize() *Repository {
 	if repo.Type == "" {
 		repo.Type = common.DefaultRepoType
@@ -92,6 +92,10 @@ type ApplicationSpec struct {
 
 	// SourceHydrator provides a way to push hydrated manifests back to git before syncing them to the cluster.
 	SourceHydrator *SourceHydrator `json:"sourceHydrator,omitempty" protobuf:"bytes,9,opt,name=sourceHydrator"`
+
+	// DependsOn is a list of applications which have to be synced and healthy before this application is synced.
+	// Applications in another namespace are referenced as <namespace>/<name>.
+	DependsOn []string `json:"dependsOn,omitempty" protobuf:"bytes,10,rep,name=dependsOn"`
 }
 
 type IgnoreDifferences []ResourceIgnoreDifferences
@@ -1363,6 +1367,10 @@ type SyncOperation struct {
 	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,11,opt,name=revisions"`
 	// SelfHealAttemptsCount contains the number of auto-heal attempts
 	SelfHealAttemptsCount int64 `json:"autoHealAttemptsCount,omitempty" protobuf:"bytes,12,opt,name=autoHealAttemptsCount"`
+	// ResourceSelector is a label selector which restricts the sync to the resources whose labels match it
+	ResourceSelector string `json:"resourceSelector,omitempty" protobuf:"bytes,13,opt,name=resourceSelector"`
+	// Reason is a free-form description of why the sync was initiated, e.g. a reference to a change record
+	Reason string `json:"reason,omitempty" protobuf:"bytes,14,opt,name=reason"`
 }
 
 // IsApplyStrategy returns true if the sync strategy is "apply"
@@ -1370,6 +1378,12 @@ func (o *SyncOperation) IsApplyStrategy() bool {
 	return o.SyncStrategy != nil && o.SyncStrategy.Apply != nil
 }
 
+// IsPartialSync returns true if only some of the resources of the application are synced, either because they are
+// listed explicitly or because they are selected by labels
+func (o *SyncOperation) IsPartialSync() bool {
+	return len(o.Resources) > 0 || o.ResourceSelector != ""
+}
+
 // OperationState contains information about state of a running operation
 type OperationState struct {
 	// Operation is the original requested operation
@@ -1736,6 +1750,8 @@ type RevisionHistory struct {
 	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,9,opt,name=revisions"`
 	// InitiatedBy contains information about who initiated the operations
 	InitiatedBy OperationInitiator `json:"initiatedBy,omitempty" protobuf:"bytes,10,opt,name=initiatedBy"`
+	// Reason is the free-form description of why the sync operation was initiated
+	Reason string `json:"reason,omitempty" protobuf:"bytes,11,opt,name=reason"`
 }
 
 // ApplicationWatchEvent contains information about application change.
@@ -1803,6 +1819,10 @@ const (
 	ApplicationConditionExcludedResourceWarning = "ExcludedResourceWarning"
 	// ApplicationConditionOrphanedResourceWarning indicates that application has orphaned resources
 	ApplicationConditionOrphanedResourceWarning = "OrphanedResourceWarning"
+	// ApplicationConditionDependencyError indicates that the dependencies of the application cannot be resolved, e.g. because they form a cycle
+	ApplicationConditionDependencyError = "DependencyError"
+	// ApplicationConditionDependencyWarning indicates that the application waits for its dependencies to be synced and healthy
+	ApplicationConditionDependencyWarning = "DependencyWarning"
 )
 
 // ApplicationCondition contains details about an application condition, which is usually an error or warning
@@ -2232,6 +2252,10 @@ type Cluster struct {
 	Labels map[string]string `json:"labels,omitempty" protobuf:"bytes,12,opt,name=labels"`
 	// Annotations for cluster secret metadata
 	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,13,opt,name=annotations"`
+	// AuthRotatedAt holds the time the bearer token of the cluster has last been rotated
+	AuthRotatedAt *metav1.Time `json:"authRotatedAt,omitempty" protobuf:"bytes,14,opt,name=authRotatedAt"`
+	// AuthRotationError holds the error of the last scheduled bearer token rotation, if it failed
+	AuthRotationError string `json:"authRotationError,omitempty" protobuf:"bytes,15,opt,name=authRotationError"`
 }
 
 // Equals returns true if two cluster objects are considered to be equal
@@ -2284,6 +2308,10 @@ type ClusterInfo struct {
 	ApplicationsCount int64 `json:"applicationsCount" protobuf:"bytes,4,opt,name=applicationsCount"`
 	// APIVersions contains list of API versions supported by the cluster
 	APIVersions []string `json:"apiVersions,omitempty" protobuf:"bytes,5,opt,name=apiVersions"`
+	// FailedAPIGroups contains the API group versions whose discovery failed during the last cache synchronization
+	FailedAPIGroups []string `json:"failedAPIGroups,omitempty" protobuf:"bytes,6,opt,name=failedAPIGroups"`
+	// ErrorChain contains the messages of the wrapped errors of the last failed cache synchronization, outermost first
+	ErrorChain []string `json:"errorChain,omitempty" protobuf:"bytes,7,opt,name=errorChain"`
 }
 
 func (c *ClusterInfo) GetKubeVersion() string {
@@ -2367,6 +2395,26 @@ type ClusterConfig struct {
 
 	// ProxyURL is the URL to the proxy to be used for all requests send to the server
 	ProxyUrl string `json:"proxyUrl,omitempty" protobuf:"bytes,8,opt,name=proxyUrl"` //nolint:revive //FIXME(var-naming)
+
+	// RotateAuthInterval is the interval at which the application controller rotates the bearer token of the cluster,
+	// e.g. 720h. The bearer token is not rotated automatically if empty.
+	RotateAuthInterval string `json:"rotateAuthInterval,omitempty" protobuf:"bytes,9,opt,name=rotateAuthInterval"`
+}
+
+// GetRotateAuthInterval returns the parsed RotateAuthInterval, which is zero if the bearer token is not rotated
+// automatically
+func (c *ClusterConfig) GetRotateAuthInterval() (time.Duration, error) {
+	if c.RotateAuthInterval == "" {
+		return 0, nil
+	}
+	interval, err := time.ParseDuration(c.RotateAuthInterval)
+	if err != nil {
+		return 0, fmt.Errorf("invalid rotateAuthInterval %q: %w", c.RotateAuthInterval, err)
+	}
+	if interval <= 0 {
+		return 0, fmt.Errorf("invalid rotateAuthInterval %q: must be positive", c.RotateAuthInterval)
+	}
+	return interval, nil
 }
 
 // TLSClientConfig contains settings to enable transport layer security
@@ -2736,27 +2784,14 @@ func (w *SyncWindows) Active() (*SyncWindows, error) {
 }
 
 func (w *SyncWindows) active(currentTime time.Time) (*SyncWindows, error) {
-	// If SyncWindows.Active() is called outside of a UTC locale, it should be
-	// first converted to UTC before we scan through the SyncWindows.
-	currentTime = currentTime.In(time.UTC)
-
 	if w.HasWindows() {
 		var active SyncWindows
-		specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
 		for _, w := range *w {
-			schedule, sErr := specParser.Parse(w.Schedule)
-			if sErr != nil {
-				return nil, fmt.Errorf("cannot parse schedule '%s': %w", w.Schedule, sErr)
-			}
-			duration, dErr := time.ParseDuration(w.Duration)
-			if dErr != nil {
-				return nil, fmt.Errorf("cannot parse duration '%s': %w", w.Duration, dErr)
+			isActive, err := w.active(currentTime)
+			if err != nil {
+				return nil, err
 			}
-
-			// Offset the nextWindow time to consider the timeZone of the sync window
-			timeZoneOffsetDuration := w.scheduleOffsetByTimeZone()
-			nextWindow := schedule.Next(currentTime.Add(timeZoneOffsetDuration - duration))
-			if nextWindow.Before(currentTime.Add(timeZoneOffsetDuration)) {
+			if isActive {
 				active = append(active, w)
 			}
 		}
@@ -2775,30 +2810,17 @@ func (w *SyncWindows) InactiveAllows() (*SyncWindows, error) {
 }
 
 func (w *SyncWindows) inactiveAllows(currentTime time.Time) (*SyncWindows, error) {
-	// If SyncWindows.InactiveAllows() is called outside of a UTC locale, it should be
-	// first converted to UTC before we scan through the SyncWindows.
-	currentTime = currentTime.In(time.UTC)
-
 	if w.HasWindows() {
 		var inactive SyncWindows
-		specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
 		for _, w := range *w {
 			if w.Kind != "allow" {
 				continue
 			}
-			schedule, sErr := specParser.Parse(w.Schedule)
-			if sErr != nil {
-				return nil, fmt.Errorf("cannot parse schedule '%s': %w", w.Schedule, sErr)
-			}
-			duration, dErr := time.ParseDuration(w.Duration)
-			if dErr != nil {
-				return nil, fmt.Errorf("cannot parse duration '%s': %w", w.Duration, dErr)
+			isActive, err := w.active(currentTime)
+			if err != nil {
+				return nil, err
 			}
-			// Offset the nextWindow time to consider the timeZone of the sync window
-			timeZoneOffsetDuration := w.scheduleOffsetByTimeZone()
-			nextWindow := schedule.Next(currentTime.Add(timeZoneOffsetDuration - duration))
-
-			if !nextWindow.Before(currentTime.Add(timeZoneOffsetDuration)) {
+			if !isActive {
 				inactive = append(inactive, w)
 			}
 		}
@@ -2809,14 +2831,14 @@ func (w *SyncWindows) inactiveAllows(currentTime time.Time) (*SyncWindows, error
 	return nil, nil
 }
 
-func (w *SyncWindow) scheduleOffsetByTimeZone() time.Duration {
+// location returns the location the schedule of the sync window is evaluated in, defaulting to UTC
+func (w *SyncWindow) location() *time.Location {
 	loc, err := time.LoadLocation(w.TimeZone)
 	if err != nil {
 		log.Warnf("Invalid time zone %s specified. Using UTC as default time zone", w.TimeZone)
-		loc = time.Now().UTC().Location()
+		return time.UTC
 	}
-	_, tzOffset := time.Now().In(loc).Zone()
-	return time.Duration(tzOffset) * time.Second
+	return loc
 }
 
 // AddWindow adds a sync window with the given parameters to the AppProject
@@ -2949,11 +2971,16 @@ func (w *SyncWindows) Matches(app *Application) *SyncWindows {
 
 // CanSync returns true if a sync window currently allows a sync. isManual indicates whether the sync has been triggered manually.
 func (w *SyncWindows) CanSync(isManual bool) (bool, error) {
+	return w.CanSyncAt(isManual, time.Now())
+}
+
+// CanSyncAt returns true if the sync windows allow a sync at the given time. isManual indicates whether the sync has been triggered manually.
+func (w *SyncWindows) CanSyncAt(isManual bool, currentTime time.Time) (bool, error) {
 	if !w.HasWindows() {
 		return true, nil
 	}
 
-	active, err := w.Active()
+	active, err := w.active(currentTime)
 	if err != nil {
 		return false, fmt.Errorf("invalid sync windows: %w", err)
 	}
@@ -2970,7 +2997,7 @@ func (w *SyncWindows) CanSync(isManual bool) (bool, error) {
 		return true, nil
 	}
 
-	inactiveAllows, err := w.InactiveAllows()
+	inactiveAllows, err := w.inactiveAllows(currentTime)
 	if err != nil {
 		return false, fmt.Errorf("invalid sync windows: %w", err)
 	}
@@ -3041,10 +3068,6 @@ func (w SyncWindow) Active() (bool, error) {
 }
 
 func (w SyncWindow) active(currentTime time.Time) (bool, error) {
-	// If SyncWindow.Active() is called outside of a UTC locale, it should be
-	// first converted to UTC before search
-	currentTime = currentTime.UTC()
-
 	specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
 	schedule, sErr := specParser.Parse(w.Schedule)
 	if sErr != nil {
@@ -3055,17 +3078,18 @@ func (w SyncWindow) active(currentTime time.Time) (bool, error) {
 		return false, fmt.Errorf("cannot parse duration '%s': %w", w.Duration, dErr)
 	}
 
-	// Offset the nextWindow time to consider the timeZone of the sync window
-	timeZoneOffsetDuration := w.scheduleOffsetByTimeZone()
-	nextWindow := schedule.Next(currentTime.Add(timeZoneOffsetDuration - duration))
+	// Evaluate the schedule in the time zone of the sync window, so that activations follow
+	// daylight saving time changes of that zone
+	currentTime = currentTime.In(w.location())
+	nextWindow := schedule.Next(currentTime.Add(-duration))
 
-	return nextWindow.Before(currentTime.Add(timeZoneOffsetDuration)), nil
+	return nextWindow.Before(currentTime), nil
 }
 
 // Update updates a sync window's settings with the given parameter
 func (w *SyncWindow) Update(s string, d string, a []string, n []string, c []string, tz string, description string) error {
-	if s == "" && d == "" && len(a) == 0 && len(n) == 0 && len(c) == 0 && description == "" {
-		return errors.New("cannot update: require one or more of schedule, duration, application, namespace, cluster or description")
+	if s == "" && d == "" && len(a) == 0 && len(n) == 0 && len(c) == 0 && tz == "" && description == "" {
+		return errors.New("cannot update: require one or more of schedule, duration, application, namespace, cluster, time zone or description")
 	}
 
 	if s != "" {
@@ -3092,10 +3116,11 @@ func (w *SyncWindow) Update(s string, d string, a []string, n []string, c []stri
 		w.Description = description
 	}
 
-	if tz == "" {
-		tz = "UTC"
+	if tz != "" {
+		w.TimeZone = tz
+	} else if w.TimeZone == "" {
+		w.TimeZone = "UTC"
 	}
-	w.TimeZone = tz
 	return nil
 }
 
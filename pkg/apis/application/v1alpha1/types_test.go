@@ -1323,6 +1323,44 @@ func TestRepository_CopyCredentialsFrom(t *testing.T) {
 	}
 }
 
+func TestRepoCreds_Sanitized(t *testing.T) {
+	creds := &RepoCreds{
+		URL:                        "https://github.com/argoproj",
+		Username:                   "user",
+		Password:                   "password",
+		SSHPrivateKey:              "ssh-key",
+		TLSClientCertData:          "cert",
+		TLSClientCertKey:           "cert-key",
+		GithubAppPrivateKey:        "app-key",
+		GithubAppId:                1,
+		GithubAppInstallationId:    2,
+		GitHubAppEnterpriseBaseURL: "https://ghe.example.com/api/v3",
+		EnableOCI:                  true,
+		Type:                       "git",
+		GCPServiceAccountKey:       "gcp-key",
+		Proxy:                      "http://proxy.argoproj.io:3128",
+		ForceHttpBasicAuth:         true,
+		NoProxy:                    ".example.com",
+		UseAzureWorkloadIdentity:   true,
+		BearerToken:                "token",
+		InsecureOCIForceHttp:       true,
+	}
+	assert.Equal(t, &RepoCreds{
+		URL:                        "https://github.com/argoproj",
+		Username:                   "user",
+		GithubAppId:                1,
+		GithubAppInstallationId:    2,
+		GitHubAppEnterpriseBaseURL: "https://ghe.example.com/api/v3",
+		EnableOCI:                  true,
+		Type:                       "git",
+		Proxy:                      "http://proxy.argoproj.io:3128",
+		ForceHttpBasicAuth:         true,
+		NoProxy:                    ".example.com",
+		UseAzureWorkloadIdentity:   true,
+		InsecureOCIForceHttp:       true,
+	}, creds.Sanitized())
+}
+
 func TestRepository_CopySettingsFrom(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -2836,6 +2874,29 @@ func TestSyncWindows_CanSync(t *testing.T) {
 	})
 }
 
+func TestSyncWindows_CanSyncAt(t *testing.T) {
+	// Deny syncs during business hours in Berlin, which is UTC+1 in winter and UTC+2 in summer
+	windows := SyncWindows{
+		{Kind: "deny", Schedule: "0 9 * * *", Duration: "8h", TimeZone: "Europe/Berlin", ManualSync: true},
+	}
+
+	canSync, err := windows.CanSyncAt(false, time.Date(2024, time.January, 15, 15, 30, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.False(t, canSync)
+
+	canSync, err = windows.CanSyncAt(true, time.Date(2024, time.January, 15, 15, 30, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.True(t, canSync)
+
+	canSync, err = windows.CanSyncAt(false, time.Date(2024, time.July, 15, 15, 30, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.True(t, canSync)
+
+	windows[0].Schedule = "bad"
+	_, err = windows.CanSyncAt(false, time.Date(2024, time.July, 15, 15, 30, 0, 0, time.UTC))
+	require.ErrorContains(t, err, "invalid sync windows")
+}
+
 func TestSyncWindows_hasDeny(t *testing.T) {
 	t.Run("True", func(t *testing.T) {
 		proj := newTestProjectWithSyncWindows()
@@ -2958,6 +3019,30 @@ func TestSyncWindow_Active(t *testing.T) {
 			currentTime:    timeWithHour(13-4, utcM4Zone),
 			expectedResult: false,
 		},
+		{
+			name:           "Allow-active-TimeZone-DaylightSavingTime",
+			syncWindow:     SyncWindow{Kind: "allow", Schedule: "0 9 * * *", Duration: "1h", TimeZone: "America/New_York"},
+			currentTime:    time.Date(2024, time.July, 1, 13, 30, 0, 0, time.UTC), // 9:30AM EDT
+			expectedResult: true,
+		},
+		{
+			name:           "Allow-active-TimeZone-StandardTime",
+			syncWindow:     SyncWindow{Kind: "allow", Schedule: "0 9 * * *", Duration: "1h", TimeZone: "America/New_York"},
+			currentTime:    time.Date(2024, time.January, 15, 14, 30, 0, 0, time.UTC), // 9:30AM EST
+			expectedResult: true,
+		},
+		{
+			name:           "Allow-inactive-TimeZone-StandardTime",
+			syncWindow:     SyncWindow{Kind: "allow", Schedule: "0 9 * * *", Duration: "1h", TimeZone: "America/New_York"},
+			currentTime:    time.Date(2024, time.January, 15, 13, 30, 0, 0, time.UTC), // 8:30AM EST
+			expectedResult: false,
+		},
+		{
+			name:           "Deny-active-InvalidTimeZone",
+			syncWindow:     SyncWindow{Kind: "deny", Schedule: "0 9 * * *", Duration: "1h", TimeZone: "Mars/Olympus_Mons"},
+			currentTime:    time.Date(2024, time.January, 15, 9, 30, 0, 0, time.UTC),
+			expectedResult: true,
+		},
 		{
 			name:           "Allow-inactive-InvalidSchedule",
 			syncWindow:     syncWindow("allow", "* 10 * * 7", "2h"),
@@ -3020,7 +3105,7 @@ func TestSyncWindow_Update(t *testing.T) {
 	})
 	t.Run("MissingConfig", func(t *testing.T) {
 		err := e.Update("", "", []string{}, []string{}, []string{}, "", "")
-		require.EqualError(t, err, "cannot update: require one or more of schedule, duration, application, namespace, cluster or description")
+		require.EqualError(t, err, "cannot update: require one or more of schedule, duration, application, namespace, cluster, time zone or description")
 	})
 	t.Run("ChangeDuration", func(t *testing.T) {
 		err := e.Update("", "10h", []string{}, []string{}, []string{}, "", "")
@@ -3037,6 +3122,16 @@ func TestSyncWindow_Update(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, "Ticket 123", e.Description)
 	})
+	t.Run("ChangeTimeZone", func(t *testing.T) {
+		err := e.Update("", "", []string{}, []string{}, []string{}, "Europe/Berlin", "")
+		require.NoError(t, err)
+		assert.Equal(t, "Europe/Berlin", e.TimeZone)
+	})
+	t.Run("KeepTimeZone", func(t *testing.T) {
+		err := e.Update("", "2h", []string{}, []string{}, []string{}, "", "")
+		require.NoError(t, err)
+		assert.Equal(t, "Europe/Berlin", e.TimeZone)
+	})
 }
 
 func TestSyncWindow_Validate(t *testing.T) {
@@ -3484,6 +3579,26 @@ func TestProjectNormalize(t *testing.T) {
 	})
 }
 
+func TestProjectExpireJWTToken(t *testing.T) {
+	p := AppProject{
+		Spec: AppProjectSpec{Roles: []ProjectRole{{Name: "test-role", JWTTokens: []JWTToken{{ID: "1", IssuedAt: 1}, {ID: "2", IssuedAt: 2, ExpiresAt: 50}}}}},
+		Status: AppProjectStatus{JWTTokensByRole: map[string]JWTTokens{
+			"test-role": {Items: []JWTToken{{ID: "1", IssuedAt: 1}, {ID: "2", IssuedAt: 2, ExpiresAt: 50}}},
+		}},
+	}
+
+	require.NoError(t, p.ExpireJWTToken(0, -1, "1", 100))
+	assert.Equal(t, int64(100), p.Spec.Roles[0].JWTTokens[0].ExpiresAt)
+	assert.Equal(t, int64(100), p.Status.JWTTokensByRole["test-role"].Items[0].ExpiresAt)
+
+	// a token which expires earlier keeps its expiry
+	require.NoError(t, p.ExpireJWTToken(0, 2, "", 100))
+	assert.Equal(t, int64(50), p.Spec.Roles[0].JWTTokens[1].ExpiresAt)
+	assert.Equal(t, int64(50), p.Status.JWTTokensByRole["test-role"].Items[1].ExpiresAt)
+
+	require.Error(t, p.ExpireJWTToken(0, -1, "3", 100))
+}
+
 func TestRetryStrategy_NextRetryAtDefaultBackoff(t *testing.T) {
 	retry := RetryStrategy{}
 	now := time.Now()
@@ -1377,6 +1377,11 @@ func (in *ApplicationSpec) DeepCopyInto(out *ApplicationSpec) {
 		*out = new(SourceHydrator)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1668,6 +1673,10 @@ func (in *Cluster) DeepCopyInto(out *Cluster) {
 			(*out)[key] = val
 		}
 	}
+	if in.AuthRotatedAt != nil {
+		in, out := &in.AuthRotatedAt, &out.AuthRotatedAt
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -1763,6 +1772,16 @@ func (in *ClusterInfo) DeepCopyInto(out *ClusterInfo) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.FailedAPIGroups != nil {
+		in, out := &in.FailedAPIGroups, &out.FailedAPIGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ErrorChain != nil {
+		in, out := &in.ErrorChain, &out.ErrorChain
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -850,6 +850,8 @@ func (m *ListRefsRequest) GetRepo() *v1alpha1.Repository {
 type Refs struct {
 	Branches             []string `protobuf:"bytes,1,rep,name=branches,proto3" json:"branches,omitempty"`
 	Tags                 []string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	BranchSHAs           []string `protobuf:"bytes,3,rep,name=branchSHAs,proto3" json:"branchSHAs,omitempty"`
+	TagSHAs              []string `protobuf:"bytes,4,rep,name=tagSHAs,proto3" json:"tagSHAs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -902,6 +904,20 @@ func (m *Refs) GetTags() []string {
 	return nil
 }
 
+func (m *Refs) GetBranchSHAs() []string {
+	if m != nil {
+		return m.BranchSHAs
+	}
+	return nil
+}
+
+func (m *Refs) GetTagSHAs() []string {
+	if m != nil {
+		return m.TagSHAs
+	}
+	return nil
+}
+
 // ListAppsRequest requests a repository directory structure
 type ListAppsRequest struct {
 	Repo                 *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
@@ -2478,158 +2494,159 @@ func init() {
 }
 
 var fileDescriptor_dd8723cfcc820480 = []byte{
-	// 2407 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x1a, 0xcb, 0x72, 0x1c, 0x49,
-	0x71, 0x9e, 0xd2, 0x4c, 0xea, 0x5d, 0xb6, 0xe5, 0xf6, 0xd8, 0x16, 0xda, 0x06, 0x3b, 0xbc, 0xf6,
-	0xee, 0x28, 0x6c, 0xc7, 0xae, 0xc1, 0xbb, 0x2c, 0xa1, 0x95, 0x6d, 0x49, 0x6b, 0xcb, 0x16, 0x6d,
-	0xef, 0x12, 0x06, 0x03, 0x51, 0xd3, 0x53, 0xea, 0xe9, 0x55, 0x3f, 0xca, 0xdd, 0xd5, 0x5a, 0xe4,
-	0x08, 0x2e, 0x40, 0x70, 0xe1, 0xc2, 0x69, 0x0f, 0x5c, 0xf9, 0x06, 0x82, 0x23, 0x27, 0x02, 0x8e,
-	0x04, 0x17, 0x2e, 0x44, 0x40, 0xf8, 0x4b, 0x88, 0x7a, 0xf4, 0x73, 0x7a, 0x46, 0x5a, 0x8f, 0xad,
-	0x05, 0x2e, 0x52, 0x57, 0x56, 0x56, 0x66, 0x56, 0x56, 0x66, 0x56, 0x66, 0xd6, 0xc0, 0xe5, 0x80,
-	0x50, 0x3f, 0x24, 0xc1, 0x01, 0x09, 0xd6, 0xc4, 0xa7, 0xcd, 0xfc, 0xe0, 0x30, 0xf3, 0xd9, 0xa5,
-	0x81, 0xcf, 0x7c, 0x04, 0x29, 0xa4, 0xf3, 0xc0, 0xb2, 0xd9, 0x20, 0xea, 0x75, 0x4d, 0xdf, 0x5d,
-	0xc3, 0x81, 0xe5, 0xd3, 0xc0, 0xff, 0x5c, 0x7c, 0xbc, 0x6b, 0xf6, 0xd7, 0x0e, 0x6e, 0xae, 0xd1,
-	0x7d, 0x6b, 0x0d, 0x53, 0x3b, 0x5c, 0xc3, 0x94, 0x3a, 0xb6, 0x89, 0x99, 0xed, 0x7b, 0x6b, 0x07,
-	0xd7, 0xb1, 0x43, 0x07, 0xf8, 0xfa, 0x9a, 0x45, 0x3c, 0x12, 0x60, 0x46, 0xfa, 0x92, 0x72, 0xe7,
-	0xbc, 0xe5, 0xfb, 0x96, 0x43, 0xd6, 0xc4, 0xa8, 0x17, 0xed, 0xad, 0x11, 0x97, 0x32, 0xc5, 0x56,
-	0xff, 0xe7, 0x1c, 0x2c, 0xec, 0x60, 0xcf, 0xde, 0x23, 0x21, 0x33, 0xc8, 0xf3, 0x88, 0x84, 0x0c,
-	0x3d, 0x83, 0x06, 0x17, 0x46, 0xab, 0xae, 0x56, 0xaf, 0xcc, 0xdc, 0xd8, 0xea, 0xa6, 0xd2, 0x74,
-	0x63, 0x69, 0xc4, 0xc7, 0x4f, 0xcd, 0x7e, 0xf7, 0xe0, 0x66, 0x97, 0xee, 0x5b, 0x5d, 0x2e, 0x4d,
-	0x37, 0x23, 0x4d, 0x37, 0x96, 0xa6, 0x6b, 0x24, 0xdb, 0x32, 0x04, 0x55, 0xd4, 0x81, 0x56, 0x40,
-	0x0e, 0xec, 0xd0, 0xf6, 0x3d, 0xad, 0xb6, 0x5a, 0xbd, 0xd2, 0x36, 0x92, 0x31, 0xd2, 0x60, 0xda,
-	0xf3, 0x37, 0xb0, 0x39, 0x20, 0x5a, 0x7d, 0xb5, 0x7a, 0xa5, 0x65, 0xc4, 0x43, 0xb4, 0x0a, 0x33,
-	0x98, 0xd2, 0x07, 0xb8, 0x47, 0x9c, 0xfb, 0xe4, 0x50, 0x6b, 0x88, 0x85, 0x59, 0x10, 0x5f, 0x8b,
-	0x29, 0x7d, 0x88, 0x5d, 0xa2, 0x35, 0xc5, 0x6c, 0x3c, 0x44, 0x17, 0xa0, 0xed, 0x61, 0x97, 0x84,
-	0x14, 0x9b, 0x44, 0x6b, 0x89, 0xb9, 0x14, 0x80, 0x7e, 0x0e, 0x4b, 0x19, 0xc1, 0x1f, 0xfb, 0x51,
-	0x60, 0x12, 0x0d, 0xc4, 0xd6, 0x1f, 0x4d, 0xb6, 0xf5, 0xf5, 0x22, 0x59, 0x63, 0x98, 0x13, 0xfa,
-	0x09, 0x34, 0xc5, 0xc9, 0x6b, 0x33, 0xab, 0xf5, 0xd7, 0xaa, 0x6d, 0x49, 0x16, 0x79, 0x30, 0x4d,
-	0x9d, 0xc8, 0xb2, 0xbd, 0x50, 0x9b, 0x15, 0x1c, 0x9e, 0x4c, 0xc6, 0x61, 0xc3, 0xf7, 0xf6, 0x6c,
-	0x6b, 0x07, 0x7b, 0xd8, 0x22, 0x2e, 0xf1, 0xd8, 0xae, 0x20, 0x6e, 0xc4, 0x4c, 0xd0, 0x0b, 0x58,
-	0xdc, 0x8f, 0x42, 0xe6, 0xbb, 0xf6, 0x0b, 0xf2, 0x88, 0xf2, 0xb5, 0xa1, 0x36, 0x27, 0xb4, 0xf9,
-	0x70, 0x32, 0xc6, 0xf7, 0x0b, 0x54, 0x8d, 0x21, 0x3e, 0xdc, 0x48, 0xf6, 0xa3, 0x1e, 0xf9, 0x8c,
-	0x04, 0xc2, 0xba, 0xe6, 0xa5, 0x91, 0x64, 0x40, 0xd2, 0x8c, 0x6c, 0x35, 0x0a, 0xb5, 0x85, 0xd5,
-	0xba, 0x34, 0xa3, 0x04, 0x84, 0xae, 0xc0, 0xc2, 0x01, 0x09, 0xec, 0xbd, 0xc3, 0xc7, 0xb6, 0xe5,
-	0x61, 0x16, 0x05, 0x44, 0x5b, 0x14, 0xa6, 0x58, 0x04, 0x23, 0x17, 0xe6, 0x06, 0xc4, 0x71, 0xb9,
-	0xca, 0x37, 0x02, 0xd2, 0x0f, 0xb5, 0x25, 0xa1, 0xdf, 0xcd, 0xc9, 0x4f, 0x50, 0x90, 0x33, 0xf2,
-	0xd4, 0xb9, 0x60, 0x9e, 0x6f, 0x28, 0x4f, 0x91, 0x3e, 0x82, 0xa4, 0x60, 0x05, 0x30, 0xba, 0x0c,
-	0xf3, 0x2c, 0xc0, 0xe6, 0xbe, 0xed, 0x59, 0x3b, 0x84, 0x0d, 0xfc, 0xbe, 0x76, 0x4a, 0x68, 0xa2,
-	0x00, 0x45, 0x26, 0x20, 0xe2, 0xe1, 0x9e, 0x43, 0xfa, 0xd2, 0x16, 0x9f, 0x1c, 0x52, 0x12, 0x6a,
-	0xa7, 0xc5, 0x2e, 0x6e, 0x76, 0x33, 0x11, 0xaa, 0x10, 0x20, 0xba, 0x77, 0x87, 0x56, 0xdd, 0xf5,
-	0x58, 0x70, 0x68, 0x94, 0x90, 0x43, 0xfb, 0x30, 0xc3, 0xf7, 0x11, 0x9b, 0xc2, 0x19, 0x61, 0x0a,
-	0xdb, 0x93, 0xe9, 0x68, 0x2b, 0x25, 0x68, 0x64, 0xa9, 0xa3, 0x2e, 0xa0, 0x01, 0x0e, 0x77, 0x22,
-	0x87, 0xd9, 0xd4, 0x21, 0x52, 0x8c, 0x50, 0x5b, 0x16, 0x6a, 0x2a, 0x99, 0x41, 0xf7, 0x01, 0x02,
-	0xb2, 0x17, 0xe3, 0x9d, 0x15, 0x3b, 0xbf, 0x36, 0x6e, 0xe7, 0x46, 0x82, 0x2d, 0x77, 0x9c, 0x59,
-	0xce, 0x99, 0xf3, 0x6d, 0x10, 0x93, 0x29, 0x6f, 0x17, 0x6e, 0xad, 0x09, 0x13, 0x2b, 0x99, 0xe1,
-	0xb6, 0xa8, 0xa0, 0x22, 0x68, 0x9d, 0x93, 0xd6, 0x9a, 0x01, 0xa1, 0x2d, 0xf8, 0x06, 0xf6, 0x3c,
-	0x9f, 0x89, 0xed, 0xc7, 0xa2, 0x6c, 0xaa, 0xf0, 0xbe, 0x8b, 0xd9, 0x20, 0xd4, 0x3a, 0x62, 0xd5,
-	0x51, 0x68, 0xdc, 0x24, 0x6c, 0x2f, 0x64, 0xd8, 0x71, 0x04, 0xd2, 0xf6, 0x1d, 0xed, 0xbc, 0x34,
-	0x89, 0x3c, 0xb4, 0x73, 0x17, 0xce, 0x8e, 0x38, 0x5c, 0xb4, 0x08, 0xf5, 0x7d, 0x72, 0x28, 0x2e,
-	0x85, 0xb6, 0xc1, 0x3f, 0xd1, 0x69, 0x68, 0x1e, 0x60, 0x27, 0x22, 0x22, 0x8c, 0xb7, 0x0c, 0x39,
-	0xb8, 0x5d, 0xfb, 0x76, 0xb5, 0xf3, 0xeb, 0x2a, 0x2c, 0x14, 0x54, 0x55, 0xb2, 0xfe, 0xc7, 0xd9,
-	0xf5, 0xaf, 0xc1, 0x71, 0xf6, 0x9e, 0xe0, 0xc0, 0x22, 0x2c, 0x23, 0x88, 0xfe, 0xf7, 0x2a, 0x68,
-	0x85, 0x33, 0xfc, 0x81, 0xcd, 0x06, 0xf7, 0x6c, 0x87, 0x84, 0xe8, 0x16, 0x4c, 0x07, 0x12, 0xa6,
-	0xae, 0xba, 0xf3, 0x63, 0x8e, 0x7e, 0xab, 0x62, 0xc4, 0xd8, 0xe8, 0x23, 0x68, 0xb9, 0x84, 0xe1,
-	0x3e, 0x66, 0x58, 0xc9, 0xbe, 0x5a, 0xb6, 0x92, 0x73, 0xd9, 0x51, 0x78, 0x5b, 0x15, 0x23, 0x59,
-	0x83, 0xde, 0x83, 0xa6, 0x39, 0x88, 0xbc, 0x7d, 0x71, 0xc9, 0xcd, 0xdc, 0xb8, 0x38, 0x6a, 0xf1,
-	0x06, 0x47, 0xda, 0xaa, 0x18, 0x12, 0xfb, 0xe3, 0x29, 0x68, 0x50, 0x1c, 0x30, 0xfd, 0x1e, 0x9c,
-	0x2e, 0x63, 0xc1, 0x6f, 0x56, 0x73, 0x40, 0xcc, 0xfd, 0x30, 0x72, 0x95, 0x9a, 0x93, 0x31, 0x42,
-	0xd0, 0x08, 0xed, 0x17, 0x52, 0xd5, 0x75, 0x43, 0x7c, 0xeb, 0x6f, 0xc3, 0xd2, 0x10, 0x37, 0x7e,
-	0xa8, 0x52, 0x36, 0x4e, 0x61, 0x56, 0xb1, 0xd6, 0x23, 0x38, 0xf3, 0x44, 0xe8, 0x22, 0xb9, 0x5e,
-	0x4e, 0x22, 0x57, 0xd0, 0xb7, 0x60, 0xb9, 0xc8, 0x36, 0xa4, 0xbe, 0x17, 0x12, 0xee, 0x6c, 0x22,
-	0x1e, 0xdb, 0xa4, 0x9f, 0xce, 0x0a, 0x29, 0x5a, 0x46, 0xc9, 0x8c, 0xfe, 0xfb, 0x1a, 0x2c, 0x1b,
-	0x24, 0xf4, 0x9d, 0x03, 0x12, 0x07, 0xcb, 0x93, 0x49, 0x77, 0x7e, 0x04, 0x75, 0x4c, 0xa9, 0x32,
-	0x93, 0xed, 0xd7, 0x96, 0x50, 0x18, 0x9c, 0x2a, 0x7a, 0x07, 0x96, 0xb0, 0xdb, 0xb3, 0xad, 0xc8,
-	0x8f, 0xc2, 0x78, 0x5b, 0xc2, 0xa8, 0xda, 0xc6, 0xf0, 0x04, 0x0f, 0x38, 0xa1, 0xf0, 0xc8, 0x6d,
-	0xaf, 0x4f, 0x7e, 0x26, 0x72, 0xa8, 0xba, 0x91, 0x05, 0xe9, 0x26, 0x9c, 0x1d, 0x52, 0x92, 0x52,
-	0x78, 0x36, 0x6d, 0xab, 0x16, 0xd2, 0xb6, 0x52, 0x31, 0x6a, 0x23, 0xc4, 0xd0, 0x5f, 0x56, 0x61,
-	0x31, 0x75, 0x2e, 0x45, 0xfe, 0x02, 0xb4, 0x5d, 0x05, 0x0b, 0xb5, 0xaa, 0x88, 0x99, 0x29, 0x20,
-	0x9f, 0xc1, 0xd5, 0x8a, 0x19, 0xdc, 0x32, 0x4c, 0xc9, 0x04, 0x5b, 0x6d, 0x5d, 0x8d, 0x72, 0x22,
-	0x37, 0x0a, 0x22, 0xaf, 0x00, 0x84, 0x49, 0x84, 0xd3, 0xa6, 0xc4, 0x6c, 0x06, 0x82, 0x74, 0x98,
-	0x95, 0xf7, 0xbd, 0x41, 0xc2, 0xc8, 0x61, 0xda, 0xb4, 0xc0, 0xc8, 0xc1, 0x84, 0xbf, 0xf9, 0xae,
-	0x8b, 0xbd, 0x7e, 0xa8, 0xb5, 0x84, 0xc8, 0xc9, 0x58, 0xf7, 0x61, 0xe1, 0x81, 0xcd, 0xf7, 0xb7,
-	0x17, 0x9e, 0x8c, 0xab, 0xbc, 0x0f, 0x0d, 0xce, 0x8c, 0x0b, 0xd5, 0x0b, 0xb0, 0x67, 0x0e, 0x48,
-	0xac, 0xc7, 0x64, 0xcc, 0x83, 0x00, 0xc3, 0x56, 0xa8, 0xd5, 0x04, 0x5c, 0x7c, 0xeb, 0x7f, 0xac,
-	0x49, 0x49, 0xd7, 0x29, 0x0d, 0xbf, 0xfe, 0x02, 0xa0, 0x3c, 0x25, 0xa9, 0x0f, 0xa7, 0x24, 0x05,
-	0x91, 0xbf, 0x4a, 0x4a, 0xf2, 0x9a, 0x2e, 0x39, 0x3d, 0x82, 0xe9, 0x75, 0x4a, 0xb9, 0x20, 0xe8,
-	0x3a, 0x34, 0x30, 0xa5, 0x52, 0xe1, 0x85, 0x78, 0xae, 0x50, 0xf8, 0x7f, 0x25, 0x92, 0x40, 0xed,
-	0xdc, 0x82, 0x76, 0x02, 0x3a, 0x8a, 0x6d, 0x3b, 0xcb, 0x76, 0x15, 0x40, 0xe6, 0xdc, 0xdb, 0xde,
-	0x9e, 0xcf, 0x8f, 0x94, 0x3b, 0x82, 0x5a, 0x2a, 0xbe, 0xf5, 0xdb, 0x31, 0x86, 0x90, 0xed, 0x1d,
-	0x68, 0xda, 0x8c, 0xb8, 0xb1, 0x70, 0xcb, 0x59, 0xe1, 0x52, 0x42, 0x86, 0x44, 0xd2, 0xff, 0xd2,
-	0x82, 0x73, 0xfc, 0xc4, 0x1e, 0x0b, 0x17, 0x5a, 0xa7, 0xf4, 0x0e, 0x61, 0xd8, 0x76, 0xc2, 0xef,
-	0x47, 0x24, 0x38, 0x7c, 0xc3, 0x86, 0x61, 0xc1, 0x94, 0xf4, 0x40, 0x15, 0x2d, 0x5f, 0x7b, 0xf9,
-	0xa5, 0xc8, 0xa7, 0x35, 0x57, 0xfd, 0xcd, 0xd4, 0x5c, 0x65, 0x35, 0x50, 0xe3, 0x84, 0x6a, 0xa0,
-	0xd1, 0x65, 0x70, 0xa6, 0xb8, 0x9e, 0xca, 0x17, 0xd7, 0x25, 0xa5, 0xc5, 0xf4, 0x71, 0x4b, 0x8b,
-	0x56, 0x69, 0x69, 0xe1, 0x96, 0xfa, 0x71, 0x5b, 0xa8, 0xfb, 0xbb, 0x59, 0x0b, 0x1c, 0x69, 0x6b,
-	0x93, 0x14, 0x19, 0xf0, 0x46, 0x8b, 0x8c, 0x4f, 0x73, 0x45, 0x83, 0x2c, 0xdb, 0xdf, 0x3b, 0xde,
-	0x9e, 0xc6, 0x94, 0x0f, 0xff, 0x77, 0xa9, 0xf7, 0xaf, 0x44, 0xc6, 0x45, 0xfd, 0x54, 0x07, 0xc9,
-	0x65, 0xcf, 0xef, 0x21, 0x7e, 0xed, 0xaa, 0xa0, 0xc5, 0xbf, 0xd1, 0x35, 0x68, 0x70, 0x25, 0xab,
-	0x94, 0xf8, 0x6c, 0x56, 0x9f, 0xfc, 0x24, 0xd6, 0x29, 0x7d, 0x4c, 0x89, 0x69, 0x08, 0x24, 0x74,
-	0x1b, 0xda, 0x89, 0xe1, 0x2b, 0xcf, 0xba, 0x90, 0x5d, 0x91, 0xf8, 0x49, 0xbc, 0x2c, 0x45, 0xe7,
-	0x6b, 0xfb, 0x76, 0x40, 0x4c, 0x91, 0x30, 0x36, 0x87, 0xd7, 0xde, 0x89, 0x27, 0x93, 0xb5, 0x09,
-	0x3a, 0xba, 0x0e, 0x53, 0xb2, 0xcf, 0x21, 0x3c, 0x68, 0xe6, 0xc6, 0xb9, 0xe1, 0x60, 0x1a, 0xaf,
-	0x52, 0x88, 0xfa, 0x9f, 0xab, 0xf0, 0x56, 0x6a, 0x10, 0xb1, 0x37, 0xc5, 0x39, 0xfb, 0xd7, 0x7f,
-	0xe3, 0x5e, 0x86, 0x79, 0x51, 0x24, 0xa4, 0xed, 0x0e, 0xd9, 0x79, 0x2b, 0x40, 0xf5, 0x3f, 0x54,
-	0xe1, 0xd2, 0xf0, 0x3e, 0x36, 0x06, 0x38, 0x60, 0xc9, 0xf1, 0x9e, 0xc4, 0x5e, 0xe2, 0x0b, 0xaf,
-	0x96, 0x5e, 0x78, 0xb9, 0xfd, 0xd5, 0xf3, 0xfb, 0xd3, 0xff, 0x54, 0x83, 0x99, 0x8c, 0x01, 0x95,
-	0x5d, 0x98, 0x3c, 0x19, 0x14, 0x76, 0x2b, 0xca, 0x42, 0x71, 0x29, 0xb4, 0x8d, 0x0c, 0x04, 0xed,
-	0x03, 0x50, 0x1c, 0x60, 0x97, 0x30, 0x12, 0xf0, 0x48, 0xce, 0x3d, 0xfe, 0xfe, 0xe4, 0xd1, 0x65,
-	0x37, 0xa6, 0x69, 0x64, 0xc8, 0xf3, 0x6c, 0x56, 0xb0, 0x0e, 0x55, 0xfc, 0x56, 0x23, 0xf4, 0x05,
-	0xcc, 0xef, 0xd9, 0x0e, 0xd9, 0x4d, 0x05, 0x99, 0x12, 0x82, 0x3c, 0x9a, 0x5c, 0x90, 0x7b, 0x59,
-	0xba, 0x46, 0x81, 0x8d, 0x7e, 0x15, 0x16, 0x8b, 0xfe, 0xc4, 0x85, 0xb4, 0x5d, 0x6c, 0x25, 0xda,
-	0x52, 0x23, 0x1d, 0xc1, 0x62, 0xd1, 0x7f, 0xf4, 0x7f, 0xd5, 0xe0, 0x4c, 0x42, 0x6e, 0xdd, 0xf3,
-	0xfc, 0xc8, 0x33, 0x45, 0xeb, 0xb0, 0xf4, 0x2c, 0x4e, 0x43, 0x93, 0xd9, 0xcc, 0x49, 0x12, 0x1f,
-	0x31, 0xe0, 0x77, 0x17, 0xf3, 0x7d, 0x87, 0xd9, 0x54, 0x1d, 0x70, 0x3c, 0x94, 0x67, 0xff, 0x3c,
-	0xb2, 0x03, 0xd2, 0x17, 0x91, 0xa0, 0x65, 0x24, 0x63, 0x3e, 0xc7, 0xb3, 0x1a, 0x91, 0xe2, 0x4b,
-	0x65, 0x26, 0x63, 0x61, 0xf7, 0xbe, 0xe3, 0x10, 0x93, 0xab, 0x23, 0x53, 0x04, 0x14, 0xa0, 0xa2,
-	0xb8, 0x60, 0x81, 0xed, 0x59, 0xaa, 0x04, 0x50, 0x23, 0x2e, 0x27, 0x0e, 0x02, 0x7c, 0xa8, 0x32,
-	0x7f, 0x39, 0x40, 0x1f, 0x42, 0xdd, 0xc5, 0x54, 0x5d, 0x74, 0x57, 0x73, 0xd1, 0xa1, 0x4c, 0x03,
-	0xdd, 0x1d, 0x4c, 0xe5, 0x4d, 0xc0, 0x97, 0x75, 0xde, 0x87, 0x56, 0x0c, 0xf8, 0x4a, 0x29, 0xe1,
-	0xe7, 0x30, 0x97, 0x0b, 0x3e, 0xe8, 0x29, 0x2c, 0xa7, 0x16, 0x95, 0x65, 0xa8, 0x92, 0xc0, 0xb7,
-	0x8e, 0x94, 0xcc, 0x18, 0x41, 0x40, 0x7f, 0x0e, 0x4b, 0xdc, 0x64, 0x84, 0xe3, 0x9f, 0x50, 0x69,
-	0xf3, 0x01, 0xb4, 0x13, 0x96, 0xa5, 0x36, 0xd3, 0x81, 0xd6, 0x41, 0xdc, 0xd2, 0x95, 0xb5, 0x4d,
-	0x32, 0xd6, 0xd7, 0x01, 0x65, 0xe5, 0x55, 0x37, 0xd0, 0xb5, 0x7c, 0x52, 0x7c, 0xa6, 0x78, 0xdd,
-	0x08, 0xf4, 0x38, 0x27, 0xfe, 0x47, 0x0d, 0x16, 0x36, 0x6d, 0xd1, 0x23, 0x39, 0xa1, 0x20, 0x77,
-	0x15, 0x16, 0xc3, 0xa8, 0xe7, 0xfa, 0xfd, 0xc8, 0x21, 0x2a, 0x29, 0x50, 0x37, 0xfd, 0x10, 0x7c,
-	0x5c, 0xf0, 0xe3, 0xca, 0xa2, 0x98, 0x0d, 0x54, 0xf5, 0x2b, 0xbe, 0xd1, 0x87, 0x70, 0xee, 0x21,
-	0xf9, 0x42, 0xed, 0x67, 0xd3, 0xf1, 0x7b, 0x3d, 0xdb, 0xb3, 0x62, 0x26, 0x4d, 0xc1, 0x64, 0x34,
-	0x42, 0x59, 0xaa, 0x38, 0x55, 0x9e, 0x2a, 0x26, 0x15, 0xf4, 0x86, 0xef, 0xba, 0x36, 0x53, 0x19,
-	0x65, 0x0e, 0xa6, 0xff, 0xb2, 0x0a, 0x8b, 0xa9, 0x66, 0xd5, 0xd9, 0xdc, 0x92, 0x3e, 0x24, 0x4f,
-	0xe6, 0x52, 0xf6, 0x64, 0x8a, 0xa8, 0xaf, 0xee, 0x3e, 0xb3, 0x59, 0xf7, 0xf9, 0x4d, 0x0d, 0xce,
-	0x6c, 0xda, 0x2c, 0x0e, 0x5c, 0xf6, 0xff, 0xda, 0x29, 0x97, 0x9c, 0x49, 0xe3, 0x78, 0x67, 0xd2,
-	0x2c, 0x39, 0x93, 0x2e, 0x2c, 0x17, 0x95, 0xa1, 0x0e, 0xe6, 0x34, 0x34, 0xa9, 0x68, 0x3a, 0xcb,
-	0xbe, 0x82, 0x1c, 0xe8, 0xbf, 0x98, 0x86, 0x8b, 0x9f, 0xd2, 0x3e, 0x66, 0x49, 0xcf, 0xe8, 0x9e,
-	0x1f, 0x88, 0xae, 0xf3, 0xc9, 0x68, 0xb1, 0xf0, 0x32, 0x58, 0x1b, 0xfb, 0x32, 0x58, 0x1f, 0xf3,
-	0x32, 0xd8, 0x38, 0xd6, 0xcb, 0x60, 0xf3, 0xc4, 0x5e, 0x06, 0x87, 0x6b, 0xad, 0xa9, 0xd2, 0x5a,
-	0xeb, 0x69, 0xae, 0x1e, 0x99, 0x16, 0x6e, 0xf3, 0x9d, 0xac, 0xdb, 0x8c, 0x3d, 0x9d, 0xb1, 0x4f,
-	0x1a, 0x85, 0x07, 0xb5, 0xd6, 0x91, 0x0f, 0x6a, 0xed, 0xe1, 0x07, 0xb5, 0xf2, 0x37, 0x19, 0x18,
-	0xf9, 0x26, 0x73, 0x19, 0xe6, 0xc3, 0x43, 0xcf, 0x24, 0xfd, 0xa4, 0x93, 0x38, 0x23, 0xb7, 0x9d,
-	0x87, 0xe6, 0x3c, 0x62, 0xb6, 0xe0, 0x11, 0x89, 0xa5, 0xce, 0x65, 0x2c, 0xb5, 0xcc, 0x4f, 0xe6,
-	0x47, 0x96, 0xb9, 0x85, 0xe7, 0x92, 0x85, 0xd2, 0xe7, 0x92, 0xff, 0x9a, 0x62, 0xeb, 0x33, 0x58,
-	0x19, 0x75, 0xca, 0xca, 0x79, 0x35, 0x98, 0x36, 0x07, 0xd8, 0xb3, 0x44, 0x5b, 0x50, 0x54, 0xff,
-	0x6a, 0x38, 0xae, 0x3a, 0xb8, 0xf1, 0xe5, 0x2c, 0x2c, 0xa5, 0x59, 0x3f, 0xff, 0x6b, 0x9b, 0x04,
-	0x3d, 0x82, 0xc5, 0xf8, 0x79, 0x29, 0x6e, 0xe4, 0xa2, 0x71, 0x6f, 0x27, 0x9d, 0x0b, 0xe5, 0x93,
-	0x52, 0x34, 0xbd, 0x82, 0x4c, 0x38, 0x57, 0x24, 0x98, 0x3e, 0xd3, 0x7c, 0x6b, 0x0c, 0xe5, 0x04,
-	0xeb, 0x28, 0x16, 0x57, 0xaa, 0xe8, 0x29, 0xcc, 0xe7, 0x1f, 0x13, 0x50, 0x2e, 0x0d, 0x2a, 0x7d,
-	0xdf, 0xe8, 0xe8, 0xe3, 0x50, 0x12, 0xf9, 0x9f, 0x71, 0x33, 0xc8, 0xf5, 0xcd, 0x91, 0x9e, 0xef,
-	0x08, 0x94, 0xbd, 0x3c, 0x74, 0xbe, 0x39, 0x16, 0x27, 0xa1, 0xfe, 0x01, 0xb4, 0xe2, 0x5e, 0x72,
-	0x5e, 0xcd, 0x85, 0x0e, 0x73, 0x67, 0x31, 0x4f, 0x6f, 0x2f, 0xd4, 0x2b, 0xe8, 0x23, 0x98, 0xe1,
-	0x68, 0x8f, 0x36, 0xb6, 0x9f, 0x60, 0xeb, 0x95, 0xd6, 0xb7, 0xe2, 0x5e, 0xeb, 0xf0, 0xe2, 0x4c,
-	0x07, 0xb6, 0x73, 0xaa, 0xa4, 0xeb, 0xa9, 0x57, 0xd0, 0xf7, 0x24, 0xff, 0x5d, 0xf5, 0xf3, 0x80,
-	0xe5, 0xae, 0xfc, 0x35, 0x4a, 0x37, 0xfe, 0x35, 0x4a, 0xf7, 0xae, 0x4b, 0xd9, 0x61, 0xa7, 0xa4,
-	0x2d, 0xa9, 0x08, 0x3c, 0x83, 0xb9, 0x4d, 0xc2, 0xd2, 0x2e, 0x02, 0xba, 0x74, 0xac, 0x5e, 0x4b,
-	0x47, 0x2f, 0xa2, 0x0d, 0x37, 0x22, 0xf4, 0x0a, 0xfa, 0xb2, 0x0a, 0xa7, 0x36, 0x09, 0x2b, 0xd6,
-	0xe5, 0xe8, 0xdd, 0x72, 0x26, 0x23, 0xea, 0xf7, 0xce, 0xc3, 0x49, 0x7d, 0x3a, 0x4f, 0x56, 0xaf,
-	0xa0, 0xdf, 0x56, 0x61, 0x7e, 0x93, 0xf0, 0x73, 0x4b, 0x64, 0xba, 0x3e, 0x5e, 0xa6, 0x92, 0x5a,
-	0xbc, 0x33, 0x61, 0x0f, 0x2c, 0xc3, 0x5d, 0xaf, 0xa0, 0xdf, 0x55, 0xe1, 0x6c, 0x46, 0x57, 0x59,
-	0x7e, 0xaf, 0x22, 0xdb, 0x27, 0x13, 0xfe, 0x10, 0x25, 0x43, 0x52, 0xaf, 0xa0, 0x5d, 0x61, 0x26,
-	0x69, 0xaa, 0x8f, 0x2e, 0x96, 0xe6, 0xf4, 0x09, 0xf7, 0x95, 0x51, 0xd3, 0x89, 0x69, 0x7c, 0x02,
-	0x33, 0x9b, 0x84, 0xc5, 0x39, 0x67, 0xde, 0xf8, 0x0b, 0xe5, 0x40, 0x3e, 0xfa, 0x14, 0xd3, 0x54,
-	0x61, 0xc4, 0x4b, 0x92, 0x56, 0x26, 0xaf, 0xca, 0x87, 0x9f, 0xd2, 0x04, 0x34, 0x6f, 0xc4, 0xe5,
-	0x69, 0x99, 0x5e, 0x41, 0xcf, 0x61, 0xb9, 0x3c, 0xfa, 0xa3, 0xb7, 0x8f, 0x9d, 0x07, 0x74, 0xae,
-	0x1e, 0x07, 0x35, 0x66, 0xf9, 0xf1, 0xfa, 0x5f, 0x5f, 0xae, 0x54, 0xff, 0xf6, 0x72, 0xa5, 0xfa,
-	0xef, 0x97, 0x2b, 0xd5, 0x1f, 0xde, 0x3c, 0xe2, 0x07, 0x6b, 0x99, 0xdf, 0xc0, 0x61, 0x6a, 0x9b,
-	0x8e, 0x4d, 0x3c, 0xd6, 0x9b, 0x12, 0x21, 0xe0, 0xe6, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xc9,
-	0x9b, 0xb9, 0x62, 0x22, 0x27, 0x00, 0x00,
+	// 2425 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3a, 0x4b, 0x73, 0x1c, 0x49,
+	0xd1, 0x9a, 0xa7, 0x66, 0x72, 0xf4, 0xac, 0xb5, 0xe5, 0xf6, 0xd8, 0xd6, 0xa7, 0xed, 0x0f, 0x3b,
+	0xbc, 0xf6, 0xee, 0x28, 0x6c, 0xc7, 0x62, 0xf0, 0x2e, 0x4b, 0x68, 0x65, 0x5b, 0xf2, 0xda, 0xb2,
+	0x45, 0xdb, 0xbb, 0x84, 0xc1, 0x40, 0xd4, 0xf4, 0x94, 0x7a, 0x7a, 0xd5, 0x8f, 0x72, 0x77, 0xb5,
+	0x16, 0x39, 0x82, 0x0b, 0x10, 0x5c, 0xb8, 0x70, 0xda, 0x03, 0x57, 0x7e, 0x03, 0xc1, 0x91, 0x13,
+	0x01, 0x47, 0x82, 0x0b, 0x17, 0x22, 0x20, 0xfc, 0x4b, 0x88, 0x7a, 0xf4, 0x73, 0x7a, 0x46, 0x5a,
+	0x8f, 0xad, 0x05, 0x2e, 0x52, 0x57, 0x56, 0x56, 0x66, 0x56, 0x56, 0x66, 0x56, 0x66, 0xd6, 0xc0,
+	0xa5, 0x80, 0x50, 0x3f, 0x24, 0xc1, 0x01, 0x09, 0xd6, 0xc5, 0xa7, 0xcd, 0xfc, 0xe0, 0x30, 0xf3,
+	0xd9, 0xa3, 0x81, 0xcf, 0x7c, 0x04, 0x29, 0xa4, 0xfb, 0xc0, 0xb2, 0xd9, 0x30, 0xea, 0xf7, 0x4c,
+	0xdf, 0x5d, 0xc7, 0x81, 0xe5, 0xd3, 0xc0, 0xff, 0x5c, 0x7c, 0xbc, 0x67, 0x0e, 0xd6, 0x0f, 0x6e,
+	0xac, 0xd3, 0x7d, 0x6b, 0x1d, 0x53, 0x3b, 0x5c, 0xc7, 0x94, 0x3a, 0xb6, 0x89, 0x99, 0xed, 0x7b,
+	0xeb, 0x07, 0xd7, 0xb0, 0x43, 0x87, 0xf8, 0xda, 0xba, 0x45, 0x3c, 0x12, 0x60, 0x46, 0x06, 0x92,
+	0x72, 0xf7, 0x9c, 0xe5, 0xfb, 0x96, 0x43, 0xd6, 0xc5, 0xa8, 0x1f, 0xed, 0xad, 0x13, 0x97, 0x32,
+	0xc5, 0x56, 0xff, 0xc7, 0x3c, 0x2c, 0xee, 0x60, 0xcf, 0xde, 0x23, 0x21, 0x33, 0xc8, 0xf3, 0x88,
+	0x84, 0x0c, 0x3d, 0x83, 0x3a, 0x17, 0x46, 0xab, 0xac, 0x55, 0x2e, 0x77, 0xae, 0x6f, 0xf7, 0x52,
+	0x69, 0x7a, 0xb1, 0x34, 0xe2, 0xe3, 0x27, 0xe6, 0xa0, 0x77, 0x70, 0xa3, 0x47, 0xf7, 0xad, 0x1e,
+	0x97, 0xa6, 0x97, 0x91, 0xa6, 0x17, 0x4b, 0xd3, 0x33, 0x92, 0x6d, 0x19, 0x82, 0x2a, 0xea, 0x42,
+	0x2b, 0x20, 0x07, 0x76, 0x68, 0xfb, 0x9e, 0x56, 0x5d, 0xab, 0x5c, 0x6e, 0x1b, 0xc9, 0x18, 0x69,
+	0x30, 0xeb, 0xf9, 0x9b, 0xd8, 0x1c, 0x12, 0xad, 0xb6, 0x56, 0xb9, 0xdc, 0x32, 0xe2, 0x21, 0x5a,
+	0x83, 0x0e, 0xa6, 0xf4, 0x01, 0xee, 0x13, 0xe7, 0x3e, 0x39, 0xd4, 0xea, 0x62, 0x61, 0x16, 0xc4,
+	0xd7, 0x62, 0x4a, 0x1f, 0x62, 0x97, 0x68, 0x0d, 0x31, 0x1b, 0x0f, 0xd1, 0x79, 0x68, 0x7b, 0xd8,
+	0x25, 0x21, 0xc5, 0x26, 0xd1, 0x5a, 0x62, 0x2e, 0x05, 0xa0, 0x9f, 0xc1, 0x72, 0x46, 0xf0, 0xc7,
+	0x7e, 0x14, 0x98, 0x44, 0x03, 0xb1, 0xf5, 0x47, 0xd3, 0x6d, 0x7d, 0xa3, 0x48, 0xd6, 0x18, 0xe5,
+	0x84, 0x7e, 0x0c, 0x0d, 0x71, 0xf2, 0x5a, 0x67, 0xad, 0xf6, 0x5a, 0xb5, 0x2d, 0xc9, 0x22, 0x0f,
+	0x66, 0xa9, 0x13, 0x59, 0xb6, 0x17, 0x6a, 0x73, 0x82, 0xc3, 0x93, 0xe9, 0x38, 0x6c, 0xfa, 0xde,
+	0x9e, 0x6d, 0xed, 0x60, 0x0f, 0x5b, 0xc4, 0x25, 0x1e, 0xdb, 0x15, 0xc4, 0x8d, 0x98, 0x09, 0x7a,
+	0x01, 0x4b, 0xfb, 0x51, 0xc8, 0x7c, 0xd7, 0x7e, 0x41, 0x1e, 0x51, 0xbe, 0x36, 0xd4, 0xe6, 0x85,
+	0x36, 0x1f, 0x4e, 0xc7, 0xf8, 0x7e, 0x81, 0xaa, 0x31, 0xc2, 0x87, 0x1b, 0xc9, 0x7e, 0xd4, 0x27,
+	0x9f, 0x91, 0x40, 0x58, 0xd7, 0x82, 0x34, 0x92, 0x0c, 0x48, 0x9a, 0x91, 0xad, 0x46, 0xa1, 0xb6,
+	0xb8, 0x56, 0x93, 0x66, 0x94, 0x80, 0xd0, 0x65, 0x58, 0x3c, 0x20, 0x81, 0xbd, 0x77, 0xf8, 0xd8,
+	0xb6, 0x3c, 0xcc, 0xa2, 0x80, 0x68, 0x4b, 0xc2, 0x14, 0x8b, 0x60, 0xe4, 0xc2, 0xfc, 0x90, 0x38,
+	0x2e, 0x57, 0xf9, 0x66, 0x40, 0x06, 0xa1, 0xb6, 0x2c, 0xf4, 0xbb, 0x35, 0xfd, 0x09, 0x0a, 0x72,
+	0x46, 0x9e, 0x3a, 0x17, 0xcc, 0xf3, 0x0d, 0xe5, 0x29, 0xd2, 0x47, 0x90, 0x14, 0xac, 0x00, 0x46,
+	0x97, 0x60, 0x81, 0x05, 0xd8, 0xdc, 0xb7, 0x3d, 0x6b, 0x87, 0xb0, 0xa1, 0x3f, 0xd0, 0xde, 0x12,
+	0x9a, 0x28, 0x40, 0x91, 0x09, 0x88, 0x78, 0xb8, 0xef, 0x90, 0x81, 0xb4, 0xc5, 0x27, 0x87, 0x94,
+	0x84, 0xda, 0x29, 0xb1, 0x8b, 0x1b, 0xbd, 0x4c, 0x84, 0x2a, 0x04, 0x88, 0xde, 0x9d, 0x91, 0x55,
+	0x77, 0x3c, 0x16, 0x1c, 0x1a, 0x25, 0xe4, 0xd0, 0x3e, 0x74, 0xf8, 0x3e, 0x62, 0x53, 0x38, 0x2d,
+	0x4c, 0xe1, 0xde, 0x74, 0x3a, 0xda, 0x4e, 0x09, 0x1a, 0x59, 0xea, 0xa8, 0x07, 0x68, 0x88, 0xc3,
+	0x9d, 0xc8, 0x61, 0x36, 0x75, 0x88, 0x14, 0x23, 0xd4, 0x56, 0x84, 0x9a, 0x4a, 0x66, 0xd0, 0x7d,
+	0x80, 0x80, 0xec, 0xc5, 0x78, 0x67, 0xc4, 0xce, 0xaf, 0x4e, 0xda, 0xb9, 0x91, 0x60, 0xcb, 0x1d,
+	0x67, 0x96, 0x73, 0xe6, 0x7c, 0x1b, 0xc4, 0x64, 0x12, 0x22, 0x7c, 0x51, 0xd3, 0x84, 0x89, 0x95,
+	0xcc, 0x70, 0x5b, 0x54, 0x50, 0x11, 0xb4, 0xce, 0x4a, 0x6b, 0xcd, 0x80, 0xd0, 0x36, 0xfc, 0x1f,
+	0xf6, 0x3c, 0x9f, 0x89, 0xed, 0xc7, 0xa2, 0x6c, 0xa9, 0xf0, 0xbe, 0x8b, 0xd9, 0x30, 0xd4, 0xba,
+	0x62, 0xd5, 0x51, 0x68, 0xdc, 0x24, 0x6c, 0x2f, 0x64, 0xd8, 0x71, 0x04, 0xd2, 0xbd, 0xdb, 0xda,
+	0x39, 0x69, 0x12, 0x79, 0x68, 0xf7, 0x0e, 0x9c, 0x19, 0x73, 0xb8, 0x68, 0x09, 0x6a, 0xfb, 0xe4,
+	0x50, 0x5c, 0x0a, 0x6d, 0x83, 0x7f, 0xa2, 0x53, 0xd0, 0x38, 0xc0, 0x4e, 0x44, 0x44, 0x18, 0x6f,
+	0x19, 0x72, 0x70, 0xab, 0xfa, 0xad, 0x4a, 0xf7, 0x57, 0x15, 0x58, 0x2c, 0xa8, 0xaa, 0x64, 0xfd,
+	0x8f, 0xb2, 0xeb, 0x5f, 0x83, 0xe3, 0xec, 0x3d, 0xc1, 0x81, 0x45, 0x58, 0x46, 0x10, 0xfd, 0x6f,
+	0x15, 0xd0, 0x0a, 0x67, 0xf8, 0x7d, 0x9b, 0x0d, 0xef, 0xda, 0x0e, 0x09, 0xd1, 0x4d, 0x98, 0x0d,
+	0x24, 0x4c, 0x5d, 0x75, 0xe7, 0x26, 0x1c, 0xfd, 0xf6, 0x8c, 0x11, 0x63, 0xa3, 0x8f, 0xa0, 0xe5,
+	0x12, 0x86, 0x07, 0x98, 0x61, 0x25, 0xfb, 0x5a, 0xd9, 0x4a, 0xce, 0x65, 0x47, 0xe1, 0x6d, 0xcf,
+	0x18, 0xc9, 0x1a, 0xf4, 0x3e, 0x34, 0xcc, 0x61, 0xe4, 0xed, 0x8b, 0x4b, 0xae, 0x73, 0xfd, 0xc2,
+	0xb8, 0xc5, 0x9b, 0x1c, 0x69, 0x7b, 0xc6, 0x90, 0xd8, 0x1f, 0x37, 0xa1, 0x4e, 0x71, 0xc0, 0xf4,
+	0xbb, 0x70, 0xaa, 0x8c, 0x05, 0xbf, 0x59, 0xcd, 0x21, 0x31, 0xf7, 0xc3, 0xc8, 0x55, 0x6a, 0x4e,
+	0xc6, 0x08, 0x41, 0x3d, 0xb4, 0x5f, 0x48, 0x55, 0xd7, 0x0c, 0xf1, 0xad, 0xbf, 0x03, 0xcb, 0x23,
+	0xdc, 0xf8, 0xa1, 0x4a, 0xd9, 0x38, 0x85, 0x39, 0xc5, 0x5a, 0x8f, 0xe0, 0xf4, 0x13, 0xa1, 0x8b,
+	0xe4, 0x7a, 0x39, 0x89, 0x5c, 0x41, 0xdf, 0x86, 0x95, 0x22, 0xdb, 0x90, 0xfa, 0x5e, 0x48, 0xb8,
+	0xb3, 0x89, 0x78, 0x6c, 0x93, 0x41, 0x3a, 0x2b, 0xa4, 0x68, 0x19, 0x25, 0x33, 0xfa, 0xef, 0xaa,
+	0xb0, 0x62, 0x90, 0xd0, 0x77, 0x0e, 0x48, 0x1c, 0x2c, 0x4f, 0x26, 0xdd, 0xf9, 0x21, 0xd4, 0x30,
+	0xa5, 0x5a, 0xf5, 0x75, 0xc4, 0xbd, 0x4c, 0x42, 0x61, 0x70, 0xaa, 0xe8, 0x5d, 0x58, 0xc6, 0x6e,
+	0xdf, 0xb6, 0x22, 0x3f, 0x0a, 0xe3, 0x6d, 0x09, 0xa3, 0x6a, 0x1b, 0xa3, 0x13, 0x3c, 0xe0, 0x84,
+	0xc2, 0x23, 0xef, 0x79, 0x03, 0xf2, 0x53, 0x91, 0x43, 0xd5, 0x8c, 0x2c, 0x48, 0x37, 0xe1, 0xcc,
+	0x88, 0x92, 0x94, 0xc2, 0xb3, 0x69, 0x5b, 0xa5, 0x90, 0xb6, 0x95, 0x8a, 0x51, 0x1d, 0x23, 0x86,
+	0xfe, 0xb2, 0x02, 0x4b, 0xa9, 0x73, 0x29, 0xf2, 0xe7, 0xa1, 0xed, 0x2a, 0x58, 0xa8, 0x55, 0x44,
+	0xcc, 0x4c, 0x01, 0xf9, 0x0c, 0xae, 0x5a, 0xcc, 0xe0, 0x56, 0xa0, 0x29, 0x13, 0x6c, 0xb5, 0x75,
+	0x35, 0xca, 0x89, 0x5c, 0x2f, 0x88, 0xbc, 0x0a, 0x10, 0x26, 0x11, 0x4e, 0x6b, 0x8a, 0xd9, 0x0c,
+	0x04, 0xe9, 0x30, 0x27, 0xef, 0x7b, 0x83, 0x84, 0x91, 0xc3, 0xb4, 0x59, 0x81, 0x91, 0x83, 0x09,
+	0x7f, 0xf3, 0x5d, 0x17, 0x7b, 0x83, 0x50, 0x6b, 0x09, 0x91, 0x93, 0xb1, 0xee, 0xc3, 0xe2, 0x03,
+	0x9b, 0xef, 0x6f, 0x2f, 0x3c, 0x19, 0x57, 0xa1, 0x50, 0xe7, 0xcc, 0xb8, 0x50, 0xfd, 0x00, 0x7b,
+	0xe6, 0x90, 0xc4, 0x7a, 0x4c, 0xc6, 0x3c, 0x08, 0x30, 0x6c, 0x85, 0x5a, 0x55, 0xc0, 0xc5, 0x37,
+	0x57, 0x84, 0x9c, 0x7f, 0xbc, 0xbd, 0x11, 0x6a, 0x35, 0x31, 0x93, 0x81, 0xf0, 0xb4, 0x9a, 0x61,
+	0x4b, 0x4c, 0xd6, 0xc5, 0x64, 0x3c, 0xd4, 0xff, 0x50, 0x95, 0x7b, 0xdc, 0xa0, 0x34, 0xfc, 0xfa,
+	0x4b, 0x87, 0xf2, 0x64, 0xa6, 0x36, 0x9a, 0xcc, 0x14, 0x44, 0xfe, 0x2a, 0xc9, 0xcc, 0x6b, 0xba,
+	0x1e, 0xf5, 0x08, 0x66, 0x37, 0x28, 0xe5, 0x82, 0xa0, 0x6b, 0x50, 0xc7, 0x94, 0xca, 0xa3, 0x2a,
+	0xdc, 0x04, 0x0a, 0x85, 0xff, 0x57, 0x22, 0x09, 0xd4, 0xee, 0x4d, 0x68, 0x27, 0xa0, 0xa3, 0xd8,
+	0xb6, 0xb3, 0x6c, 0xd7, 0x00, 0x64, 0xb6, 0x7e, 0xcf, 0xdb, 0xf3, 0xb9, 0x31, 0x70, 0x17, 0x52,
+	0x4b, 0xc5, 0xb7, 0x7e, 0x2b, 0xc6, 0x10, 0xb2, 0xbd, 0x0b, 0x0d, 0x9b, 0x11, 0x37, 0x16, 0x6e,
+	0x25, 0x2b, 0x5c, 0x4a, 0xc8, 0x90, 0x48, 0xfa, 0x9f, 0x5b, 0x70, 0x96, 0x9f, 0xd8, 0x63, 0xe1,
+	0x7c, 0x1b, 0x94, 0xde, 0x26, 0x0c, 0xdb, 0x4e, 0xf8, 0xbd, 0x88, 0x04, 0x87, 0x6f, 0xd8, 0x30,
+	0x2c, 0x68, 0x4a, 0xdf, 0xd5, 0xaa, 0x6f, 0xa6, 0x70, 0x6b, 0x86, 0x85, 0x6a, 0xad, 0xf6, 0x66,
+	0xaa, 0xb5, 0xb2, 0xea, 0xa9, 0x7e, 0x42, 0xd5, 0xd3, 0xf8, 0x02, 0x3a, 0x53, 0x96, 0x37, 0xf3,
+	0x65, 0x79, 0x49, 0x51, 0x32, 0x7b, 0xdc, 0xa2, 0xa4, 0x55, 0x5a, 0x94, 0xb8, 0xa5, 0x7e, 0xdc,
+	0x16, 0xea, 0xfe, 0x4e, 0xd6, 0x02, 0xc7, 0xda, 0xda, 0x34, 0xe5, 0x09, 0xbc, 0xd1, 0xf2, 0xe4,
+	0xd3, 0x5c, 0xb9, 0x21, 0x0b, 0xfe, 0xf7, 0x8f, 0xb7, 0xa7, 0x09, 0x85, 0xc7, 0xff, 0x5c, 0xd2,
+	0xfe, 0x4b, 0x91, 0xab, 0x51, 0x3f, 0xd5, 0x41, 0x92, 0x26, 0xf0, 0x1b, 0x8c, 0x5f, 0xd8, 0x2a,
+	0x68, 0xf1, 0x6f, 0x74, 0x15, 0xea, 0x5c, 0xc9, 0x2a, 0x99, 0x3e, 0x93, 0xd5, 0x27, 0x3f, 0x89,
+	0x0d, 0x4a, 0x1f, 0x53, 0x62, 0x1a, 0x02, 0x09, 0xdd, 0x82, 0x76, 0x62, 0xf8, 0xca, 0xb3, 0xce,
+	0x67, 0x57, 0x24, 0x7e, 0x12, 0x2f, 0x4b, 0xd1, 0xf9, 0xda, 0x81, 0x1d, 0x10, 0x93, 0x23, 0x6a,
+	0x8d, 0xd1, 0xb5, 0xb7, 0xe3, 0xc9, 0x64, 0x6d, 0x82, 0x8e, 0xae, 0x41, 0x53, 0x76, 0x48, 0x84,
+	0x07, 0x75, 0xae, 0x9f, 0x1d, 0x0d, 0xa6, 0xf1, 0x2a, 0x85, 0xa8, 0xff, 0xa9, 0x02, 0x6f, 0xa7,
+	0x06, 0x11, 0x7b, 0x53, 0x9c, 0xed, 0x7f, 0xfd, 0x37, 0xee, 0x25, 0x58, 0x10, 0xe5, 0x45, 0xda,
+	0x28, 0x91, 0x3d, 0xbb, 0x02, 0x54, 0xff, 0x7d, 0x05, 0x2e, 0x8e, 0xee, 0x63, 0x73, 0x88, 0x03,
+	0x96, 0x1c, 0xef, 0x49, 0xec, 0x25, 0xbe, 0xf0, 0xaa, 0xe9, 0x85, 0x97, 0xdb, 0x5f, 0x2d, 0xbf,
+	0x3f, 0xfd, 0x8f, 0x55, 0xe8, 0x64, 0x0c, 0xa8, 0xec, 0xc2, 0xe4, 0xd9, 0x93, 0xb0, 0x5b, 0x51,
+	0x50, 0xc6, 0xd9, 0x53, 0x0a, 0x41, 0xfb, 0x00, 0x14, 0x07, 0xd8, 0x25, 0x8c, 0x04, 0x32, 0x81,
+	0xea, 0x5c, 0xbf, 0x3f, 0x7d, 0x74, 0xd9, 0x8d, 0x69, 0x1a, 0x19, 0xf2, 0x3c, 0x0f, 0x16, 0xac,
+	0x43, 0x15, 0xbf, 0xd5, 0x08, 0x7d, 0x01, 0x0b, 0x7b, 0xb6, 0x43, 0x76, 0x53, 0x41, 0x9a, 0x6b,
+	0xb5, 0xe9, 0x6f, 0x49, 0x2e, 0xc8, 0xdd, 0x2c, 0x5d, 0xa3, 0xc0, 0x46, 0xbf, 0x02, 0x4b, 0x45,
+	0x7f, 0xe2, 0x42, 0xda, 0x2e, 0xb6, 0x12, 0x6d, 0xa9, 0x91, 0x8e, 0x60, 0xa9, 0xe8, 0x3f, 0xfa,
+	0x3f, 0xab, 0x70, 0x3a, 0x21, 0xb7, 0xe1, 0x79, 0x7e, 0xe4, 0x99, 0xa2, 0xe9, 0x58, 0x7a, 0x16,
+	0xa7, 0xa0, 0xc1, 0x6c, 0xe6, 0x24, 0x89, 0x8f, 0x18, 0x88, 0xfc, 0xd5, 0xf7, 0x79, 0xdb, 0x47,
+	0x1d, 0x70, 0x3c, 0x94, 0x67, 0xff, 0x3c, 0xb2, 0x03, 0x32, 0x10, 0x91, 0xa0, 0x65, 0x24, 0x63,
+	0x3e, 0xc7, 0xb3, 0x1a, 0x51, 0x1c, 0x48, 0x65, 0x26, 0x63, 0x61, 0xf7, 0xbe, 0xe3, 0x10, 0x93,
+	0xab, 0x23, 0x53, 0x3e, 0x14, 0xa0, 0x7c, 0xa7, 0x21, 0x0b, 0x6c, 0xcf, 0x52, 0xc5, 0x83, 0x1a,
+	0x71, 0x39, 0x71, 0x10, 0xe0, 0x43, 0x55, 0x33, 0xc8, 0x01, 0xfa, 0x10, 0x6a, 0x2e, 0xa6, 0xea,
+	0xa2, 0xbb, 0x92, 0x8b, 0x0e, 0x65, 0x1a, 0xe8, 0xed, 0x60, 0x2a, 0x6f, 0x02, 0xbe, 0xac, 0xfb,
+	0x4d, 0x68, 0xc5, 0x80, 0xaf, 0x94, 0x12, 0x7e, 0x0e, 0xf3, 0xb9, 0xe0, 0x83, 0x9e, 0xc2, 0x4a,
+	0x6a, 0x51, 0x59, 0x86, 0x2a, 0x09, 0x7c, 0xfb, 0x48, 0xc9, 0x8c, 0x31, 0x04, 0xf4, 0xe7, 0xb0,
+	0xcc, 0x4d, 0x46, 0x38, 0xfe, 0x09, 0x15, 0x45, 0x1f, 0x40, 0x3b, 0x61, 0x59, 0x6a, 0x33, 0x5d,
+	0x68, 0x1d, 0xc4, 0xcd, 0x60, 0x59, 0x15, 0x25, 0x63, 0x7d, 0x03, 0x50, 0x56, 0x5e, 0x75, 0x03,
+	0x5d, 0xcd, 0x27, 0xc5, 0xa7, 0x8b, 0xd7, 0x8d, 0x40, 0x8f, 0x73, 0xe2, 0xbf, 0x57, 0x61, 0x71,
+	0xcb, 0x16, 0xdd, 0x95, 0x13, 0x0a, 0x72, 0x57, 0x60, 0x29, 0x8c, 0xfa, 0xae, 0x3f, 0x88, 0x1c,
+	0xa2, 0x92, 0x02, 0x75, 0xd3, 0x8f, 0xc0, 0x27, 0x05, 0x3f, 0xae, 0x2c, 0x8a, 0xd9, 0x50, 0xd5,
+	0xcd, 0xe2, 0x1b, 0x7d, 0x08, 0x67, 0x1f, 0x92, 0x2f, 0xd4, 0x7e, 0xb6, 0x1c, 0xbf, 0xdf, 0xb7,
+	0x3d, 0x2b, 0x66, 0xd2, 0x10, 0x4c, 0xc6, 0x23, 0x94, 0xa5, 0x8a, 0xcd, 0xf2, 0x54, 0x31, 0xa9,
+	0xbd, 0x37, 0x7d, 0xd7, 0xb5, 0x99, 0xca, 0x28, 0x73, 0x30, 0xfd, 0x17, 0x15, 0x58, 0x4a, 0x35,
+	0xab, 0xce, 0xe6, 0xa6, 0xf4, 0x21, 0x79, 0x32, 0x17, 0xb3, 0x27, 0x53, 0x44, 0x7d, 0x75, 0xf7,
+	0x99, 0xcb, 0xba, 0xcf, 0xaf, 0xab, 0x70, 0x7a, 0xcb, 0x66, 0x71, 0xe0, 0xb2, 0xff, 0xdb, 0x4e,
+	0xb9, 0xe4, 0x4c, 0xea, 0xc7, 0x3b, 0x93, 0x46, 0xc9, 0x99, 0xf4, 0x60, 0xa5, 0xa8, 0x0c, 0x75,
+	0x30, 0xa7, 0xa0, 0x41, 0x45, 0xbb, 0x5a, 0x76, 0x24, 0xe4, 0x40, 0xff, 0xf9, 0x2c, 0x5c, 0xf8,
+	0x94, 0x0e, 0x30, 0x4b, 0xba, 0x4d, 0x77, 0xfd, 0x40, 0xf4, 0xab, 0x4f, 0x46, 0x8b, 0x85, 0x37,
+	0xc5, 0xea, 0xc4, 0x37, 0xc5, 0xda, 0x84, 0x37, 0xc5, 0xfa, 0xb1, 0xde, 0x14, 0x1b, 0x27, 0xf6,
+	0xa6, 0x38, 0x5a, 0x6b, 0x35, 0x4b, 0x6b, 0xad, 0xa7, 0xb9, 0x7a, 0x64, 0x56, 0xb8, 0xcd, 0xb7,
+	0xb3, 0x6e, 0x33, 0xf1, 0x74, 0x26, 0x3e, 0x86, 0x14, 0x9e, 0xe2, 0x5a, 0x47, 0x3e, 0xc5, 0xb5,
+	0x47, 0x9f, 0xe2, 0xca, 0x5f, 0x73, 0x60, 0xec, 0x6b, 0xce, 0x25, 0x58, 0x08, 0x0f, 0x3d, 0x93,
+	0x0c, 0x62, 0x81, 0xb5, 0x8e, 0xdc, 0x76, 0x1e, 0x9a, 0xf3, 0x88, 0xb9, 0x82, 0x47, 0x24, 0x96,
+	0x3a, 0x9f, 0xb1, 0xd4, 0x32, 0x3f, 0x59, 0x18, 0x5b, 0xe6, 0x16, 0x1e, 0x5a, 0x16, 0x4b, 0x1f,
+	0x5a, 0xfe, 0x63, 0x8a, 0xad, 0xcf, 0x60, 0x75, 0xdc, 0x29, 0x2b, 0xe7, 0xd5, 0x60, 0xd6, 0x1c,
+	0x62, 0xcf, 0x12, 0x0d, 0x45, 0x51, 0xfd, 0xab, 0xe1, 0xa4, 0xea, 0xe0, 0xfa, 0x97, 0x73, 0xb0,
+	0x9c, 0x66, 0xfd, 0xfc, 0xaf, 0x6d, 0x12, 0xf4, 0x08, 0x96, 0xe2, 0x87, 0xa9, 0xb8, 0x05, 0x8c,
+	0x26, 0xbd, 0xba, 0x74, 0xcf, 0x97, 0x4f, 0x4a, 0xd1, 0xf4, 0x19, 0x64, 0xc2, 0xd9, 0x22, 0xc1,
+	0xf4, 0x81, 0xe7, 0x1b, 0x13, 0x28, 0x27, 0x58, 0x47, 0xb1, 0xb8, 0x5c, 0x41, 0x4f, 0x61, 0x21,
+	0xff, 0x0c, 0x81, 0x72, 0x69, 0x50, 0xe9, 0xcb, 0x48, 0x57, 0x9f, 0x84, 0x92, 0xc8, 0xff, 0x0c,
+	0x16, 0x0b, 0x1d, 0x77, 0xa4, 0xe7, 0x3b, 0x02, 0x65, 0x6f, 0x16, 0xdd, 0xff, 0x9f, 0x88, 0x93,
+	0x50, 0xff, 0x00, 0x5a, 0x71, 0x17, 0x3a, 0xaf, 0xe6, 0x42, 0x6f, 0xba, 0xbb, 0x94, 0xa7, 0xb7,
+	0x17, 0xea, 0x33, 0xe8, 0x23, 0xe8, 0x70, 0xb4, 0x47, 0x9b, 0xf7, 0x9e, 0x60, 0xeb, 0x95, 0xd6,
+	0xb7, 0xe2, 0x5e, 0xeb, 0xe8, 0xe2, 0x4c, 0x07, 0xb6, 0xfb, 0x56, 0x49, 0xd7, 0x53, 0x9f, 0x41,
+	0xdf, 0x95, 0xfc, 0x77, 0xd5, 0x0f, 0x0b, 0x56, 0x7a, 0xf2, 0x77, 0x2c, 0xbd, 0xf8, 0x77, 0x2c,
+	0xbd, 0x3b, 0xfc, 0x77, 0x2c, 0xdd, 0x92, 0xb6, 0xa4, 0x22, 0xf0, 0x0c, 0xe6, 0xb7, 0x08, 0x4b,
+	0xbb, 0x08, 0xe8, 0xe2, 0xb1, 0x7a, 0x2d, 0x5d, 0xbd, 0x88, 0x36, 0xda, 0x88, 0xd0, 0x67, 0xd0,
+	0x97, 0x15, 0x78, 0x6b, 0x8b, 0xb0, 0x62, 0x5d, 0x8e, 0xde, 0x2b, 0x67, 0x32, 0xa6, 0x7e, 0xef,
+	0x3e, 0x9c, 0xd6, 0xa7, 0xf3, 0x64, 0xf5, 0x19, 0xf4, 0x9b, 0x0a, 0x2c, 0x6c, 0x11, 0x7e, 0x6e,
+	0x89, 0x4c, 0xd7, 0x26, 0xcb, 0x54, 0x52, 0x8b, 0x77, 0xa7, 0xec, 0x81, 0x65, 0xb8, 0xeb, 0x33,
+	0xe8, 0xb7, 0x15, 0x38, 0x93, 0xd1, 0x55, 0x96, 0xdf, 0xab, 0xc8, 0xf6, 0xc9, 0x94, 0x3f, 0x61,
+	0xc9, 0x90, 0xd4, 0x67, 0xd0, 0xae, 0x30, 0x93, 0x34, 0xd5, 0x47, 0x17, 0x4a, 0x73, 0xfa, 0x84,
+	0xfb, 0xea, 0xb8, 0xe9, 0xc4, 0x34, 0x3e, 0x81, 0xce, 0x16, 0x61, 0x71, 0xce, 0x99, 0x37, 0xfe,
+	0x42, 0x39, 0xd0, 0x3d, 0x5f, 0x3e, 0x99, 0x09, 0x10, 0xcb, 0x92, 0x56, 0x26, 0xaf, 0xca, 0x87,
+	0x9f, 0xd2, 0x04, 0xb4, 0xab, 0x4f, 0x42, 0x49, 0xa8, 0x3f, 0x87, 0x95, 0xf2, 0xe8, 0x8f, 0xde,
+	0x39, 0x76, 0x1e, 0xd0, 0xbd, 0x72, 0x1c, 0xd4, 0x98, 0xe5, 0xc7, 0x1b, 0x7f, 0x79, 0xb9, 0x5a,
+	0xf9, 0xeb, 0xcb, 0xd5, 0xca, 0xbf, 0x5e, 0xae, 0x56, 0x7e, 0x70, 0xe3, 0x88, 0x9f, 0xba, 0x65,
+	0x7e, 0x3d, 0x87, 0xa9, 0x6d, 0x3a, 0x36, 0xf1, 0x58, 0xbf, 0x29, 0x42, 0xc0, 0x8d, 0x7f, 0x0f,
+	0x00, 0xf7, 0x06, 0x9b, 0xc5, 0x5c, 0x27, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -4124,6 +4141,24 @@ func (m *Refs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.TagSHAs) > 0 {
+		for iNdEx := len(m.TagSHAs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TagSHAs[iNdEx])
+			copy(dAtA[i:], m.TagSHAs[iNdEx])
+			i = encodeVarintRepository(dAtA, i, uint64(len(m.TagSHAs[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.BranchSHAs) > 0 {
+		for iNdEx := len(m.BranchSHAs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BranchSHAs[iNdEx])
+			copy(dAtA[i:], m.BranchSHAs[iNdEx])
+			i = encodeVarintRepository(dAtA, i, uint64(len(m.BranchSHAs[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Tags) > 0 {
 		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Tags[iNdEx])
@@ -5956,6 +5991,18 @@ func (m *Refs) Size() (n int) {
 			n += 1 + l + sovRepository(uint64(l))
 		}
 	}
+	if len(m.BranchSHAs) > 0 {
+		for _, s := range m.BranchSHAs {
+			l = len(s)
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if len(m.TagSHAs) > 0 {
+		for _, s := range m.TagSHAs {
+			l = len(s)
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -8820,6 +8867,70 @@ func (m *Refs) Unmarshal(dAtA []byte) error {
 			}
 			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchSHAs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BranchSHAs = append(m.BranchSHAs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TagSHAs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TagSHAs = append(m.TagSHAs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -16,6 +16,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 
+	"github.com/argoproj/argo-cd/v3/common"
 	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
 	cacheutil "github.com/argoproj/argo-cd/v3/util/cache"
@@ -517,6 +518,66 @@ func (c *Cache) GetGitDirectories(repoURL, revision string) ([]string, error) {
 	return item, err
 }
 
+// escapeKeyPattern escapes the characters which have a special meaning in Redis key patterns
+func escapeKeyPattern(s string) string {
+	var sb strings.Builder
+	for _, r := range s {
+		switch r {
+		case '*', '?', '[', ']', '\\':
+			sb.WriteRune('\\')
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+// fullKeyPattern turns a key pattern into a pattern matching the full key stored by the cache client, which includes
+// the cache version and optionally a compression suffix
+func fullKeyPattern(pattern string) string {
+	return fmt.Sprintf("%s|%s*", pattern, common.CacheVersion)
+}
+
+// RepoKeyPatterns returns Redis key patterns matching the entries cached for the given repository. Manifest entries
+// don't include the repository URL, so they are matched using the instance names of the applications sourcing the
+// repository. An empty revision matches the entries of all revisions, including the resolved references, Helm index
+// and OCI tags of the repository.
+func RepoKeyPatterns(repoURL, revision string, appNames []string) []string {
+	repo := escapeKeyPattern(repoURL)
+	rev := "*"
+	if revision != "" {
+		rev = escapeKeyPattern(revision)
+	}
+	patterns := []string{
+		gitRefsKey(repo),
+		listApps(repo, rev),
+		revisionMetadataKey(repo, rev),
+		revisionChartDetailsKey(repo, "*", rev),
+		gitFilesKey(repo, rev, "*"),
+		gitDirectoriesKey(repo, rev),
+	}
+	if revision == "" {
+		patterns = append(patterns, helmIndexRefsKey(repo), ociTagsKey(repo))
+	} else {
+		patterns = append(patterns, fmt.Sprintf("appdetails|%s|*", rev))
+	}
+	for _, appName := range appNames {
+		patterns = append(patterns, fmt.Sprintf("mfst|*|%s|%s|*", escapeKeyPattern(appName), rev))
+	}
+	for i := range patterns {
+		patterns[i] = fullKeyPattern(patterns[i])
+	}
+	return patterns
+}
+
+// AllKeyPatterns returns Redis key patterns matching all entries cached by the repo-server
+func AllKeyPatterns() []string {
+	var patterns []string
+	for _, prefix := range []string{"mfst", "appdetails", "ldir", "revisionmetadata", "chartdetails", "gitfiles", "gitdirs", "git-refs", "helm-index", "oci-tags"} {
+		patterns = append(patterns, fullKeyPattern(prefix+"|*"))
+	}
+	return patterns
+}
+
 func (cmr *CachedManifestResponse) shallowCopy() *CachedManifestResponse {
 	if cmr == nil {
 		return nil
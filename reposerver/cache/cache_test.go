@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/v3/reposerver/cache/mocks"
@@ -750,3 +751,14 @@ func TestGetGitFiles(t *testing.T) {
 		fixtures.mockCache.AssertCacheCalledTimes(t, &mocks.CacheCallCounts{ExternalGets: 1, ExternalSets: 1})
 	})
 }
+
+func TestRepoKeyPatterns(t *testing.T) {
+	patterns := RepoKeyPatterns("https://example.com/repo[1]", "abc", []string{"guestbook"})
+	assert.Contains(t, patterns, "ldir|https://example.com/repo\\[1\\]|abc|"+common.CacheVersion+"*")
+	assert.Contains(t, patterns, "mfst|*|guestbook|abc|*|"+common.CacheVersion+"*")
+	assert.NotContains(t, patterns, "helm-index|https://example.com/repo\\[1\\]|"+common.CacheVersion+"*")
+
+	patterns = RepoKeyPatterns("https://example.com/repo", "", nil)
+	assert.Contains(t, patterns, "gitdirs|https://example.com/repo|*|"+common.CacheVersion+"*")
+	assert.Contains(t, patterns, "helm-index|https://example.com/repo|"+common.CacheVersion+"*")
+}
@@ -224,8 +224,10 @@ func (s *Service) ListRefs(_ context.Context, q *apiclient.ListRefsRequest) (*ap
 	}
 
 	res := apiclient.Refs{
-		Branches: refs.Branches,
-		Tags:     refs.Tags,
+		Branches:   refs.Branches,
+		Tags:       refs.Tags,
+		BranchSHAs: refs.BranchSHAs,
+		TagSHAs:    refs.TagSHAs,
 	}
 
 	return &res, nil
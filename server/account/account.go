@@ -2,22 +2,33 @@ package account
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"regexp"
+	"slices"
 	"sort"
+	"strings"
 	"time"
 
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/kubectl/pkg/util/slice"
 
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/account"
 	"github.com/argoproj/argo-cd/v3/server/rbacpolicy"
+	"github.com/argoproj/argo-cd/v3/util/argo"
 	"github.com/argoproj/argo-cd/v3/util/password"
+	"github.com/argoproj/argo-cd/v3/util/rand"
 	"github.com/argoproj/argo-cd/v3/util/rbac"
 	"github.com/argoproj/argo-cd/v3/util/session"
 	"github.com/argoproj/argo-cd/v3/util/settings"
@@ -25,14 +36,18 @@ import (
 
 // Server provides a Session service
 type Server struct {
-	sessionMgr  *session.SessionManager
-	settingsMgr *settings.SettingsManager
-	enf         *rbac.Enforcer
+	sessionMgr    *session.SessionManager
+	settingsMgr   *settings.SettingsManager
+	enf           *rbac.Enforcer
+	policyEnf     *rbacpolicy.RBACPolicyEnforcer
+	auditLogger   *argo.AuditLogger
+	kubeclientset kubernetes.Interface
 }
 
 // NewServer returns a new instance of the Session service
-func NewServer(sessionMgr *session.SessionManager, settingsMgr *settings.SettingsManager, enf *rbac.Enforcer) *Server {
-	return &Server{sessionMgr, settingsMgr, enf}
+func NewServer(sessionMgr *session.SessionManager, settingsMgr *settings.SettingsManager, enf *rbac.Enforcer, policyEnf *rbacpolicy.RBACPolicyEnforcer, kubeclientset kubernetes.Interface, enableK8sEvent []string) *Server {
+	auditLogger := argo.NewAuditLogger(kubeclientset, "argocd-server", enableK8sEvent)
+	return &Server{sessionMgr, settingsMgr, enf, policyEnf, auditLogger, kubeclientset}
 }
 
 // UpdatePassword updates the password of the currently authenticated account or the account specified in the request.
@@ -94,6 +109,14 @@ func (s *Server) UpdatePassword(ctx context.Context, q *account.UpdatePasswordRe
 		return nil, err
 	}
 
+	passwordPolicy, err := s.settingsMgr.GetPasswordPolicy()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get password policy: %w", err)
+	}
+	if err := passwordPolicy.Validate(updatedUsername, q.NewPassword); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	hashedPassword, err := password.HashPassword(q.NewPassword)
 	if err != nil {
 		return nil, fmt.Errorf("failed to hash password: %w", err)
@@ -114,11 +137,15 @@ func (s *Server) UpdatePassword(ctx context.Context, q *account.UpdatePasswordRe
 	} else {
 		log.Infof("user '%s' updated password of user '%s'", username, updatedUsername)
 	}
+	s.logAccountEvent(ctx, updatedUsername, argo.EventReasonResourceUpdated, "updated password of account "+updatedUsername)
 	return &account.UpdatePasswordResponse{}, nil
 }
 
 // CanI checks if the current account has permission to perform an action
 func (s *Server) CanI(ctx context.Context, r *account.CanIRequest) (*account.CanIResponse, error) {
+	if r.IsListAll() {
+		return s.listPermissions(ctx)
+	}
 	if !slice.ContainsString(rbac.Actions, r.Action, nil) {
 		return nil, status.Errorf(codes.InvalidArgument, "%v does not contain %s", rbac.Actions, r.Action)
 	}
@@ -133,31 +160,191 @@ func (s *Server) CanI(ctx context.Context, r *account.CanIRequest) (*account.Can
 	return &account.CanIResponse{Value: "no"}, nil
 }
 
-func toAPIAccount(name string, a settings.Account) *account.Account {
+// CanIAs checks if the given subject and groups have permission to perform an action. The check is evaluated the same
+// way as for an authenticated user with these claims, and is only allowed to callers permitted to impersonate the subject.
+func (s *Server) CanIAs(ctx context.Context, r *account.CanIAsRequest) (*account.CanIResponse, error) {
+	if r.Subject == "" && len(r.Groups) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "subject or groups must be specified")
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceAccounts, rbac.ActionImpersonate, r.Subject); err != nil {
+		return nil, fmt.Errorf("permission denied to impersonate %s: %w", r.Subject, err)
+	}
+	if !slice.ContainsString(rbac.Actions, r.Action, nil) {
+		return nil, status.Errorf(codes.InvalidArgument, "%v does not contain %s", rbac.Actions, r.Action)
+	}
+	if !slice.ContainsString(rbac.Resources, r.Resource, nil) {
+		return nil, status.Errorf(codes.InvalidArgument, "%v does not contain %s", rbac.Resources, r.Resource)
+	}
+
+	scopes := rbac.DefaultScopes
+	if s.policyEnf != nil {
+		scopes = s.policyEnf.GetScopes()
+	}
+	claims := jwt.MapClaims{"sub": r.Subject}
+	if len(scopes) > 0 && len(r.Groups) > 0 {
+		claims[scopes[0]] = r.Groups
+	}
+	if s.enf.Enforce(claims, r.Resource, r.Action, r.Subresource) {
+		return &account.CanIResponse{Value: "yes"}, nil
+	}
+	return &account.CanIResponse{Value: "no"}, nil
+}
+
+// listPermissions returns the JSON encoded policy rules which apply to the caller, its groups or the default role
+func (s *Server) listPermissions(ctx context.Context) (*account.CanIResponse, error) {
+	subject := session.GetUserIdentifier(ctx)
+	if subject == "" {
+		return nil, status.Error(codes.Unauthenticated, "no session information")
+	}
+	if rbacpolicy.IsProjectSubject(subject) {
+		return nil, status.Errorf(codes.InvalidArgument, "listing permissions is not supported for project role %q", subject)
+	}
+	scopes := rbac.DefaultScopes
+	if s.policyEnf != nil {
+		scopes = s.policyEnf.GetScopes()
+	}
+	rules, err := s.enf.GetImplicitPermissions(append([]string{subject}, session.Groups(ctx, scopes)...)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get permissions: %w", err)
+	}
+	permissions := toPermissions(rules)
+	value, err := json.Marshal(permissions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal permissions: %w", err)
+	}
+	return &account.CanIResponse{Value: string(value)}, nil
+}
+
+// ListPermissions returns the policy rules which apply to a local account, either directly, through its roles or the
+// default role, or through the project roles which list the account in their groups
+func (s *Server) ListPermissions(ctx context.Context, r *account.ListAccountPermissionsRequest) (*account.AccountPermissionList, error) {
+	if err := s.ensureHasAccountPermission(ctx, rbac.ActionGet, r.Name); err != nil {
+		return nil, fmt.Errorf("permission denied to get account %s: %w", r.Name, err)
+	}
+	if _, err := s.settingsMgr.GetAccount(r.Name); err != nil {
+		return nil, fmt.Errorf("failed to get account %s: %w", r.Name, err)
+	}
+
+	rules, err := s.enf.GetImplicitPermissions(r.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get permissions of account %s: %w", r.Name, err)
+	}
+	if s.policyEnf != nil {
+		projectRules, err := s.policyEnf.GetProjectRolePermissions(r.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get project role permissions of account %s: %w", r.Name, err)
+		}
+		rules = append(rules, projectRules...)
+	}
+
+	permissions := toPermissions(rules)
+	items := make([]*account.AccountPermission, 0, len(permissions))
+	for _, p := range permissions {
+		items = append(items, &account.AccountPermission{Subject: p.Subject, Resource: p.Resource, Action: p.Action, Object: p.Object, Effect: p.Effect})
+	}
+	return &account.AccountPermissionList{Items: items}, nil
+}
+
+// toPermissions converts the policy rules to permissions without duplicates, sorted by resource and action
+func toPermissions(rules [][]string) []account.Permission {
+	seen := make(map[string]bool)
+	permissions := make([]account.Permission, 0, len(rules))
+	for _, rule := range rules {
+		key := strings.Join(rule, ",")
+		if len(rule) < 5 || seen[key] {
+			continue
+		}
+		seen[key] = true
+		permissions = append(permissions, account.Permission{Subject: rule[0], Resource: rule[1], Action: rule[2], Object: rule[3], Effect: rule[4]})
+	}
+	sort.SliceStable(permissions, func(i, j int) bool {
+		if permissions[i].Resource != permissions[j].Resource {
+			return permissions[i].Resource < permissions[j].Resource
+		}
+		return permissions[i].Action < permissions[j].Action
+	})
+	return permissions
+}
+
+func (s *Server) toAPIAccount(ctx context.Context, name string, a settings.Account) *account.Account {
+	return s.toAPIAccounts(ctx, map[string]settings.Account{name: a})[0]
+}
+
+// toAPIAccounts converts the accounts sorted by name, reading the last usage of all their tokens at once
+func (s *Server) toAPIAccounts(ctx context.Context, accounts map[string]settings.Account) []*account.Account {
+	var refs []session.TokenRef
+	for name, a := range accounts {
+		for _, t := range a.Tokens {
+			refs = append(refs, session.TokenRef{Account: name, ID: t.ID})
+		}
+	}
+	lastUsed, err := s.sessionMgr.GetTokensLastUsed(ctx, refs)
+	if err != nil {
+		log.Warnf("Failed to get last usage of account tokens: %v", err)
+	}
+	items := make([]*account.Account, 0, len(accounts))
+	for name, a := range accounts {
+		items = append(items, s.newAPIAccount(name, a, lastUsed))
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Name < items[j].Name
+	})
+	return items
+}
+
+func (s *Server) newAPIAccount(name string, a settings.Account, lastUsed map[session.TokenRef]time.Time) *account.Account {
 	var capabilities []string
 	for _, c := range a.Capabilities {
 		capabilities = append(capabilities, string(c))
 	}
 	var tokens []*account.Token
+	var nonExpiringTokenCount, nextTokenExpiresAt int64
+	now := time.Now().Unix()
 	for _, t := range a.Tokens {
-		tokens = append(tokens, &account.Token{Id: t.ID, ExpiresAt: t.ExpiresAt, IssuedAt: t.IssuedAt})
+		if t.ExpiresAt == 0 {
+			nonExpiringTokenCount++
+		} else if t.ExpiresAt > now && (nextTokenExpiresAt == 0 || t.ExpiresAt < nextTokenExpiresAt) {
+			nextTokenExpiresAt = t.ExpiresAt
+		}
+		token := &account.Token{Id: t.ID, ExpiresAt: t.ExpiresAt, IssuedAt: t.IssuedAt}
+		if t.Scope != nil {
+			token.Scope = &account.TokenScope{Projects: t.Scope.Projects, Actions: t.Scope.Actions, Resources: t.Scope.Resources}
+		}
+		if usedAt, ok := lastUsed[session.TokenRef{Account: name, ID: t.ID}]; ok {
+			token.LastUsedAt = usedAt.Unix()
+		}
+		tokens = append(tokens, token)
 	}
 	sort.Slice(tokens, func(i, j int) bool {
 		return tokens[i].IssuedAt > tokens[j].IssuedAt
 	})
-	return &account.Account{
-		Name:         name,
-		Enabled:      a.Enabled,
-		Capabilities: capabilities,
-		Tokens:       tokens,
+	apiAccount := &account.Account{
+		Name:                  name,
+		Enabled:               a.Enabled,
+		Capabilities:          capabilities,
+		Tokens:                tokens,
+		TokenCount:            int64(len(tokens)),
+		NonExpiringTokenCount: nonExpiringTokenCount,
+		NextTokenExpiresAt:    nextTokenExpiresAt,
+		Projects:              a.Projects,
 	}
+	if a.LockedAt != nil {
+		lockoutPolicy, err := s.settingsMgr.GetAccountLockoutPolicy()
+		if err != nil {
+			log.Warnf("Failed to get account lockout policy: %v", err)
+			lockoutPolicy = &settings.AccountLockoutPolicy{}
+		}
+		apiAccount.Locked = a.IsLocked(lockoutPolicy)
+		apiAccount.LockedAt = a.LockedAt.Unix()
+	}
+	return apiAccount
 }
 
 func (s *Server) ensureHasAccountPermission(ctx context.Context, action string, account string) error {
 	id := session.GetUserIdentifier(ctx)
 
-	// account has always has access to itself
-	if id == account && session.Iss(ctx) == session.SessionManagerClaimsIssuer {
+	// account has always has access to itself, unless the token is restricted by a scope
+	if id == account && session.Iss(ctx) == session.SessionManagerClaimsIssuer && !session.IsScoped(ctx) {
 		return nil
 	}
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceAccounts, action, account); err != nil {
@@ -168,20 +355,17 @@ func (s *Server) ensureHasAccountPermission(ctx context.Context, action string,
 
 // ListAccounts returns the list of accounts
 func (s *Server) ListAccounts(ctx context.Context, _ *account.ListAccountRequest) (*account.AccountsList, error) {
-	resp := account.AccountsList{}
 	accounts, err := s.settingsMgr.GetAccounts()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get accounts: %w", err)
 	}
+	permitted := make(map[string]settings.Account)
 	for name, a := range accounts {
 		if err := s.ensureHasAccountPermission(ctx, rbac.ActionGet, name); err == nil {
-			resp.Items = append(resp.Items, toAPIAccount(name, a))
+			permitted[name] = a
 		}
 	}
-	sort.Slice(resp.Items, func(i, j int) bool {
-		return resp.Items[i].Name < resp.Items[j].Name
-	})
-	return &resp, nil
+	return &account.AccountsList{Items: s.toAPIAccounts(ctx, permitted)}, nil
 }
 
 // GetAccount returns an account
@@ -193,15 +377,26 @@ func (s *Server) GetAccount(ctx context.Context, r *account.GetAccountRequest) (
 	if err != nil {
 		return nil, fmt.Errorf("failed to get account %s: %w", r.Name, err)
 	}
-	return toAPIAccount(r.Name, *a), nil
+	return s.toAPIAccount(ctx, r.Name, *a), nil
 }
 
+// clientSecretLength is the length of the generated client secrets of client-credentials tokens
+const clientSecretLength = 40
+
 // CreateToken creates a token
 func (s *Server) CreateToken(ctx context.Context, r *account.CreateTokenRequest) (*account.CreateTokenResponse, error) {
 	if err := s.ensureHasAccountPermission(ctx, rbac.ActionUpdate, r.Name); err != nil {
 		return nil, fmt.Errorf("permission denied to create token for account %s: %w", r.Name, err)
 	}
 
+	scope, err := validateTokenScope(r.Scope)
+	if err != nil {
+		return nil, err
+	}
+	if r.Type != "" && r.Type != settings.TokenTypeClientCredentials {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported token type '%s'", r.Type)
+	}
+
 	id := r.Id
 	if id == "" {
 		uniqueId, err := uuid.NewRandom()
@@ -211,8 +406,18 @@ func (s *Server) CreateToken(ctx context.Context, r *account.CreateTokenRequest)
 		id = uniqueId.String()
 	}
 
-	var tokenString string
-	err := s.settingsMgr.UpdateAccount(r.Name, func(account *settings.Account) error {
+	var tokenString, clientSecret, secretHash string
+	if r.Type == settings.TokenTypeClientCredentials {
+		clientSecret, err = rand.String(clientSecretLength)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate client secret: %w", err)
+		}
+		secretHash, err = password.HashPassword(clientSecret)
+		if err != nil {
+			return nil, fmt.Errorf("failed to hash client secret: %w", err)
+		}
+	}
+	err = s.settingsMgr.UpdateAccount(r.Name, func(account *settings.Account) error {
 		if account.TokenIndex(id) > -1 {
 			return fmt.Errorf("account already has token with id '%s'", id)
 		}
@@ -221,10 +426,12 @@ func (s *Server) CreateToken(ctx context.Context, r *account.CreateTokenRequest)
 		}
 
 		now := time.Now()
-		var err error
-		tokenString, err = s.sessionMgr.Create(fmt.Sprintf("%s:%s", r.Name, settings.AccountCapabilityApiKey), r.ExpiresIn, id)
-		if err != nil {
-			return err
+		if r.Type != settings.TokenTypeClientCredentials {
+			var err error
+			tokenString, err = s.sessionMgr.CreateScoped(fmt.Sprintf("%s:%s", r.Name, settings.AccountCapabilityApiKey), r.ExpiresIn, id, scope)
+			if err != nil {
+				return err
+			}
 		}
 
 		var expiresAt int64
@@ -232,18 +439,48 @@ func (s *Server) CreateToken(ctx context.Context, r *account.CreateTokenRequest)
 			expiresAt = now.Add(time.Duration(r.ExpiresIn) * time.Second).Unix()
 		}
 		account.Tokens = append(account.Tokens, settings.Token{
-			ID:        id,
-			IssuedAt:  now.Unix(),
-			ExpiresAt: expiresAt,
+			ID:         id,
+			IssuedAt:   now.Unix(),
+			ExpiresAt:  expiresAt,
+			Scope:      scope,
+			Type:       r.Type,
+			SecretHash: secretHash,
 		})
 		return nil
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to update account with new token: %w", err)
 	}
+	s.logAccountEvent(ctx, r.Name, argo.EventReasonResourceCreated, fmt.Sprintf("created token %s of account %s", id, r.Name))
+	if r.Type == settings.TokenTypeClientCredentials {
+		return &account.CreateTokenResponse{ClientId: session.ClientCredentialsID(r.Name, id), ClientSecret: clientSecret}, nil
+	}
 	return &account.CreateTokenResponse{Token: tokenString}, nil
 }
 
+// validateTokenScope validates the requested token scope and converts it to its settings representation
+func validateTokenScope(scope *account.TokenScope) (*settings.TokenScope, error) {
+	if scope == nil || (len(scope.Projects) == 0 && len(scope.Actions) == 0 && len(scope.Resources) == 0) {
+		return nil, nil
+	}
+	for _, action := range scope.Actions {
+		if !slice.ContainsString(rbac.Actions, action, nil) {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown action %q in token scope", action)
+		}
+	}
+	for _, resource := range scope.Resources {
+		if !slice.ContainsString(rbac.Resources, resource, nil) {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown resource %q in token scope", resource)
+		}
+	}
+	for _, project := range scope.Projects {
+		if project == "" {
+			return nil, status.Error(codes.InvalidArgument, "empty project in token scope")
+		}
+	}
+	return &settings.TokenScope{Projects: scope.Projects, Actions: scope.Actions, Resources: scope.Resources}, nil
+}
+
 // DeleteToken deletes a token
 func (s *Server) DeleteToken(ctx context.Context, r *account.DeleteTokenRequest) (*account.EmptyResponse, error) {
 	if err := s.ensureHasAccountPermission(ctx, rbac.ActionUpdate, r.Name); err != nil {
@@ -260,5 +497,151 @@ func (s *Server) DeleteToken(ctx context.Context, r *account.DeleteTokenRequest)
 	if err != nil {
 		return nil, fmt.Errorf("failed to delete account %s: %w", r.Name, err)
 	}
+	s.logAccountEvent(ctx, r.Name, argo.EventReasonResourceDeleted, fmt.Sprintf("deleted token %s of account %s", r.Id, r.Name))
+	return &account.EmptyResponse{}, nil
+}
+
+// CreateAccount creates a local account
+func (s *Server) CreateAccount(ctx context.Context, r *account.CreateAccountRequest) (*account.Account, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceAccounts, rbac.ActionCreate, r.Name); err != nil {
+		return nil, fmt.Errorf("permission denied to create account %s: %w", r.Name, err)
+	}
+	if !settings.IsValidAccountName(r.Name) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid account name '%s': must consist of alphanumeric characters, '-' or '_', and start and end with an alphanumeric character", r.Name)
+	}
+
+	a := settings.Account{Enabled: true}
+	for _, c := range r.Capabilities {
+		capability := settings.AccountCapability(c)
+		switch capability {
+		case settings.AccountCapabilityLogin, settings.AccountCapabilityApiKey:
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported account capability '%s': must be one of %s, %s", c, settings.AccountCapabilityLogin, settings.AccountCapabilityApiKey)
+		}
+		if !a.HasCapability(capability) {
+			a.Capabilities = append(a.Capabilities, capability)
+		}
+	}
+	for _, project := range r.Projects {
+		if errs := validation.IsDNS1123Subdomain(project); len(errs) > 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid project name '%s': %s", project, strings.Join(errs, ", "))
+		}
+		if !slices.Contains(a.Projects, project) {
+			a.Projects = append(a.Projects, project)
+		}
+	}
+
+	if err := s.settingsMgr.AddAccount(r.Name, a); err != nil {
+		return nil, fmt.Errorf("failed to create account %s: %w", r.Name, err)
+	}
+	log.Infof("user '%s' created account '%s'", session.GetUserIdentifier(ctx), r.Name)
+	return s.toAPIAccount(ctx, r.Name, a), nil
+}
+
+// DeleteAccount deletes a local account
+func (s *Server) DeleteAccount(ctx context.Context, r *account.DeleteAccountRequest) (*account.EmptyResponse, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceAccounts, rbac.ActionDelete, r.Name); err != nil {
+		return nil, fmt.Errorf("permission denied to delete account %s: %w", r.Name, err)
+	}
+	if err := s.settingsMgr.DeleteAccount(r.Name); err != nil {
+		return nil, fmt.Errorf("failed to delete account %s: %w", r.Name, err)
+	}
+	log.Infof("user '%s' deleted account '%s'", session.GetUserIdentifier(ctx), r.Name)
 	return &account.EmptyResponse{}, nil
 }
+
+// SetAccountEnabled enables or disables a local account. Sessions and tokens of a disabled account are rejected
+// from then on.
+func (s *Server) SetAccountEnabled(ctx context.Context, r *account.SetAccountEnabledRequest) (*account.Account, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceAccounts, rbac.ActionUpdate, r.Name); err != nil {
+		return nil, fmt.Errorf("permission denied to update account %s: %w", r.Name, err)
+	}
+	if !r.Enabled && r.Name == session.GetUserIdentifier(ctx) && session.Iss(ctx) == session.SessionManagerClaimsIssuer {
+		return nil, status.Error(codes.InvalidArgument, "cannot disable the current account")
+	}
+
+	var updated settings.Account
+	err := s.settingsMgr.UpdateAccount(r.Name, func(acc *settings.Account) error {
+		acc.Enabled = r.Enabled
+		updated = *acc
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update account %s: %w", r.Name, err)
+	}
+	if !r.Enabled {
+		// sessions of the account must not come back when it is enabled again
+		if err := s.sessionMgr.RevokeSessions(r.Name); err != nil {
+			return nil, fmt.Errorf("failed to revoke sessions of account %s: %w", r.Name, err)
+		}
+	}
+
+	action := "disabled"
+	if r.Enabled {
+		action = "enabled"
+	}
+	s.logAccountEvent(ctx, r.Name, argo.EventReasonResourceUpdated, fmt.Sprintf("%s account %s", action, r.Name))
+	return s.toAPIAccount(ctx, r.Name, updated), nil
+}
+
+// UnlockAccount unlocks a local account locked due to too many failed logins
+func (s *Server) UnlockAccount(ctx context.Context, r *account.UnlockAccountRequest) (*account.Account, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceAccounts, rbac.ActionUpdate, r.Name); err != nil {
+		return nil, fmt.Errorf("permission denied to update account %s: %w", r.Name, err)
+	}
+
+	var updated settings.Account
+	err := s.settingsMgr.UpdateAccount(r.Name, func(acc *settings.Account) error {
+		acc.LockedAt = nil
+		updated = *acc
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to unlock account %s: %w", r.Name, err)
+	}
+	s.sessionMgr.ResetLoginFailures(r.Name)
+
+	s.logAccountEvent(ctx, r.Name, argo.EventReasonResourceUpdated, "unlocked account "+r.Name)
+	return s.toAPIAccount(ctx, r.Name, updated), nil
+}
+
+// logAccountEvent records an audit event about the given action of the current user on a local account
+func (s *Server) logAccountEvent(ctx context.Context, name string, reason string, action string) {
+	user := session.Username(ctx)
+	if user == "" {
+		user = "Unknown user"
+	}
+	eventInfo := argo.EventInfo{Type: corev1.EventTypeNormal, Reason: reason}
+	s.auditLogger.LogAccountEvent(name, s.settingsMgr.GetNamespace(), eventInfo, fmt.Sprintf("%s %s", user, action), user)
+}
+
+// ListEvents returns the audit events recorded about a local account, oldest first
+func (s *Server) ListEvents(ctx context.Context, r *account.ListAccountEventsRequest) (*account.AccountEventList, error) {
+	if err := s.ensureHasAccountPermission(ctx, rbac.ActionGet, r.Name); err != nil {
+		return nil, fmt.Errorf("permission denied to get account %s: %w", r.Name, err)
+	}
+	if _, err := s.settingsMgr.GetAccount(r.Name); err != nil {
+		return nil, fmt.Errorf("failed to get account %s: %w", r.Name, err)
+	}
+
+	selector := labels.SelectorFromSet(map[string]string{common.LabelKeyAccount: r.Name}).String()
+	events, err := s.kubeclientset.CoreV1().Events(s.settingsMgr.GetNamespace()).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list events of account %s: %w", r.Name, err)
+	}
+
+	items := make([]*account.AccountEvent, 0, len(events.Items))
+	for _, e := range events.Items {
+		items = append(items, &account.AccountEvent{
+			Time:    e.LastTimestamp.Unix(),
+			Type:    e.Type,
+			Reason:  e.Reason,
+			Message: e.Message,
+			User:    e.Annotations["user"],
+		})
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].Time < items[j].Time
+	})
+	return &account.AccountEventList{Items: items}, nil
+}
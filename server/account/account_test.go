@@ -2,6 +2,8 @@ package account
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"testing"
 	"time"
 
@@ -17,8 +19,12 @@ import (
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/account"
 	sessionpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/session"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/server/rbacpolicy"
 	"github.com/argoproj/argo-cd/v3/server/session"
 	"github.com/argoproj/argo-cd/v3/test"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	jwtutil "github.com/argoproj/argo-cd/v3/util/jwt"
 	"github.com/argoproj/argo-cd/v3/util/password"
 	"github.com/argoproj/argo-cd/v3/util/rbac"
 	sessionutil "github.com/argoproj/argo-cd/v3/util/session"
@@ -70,7 +76,7 @@ func newTestAccountServerExt(t *testing.T, ctx context.Context, enforceFn rbac.C
 	enforcer := rbac.NewEnforcer(kubeclientset, testNamespace, common.ArgoCDRBACConfigMapName, nil)
 	enforcer.SetClaimsEnforcerFunc(enforceFn)
 
-	return NewServer(sessionMgr, settingsMgr, enforcer), session.NewServer(sessionMgr, settingsMgr, nil, nil, nil)
+	return NewServer(sessionMgr, settingsMgr, enforcer, nil, kubeclientset, []string{"all"}), session.NewServer(sessionMgr, settingsMgr, nil, nil, nil, argo.NewAuditLogger(kubeclientset, "argocd-server", []string{"all"}))
 }
 
 func getAdminAccount(mgr *settings.SettingsManager) (*settings.Account, error) {
@@ -140,6 +146,48 @@ func TestUpdatePassword(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestUpdatePassword_PasswordPolicy(t *testing.T) {
+	accountServer, sessionServer := newTestAccountServer(t, t.Context(), func(cm *corev1.ConfigMap, _ *corev1.Secret) {
+		cm.Data["passwordPolicy.minLength"] = "12"
+		cm.Data["passwordPolicy.characterClasses"] = "uppercase, digit"
+		cm.Data["passwordPolicy.bannedPasswords"] = "Password1234\nLetmein12345"
+	})
+	ctx := adminContext(t.Context())
+
+	_, err := accountServer.UpdatePassword(ctx, &account.UpdatePasswordRequest{CurrentPassword: "oldpassword", NewPassword: "newpassword"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, "password does not satisfy the password policy: must be at least 12 characters long; must contain at least one uppercase character; must contain at least one digit character", status.Convert(err).Message())
+
+	_, err = accountServer.UpdatePassword(ctx, &account.UpdatePasswordRequest{CurrentPassword: "oldpassword", NewPassword: "password1234"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "must not be a commonly used password")
+
+	_, err = accountServer.UpdatePassword(ctx, &account.UpdatePasswordRequest{CurrentPassword: "oldpassword", NewPassword: "NewPassword1234"})
+	require.NoError(t, err)
+	_, err = sessionServer.Create(ctx, &sessionpkg.SessionCreateRequest{Username: "admin", Password: "NewPassword1234"})
+	require.NoError(t, err)
+}
+
+func TestUpdatePassword_PasswordExpired(t *testing.T) {
+	accountServer, sessionServer := newTestAccountServer(t, t.Context(), func(cm *corev1.ConfigMap, secret *corev1.Secret) {
+		cm.Data["accounts.anotherUser"] = "login"
+		cm.Data["passwordPolicy.maxAge"] = "30d"
+		secret.Data["accounts.anotherUser.password"] = secret.Data["admin.password"]
+		secret.Data["accounts.anotherUser.passwordMtime"] = []byte(time.Now().Add(-31 * 24 * time.Hour).Format(time.RFC3339))
+	})
+	ctx := adminContext(t.Context())
+
+	_, err := sessionServer.Create(ctx, &sessionpkg.SessionCreateRequest{Username: "anotherUser", Password: "oldpassword"})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	assert.ErrorContains(t, err, "password of account anotherUser has expired")
+
+	// the expired password can still be updated, e.g. by an admin
+	_, err = accountServer.UpdatePassword(ctx, &account.UpdatePasswordRequest{CurrentPassword: "oldpassword", NewPassword: "newpassword", Name: "anotherUser"})
+	require.NoError(t, err)
+	_, err = sessionServer.Create(ctx, &sessionpkg.SessionCreateRequest{Username: "anotherUser", Password: "newpassword"})
+	require.NoError(t, err)
+}
+
 func TestUpdatePassword_AdminUpdatesAnotherUser(t *testing.T) {
 	accountServer, sessionServer := newTestAccountServer(t, t.Context(), func(cm *corev1.ConfigMap, _ *corev1.Secret) {
 		cm.Data["accounts.anotherUser"] = "login"
@@ -234,6 +282,30 @@ func TestListAccounts_AccountsAreConfigured(t *testing.T) {
 	}, resp.Items)
 }
 
+func TestListAccounts_TokenSummary(t *testing.T) {
+	ctx := adminContext(t.Context())
+	soon := time.Now().Add(time.Hour).Unix()
+	later := time.Now().Add(24 * time.Hour).Unix()
+	accountServer, _ := newTestAccountServer(t, ctx, func(cm *corev1.ConfigMap, secret *corev1.Secret) {
+		cm.Data["accounts.account1"] = "apiKey"
+		secret.Data["accounts.account1.tokens"] = []byte(fmt.Sprintf(`[{"id":"1","iat":1583789194},{"id":"2","iat":1583789194},{"id":"3","iat":1583789194,"exp":1583789194},{"id":"4","iat":1583789194,"exp":%d},{"id":"5","iat":1583789194,"exp":%d}]`, later, soon))
+	})
+
+	resp, err := accountServer.ListAccounts(ctx, &account.ListAccountRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Items, 2)
+	acc := resp.Items[0]
+	assert.Equal(t, "account1", acc.Name)
+	assert.Equal(t, int64(5), acc.TokenCount)
+	assert.Equal(t, int64(2), acc.NonExpiringTokenCount)
+	assert.Equal(t, soon, acc.NextTokenExpiresAt)
+
+	admin := resp.Items[1]
+	assert.Zero(t, admin.TokenCount)
+	assert.Zero(t, admin.NonExpiringTokenCount)
+	assert.Zero(t, admin.NextTokenExpiresAt)
+}
+
 func TestGetAccount(t *testing.T) {
 	ctx := adminContext(t.Context())
 	accountServer, _ := newTestAccountServer(t, ctx, func(cm *corev1.ConfigMap, _ *corev1.Secret) {
@@ -269,6 +341,76 @@ func TestCreateToken_SuccessfullyCreated(t *testing.T) {
 	assert.Len(t, acc.Tokens, 1)
 }
 
+func TestCreateToken_Scoped(t *testing.T) {
+	ctx := adminContext(t.Context())
+	accountServer, _ := newTestAccountServer(t, ctx, func(cm *corev1.ConfigMap, _ *corev1.Secret) {
+		cm.Data["accounts.account1"] = "apiKey"
+	})
+
+	scope := &account.TokenScope{Projects: []string{"foo"}, Actions: []string{"get", "sync"}, Resources: []string{"applications"}}
+	resp, err := accountServer.CreateToken(ctx, &account.CreateTokenRequest{Name: "account1", Scope: scope})
+	require.NoError(t, err)
+
+	claims, _, err := accountServer.sessionMgr.Parse(resp.Token)
+	require.NoError(t, err)
+	mapClaims, err := jwtutil.MapClaims(claims)
+	require.NoError(t, err)
+	tokenScope, err := rbacpolicy.GetTokenScope(mapClaims)
+	require.NoError(t, err)
+	assert.Equal(t, &settings.TokenScope{Projects: []string{"foo"}, Actions: []string{"get", "sync"}, Resources: []string{"applications"}}, tokenScope)
+
+	acc, err := accountServer.GetAccount(ctx, &account.GetAccountRequest{Name: "account1"})
+	require.NoError(t, err)
+	require.Len(t, acc.Tokens, 1)
+	assert.Equal(t, scope, acc.Tokens[0].Scope)
+
+	_, err = accountServer.CreateToken(ctx, &account.CreateTokenRequest{Name: "account1", Scope: &account.TokenScope{Actions: []string{"unknown"}}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = accountServer.CreateToken(ctx, &account.CreateTokenRequest{Name: "account1", Scope: &account.TokenScope{Resources: []string{"unknown"}}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetAccount_TokenLastUsed(t *testing.T) {
+	ctx := adminContext(t.Context())
+	accountServer, _ := newTestAccountServer(t, ctx, func(cm *corev1.ConfigMap, _ *corev1.Secret) {
+		cm.Data["accounts.account1"] = "apiKey"
+		cm.Data["accounts.account2"] = "apiKey"
+	})
+
+	resp, err := accountServer.CreateToken(ctx, &account.CreateTokenRequest{Name: "account1", Id: "used"})
+	require.NoError(t, err)
+	_, err = accountServer.CreateToken(ctx, &account.CreateTokenRequest{Name: "account1", Id: "unused"})
+	require.NoError(t, err)
+	// the same explicit id in another account is tracked separately
+	_, err = accountServer.CreateToken(ctx, &account.CreateTokenRequest{Name: "account2", Id: "used"})
+	require.NoError(t, err)
+
+	start := time.Now().Unix()
+	_, _, err = accountServer.sessionMgr.Parse(resp.Token)
+	require.NoError(t, err)
+
+	acc, err := accountServer.GetAccount(ctx, &account.GetAccountRequest{Name: "account1"})
+	require.NoError(t, err)
+	lastUsed := map[string]int64{}
+	for _, token := range acc.Tokens {
+		lastUsed[token.Id] = token.LastUsedAt
+	}
+	assert.GreaterOrEqual(t, lastUsed["used"], start)
+	assert.Zero(t, lastUsed["unused"])
+
+	list, err := accountServer.ListAccounts(ctx, &account.ListAccountRequest{})
+	require.NoError(t, err)
+	lastUsed = map[string]int64{}
+	for _, acc := range list.Items {
+		for _, token := range acc.Tokens {
+			lastUsed[acc.Name+"/"+token.Id] = token.LastUsedAt
+		}
+	}
+	assert.GreaterOrEqual(t, lastUsed["account1/used"], start)
+	assert.Zero(t, lastUsed["account1/unused"])
+	assert.Zero(t, lastUsed["account2/used"])
+}
+
 func TestCreateToken_DoesNotHaveCapability(t *testing.T) {
 	ctx := adminContext(t.Context())
 	accountServer, _ := newTestAccountServer(t, ctx, func(cm *corev1.ConfigMap, _ *corev1.Secret) {
@@ -309,6 +451,313 @@ func TestDeleteToken_SuccessfullyRemoved(t *testing.T) {
 	assert.Empty(t, acc.Tokens)
 }
 
+func TestCreateAccount(t *testing.T) {
+	ctx := adminContext(t.Context())
+	accountServer, _ := newTestAccountServer(t, ctx, func(cm *corev1.ConfigMap, _ *corev1.Secret) {
+		cm.Data["accounts.account1"] = "apiKey"
+	})
+
+	t.Run("SuccessfullyCreated", func(t *testing.T) {
+		acc, err := accountServer.CreateAccount(ctx, &account.CreateAccountRequest{Name: "account2", Capabilities: []string{"login", "apiKey", "login"}})
+		require.NoError(t, err)
+		assert.Equal(t, &account.Account{Name: "account2", Enabled: true, Capabilities: []string{"login", "apiKey"}}, acc)
+
+		acc, err = accountServer.GetAccount(ctx, &account.GetAccountRequest{Name: "account2"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"login", "apiKey"}, acc.Capabilities)
+	})
+
+	t.Run("AlreadyExists", func(t *testing.T) {
+		_, err := accountServer.CreateAccount(ctx, &account.CreateAccountRequest{Name: "account1", Capabilities: []string{"login"}})
+		require.Error(t, err)
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	})
+
+	t.Run("InvalidName", func(t *testing.T) {
+		_, err := accountServer.CreateAccount(ctx, &account.CreateAccountRequest{Name: "bad.name"})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("InvalidCapability", func(t *testing.T) {
+		_, err := accountServer.CreateAccount(ctx, &account.CreateAccountRequest{Name: "account3", Capabilities: []string{"sudo"}})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("BoundToProjects", func(t *testing.T) {
+		acc, err := accountServer.CreateAccount(ctx, &account.CreateAccountRequest{Name: "ci", Capabilities: []string{"apiKey"}, Projects: []string{"foo", "bar", "foo"}})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"foo", "bar"}, acc.Projects)
+
+		acc, err = accountServer.GetAccount(ctx, &account.GetAccountRequest{Name: "ci"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"foo", "bar"}, acc.Projects)
+
+		_, err = accountServer.CreateAccount(ctx, &account.CreateAccountRequest{Name: "account3", Projects: []string{"foo,bar"}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("DoesNotHavePermissions", func(t *testing.T) {
+		accountServer, _ := newTestAccountServerExt(t, ctx, func(_ jwt.Claims, _ ...any) bool {
+			return false
+		})
+		_, err := accountServer.CreateAccount(ctx, &account.CreateAccountRequest{Name: "account3", Capabilities: []string{"login"}})
+		assert.ErrorContains(t, err, "permission denied")
+	})
+}
+
+func TestDeleteAccount(t *testing.T) {
+	ctx := adminContext(t.Context())
+	accountServer, _ := newTestAccountServer(t, ctx, func(cm *corev1.ConfigMap, secret *corev1.Secret) {
+		cm.Data["accounts.account1"] = "apiKey"
+		secret.Data["accounts.account1.tokens"] = []byte(`[{"id":"123","iat":1583789194,"exp":1583789194}]`)
+	})
+
+	t.Run("SuccessfullyDeleted", func(t *testing.T) {
+		_, err := accountServer.DeleteAccount(ctx, &account.DeleteAccountRequest{Name: "account1"})
+		require.NoError(t, err)
+
+		_, err = accountServer.GetAccount(ctx, &account.GetAccountRequest{Name: "account1"})
+		require.Error(t, err)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("NonExistingAccount", func(t *testing.T) {
+		_, err := accountServer.DeleteAccount(ctx, &account.DeleteAccountRequest{Name: "bad-name"})
+		require.Error(t, err)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("Admin", func(t *testing.T) {
+		_, err := accountServer.DeleteAccount(ctx, &account.DeleteAccountRequest{Name: "admin"})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("DoesNotHavePermissions", func(t *testing.T) {
+		accountServer, _ := newTestAccountServerExt(t, ctx, func(_ jwt.Claims, _ ...any) bool {
+			return false
+		}, func(cm *corev1.ConfigMap, _ *corev1.Secret) {
+			cm.Data["accounts.account1"] = "apiKey"
+		})
+		_, err := accountServer.DeleteAccount(ctx, &account.DeleteAccountRequest{Name: "account1"})
+		assert.ErrorContains(t, err, "permission denied")
+	})
+}
+
+func TestSetAccountEnabled(t *testing.T) {
+	ctx := adminContext(t.Context())
+	accountServer, _ := newTestAccountServer(t, ctx, func(cm *corev1.ConfigMap, _ *corev1.Secret) {
+		cm.Data["accounts.account1"] = "apiKey, login"
+	})
+
+	resp, err := accountServer.CreateToken(ctx, &account.CreateTokenRequest{Name: "account1"})
+	require.NoError(t, err)
+	sessionToken, err := accountServer.sessionMgr.Create("account1:login", 0, "session")
+	require.NoError(t, err)
+
+	t.Run("Disable", func(t *testing.T) {
+		acc, err := accountServer.SetAccountEnabled(ctx, &account.SetAccountEnabledRequest{Name: "account1", Enabled: false})
+		require.NoError(t, err)
+		assert.False(t, acc.Enabled)
+
+		_, _, err = accountServer.sessionMgr.Parse(resp.Token)
+		assert.ErrorContains(t, err, "account account1 is disabled")
+	})
+
+	t.Run("Enable", func(t *testing.T) {
+		acc, err := accountServer.SetAccountEnabled(ctx, &account.SetAccountEnabledRequest{Name: "account1", Enabled: true})
+		require.NoError(t, err)
+		assert.True(t, acc.Enabled)
+
+		_, _, err = accountServer.sessionMgr.Parse(resp.Token)
+		require.NoError(t, err)
+		// sessions issued before the account was disabled stay revoked
+		_, _, err = accountServer.sessionMgr.Parse(sessionToken)
+		assert.ErrorContains(t, err, "token is revoked")
+	})
+
+	t.Run("CurrentAccount", func(t *testing.T) {
+		_, err := accountServer.SetAccountEnabled(ctx, &account.SetAccountEnabledRequest{Name: "admin", Enabled: false})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("NonExistingAccount", func(t *testing.T) {
+		_, err := accountServer.SetAccountEnabled(ctx, &account.SetAccountEnabledRequest{Name: "bad-name", Enabled: false})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("DoesNotHavePermissions", func(t *testing.T) {
+		accountServer, _ := newTestAccountServerExt(t, ctx, func(_ jwt.Claims, _ ...any) bool {
+			return false
+		}, func(cm *corev1.ConfigMap, _ *corev1.Secret) {
+			cm.Data["accounts.account1"] = "apiKey"
+		})
+		_, err := accountServer.SetAccountEnabled(ctx, &account.SetAccountEnabledRequest{Name: "account1", Enabled: false})
+		assert.ErrorContains(t, err, "permission denied")
+	})
+}
+
+func TestUnlockAccount(t *testing.T) {
+	ctx := adminContext(t.Context())
+	accountServer, _ := newTestAccountServer(t, ctx, func(cm *corev1.ConfigMap, secret *corev1.Secret) {
+		cm.Data["accounts.account1"] = "login"
+		secret.Data["accounts.account1.lockedAt"] = []byte(time.Now().Format(time.RFC3339))
+	})
+
+	acc, err := accountServer.GetAccount(ctx, &account.GetAccountRequest{Name: "account1"})
+	require.NoError(t, err)
+	assert.True(t, acc.Locked)
+	assert.NotZero(t, acc.LockedAt)
+
+	acc, err = accountServer.UnlockAccount(ctx, &account.UnlockAccountRequest{Name: "account1"})
+	require.NoError(t, err)
+	assert.False(t, acc.Locked)
+	assert.Zero(t, acc.LockedAt)
+
+	_, err = accountServer.UnlockAccount(ctx, &account.UnlockAccountRequest{Name: "bad-name"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	t.Run("DoesNotHavePermissions", func(t *testing.T) {
+		accountServer, _ := newTestAccountServerExt(t, ctx, func(_ jwt.Claims, _ ...any) bool {
+			return false
+		}, func(cm *corev1.ConfigMap, _ *corev1.Secret) {
+			cm.Data["accounts.account1"] = "login"
+		})
+		_, err := accountServer.UnlockAccount(ctx, &account.UnlockAccountRequest{Name: "account1"})
+		assert.ErrorContains(t, err, "permission denied")
+	})
+}
+
+func TestListEvents(t *testing.T) {
+	ctx := adminContext(t.Context())
+	accountServer, sessionServer := newTestAccountServer(t, ctx, func(cm *corev1.ConfigMap, _ *corev1.Secret) {
+		cm.Data["accounts.account1"] = "apiKey"
+	})
+
+	_, err := accountServer.CreateToken(ctx, &account.CreateTokenRequest{Name: "account1", Id: "ci"})
+	require.NoError(t, err)
+	_, err = accountServer.DeleteToken(ctx, &account.DeleteTokenRequest{Name: "account1", Id: "ci"})
+	require.NoError(t, err)
+	_, err = sessionServer.Create(ctx, &sessionpkg.SessionCreateRequest{Username: "admin", Password: "wrongpassword"})
+	require.Error(t, err)
+	_, err = sessionServer.Create(ctx, &sessionpkg.SessionCreateRequest{Username: "admin", Password: "oldpassword"})
+	require.NoError(t, err)
+	_, err = sessionServer.Create(ctx, &sessionpkg.SessionCreateRequest{Username: "unknown", Password: "oldpassword"})
+	require.Error(t, err)
+
+	events, err := accountServer.ListEvents(ctx, &account.ListAccountEventsRequest{Name: "account1"})
+	require.NoError(t, err)
+	require.Len(t, events.Items, 2)
+	var messages []string
+	for _, e := range events.Items {
+		assert.Equal(t, "admin", e.User)
+		assert.NotZero(t, e.Time)
+		messages = append(messages, e.Message)
+	}
+	assert.ElementsMatch(t, []string{"admin created token ci of account account1", "admin deleted token ci of account account1"}, messages)
+
+	events, err = accountServer.ListEvents(ctx, &account.ListAccountEventsRequest{Name: "admin"})
+	require.NoError(t, err)
+	var reasons []string
+	for _, e := range events.Items {
+		reasons = append(reasons, e.Reason)
+	}
+	assert.ElementsMatch(t, []string{argo.EventReasonLoginFailed, argo.EventReasonLoginSucceeded}, reasons)
+
+	_, err = accountServer.ListEvents(ctx, &account.ListAccountEventsRequest{Name: "bad-name"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	t.Run("DoesNotHavePermissions", func(t *testing.T) {
+		accountServer, _ := newTestAccountServerExt(t, ctx, func(_ jwt.Claims, _ ...any) bool {
+			return false
+		}, func(cm *corev1.ConfigMap, _ *corev1.Secret) {
+			cm.Data["accounts.account1"] = "login"
+		})
+		_, err := accountServer.ListEvents(ctx, &account.ListAccountEventsRequest{Name: "account1"})
+		assert.ErrorContains(t, err, "permission denied")
+	})
+}
+
+func TestListPermissions(t *testing.T) {
+	ctx := adminContext(t.Context())
+	accountServer, _ := newTestAccountServer(t, ctx, func(cm *corev1.ConfigMap, _ *corev1.Secret) {
+		cm.Data["accounts.bot"] = "apiKey"
+	})
+	proj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: test.FakeArgoCDNamespace},
+		Spec: v1alpha1.AppProjectSpec{Roles: []v1alpha1.ProjectRole{{
+			Name:     "deployer",
+			Policies: []string{"p, proj:demo:deployer, applications, sync, demo/*, allow"},
+			Groups:   []string{"bot"},
+		}, {
+			Name:     "viewer",
+			Policies: []string{"p, proj:demo:viewer, applications, get, demo/*, allow"},
+			Groups:   []string{"team-a"},
+		}}},
+	}
+	accountServer.policyEnf = rbacpolicy.NewRBACPolicyEnforcer(accountServer.enf, test.NewFakeProjLister(proj))
+	require.NoError(t, accountServer.enf.SetUserPolicy(`p, role:ci, applications, get, */*, allow
+p, role:ci, applications, delete, */*, deny
+p, bot, logs, get, */*, allow
+p, role:dev, clusters, get, *, allow
+g, bot, role:ci`))
+
+	permissions, err := accountServer.ListPermissions(ctx, &account.ListAccountPermissionsRequest{Name: "bot"})
+	require.NoError(t, err)
+	assert.Equal(t, []*account.AccountPermission{
+		{Subject: "role:ci", Resource: "applications", Action: "delete", Object: "*/*", Effect: "deny"},
+		{Subject: "role:ci", Resource: "applications", Action: "get", Object: "*/*", Effect: "allow"},
+		{Subject: "proj:demo:deployer", Resource: "applications", Action: "sync", Object: "demo/*", Effect: "allow"},
+		{Subject: "bot", Resource: "logs", Action: "get", Object: "*/*", Effect: "allow"},
+		{Subject: "proj:demo:deployer", Resource: "projects", Action: "get", Object: "demo", Effect: "allow"},
+	}, permissions.Items)
+
+	_, err = accountServer.ListPermissions(ctx, &account.ListAccountPermissionsRequest{Name: "bad-name"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	t.Run("DoesNotHavePermissions", func(t *testing.T) {
+		accountServer, _ := newTestAccountServerExt(t, ctx, func(_ jwt.Claims, _ ...any) bool {
+			return false
+		}, func(cm *corev1.ConfigMap, _ *corev1.Secret) {
+			cm.Data["accounts.bot"] = "apiKey"
+		})
+		_, err := accountServer.ListPermissions(ctx, &account.ListAccountPermissionsRequest{Name: "bot"})
+		assert.ErrorContains(t, err, "permission denied")
+	})
+}
+
+func TestRevokeAllSessions(t *testing.T) {
+	ctx := adminContext(t.Context())
+	accountServer, sessionServer := newTestAccountServer(t, ctx)
+
+	resp, err := sessionServer.Create(ctx, &sessionpkg.SessionCreateRequest{Username: "admin", Password: "oldpassword"})
+	require.NoError(t, err)
+	_, _, err = accountServer.sessionMgr.Parse(resp.Token)
+	require.NoError(t, err)
+
+	_, err = sessionServer.RevokeAll(ctx, &sessionpkg.SessionRevokeAllRequest{})
+	require.NoError(t, err)
+	_, _, err = accountServer.sessionMgr.Parse(resp.Token)
+	require.ErrorContains(t, err, "token is revoked")
+
+	events, err := accountServer.ListEvents(ctx, &account.ListAccountEventsRequest{Name: "admin"})
+	require.NoError(t, err)
+	var messages []string
+	for _, e := range events.Items {
+		messages = append(messages, e.Message)
+	}
+	assert.Contains(t, messages, "admin revoked all sessions")
+
+	_, err = sessionServer.RevokeAll(ssoAdminContext(t.Context(), time.Now()), &sessionpkg.SessionRevokeAllRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = sessionServer.RevokeAll(projTokenContext(t.Context()), &sessionpkg.SessionRevokeAllRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = sessionServer.RevokeAll(t.Context(), &sessionpkg.SessionRevokeAllRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
 func TestCanI_GetLogsAllow(t *testing.T) {
 	accountServer, _ := newTestAccountServer(t, t.Context(), func(_ *corev1.ConfigMap, _ *corev1.Secret) {
 	})
@@ -332,3 +781,75 @@ func TestCanI_GetLogsDeny(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "no", resp.Value)
 }
+
+func TestCanI_ListPermissions(t *testing.T) {
+	accountServer, _ := newTestAccountServer(t, t.Context())
+	require.NoError(t, accountServer.enf.SetUserPolicy(`p, role:dev, applications, sync, dev/*, allow
+p, role:dev, applications, delete, */*, deny
+p, role:reader, clusters, get, *, allow
+p, alice, logs, get, */*, allow
+g, admin, role:dev
+g, team-a, role:reader`))
+
+	//nolint:staticcheck
+	ctx := context.WithValue(t.Context(), "claims", jwt.MapClaims{"sub": "admin", "iss": sessionutil.SessionManagerClaimsIssuer, "groups": []any{"team-a"}})
+	resp, err := accountServer.CanI(ctx, &account.CanIRequest{Resource: account.CanIListAll, Action: account.CanIListAll, Subresource: account.CanIListAll})
+	require.NoError(t, err)
+
+	var permissions []account.Permission
+	require.NoError(t, json.Unmarshal([]byte(resp.Value), &permissions))
+	assert.Equal(t, []account.Permission{
+		{Subject: "role:dev", Resource: "applications", Action: "delete", Object: "*/*", Effect: "deny"},
+		{Subject: "role:dev", Resource: "applications", Action: "sync", Object: "dev/*", Effect: "allow"},
+		{Subject: "role:reader", Resource: "clusters", Action: "get", Object: "*", Effect: "allow"},
+	}, permissions)
+}
+
+func TestCanIAs(t *testing.T) {
+	accountServer, _ := newTestAccountServer(t, t.Context())
+	accountServer.enf.SetClaimsEnforcerFunc(rbacpolicy.NewRBACPolicyEnforcer(accountServer.enf, test.NewFakeProjLister()).EnforceClaims)
+	require.NoError(t, accountServer.enf.SetUserPolicy(`p, role:dev, applications, sync, dev/*, allow
+p, role:impersonator, accounts, impersonate, *, allow
+p, alice, logs, get, */*, allow
+g, team-a, role:dev
+g, admin, role:impersonator`))
+
+	//nolint:staticcheck
+	ctx := context.WithValue(t.Context(), "claims", jwt.MapClaims{"sub": "admin", "iss": sessionutil.SessionManagerClaimsIssuer})
+
+	canIAs := func(t *testing.T, ctx context.Context, r *account.CanIAsRequest) string {
+		t.Helper()
+		resp, err := accountServer.CanIAs(ctx, r)
+		require.NoError(t, err)
+		return resp.Value
+	}
+
+	t.Run("Subject", func(t *testing.T) {
+		assert.Equal(t, "yes", canIAs(t, ctx, &account.CanIAsRequest{Resource: "logs", Action: "get", Subresource: "dev/app", Subject: "alice"}))
+		assert.Equal(t, "no", canIAs(t, ctx, &account.CanIAsRequest{Resource: "applications", Action: "sync", Subresource: "dev/app", Subject: "alice"}))
+	})
+
+	t.Run("Groups", func(t *testing.T) {
+		assert.Equal(t, "yes", canIAs(t, ctx, &account.CanIAsRequest{Resource: "applications", Action: "sync", Subresource: "dev/app", Subject: "alice", Groups: []string{"team-a"}}))
+		assert.Equal(t, "no", canIAs(t, ctx, &account.CanIAsRequest{Resource: "applications", Action: "sync", Subresource: "prod/app", Groups: []string{"team-a"}}))
+	})
+
+	t.Run("NoSubject", func(t *testing.T) {
+		_, err := accountServer.CanIAs(ctx, &account.CanIAsRequest{Resource: "logs", Action: "get", Subresource: "*"})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("DoesNotHavePermissions", func(t *testing.T) {
+		//nolint:staticcheck
+		ctx := context.WithValue(t.Context(), "claims", jwt.MapClaims{"sub": "alice", "iss": sessionutil.SessionManagerClaimsIssuer})
+		_, err := accountServer.CanIAs(ctx, &account.CanIAsRequest{Resource: "logs", Action: "get", Subresource: "*", Subject: "admin"})
+		assert.ErrorContains(t, err, "permission denied")
+	})
+}
+
+func TestCanI_ListPermissionsProjectToken(t *testing.T) {
+	accountServer, _ := newTestAccountServer(t, t.Context())
+	_, err := accountServer.CanI(projTokenContext(t.Context()), &account.CanIRequest{Resource: account.CanIListAll, Action: account.CanIListAll, Subresource: account.CanIListAll})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,95 @@
+package account
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/argoproj/argo-cd/v3/util/session"
+)
+
+// tokenResponse is the successful response of the token endpoint as defined by RFC 6749, section 5.1
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// tokenErrorResponse is the error response of the token endpoint as defined by RFC 6749, section 5.2
+type tokenErrorResponse struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+// NewTokenHandler returns the OAuth 2.0 token endpoint which exchanges the client credentials of local accounts for
+// short-lived API tokens using the client credentials grant. Clients authenticate either with HTTP basic
+// authentication or with the client_id and client_secret form parameters.
+func NewTokenHandler(sessionMgr *session.SessionManager) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			writeTokenError(w, http.StatusMethodNotAllowed, "invalid_request", "token requests must use POST")
+			return
+		}
+		if err := r.ParseForm(); err != nil {
+			writeTokenError(w, http.StatusBadRequest, "invalid_request", "failed to parse the request body")
+			return
+		}
+		if grantType := r.PostForm.Get("grant_type"); grantType != "client_credentials" {
+			writeTokenError(w, http.StatusBadRequest, "unsupported_grant_type", "only the client_credentials grant type is supported")
+			return
+		}
+		clientID, clientSecret, basicAuth := r.BasicAuth()
+		if basicAuth {
+			// RFC 6749, section 2.3.1 requires the credentials to be form encoded before basic authentication
+			var err error
+			if clientID, err = url.QueryUnescape(clientID); err == nil {
+				clientSecret, err = url.QueryUnescape(clientSecret)
+			}
+			if err != nil {
+				writeTokenError(w, http.StatusBadRequest, "invalid_request", "invalid client credentials encoding")
+				return
+			}
+		} else {
+			clientID, clientSecret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+		}
+		if clientID == "" || clientSecret == "" {
+			writeTokenError(w, http.StatusUnauthorized, "invalid_client", "client credentials are missing")
+			return
+		}
+
+		token, expiresIn, err := sessionMgr.ExchangeClientCredentials(clientID, clientSecret)
+		if err != nil {
+			switch status.Code(err) {
+			case codes.Unauthenticated, codes.InvalidArgument:
+				if basicAuth {
+					w.Header().Set("WWW-Authenticate", `Basic realm="argocd"`)
+				}
+				writeTokenError(w, http.StatusUnauthorized, "invalid_client", status.Convert(err).Message())
+			default:
+				log.Warnf("Failed to exchange client credentials of %s: %v", clientID, err)
+				writeTokenError(w, http.StatusInternalServerError, "server_error", "")
+			}
+			return
+		}
+		writeTokenResponse(w, http.StatusOK, tokenResponse{AccessToken: token, TokenType: "Bearer", ExpiresIn: expiresIn})
+	}
+}
+
+func writeTokenError(w http.ResponseWriter, code int, errorCode string, description string) {
+	writeTokenResponse(w, code, tokenErrorResponse{Error: errorCode, ErrorDescription: description})
+}
+
+func writeTokenResponse(w http.ResponseWriter, code int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Pragma", "no-cache")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		log.Warnf("Failed to write token response: %v", err)
+	}
+}
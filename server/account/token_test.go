@@ -0,0 +1,103 @@
+package account
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/account"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+func requestToken(t *testing.T, h http.Handler, form url.Values, clientID, clientSecret string) (*httptest.ResponseRecorder, map[string]any) {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, common.TokenEndpoint, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if clientID != "" {
+		req.SetBasicAuth(url.QueryEscape(clientID), url.QueryEscape(clientSecret))
+	}
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, req)
+	res := map[string]any{}
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &res))
+	return rr, res
+}
+
+func TestCreateToken_ClientCredentials(t *testing.T) {
+	ctx := adminContext(t.Context())
+	accountServer, _ := newTestAccountServer(t, ctx, func(cm *corev1.ConfigMap, _ *corev1.Secret) {
+		cm.Data["accounts.ci"] = "apiKey"
+	})
+
+	scope := &account.TokenScope{Projects: []string{"foo"}}
+	resp, err := accountServer.CreateToken(ctx, &account.CreateTokenRequest{Name: "ci", Id: "pipeline", Type: settings.TokenTypeClientCredentials, Scope: scope})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Token)
+	assert.Equal(t, "pipeline@ci", resp.ClientId)
+	assert.Len(t, resp.ClientSecret, clientSecretLength)
+
+	acc, err := accountServer.settingsMgr.GetAccount("ci")
+	require.NoError(t, err)
+	require.Len(t, acc.Tokens, 1)
+	assert.Equal(t, settings.TokenTypeClientCredentials, acc.Tokens[0].Type)
+	assert.NotEmpty(t, acc.Tokens[0].SecretHash)
+	assert.NotContains(t, acc.Tokens[0].SecretHash, resp.ClientSecret)
+
+	_, err = accountServer.CreateToken(ctx, &account.CreateTokenRequest{Name: "ci", Type: "unknown"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestTokenHandler(t *testing.T) {
+	ctx := adminContext(t.Context())
+	accountServer, _ := newTestAccountServer(t, ctx, func(cm *corev1.ConfigMap, _ *corev1.Secret) {
+		cm.Data["accounts.ci"] = "apiKey"
+	})
+	resp, err := accountServer.CreateToken(ctx, &account.CreateTokenRequest{Name: "ci", Type: settings.TokenTypeClientCredentials})
+	require.NoError(t, err)
+	h := NewTokenHandler(accountServer.sessionMgr)
+	grant := url.Values{"grant_type": {"client_credentials"}}
+
+	rr, res := requestToken(t, h, grant, resp.ClientId, resp.ClientSecret)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	assert.Equal(t, "no-store", rr.Header().Get("Cache-Control"))
+	assert.Equal(t, "Bearer", res["token_type"])
+	assert.InDelta(t, 3600, res["expires_in"], 0)
+	accessToken := res["access_token"].(string)
+	claims, _, err := accountServer.sessionMgr.Parse(accessToken)
+	require.NoError(t, err)
+	sub, err := claims.GetSubject()
+	require.NoError(t, err)
+	assert.Equal(t, "ci", sub)
+
+	rr, _ = requestToken(t, h, url.Values{"grant_type": {"client_credentials"}, "client_id": {resp.ClientId}, "client_secret": {resp.ClientSecret}}, "", "")
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	rr, res = requestToken(t, h, grant, resp.ClientId, "wrong")
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	assert.Equal(t, "invalid_client", res["error"])
+	rr, res = requestToken(t, h, grant, "unknown@ci", resp.ClientSecret)
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	assert.Equal(t, "invalid_client", res["error"])
+	rr, res = requestToken(t, h, url.Values{"grant_type": {"password"}}, resp.ClientId, resp.ClientSecret)
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.Equal(t, "unsupported_grant_type", res["error"])
+
+	// deleting the client credentials revokes the tokens issued for them
+	id := strings.TrimSuffix(resp.ClientId, "@ci")
+	_, err = accountServer.DeleteToken(ctx, &account.DeleteTokenRequest{Name: "ci", Id: id})
+	require.NoError(t, err)
+	_, _, err = accountServer.sessionMgr.Parse(accessToken)
+	require.Error(t, err)
+	rr, _ = requestToken(t, h, grant, resp.ClientId, resp.ClientSecret)
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+}
@@ -2,6 +2,7 @@ package application
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -294,6 +295,13 @@ func (s *Server) List(ctx context.Context, q *application.ApplicationQuery) (*v1
 	// Filter applications by source repo URL
 	filteredApps = argo.FilterByRepoP(filteredApps, q.GetRepo())
 
+	// Filter applications by destination cluster
+	filteredApps = argo.FilterByClusterP(filteredApps, q.GetCluster())
+
+	// Filter applications by health and sync status
+	filteredApps = argo.FilterByHealthP(filteredApps, q.GetHealth())
+	filteredApps = argo.FilterBySyncStatusP(filteredApps, q.GetSync())
+
 	newItems := make([]v1alpha1.Application, 0)
 	for _, a := range filteredApps {
 		// Skip any application that is neither in the control plane's namespace
@@ -306,9 +314,13 @@ func (s *Server) List(ctx context.Context, q *application.ApplicationQuery) (*v1
 		}
 	}
 
-	// Sort found applications by name
+	// Sort found applications by name, and by namespace for applications with the same name, so that the pages of
+	// a paginated list are stable
 	sort.Slice(newItems, func(i, j int) bool {
-		return newItems[i].Name < newItems[j].Name
+		if newItems[i].Name != newItems[j].Name {
+			return newItems[i].Name < newItems[j].Name
+		}
+		return newItems[i].Namespace < newItems[j].Namespace
 	})
 
 	appList := v1alpha1.ApplicationList{
@@ -317,9 +329,50 @@ func (s *Server) List(ctx context.Context, q *application.ApplicationQuery) (*v1
 		},
 		Items: newItems,
 	}
+	if q.GetLimit() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid limit %d: must not be negative", q.GetLimit())
+	}
+	if q.GetLimit() > 0 || q.GetContinue() != "" {
+		if err := paginateApplications(&appList, q.GetLimit(), q.GetContinue()); err != nil {
+			return nil, err
+		}
+	}
 	return &appList, nil
 }
 
+// paginateApplications restricts the sorted items of the list to the page starting after the application referenced
+// by the continue token, with at most limit items. If there are more items after the page, the continue token of the
+// next page is set in the list metadata. The token is opaque for clients and refers to the last application of a page
+// by namespace and name, so that pages stay consistent when applications are added or deleted in between.
+func paginateApplications(appList *v1alpha1.ApplicationList, limit int64, continueToken string) error {
+	items := appList.Items
+	if continueToken != "" {
+		decoded, err := base64.RawURLEncoding.DecodeString(continueToken)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, "invalid continue token")
+		}
+		namespace, name, found := strings.Cut(string(decoded), "/")
+		if !found || name == "" {
+			return status.Error(codes.InvalidArgument, "invalid continue token")
+		}
+		start := sort.Search(len(items), func(i int) bool {
+			if items[i].Name != name {
+				return items[i].Name > name
+			}
+			return items[i].Namespace > namespace
+		})
+		items = items[start:]
+	}
+	if limit > 0 && int64(len(items)) > limit {
+		last := items[limit-1]
+		appList.Continue = base64.RawURLEncoding.EncodeToString([]byte(last.Namespace + "/" + last.Name))
+		appList.RemainingItemCount = ptr.To(int64(len(items)) - limit)
+		items = items[:limit]
+	}
+	appList.Items = items
+	return nil
+}
+
 // Create creates an application
 func (s *Server) Create(ctx context.Context, q *application.ApplicationCreateRequest) (*v1alpha1.Application, error) {
 	if q.GetApplication() == nil {
@@ -399,7 +452,7 @@ func (s *Server) Create(ctx context.Context, q *application.ApplicationCreateReq
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionUpdate, a.RBACName(s.ns)); err != nil {
 		return nil, err
 	}
-	updated, err := s.updateApp(ctx, existing, a, true)
+	updated, err := s.updateApp(ctx, existing, a, true, "")
 	if err != nil {
 		return nil, fmt.Errorf("error updating application: %w", err)
 	}
@@ -467,6 +520,33 @@ func (s *Server) queryRepoServer(ctx context.Context, proj *v1alpha1.AppProject,
 	return action(client, permittedHelmRepos, permittedHelmCredentials, permittedOCIRepos, permittedOCICredentials, helmOptions, enabledSourceTypes)
 }
 
+// getHistorySources returns the sources of the application history entry with the given ID, pinned to the revisions
+// which were deployed, and whether the entry was deployed from multiple sources
+func getHistorySources(a *v1alpha1.Application, id int64) (v1alpha1.ApplicationSources, bool, error) {
+	for _, info := range a.Status.History {
+		if info.ID != id {
+			continue
+		}
+		if !info.Sources.IsZero() {
+			sources := make(v1alpha1.ApplicationSources, len(info.Sources))
+			for i, source := range info.Sources {
+				if i < len(info.Revisions) {
+					source.TargetRevision = info.Revisions[i]
+				}
+				sources[i] = source
+			}
+			return sources, true, nil
+		}
+		if info.Source.IsZero() {
+			return nil, false, status.Errorf(codes.FailedPrecondition, "deployment with id %v of application %s has no recorded source", id, a.QualifiedName())
+		}
+		source := info.Source
+		source.TargetRevision = info.Revision
+		return v1alpha1.ApplicationSources{source}, false, nil
+	}
+	return nil, false, status.Errorf(codes.InvalidArgument, "application %s does not have deployment with id %v", a.QualifiedName(), id)
+}
+
 // GetManifests returns application manifests
 func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationManifestQuery) (*apiclient.ManifestResponse, error) {
 	if q.Name == nil || *q.Name == "" {
@@ -481,6 +561,15 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 		return nil, security.NamespaceNotPermittedError(a.Namespace)
 	}
 
+	var historySources v1alpha1.ApplicationSources
+	hasMultipleSources := a.Spec.HasMultipleSources()
+	if q.HistoryId != nil {
+		historySources, hasMultipleSources, err = getHistorySources(a, q.GetHistoryId())
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	manifestInfos := make([]*apiclient.ManifestResponse, 0)
 	err = s.queryRepoServer(ctx, proj, func(
 		client apiclient.RepoServerServiceClient, helmRepos []*v1alpha1.Repository, helmCreds []*v1alpha1.RepoCreds, ociRepos []*v1alpha1.Repository, ociCreds []*v1alpha1.RepoCreds, helmOptions *v1alpha1.HelmOptions, enableGenerateManifests map[string]bool,
@@ -507,7 +596,10 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 
 		sources := make([]v1alpha1.ApplicationSource, 0)
 		appSpec := a.Spec
-		if a.Spec.HasMultipleSources() {
+		switch {
+		case historySources != nil:
+			sources = historySources
+		case a.Spec.HasMultipleSources():
 			numOfSources := int64(len(a.Spec.GetSources()))
 			for i, pos := range q.SourcePositions {
 				if pos <= 0 || pos > numOfSources {
@@ -516,7 +608,7 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 				appSpec.Sources[pos-1].TargetRevision = q.Revisions[i]
 			}
 			sources = appSpec.GetSources()
-		} else {
+		default:
 			source := a.Spec.GetSource()
 			if q.GetRevision() != "" {
 				source.TargetRevision = q.GetRevision()
@@ -579,7 +671,7 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 				EnabledSourceTypes:              enableGenerateManifests,
 				ProjectName:                     proj.Name,
 				ProjectSourceRepos:              proj.Spec.SourceRepos,
-				HasMultipleSources:              a.Spec.HasMultipleSources(),
+				HasMultipleSources:              hasMultipleSources,
 				RefSources:                      refSources,
 				AnnotationManifestGeneratePaths: a.GetAnnotation(v1alpha1.AnnotationKeyManifestGeneratePaths),
 				InstallationID:                  installationID,
@@ -667,6 +759,9 @@ func (s *Server) GetManifestsWithFiles(stream application.ApplicationService_Get
 		}
 
 		source := a.Spec.GetSource()
+		if err := overrideHelmValues(&source, query); err != nil {
+			return err
+		}
 
 		proj, err := argo.GetAppProject(ctx, a, applisters.NewAppProjectLister(s.projInformer.GetIndexer()), s.ns, s.settingsMgr, s.db)
 		if err != nil {
@@ -748,6 +843,30 @@ func (s *Server) GetManifestsWithFiles(stream application.ApplicationService_Get
 	return nil
 }
 
+// overrideHelmValues replaces the inline Helm values and parameters of the source with the ones of the query, if any
+func overrideHelmValues(source *v1alpha1.ApplicationSource, query *application.ApplicationManifestQueryWithFiles) error {
+	if query.HelmValues == nil && len(query.HelmParameters) == 0 {
+		return nil
+	}
+	helm := &v1alpha1.ApplicationSourceHelm{}
+	if source.Helm != nil {
+		helm = source.Helm.DeepCopy()
+	}
+	if query.HelmValues != nil {
+		if err := helm.SetValuesString(query.GetHelmValues()); err != nil {
+			return fmt.Errorf("error setting Helm values: %w", err)
+		}
+	}
+	if len(query.HelmParameters) > 0 {
+		helm.Parameters = make([]v1alpha1.HelmParameter, 0, len(query.HelmParameters))
+		for _, param := range query.HelmParameters {
+			helm.Parameters = append(helm.Parameters, *param)
+		}
+	}
+	source.Helm = helm
+	return nil
+}
+
 // Get returns an application by name
 func (s *Server) Get(ctx context.Context, q *application.ApplicationQuery) (*v1alpha1.Application, error) {
 	appName := q.GetName()
@@ -864,6 +983,66 @@ func (s *Server) ListResourceEvents(ctx context.Context, q *application.Applicat
 		return nil, err
 	}
 
+	kubeClientset, namespace, fieldSelector, err := s.getResourceEventsClient(ctx, a, q)
+	if err != nil {
+		return nil, err
+	}
+	log.Infof("Querying for resource events with field selector: %s", fieldSelector)
+	opts := metav1.ListOptions{FieldSelector: fieldSelector}
+	list, err := kubeClientset.CoreV1().Events(namespace).List(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error listing resource events: %w", err)
+	}
+	return list.DeepCopy(), nil
+}
+
+// WatchResourceEvents streams the events of an application, or of one of its resources, as they are created or updated.
+// The events which already exist are sent first.
+func (s *Server) WatchResourceEvents(q *application.ApplicationResourceEventsQuery, ws application.ApplicationService_WatchResourceEventsServer) error {
+	ctx := ws.Context()
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return err
+	}
+
+	kubeClientset, namespace, fieldSelector, err := s.getResourceEventsClient(ctx, a, q)
+	if err != nil {
+		return err
+	}
+	log.Infof("Watching resource events with field selector: %s", fieldSelector)
+	w, err := kubeClientset.CoreV1().Events(namespace).Watch(ctx, metav1.ListOptions{FieldSelector: fieldSelector})
+	if err != nil {
+		return fmt.Errorf("error watching resource events: %w", err)
+	}
+	defer w.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case next, ok := <-w.ResultChan():
+			if !ok {
+				return nil
+			}
+			switch next.Type {
+			case watch.Added, watch.Modified:
+				event, ok := next.Object.(*corev1.Event)
+				if !ok {
+					continue
+				}
+				if err := ws.Send(event.DeepCopy()); err != nil {
+					return err
+				}
+			case watch.Error:
+				return fmt.Errorf("error watching resource events: %w", apierrors.FromObject(next.Object))
+			}
+		}
+	}
+}
+
+// getResourceEventsClient returns the clientset, namespace and field selector to query the events of an application,
+// or of one of its resources if the query references one.
+func (s *Server) getResourceEventsClient(ctx context.Context, a *v1alpha1.Application, q *application.ApplicationResourceEventsQuery) (kubernetes.Interface, string, string, error) {
 	var (
 		kubeClientset kubernetes.Interface
 		fieldSelector string
@@ -883,7 +1062,7 @@ func (s *Server) ListResourceEvents(ctx context.Context, q *application.Applicat
 	} else {
 		tree, err := s.getAppResources(ctx, a)
 		if err != nil {
-			return nil, fmt.Errorf("error getting app resources: %w", err)
+			return nil, "", "", fmt.Errorf("error getting app resources: %w", err)
 		}
 		found := false
 		for _, n := range append(tree.Nodes, tree.OrphanedNodes...) {
@@ -893,18 +1072,18 @@ func (s *Server) ListResourceEvents(ctx context.Context, q *application.Applicat
 			}
 		}
 		if !found {
-			return nil, status.Errorf(codes.InvalidArgument, "%s not found as part of application %s", q.GetResourceName(), q.GetName())
+			return nil, "", "", status.Errorf(codes.InvalidArgument, "%s not found as part of application %s", q.GetResourceName(), q.GetName())
 		}
 
 		namespace = q.GetResourceNamespace()
 		var config *rest.Config
 		config, err = s.getApplicationClusterConfig(ctx, a)
 		if err != nil {
-			return nil, fmt.Errorf("error getting application cluster config: %w", err)
+			return nil, "", "", fmt.Errorf("error getting application cluster config: %w", err)
 		}
 		kubeClientset, err = kubernetes.NewForConfig(config)
 		if err != nil {
-			return nil, fmt.Errorf("error creating kube client: %w", err)
+			return nil, "", "", fmt.Errorf("error creating kube client: %w", err)
 		}
 		fieldSelector = fields.SelectorFromSet(map[string]string{
 			"involvedObject.name":      q.GetResourceName(),
@@ -912,18 +1091,13 @@ func (s *Server) ListResourceEvents(ctx context.Context, q *application.Applicat
 			"involvedObject.namespace": namespace,
 		}).String()
 	}
-	log.Infof("Querying for resource events with field selector: %s", fieldSelector)
-	opts := metav1.ListOptions{FieldSelector: fieldSelector}
-	list, err := kubeClientset.CoreV1().Events(namespace).List(ctx, opts)
-	if err != nil {
-		return nil, fmt.Errorf("error listing resource events: %w", err)
-	}
-	return list.DeepCopy(), nil
+	return kubeClientset, namespace, fieldSelector, nil
 }
 
 // validateAndUpdateApp validates and updates the application. currentProject is the name of the project the app
 // currently is under. If not specified, we assume that the app is under the project specified in the app spec.
-func (s *Server) validateAndUpdateApp(ctx context.Context, newApp *v1alpha1.Application, merge bool, validate bool, action string, currentProject string) (*v1alpha1.Application, error) {
+// If resourceVersion is specified, the update fails with a conflict if the app has been modified since.
+func (s *Server) validateAndUpdateApp(ctx context.Context, newApp *v1alpha1.Application, merge bool, validate bool, action string, currentProject string, resourceVersion string) (*v1alpha1.Application, error) {
 	s.projectLock.RLock(newApp.Spec.GetProject())
 	defer s.projectLock.RUnlock(newApp.Spec.GetProject())
 
@@ -937,7 +1111,7 @@ func (s *Server) validateAndUpdateApp(ctx context.Context, newApp *v1alpha1.Appl
 		return nil, fmt.Errorf("error validating and normalizing app: %w", err)
 	}
 
-	a, err := s.updateApp(ctx, app, newApp, merge)
+	a, err := s.updateApp(ctx, app, newApp, merge, resourceVersion)
 	if err != nil {
 		return nil, fmt.Errorf("error updating application: %w", err)
 	}
@@ -977,7 +1151,10 @@ func (s *Server) waitSync(app *v1alpha1.Application) {
 	logCtx.Warnf("waitSync failed: timed out")
 }
 
-func (s *Server) updateApp(ctx context.Context, app *v1alpha1.Application, newApp *v1alpha1.Application, merge bool) (*v1alpha1.Application, error) {
+func (s *Server) updateApp(ctx context.Context, app *v1alpha1.Application, newApp *v1alpha1.Application, merge bool, resourceVersion string) (*v1alpha1.Application, error) {
+	if resourceVersion != "" {
+		app.ResourceVersion = resourceVersion
+	}
 	for i := 0; i < 10; i++ {
 		app.Spec = newApp.Spec
 		if merge {
@@ -996,7 +1173,7 @@ func (s *Server) updateApp(ctx context.Context, app *v1alpha1.Application, newAp
 			s.waitSync(res)
 			return res, nil
 		}
-		if !apierrors.IsConflict(err) {
+		if !apierrors.IsConflict(err) || resourceVersion != "" {
 			return nil, err
 		}
 
@@ -1023,7 +1200,7 @@ func (s *Server) Update(ctx context.Context, q *application.ApplicationUpdateReq
 	if q.Validate != nil {
 		validate = *q.Validate
 	}
-	return s.validateAndUpdateApp(ctx, q.Application, false, validate, rbac.ActionUpdate, q.GetProject())
+	return s.validateAndUpdateApp(ctx, q.Application, false, validate, rbac.ActionUpdate, q.GetProject(), "")
 }
 
 // UpdateSpec updates an application spec and filters out any invalid parameter overrides
@@ -1041,7 +1218,7 @@ func (s *Server) UpdateSpec(ctx context.Context, q *application.ApplicationUpdat
 	if q.Validate != nil {
 		validate = *q.Validate
 	}
-	a, err = s.validateAndUpdateApp(ctx, a, false, validate, rbac.ActionUpdate, q.GetProject())
+	a, err = s.validateAndUpdateApp(ctx, a, false, validate, rbac.ActionUpdate, q.GetProject(), q.GetResourceVersion())
 	if err != nil {
 		return nil, fmt.Errorf("error validating and updating app: %w", err)
 	}
@@ -1091,7 +1268,7 @@ func (s *Server) Patch(ctx context.Context, q *application.ApplicationPatchReque
 	if err != nil {
 		return nil, fmt.Errorf("error unmarshaling patched app: %w", err)
 	}
-	return s.validateAndUpdateApp(ctx, newApp, false, true, rbac.ActionUpdate, q.GetProject())
+	return s.validateAndUpdateApp(ctx, newApp, false, true, rbac.ActionUpdate, q.GetProject(), "")
 }
 
 func (s *Server) getAppProject(ctx context.Context, a *v1alpha1.Application, logCtx *log.Entry) (*v1alpha1.AppProject, error) {
@@ -1819,6 +1996,12 @@ func (s *Server) PodLogs(q *application.ApplicationPodLogsQuery, ws application.
 
 	// from the tree find pods which match query of kind, group, and resource name
 	pods := getSelectedPods(tree.Nodes, q)
+	if q.GetSelector() != "" {
+		pods, err = filterPodsBySelector(ws.Context(), kubeClientset, pods, q.GetSelector())
+		if err != nil {
+			return err
+		}
+	}
 	if len(pods) == 0 {
 		return nil
 	}
@@ -1927,6 +2110,33 @@ func (s *Server) PodLogs(q *application.ApplicationPodLogsQuery, ws application.
 	}
 }
 
+// filterPodsBySelector returns the pods whose labels match the label selector. The labels are not part of the
+// resource tree, so the matching pods are listed from the namespaces of the given pods.
+func filterPodsBySelector(ctx context.Context, kubeClientset kubernetes.Interface, pods []v1alpha1.ResourceNode, selector string) ([]v1alpha1.ResourceNode, error) {
+	if _, err := labels.Parse(selector); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid selector %q: %v", selector, err)
+	}
+	matchingUIDs := make(map[string]bool)
+	listedNamespaces := make(map[string]bool)
+	var filtered []v1alpha1.ResourceNode
+	for _, pod := range pods {
+		if !listedNamespaces[pod.Namespace] {
+			listedNamespaces[pod.Namespace] = true
+			list, err := kubeClientset.CoreV1().Pods(pod.Namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+			if err != nil {
+				return nil, fmt.Errorf("error listing pods in namespace %s: %w", pod.Namespace, err)
+			}
+			for _, item := range list.Items {
+				matchingUIDs[string(item.UID)] = true
+			}
+		}
+		if matchingUIDs[pod.UID] {
+			filtered = append(filtered, pod)
+		}
+	}
+	return filtered, nil
+}
+
 // from all of the treeNodes, get the pod who meets the criteria or whose parents meets the criteria
 func getSelectedPods(treeNodes []v1alpha1.ResourceNode, q *application.ApplicationPodLogsQuery) []v1alpha1.ResourceNode {
 	var pods []v1alpha1.ResourceNode
@@ -2049,17 +2259,24 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 			}
 		}
 	}
+	if syncReq.GetResourceSelector() != "" {
+		if _, err := labels.Parse(syncReq.GetResourceSelector()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid resource selector: %v", err)
+		}
+	}
 	op := v1alpha1.Operation{
 		Sync: &v1alpha1.SyncOperation{
-			Revision:     revision,
-			Prune:        syncReq.GetPrune(),
-			DryRun:       syncReq.GetDryRun(),
-			SyncOptions:  syncOptions,
-			SyncStrategy: syncReq.Strategy,
-			Resources:    resources,
-			Manifests:    syncReq.Manifests,
-			Sources:      a.Spec.Sources,
-			Revisions:    sourceRevisions,
+			Revision:         revision,
+			Prune:            syncReq.GetPrune(),
+			DryRun:           syncReq.GetDryRun(),
+			SyncOptions:      syncOptions,
+			SyncStrategy:     syncReq.Strategy,
+			Resources:        resources,
+			ResourceSelector: syncReq.GetResourceSelector(),
+			Reason:           syncReq.GetReason(),
+			Manifests:        syncReq.Manifests,
+			Sources:          a.Spec.Sources,
+			Revisions:        sourceRevisions,
 		},
 		InitiatedBy: v1alpha1.OperationInitiator{Username: session.Username(ctx)},
 		Info:        syncReq.Infos,
@@ -2076,7 +2293,7 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 		return nil, fmt.Errorf("error setting app operation: %w", err)
 	}
 	partial := ""
-	if len(syncReq.Resources) > 0 {
+	if op.Sync.IsPartialSync() {
 		partial = "partial "
 	}
 	var reason string
@@ -2088,6 +2305,9 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 	if syncReq.Manifests != nil {
 		reason = fmt.Sprintf("initiated %ssync locally", partial)
 	}
+	if syncReq.GetReason() != "" {
+		reason = fmt.Sprintf("%s: %s", reason, syncReq.GetReason())
+	}
 	s.logAppEvent(ctx, a, argo.EventReasonOperationStarted, reason)
 	return a, nil
 }
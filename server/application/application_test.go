@@ -29,11 +29,14 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	k8sbatchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/watch"
+	dynfake "k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/rest"
 	kubetesting "k8s.io/client-go/testing"
@@ -645,6 +648,40 @@ func (t *TestResourceTreeServer) RecvMsg(_ any) error {
 	return nil
 }
 
+type TestResourceEventsServer struct {
+	ctx    context.Context
+	events chan *corev1.Event
+}
+
+func (t *TestResourceEventsServer) Send(event *corev1.Event) error {
+	if t.events != nil {
+		t.events <- event
+	}
+	return nil
+}
+
+func (t *TestResourceEventsServer) SetHeader(metadata.MD) error {
+	return nil
+}
+
+func (t *TestResourceEventsServer) SendHeader(metadata.MD) error {
+	return nil
+}
+
+func (t *TestResourceEventsServer) SetTrailer(metadata.MD) {}
+
+func (t *TestResourceEventsServer) Context() context.Context {
+	return t.ctx
+}
+
+func (t *TestResourceEventsServer) SendMsg(_ any) error {
+	return nil
+}
+
+func (t *TestResourceEventsServer) RecvMsg(_ any) error {
+	return nil
+}
+
 type TestPodLogsServer struct {
 	ctx context.Context
 }
@@ -821,6 +858,19 @@ func TestNoAppEnumeration(t *testing.T) {
 		assert.EqualError(t, err, "rpc error: code = NotFound desc = applications.argoproj.io \"doest-not-exist\" not found", "when the request specifies a project, we can return the standard k8s error message")
 	})
 
+	t.Run("WatchResourceEvents", func(t *testing.T) {
+		cancelledCtx, cancel := context.WithCancel(adminCtx)
+		cancel()
+		err := appServer.WatchResourceEvents(&application.ApplicationResourceEventsQuery{Name: ptr.To("test")}, &TestResourceEventsServer{ctx: cancelledCtx})
+		require.NoError(t, err)
+		err = appServer.WatchResourceEvents(&application.ApplicationResourceEventsQuery{Name: ptr.To("test")}, &TestResourceEventsServer{ctx: noRoleCtx})
+		require.EqualError(t, err, common.PermissionDeniedAPIError.Error(), "error message must be _only_ the permission error, to avoid leaking information about app existence")
+		err = appServer.WatchResourceEvents(&application.ApplicationResourceEventsQuery{Name: ptr.To("doest-not-exist")}, &TestResourceEventsServer{ctx: adminCtx})
+		require.EqualError(t, err, common.PermissionDeniedAPIError.Error(), "error message must be _only_ the permission error, to avoid leaking information about app existence")
+		err = appServer.WatchResourceEvents(&application.ApplicationResourceEventsQuery{Name: ptr.To("doest-not-exist"), Project: ptr.To("test")}, &TestResourceEventsServer{ctx: adminCtx})
+		assert.EqualError(t, err, "rpc error: code = NotFound desc = applications.argoproj.io \"doest-not-exist\" not found", "when the request specifies a project, we can return the standard k8s error message")
+	})
+
 	t.Run("UpdateSpec", func(t *testing.T) {
 		_, err := appServer.UpdateSpec(adminCtx, &application.ApplicationUpdateSpecRequest{Name: ptr.To("test"), Spec: &v1alpha1.ApplicationSpec{
 			Destination: v1alpha1.ApplicationDestination{Namespace: "default", Server: "https://cluster-api.example.com"},
@@ -1292,6 +1342,70 @@ func TestListApps(t *testing.T) {
 	assert.Equal(t, []string{"abc", "bcd", "def"}, names)
 }
 
+func TestListAppsWithPagination(t *testing.T) {
+	appServer := newTestAppServer(t, newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "bcd"
+	}), newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "abc"
+	}), newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "def"
+	}))
+
+	listNames := func(q *application.ApplicationQuery) ([]string, *v1alpha1.ApplicationList) {
+		t.Helper()
+		res, err := appServer.List(t.Context(), q)
+		require.NoError(t, err)
+		var names []string
+		for i := range res.Items {
+			names = append(names, res.Items[i].Name)
+		}
+		return names, res
+	}
+
+	names, res := listNames(&application.ApplicationQuery{Limit: ptr.To(int64(2))})
+	assert.Equal(t, []string{"abc", "bcd"}, names)
+	require.NotEmpty(t, res.Continue)
+	assert.Equal(t, ptr.To(int64(1)), res.RemainingItemCount)
+
+	names, res = listNames(&application.ApplicationQuery{Limit: ptr.To(int64(2)), Continue: ptr.To(res.Continue)})
+	assert.Equal(t, []string{"def"}, names)
+	assert.Empty(t, res.Continue)
+	assert.Nil(t, res.RemainingItemCount)
+
+	names, _ = listNames(&application.ApplicationQuery{Limit: ptr.To(int64(5))})
+	assert.Equal(t, []string{"abc", "bcd", "def"}, names)
+
+	_, err := appServer.List(t.Context(), &application.ApplicationQuery{Limit: ptr.To(int64(-1))})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = appServer.List(t.Context(), &application.ApplicationQuery{Continue: ptr.To("not a token")})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestListAppsWithStatusFilters(t *testing.T) {
+	appServer := newTestAppServer(t, newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "healthy"
+		app.Status.Health.Status = health.HealthStatusHealthy
+		app.Status.Sync.Status = v1alpha1.SyncStatusCodeSynced
+	}), newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "degraded"
+		app.Spec.Destination = v1alpha1.ApplicationDestination{Name: "other-cluster", Namespace: "default"}
+		app.Status.Health.Status = health.HealthStatusDegraded
+		app.Status.Sync.Status = v1alpha1.SyncStatusCodeOutOfSync
+	}))
+
+	for _, q := range []*application.ApplicationQuery{
+		{Health: ptr.To("Degraded")},
+		{Sync: ptr.To("OutOfSync")},
+		{Cluster: ptr.To("other-cluster")},
+	} {
+		res, err := appServer.List(t.Context(), q)
+		require.NoError(t, err)
+		require.Len(t, res.Items, 1)
+		assert.Equal(t, "degraded", res.Items[0].Name)
+	}
+}
+
 func TestCoupleAppsListApps(t *testing.T) {
 	var objects []runtime.Object
 	ctx := t.Context()
@@ -1534,6 +1648,26 @@ func TestUpdateAppSpec(t *testing.T) {
 	app, err := appServer.Get(t.Context(), &application.ApplicationQuery{Name: &testApp.Name})
 	require.NoError(t, err)
 	assert.Equal(t, "default", app.Spec.Project)
+
+	t.Run("ModifiedSinceResourceVersion", func(t *testing.T) {
+		// the fake clientset does not check resource versions, so pretend that the app has been modified
+		fakeAppCs := appServer.appclientset.(*deepCopyAppClientset).GetUnderlyingClientSet().(*apps.Clientset)
+		var updatedVersions []string
+		fakeAppCs.PrependReactor("update", "applications", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+			app := action.(kubetesting.UpdateAction).GetObject().(*v1alpha1.Application)
+			updatedVersions = append(updatedVersions, app.ResourceVersion)
+			return true, nil, apierrors.NewConflict(schema.GroupResource{Group: "argoproj.io", Resource: "applications"}, app.Name, stderrors.New("modified"))
+		})
+		_, err := appServer.UpdateSpec(t.Context(), &application.ApplicationUpdateSpecRequest{
+			Name:            &testApp.Name,
+			Spec:            &testApp.Spec,
+			ResourceVersion: ptr.To("1"),
+		})
+		require.Error(t, err)
+		assert.True(t, apierrors.IsConflict(err))
+		// the update is not retried with the current version of the app
+		assert.Equal(t, []string{"1"}, updatedVersions)
+	})
 }
 
 func TestDeleteApp(t *testing.T) {
@@ -1852,6 +1986,43 @@ func TestSyncAndTerminate(t *testing.T) {
 	assert.Equal(t, synccommon.OperationTerminating, app.Status.OperationState.Phase)
 }
 
+func TestSyncResourceSelector(t *testing.T) {
+	ctx := t.Context()
+	appServer := newTestAppServer(t)
+	testApp := newTestApp()
+	testApp.Spec.Source.RepoURL = "https://github.com/argoproj/argo-cd.git"
+	app, err := appServer.Create(ctx, &application.ApplicationCreateRequest{Application: testApp})
+	require.NoError(t, err)
+
+	_, err = appServer.Sync(ctx, &application.ApplicationSyncRequest{Name: &app.Name, ResourceSelector: ptr.To("tier in frontend")})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	app, err = appServer.Sync(ctx, &application.ApplicationSyncRequest{Name: &app.Name, ResourceSelector: ptr.To("tier=frontend")})
+	require.NoError(t, err)
+	require.NotNil(t, app.Operation)
+	assert.Equal(t, "tier=frontend", app.Operation.Sync.ResourceSelector)
+	events, err := appServer.kubeclientset.CoreV1().Events(appServer.ns).List(t.Context(), metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Contains(t, events.Items[1].Message, "initiated partial sync to HEAD")
+}
+
+func TestSyncReason(t *testing.T) {
+	ctx := t.Context()
+	appServer := newTestAppServer(t)
+	testApp := newTestApp()
+	testApp.Spec.Source.RepoURL = "https://github.com/argoproj/argo-cd.git"
+	app, err := appServer.Create(ctx, &application.ApplicationCreateRequest{Application: testApp})
+	require.NoError(t, err)
+
+	app, err = appServer.Sync(ctx, &application.ApplicationSyncRequest{Name: &app.Name, Reason: ptr.To("JIRA-123 hotfix")})
+	require.NoError(t, err)
+	require.NotNil(t, app.Operation)
+	assert.Equal(t, "JIRA-123 hotfix", app.Operation.Sync.Reason)
+	events, err := appServer.kubeclientset.CoreV1().Events(appServer.ns).List(t.Context(), metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.True(t, strings.HasSuffix(events.Items[1].Message, ": JIRA-123 hotfix"), events.Items[1].Message)
+}
+
 func TestSyncHelm(t *testing.T) {
 	ctx := t.Context()
 	appServer := newTestAppServer(t)
@@ -1904,6 +2075,39 @@ func TestSyncGit(t *testing.T) {
 	assert.Equal(t, "Unknown user initiated sync locally", events.Items[1].Message)
 }
 
+func TestGetHistorySources(t *testing.T) {
+	app := newTestApp()
+	app.Status.History = v1alpha1.RevisionHistories{
+		{ID: 1, Revision: "abc", Source: v1alpha1.ApplicationSource{RepoURL: "https://example.com/repo.git", Path: "app", TargetRevision: "HEAD"}},
+		{ID: 2, Revisions: []string{"def", "1.2.3"}, Sources: v1alpha1.ApplicationSources{
+			{RepoURL: "https://example.com/repo.git", Path: "app", TargetRevision: "main"},
+			{RepoURL: "https://charts.example.com", Chart: "chart", TargetRevision: "1.*"},
+		}},
+		{ID: 3},
+	}
+
+	sources, multiple, err := getHistorySources(app, 1)
+	require.NoError(t, err)
+	assert.False(t, multiple)
+	require.Len(t, sources, 1)
+	assert.Equal(t, "abc", sources[0].TargetRevision)
+	assert.Equal(t, "HEAD", app.Status.History[0].Source.TargetRevision)
+
+	sources, multiple, err = getHistorySources(app, 2)
+	require.NoError(t, err)
+	assert.True(t, multiple)
+	require.Len(t, sources, 2)
+	assert.Equal(t, "def", sources[0].TargetRevision)
+	assert.Equal(t, "1.2.3", sources[1].TargetRevision)
+	assert.Equal(t, "main", app.Status.History[1].Sources[0].TargetRevision)
+
+	_, _, err = getHistorySources(app, 3)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, _, err = getHistorySources(app, 4)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
 func TestRollbackApp(t *testing.T) {
 	testApp := newTestApp()
 	testApp.Status.History = []v1alpha1.RevisionHistory{{
@@ -2222,6 +2426,34 @@ func TestLogsGetSelectedPod(t *testing.T) {
 	})
 }
 
+func TestLogsFilterPodsBySelector(t *testing.T) {
+	newPod := func(name, uid string, podLabels map[string]string) *corev1.Pod {
+		return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace, UID: types.UID(uid), Labels: podLabels}}
+	}
+	kubeClientset := fake.NewClientset(
+		newPod("frontend", "1", map[string]string{"app": "frontend"}),
+		newPod("backend", "2", map[string]string{"app": "backend"}),
+		// not part of the application
+		newPod("other", "3", map[string]string{"app": "frontend"}),
+	)
+	pods := []v1alpha1.ResourceNode{
+		{ResourceRef: v1alpha1.ResourceRef{Version: "v1", Kind: "Pod", Namespace: testNamespace, Name: "frontend", UID: "1"}},
+		{ResourceRef: v1alpha1.ResourceRef{Version: "v1", Kind: "Pod", Namespace: testNamespace, Name: "backend", UID: "2"}},
+	}
+
+	filtered, err := filterPodsBySelector(t.Context(), kubeClientset, pods, "app=frontend")
+	require.NoError(t, err)
+	require.Len(t, filtered, 1)
+	assert.Equal(t, "frontend", filtered[0].Name)
+
+	filtered, err = filterPodsBySelector(t.Context(), kubeClientset, pods, "app in (frontend,backend)")
+	require.NoError(t, err)
+	assert.Len(t, filtered, 2)
+
+	_, err = filterPodsBySelector(t.Context(), kubeClientset, pods, "app=(")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
 func TestMaxPodLogsRender(t *testing.T) {
 	defaultMaxPodLogsToRender, _ := newTestAppServer(t).settingsMgr.GetMaxPodLogsToRender()
 
@@ -3493,3 +3725,229 @@ func Test_DeepCopyInformers(t *testing.T) {
 		assert.NotSame(t, p, &spList[i])
 	}
 }
+
+type TestSyncApplicationsServer struct {
+	ctx     context.Context
+	results []*application.ApplicationsSyncResult
+}
+
+func (t *TestSyncApplicationsServer) Send(res *application.ApplicationsSyncResult) error {
+	t.results = append(t.results, res)
+	return nil
+}
+
+func (t *TestSyncApplicationsServer) SetHeader(metadata.MD) error {
+	return nil
+}
+
+func (t *TestSyncApplicationsServer) SendHeader(metadata.MD) error {
+	return nil
+}
+
+func (t *TestSyncApplicationsServer) SetTrailer(metadata.MD) {}
+
+func (t *TestSyncApplicationsServer) Context() context.Context {
+	return t.ctx
+}
+
+func (t *TestSyncApplicationsServer) SendMsg(_ any) error {
+	return nil
+}
+
+func (t *TestSyncApplicationsServer) RecvMsg(_ any) error {
+	return nil
+}
+
+func TestSyncApplications(t *testing.T) {
+	bulkSyncPollInterval = 10 * time.Millisecond
+	t.Cleanup(func() { bulkSyncPollInterval = time.Second })
+
+	t.Run("InvalidArguments", func(t *testing.T) {
+		appServer := newTestAppServer(t)
+		ws := &TestSyncApplicationsServer{ctx: t.Context()}
+		err := appServer.SyncApplications(&application.ApplicationsSyncRequest{}, ws)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		err = appServer.SyncApplications(&application.ApplicationsSyncRequest{Selector: ptr.To("env=staging"), Names: []string{"test-app"}}, ws)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		err = appServer.SyncApplications(&application.ApplicationsSyncRequest{Names: []string{"test-app"}, MaxParallel: ptr.To(int64(-1))}, ws)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		err = appServer.SyncApplications(&application.ApplicationsSyncRequest{Selector: ptr.To("env=staging")}, ws)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Empty(t, ws.results)
+	})
+
+	t.Run("WaitForCompletion", func(t *testing.T) {
+		testApp := newTestApp()
+		testApp.Labels = map[string]string{"env": "staging"}
+		// the state of a previous operation, which was started later according to the clock of the API server
+		testApp.Status.OperationState = &v1alpha1.OperationState{
+			Phase:     synccommon.OperationFailed,
+			Message:   "previous sync failed",
+			StartedAt: metav1.NewTime(time.Now().Add(time.Hour)),
+		}
+		appServer := newTestAppServer(t, testApp)
+
+		// pretend to be the controller, which completes the operation
+		go func() {
+			appIf := appServer.appclientset.ArgoprojV1alpha1().Applications(appServer.ns)
+			for t.Context().Err() == nil {
+				app, err := appIf.Get(t.Context(), testApp.Name, metav1.GetOptions{})
+				if err == nil && app.Operation != nil {
+					app.Status.OperationState = &v1alpha1.OperationState{
+						Operation: *app.Operation,
+						Phase:     synccommon.OperationSucceeded,
+						Message:   "successfully synced (all tasks run)",
+						StartedAt: metav1.Now(),
+					}
+					app.Operation = nil
+					_, _ = appIf.Update(t.Context(), app, metav1.UpdateOptions{})
+					return
+				}
+				time.Sleep(10 * time.Millisecond)
+			}
+		}()
+
+		ws := &TestSyncApplicationsServer{ctx: t.Context()}
+		err := appServer.SyncApplications(&application.ApplicationsSyncRequest{Selector: ptr.To("env=staging"), Prune: ptr.To(true)}, ws)
+		require.NoError(t, err)
+		require.Len(t, ws.results, 1)
+		assert.Equal(t, testApp.Name, ws.results[0].GetName())
+		assert.Equal(t, string(synccommon.OperationSucceeded), ws.results[0].GetPhase())
+		assert.Equal(t, "successfully synced (all tasks run)", ws.results[0].GetMessage())
+	})
+
+	t.Run("AbortOnFailure", func(t *testing.T) {
+		appServer := newTestAppServer(t, newTestApp())
+		ws := &TestSyncApplicationsServer{ctx: t.Context()}
+		err := appServer.SyncApplications(&application.ApplicationsSyncRequest{
+			Names:          []string{"missing-app", "test-app"},
+			AbortOnFailure: ptr.To(true),
+		}, ws)
+		require.NoError(t, err)
+		require.Len(t, ws.results, 2)
+		assert.Equal(t, "missing-app", ws.results[0].GetName())
+		assert.Empty(t, ws.results[0].GetPhase())
+		assert.NotEmpty(t, ws.results[0].GetMessage())
+		assert.Equal(t, "test-app", ws.results[1].GetName())
+		assert.Nil(t, ws.results[1].Phase)
+		assert.Contains(t, ws.results[1].GetMessage(), "skipped")
+	})
+}
+
+func TestResourceUsage(t *testing.T) {
+	podMetrics := func(name, cpu, memory string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "metrics.k8s.io/v1beta1",
+			"kind":       "PodMetrics",
+			"metadata":   map[string]any{"name": name, "namespace": testNamespace},
+			"containers": []any{
+				map[string]any{"name": "app", "usage": map[string]any{"cpu": cpu, "memory": memory}},
+				map[string]any{"name": "sidecar", "usage": map[string]any{"cpu": "5m", "memory": "1Mi"}},
+			},
+		}}
+	}
+	deployment := v1alpha1.ResourceRef{Group: "apps", Version: "v1", Kind: "Deployment", Namespace: testNamespace, Name: "guestbook", UID: "1"}
+	rs := v1alpha1.ResourceRef{Group: "apps", Version: "v1", Kind: "ReplicaSet", Namespace: testNamespace, Name: "guestbook-6b9c", UID: "2"}
+	nodes := []v1alpha1.ResourceNode{
+		{ResourceRef: deployment},
+		{ResourceRef: rs, ParentRefs: []v1alpha1.ResourceRef{deployment}},
+		{ResourceRef: v1alpha1.ResourceRef{Version: "v1", Kind: "Pod", Namespace: testNamespace, Name: "guestbook-6b9c-a", UID: "3"}, ParentRefs: []v1alpha1.ResourceRef{rs}},
+		{ResourceRef: v1alpha1.ResourceRef{Version: "v1", Kind: "Pod", Namespace: testNamespace, Name: "guestbook-6b9c-b", UID: "4"}, ParentRefs: []v1alpha1.ResourceRef{rs}},
+		{ResourceRef: v1alpha1.ResourceRef{Version: "v1", Kind: "Pod", Namespace: testNamespace, Name: "standalone", UID: "5"}},
+		// no metrics are available for this pod
+		{ResourceRef: v1alpha1.ResourceRef{Version: "v1", Kind: "Pod", Namespace: testNamespace, Name: "pending", UID: "6"}},
+	}
+
+	testApp := newTestApp()
+	appServer := newTestAppServer(t, testApp)
+	dynamicClient := dynfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{podMetricsGVR: "PodMetricsList"})
+	for _, metrics := range []*unstructured.Unstructured{
+		podMetrics("guestbook-6b9c-a", "100m", "64Mi"),
+		podMetrics("guestbook-6b9c-b", "250m", "128Mi"),
+		podMetrics("standalone", "1", "1Gi"),
+	} {
+		require.NoError(t, dynamicClient.Tracker().Create(podMetricsGVR, metrics, testNamespace))
+	}
+	appServer.kubectl = &kubetest.MockKubectlCmd{DynamicClient: dynamicClient}
+	appStateCache := appstate.NewCache(cache.NewCache(cache.NewInMemoryCache(time.Hour)), time.Minute)
+	appServer.cache = servercache.NewCache(appStateCache, time.Minute, time.Minute)
+	require.NoError(t, appStateCache.SetAppResourcesTree(testApp.Name, &v1alpha1.ApplicationTree{Nodes: nodes}))
+
+	res, err := appServer.ResourceUsage(t.Context(), &application.ApplicationResourceUsageQuery{Name: &testApp.Name})
+	require.NoError(t, err)
+	require.Len(t, res.Workloads, 2)
+
+	assert.Equal(t, "Pod", res.Workloads[0].GetKind())
+	assert.Equal(t, "standalone", res.Workloads[0].GetName())
+	assert.Equal(t, int64(1), res.Workloads[0].GetPods())
+	assert.Equal(t, int64(1005), res.Workloads[0].GetCpuMillis())
+	assert.Equal(t, int64(1025*1024*1024), res.Workloads[0].GetMemoryBytes())
+
+	assert.Equal(t, "Deployment", res.Workloads[1].GetKind())
+	assert.Equal(t, "guestbook", res.Workloads[1].GetName())
+	assert.Equal(t, int64(2), res.Workloads[1].GetPods())
+	assert.Equal(t, int64(360), res.Workloads[1].GetCpuMillis())
+	assert.Equal(t, int64(194*1024*1024), res.Workloads[1].GetMemoryBytes())
+
+	assert.Contains(t, res.GetMessage(), "the usage of 1 of 4 pods is not available")
+	// the pod metrics are listed once for the namespace of the pods
+	assert.Len(t, dynamicClient.Actions(), 1)
+}
+
+func TestWatchResourceEvents(t *testing.T) {
+	testApp := newTestApp()
+	appServer := newTestAppServer(t, testApp)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	ws := &TestResourceEventsServer{ctx: ctx, events: make(chan *corev1.Event, 10)}
+	done := make(chan error)
+	go func() {
+		done <- appServer.WatchResourceEvents(&application.ApplicationResourceEventsQuery{Name: &testApp.Name}, ws)
+	}()
+
+	// the watch is started asynchronously, so keep creating events until one is received
+	var received *corev1.Event
+	for i := 0; received == nil; i++ {
+		event := &corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: fmt.Sprintf("%s.%d", testApp.Name, i), Namespace: testApp.Namespace},
+			InvolvedObject: corev1.ObjectReference{Kind: "Application", Name: testApp.Name, Namespace: testApp.Namespace},
+			Reason:         "ResourceUpdated",
+		}
+		_, err := appServer.kubeclientset.CoreV1().Events(testApp.Namespace).Create(t.Context(), event, metav1.CreateOptions{})
+		require.NoError(t, err)
+		select {
+		case received = <-ws.events:
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+	assert.Equal(t, "ResourceUpdated", received.Reason)
+	assert.Equal(t, testApp.Name, received.InvolvedObject.Name)
+
+	cancel()
+	require.NoError(t, <-done)
+}
+
+func TestOverrideHelmValues(t *testing.T) {
+	helm := &v1alpha1.ApplicationSourceHelm{
+		ValueFiles: []string{"values-prod.yaml"},
+		Values:     "replicas: 3\n",
+		Parameters: []v1alpha1.HelmParameter{{Name: "image.tag", Value: "v1"}},
+	}
+
+	source := &v1alpha1.ApplicationSource{Helm: helm}
+	require.NoError(t, overrideHelmValues(source, &application.ApplicationManifestQueryWithFiles{}))
+	assert.Same(t, helm, source.Helm)
+
+	err := overrideHelmValues(source, &application.ApplicationManifestQueryWithFiles{
+		HelmValues:     ptr.To("replicas: 1\n"),
+		HelmParameters: []*v1alpha1.HelmParameter{{Name: "image.tag", Value: "v2"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"values-prod.yaml"}, source.Helm.ValueFiles)
+	assert.Equal(t, "replicas: 1", source.Helm.ValuesString())
+	assert.Equal(t, []v1alpha1.HelmParameter{{Name: "image.tag", Value: "v2"}}, source.Helm.Parameters)
+	// the source of the application in the informer cache must not be modified
+	assert.Equal(t, "replicas: 3\n", helm.ValuesString())
+	assert.Equal(t, []v1alpha1.HelmParameter{{Name: "image.tag", Value: "v1"}}, helm.Parameters)
+}
@@ -0,0 +1,157 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+)
+
+// bulkSyncPollInterval is the interval in which the completion of the syncs of a bulk sync is checked
+var bulkSyncPollInterval = time.Second
+
+// bulkSyncIDInfo is the name of the operation info which identifies a sync operation started by a bulk sync
+const bulkSyncIDInfo = "Bulk sync ID"
+
+// SyncApplications syncs the applications matching the selector and projects, or the names of the request. At most maxParallel
+// applications are synced at the same time, and the result of each sync is sent once the sync has completed.
+func (s *Server) SyncApplications(q *application.ApplicationsSyncRequest, ws application.ApplicationService_SyncApplicationsServer) error {
+	ctx := ws.Context()
+	byFilter := q.GetSelector() != "" || len(q.Projects) > 0
+	if !byFilter && len(q.Names) == 0 {
+		return status.Error(codes.InvalidArgument, "either a selector, projects or the names of the applications are required")
+	}
+	if byFilter && len(q.Names) > 0 {
+		return status.Error(codes.InvalidArgument, "a selector or projects and the names of the applications are mutually exclusive")
+	}
+	maxParallel := q.GetMaxParallel()
+	if maxParallel < 0 {
+		return status.Errorf(codes.InvalidArgument, "invalid max parallel %d: must not be negative", maxParallel)
+	}
+	if maxParallel == 0 {
+		maxParallel = 1
+	}
+
+	var results []*application.ApplicationsSyncResult
+	if byFilter {
+		apps, err := s.List(ctx, &application.ApplicationQuery{
+			Selector:     q.Selector,
+			AppNamespace: q.AppNamespace,
+			Projects:     q.Projects,
+		})
+		if err != nil {
+			return err
+		}
+		if len(apps.Items) == 0 {
+			return status.Error(codes.NotFound, "no applications match the selector and projects")
+		}
+		for _, a := range apps.Items {
+			results = append(results, &application.ApplicationsSyncResult{Name: ptr.To(a.Name), AppNamespace: ptr.To(a.Namespace)})
+		}
+	} else {
+		for _, name := range q.Names {
+			appName, appNs := argo.ParseFromQualifiedName(name, q.GetAppNamespace())
+			results = append(results, &application.ApplicationsSyncResult{Name: ptr.To(appName), AppNamespace: ptr.To(s.appNamespaceOrDefault(appNs))})
+		}
+	}
+
+	var (
+		wg      sync.WaitGroup
+		failed  atomic.Bool
+		sendMu  sync.Mutex
+		sendErr error
+	)
+	send := func(res *application.ApplicationsSyncResult) {
+		sendMu.Lock()
+		defer sendMu.Unlock()
+		if sendErr == nil {
+			sendErr = ws.Send(res)
+		}
+	}
+	slots := make(chan struct{}, maxParallel)
+	for _, res := range results {
+		slots <- struct{}{}
+		if q.GetAbortOnFailure() && failed.Load() {
+			<-slots
+			res.Message = ptr.To("skipped because the sync of another application failed")
+			send(res)
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			phase, message := s.syncAndWait(ctx, q, res.GetName(), res.GetAppNamespace())
+			if phase != string(common.OperationSucceeded) {
+				failed.Store(true)
+			}
+			res.Phase = ptr.To(phase)
+			res.Message = ptr.To(message)
+			send(res)
+		}()
+	}
+	wg.Wait()
+	return sendErr
+}
+
+// syncAndWait syncs a single application of a bulk sync and waits for the sync operation to complete. It returns the
+// phase and message of the completed operation, or an empty phase if the sync could not be started.
+func (s *Server) syncAndWait(ctx context.Context, q *application.ApplicationsSyncRequest, appName, appNs string) (string, string) {
+	// the operation is identified by an info with a unique ID, which the controller keeps in the operation state
+	syncID := uuid.NewString()
+	_, err := s.Sync(ctx, &application.ApplicationSyncRequest{
+		Name:             ptr.To(appName),
+		AppNamespace:     ptr.To(appNs),
+		DryRun:           q.DryRun,
+		Prune:            q.Prune,
+		Strategy:         q.Strategy,
+		Infos:            append(slices.Clone(q.Infos), &v1alpha1.Info{Name: bulkSyncIDInfo, Value: syncID}),
+		RetryStrategy:    q.RetryStrategy,
+		SyncOptions:      q.SyncOptions,
+		ResourceSelector: q.ResourceSelector,
+		Reason:           q.Reason,
+	})
+	if err != nil {
+		return "", err.Error()
+	}
+
+	var opState *v1alpha1.OperationState
+	err = wait.PollUntilContextCancel(ctx, bulkSyncPollInterval, false, func(context.Context) (bool, error) {
+		current, err := s.appLister.Applications(appNs).Get(appName)
+		if err != nil {
+			return false, err
+		}
+		// the operation is removed from the application once it has completed, while the informer cache may still
+		// hold the state of a previous operation
+		opState = current.Status.OperationState
+		return current.Operation == nil && opState != nil && opState.Phase.Completed() && hasOperationInfo(opState.Operation, bulkSyncIDInfo, syncID), nil
+	})
+	if err != nil {
+		log.WithFields(log.Fields{"application": appName, "appNamespace": appNs}).Warnf("Failed to wait for the sync to complete: %v", err)
+		return "", fmt.Sprintf("failed to wait for the sync to complete: %v", err)
+	}
+	return string(opState.Phase), opState.Message
+}
+
+// hasOperationInfo returns whether the operation has an info with the given name and value
+func hasOperationInfo(op v1alpha1.Operation, name, value string) bool {
+	return slices.ContainsFunc(op.Info, func(info *v1alpha1.Info) bool {
+		return info.Name == name && info.Value == value
+	})
+}
@@ -0,0 +1,318 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/kubectl/pkg/util/podutils"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applisters "github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/db"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	kubeutil "github.com/argoproj/argo-cd/v3/util/kube"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
+	"github.com/argoproj/argo-cd/v3/util/security"
+	util_session "github.com/argoproj/argo-cd/v3/util/session"
+)
+
+type portForwardHandler struct {
+	appLister         applisters.ApplicationLister
+	db                db.ArgoDB
+	appResourceTreeFn AppResourceTreeFn
+	namespace         string
+	enabledNamespaces []string
+	sessionManager    *util_session.SessionManager
+	terminalOptions   *TerminalOptions
+}
+
+// NewPortForwardHandler returns a new handler which forwards the binary messages of WebSocket connections to a port of
+// a pod or service of an application. Each WebSocket connection carries a single forwarded connection.
+func NewPortForwardHandler(appLister applisters.ApplicationLister, namespace string, enabledNamespaces []string, db db.ArgoDB, appResourceTree AppResourceTreeFn, sessionManager *util_session.SessionManager, terminalOptions *TerminalOptions) *portForwardHandler {
+	return &portForwardHandler{
+		appLister:         appLister,
+		db:                db,
+		appResourceTreeFn: appResourceTree,
+		namespace:         namespace,
+		enabledNamespaces: enabledNamespaces,
+		sessionManager:    sessionManager,
+		terminalOptions:   terminalOptions,
+	}
+}
+
+// WithFeatureFlagMiddleware is an HTTP middleware to verify if the exec
+// feature, which port forwarding is part of, is enabled before invoking the main handler
+func (s *portForwardHandler) WithFeatureFlagMiddleware(getSettings GetSettingsFunc) http.Handler {
+	return withExecFeatureFlag(getSettings, s)
+}
+
+func (s *portForwardHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	app := q.Get("appName")
+	project := q.Get("projectName")
+	namespace := q.Get("namespace")
+	kind := q.Get("kind")
+	name := q.Get("name")
+	port := q.Get("port")
+
+	if app == "" || project == "" || namespace == "" || kind == "" || name == "" || port == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	appNamespace := q.Get("appNamespace")
+
+	if kind != kube.PodKind && kind != kube.ServiceKind {
+		http.Error(w, "Kind must be either Pod or Service", http.StatusBadRequest)
+		return
+	}
+	if !argo.IsValidPodName(name) {
+		http.Error(w, "Resource name is not valid", http.StatusBadRequest)
+		return
+	}
+	if !argo.IsValidAppName(app) {
+		http.Error(w, "App name is not valid", http.StatusBadRequest)
+		return
+	}
+	if !argo.IsValidProjectName(project) {
+		http.Error(w, "Project name is not valid", http.StatusBadRequest)
+		return
+	}
+	if !argo.IsValidNamespaceName(namespace) {
+		http.Error(w, "Namespace name is not valid", http.StatusBadRequest)
+		return
+	}
+	if !argo.IsValidNamespaceName(appNamespace) {
+		http.Error(w, "App namespace name is not valid", http.StatusBadRequest)
+		return
+	}
+
+	ns := appNamespace
+	if ns == "" {
+		ns = s.namespace
+	}
+
+	if !security.IsNamespaceEnabled(ns, s.namespace, s.enabledNamespaces) {
+		http.Error(w, security.NamespaceNotPermittedError(ns).Error(), http.StatusForbidden)
+		return
+	}
+
+	ctx := r.Context()
+
+	// forwarding a port grants the same access to the workloads of the application as a terminal does
+	appRBACName := security.RBACName(s.namespace, project, appNamespace, app)
+	if err := s.enforcePermissions(ctx, appRBACName); err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	fieldLog := log.WithFields(log.Fields{
+		"application": app, "userName": util_session.Username(ctx), "kind": kind, "name": name, "port": port,
+		"namespace": namespace, "project": project, "appNamespace": appNamespace,
+	})
+
+	a, err := s.appLister.Applications(ns).Get(app)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			http.Error(w, "App not found", http.StatusNotFound)
+			return
+		}
+		fieldLog.Errorf("Error when getting app %q when forwarding a port: %s", app, err)
+		http.Error(w, "Cannot get app", http.StatusInternalServerError)
+		return
+	}
+
+	if a.Spec.Project != project {
+		fieldLog.Warnf("The wrong project (%q) was specified for the app %q when forwarding a port", project, app)
+		http.Error(w, "The wrong project was specified for the app", http.StatusBadRequest)
+		return
+	}
+
+	destCluster, err := argo.GetDestinationCluster(ctx, a.Spec.Destination, s.db)
+	if err != nil {
+		http.Error(w, "Cannot get destination cluster", http.StatusBadRequest)
+		return
+	}
+	config, err := destCluster.RawRestConfig()
+	if err != nil {
+		http.Error(w, "Cannot get raw cluster config", http.StatusBadRequest)
+		return
+	}
+	kubeClientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		http.Error(w, "Cannot initialize kubeclient", http.StatusBadRequest)
+		return
+	}
+
+	resourceTree, err := s.appResourceTreeFn(ctx, a)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if !resourceExists(resourceTree.Nodes, kind, name, namespace) {
+		http.Error(w, "Resource doesn't belong to specified app", http.StatusBadRequest)
+		return
+	}
+
+	podName, targetPort, err := resolvePortForwardTarget(ctx, kubeClientset, resourceTree.Nodes, namespace, kind, name, port)
+	if err != nil {
+		fieldLog.Warnf("Cannot resolve the port to forward to: %s", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var token string
+	if !s.terminalOptions.DisableAuth {
+		token, err = getToken(r)
+		if err != nil {
+			http.Error(w, "Cannot get the token of the session", http.StatusUnauthorized)
+			return
+		}
+	}
+
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// the upgrader has already replied with an error
+		return
+	}
+	stream := &sessionValidatingStream{
+		ReadWriteCloser: utilio.NewWebSocketStream(conn),
+		validate: func() error {
+			return s.validateSession(ctx, token, appRBACName)
+		},
+	}
+	defer utilio.Close(stream)
+
+	fieldLog.Infof("forwarding connection to port %d of pod %s", targetPort, podName)
+	if err := kubeutil.ForwardPortStream(kubeClientset, config, namespace, podName, targetPort, stream); err != nil {
+		fieldLog.Warnf("port forwarding failed: %s", err)
+	}
+}
+
+// enforcePermissions checks whether the user is allowed to forward ports to the workloads of the application
+func (s *portForwardHandler) enforcePermissions(ctx context.Context, appRBACName string) error {
+	if err := s.terminalOptions.Enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionGet, appRBACName); err != nil {
+		return err
+	}
+	return s.terminalOptions.Enf.EnforceErr(ctx.Value("claims"), rbac.ResourceExec, rbac.ActionCreate, appRBACName)
+}
+
+// validateSession checks, like the terminal does, whether the session of the user is still valid and the user is
+// still allowed to forward ports
+func (s *portForwardHandler) validateSession(ctx context.Context, token string, appRBACName string) error {
+	if s.terminalOptions.DisableAuth {
+		return nil
+	}
+	// a refreshed token does not matter, since the connection is authenticated with the previous one, which is
+	// still valid
+	if _, _, err := s.sessionManager.VerifyToken(token); err != nil {
+		return fmt.Errorf("session is no longer valid: %w", err)
+	}
+	return s.enforcePermissions(ctx, appRBACName)
+}
+
+// sessionValidatingStream validates the session of the user before each read from the stream, so that the forwarded
+// connection is closed once the session has expired or has been revoked
+type sessionValidatingStream struct {
+	io.ReadWriteCloser
+	validate func() error
+}
+
+func (s *sessionValidatingStream) Read(p []byte) (int, error) {
+	if err := s.validate(); err != nil {
+		return 0, err
+	}
+	return s.ReadWriteCloser.Read(p)
+}
+
+func resourceExists(treeNodes []appv1.ResourceNode, kind, name, namespace string) bool {
+	for _, treeNode := range treeNodes {
+		if treeNode.Kind == kind && treeNode.Group == "" && treeNode.UID != "" &&
+			treeNode.Name == name && treeNode.Namespace == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// resolvePortForwardTarget returns the pod and its port to forward connections to. The given port of the pod or service
+// is either a number or the name of a port. Connections to a service are forwarded to a ready pod of the service which
+// belongs to the resource tree of the application.
+func resolvePortForwardTarget(ctx context.Context, kubeClientset kubernetes.Interface, treeNodes []appv1.ResourceNode, namespace, kind, name, port string) (string, int, error) {
+	if kind == kube.PodKind {
+		pod, err := kubeClientset.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return "", 0, fmt.Errorf("cannot get pod %s: %w", name, err)
+		}
+		if pod.Status.Phase != corev1.PodRunning {
+			return "", 0, fmt.Errorf("pod %s is not running", name)
+		}
+		podPort, err := containerPortNumber(pod, intstr.Parse(port))
+		return pod.Name, podPort, err
+	}
+
+	svc, err := kubeClientset.CoreV1().Services(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", 0, fmt.Errorf("cannot get service %s: %w", name, err)
+	}
+	var servicePort *corev1.ServicePort
+	for i := range svc.Spec.Ports {
+		if svc.Spec.Ports[i].Name == port || strconv.Itoa(int(svc.Spec.Ports[i].Port)) == port {
+			servicePort = &svc.Spec.Ports[i]
+			break
+		}
+	}
+	if servicePort == nil {
+		return "", 0, fmt.Errorf("service %s has no port %s", name, port)
+	}
+	if len(svc.Spec.Selector) == 0 {
+		return "", 0, fmt.Errorf("service %s has no selector", name)
+	}
+	targetPort := servicePort.TargetPort
+	if targetPort.Type == intstr.Int && targetPort.IntVal == 0 {
+		targetPort = intstr.FromInt32(servicePort.Port)
+	}
+	pods, err := kubeClientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: labels.SelectorFromSet(svc.Spec.Selector).String()})
+	if err != nil {
+		return "", 0, fmt.Errorf("cannot list pods of service %s: %w", name, err)
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Status.Phase == corev1.PodRunning && podutils.IsPodReady(pod) && resourceExists(treeNodes, kube.PodKind, pod.Name, namespace) {
+			podPort, err := containerPortNumber(pod, targetPort)
+			return pod.Name, podPort, err
+		}
+	}
+	return "", 0, fmt.Errorf("service %s has no ready pods which belong to the application", name)
+}
+
+// containerPortNumber returns the number of the given port of the pod, which may refer to a named container port
+func containerPortNumber(pod *corev1.Pod, port intstr.IntOrString) (int, error) {
+	if port.Type == intstr.Int {
+		if port.IntVal <= 0 || port.IntVal > 65535 {
+			return 0, fmt.Errorf("port %d is not valid", port.IntVal)
+		}
+		return port.IntValue(), nil
+	}
+	for _, container := range pod.Spec.Containers {
+		for _, containerPort := range container.Ports {
+			if containerPort.Name == port.StrVal {
+				return int(containerPort.ContainerPort), nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("pod %s has no port named %s", pod.Name, port.StrVal)
+}
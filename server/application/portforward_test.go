@@ -0,0 +1,153 @@
+package application
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/test"
+	"github.com/argoproj/argo-cd/v3/util/assets"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
+	"github.com/argoproj/argo-cd/v3/util/security"
+	"github.com/argoproj/argo-cd/v3/util/session"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+func TestPortForwardHandler_ServeHTTP_invalid_params(t *testing.T) {
+	for _, query := range []string{
+		"appName=valid&projectName=valid&namespace=valid&kind=Pod&name=valid",
+		"appName=valid&projectName=valid&namespace=valid&kind=Deployment&name=valid&port=80",
+		"appName=valid&projectName=valid&namespace=valid&kind=Pod&name=invalid%20name&port=80",
+		"appName=valid&projectName=valid&namespace=invalid%20name&kind=Pod&name=valid&port=80",
+	} {
+		t.Run(query, func(t *testing.T) {
+			handler := portForwardHandler{}
+			request := httptest.NewRequest(http.MethodGet, "https://argocd.example.com/port-forward?"+query, http.NoBody)
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+			assert.Equal(t, http.StatusBadRequest, recorder.Result().StatusCode)
+		})
+	}
+}
+
+func TestPortForwardHandler_ServeHTTP_disallowed_namespace(t *testing.T) {
+	handler := portForwardHandler{namespace: "argocd", enabledNamespaces: []string{"allowed"}}
+	request := httptest.NewRequest(http.MethodGet, "https://argocd.example.com/port-forward?appName=valid&projectName=valid&namespace=test&kind=Service&name=valid&port=80&appNamespace=disallowed", http.NoBody)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	response := recorder.Result()
+	assert.Equal(t, http.StatusForbidden, response.StatusCode)
+	assert.Equal(t, security.NamespaceNotPermittedError("disallowed").Error()+"\n", recorder.Body.String())
+}
+
+func TestResolvePortForwardTarget(t *testing.T) {
+	newPod := func(name string, phase corev1.PodPhase, ready corev1.ConditionStatus) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: map[string]string{"app": "web"}},
+			Spec: corev1.PodSpec{Containers: []corev1.Container{{
+				Name:  "web",
+				Ports: []corev1.ContainerPort{{Name: "http", ContainerPort: 8080}},
+			}}},
+			Status: corev1.PodStatus{Phase: phase, Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: ready}}},
+		}
+	}
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{"app": "web"},
+			Ports: []corev1.ServicePort{
+				{Name: "http", Port: 80, TargetPort: intstr.FromString("http")},
+				{Name: "metrics", Port: 9090},
+			},
+		},
+	}
+	kubeClientset := fake.NewClientset(
+		newPod("web-1", corev1.PodRunning, corev1.ConditionFalse),
+		newPod("web-2", corev1.PodRunning, corev1.ConditionTrue),
+		newPod("web-3", corev1.PodPending, corev1.ConditionFalse),
+		svc,
+	)
+	nodes := []appv1.ResourceNode{
+		{ResourceRef: appv1.ResourceRef{Version: "v1", Kind: "Service", Namespace: "default", Name: "web", UID: "1"}},
+		{ResourceRef: appv1.ResourceRef{Version: "v1", Kind: "Pod", Namespace: "default", Name: "web-1", UID: "2"}},
+		{ResourceRef: appv1.ResourceRef{Version: "v1", Kind: "Pod", Namespace: "default", Name: "web-2", UID: "3"}},
+	}
+	ctx := context.Background()
+
+	t.Run("PodPortNumber", func(t *testing.T) {
+		pod, port, err := resolvePortForwardTarget(ctx, kubeClientset, nodes, "default", "Pod", "web-1", "8081")
+		require.NoError(t, err)
+		assert.Equal(t, "web-1", pod)
+		assert.Equal(t, 8081, port)
+	})
+	t.Run("PodPortName", func(t *testing.T) {
+		_, port, err := resolvePortForwardTarget(ctx, kubeClientset, nodes, "default", "Pod", "web-1", "http")
+		require.NoError(t, err)
+		assert.Equal(t, 8080, port)
+	})
+	t.Run("PodNotRunning", func(t *testing.T) {
+		_, _, err := resolvePortForwardTarget(ctx, kubeClientset, nodes, "default", "Pod", "web-3", "8080")
+		assert.ErrorContains(t, err, "is not running")
+	})
+	t.Run("PodUnknownPortName", func(t *testing.T) {
+		_, _, err := resolvePortForwardTarget(ctx, kubeClientset, nodes, "default", "Pod", "web-1", "grpc")
+		assert.ErrorContains(t, err, "has no port named grpc")
+	})
+	t.Run("ServiceNamedTargetPort", func(t *testing.T) {
+		pod, port, err := resolvePortForwardTarget(ctx, kubeClientset, nodes, "default", "Service", "web", "80")
+		require.NoError(t, err)
+		assert.Equal(t, "web-2", pod)
+		assert.Equal(t, 8080, port)
+	})
+	t.Run("ServiceDefaultTargetPort", func(t *testing.T) {
+		_, port, err := resolvePortForwardTarget(ctx, kubeClientset, nodes, "default", "Service", "web", "metrics")
+		require.NoError(t, err)
+		assert.Equal(t, 9090, port)
+	})
+	t.Run("ServiceUnknownPort", func(t *testing.T) {
+		_, _, err := resolvePortForwardTarget(ctx, kubeClientset, nodes, "default", "Service", "web", "443")
+		assert.ErrorContains(t, err, "has no port 443")
+	})
+	t.Run("ServiceReadyPodNotInResourceTree", func(t *testing.T) {
+		_, _, err := resolvePortForwardTarget(ctx, kubeClientset, nodes[:2], "default", "Service", "web", "80")
+		assert.ErrorContains(t, err, "has no ready pods which belong to the application")
+	})
+}
+
+func TestPortForwardHandler_validateSession(t *testing.T) {
+	kubeclientset := fake.NewClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "argocd-cm", Namespace: testNamespace, Labels: map[string]string{"app.kubernetes.io/part-of": "argocd"}},
+	}, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "argocd-secret", Namespace: testNamespace},
+		Data:       map[string][]byte{"server.secretkey": []byte("test")},
+	})
+	settingsMgr := settings.NewSettingsManager(t.Context(), kubeclientset, testNamespace)
+	sessionMgr := session.NewSessionManager(settingsMgr, test.NewFakeProjLister(), "", nil, session.NewUserStateStorage(nil))
+	enf := rbac.NewEnforcer(kubeclientset, testNamespace, common.ArgoCDRBACConfigMapName, nil)
+	enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+	enf.SetDefaultRole("role:admin")
+	ctx := context.WithValue(t.Context(), "claims", &jwt.RegisteredClaims{Subject: "admin"})
+
+	handler := &portForwardHandler{sessionManager: sessionMgr, terminalOptions: &TerminalOptions{Enf: enf}}
+	token, err := sessionMgr.Create("admin:login", 0, "123")
+	require.NoError(t, err)
+	require.NoError(t, handler.validateSession(ctx, token, "default/my-app"))
+	require.ErrorContains(t, handler.validateSession(ctx, "invalid", "default/my-app"), "session is no longer valid")
+
+	enf.SetDefaultRole("")
+	require.Error(t, handler.validateSession(ctx, token, "default/my-app"))
+
+	handler.terminalOptions.DisableAuth = true
+	require.NoError(t, handler.validateSession(ctx, "invalid", "default/my-app"))
+}
@@ -0,0 +1,179 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
+)
+
+// podMetricsGVR is the resource of the pod metrics which metrics-server serves
+var podMetricsGVR = schema.GroupVersionResource{Group: "metrics.k8s.io", Version: "v1beta1", Resource: "pods"}
+
+// ResourceUsage returns the CPU and memory usage of the workloads of an application. The usage of the pods of the
+// application is queried from the metrics-server of the destination cluster and summed up by the top-level resource
+// of the resource tree which the pods belong to, e.g. their deployment.
+func (s *Server) ResourceUsage(ctx context.Context, q *application.ApplicationResourceUsageQuery) (*application.ApplicationResourceUsageResponse, error) {
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+	tree, err := s.getAppResources(ctx, a)
+	if err != nil {
+		return nil, fmt.Errorf("error getting app resource tree: %w", err)
+	}
+	config, err := s.getApplicationClusterConfig(ctx, a)
+	if err != nil {
+		return nil, fmt.Errorf("error getting application cluster config: %w", err)
+	}
+
+	dynamicClient, err := s.kubectl.NewDynamicClient(config)
+	if err != nil {
+		return nil, fmt.Errorf("error creating dynamic client: %w", err)
+	}
+
+	// the pod metrics are listed once per namespace and joined with the pods of the resource tree
+	metricsByPod := make(map[kube.ResourceKey]*unstructured.Unstructured)
+	listErrs := make(map[string]error)
+	usageByWorkload := make(map[kube.ResourceKey]*application.WorkloadResourceUsage)
+	var pods, missing int
+	var missingErr error
+	for _, node := range tree.Nodes {
+		if node.Kind != kube.PodKind || node.Group != "" || node.UID == "" {
+			continue
+		}
+		pods++
+		listErr, listed := listErrs[node.Namespace]
+		if !listed {
+			listErr = listPodMetrics(ctx, dynamicClient, node.Namespace, metricsByPod)
+			listErrs[node.Namespace] = listErr
+		}
+		err := listErr
+		metrics := metricsByPod[kube.NewResourceKey("", kube.PodKind, node.Namespace, node.Name)]
+		if err == nil && metrics == nil {
+			err = fmt.Errorf("no metrics for pod %s/%s", node.Namespace, node.Name)
+		}
+		var cpuMillis, memoryBytes int64
+		if err == nil {
+			cpuMillis, memoryBytes, err = podMetricsUsage(metrics)
+		}
+		if err != nil {
+			log.WithFields(log.Fields{"application": a.QualifiedName(), "pod": node.Name}).Debugf("Failed to get pod metrics: %v", err)
+			missing++
+			if missingErr == nil {
+				missingErr = err
+			}
+			continue
+		}
+		workload := findWorkloadNode(tree, node)
+		key := kube.NewResourceKey(workload.Group, workload.Kind, workload.Namespace, workload.Name)
+		usage, ok := usageByWorkload[key]
+		if !ok {
+			usage = &application.WorkloadResourceUsage{
+				Group:       ptr.To(workload.Group),
+				Kind:        ptr.To(workload.Kind),
+				Namespace:   ptr.To(workload.Namespace),
+				Name:        ptr.To(workload.Name),
+				Pods:        ptr.To(int64(0)),
+				CpuMillis:   ptr.To(int64(0)),
+				MemoryBytes: ptr.To(int64(0)),
+			}
+			usageByWorkload[key] = usage
+		}
+		*usage.Pods++
+		*usage.CpuMillis += cpuMillis
+		*usage.MemoryBytes += memoryBytes
+	}
+
+	keys := make([]kube.ResourceKey, 0, len(usageByWorkload))
+	for key := range usageByWorkload {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].String() < keys[j].String()
+	})
+	res := &application.ApplicationResourceUsageResponse{}
+	for _, key := range keys {
+		res.Workloads = append(res.Workloads, usageByWorkload[key])
+	}
+	if missing > 0 {
+		res.Message = ptr.To(fmt.Sprintf("the usage of %d of %d pods is not available, is metrics-server installed in the destination cluster? %v", missing, pods, missingErr))
+	}
+	return res, nil
+}
+
+// listPodMetrics adds the metrics of the pods of the given namespace to metricsByPod, keyed by their pod
+func listPodMetrics(ctx context.Context, dynamicClient dynamic.Interface, namespace string, metricsByPod map[kube.ResourceKey]*unstructured.Unstructured) error {
+	list, err := dynamicClient.Resource(podMetricsGVR).Namespace(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("error listing the pod metrics of namespace %s: %w", namespace, err)
+	}
+	for i := range list.Items {
+		metrics := &list.Items[i]
+		metricsByPod[kube.NewResourceKey("", kube.PodKind, metrics.GetNamespace(), metrics.GetName())] = metrics
+	}
+	return nil
+}
+
+// findWorkloadNode returns the top-level resource of the resource tree which the node belongs to, by following the
+// parent references of the node
+func findWorkloadNode(tree *v1alpha1.ApplicationTree, node v1alpha1.ResourceNode) v1alpha1.ResourceNode {
+	visited := map[string]bool{node.UID: true}
+	for len(node.ParentRefs) > 0 {
+		ref := node.ParentRefs[0]
+		parent := tree.FindNode(ref.Group, ref.Kind, ref.Namespace, ref.Name)
+		if parent == nil || visited[parent.UID] {
+			break
+		}
+		visited[parent.UID] = true
+		node = *parent
+	}
+	return node
+}
+
+// podMetricsUsage returns the CPU usage in millicores and the memory usage in bytes summed up over the containers of
+// a pod metrics resource
+func podMetricsUsage(metrics *unstructured.Unstructured) (int64, int64, error) {
+	containers, _, err := unstructured.NestedSlice(metrics.Object, "containers")
+	if err != nil {
+		return 0, 0, fmt.Errorf("error reading the containers of the pod metrics: %w", err)
+	}
+	var cpuMillis, memoryBytes int64
+	for _, c := range containers {
+		container, ok := c.(map[string]any)
+		if !ok {
+			continue
+		}
+		usage, _, err := unstructured.NestedStringMap(container, "usage")
+		if err != nil {
+			return 0, 0, fmt.Errorf("error reading the usage of the pod metrics: %w", err)
+		}
+		if cpu, ok := usage["cpu"]; ok {
+			q, err := resource.ParseQuantity(cpu)
+			if err != nil {
+				return 0, 0, fmt.Errorf("invalid CPU usage %q: %w", cpu, err)
+			}
+			cpuMillis += q.MilliValue()
+		}
+		if memory, ok := usage["memory"]; ok {
+			q, err := resource.ParseQuantity(memory)
+			if err != nil {
+				return 0, 0, fmt.Errorf("invalid memory usage %q: %w", memory, err)
+			}
+			memoryBytes += q.Value()
+		}
+	}
+	return cpuMillis, memoryBytes, nil
+}
@@ -2,6 +2,7 @@ package application
 
 import (
 	"context"
+	"errors"
 	"io"
 	"net/http"
 	"time"
@@ -16,6 +17,7 @@ import (
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
 	cmdutil "k8s.io/kubectl/pkg/cmd/util"
 
 	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
@@ -75,6 +77,11 @@ type GetSettingsFunc func() (*settings.ArgoCDSettings, error)
 // WithFeatureFlagMiddleware is an HTTP middleware to verify if the terminal
 // feature is enabled before invoking the main handler
 func (s *terminalHandler) WithFeatureFlagMiddleware(getSettings GetSettingsFunc) http.Handler {
+	return withExecFeatureFlag(getSettings, s)
+}
+
+// withExecFeatureFlag returns a handler which replies with a not found error unless the exec feature is enabled
+func withExecFeatureFlag(getSettings GetSettingsFunc, next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		argocdSettings, err := getSettings()
 		if err != nil {
@@ -86,7 +93,7 @@ func (s *terminalHandler) WithFeatureFlagMiddleware(getSettings GetSettingsFunc)
 			w.WriteHeader(http.StatusNotFound)
 			return
 		}
-		s.ServeHTTP(w, r)
+		next.ServeHTTP(w, r)
 	})
 }
 
@@ -142,6 +149,9 @@ func (s *terminalHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	shell := q.Get("shell") // No need to validate. Will only be used if it's in the allow-list.
+	// An explicit command is run instead of a shell. It needs no allow-list, since the exec permission already grants
+	// the ability to run arbitrary commands through any of the allowed shells.
+	command := q["command"]
 
 	ctx := r.Context()
 
@@ -240,6 +250,20 @@ func (s *terminalHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// load balancers which may close an idle connection after some period of time
 	go session.StartKeepalives(time.Second * 5)
 
+	if len(command) > 0 {
+		// the command is not logged, since its arguments may contain secrets
+		fieldLog.Info("terminal command starting")
+		err = startProcess(kubeClientset, config, namespace, podName, container, command, session)
+		var exitErr utilexec.ExitError
+		if err != nil && !errors.As(err, &exitErr) {
+			// the WebSocket connection is already established, so report the failure through it
+			_, _ = session.Write([]byte(err.Error() + "\r\n"))
+		}
+		_ = session.WriteExitCode(commandExitCode(err))
+		session.Close()
+		return
+	}
+
 	if isValidShell(s.allowedShells, shell) {
 		cmd := []string{shell}
 		err = startProcess(kubeClientset, config, namespace, podName, container, cmd, session)
@@ -287,6 +311,20 @@ type TerminalMessage struct {
 	Data      string `json:"data"`
 	Rows      uint16 `json:"rows"`
 	Cols      uint16 `json:"cols"`
+	// ExitCode is the exit code of the command, sent with the "exit" operation once the command has finished
+	ExitCode int `json:"exitCode,omitempty"`
+}
+
+// commandExitCode returns the exit code of a command executed in a container, given the error returned by startProcess
+func commandExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	var exitErr utilexec.ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitStatus()
+	}
+	return 1
 }
 
 // TerminalCommand is the struct for websocket commands,For example you need ask client to reconnect
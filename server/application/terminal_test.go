@@ -1,6 +1,8 @@
 package application
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -8,6 +10,7 @@ import (
 
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	"github.com/stretchr/testify/assert"
+	utilexec "k8s.io/client-go/util/exec"
 
 	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/argo"
@@ -227,3 +230,9 @@ func TestTerminalHandler_ServeHTTP_disallowed_namespace(t *testing.T) {
 	assert.Equal(t, http.StatusForbidden, response.StatusCode)
 	assert.Equal(t, security.NamespaceNotPermittedError("disallowed").Error()+"\n", recorder.Body.String())
 }
+
+func TestCommandExitCode(t *testing.T) {
+	assert.Equal(t, 0, commandExitCode(nil))
+	assert.Equal(t, 2, commandExitCode(fmt.Errorf("command failed: %w", utilexec.CodeExitError{Err: errors.New("exit status 2"), Code: 2})))
+	assert.Equal(t, 1, commandExitCode(errors.New("unable to upgrade connection")))
+}
@@ -241,6 +241,24 @@ func (t *terminalSession) Write(p []byte) (int, error) {
 	return len(p), nil
 }
 
+// WriteExitCode sends the exit code of the executed command to the client
+func (t *terminalSession) WriteExitCode(code int) error {
+	msg, err := json.Marshal(TerminalMessage{
+		Operation: "exit",
+		ExitCode:  code,
+	})
+	if err != nil {
+		return err
+	}
+	t.writeLock.Lock()
+	err = t.wsConn.WriteMessage(websocket.TextMessage, msg)
+	t.writeLock.Unlock()
+	if err != nil {
+		log.Errorf("write message err: %v", err)
+	}
+	return err
+}
+
 // Close closes websocket connection
 func (t *terminalSession) Close() error {
 	return t.wsConn.Close()
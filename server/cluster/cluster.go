@@ -12,7 +12,7 @@ import (
 	"google.golang.org/grpc/status"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
-	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/cluster"
@@ -22,23 +22,26 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/clusterauth"
 	"github.com/argoproj/argo-cd/v3/util/db"
 	"github.com/argoproj/argo-cd/v3/util/rbac"
+	"github.com/argoproj/argo-cd/v3/util/settings"
 )
 
 // Server provides a Cluster service
 type Server struct {
-	db      db.ArgoDB
-	enf     *rbac.Enforcer
-	cache   *servercache.Cache
-	kubectl kube.Kubectl
+	db          db.ArgoDB
+	enf         *rbac.Enforcer
+	cache       *servercache.Cache
+	kubectl     kube.Kubectl
+	settingsMgr *settings.SettingsManager
 }
 
 // NewServer returns a new instance of the Cluster service
-func NewServer(db db.ArgoDB, enf *rbac.Enforcer, cache *servercache.Cache, kubectl kube.Kubectl) *Server {
+func NewServer(db db.ArgoDB, enf *rbac.Enforcer, cache *servercache.Cache, kubectl kube.Kubectl, settingsMgr *settings.SettingsManager) *Server {
 	return &Server{
-		db:      db,
-		enf:     enf,
-		cache:   cache,
-		kubectl: kubectl,
+		db:          db,
+		enf:         enf,
+		cache:       cache,
+		kubectl:     kubectl,
+		settingsMgr: settingsMgr,
 	}
 }
 
@@ -291,6 +294,9 @@ var clusterFieldsByPath = map[string]func(updated *appv1.Cluster, existing *appv
 	"project": func(updated *appv1.Cluster, existing *appv1.Cluster) {
 		updated.Project = existing.Project
 	},
+	"rotateAuthInterval": func(updated *appv1.Cluster, existing *appv1.Cluster) {
+		updated.Config.RotateAuthInterval = existing.Config.RotateAuthInterval
+	},
 }
 
 // Update updates a cluster
@@ -318,6 +324,10 @@ func (s *Server) Update(ctx context.Context, q *cluster.ClusterUpdateRequest) (*
 			}
 		}
 		q.Cluster = c
+	} else {
+		// the auth rotation status is maintained by the application controller
+		q.Cluster.AuthRotatedAt = c.AuthRotatedAt
+		q.Cluster.AuthRotationError = c.AuthRotationError
 	}
 	clusterRESTConfig, err := q.Cluster.RESTConfig()
 	if err != nil {
@@ -413,59 +423,39 @@ func (s *Server) RotateAuth(ctx context.Context, q *cluster.ClusterQuery) (*clus
 	for _, server := range servers {
 		logCtx := log.WithField("cluster", server)
 		logCtx.Info("Rotating auth")
-		restCfg, err := clust.RESTConfig()
-		if err != nil {
-			return nil, fmt.Errorf("failed to get REST config for cluster: %w", err)
-		}
-		if restCfg.BearerToken == "" {
+		if clust.Config.BearerToken == "" {
 			return nil, status.Errorf(codes.InvalidArgument, "Cluster '%s' does not use bearer token authentication", server)
 		}
-
-		claims, err := clusterauth.ParseServiceAccountToken(restCfg.BearerToken)
-		if err != nil {
-			return nil, fmt.Errorf("failed to parse service account token: %w", err)
-		}
-		kubeclientset, err := kubernetes.NewForConfig(restCfg)
-		if err != nil {
-			return nil, fmt.Errorf("failed to create Kubernetes clientset: %w", err)
-		}
-		newSecret, err := clusterauth.GenerateNewClusterManagerSecret(kubeclientset, claims)
-		if err != nil {
-			return nil, fmt.Errorf("failed to generate new cluster manager secret: %w", err)
-		}
-		// we are using token auth, make sure we don't store client-cert information
-		clust.Config.KeyData = nil
-		clust.Config.CertData = nil
-		clust.Config.BearerToken = string(newSecret.Data["token"])
-
-		clusterRESTConfig, err := clust.RESTConfig()
-		if err != nil {
-			return nil, fmt.Errorf("failed to get REST config for cluster: %w", err)
-		}
-		// Test the token we just created before persisting it
-		serverVersion, err := s.kubectl.GetServerVersion(clusterRESTConfig)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get server version: %w", err)
-		}
-		_, err = s.db.UpdateCluster(ctx, clust)
-		if err != nil {
-			return nil, fmt.Errorf("failed to update cluster in database: %w", err)
-		}
-		err = s.cache.SetClusterInfo(clust.Server, &appv1.ClusterInfo{
-			ServerVersion: serverVersion,
-			ConnectionState: appv1.ConnectionState{
-				Status:     appv1.ConnectionStatusSuccessful,
-				ModifiedAt: &metav1.Time{Time: time.Now()},
-			},
+		var serverVersion string
+		err := clusterauth.RotateClusterToken(clust, func(config *rest.Config) error {
+			var err error
+			serverVersion, err = s.kubectl.GetServerVersion(config)
+			if err != nil {
+				return fmt.Errorf("failed to get server version: %w", err)
+			}
+			return nil
+		}, func(clust *appv1.Cluster) error {
+			clust.AuthRotatedAt = &metav1.Time{Time: time.Now()}
+			clust.AuthRotationError = ""
+			if _, err := s.db.UpdateCluster(ctx, clust); err != nil {
+				return fmt.Errorf("failed to update cluster in database: %w", err)
+			}
+			err := s.cache.SetClusterInfo(clust.Server, &appv1.ClusterInfo{
+				ServerVersion: serverVersion,
+				ConnectionState: appv1.ConnectionState{
+					Status:     appv1.ConnectionStatusSuccessful,
+					ModifiedAt: &metav1.Time{Time: time.Now()},
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to set cluster info in cache: %w", err)
+			}
+			return nil
 		})
 		if err != nil {
-			return nil, fmt.Errorf("failed to set cluster info in cache: %w", err)
-		}
-		err = clusterauth.RotateServiceAccountSecrets(kubeclientset, claims, newSecret)
-		if err != nil {
-			return nil, fmt.Errorf("failed to rotate service account secrets: %w", err)
+			return nil, err
 		}
-		logCtx.Infof("Rotated auth (old: %s, new: %s)", claims.SecretName, newSecret.Name)
+		logCtx.Info("Rotated auth")
 	}
 	return &cluster.ClusterResponse{}, nil
 }
@@ -230,7 +230,7 @@ func TestUpdateCluster_RejectInvalidParams(t *testing.T) {
 	_ = enf.SetBuiltinPolicy(`p, role:test, clusters, *, https://127.0.0.1, allow
 p, role:test, clusters, *, allowed-project/*, allow`)
 	enf.SetDefaultRole("role:test")
-	server := NewServer(db, enf, newServerInMemoryCache(), &kubetest.MockKubectlCmd{})
+	server := NewServer(db, enf, newServerInMemoryCache(), &kubetest.MockKubectlCmd{}, nil)
 
 	for _, c := range testCases {
 		cc := c
@@ -260,7 +260,7 @@ func TestGetCluster_UrlEncodedName(t *testing.T) {
 
 	db.On("ListClusters", mock.Anything).Return(&mockClusterList, nil)
 
-	server := NewServer(db, newNoopEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{})
+	server := NewServer(db, newNoopEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{}, nil)
 
 	localCluster, err := server.Get(t.Context(), &cluster.ClusterQuery{
 		Id: &cluster.ClusterID{
@@ -290,7 +290,7 @@ func TestGetCluster_NameWithUrlEncodingButShouldNotBeUnescaped(t *testing.T) {
 
 	db.On("ListClusters", mock.Anything).Return(&mockClusterList, nil)
 
-	server := NewServer(db, newNoopEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{})
+	server := NewServer(db, newNoopEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{}, nil)
 
 	localCluster, err := server.Get(t.Context(), &cluster.ClusterQuery{
 		Id: &cluster.ClusterID{
@@ -320,7 +320,7 @@ func TestGetCluster_CannotSetCADataAndInsecureTrue(t *testing.T) {
 	}
 	clientset := getClientset(nil, testNamespace)
 	db := db.NewDB(testNamespace, settings.NewSettingsManager(t.Context(), clientset, testNamespace), clientset)
-	server := NewServer(db, newNoopEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{})
+	server := NewServer(db, newNoopEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{}, nil)
 
 	t.Run("Create Fails When CAData is Set and Insecure is True", func(t *testing.T) {
 		_, err := server.Create(t.Context(), &cluster.ClusterCreateRequest{
@@ -343,11 +343,14 @@ func TestUpdateCluster_NoFieldsPaths(t *testing.T) {
 	db := &dbmocks.ArgoDB{}
 	var updated *v1alpha1.Cluster
 
+	rotatedAt := metav1.Now()
 	clusters := []v1alpha1.Cluster{
 		{
-			Name:       "minikube",
-			Server:     "https://127.0.0.1",
-			Namespaces: []string{"default", "kube-system"},
+			Name:              "minikube",
+			Server:            "https://127.0.0.1",
+			Namespaces:        []string{"default", "kube-system"},
+			AuthRotatedAt:     &rotatedAt,
+			AuthRotationError: "failed to verify the new token",
 		},
 	}
 
@@ -362,7 +365,7 @@ func TestUpdateCluster_NoFieldsPaths(t *testing.T) {
 		return true
 	})).Return(&v1alpha1.Cluster{}, nil)
 
-	server := NewServer(db, newNoopEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{})
+	server := NewServer(db, newNoopEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{}, nil)
 
 	_, err := server.Update(t.Context(), &cluster.ClusterUpdateRequest{
 		Cluster: &v1alpha1.Cluster{
@@ -375,6 +378,9 @@ func TestUpdateCluster_NoFieldsPaths(t *testing.T) {
 
 	assert.Equal(t, "minikube", updated.Name)
 	assert.Equal(t, []string{"default", "kube-system"}, updated.Namespaces)
+	// the auth rotation status cannot be changed by the request
+	assert.Equal(t, &rotatedAt, updated.AuthRotatedAt)
+	assert.Equal(t, "failed to verify the new token", updated.AuthRotationError)
 }
 
 func TestUpdateCluster_FieldsPathSet(t *testing.T) {
@@ -390,7 +396,7 @@ func TestUpdateCluster_FieldsPathSet(t *testing.T) {
 		return true
 	})).Return(&v1alpha1.Cluster{}, nil)
 
-	server := NewServer(db, newNoopEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{})
+	server := NewServer(db, newNoopEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{}, nil)
 
 	_, err := server.Update(t.Context(), &cluster.ClusterUpdateRequest{
 		Cluster: &v1alpha1.Cluster{
@@ -453,6 +459,19 @@ func TestUpdateCluster_FieldsPathSet(t *testing.T) {
 	assert.Equal(t, "minikube", updated.Name)
 	assert.Equal(t, []string{"default", "kube-system"}, updated.Namespaces)
 	assert.Equal(t, "new-project", updated.Project)
+
+	_, err = server.Update(t.Context(), &cluster.ClusterUpdateRequest{
+		Cluster: &v1alpha1.Cluster{
+			Server: "https://127.0.0.1",
+			Config: v1alpha1.ClusterConfig{RotateAuthInterval: "720h"},
+		},
+		UpdatedFields: []string{"rotateAuthInterval"},
+	})
+
+	require.NoError(t, err)
+
+	assert.Equal(t, "minikube", updated.Name)
+	assert.Equal(t, "720h", updated.Config.RotateAuthInterval)
 }
 
 func TestDeleteClusterByName(t *testing.T) {
@@ -475,7 +494,7 @@ func TestDeleteClusterByName(t *testing.T) {
 		},
 	})
 	db := db.NewDB(testNamespace, settings.NewSettingsManager(t.Context(), clientset, testNamespace), clientset)
-	server := NewServer(db, newNoopEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{})
+	server := NewServer(db, newNoopEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{}, nil)
 
 	t.Run("Delete Fails When Deleting by Unknown Name", func(t *testing.T) {
 		_, err := server.Delete(t.Context(), &cluster.ClusterQuery{
@@ -552,7 +571,7 @@ func TestRotateAuth(t *testing.T) {
 		})
 
 	db := db.NewDB(testNamespace, settings.NewSettingsManager(t.Context(), clientset, testNamespace), clientset)
-	server := NewServer(db, newNoopEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{})
+	server := NewServer(db, newNoopEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{}, nil)
 
 	t.Run("RotateAuth by Unknown Name", func(t *testing.T) {
 		_, err := server.RotateAuth(t.Context(), &cluster.ClusterQuery{
@@ -634,7 +653,7 @@ func TestListCluster(t *testing.T) {
 
 	db.On("ListClusters", mock.Anything).Return(&mockClusterList, nil)
 
-	s := NewServer(db, newNoopEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{})
+	s := NewServer(db, newNoopEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{}, nil)
 
 	tests := []struct {
 		name    string
@@ -737,7 +756,7 @@ func TestGetClusterAndVerifyAccess(t *testing.T) {
 
 		db.On("ListClusters", mock.Anything).Return(&mockClusterList, nil)
 
-		server := NewServer(db, newNoopEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{})
+		server := NewServer(db, newNoopEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{}, nil)
 		localCluster, err := server.getClusterAndVerifyAccess(t.Context(), &cluster.ClusterQuery{
 			Name: "test/not-exists",
 		}, rbac.ActionGet)
@@ -763,7 +782,7 @@ func TestGetClusterAndVerifyAccess(t *testing.T) {
 
 		db.On("ListClusters", mock.Anything).Return(&mockClusterList, nil)
 
-		server := NewServer(db, newEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{})
+		server := NewServer(db, newEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{}, nil)
 		localCluster, err := server.getClusterAndVerifyAccess(t.Context(), &cluster.ClusterQuery{
 			Name: "test/ing",
 		}, rbac.ActionGet)
@@ -791,7 +810,7 @@ func TestNoClusterEnumeration(t *testing.T) {
 	db.On("ListClusters", mock.Anything).Return(&mockClusterList, nil)
 	db.On("GetCluster", mock.Anything, mock.Anything).Return(&mockCluster, nil)
 
-	server := NewServer(db, newEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{})
+	server := NewServer(db, newEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{}, nil)
 
 	t.Run("Get", func(t *testing.T) {
 		_, err := server.Get(t.Context(), &cluster.ClusterQuery{
@@ -856,4 +875,25 @@ func TestNoClusterEnumeration(t *testing.T) {
 		})
 		assert.ErrorIs(t, err, common.PermissionDeniedAPIError, "error message must be _only_ the permission error, to avoid leaking information about cluster existence")
 	})
+
+	t.Run("Diagnose", func(t *testing.T) {
+		_, err := server.Diagnose(t.Context(), &cluster.ClusterQuery{
+			Server: "https://127.0.0.2",
+		})
+		require.ErrorIs(t, err, common.PermissionDeniedAPIError, "error message must be _only_ the permission error, to avoid leaking information about cluster existence")
+
+		_, err = server.Diagnose(t.Context(), &cluster.ClusterQuery{
+			Server: "https://127.0.0.1",
+		})
+		require.ErrorIs(t, err, common.PermissionDeniedAPIError, "error message must be _only_ the permission error, to avoid leaking information about cluster existence")
+
+		enf := rbac.NewEnforcer(fake.NewClientset(test.NewFakeConfigMap()), test.FakeArgoCDNamespace, common.ArgoCDConfigMapName, nil)
+		_ = enf.SetBuiltinPolicy(`p, role:test, clusters, get, *, allow`)
+		enf.SetDefaultRole("role:test")
+		readOnlyServer := NewServer(db, enf, newServerInMemoryCache(), &kubetest.MockKubectlCmd{}, nil)
+		_, err = readOnlyServer.Diagnose(t.Context(), &cluster.ClusterQuery{
+			Server: "https://127.0.0.1",
+		})
+		assert.ErrorIs(t, err, common.PermissionDeniedAPIError, "diagnosing a cluster must require the update permission")
+	})
 }
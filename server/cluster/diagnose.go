@@ -0,0 +1,408 @@
+package cluster
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/cache"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"golang.org/x/sync/errgroup"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/cluster"
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/clusterauth"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
+)
+
+const (
+	diagnosisCheckPassed  = "Passed"
+	diagnosisCheckWarning = "Warning"
+	diagnosisCheckFailed  = "Failed"
+	diagnosisCheckSkipped = "Skipped"
+
+	diagnosisRequestTimeout = 10 * time.Second
+	// diagnosisAccessReviewParallelism limits the number of concurrent access reviews sent to the cluster
+	diagnosisAccessReviewParallelism = 10
+
+	credentialsExpiryWarningPeriod    = 7 * 24 * time.Hour
+	tlsCertificateExpiryWarningPeriod = 30 * 24 * time.Hour
+	clockSkewWarningThreshold         = 30 * time.Second
+	clockSkewFailureThreshold         = 5 * time.Minute
+)
+
+// Diagnose checks the connectivity, credentials and permissions of a cluster. Like invalidating the cache, it requires
+// the update permission, since the checks send requests to the cluster with its credentials.
+func (s *Server) Diagnose(ctx context.Context, q *cluster.ClusterQuery) (*cluster.ClusterDiagnosisReport, error) {
+	clust, err := s.getClusterAndVerifyAccess(ctx, q, rbac.ActionUpdate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify access for cluster: %w", err)
+	}
+	resourcesFilter, err := s.settingsMgr.GetResourcesFilter()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resources filter: %w", err)
+	}
+	respectRBAC, err := s.settingsMgr.RespectRBAC()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get respect rbac setting: %w", err)
+	}
+	return diagnoseCluster(ctx, clust, s.kubectl, resourcesFilter, respectRBAC), nil
+}
+
+// diagnoseCluster runs the connectivity and permission checks against the cluster. The checks which depend on the
+// API server being reachable are skipped if it is not.
+func diagnoseCluster(ctx context.Context, clust *appv1.Cluster, kubectl kube.Kubectl, resourcesFilter kube.ResourceFilter, respectRBAC int) *cluster.ClusterDiagnosisReport {
+	report := &cluster.ClusterDiagnosisReport{Server: clust.Server, Name: clust.Name}
+	config, err := clust.RESTConfig()
+	if err != nil {
+		report.Checks = append(report.Checks, newDiagnosisCheck("Configuration", diagnosisCheckFailed, "Failed to get REST config for cluster: %v", err))
+		return report
+	}
+	httpClient, err := rest.HTTPClientFor(config)
+	if err != nil {
+		report.Checks = append(report.Checks, newDiagnosisCheck("Configuration", diagnosisCheckFailed, "Failed to create HTTP client for cluster: %v", err))
+		return report
+	}
+	httpClient.Timeout = diagnosisRequestTimeout
+
+	probe := probeAPIServer(ctx, httpClient, config.Host)
+	report.ServerVersion = probe.serverVersion
+	report.Checks = append(report.Checks,
+		checkReachability(probe),
+		checkTLS(config, probe, time.Now()),
+		checkCredentials(config, probe, time.Now()),
+		checkClockSkew(probe),
+	)
+	if probe.err != nil {
+		for _, name := range []string{"List namespaces", "Watch resources", "Websocket"} {
+			report.Checks = append(report.Checks, newDiagnosisCheck(name, diagnosisCheckSkipped, "API server is not reachable"))
+		}
+		return report
+	}
+
+	kubeclientset, err := kubernetes.NewForConfigAndClient(config, httpClient)
+	if err != nil {
+		report.Checks = append(report.Checks, newDiagnosisCheck("Configuration", diagnosisCheckFailed, "Failed to create Kubernetes clientset: %v", err))
+		return report
+	}
+	report.Checks = append(report.Checks,
+		checkListNamespaces(ctx, kubeclientset, clust),
+		checkWatchResources(ctx, kubeclientset, kubectl, config, clust, resourcesFilter, respectRBAC),
+		checkWebsocket(ctx, httpClient, config.Host, clust),
+	)
+	return report
+}
+
+func newDiagnosisCheck(name string, status string, format string, args ...any) *cluster.ClusterDiagnosisCheck {
+	return &cluster.ClusterDiagnosisCheck{Name: name, Status: status, Message: fmt.Sprintf(format, args...)}
+}
+
+// apiServerProbe is the outcome of a request to the version endpoint of an API server
+type apiServerProbe struct {
+	response      *http.Response
+	serverVersion string
+	err           error
+	sentAt        time.Time
+	receivedAt    time.Time
+}
+
+func probeAPIServer(ctx context.Context, httpClient *http.Client, host string) *apiServerProbe {
+	probe := &apiServerProbe{}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(host, "/")+"/version", http.NoBody)
+	if err != nil {
+		probe.err = err
+		return probe
+	}
+	probe.sentAt = time.Now()
+	resp, err := httpClient.Do(req)
+	probe.receivedAt = time.Now()
+	if err != nil {
+		probe.err = err
+		return probe
+	}
+	defer utilio.Close(resp.Body)
+	probe.response = resp
+	if resp.StatusCode == http.StatusOK {
+		var info version.Info
+		if err := json.NewDecoder(resp.Body).Decode(&info); err == nil {
+			probe.serverVersion = info.GitVersion
+		}
+	}
+	return probe
+}
+
+func checkReachability(probe *apiServerProbe) *cluster.ClusterDiagnosisCheck {
+	const name = "Reachability"
+	switch {
+	case probe.err != nil:
+		return newDiagnosisCheck(name, diagnosisCheckFailed, "Failed to reach the API server: %v", probe.err)
+	case probe.response.StatusCode >= http.StatusInternalServerError:
+		return newDiagnosisCheck(name, diagnosisCheckFailed, "API server responded with HTTP %d", probe.response.StatusCode)
+	}
+	latency := probe.receivedAt.Sub(probe.sentAt).Round(time.Millisecond)
+	if probe.serverVersion != "" {
+		return newDiagnosisCheck(name, diagnosisCheckPassed, "API server %s responded in %v", probe.serverVersion, latency)
+	}
+	return newDiagnosisCheck(name, diagnosisCheckPassed, "API server responded in %v", latency)
+}
+
+func checkTLS(config *rest.Config, probe *apiServerProbe, now time.Time) *cluster.ClusterDiagnosisCheck {
+	const name = "TLS"
+	if u, err := url.Parse(config.Host); err == nil && u.Scheme != "https" {
+		return newDiagnosisCheck(name, diagnosisCheckWarning, "Connection to the API server is not encrypted")
+	}
+	var verificationErr *tls.CertificateVerificationError
+	if errors.As(probe.err, &verificationErr) {
+		return newDiagnosisCheck(name, diagnosisCheckFailed, "Certificate of the API server is not valid: %v", verificationErr.Err)
+	}
+	if probe.response == nil || probe.response.TLS == nil || len(probe.response.TLS.PeerCertificates) == 0 {
+		return newDiagnosisCheck(name, diagnosisCheckSkipped, "No TLS connection to the API server has been established")
+	}
+	notAfter := probe.response.TLS.PeerCertificates[0].NotAfter
+	switch {
+	case config.Insecure:
+		return newDiagnosisCheck(name, diagnosisCheckWarning, "Certificate of the API server is not validated because the cluster is configured as insecure")
+	case now.Add(tlsCertificateExpiryWarningPeriod).After(notAfter):
+		return newDiagnosisCheck(name, diagnosisCheckWarning, "Certificate of the API server expires at %s", notAfter.Format(time.RFC3339))
+	}
+	return newDiagnosisCheck(name, diagnosisCheckPassed, "Certificate of the API server is valid until %s", notAfter.Format(time.RFC3339))
+}
+
+func checkCredentials(config *rest.Config, probe *apiServerProbe, now time.Time) *cluster.ClusterDiagnosisCheck {
+	const name = "Credentials"
+	if probe.response != nil && probe.response.StatusCode == http.StatusUnauthorized {
+		return newDiagnosisCheck(name, diagnosisCheckFailed, "API server rejected the credentials of the cluster")
+	}
+	var kind string
+	var expiresAt *time.Time
+	switch {
+	case config.BearerToken != "":
+		kind = "Bearer token"
+		claims, err := clusterauth.ParseServiceAccountToken(config.BearerToken)
+		if err != nil {
+			return newDiagnosisCheck(name, diagnosisCheckPassed, "Bearer token is not a JWT, its expiry is unknown")
+		}
+		if claims.ExpiresAt != nil {
+			expiresAt = &claims.ExpiresAt.Time
+		}
+	case len(config.CertData) > 0:
+		kind = "Client certificate"
+		block, _ := pem.Decode(config.CertData)
+		if block == nil {
+			return newDiagnosisCheck(name, diagnosisCheckFailed, "Client certificate is not PEM encoded")
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return newDiagnosisCheck(name, diagnosisCheckFailed, "Failed to parse client certificate: %v", err)
+		}
+		expiresAt = &cert.NotAfter
+	case config.ExecProvider != nil:
+		return newDiagnosisCheck(name, diagnosisCheckPassed, "Credentials are issued on demand by exec provider %s", config.ExecProvider.Command)
+	case config.BearerTokenFile != "":
+		return newDiagnosisCheck(name, diagnosisCheckPassed, "Bearer token is read from %s", config.BearerTokenFile)
+	case config.Username != "":
+		return newDiagnosisCheck(name, diagnosisCheckPassed, "Basic authentication credentials do not expire")
+	default:
+		return newDiagnosisCheck(name, diagnosisCheckWarning, "No credentials are configured for the cluster")
+	}
+	switch {
+	case expiresAt == nil:
+		return newDiagnosisCheck(name, diagnosisCheckPassed, "%s does not expire", kind)
+	case !now.Before(*expiresAt):
+		return newDiagnosisCheck(name, diagnosisCheckFailed, "%s expired at %s", kind, expiresAt.Format(time.RFC3339))
+	case now.Add(credentialsExpiryWarningPeriod).After(*expiresAt):
+		return newDiagnosisCheck(name, diagnosisCheckWarning, "%s expires at %s", kind, expiresAt.Format(time.RFC3339))
+	}
+	return newDiagnosisCheck(name, diagnosisCheckPassed, "%s is valid until %s", kind, expiresAt.Format(time.RFC3339))
+}
+
+func checkClockSkew(probe *apiServerProbe) *cluster.ClusterDiagnosisCheck {
+	const name = "Clock skew"
+	if probe.response == nil {
+		return newDiagnosisCheck(name, diagnosisCheckSkipped, "API server is not reachable")
+	}
+	serverTime, err := http.ParseTime(probe.response.Header.Get("Date"))
+	if err != nil {
+		return newDiagnosisCheck(name, diagnosisCheckSkipped, "API server did not report its time")
+	}
+	// the Date header has a precision of a second, so the local time is compared with the same precision
+	localTime := probe.sentAt.Add(probe.receivedAt.Sub(probe.sentAt) / 2).Truncate(time.Second)
+	skew := serverTime.Sub(localTime)
+	direction := "ahead of"
+	if skew < 0 {
+		skew, direction = -skew, "behind"
+	}
+	switch {
+	case skew >= clockSkewFailureThreshold:
+		return newDiagnosisCheck(name, diagnosisCheckFailed, "Clock of the API server is %v %s Argo CD", skew, direction)
+	case skew >= clockSkewWarningThreshold:
+		return newDiagnosisCheck(name, diagnosisCheckWarning, "Clock of the API server is %v %s Argo CD", skew, direction)
+	case skew > time.Second:
+		return newDiagnosisCheck(name, diagnosisCheckPassed, "Clock of the API server is %v %s Argo CD", skew, direction)
+	}
+	return newDiagnosisCheck(name, diagnosisCheckPassed, "Clocks of the API server and Argo CD are in sync")
+}
+
+func checkListNamespaces(ctx context.Context, kubeclientset kubernetes.Interface, clust *appv1.Cluster) *cluster.ClusterDiagnosisCheck {
+	const name = "List namespaces"
+	if len(clust.Namespaces) > 0 {
+		return newDiagnosisCheck(name, diagnosisCheckSkipped, "Cluster is restricted to namespaces %s", strings.Join(clust.Namespaces, ", "))
+	}
+	allowed, reason, err := reviewAccess(ctx, kubeclientset, authorizationv1.ResourceAttributes{Verb: "list", Resource: "namespaces"})
+	switch {
+	case err != nil:
+		return newDiagnosisCheck(name, diagnosisCheckFailed, "Failed to review access: %v", err)
+	case !allowed:
+		check := newDiagnosisCheck(name, diagnosisCheckFailed, "Credentials are not allowed to list namespaces")
+		if reason != "" {
+			check.Details = []string{reason}
+		}
+		return check
+	}
+	return newDiagnosisCheck(name, diagnosisCheckPassed, "Credentials are allowed to list namespaces")
+}
+
+// checkWatchResources verifies that the resources which are tracked by the application controller can be watched,
+// in each of the namespaces the cluster is restricted to
+func checkWatchResources(ctx context.Context, kubeclientset kubernetes.Interface, kubectl kube.Kubectl, config *rest.Config, clust *appv1.Cluster, resourcesFilter kube.ResourceFilter, respectRBAC int) *cluster.ClusterDiagnosisCheck {
+	const name = "Watch resources"
+	apiResources, err := kubectl.GetAPIResources(config, true, resourcesFilter)
+	if err != nil {
+		return newDiagnosisCheck(name, diagnosisCheckFailed, "Failed to discover API resources: %v", err)
+	}
+	attributes := trackedResourceAttributes(apiResources, clust)
+
+	var mutex sync.Mutex
+	var denied []string
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.SetLimit(diagnosisAccessReviewParallelism)
+	for _, attrs := range attributes {
+		group.Go(func() error {
+			allowed, _, err := reviewAccess(groupCtx, kubeclientset, attrs)
+			if err != nil {
+				return err
+			}
+			if !allowed {
+				mutex.Lock()
+				denied = append(denied, formatResourceAttributes(attrs))
+				mutex.Unlock()
+			}
+			return nil
+		})
+	}
+	if err := group.Wait(); err != nil {
+		return newDiagnosisCheck(name, diagnosisCheckFailed, "Failed to review access: %v", err)
+	}
+	if len(denied) == 0 {
+		return newDiagnosisCheck(name, diagnosisCheckPassed, "Credentials are allowed to watch all %d tracked resources", len(attributes))
+	}
+	status := diagnosisCheckFailed
+	if respectRBAC != cache.RespectRbacDisabled {
+		// resources which cannot be watched are ignored instead of failing the cluster cache
+		status = diagnosisCheckWarning
+	}
+	check := newDiagnosisCheck(name, status, "Credentials are not allowed to watch %d of %d tracked resources", len(denied), len(attributes))
+	slices.Sort(denied)
+	check.Details = denied
+	return check
+}
+
+// trackedResourceAttributes returns the access review attributes for watching the given resources the same way as
+// the cluster cache of the application controller does
+func trackedResourceAttributes(apiResources []kube.APIResourceInfo, clust *appv1.Cluster) []authorizationv1.ResourceAttributes {
+	var attributes []authorizationv1.ResourceAttributes
+	for _, res := range apiResources {
+		attrs := authorizationv1.ResourceAttributes{
+			Verb:     "watch",
+			Group:    res.GroupVersionResource.Group,
+			Version:  res.GroupVersionResource.Version,
+			Resource: res.GroupVersionResource.Resource,
+		}
+		switch {
+		case len(clust.Namespaces) == 0:
+			attributes = append(attributes, attrs)
+		case res.Meta.Namespaced:
+			for _, ns := range clust.Namespaces {
+				attrs.Namespace = ns
+				attributes = append(attributes, attrs)
+			}
+		case clust.ClusterResources:
+			attributes = append(attributes, attrs)
+		}
+	}
+	return attributes
+}
+
+func formatResourceAttributes(attrs authorizationv1.ResourceAttributes) string {
+	resource := attrs.Resource
+	if attrs.Group != "" {
+		resource += "." + attrs.Group
+	}
+	if attrs.Namespace != "" {
+		return fmt.Sprintf("%s %s in namespace %s", attrs.Verb, resource, attrs.Namespace)
+	}
+	return fmt.Sprintf("%s %s", attrs.Verb, resource)
+}
+
+func reviewAccess(ctx context.Context, kubeclientset kubernetes.Interface, attrs authorizationv1.ResourceAttributes) (bool, string, error) {
+	review, err := kubeclientset.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{ResourceAttributes: &attrs},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return false, "", err
+	}
+	return review.Status.Allowed, review.Status.Reason, nil
+}
+
+// checkWebsocket verifies that websocket connections can be upgraded by the API server, and by any proxy in between,
+// by requesting a watch over a websocket
+func checkWebsocket(ctx context.Context, httpClient *http.Client, host string, clust *appv1.Cluster) *cluster.ClusterDiagnosisCheck {
+	const name = "Websocket"
+	path := "/api/v1/namespaces"
+	if len(clust.Namespaces) > 0 {
+		path = fmt.Sprintf("/api/v1/namespaces/%s/configmaps", clust.Namespaces[0])
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(host, "/")+path+"?watch=true&timeoutSeconds=1", http.NoBody)
+	if err != nil {
+		return newDiagnosisCheck(name, diagnosisCheckFailed, "Failed to create websocket request: %v", err)
+	}
+	key := make([]byte, 16)
+	_, _ = rand.Read(key)
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", "websocket")
+	req.Header.Set("Sec-WebSocket-Version", "13")
+	req.Header.Set("Sec-WebSocket-Key", base64.StdEncoding.EncodeToString(key))
+	req.Header.Set("Origin", host)
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return newDiagnosisCheck(name, diagnosisCheckWarning, "Websocket request failed: %v", err)
+	}
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+	utilio.Close(resp.Body)
+	switch resp.StatusCode {
+	case http.StatusSwitchingProtocols:
+		return newDiagnosisCheck(name, diagnosisCheckPassed, "API server accepted a websocket connection")
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return newDiagnosisCheck(name, diagnosisCheckSkipped, "Credentials are not allowed to watch %s, websocket support could not be verified", path)
+	}
+	return newDiagnosisCheck(name, diagnosisCheckWarning, "API server or a proxy in between did not upgrade the connection to a websocket: HTTP %d", resp.StatusCode)
+}
@@ -0,0 +1,246 @@
+package cluster
+
+import (
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/cache"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube/kubetest"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/cluster"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+func newTestBearerToken(t *testing.T, expiresAt *time.Time) string {
+	t.Helper()
+	claims := jwt.RegisteredClaims{Subject: "system:serviceaccount:kube-system:argocd-manager"}
+	if expiresAt != nil {
+		claims.ExpiresAt = jwt.NewNumericDate(*expiresAt)
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("test"))
+	require.NoError(t, err)
+	return token
+}
+
+// newFakeAPIServer serves the version, access review and websocket watch endpoints of an API server. Watching
+// secrets is denied.
+func newFakeAPIServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/version", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"gitVersion":"v1.31.0"}`))
+	})
+	mux.HandleFunc("/apis/authorization.k8s.io/v1/selfsubjectaccessreviews", func(w http.ResponseWriter, r *http.Request) {
+		var review authorizationv1.SelfSubjectAccessReview
+		body, err := io.ReadAll(r.Body)
+		if !assert.NoError(t, err) {
+			return
+		}
+		if _, _, err := scheme.Codecs.UniversalDeserializer().Decode(body, nil, &review); !assert.NoError(t, err) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		review.APIVersion = "authorization.k8s.io/v1"
+		review.Kind = "SelfSubjectAccessReview"
+		review.Status.Allowed = review.Spec.ResourceAttributes.Resource != "secrets"
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(review)
+	})
+	mux.HandleFunc("/api/v1/namespaces", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Upgrade") != "websocket" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		conn, buf, err := w.(http.Hijacker).Hijack()
+		if !assert.NoError(t, err) {
+			return
+		}
+		defer conn.Close()
+		_, _ = buf.WriteString("HTTP/1.1 101 Switching Protocols\r\nUpgrade: websocket\r\nConnection: Upgrade\r\n\r\n")
+		_ = buf.Flush()
+	})
+	server := httptest.NewTLSServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newFakeAPIServerCluster(t *testing.T, server *httptest.Server) *v1alpha1.Cluster {
+	t.Helper()
+	expiresAt := time.Now().Add(365 * 24 * time.Hour)
+	return &v1alpha1.Cluster{
+		Server: server.URL,
+		Name:   "fake",
+		Config: v1alpha1.ClusterConfig{
+			BearerToken: newTestBearerToken(t, &expiresAt),
+			TLSClientConfig: v1alpha1.TLSClientConfig{
+				CAData: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}),
+			},
+		},
+	}
+}
+
+func diagnosisCheckStatuses(report *cluster.ClusterDiagnosisReport) map[string]string {
+	statuses := make(map[string]string)
+	for _, check := range report.Checks {
+		statuses[check.Name] = check.Status
+	}
+	return statuses
+}
+
+func TestDiagnoseCluster(t *testing.T) {
+	server := newFakeAPIServer(t)
+	kubectl := &kubetest.MockKubectlCmd{APIResources: []kube.APIResourceInfo{
+		{GroupVersionResource: schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}, Meta: metav1.APIResource{Namespaced: true}},
+		{GroupVersionResource: schema.GroupVersionResource{Version: "v1", Resource: "secrets"}, Meta: metav1.APIResource{Namespaced: true}},
+		{GroupVersionResource: schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}},
+	}}
+
+	t.Run("RespectRBACDisabled", func(t *testing.T) {
+		report := diagnoseCluster(t.Context(), newFakeAPIServerCluster(t, server), kubectl, &settings.ResourcesFilter{}, cache.RespectRbacDisabled)
+
+		assert.Equal(t, server.URL, report.Server)
+		assert.Equal(t, "fake", report.Name)
+		assert.Equal(t, "v1.31.0", report.ServerVersion)
+		assert.Equal(t, map[string]string{
+			"Reachability":    diagnosisCheckPassed,
+			"TLS":             diagnosisCheckPassed,
+			"Credentials":     diagnosisCheckPassed,
+			"Clock skew":      diagnosisCheckPassed,
+			"List namespaces": diagnosisCheckPassed,
+			"Watch resources": diagnosisCheckFailed,
+			"Websocket":       diagnosisCheckPassed,
+		}, diagnosisCheckStatuses(report))
+		for _, check := range report.Checks {
+			if check.Name == "Watch resources" {
+				assert.Equal(t, "Credentials are not allowed to watch 1 of 3 tracked resources", check.Message)
+				assert.Equal(t, []string{"watch secrets"}, check.Details)
+			}
+		}
+	})
+
+	t.Run("RespectRBACNormal", func(t *testing.T) {
+		report := diagnoseCluster(t.Context(), newFakeAPIServerCluster(t, server), kubectl, &settings.ResourcesFilter{}, cache.RespectRbacNormal)
+		assert.Equal(t, diagnosisCheckWarning, diagnosisCheckStatuses(report)["Watch resources"])
+	})
+
+	t.Run("Unreachable", func(t *testing.T) {
+		clust := newFakeAPIServerCluster(t, server)
+		clust.Server = "https://127.0.0.1:1"
+		report := diagnoseCluster(t.Context(), clust, kubectl, &settings.ResourcesFilter{}, cache.RespectRbacDisabled)
+
+		assert.Empty(t, report.ServerVersion)
+		assert.Equal(t, map[string]string{
+			"Reachability":    diagnosisCheckFailed,
+			"TLS":             diagnosisCheckSkipped,
+			"Credentials":     diagnosisCheckPassed,
+			"Clock skew":      diagnosisCheckSkipped,
+			"List namespaces": diagnosisCheckSkipped,
+			"Watch resources": diagnosisCheckSkipped,
+			"Websocket":       diagnosisCheckSkipped,
+		}, diagnosisCheckStatuses(report))
+	})
+
+	t.Run("UnknownCertificateAuthority", func(t *testing.T) {
+		clust := newFakeAPIServerCluster(t, server)
+		clust.Config.CAData = nil
+		report := diagnoseCluster(t.Context(), clust, kubectl, &settings.ResourcesFilter{}, cache.RespectRbacDisabled)
+
+		statuses := diagnosisCheckStatuses(report)
+		assert.Equal(t, diagnosisCheckFailed, statuses["Reachability"])
+		assert.Equal(t, diagnosisCheckFailed, statuses["TLS"])
+	})
+}
+
+func TestCheckCredentials(t *testing.T) {
+	now := time.Now()
+	reachable := &apiServerProbe{response: &http.Response{StatusCode: http.StatusOK}}
+	expired := now.Add(-time.Hour)
+	expiring := now.Add(24 * time.Hour)
+
+	check := checkCredentials(&rest.Config{BearerToken: newTestBearerToken(t, nil)}, reachable, now)
+	assert.Equal(t, diagnosisCheckPassed, check.Status)
+	assert.Equal(t, "Bearer token does not expire", check.Message)
+
+	check = checkCredentials(&rest.Config{BearerToken: newTestBearerToken(t, &expired)}, reachable, now)
+	assert.Equal(t, diagnosisCheckFailed, check.Status)
+	assert.Contains(t, check.Message, "Bearer token expired at")
+
+	check = checkCredentials(&rest.Config{BearerToken: newTestBearerToken(t, &expiring)}, reachable, now)
+	assert.Equal(t, diagnosisCheckWarning, check.Status)
+	assert.Contains(t, check.Message, "Bearer token expires at")
+
+	check = checkCredentials(&rest.Config{BearerToken: "opaque"}, reachable, now)
+	assert.Equal(t, diagnosisCheckPassed, check.Status)
+
+	check = checkCredentials(&rest.Config{BearerToken: "opaque"}, &apiServerProbe{response: &http.Response{StatusCode: http.StatusUnauthorized}}, now)
+	assert.Equal(t, diagnosisCheckFailed, check.Status)
+	assert.Equal(t, "API server rejected the credentials of the cluster", check.Message)
+
+	check = checkCredentials(&rest.Config{}, reachable, now)
+	assert.Equal(t, diagnosisCheckWarning, check.Status)
+}
+
+func TestCheckClockSkew(t *testing.T) {
+	sentAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	newProbe := func(date string) *apiServerProbe {
+		return &apiServerProbe{
+			response:   &http.Response{Header: http.Header{"Date": []string{date}}},
+			sentAt:     sentAt,
+			receivedAt: sentAt.Add(200 * time.Millisecond),
+		}
+	}
+
+	check := checkClockSkew(newProbe("Wed, 01 Jan 2025 12:00:00 GMT"))
+	assert.Equal(t, diagnosisCheckPassed, check.Status)
+	assert.Equal(t, "Clocks of the API server and Argo CD are in sync", check.Message)
+
+	check = checkClockSkew(newProbe("Wed, 01 Jan 2025 12:01:00 GMT"))
+	assert.Equal(t, diagnosisCheckWarning, check.Status)
+	assert.Equal(t, "Clock of the API server is 1m0s ahead of Argo CD", check.Message)
+
+	check = checkClockSkew(newProbe("Wed, 01 Jan 2025 11:50:00 GMT"))
+	assert.Equal(t, diagnosisCheckFailed, check.Status)
+	assert.Equal(t, "Clock of the API server is 10m0s behind Argo CD", check.Message)
+
+	check = checkClockSkew(newProbe(""))
+	assert.Equal(t, diagnosisCheckSkipped, check.Status)
+
+	check = checkClockSkew(&apiServerProbe{})
+	assert.Equal(t, diagnosisCheckSkipped, check.Status)
+}
+
+func TestTrackedResourceAttributes(t *testing.T) {
+	apiResources := []kube.APIResourceInfo{
+		{GroupVersionResource: schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}, Meta: metav1.APIResource{Namespaced: true}},
+		{GroupVersionResource: schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}},
+	}
+	format := func(attributes []authorizationv1.ResourceAttributes) []string {
+		var res []string
+		for _, attrs := range attributes {
+			res = append(res, formatResourceAttributes(attrs))
+		}
+		return res
+	}
+
+	assert.Equal(t, []string{"watch deployments.apps", "watch namespaces"},
+		format(trackedResourceAttributes(apiResources, &v1alpha1.Cluster{})))
+	assert.Equal(t, []string{"watch deployments.apps in namespace a", "watch deployments.apps in namespace b"},
+		format(trackedResourceAttributes(apiResources, &v1alpha1.Cluster{Namespaces: []string{"a", "b"}})))
+	assert.Equal(t, []string{"watch deployments.apps in namespace a", "watch namespaces"},
+		format(trackedResourceAttributes(apiResources, &v1alpha1.Cluster{Namespaces: []string{"a"}, ClusterResources: true})))
+}
@@ -28,7 +28,7 @@ func NewServer(db db.ArgoDB, enf *rbac.Enforcer) *Server {
 }
 
 // ListGnuPGPublicKeys returns a list of GnuPG public keys in the configuration
-func (s *Server) List(ctx context.Context, _ *gpgkeypkg.GnuPGPublicKeyQuery) (*appsv1.GnuPGPublicKeyList, error) {
+func (s *Server) List(ctx context.Context, q *gpgkeypkg.GnuPGPublicKeyQuery) (*appsv1.GnuPGPublicKeyList, error) {
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceGPGKeys, rbac.ActionGet, ""); err != nil {
 		return nil, err
 	}
@@ -38,8 +38,10 @@ func (s *Server) List(ctx context.Context, _ *gpgkeypkg.GnuPGPublicKeyQuery) (*a
 	}
 	keyList := &appsv1.GnuPGPublicKeyList{}
 	for _, v := range keys {
-		// Remove key's data from list result to save some bytes
-		v.KeyData = ""
+		// Remove key's data from list result to save some bytes, unless the client needs it
+		if !q.WithKeyData {
+			v.KeyData = ""
+		}
 		keyList.Items = append(keyList.Items, *v)
 	}
 	return keyList, nil
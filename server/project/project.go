@@ -4,7 +4,10 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"slices"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	"github.com/argoproj/pkg/v2/sync"
@@ -17,6 +20,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/retry"
@@ -30,6 +34,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/server/rbacpolicy"
 	"github.com/argoproj/argo-cd/v3/util/argo"
 	"github.com/argoproj/argo-cd/v3/util/db"
+	"github.com/argoproj/argo-cd/v3/util/glob"
 	jwtutil "github.com/argoproj/argo-cd/v3/util/jwt"
 	"github.com/argoproj/argo-cd/v3/util/rbac"
 	"github.com/argoproj/argo-cd/v3/util/session"
@@ -39,6 +44,8 @@ import (
 const (
 	// JWTTokenSubFormat format of the JWT token subject that Argo CD vends out.
 	JWTTokenSubFormat = "proj:%s:%s"
+	// projectTemplatePlaceholder is replaced with the name of the project in the role policies of project templates
+	projectTemplatePlaceholder = "{{project}}"
 )
 
 // Server provides a Project service
@@ -232,7 +239,12 @@ func (s *Server) deleteToken(ctx context.Context, q *project.ProjectTokenDeleteR
 		}
 	}
 
-	err = prj.RemoveJWTToken(roleIndex, q.Iat, q.Id)
+	if q.ExpiresIn > 0 {
+		// keep the token valid for a while, e.g. until the clients using it have switched to a rotated token
+		err = prj.ExpireJWTToken(roleIndex, q.Iat, q.Id, time.Now().Unix()+q.ExpiresIn)
+	} else {
+		err = prj.RemoveJWTToken(roleIndex, q.Iat, q.Id)
+	}
 	if err != nil {
 		return &project.EmptyResponse{}, nil
 	}
@@ -241,11 +253,32 @@ func (s *Server) deleteToken(ctx context.Context, q *project.ProjectTokenDeleteR
 	if err != nil {
 		return nil, err
 	}
-	s.logEvent(ctx, prj, argo.EventReasonResourceDeleted, "deleted token")
+	if q.ExpiresIn > 0 {
+		s.logEvent(ctx, prj, argo.EventReasonResourceUpdated, fmt.Sprintf("scheduled revocation of token in %ds", q.ExpiresIn))
+	} else {
+		s.logEvent(ctx, prj, argo.EventReasonResourceDeleted, "deleted token")
+	}
 
 	return &project.EmptyResponse{}, nil
 }
 
+// CanIRole checks whether a token of a project role is allowed to perform an action. The role is evaluated by the
+// enforcer of the API server, so the global policy, the default role and the match mode of argocd-rbac-cm apply.
+func (s *Server) CanIRole(ctx context.Context, q *project.ProjectRoleCanIRequest) (*project.ProjectRoleCanIResponse, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceProjects, rbac.ActionGet, q.Project); err != nil {
+		return nil, err
+	}
+	prj, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Get(ctx, q.Project, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if _, _, err := prj.GetRoleByName(q.Role); err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	claims := jwt.MapClaims{"sub": fmt.Sprintf(JWTTokenSubFormat, q.Project, q.Role)}
+	return &project.ProjectRoleCanIResponse{Allowed: s.enf.Enforce(claims, q.Resource, q.Action, q.Subresource)}, nil
+}
+
 // Create a new project
 func (s *Server) Create(ctx context.Context, q *project.ProjectCreateRequest) (*v1alpha1.AppProject, error) {
 	if q.Project == nil {
@@ -254,6 +287,17 @@ func (s *Server) Create(ctx context.Context, q *project.ProjectCreateRequest) (*
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceProjects, rbac.ActionCreate, q.Project.Name); err != nil {
 		return nil, err
 	}
+	if q.GetTemplate() != "" {
+		templates, err := s.settingsMgr.GetProjectTemplates()
+		if err != nil {
+			return nil, fmt.Errorf("error getting project templates: %w", err)
+		}
+		template, ok := templates[q.GetTemplate()]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "project template '%s' does not exist", q.GetTemplate())
+		}
+		applyProjectTemplate(q.Project, template)
+	}
 	q.Project.NormalizePolicies()
 	err := validateProject(q.Project)
 	if err != nil {
@@ -283,6 +327,24 @@ func (s *Server) Create(ctx context.Context, q *project.ProjectCreateRequest) (*
 	return res, err
 }
 
+// applyProjectTemplate sets the fields of the project spec which are not set to the values of the template. The
+// {{project}} placeholder in the policies of the roles of the template is replaced with the name of the project.
+func applyProjectTemplate(proj *v1alpha1.AppProject, template v1alpha1.AppProjectSpec) {
+	template = *template.DeepCopy()
+	for i := range template.Roles {
+		for j, policy := range template.Roles[i].Policies {
+			template.Roles[i].Policies[j] = strings.ReplaceAll(policy, projectTemplatePlaceholder, proj.Name)
+		}
+	}
+	spec := reflect.ValueOf(&proj.Spec).Elem()
+	templateSpec := reflect.ValueOf(template)
+	for i := 0; i < spec.NumField(); i++ {
+		if spec.Field(i).IsZero() {
+			spec.Field(i).Set(templateSpec.Field(i))
+		}
+	}
+}
+
 // List returns list of projects
 func (s *Server) List(ctx context.Context, _ *project.ProjectQuery) (*v1alpha1.AppProjectList, error) {
 	list, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).List(ctx, metav1.ListOptions{})
@@ -532,6 +594,210 @@ func (s *Server) GetSyncWindowsState(ctx context.Context, q *project.SyncWindows
 	return res, nil
 }
 
+// GetUsage returns how a project is used by the applications the user is permitted to get: the number of applications
+// per destination, the repositories the applications are deployed from, the destinations of the project no application
+// is deployed to and the cluster-scoped resources managed by the applications which are not permitted by the project.
+func (s *Server) GetUsage(ctx context.Context, q *project.ProjectQuery) (*project.ProjectUsageResponse, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceProjects, rbac.ActionGet, q.Name); err != nil {
+		return nil, err
+	}
+	proj, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Get(ctx, q.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	appsList, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	res := &project.ProjectUsageResponse{}
+	usageByDest := make(map[v1alpha1.ApplicationDestination]*project.ProjectDestinationUsage)
+	// clusters are resolved once per distinct server and name, since most applications share a few clusters
+	clustersByDest := make(map[v1alpha1.ApplicationDestination]*v1alpha1.Cluster)
+	repos := make(map[string]bool)
+	for _, app := range argo.FilterByProjects(appsList.Items, []string{q.Name}) {
+		if !s.enf.Enforce(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionGet, app.RBACName(s.ns)) {
+			continue
+		}
+		// resolve the cluster so that applications referencing it by name and by server are counted together
+		dest := v1alpha1.ApplicationDestination{Server: app.Spec.Destination.Server, Name: app.Spec.Destination.Name, Namespace: app.Spec.Destination.Namespace}
+		clusterDest := v1alpha1.ApplicationDestination{Server: dest.Server, Name: dest.Name}
+		cluster, ok := clustersByDest[clusterDest]
+		if !ok {
+			cluster, _ = argo.GetDestinationCluster(ctx, app.Spec.Destination, s.db)
+			clustersByDest[clusterDest] = cluster
+		}
+		if cluster != nil {
+			dest.Server, dest.Name = cluster.Server, cluster.Name
+		}
+		usage, ok := usageByDest[dest]
+		if !ok {
+			usage = &project.ProjectDestinationUsage{Server: dest.Server, Name: dest.Name, Namespace: dest.Namespace}
+			usageByDest[dest] = usage
+			res.Destinations = append(res.Destinations, usage)
+		}
+		usage.Applications++
+
+		for _, src := range app.Spec.GetSources() {
+			repos[src.RepoURL] = true
+		}
+		for _, r := range app.Status.Resources {
+			if r.Namespace == "" && !proj.IsGroupKindPermitted(schema.GroupKind{Group: r.Group, Kind: r.Kind}, false) {
+				res.DeniedClusterResources = append(res.DeniedClusterResources, &project.ProjectClusterResourceUsage{
+					Application: app.Name, Group: r.Group, Kind: r.Kind, Name: r.Name,
+				})
+			}
+		}
+	}
+	sort.Slice(res.Destinations, func(i, j int) bool {
+		a, b := res.Destinations[i], res.Destinations[j]
+		if a.Server != b.Server {
+			return a.Server < b.Server
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.Namespace < b.Namespace
+	})
+	for repo := range repos {
+		res.Repositories = append(res.Repositories, repo)
+	}
+	sort.Strings(res.Repositories)
+
+	for i := range proj.Spec.Destinations {
+		item := proj.Spec.Destinations[i]
+		if strings.HasPrefix(item.Server, "!") || strings.HasPrefix(item.Name, "!") || strings.HasPrefix(item.Namespace, "!") {
+			continue
+		}
+		used := false
+		for dest := range usageByDest {
+			if isDestinationUsed(item, dest) {
+				used = true
+				break
+			}
+		}
+		if !used {
+			res.UnusedDestinations = append(res.UnusedDestinations, &item)
+		}
+	}
+	return res, nil
+}
+
+// isDestinationUsed returns whether an application destination matches the (possibly wildcard) destination of a project
+func isDestinationUsed(item v1alpha1.ApplicationDestination, dest v1alpha1.ApplicationDestination) bool {
+	match := func(pattern, val string) bool {
+		return pattern == "*" || glob.Match(pattern, val)
+	}
+	serverMatched := item.Server != "" && dest.Server != "" && match(item.Server, dest.Server)
+	nameMatched := item.Name != "" && dest.Name != "" && match(item.Name, dest.Name)
+	return (serverMatched || nameMatched) && match(item.Namespace, dest.Namespace)
+}
+
+// ListDestinationServiceAccounts returns the default service accounts of the destinations of a project
+func (s *Server) ListDestinationServiceAccounts(ctx context.Context, q *project.ProjectQuery) (*project.DestinationServiceAccountsResponse, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceProjects, rbac.ActionGet, q.Name); err != nil {
+		return nil, err
+	}
+	proj, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Get(ctx, q.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	res := &project.DestinationServiceAccountsResponse{}
+	for i := range proj.Spec.DestinationServiceAccounts {
+		res.Items = append(res.Items, &proj.Spec.DestinationServiceAccounts[i])
+	}
+	return res, nil
+}
+
+// AddDestinationServiceAccount adds a default service account for a destination to a project
+func (s *Server) AddDestinationServiceAccount(ctx context.Context, q *project.ProjectDestinationServiceAccountRequest) (*v1alpha1.AppProject, error) {
+	var res *v1alpha1.AppProject
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		var addErr error
+		res, addErr = s.addDestinationServiceAccount(ctx, q)
+		return addErr
+	})
+	return res, err
+}
+
+func (s *Server) addDestinationServiceAccount(ctx context.Context, q *project.ProjectDestinationServiceAccountRequest) (*v1alpha1.AppProject, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceProjects, rbac.ActionUpdate, q.Project); err != nil {
+		return nil, err
+	}
+	if q.Server == "" || q.Namespace == "" || q.DefaultServiceAccount == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "server, namespace and defaultServiceAccount are required")
+	}
+
+	s.projectLock.Lock(q.Project)
+	defer s.projectLock.Unlock(q.Project)
+
+	proj, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Get(ctx, q.Project, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, dsa := range proj.Spec.DestinationServiceAccounts {
+		if dsa.Server == q.Server && dsa.Namespace == q.Namespace {
+			return nil, status.Errorf(codes.AlreadyExists, "destination '%s/%s' already has the default service account '%s'", q.Server, q.Namespace, dsa.DefaultServiceAccount)
+		}
+	}
+	proj.Spec.DestinationServiceAccounts = append(proj.Spec.DestinationServiceAccounts, v1alpha1.ApplicationDestinationServiceAccount{
+		Server:                q.Server,
+		Namespace:             q.Namespace,
+		DefaultServiceAccount: q.DefaultServiceAccount,
+	})
+	if err := validateProject(proj); err != nil {
+		return nil, err
+	}
+
+	res, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Update(ctx, proj, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	s.logEvent(ctx, res, argo.EventReasonResourceUpdated, fmt.Sprintf("added default service account '%s' for destination '%s/%s'", q.DefaultServiceAccount, q.Server, q.Namespace))
+	return res, nil
+}
+
+// DeleteDestinationServiceAccount removes a default service account of a destination from a project. If
+// defaultServiceAccount is set, the service account of the destination must match it.
+func (s *Server) DeleteDestinationServiceAccount(ctx context.Context, q *project.ProjectDestinationServiceAccountRequest) (*v1alpha1.AppProject, error) {
+	var res *v1alpha1.AppProject
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		var deleteErr error
+		res, deleteErr = s.deleteDestinationServiceAccount(ctx, q)
+		return deleteErr
+	})
+	return res, err
+}
+
+func (s *Server) deleteDestinationServiceAccount(ctx context.Context, q *project.ProjectDestinationServiceAccountRequest) (*v1alpha1.AppProject, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceProjects, rbac.ActionUpdate, q.Project); err != nil {
+		return nil, err
+	}
+
+	s.projectLock.Lock(q.Project)
+	defer s.projectLock.Unlock(q.Project)
+
+	proj, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Get(ctx, q.Project, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	originalLength := len(proj.Spec.DestinationServiceAccounts)
+	proj.Spec.DestinationServiceAccounts = slices.DeleteFunc(proj.Spec.DestinationServiceAccounts, func(dsa v1alpha1.ApplicationDestinationServiceAccount) bool {
+		return dsa.Server == q.Server && dsa.Namespace == q.Namespace &&
+			(q.DefaultServiceAccount == "" || dsa.DefaultServiceAccount == q.DefaultServiceAccount)
+	})
+	if originalLength == len(proj.Spec.DestinationServiceAccounts) {
+		return nil, status.Errorf(codes.NotFound, "destination '%s/%s' of project '%s' does not have a matching default service account", q.Server, q.Namespace, q.Project)
+	}
+
+	res, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Update(ctx, proj, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	s.logEvent(ctx, res, argo.EventReasonResourceUpdated, fmt.Sprintf("removed default service account of destination '%s/%s'", q.Server, q.Namespace))
+	return res, nil
+}
+
 func (s *Server) NormalizeProjs() error {
 	projList, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).List(context.Background(), metav1.ListOptions{})
 	if err != nil {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/argoproj/argo-cd/v3/util/argo"
 	"github.com/argoproj/argo-cd/v3/util/db"
@@ -358,6 +359,101 @@ func TestProjectServer(t *testing.T) {
 		assert.Equal(t, "project is referenced by 1 applications", statusCode.Message())
 	})
 
+	t.Run("TestGetUsage", func(t *testing.T) {
+		proj := existingProj.DeepCopy()
+		proj.Spec.Destinations = append(proj.Spec.Destinations, v1alpha1.ApplicationDestination{Namespace: "*", Name: "server3"})
+		proj.Spec.ClusterResourceWhitelist = []metav1.GroupKind{{Group: "", Kind: "Namespace"}}
+		byServer := v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "by-server", Namespace: "default"},
+			Spec: v1alpha1.ApplicationSpec{
+				Project:     "test",
+				Source:      &v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argo-cd.git"},
+				Destination: v1alpha1.ApplicationDestination{Namespace: "ns1", Server: "https://server1"},
+			},
+			Status: v1alpha1.ApplicationStatus{Resources: []v1alpha1.ResourceStatus{
+				{Kind: "Namespace", Name: "ns1"},
+				{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole", Name: "admin"},
+				{Group: "apps", Kind: "Deployment", Namespace: "ns1", Name: "guestbook"},
+			}},
+		}
+		byName := v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "by-name", Namespace: "default"},
+			Spec: v1alpha1.ApplicationSpec{
+				Project: "test",
+				Sources: v1alpha1.ApplicationSources{
+					{RepoURL: "https://github.com/argoproj/argo-cd.git"},
+					{RepoURL: "https://github.com/argoproj/argocd-example-apps.git"},
+				},
+				Destination: v1alpha1.ApplicationDestination{Namespace: "ns1", Name: "server1"},
+			},
+		}
+		otherProject := v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default"},
+			Spec: v1alpha1.ApplicationSpec{
+				Project:     "other",
+				Source:      &v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/other.git"},
+				Destination: v1alpha1.ApplicationDestination{Namespace: "ns2", Server: "https://server2"},
+			},
+		}
+
+		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(proj, &byServer, &byName, &otherProject), enforcer, sync.NewKeyLock(), nil, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+
+		usage, err := projectServer.GetUsage(t.Context(), &project.ProjectQuery{Name: "test"})
+		require.NoError(t, err)
+		assert.Equal(t, []*project.ProjectDestinationUsage{{Server: "https://server1", Name: "server1", Namespace: "ns1", Applications: 2}}, usage.Destinations)
+		assert.Equal(t, []string{"https://github.com/argoproj/argo-cd.git", "https://github.com/argoproj/argocd-example-apps.git"}, usage.Repositories)
+		assert.Equal(t, []*v1alpha1.ApplicationDestination{{Namespace: "ns2", Server: "https://server2"}, {Namespace: "*", Name: "server3"}}, usage.UnusedDestinations)
+		assert.Equal(t, []*project.ProjectClusterResourceUsage{{Application: "by-server", Group: "rbac.authorization.k8s.io", Kind: "ClusterRole", Name: "admin"}}, usage.DeniedClusterResources)
+
+		appEnforcer := rbac.NewEnforcer(kubeclientset, testNamespace, common.ArgoCDRBACConfigMapName, nil)
+		_ = appEnforcer.SetBuiltinPolicy(`p, role:test, projects, get, test, allow
+p, role:test, applications, get, test/by-name, allow`)
+		appEnforcer.SetDefaultRole("role:test")
+		projectServer = NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(proj, &byServer, &byName, &otherProject), appEnforcer, sync.NewKeyLock(), nil, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+
+		usage, err = projectServer.GetUsage(t.Context(), &project.ProjectQuery{Name: "test"})
+		require.NoError(t, err)
+		assert.Equal(t, []*project.ProjectDestinationUsage{{Server: "https://server1", Name: "server1", Namespace: "ns1", Applications: 1}}, usage.Destinations)
+		assert.Empty(t, usage.DeniedClusterResources)
+	})
+
+	t.Run("TestDestinationServiceAccounts", func(t *testing.T) {
+		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(existingProj.DeepCopy()), enforcer, sync.NewKeyLock(), nil, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+
+		proj, err := projectServer.AddDestinationServiceAccount(t.Context(), &project.ProjectDestinationServiceAccountRequest{
+			Project: "test", Server: "https://server1", Namespace: "ns1", DefaultServiceAccount: "deployer",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []v1alpha1.ApplicationDestinationServiceAccount{{Server: "https://server1", Namespace: "ns1", DefaultServiceAccount: "deployer"}}, proj.Spec.DestinationServiceAccounts)
+
+		_, err = projectServer.AddDestinationServiceAccount(t.Context(), &project.ProjectDestinationServiceAccountRequest{
+			Project: "test", Server: "https://server1", Namespace: "ns1", DefaultServiceAccount: "other",
+		})
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+		_, err = projectServer.AddDestinationServiceAccount(t.Context(), &project.ProjectDestinationServiceAccountRequest{
+			Project: "test", Server: "https://server2", Namespace: "ns2", DefaultServiceAccount: "deploy*",
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		list, err := projectServer.ListDestinationServiceAccounts(t.Context(), &project.ProjectQuery{Name: "test"})
+		require.NoError(t, err)
+		assert.Equal(t, []*v1alpha1.ApplicationDestinationServiceAccount{{Server: "https://server1", Namespace: "ns1", DefaultServiceAccount: "deployer"}}, list.Items)
+
+		_, err = projectServer.DeleteDestinationServiceAccount(t.Context(), &project.ProjectDestinationServiceAccountRequest{
+			Project: "test", Server: "https://server1", Namespace: "ns1", DefaultServiceAccount: "other",
+		})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		proj, err = projectServer.DeleteDestinationServiceAccount(t.Context(), &project.ProjectDestinationServiceAccountRequest{
+			Project: "test", Server: "https://server1", Namespace: "ns1",
+		})
+		require.NoError(t, err)
+		assert.Empty(t, proj.Spec.DestinationServiceAccounts)
+	})
+
 	// configure a user named "admin" which is denied by default
 	enforcer = newEnforcer(kubeclientset)
 	_ = enforcer.SetBuiltinPolicy(`p, *, *, *, *, deny`)
@@ -534,6 +630,27 @@ p, role:admin, projects, update, *, allow`)
 		assert.Equal(t, projWithoutToken.Spec.Roles[0].JWTTokens[0].IssuedAt, secondIssuedAt)
 	})
 
+	t.Run("TestDeleteTokenWithExpiresIn", func(t *testing.T) {
+		sessionMgr := session.NewSessionManager(settingsMgr, test.NewFakeProjLister(), "", nil, session.NewUserStateStorage(nil))
+		projWithToken := existingProj.DeepCopy()
+		token := v1alpha1.ProjectRole{Name: tokenName, JWTTokens: []v1alpha1.JWTToken{{IssuedAt: 1, ID: "old"}, {IssuedAt: 2, ID: "new"}}}
+		projWithToken.Spec.Roles = append(projWithToken.Spec.Roles, token)
+		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projWithToken), enforcer, sync.NewKeyLock(), sessionMgr, policyEnf, projInformer, settingsMgr, argoDB, testEnableEventList)
+		before := time.Now().Unix()
+		_, err := projectServer.DeleteToken(ctx, &project.ProjectTokenDeleteRequest{Project: projWithToken.Name, Role: tokenName, Iat: -1, Id: "old", ExpiresIn: 3600})
+		require.NoError(t, err)
+		proj, err := projectServer.Get(t.Context(), &project.ProjectQuery{Name: projWithToken.Name})
+		require.NoError(t, err)
+		oldToken, _, err := proj.GetJWTToken(tokenName, -1, "old")
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, oldToken.ExpiresAt, before+3600)
+		assert.LessOrEqual(t, oldToken.ExpiresAt, time.Now().Unix()+3600)
+		newToken, _, err := proj.GetJWTToken(tokenName, -1, "new")
+		require.NoError(t, err)
+		assert.Zero(t, newToken.ExpiresAt)
+	})
+
 	enforcer = newEnforcer(kubeclientset)
 
 	t.Run("TestCreateTwoTokensInRoleSuccess", func(t *testing.T) {
@@ -754,3 +871,109 @@ func newEnforcer(kubeclientset *fake.Clientset) *rbac.Enforcer {
 	})
 	return enforcer
 }
+
+func TestCreateProjectFromTemplate(t *testing.T) {
+	kubeclientset := fake.NewClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      "argocd-cm",
+			Labels: map[string]string{
+				"app.kubernetes.io/part-of": "argocd",
+			},
+		},
+		Data: map[string]string{
+			"project.template.standard": `
+description: Standard project
+sourceRepos:
+- https://github.com/my-org/*
+destinations:
+- server: https://kubernetes.default.svc
+  namespace: '*'
+clusterResourceWhitelist:
+- group: ''
+  kind: Namespace
+roles:
+- name: read-only
+  policies:
+  - p, proj:{{project}}:read-only, applications, get, {{project}}/*, allow
+`,
+		},
+	}, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "argocd-secret",
+			Namespace: testNamespace,
+		},
+		Data: map[string][]byte{
+			"admin.password":   []byte("test"),
+			"server.secretkey": []byte("test"),
+		},
+	})
+	settingsMgr := settings.NewSettingsManager(t.Context(), kubeclientset, testNamespace)
+	sessionMgr := session.NewSessionManager(settingsMgr, test.NewFakeProjLister(), "", nil, session.NewUserStateStorage(nil))
+	argoDB := db.NewDB(testNamespace, settingsMgr, kubeclientset)
+	projectServer := NewServer(testNamespace, kubeclientset, apps.NewSimpleClientset(), newEnforcer(kubeclientset), sync.NewKeyLock(), sessionMgr, nil, nil, settingsMgr, argoDB, testEnableEventList)
+
+	t.Run("TemplateNotFound", func(t *testing.T) {
+		_, err := projectServer.Create(t.Context(), &project.ProjectCreateRequest{
+			Project:  &v1alpha1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}},
+			Template: "unknown",
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("FieldsOfTheProjectTakePrecedence", func(t *testing.T) {
+		proj, err := projectServer.Create(t.Context(), &project.ProjectCreateRequest{
+			Project: &v1alpha1.AppProject{
+				ObjectMeta: metav1.ObjectMeta{Name: "team-a"},
+				Spec:       v1alpha1.AppProjectSpec{Description: "Team A"},
+			},
+			Template: "standard",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Team A", proj.Spec.Description)
+		assert.Equal(t, []string{"https://github.com/my-org/*"}, proj.Spec.SourceRepos)
+		assert.Equal(t, []v1alpha1.ApplicationDestination{{Server: "https://kubernetes.default.svc", Namespace: "*"}}, proj.Spec.Destinations)
+		assert.Equal(t, []metav1.GroupKind{{Group: "", Kind: "Namespace"}}, proj.Spec.ClusterResourceWhitelist)
+		require.Len(t, proj.Spec.Roles, 1)
+		assert.Equal(t, []string{"p, proj:team-a:read-only, applications, get, team-a/*, allow"}, proj.Spec.Roles[0].Policies)
+	})
+}
+
+func TestCanIRole(t *testing.T) {
+	kubeclientset := fake.NewClientset()
+	proj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: testNamespace},
+		Spec: v1alpha1.AppProjectSpec{
+			Roles: []v1alpha1.ProjectRole{{Name: "ci", Policies: []string{"p, proj:test:ci, applications, sync, test/*, allow"}}},
+		},
+	}
+	appclientset := apps.NewSimpleClientset(proj)
+	enforcer := rbac.NewEnforcer(kubeclientset, testNamespace, common.ArgoCDRBACConfigMapName, nil)
+	require.NoError(t, enforcer.SetBuiltinPolicy(assets.BuiltinPolicyCSV))
+	require.NoError(t, enforcer.SetUserPolicy("g, admin, role:admin"))
+	enforcer.SetDefaultRole("role:readonly")
+	policyEnf := rbacpolicy.NewRBACPolicyEnforcer(enforcer, test.NewFakeProjListerFromInterface(appclientset.ArgoprojV1alpha1().AppProjects(testNamespace)))
+	enforcer.SetClaimsEnforcerFunc(policyEnf.EnforceClaims)
+	projectServer := NewServer(testNamespace, kubeclientset, appclientset, enforcer, sync.NewKeyLock(), nil, policyEnf, nil, nil, nil, testEnableEventList)
+	ctx := context.WithValue(t.Context(), "claims", &jwt.MapClaims{"sub": "admin"})
+
+	canI := func(action, resource, subresource string) bool {
+		t.Helper()
+		res, err := projectServer.CanIRole(ctx, &project.ProjectRoleCanIRequest{Project: "test", Role: "ci", Action: action, Resource: resource, Subresource: subresource})
+		require.NoError(t, err)
+		return res.Allowed
+	}
+	assert.True(t, canI("sync", "applications", "test/guestbook"))
+	assert.False(t, canI("delete", "applications", "test/guestbook"))
+	// the default role of argocd-rbac-cm applies to project tokens as well
+	assert.True(t, canI("get", "applications", "test/guestbook"))
+	assert.True(t, canI("get", "clusters", "https://kubernetes.default.svc"))
+
+	_, err := projectServer.CanIRole(ctx, &project.ProjectRoleCanIRequest{Project: "test", Role: "unknown", Action: "get", Resource: "applications", Subresource: "test/guestbook"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	enforcer.SetDefaultRole("")
+	assert.False(t, canI("get", "applications", "test/guestbook"))
+	_, err = projectServer.CanIRole(context.WithValue(t.Context(), "claims", &jwt.MapClaims{"sub": "someone"}), &project.ProjectRoleCanIRequest{Project: "test", Role: "ci", Action: "sync", Resource: "applications", Subresource: "test/guestbook"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
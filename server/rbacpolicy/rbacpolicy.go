@@ -1,17 +1,30 @@
 package rbacpolicy
 
 import (
+	"encoding/json"
+	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/golang-jwt/jwt/v5"
 	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/labels"
 
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	applister "github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
 	jwtutil "github.com/argoproj/argo-cd/v3/util/jwt"
 	"github.com/argoproj/argo-cd/v3/util/rbac"
+	"github.com/argoproj/argo-cd/v3/util/settings"
 )
 
+// TokenScopeClaim is the claim holding the scope of a token restricted to a subset of the
+// permissions of its subject
+const TokenScopeClaim = "token_scope"
+
+// AccountProjectsClaim is the claim holding the projects a local account is bound to. It is set by the session
+// manager from the account settings when a token is verified.
+const AccountProjectsClaim = "account_projects"
+
 // RBACPolicyEnforcer provides an RBAC Claims Enforcer which additionally consults AppProject
 // roles, jwt tokens, and groups. It is backed by a AppProject informer/lister cache and does not
 // make any API calls during enforcement.
@@ -63,6 +76,21 @@ func (p *RBACPolicyEnforcer) EnforceClaims(claims jwt.Claims, rvals ...any) bool
 	}
 
 	subject := jwtutil.GetUserIdentifier(mapClaims)
+	// Scoped tokens never grant more than their scope allows, regardless of the subject's policies
+	scope, err := GetTokenScope(mapClaims)
+	if err != nil {
+		log.WithError(err).Warn("failed to parse token scope")
+		return false
+	}
+	if scope != nil && !tokenScopeAllows(scope, rvals...) {
+		log.WithFields(log.Fields{"subject": subject, "rval": rvals}).Debug("enforce failed: request is outside of the token scope")
+		return false
+	}
+	// Accounts bound to projects never get access outside of these projects, regardless of the subject's policies
+	if projects := jwtutil.GetScopeValues(mapClaims, []string{AccountProjectsClaim}); len(projects) > 0 && !tokenScopeAllows(&settings.TokenScope{Projects: projects}, rvals...) {
+		log.WithFields(log.Fields{"subject": subject, "rval": rvals, "projects": projects}).Debug("enforce failed: request is outside of the projects of the account")
+		return false
+	}
 	// Check if the request is for an application resource. We have special enforcement which takes
 	// into consideration the project's token and group bindings
 	var runtimePolicy string
@@ -116,33 +144,106 @@ func (p *RBACPolicyEnforcer) EnforceClaims(claims jwt.Claims, rvals ...any) bool
 	return false
 }
 
-// getProjectFromRequest parses the project name from the RBAC request and returns the associated
-// project (if it exists)
-func (p *RBACPolicyEnforcer) getProjectFromRequest(rvals ...any) *v1alpha1.AppProject {
-	if len(rvals) != 4 {
-		return nil
+// GetProjectRolePermissions returns the policy rules of the project roles which are granted to any of the subjects
+// through the groups of the role. Each rule consists of the project role subject, resource, action, object and effect.
+func (p *RBACPolicyEnforcer) GetProjectRolePermissions(subjects ...string) ([][]string, error) {
+	projects, err := p.projLister.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list projects: %w", err)
 	}
-	getProjectByName := func(projName string) *v1alpha1.AppProject {
-		proj, err := p.projLister.Get(projName)
+	var rules [][]string
+	for _, proj := range projects {
+		if len(proj.Spec.Roles) == 0 {
+			continue
+		}
+		permissions, err := p.enf.GetImplicitPermissionsWithRuntimePolicy(proj.Name, proj.ProjectPoliciesString(), subjects...)
 		if err != nil {
-			return nil
+			return nil, fmt.Errorf("failed to get permissions in project %s: %w", proj.Name, err)
 		}
-		return proj
-	}
-	if res, ok := rvals[1].(string); ok {
-		if obj, ok := rvals[3].(string); ok {
-			switch res {
-			case rbac.ResourceApplicationSets, rbac.ResourceApplications, rbac.ResourceRepositories, rbac.ResourceClusters, rbac.ResourceLogs, rbac.ResourceExec:
-				if objSplit := strings.Split(obj, "/"); len(objSplit) >= 2 {
-					return getProjectByName(objSplit[0])
-				}
-			case rbac.ResourceProjects:
-				// we also automatically give project tokens and groups 'get' access to the project
-				return getProjectByName(obj)
+		for _, permission := range permissions {
+			if len(permission) > 0 && IsProjectSubject(permission[0]) {
+				rules = append(rules, permission)
 			}
 		}
 	}
-	return nil
+	return rules, nil
+}
+
+// GetTokenScope returns the scope embedded in the given claims, or nil if the token is not scoped
+func GetTokenScope(claims jwt.MapClaims) (*settings.TokenScope, error) {
+	val, ok := claims[TokenScopeClaim]
+	if !ok || val == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(val)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling token scope: %w", err)
+	}
+	var scope settings.TokenScope
+	if err := json.Unmarshal(data, &scope); err != nil {
+		return nil, fmt.Errorf("error unmarshaling token scope: %w", err)
+	}
+	return &scope, nil
+}
+
+// tokenScopeAllows checks whether the RBAC request is within the given token scope
+func tokenScopeAllows(scope *settings.TokenScope, rvals ...any) bool {
+	if len(rvals) < 3 {
+		return false
+	}
+	res, _ := rvals[1].(string)
+	act, _ := rvals[2].(string)
+	if len(scope.Resources) > 0 && !slices.Contains(scope.Resources, res) {
+		return false
+	}
+	// actions such as action/<group>/<kind>/<name> and update/* are matched by their verb
+	if len(scope.Actions) > 0 && !slices.Contains(scope.Actions, act) && !slices.Contains(scope.Actions, strings.SplitN(act, "/", 2)[0]) {
+		return false
+	}
+	if len(scope.Projects) == 0 {
+		return true
+	}
+	projName, ok := getProjectNameFromRequest(rvals...)
+	return ok && slices.Contains(scope.Projects, projName)
+}
+
+// getProjectNameFromRequest parses the project name from the RBAC request
+func getProjectNameFromRequest(rvals ...any) (string, bool) {
+	if len(rvals) != 4 {
+		return "", false
+	}
+	res, ok := rvals[1].(string)
+	if !ok {
+		return "", false
+	}
+	obj, ok := rvals[3].(string)
+	if !ok {
+		return "", false
+	}
+	switch res {
+	case rbac.ResourceApplicationSets, rbac.ResourceApplications, rbac.ResourceRepositories, rbac.ResourceClusters, rbac.ResourceLogs, rbac.ResourceExec:
+		if objSplit := strings.Split(obj, "/"); len(objSplit) >= 2 {
+			return objSplit[0], true
+		}
+	case rbac.ResourceProjects:
+		// we also automatically give project tokens and groups 'get' access to the project
+		return obj, true
+	}
+	return "", false
+}
+
+// getProjectFromRequest parses the project name from the RBAC request and returns the associated
+// project (if it exists)
+func (p *RBACPolicyEnforcer) getProjectFromRequest(rvals ...any) *v1alpha1.AppProject {
+	projName, ok := getProjectNameFromRequest(rvals...)
+	if !ok {
+		return nil
+	}
+	proj, err := p.projLister.Get(projName)
+	if err != nil {
+		return nil
+	}
+	return proj
 }
 
 // enforceProjectToken will check to see the valid token has not yet been revoked in the project
@@ -128,6 +128,91 @@ p, cam, applications, %s/argoproj.io/Rollout/resume, my-proj/*, allow
 	assert.False(t, enf.Enforce(claims, "applications", rbac.ActionAction+"/argoproj.io/Rollout/resume", "my-proj/my-app"))
 }
 
+func TestEnforceTokenScope(t *testing.T) {
+	kubeclientset := fake.NewClientset(test.NewFakeConfigMap())
+	projLister := test.NewFakeProjLister(newFakeProj())
+	enf := rbac.NewEnforcer(kubeclientset, test.FakeArgoCDNamespace, common.ArgoCDConfigMapName, nil)
+	enf.EnableLog(true)
+	_ = enf.SetBuiltinPolicy(`p, ci, *, *, *, allow`)
+	rbacEnf := NewRBACPolicyEnforcer(enf, projLister)
+	enf.SetClaimsEnforcerFunc(rbacEnf.EnforceClaims)
+
+	// Unscoped tokens have all permissions of the subject
+	claims := jwt.MapClaims{"sub": "ci"}
+	assert.True(t, enf.Enforce(claims, "applications", "delete", "other-proj/my-app"))
+	assert.True(t, enf.Enforce(claims, "clusters", "get", "*"))
+
+	claims = jwt.MapClaims{"sub": "ci", TokenScopeClaim: map[string]any{
+		"projects":  []any{"my-proj"},
+		"actions":   []any{"get", "sync", "action"},
+		"resources": []any{"applications", "projects"},
+	}}
+	assert.True(t, enf.Enforce(claims, "applications", "get", "my-proj/my-app"))
+	assert.True(t, enf.Enforce(claims, "applications", "sync", "my-proj/my-app"))
+	assert.True(t, enf.Enforce(claims, "applications", rbac.ActionAction+"/argoproj.io/Rollout/resume", "my-proj/my-app"))
+	assert.True(t, enf.Enforce(claims, "projects", "get", "my-proj"))
+	// outside of the scoped actions
+	assert.False(t, enf.Enforce(claims, "applications", "delete", "my-proj/my-app"))
+	// outside of the scoped projects
+	assert.False(t, enf.Enforce(claims, "applications", "get", "other-proj/my-app"))
+	assert.False(t, enf.Enforce(claims, "projects", "get", "other-proj"))
+	// outside of the scoped resources
+	assert.False(t, enf.Enforce(claims, "clusters", "get", "*"))
+
+	// A scope never grants more than the subject has
+	claims = jwt.MapClaims{"sub": "eve", TokenScopeClaim: map[string]any{"resources": []any{"applications"}}}
+	assert.False(t, enf.Enforce(claims, "applications", "get", "my-proj/my-app"))
+
+	// Malformed scopes deny everything
+	claims = jwt.MapClaims{"sub": "ci", TokenScopeClaim: "applications"}
+	assert.False(t, enf.Enforce(claims, "applications", "get", "my-proj/my-app"))
+
+	// The default role is restricted by the scope as well
+	_ = enf.SetUserPolicy(`p, role:admin, *, *, *, allow`)
+	enf.SetDefaultRole("role:admin")
+	claims = jwt.MapClaims{"sub": "eve", TokenScopeClaim: map[string]any{"resources": []any{"applications"}, "actions": []any{"get"}}}
+	assert.True(t, enf.Enforce(claims, "applications", "get", "my-proj/my-app"))
+	assert.False(t, enf.Enforce(claims, "applications", "delete", "my-proj/my-app"))
+	assert.False(t, enf.Enforce(claims, "clusters", "get", "*"))
+	// unscoped claims still get the permissions of the default role
+	assert.True(t, enf.Enforce(jwt.MapClaims{"sub": "eve"}, "clusters", "get", "*"))
+}
+
+func TestEnforceAccountProjects(t *testing.T) {
+	kubeclientset := fake.NewClientset(test.NewFakeConfigMap())
+	projLister := test.NewFakeProjLister(newFakeProj())
+	enf := rbac.NewEnforcer(kubeclientset, test.FakeArgoCDNamespace, common.ArgoCDConfigMapName, nil)
+	enf.EnableLog(true)
+	// a misconfigured policy granting the account everything
+	_ = enf.SetBuiltinPolicy(`p, ci, *, *, *, allow`)
+	rbacEnf := NewRBACPolicyEnforcer(enf, projLister)
+	enf.SetClaimsEnforcerFunc(rbacEnf.EnforceClaims)
+
+	claims := jwt.MapClaims{"sub": "ci", AccountProjectsClaim: []any{"my-proj"}}
+	assert.True(t, enf.Enforce(claims, "applications", "delete", "my-proj/my-app"))
+	assert.True(t, enf.Enforce(claims, "logs", "get", "my-proj/my-app"))
+	assert.True(t, enf.Enforce(claims, "projects", "get", "my-proj"))
+	assert.False(t, enf.Enforce(claims, "applications", "get", "other-proj/my-app"))
+	assert.False(t, enf.Enforce(claims, "projects", "get", "other-proj"))
+	// requests which do not belong to a project are denied
+	assert.False(t, enf.Enforce(claims, "accounts", "update", "admin"))
+	assert.False(t, enf.Enforce(claims, "certificates", "create", "*"))
+
+	// the projects of the account and the scope of its token both apply
+	claims = jwt.MapClaims{"sub": "ci", AccountProjectsClaim: []string{"my-proj", "other-proj"}, TokenScopeClaim: map[string]any{"projects": []any{"other-proj"}}}
+	assert.False(t, enf.Enforce(claims, "applications", "get", "my-proj/my-app"))
+	assert.True(t, enf.Enforce(claims, "applications", "get", "other-proj/my-app"))
+
+	// the default role does not grant access outside of the projects of the account either
+	_ = enf.SetUserPolicy(`p, role:admin, *, *, *, allow`)
+	enf.SetDefaultRole("role:admin")
+	claims = jwt.MapClaims{"sub": "ci", AccountProjectsClaim: []any{"my-proj"}}
+	assert.True(t, enf.Enforce(claims, "applications", "get", "my-proj/my-app"))
+	assert.False(t, enf.Enforce(claims, "applications", "get", "other-proj/my-app"))
+	assert.False(t, enf.Enforce(claims, "projects", "get", "other-proj"))
+	assert.False(t, enf.Enforce(claims, "certificates", "create", "*"))
+}
+
 func TestInvalidatedCache(t *testing.T) {
 	kubeclientset := fake.NewClientset(test.NewFakeConfigMap())
 	projLister := test.NewFakeProjLister(newFakeProj())
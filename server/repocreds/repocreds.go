@@ -46,10 +46,9 @@ func (s *Server) ListRepositoryCredentials(ctx context.Context, _ *repocredspkg.
 				return nil, err
 			}
 			if repo != nil {
-				items = append(items, appsv1.RepoCreds{
-					URL:      url,
-					Username: repo.Username,
-				})
+				sanitized := repo.Sanitized()
+				sanitized.URL = url
+				items = append(items, *sanitized)
 			}
 		}
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"slices"
 	"sort"
 
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
@@ -180,12 +181,18 @@ func (s *Server) GetWrite(ctx context.Context, q *repositorypkg.RepoQuery) (*v1a
 	return repo, nil
 }
 
-// ListRepositories returns a list of all configured repositories and the state of their connections
+// ListRepositories returns a list of all configured repositories and the state of their connections. If a repo URL is
+// given, only the repositories with that URL are returned, so that only their connection state is refreshed.
 func (s *Server) ListRepositories(ctx context.Context, q *repositorypkg.RepoQuery) (*v1alpha1.RepositoryList, error) {
 	repos, err := s.db.ListRepositories(ctx)
 	if err != nil {
 		return nil, err
 	}
+	if q.Repo != "" {
+		repos = slices.DeleteFunc(repos, func(repo *v1alpha1.Repository) bool {
+			return !git.SameURL(repo.Repo, q.Repo)
+		})
+	}
 	items, err := s.prepareRepoList(ctx, rbac.ResourceRepositories, repos, q.ForceRefresh)
 	if err != nil {
 		return nil, err
@@ -551,16 +558,26 @@ func (s *Server) UpdateRepository(ctx context.Context, q *repositorypkg.RepoUpda
 		return nil, err
 	}
 
+	updated, err := updatedRepository(repo, q)
+	if err != nil {
+		return nil, err
+	}
+
 	// verify that user can do update inside project where repository is located
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceRepositories, rbac.ActionUpdate, createRBACObject(repo.Project, repo.Repo)); err != nil {
 		return nil, err
 	}
 	// verify that user can do update inside project where repository will be located
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceRepositories, rbac.ActionUpdate, createRBACObject(q.Repo.Project, q.Repo.Repo)); err != nil {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceRepositories, rbac.ActionUpdate, createRBACObject(updated.Project, updated.Repo)); err != nil {
 		return nil, err
 	}
-	_, err = s.db.UpdateRepository(ctx, q.Repo)
-	return &v1alpha1.Repository{Repo: q.Repo.Repo, Type: q.Repo.Type, Name: q.Repo.Name}, err
+	if q.Test {
+		if err := s.testUpdatedRepo(ctx, updated); err != nil {
+			return nil, err
+		}
+	}
+	_, err = s.db.UpdateRepository(ctx, updated)
+	return &v1alpha1.Repository{Repo: updated.Repo, Type: updated.Type, Name: updated.Name}, err
 }
 
 // UpdateWriteRepository updates a repository configuration with write credentials
@@ -578,16 +595,108 @@ func (s *Server) UpdateWriteRepository(ctx context.Context, q *repositorypkg.Rep
 		return nil, err
 	}
 
+	updated, err := updatedRepository(repo, q)
+	if err != nil {
+		return nil, err
+	}
+
 	// verify that user can do update inside project where repository is located
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceWriteRepositories, rbac.ActionUpdate, createRBACObject(repo.Project, repo.Repo)); err != nil {
 		return nil, err
 	}
 	// verify that user can do update inside project where repository will be located
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceWriteRepositories, rbac.ActionUpdate, createRBACObject(q.Repo.Project, q.Repo.Repo)); err != nil {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceWriteRepositories, rbac.ActionUpdate, createRBACObject(updated.Project, updated.Repo)); err != nil {
 		return nil, err
 	}
-	_, err = s.db.UpdateWriteRepository(ctx, q.Repo)
-	return &v1alpha1.Repository{Repo: q.Repo.Repo, Type: q.Repo.Type, Name: q.Repo.Name}, err
+	if q.Test {
+		if err := s.testRepo(ctx, updated); err != nil {
+			return nil, err
+		}
+	}
+	_, err = s.db.UpdateWriteRepository(ctx, updated)
+	return &v1alpha1.Repository{Repo: updated.Repo, Type: updated.Type, Name: updated.Name}, err
+}
+
+// repoFieldsByPath sets the field of a repository with the given JSON name to the value of the updated repository
+var repoFieldsByPath = map[string]func(repo *v1alpha1.Repository, updated *v1alpha1.Repository){
+	"type":                  func(repo, updated *v1alpha1.Repository) { repo.Type = updated.Type },
+	"name":                  func(repo, updated *v1alpha1.Repository) { repo.Name = updated.Name },
+	"project":               func(repo, updated *v1alpha1.Repository) { repo.Project = updated.Project },
+	"username":              func(repo, updated *v1alpha1.Repository) { repo.Username = updated.Username },
+	"password":              func(repo, updated *v1alpha1.Repository) { repo.Password = updated.Password },
+	"bearerToken":           func(repo, updated *v1alpha1.Repository) { repo.BearerToken = updated.BearerToken },
+	"sshPrivateKey":         func(repo, updated *v1alpha1.Repository) { repo.SSHPrivateKey = updated.SSHPrivateKey },
+	"tlsClientCertData":     func(repo, updated *v1alpha1.Repository) { repo.TLSClientCertData = updated.TLSClientCertData },
+	"tlsClientCertKey":      func(repo, updated *v1alpha1.Repository) { repo.TLSClientCertKey = updated.TLSClientCertKey },
+	"insecure":              func(repo, updated *v1alpha1.Repository) { repo.Insecure = updated.Insecure },
+	"insecureIgnoreHostKey": func(repo, updated *v1alpha1.Repository) { repo.InsecureIgnoreHostKey = updated.InsecureIgnoreHostKey },
+	"enableLfs":             func(repo, updated *v1alpha1.Repository) { repo.EnableLFS = updated.EnableLFS },
+	"enableOCI":             func(repo, updated *v1alpha1.Repository) { repo.EnableOCI = updated.EnableOCI },
+	"insecureOCIForceHttp":  func(repo, updated *v1alpha1.Repository) { repo.InsecureOCIForceHttp = updated.InsecureOCIForceHttp },
+	"githubAppPrivateKey":   func(repo, updated *v1alpha1.Repository) { repo.GithubAppPrivateKey = updated.GithubAppPrivateKey },
+	"githubAppID":           func(repo, updated *v1alpha1.Repository) { repo.GithubAppId = updated.GithubAppId },
+	"githubAppInstallationID": func(repo, updated *v1alpha1.Repository) {
+		repo.GithubAppInstallationId = updated.GithubAppInstallationId
+	},
+	"githubAppEnterpriseBaseUrl": func(repo, updated *v1alpha1.Repository) {
+		repo.GitHubAppEnterpriseBaseURL = updated.GitHubAppEnterpriseBaseURL
+	},
+	"proxy":                func(repo, updated *v1alpha1.Repository) { repo.Proxy = updated.Proxy },
+	"noProxy":              func(repo, updated *v1alpha1.Repository) { repo.NoProxy = updated.NoProxy },
+	"gcpServiceAccountKey": func(repo, updated *v1alpha1.Repository) { repo.GCPServiceAccountKey = updated.GCPServiceAccountKey },
+	"forceHttpBasicAuth":   func(repo, updated *v1alpha1.Repository) { repo.ForceHttpBasicAuth = updated.ForceHttpBasicAuth },
+	"useAzureWorkloadIdentity": func(repo, updated *v1alpha1.Repository) {
+		repo.UseAzureWorkloadIdentity = updated.UseAzureWorkloadIdentity
+	},
+}
+
+// updatedRepository returns the repository to store for an update request. If the request has updated fields, only
+// these are taken from the request, and the other fields of the existing repository are kept, including the secrets
+// which the API never returns.
+func updatedRepository(existing *v1alpha1.Repository, q *repositorypkg.RepoUpdateRequest) (*v1alpha1.Repository, error) {
+	if len(q.UpdatedFields) == 0 {
+		return q.Repo, nil
+	}
+	repo := existing.DeepCopy()
+	repo.ConnectionState = v1alpha1.ConnectionState{}
+	if repo.InheritedCreds {
+		// the credentials of a credential template must not be stored with the repository
+		repo.Username = ""
+		repo.Password = ""
+		repo.BearerToken = ""
+		repo.SSHPrivateKey = ""
+		repo.TLSClientCertData = ""
+		repo.TLSClientCertKey = ""
+		repo.GithubAppPrivateKey = ""
+		repo.GithubAppId = 0
+		repo.GithubAppInstallationId = 0
+		repo.GitHubAppEnterpriseBaseURL = ""
+		repo.GCPServiceAccountKey = ""
+		repo.UseAzureWorkloadIdentity = false
+		repo.InheritedCreds = false
+	}
+	for _, path := range q.UpdatedFields {
+		updater, ok := repoFieldsByPath[path]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "repository field %q cannot be updated", path)
+		}
+		updater(repo, q.Repo)
+	}
+	return repo, nil
+}
+
+// testUpdatedRepo verifies that the repository can be accessed, with the credentials of its credential template if it
+// has none of its own
+func (s *Server) testUpdatedRepo(ctx context.Context, repo *v1alpha1.Repository) error {
+	repo = repo.DeepCopy()
+	if !repo.HasCredentials() {
+		creds, err := s.db.GetRepositoryCredentials(ctx, repo.Repo)
+		if err != nil {
+			return err
+		}
+		repo.CopyCredentialsFrom(creds)
+	}
+	return s.testRepo(ctx, repo)
 }
 
 // Delete removes a repository from the configuration
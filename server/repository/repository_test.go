@@ -502,6 +502,25 @@ func TestRepositoryServer(t *testing.T) {
 		require.NoError(t, err)
 		assert.Len(t, resp.Items, 2)
 	})
+
+	t.Run("Test_ListRepositoriesByURL", func(t *testing.T) {
+		repoServerClient := mocks.RepoServerServiceClient{}
+		repoServerClient.On("TestRepository", mock.Anything, mock.Anything).Return(&apiclient.TestRepositoryResponse{}, nil)
+		repoServerClientset := mocks.Clientset{RepoServerServiceClient: &repoServerClient}
+		enforcer := newEnforcer(kubeclientset)
+
+		db := &dbmocks.ArgoDB{}
+		db.On("GetRepository", t.Context(), mock.Anything, mock.Anything).Return(nil, nil)
+		db.On("ListHelmRepositories", t.Context(), mock.Anything).Return(nil, nil)
+		db.On("ListRepositories", t.Context()).Return([]*appsv1.Repository{{Repo: "https://test/one"}, {Repo: "https://test/two"}}, nil)
+
+		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, appLister, projInformer, testNamespace, settingsMgr, false)
+		resp, err := s.ListRepositories(t.Context(), &repository.RepoQuery{Repo: "https://test/two.git", ForceRefresh: true})
+		require.NoError(t, err)
+		require.Len(t, resp.Items, 1)
+		assert.Equal(t, "https://test/two", resp.Items[0].Repo)
+		db.AssertNotCalled(t, "GetRepository", t.Context(), "https://test/one", mock.Anything)
+	})
 }
 
 func TestRepositoryServerListApps(t *testing.T) {
@@ -1155,3 +1174,58 @@ func TestDeleteRepository(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdatedRepository(t *testing.T) {
+	existing := &appsv1.Repository{
+		Repo:              "https://github.com/argoproj/argo-cd",
+		Username:          "git",
+		Password:          "secret",
+		TLSClientCertData: "cert",
+		TLSClientCertKey:  "key",
+		Proxy:             "http://proxy:3128",
+		ConnectionState:   appsv1.ConnectionState{Status: appsv1.ConnectionStatusSuccessful},
+	}
+
+	t.Run("AllFields", func(t *testing.T) {
+		q := &repository.RepoUpdateRequest{Repo: &appsv1.Repository{Repo: existing.Repo, Insecure: true}}
+		repo, err := updatedRepository(existing, q)
+		require.NoError(t, err)
+		assert.Same(t, q.Repo, repo)
+	})
+
+	t.Run("UpdatedFields", func(t *testing.T) {
+		repo, err := updatedRepository(existing, &repository.RepoUpdateRequest{
+			Repo:          &appsv1.Repository{Repo: existing.Repo, Insecure: true, Username: "ignored"},
+			UpdatedFields: []string{"insecure", "proxy"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, &appsv1.Repository{
+			Repo:              existing.Repo,
+			Username:          "git",
+			Password:          "secret",
+			TLSClientCertData: "cert",
+			TLSClientCertKey:  "key",
+			Insecure:          true,
+		}, repo)
+		assert.False(t, existing.Insecure)
+	})
+
+	t.Run("InheritedCreds", func(t *testing.T) {
+		inherited := existing.DeepCopy()
+		inherited.InheritedCreds = true
+		repo, err := updatedRepository(inherited, &repository.RepoUpdateRequest{
+			Repo:          &appsv1.Repository{Repo: existing.Repo, Name: "argo-cd"},
+			UpdatedFields: []string{"name"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, &appsv1.Repository{Repo: existing.Repo, Name: "argo-cd", Proxy: "http://proxy:3128"}, repo)
+	})
+
+	t.Run("UnknownField", func(t *testing.T) {
+		_, err := updatedRepository(existing, &repository.RepoUpdateRequest{
+			Repo:          &appsv1.Repository{Repo: existing.Repo},
+			UpdatedFields: []string{"connectionState"},
+		})
+		require.ErrorContains(t, err, `repository field "connectionState" cannot be updated`)
+	})
+}
@@ -0,0 +1,961 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/yaml"
+
+	"github.com/google/uuid"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/password"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
+	"github.com/argoproj/argo-cd/v3/util/session"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+const (
+	// URLPrefix is the path under which the SCIM endpoint is served
+	URLPrefix = "/api/scim/v2"
+	// ContentType is the media type of SCIM requests and responses
+	ContentType = "application/scim+json"
+
+	schemaUser                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	schemaGroup                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	schemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	schemaListResponse          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	schemaError                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+
+	// groupsKey is the key of argocd-rbac-cm holding the members of the groups provisioned through SCIM
+	groupsKey = "scim.groups"
+	// groupsPolicyKey is the key of argocd-rbac-cm holding the policy which assigns the provisioned groups to their
+	// members. It is generated from groupsKey and merged into the RBAC policy like any other policy.*.csv key.
+	groupsPolicyKey = "policy.scim.csv"
+
+	// maxRequestSize is the maximum size of a request body
+	maxRequestSize = 1 << 20
+)
+
+var (
+	// filterRegexp matches the only filter expressions supported by the endpoint, e.g. userName eq "alice"
+	filterRegexp = regexp.MustCompile(`^\s*(\w+)\s+(?i:eq)\s+"([^"]*)"\s*$`)
+	// memberPathRegexp matches the path of a PATCH operation removing a single member, e.g. members[value eq "alice"]
+	memberPathRegexp = regexp.MustCompile(`^(?i:members)\[\s*(?i:value)\s+(?i:eq)\s+"([^"]*)"\s*\]$`)
+	// groupNameRegexp restricts the names of groups so that they can neither break the generated policy nor be confused
+	// with roles or project roles
+	groupNameRegexp = regexp.MustCompile(`^[^\s,:"#][^,:"\n\r]*$`)
+	// invalidAccountNameChars matches the characters of a userName that are not allowed in account names
+	invalidAccountNameChars = regexp.MustCompile(`[^-_a-zA-Z0-9]+`)
+)
+
+type meta struct {
+	ResourceType string `json:"resourceType"`
+	Location     string `json:"location,omitempty"`
+}
+
+type reference struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+}
+
+type user struct {
+	Schemas  []string `json:"schemas"`
+	ID       string   `json:"id,omitempty"`
+	UserName string   `json:"userName"`
+	// ExternalID is the userName the account was provisioned with, if it had to be mapped to a valid account name
+	ExternalID string      `json:"externalId,omitempty"`
+	Active     *bool       `json:"active,omitempty"`
+	Password   string      `json:"password,omitempty"`
+	Groups     []reference `json:"groups,omitempty"`
+	Meta       *meta       `json:"meta,omitempty"`
+}
+
+type group struct {
+	Schemas     []string    `json:"schemas"`
+	ID          string      `json:"id,omitempty"`
+	DisplayName string      `json:"displayName"`
+	Members     []reference `json:"members"`
+	Meta        *meta       `json:"meta,omitempty"`
+}
+
+type listResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []any    `json:"Resources"`
+}
+
+type patchRequest struct {
+	Operations []patchOperation `json:"Operations"`
+}
+
+type patchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value"`
+}
+
+type errorResponse struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail"`
+}
+
+// httpError is an error with the HTTP status and SCIM error type to respond with
+type httpError struct {
+	status   int
+	scimType string
+	detail   string
+}
+
+func (e *httpError) Error() string {
+	return e.detail
+}
+
+func badRequest(scimType string, format string, args ...any) error {
+	return &httpError{status: http.StatusBadRequest, scimType: scimType, detail: fmt.Sprintf(format, args...)}
+}
+
+// Handler serves a SCIM 2.0 endpoint which allows identity providers to provision local accounts and the groups they
+// are members of. Requests are authenticated by an Argo CD token and authorized by the 'accounts' RBAC resource.
+// Groups are assigned to their members in the RBAC policy, so that permissions can be granted to the groups.
+type Handler struct {
+	namespace     string
+	kubeclientset kubernetes.Interface
+	settingsMgr   *settings.SettingsManager
+	enf           *rbac.Enforcer
+	authn         session.TokenVerifier
+	auditLogger   *argo.AuditLogger
+}
+
+// NewHandler creates a handler serving the SCIM endpoint
+func NewHandler(namespace string, kubeclientset kubernetes.Interface, settingsMgr *settings.SettingsManager, enf *rbac.Enforcer, authn session.TokenVerifier, auditLogger *argo.AuditLogger) *Handler {
+	return &Handler{namespace: namespace, kubeclientset: kubeclientset, settingsMgr: settingsMgr, enf: enf, authn: authn, auditLogger: auditLogger}
+}
+
+// logAccountEvent records an audit event about the given action of the current user on a local account
+func (h *Handler) logAccountEvent(ctx context.Context, name string, reason string, action string) {
+	user := session.Username(ctx)
+	if user == "" {
+		user = "Unknown user"
+	}
+	eventInfo := argo.EventInfo{Type: corev1.EventTypeNormal, Reason: reason}
+	h.auditLogger.LogAccountEvent(name, h.namespace, eventInfo, fmt.Sprintf("%s %s through SCIM", user, action), user)
+}
+
+// revokeAccess revokes the session tokens of the account by changing its session nonce and deletes its API tokens
+func revokeAccess(account *settings.Account) error {
+	nonce, err := uuid.NewRandom()
+	if err != nil {
+		return err
+	}
+	account.SessionNonce = nonce.String()
+	account.Tokens = nil
+	return nil
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	enabled, err := h.settingsMgr.IsSCIMEnabled()
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if !enabled {
+		writeError(w, &httpError{status: http.StatusNotFound, detail: "SCIM provisioning is not enabled"})
+		return
+	}
+	tokenString, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || tokenString == "" {
+		writeError(w, &httpError{status: http.StatusUnauthorized, detail: "bearer token not found"})
+		return
+	}
+	claims, _, err := h.authn.VerifyToken(tokenString)
+	if err != nil {
+		writeError(w, &httpError{status: http.StatusUnauthorized, detail: "invalid token"})
+		return
+	}
+	//nolint:staticcheck
+	ctx := context.WithValue(r.Context(), "claims", claims)
+	r.Body = http.MaxBytesReader(w, r.Body, maxRequestSize)
+
+	resource, id, _ := strings.Cut(strings.Trim(strings.TrimPrefix(r.URL.Path, URLPrefix), "/"), "/")
+	var res any
+	code := http.StatusOK
+	switch {
+	case resource == "ServiceProviderConfig" && id == "" && r.Method == http.MethodGet:
+		res = serviceProviderConfig()
+	case resource == "Users" && id == "" && r.Method == http.MethodGet:
+		res, err = h.listUsers(ctx, r)
+	case resource == "Users" && id == "" && r.Method == http.MethodPost:
+		res, err = h.createUser(ctx, r)
+		code = http.StatusCreated
+	case resource == "Users" && id != "" && r.Method == http.MethodGet:
+		res, err = h.getUser(ctx, id)
+	case resource == "Users" && id != "" && r.Method == http.MethodPut:
+		res, err = h.replaceUser(ctx, id, r)
+	case resource == "Users" && id != "" && r.Method == http.MethodPatch:
+		res, err = h.patchUser(ctx, id, r)
+	case resource == "Users" && id != "" && r.Method == http.MethodDelete:
+		err = h.deleteUser(ctx, id)
+		code = http.StatusNoContent
+	case resource == "Groups" && id == "" && r.Method == http.MethodGet:
+		res, err = h.listGroups(ctx, r)
+	case resource == "Groups" && id == "" && r.Method == http.MethodPost:
+		res, err = h.createGroup(ctx, r)
+		code = http.StatusCreated
+	case resource == "Groups" && id != "" && r.Method == http.MethodGet:
+		res, err = h.getGroup(ctx, id)
+	case resource == "Groups" && id != "" && r.Method == http.MethodPut:
+		res, err = h.replaceGroup(ctx, id, r)
+	case resource == "Groups" && id != "" && r.Method == http.MethodPatch:
+		res, err = h.patchGroup(ctx, id, r)
+	case resource == "Groups" && id != "" && r.Method == http.MethodDelete:
+		err = h.deleteGroup(ctx, id)
+		code = http.StatusNoContent
+	default:
+		err = &httpError{status: http.StatusNotFound, detail: fmt.Sprintf("%s %s is not supported", r.Method, r.URL.Path)}
+	}
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", ContentType)
+	w.WriteHeader(code)
+	if res != nil {
+		_ = json.NewEncoder(w).Encode(res)
+	}
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	res := errorResponse{Schemas: []string{schemaError}, Detail: err.Error()}
+	code := http.StatusInternalServerError
+	var httpErr *httpError
+	if errors.As(err, &httpErr) {
+		code = httpErr.status
+		res.ScimType = httpErr.scimType
+	} else if s, ok := status.FromError(err); ok {
+		res.Detail = s.Message()
+		switch s.Code() {
+		case codes.NotFound:
+			code = http.StatusNotFound
+		case codes.AlreadyExists:
+			code = http.StatusConflict
+			res.ScimType = "uniqueness"
+		case codes.InvalidArgument:
+			code = http.StatusBadRequest
+			res.ScimType = "invalidValue"
+		case codes.PermissionDenied:
+			code = http.StatusForbidden
+		}
+	}
+	if code == http.StatusInternalServerError {
+		log.Errorf("SCIM request failed: %v", err)
+	}
+	res.Status = strconv.Itoa(code)
+	w.Header().Set("Content-Type", ContentType)
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(res)
+}
+
+func serviceProviderConfig() map[string]any {
+	return map[string]any{
+		"schemas":        []string{schemaServiceProviderConfig},
+		"patch":          map[string]bool{"supported": true},
+		"bulk":           map[string]any{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]any{"supported": true, "maxResults": 0},
+		"changePassword": map[string]bool{"supported": true},
+		"sort":           map[string]bool{"supported": false},
+		"etag":           map[string]bool{"supported": false},
+		"authenticationSchemes": []map[string]string{{
+			"type":        "oauthbearertoken",
+			"name":        "OAuth Bearer Token",
+			"description": "Authentication with an Argo CD API token",
+		}},
+	}
+}
+
+func decodeBody(r *http.Request, v any) error {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return badRequest("invalidSyntax", "invalid request body: %v", err)
+	}
+	return nil
+}
+
+// parseFilter parses a filter of the form 'attribute eq "value"' on one of the given attributes
+func parseFilter(filter string, attributes ...string) (string, string, error) {
+	if filter == "" {
+		return "", "", nil
+	}
+	match := filterRegexp.FindStringSubmatch(filter)
+	if match == nil {
+		return "", "", badRequest("invalidFilter", "unsupported filter '%s': only 'attribute eq \"value\"' is supported", filter)
+	}
+	for _, attribute := range attributes {
+		if strings.EqualFold(match[1], attribute) {
+			return attribute, match[2], nil
+		}
+	}
+	return "", "", badRequest("invalidFilter", "unsupported filter attribute '%s': must be one of %s", match[1], strings.Join(attributes, ", "))
+}
+
+// paginate returns the page of the resources selected by the startIndex and count query parameters
+func paginate(r *http.Request, resources []any) (*listResponse, error) {
+	startIndex, count := 1, len(resources)
+	if v := r.URL.Query().Get("startIndex"); v != "" {
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, badRequest("invalidValue", "invalid startIndex '%s'", v)
+		}
+		startIndex = max(i, 1)
+	}
+	if v := r.URL.Query().Get("count"); v != "" {
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, badRequest("invalidValue", "invalid count '%s'", v)
+		}
+		count = max(i, 0)
+	}
+	start := min(startIndex-1, len(resources))
+	end := min(start+count, len(resources))
+	return &listResponse{
+		Schemas:      []string{schemaListResponse},
+		TotalResults: len(resources),
+		StartIndex:   startIndex,
+		ItemsPerPage: end - start,
+		Resources:    resources[start:end],
+	}, nil
+}
+
+func parseBool(raw json.RawMessage) (bool, error) {
+	var b bool
+	if err := json.Unmarshal(raw, &b); err == nil {
+		return b, nil
+	}
+	// some identity providers send booleans as strings, e.g. "False"
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return false, badRequest("invalidValue", "invalid boolean value %s", string(raw))
+	}
+	b, err := strconv.ParseBool(s)
+	if err != nil {
+		return false, badRequest("invalidValue", "invalid boolean value %s", string(raw))
+	}
+	return b, nil
+}
+
+func (h *Handler) toUser(name string, account settings.Account, groups map[string][]string) *user {
+	active := account.Enabled
+	u := &user{
+		Schemas:    []string{schemaUser},
+		ID:         name,
+		UserName:   name,
+		ExternalID: account.ExternalID,
+		Active:     &active,
+		Meta:       &meta{ResourceType: "User", Location: URLPrefix + "/Users/" + name},
+	}
+	for _, g := range sortedKeys(groups) {
+		if slices.Contains(groups[g], name) {
+			u.Groups = append(u.Groups, reference{Value: g, Display: g})
+		}
+	}
+	return u
+}
+
+// matchesUserFilter returns whether the account matches the filter of a list request. Identity providers look users up
+// by the userName they were provisioned with, so a userName filter also matches the externalId of mapped accounts.
+func matchesUserFilter(name string, account settings.Account, attribute string, value string) bool {
+	switch attribute {
+	case "":
+		return true
+	case "id":
+		return name == value
+	case "externalId":
+		return account.ExternalID != "" && account.ExternalID == value
+	default:
+		return name == value || (account.ExternalID != "" && account.ExternalID == value)
+	}
+}
+
+func (h *Handler) listUsers(ctx context.Context, r *http.Request) (any, error) {
+	attribute, value, err := parseFilter(r.URL.Query().Get("filter"), "userName", "id", "externalId")
+	if err != nil {
+		return nil, err
+	}
+	accounts, err := h.settingsMgr.GetAccounts()
+	if err != nil {
+		return nil, err
+	}
+	groups, err := h.getGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resources := []any{}
+	for _, name := range sortedKeys(accounts) {
+		if !matchesUserFilter(name, accounts[name], attribute, value) {
+			continue
+		}
+		if !h.enf.Enforce(ctx.Value("claims"), rbac.ResourceAccounts, rbac.ActionGet, name) {
+			continue
+		}
+		resources = append(resources, h.toUser(name, accounts[name], groups))
+	}
+	return paginate(r, resources)
+}
+
+func (h *Handler) getUser(ctx context.Context, name string) (*user, error) {
+	if err := h.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceAccounts, rbac.ActionGet, name); err != nil {
+		return nil, err
+	}
+	account, err := h.settingsMgr.GetAccount(name)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := h.getGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return h.toUser(name, *account, groups), nil
+}
+
+// setPassword validates the password against the password policy and sets it as the password of the account
+func (h *Handler) setPassword(name string, account *settings.Account, newPassword string) error {
+	policy, err := h.settingsMgr.GetPasswordPolicy()
+	if err != nil {
+		return err
+	}
+	if err := policy.Validate(name, newPassword); err != nil {
+		return badRequest("invalidValue", "%v", err)
+	}
+	hashedPassword, err := password.HashPassword(newPassword)
+	if err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	account.PasswordHash = hashedPassword
+	account.PasswordMtime = &now
+	return nil
+}
+
+// accountName maps a SCIM userName, such as an email address or a user principal name, to a valid account name by
+// replacing each run of characters that are not allowed in account names with '-'
+func accountName(userName string) string {
+	if settings.IsValidAccountName(userName) {
+		return userName
+	}
+	return strings.Trim(invalidAccountNameChars.ReplaceAllString(userName, "-"), "-_")
+}
+
+func (h *Handler) createUser(ctx context.Context, r *http.Request) (any, error) {
+	var u user
+	if err := decodeBody(r, &u); err != nil {
+		return nil, err
+	}
+	name := accountName(u.UserName)
+	if err := h.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceAccounts, rbac.ActionCreate, name); err != nil {
+		return nil, err
+	}
+	if !settings.IsValidAccountName(name) {
+		return nil, badRequest("invalidValue", "invalid userName '%s': must contain at least one alphanumeric character", u.UserName)
+	}
+	groups, err := h.getGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := groups[name]; ok {
+		return nil, &httpError{status: http.StatusConflict, scimType: "uniqueness", detail: fmt.Sprintf("userName '%s' is already the name of a group", u.UserName)}
+	}
+
+	account := settings.Account{Enabled: u.Active == nil || *u.Active, Capabilities: []settings.AccountCapability{settings.AccountCapabilityLogin}}
+	// a new session nonce ensures that session tokens of a deleted account with the same name are not accepted
+	if err := revokeAccess(&account); err != nil {
+		return nil, err
+	}
+	if name != u.UserName {
+		account.ExternalID = u.UserName
+	}
+	if u.Password != "" {
+		if err := h.setPassword(name, &account, u.Password); err != nil {
+			return nil, err
+		}
+	}
+	if err := h.settingsMgr.AddAccount(name, account); err != nil {
+		return nil, err
+	}
+	h.logAccountEvent(ctx, name, argo.EventReasonResourceCreated, "provisioned account "+name)
+	return h.toUser(name, account, groups), nil
+}
+
+// updateUser applies the given changes to the active state and password of an account
+func (h *Handler) updateUser(ctx context.Context, name string, active *bool, newPassword string) (*user, error) {
+	if err := h.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceAccounts, rbac.ActionUpdate, name); err != nil {
+		return nil, err
+	}
+	if active != nil && !*active && name == session.GetUserIdentifier(ctx) && session.Iss(ctx) == session.SessionManagerClaimsIssuer {
+		return nil, badRequest("invalidValue", "cannot deactivate the account of the current token")
+	}
+	var updated settings.Account
+	var wasEnabled bool
+	err := h.settingsMgr.UpdateAccount(name, func(account *settings.Account) error {
+		wasEnabled = account.Enabled
+		if active != nil {
+			account.Enabled = *active
+			// deprovisioning ends the access of the user immediately, instead of once its tokens expire
+			if !*active {
+				if err := revokeAccess(account); err != nil {
+					return err
+				}
+			}
+		}
+		if newPassword != "" {
+			if err := h.setPassword(name, account, newPassword); err != nil {
+				return err
+			}
+		}
+		updated = *account
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if wasEnabled && !updated.Enabled {
+		h.logAccountEvent(ctx, name, argo.EventReasonResourceUpdated, "deactivated account "+name)
+	} else if !wasEnabled && updated.Enabled {
+		h.logAccountEvent(ctx, name, argo.EventReasonResourceUpdated, "activated account "+name)
+	}
+	if newPassword != "" {
+		h.logAccountEvent(ctx, name, argo.EventReasonResourceUpdated, "changed the password of account "+name)
+	}
+	groups, err := h.getGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return h.toUser(name, updated, groups), nil
+}
+
+func (h *Handler) replaceUser(ctx context.Context, name string, r *http.Request) (any, error) {
+	var u user
+	if err := decodeBody(r, &u); err != nil {
+		return nil, err
+	}
+	if u.UserName != "" && accountName(u.UserName) != name {
+		return nil, badRequest("mutability", "userName of '%s' cannot be changed", name)
+	}
+	active := u.Active == nil || *u.Active
+	return h.updateUser(ctx, name, &active, u.Password)
+}
+
+func (h *Handler) patchUser(ctx context.Context, name string, r *http.Request) (any, error) {
+	var req patchRequest
+	if err := decodeBody(r, &req); err != nil {
+		return nil, err
+	}
+	var active *bool
+	var newPassword string
+	for _, op := range req.Operations {
+		if !strings.EqualFold(op.Op, "replace") && !strings.EqualFold(op.Op, "add") {
+			return nil, badRequest("invalidValue", "unsupported operation '%s' on a user", op.Op)
+		}
+		values := map[string]json.RawMessage{}
+		if op.Path == "" {
+			if err := json.Unmarshal(op.Value, &values); err != nil {
+				return nil, badRequest("invalidValue", "invalid value of operation '%s': %v", op.Op, err)
+			}
+		} else {
+			values[op.Path] = op.Value
+		}
+		for path, value := range values {
+			switch strings.ToLower(path) {
+			case "active":
+				b, err := parseBool(value)
+				if err != nil {
+					return nil, err
+				}
+				active = &b
+			case "password":
+				if err := json.Unmarshal(value, &newPassword); err != nil {
+					return nil, badRequest("invalidValue", "invalid password: %v", err)
+				}
+			default:
+				return nil, badRequest("invalidPath", "unsupported path '%s': only active and password can be changed", path)
+			}
+		}
+	}
+	return h.updateUser(ctx, name, active, newPassword)
+}
+
+func (h *Handler) deleteUser(ctx context.Context, name string) error {
+	if err := h.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceAccounts, rbac.ActionDelete, name); err != nil {
+		return err
+	}
+	// the access is revoked first, so that it ends even if the account can not be deleted
+	if err := h.settingsMgr.UpdateAccount(name, revokeAccess); err != nil {
+		return err
+	}
+	if err := h.settingsMgr.DeleteAccount(name); err != nil {
+		return err
+	}
+	err := h.updateGroups(ctx, func(groups map[string][]string) error {
+		for g, members := range groups {
+			groups[g] = slices.DeleteFunc(members, func(member string) bool { return member == name })
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	h.logAccountEvent(ctx, name, argo.EventReasonResourceDeleted, "deprovisioned account "+name)
+	return nil
+}
+
+func toGroup(name string, members []string) *group {
+	g := &group{
+		Schemas:     []string{schemaGroup},
+		ID:          name,
+		DisplayName: name,
+		Members:     []reference{},
+		Meta:        &meta{ResourceType: "Group", Location: URLPrefix + "/Groups/" + name},
+	}
+	for _, member := range members {
+		g.Members = append(g.Members, reference{Value: member, Display: member})
+	}
+	return g
+}
+
+// enforceGroups checks the permission to read or change groups. As groups grant the permissions of the roles they
+// are assigned to to their members, changing them requires the permission to update all accounts.
+func (h *Handler) enforceGroups(ctx context.Context, action string) error {
+	return h.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceAccounts, action, "*")
+}
+
+func (h *Handler) listGroups(ctx context.Context, r *http.Request) (any, error) {
+	attribute, value, err := parseFilter(r.URL.Query().Get("filter"), "displayName", "id")
+	if err != nil {
+		return nil, err
+	}
+	if err := h.enforceGroups(ctx, rbac.ActionGet); err != nil {
+		return nil, err
+	}
+	groups, err := h.getGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resources := []any{}
+	for _, name := range sortedKeys(groups) {
+		if attribute != "" && name != value {
+			continue
+		}
+		resources = append(resources, toGroup(name, groups[name]))
+	}
+	return paginate(r, resources)
+}
+
+func (h *Handler) getGroup(ctx context.Context, name string) (any, error) {
+	if err := h.enforceGroups(ctx, rbac.ActionGet); err != nil {
+		return nil, err
+	}
+	groups, err := h.getGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	members, ok := groups[name]
+	if !ok {
+		return nil, &httpError{status: http.StatusNotFound, detail: fmt.Sprintf("group '%s' does not exist", name)}
+	}
+	return toGroup(name, members), nil
+}
+
+// memberNames returns the names of the given members after verifying that they are local accounts
+func (h *Handler) memberNames(members []reference) ([]string, error) {
+	accounts, err := h.settingsMgr.GetAccounts()
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, m := range members {
+		if _, ok := accounts[m.Value]; !ok {
+			return nil, badRequest("invalidValue", "member '%s' is not a local account", m.Value)
+		}
+		if !slices.Contains(names, m.Value) {
+			names = append(names, m.Value)
+		}
+	}
+	return names, nil
+}
+
+func (h *Handler) createGroup(ctx context.Context, r *http.Request) (any, error) {
+	var g group
+	if err := decodeBody(r, &g); err != nil {
+		return nil, err
+	}
+	if err := h.enforceGroups(ctx, rbac.ActionUpdate); err != nil {
+		return nil, err
+	}
+	if !groupNameRegexp.MatchString(g.DisplayName) {
+		return nil, badRequest("invalidValue", "invalid displayName '%s': must not contain ',', ':' or '\"'", g.DisplayName)
+	}
+	if _, err := h.settingsMgr.GetAccount(g.DisplayName); err == nil {
+		return nil, &httpError{status: http.StatusConflict, scimType: "uniqueness", detail: fmt.Sprintf("displayName '%s' is already the name of a local account", g.DisplayName)}
+	}
+	members, err := h.memberNames(g.Members)
+	if err != nil {
+		return nil, err
+	}
+	err = h.updateGroups(ctx, func(groups map[string][]string) error {
+		if _, ok := groups[g.DisplayName]; ok {
+			return &httpError{status: http.StatusConflict, scimType: "uniqueness", detail: fmt.Sprintf("group '%s' already exists", g.DisplayName)}
+		}
+		groups[g.DisplayName] = members
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Infof("user '%s' provisioned group '%s' through SCIM", session.GetUserIdentifier(ctx), g.DisplayName)
+	return toGroup(g.DisplayName, members), nil
+}
+
+// updateGroup replaces the members of an existing group by the result of the given function
+func (h *Handler) updateGroup(ctx context.Context, name string, update func(members []string) ([]string, error)) (any, error) {
+	if err := h.enforceGroups(ctx, rbac.ActionUpdate); err != nil {
+		return nil, err
+	}
+	var updated []string
+	err := h.updateGroups(ctx, func(groups map[string][]string) error {
+		members, ok := groups[name]
+		if !ok {
+			return &httpError{status: http.StatusNotFound, detail: fmt.Sprintf("group '%s' does not exist", name)}
+		}
+		members, err := update(members)
+		if err != nil {
+			return err
+		}
+		groups[name] = members
+		updated = members
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Infof("user '%s' updated group '%s' through SCIM", session.GetUserIdentifier(ctx), name)
+	return toGroup(name, updated), nil
+}
+
+func (h *Handler) replaceGroup(ctx context.Context, name string, r *http.Request) (any, error) {
+	var g group
+	if err := decodeBody(r, &g); err != nil {
+		return nil, err
+	}
+	if g.DisplayName != "" && g.DisplayName != name {
+		return nil, badRequest("mutability", "displayName of '%s' cannot be changed", name)
+	}
+	members, err := h.memberNames(g.Members)
+	if err != nil {
+		return nil, err
+	}
+	return h.updateGroup(ctx, name, func(_ []string) ([]string, error) {
+		return members, nil
+	})
+}
+
+func (h *Handler) patchGroup(ctx context.Context, name string, r *http.Request) (any, error) {
+	var req patchRequest
+	if err := decodeBody(r, &req); err != nil {
+		return nil, err
+	}
+	// resolve the members of all operations before applying them, so that a conflicting update can be retried
+	type memberOperation struct {
+		op      string
+		members []string
+	}
+	var ops []memberOperation
+	for _, op := range req.Operations {
+		opName := strings.ToLower(op.Op)
+		if opName != "add" && opName != "remove" && opName != "replace" {
+			return nil, badRequest("invalidValue", "unsupported operation '%s' on a group", op.Op)
+		}
+		if match := memberPathRegexp.FindStringSubmatch(op.Path); match != nil && opName == "remove" {
+			ops = append(ops, memberOperation{op: opName, members: []string{match[1]}})
+			continue
+		}
+		var refs []reference
+		switch {
+		case strings.EqualFold(op.Path, "members"):
+			if len(op.Value) > 0 {
+				if err := json.Unmarshal(op.Value, &refs); err != nil {
+					return nil, badRequest("invalidValue", "invalid members: %v", err)
+				}
+			}
+		case op.Path == "" && opName != "remove":
+			var g group
+			if err := json.Unmarshal(op.Value, &g); err != nil {
+				return nil, badRequest("invalidValue", "invalid value of operation '%s': %v", op.Op, err)
+			}
+			if g.DisplayName != "" && g.DisplayName != name {
+				return nil, badRequest("mutability", "displayName of '%s' cannot be changed", name)
+			}
+			refs = g.Members
+		default:
+			return nil, badRequest("invalidPath", "unsupported path '%s': only members can be changed", op.Path)
+		}
+		if opName == "remove" {
+			if len(refs) == 0 {
+				ops = append(ops, memberOperation{op: "replace"})
+				continue
+			}
+			var members []string
+			for _, ref := range refs {
+				members = append(members, ref.Value)
+			}
+			ops = append(ops, memberOperation{op: opName, members: members})
+			continue
+		}
+		members, err := h.memberNames(refs)
+		if err != nil {
+			return nil, err
+		}
+		ops = append(ops, memberOperation{op: opName, members: members})
+	}
+	return h.updateGroup(ctx, name, func(members []string) ([]string, error) {
+		for _, op := range ops {
+			switch op.op {
+			case "add":
+				for _, m := range op.members {
+					if !slices.Contains(members, m) {
+						members = append(members, m)
+					}
+				}
+			case "remove":
+				members = slices.DeleteFunc(members, func(m string) bool { return slices.Contains(op.members, m) })
+			case "replace":
+				members = slices.Clone(op.members)
+			}
+		}
+		return members, nil
+	})
+}
+
+func (h *Handler) deleteGroup(ctx context.Context, name string) error {
+	if err := h.enforceGroups(ctx, rbac.ActionUpdate); err != nil {
+		return err
+	}
+	err := h.updateGroups(ctx, func(groups map[string][]string) error {
+		if _, ok := groups[name]; !ok {
+			return &httpError{status: http.StatusNotFound, detail: fmt.Sprintf("group '%s' does not exist", name)}
+		}
+		delete(groups, name)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	log.Infof("user '%s' deprovisioned group '%s' through SCIM", session.GetUserIdentifier(ctx), name)
+	return nil
+}
+
+func parseGroups(cm *corev1.ConfigMap) (map[string][]string, error) {
+	groups := map[string][]string{}
+	if data := cm.Data[groupsKey]; data != "" {
+		if err := yaml.Unmarshal([]byte(data), &groups); err != nil {
+			return nil, fmt.Errorf("failed to parse %s of %s: %w", groupsKey, common.ArgoCDRBACConfigMapName, err)
+		}
+	}
+	for name, members := range groups {
+		if members == nil {
+			groups[name] = []string{}
+		}
+	}
+	return groups, nil
+}
+
+// groupsPolicy returns the RBAC policy which assigns the groups to their members
+func groupsPolicy(groups map[string][]string) string {
+	var lines []string
+	for _, name := range sortedKeys(groups) {
+		for _, member := range groups[name] {
+			lines = append(lines, fmt.Sprintf("g, %s, %s", member, name))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// getGroups returns the members of the provisioned groups by group name
+func (h *Handler) getGroups(ctx context.Context) (map[string][]string, error) {
+	cm, err := h.kubeclientset.CoreV1().ConfigMaps(h.namespace).Get(ctx, common.ArgoCDRBACConfigMapName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return map[string][]string{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s: %w", common.ArgoCDRBACConfigMapName, err)
+	}
+	return parseGroups(cm)
+}
+
+// updateGroups applies the given function to the provisioned groups and persists them together with the policy
+// assigning them to their members
+func (h *Handler) updateGroups(ctx context.Context, update func(groups map[string][]string) error) error {
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		configMaps := h.kubeclientset.CoreV1().ConfigMaps(h.namespace)
+		cm, err := configMaps.Get(ctx, common.ArgoCDRBACConfigMapName, metav1.GetOptions{})
+		create := apierrors.IsNotFound(err)
+		if create {
+			cm = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+				Name:   common.ArgoCDRBACConfigMapName,
+				Labels: map[string]string{"app.kubernetes.io/part-of": "argocd"},
+			}}
+		} else if err != nil {
+			return fmt.Errorf("failed to get %s: %w", common.ArgoCDRBACConfigMapName, err)
+		}
+		groups, err := parseGroups(cm)
+		if err != nil {
+			return err
+		}
+		if err := update(groups); err != nil {
+			return err
+		}
+		data, err := yaml.Marshal(groups)
+		if err != nil {
+			return fmt.Errorf("failed to marshal groups: %w", err)
+		}
+		if cm.Data == nil {
+			cm.Data = map[string]string{}
+		}
+		cm.Data[groupsKey] = string(data)
+		cm.Data[groupsPolicyKey] = groupsPolicy(groups)
+		if create {
+			_, err = configMaps.Create(ctx, cm, metav1.CreateOptions{})
+		} else {
+			_, err = configMaps.Update(ctx, cm, metav1.UpdateOptions{})
+		}
+		return err
+	})
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,282 @@
+package scim
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/password"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+const testNamespace = "default"
+
+type fakeTokenVerifier struct{}
+
+func (fakeTokenVerifier) VerifyToken(token string) (jwt.Claims, string, error) {
+	if token != "provisioner-token" && token != "reader-token" {
+		return nil, "", errors.New("invalid token")
+	}
+	return jwt.MapClaims{"sub": strings.TrimSuffix(token, "-token")}, "", nil
+}
+
+func newTestHandler(t *testing.T, scimEnabled string) (*Handler, *fake.Clientset) {
+	t.Helper()
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "argocd-cm",
+			Namespace: testNamespace,
+			Labels:    map[string]string{"app.kubernetes.io/part-of": "argocd"},
+		},
+		Data: map[string]string{"scim.enabled": scimEnabled, "accounts.alice": "login"},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "argocd-secret", Namespace: testNamespace},
+		Data:       map[string][]byte{"server.secretkey": []byte("test")},
+	}
+	rbacCM := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDRBACConfigMapName, Namespace: testNamespace},
+		Data:       map[string]string{"policy.csv": "p, role:readonly, applications, get, */*, allow"},
+	}
+	kubeclientset := fake.NewClientset(cm, secret, rbacCM)
+	settingsMgr := settings.NewSettingsManager(t.Context(), kubeclientset, testNamespace)
+	enf := rbac.NewEnforcer(kubeclientset, testNamespace, common.ArgoCDRBACConfigMapName, nil)
+	enf.SetClaimsEnforcerFunc(func(claims jwt.Claims, rvals ...any) bool {
+		sub := claims.(jwt.MapClaims)["sub"]
+		return sub == "provisioner" || (sub == "reader" && rvals[2] == rbac.ActionGet)
+	})
+	return NewHandler(testNamespace, kubeclientset, settingsMgr, enf, fakeTokenVerifier{}, argo.NewAuditLogger(kubeclientset, "argocd-server", []string{"all"})), kubeclientset
+}
+
+func doRequest(t *testing.T, h http.Handler, token, method, path, body string) (*httptest.ResponseRecorder, map[string]any) {
+	t.Helper()
+	req := httptest.NewRequest(method, URLPrefix+path, strings.NewReader(body))
+	req.Header.Set("Content-Type", ContentType)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, req)
+	res := map[string]any{}
+	if rr.Body.Len() > 0 {
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &res))
+	}
+	return rr, res
+}
+
+func TestHandler_Users(t *testing.T) {
+	h, _ := newTestHandler(t, "true")
+
+	rr, res := doRequest(t, h, "provisioner-token", http.MethodPost, "/Users", `{"schemas":["urn:ietf:params:scim:schemas:core:2.0:User"],"userName":"bob","active":true,"password":"Password123!"}`)
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	assert.Equal(t, ContentType, rr.Header().Get("Content-Type"))
+	assert.Equal(t, "bob", res["id"])
+	assert.Equal(t, true, res["active"])
+	assert.NotContains(t, res, "password")
+	bob, err := h.settingsMgr.GetAccount("bob")
+	require.NoError(t, err)
+	assert.True(t, bob.Enabled)
+	assert.True(t, bob.HasCapability(settings.AccountCapabilityLogin))
+	valid, _ := password.VerifyPassword("Password123!", bob.PasswordHash)
+	assert.True(t, valid)
+
+	rr, res = doRequest(t, h, "provisioner-token", http.MethodPost, "/Users", `{"userName":"bob"}`)
+	assert.Equal(t, http.StatusConflict, rr.Code)
+	assert.Equal(t, "uniqueness", res["scimType"])
+
+	rr, _ = doRequest(t, h, "provisioner-token", http.MethodPost, "/Users", `{"userName":"@."}`)
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+	rr, res = doRequest(t, h, "provisioner-token", http.MethodGet, `/Users?filter=userName%20eq%20"bob"`, "")
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.InDelta(t, 1, res["totalResults"], 0)
+	assert.Equal(t, "bob", res["Resources"].([]any)[0].(map[string]any)["userName"])
+
+	rr, res = doRequest(t, h, "provisioner-token", http.MethodGet, "/Users?startIndex=2&count=1", "")
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.InDelta(t, 3, res["totalResults"], 0)
+	assert.Equal(t, "alice", res["Resources"].([]any)[0].(map[string]any)["userName"])
+
+	rr, _ = doRequest(t, h, "provisioner-token", http.MethodGet, `/Users?filter=emails%20co%20"example"`, "")
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+	rr, res = doRequest(t, h, "provisioner-token", http.MethodPatch, "/Users/bob", `{"Operations":[{"op":"Replace","path":"active","value":"False"}]}`)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	assert.Equal(t, false, res["active"])
+	bob, err = h.settingsMgr.GetAccount("bob")
+	require.NoError(t, err)
+	assert.False(t, bob.Enabled)
+
+	rr, res = doRequest(t, h, "provisioner-token", http.MethodPut, "/Users/bob", `{"userName":"bob","active":true}`)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	assert.Equal(t, true, res["active"])
+
+	rr, _ = doRequest(t, h, "provisioner-token", http.MethodPut, "/Users/bob", `{"userName":"robert"}`)
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+	rr, _ = doRequest(t, h, "reader-token", http.MethodDelete, "/Users/bob", "")
+	assert.Equal(t, http.StatusForbidden, rr.Code)
+
+	rr, _ = doRequest(t, h, "provisioner-token", http.MethodDelete, "/Users/bob", "")
+	assert.Equal(t, http.StatusNoContent, rr.Code)
+	_, err = h.settingsMgr.GetAccount("bob")
+	require.Error(t, err)
+
+	rr, _ = doRequest(t, h, "provisioner-token", http.MethodGet, "/Users/bob", "")
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func TestHandler_DeprovisioningRevokesAccess(t *testing.T) {
+	h, kubeclientset := newTestHandler(t, "true")
+	grantAccess := func(account *settings.Account) error {
+		account.SessionNonce = "nonce"
+		account.Tokens = []settings.Token{{ID: "token"}}
+		return nil
+	}
+	eventMessages := func() []string {
+		events, err := kubeclientset.CoreV1().Events(testNamespace).List(t.Context(), metav1.ListOptions{})
+		require.NoError(t, err)
+		var messages []string
+		for _, event := range events.Items {
+			messages = append(messages, event.Message)
+		}
+		return messages
+	}
+
+	require.NoError(t, h.settingsMgr.UpdateAccount("alice", grantAccess))
+	rr, _ := doRequest(t, h, "provisioner-token", http.MethodPatch, "/Users/alice", `{"Operations":[{"op":"replace","value":{"active":false,"password":"Password123!"}}]}`)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	alice, err := h.settingsMgr.GetAccount("alice")
+	require.NoError(t, err)
+	assert.NotEqual(t, "nonce", alice.SessionNonce)
+	assert.Empty(t, alice.Tokens)
+	assert.Contains(t, eventMessages(), "provisioner deactivated account alice through SCIM")
+	assert.Contains(t, eventMessages(), "provisioner changed the password of account alice through SCIM")
+
+	require.NoError(t, h.settingsMgr.UpdateAccount("alice", grantAccess))
+	rr, _ = doRequest(t, h, "provisioner-token", http.MethodDelete, "/Users/alice", "")
+	require.Equal(t, http.StatusNoContent, rr.Code, rr.Body.String())
+	assert.Contains(t, eventMessages(), "provisioner deprovisioned account alice through SCIM")
+
+	// a provisioned account does not accept the session tokens of a deleted account with the same name
+	rr, _ = doRequest(t, h, "provisioner-token", http.MethodPost, "/Users", `{"userName":"alice"}`)
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	alice, err = h.settingsMgr.GetAccount("alice")
+	require.NoError(t, err)
+	assert.NotEmpty(t, alice.SessionNonce)
+}
+
+func TestHandler_UsersWithEmailUserName(t *testing.T) {
+	h, _ := newTestHandler(t, "true")
+
+	rr, res := doRequest(t, h, "provisioner-token", http.MethodPost, "/Users", `{"userName":"bob.smith@example.com"}`)
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	assert.Equal(t, "bob-smith-example-com", res["id"])
+	assert.Equal(t, "bob-smith-example-com", res["userName"])
+	assert.Equal(t, "bob.smith@example.com", res["externalId"])
+	bob, err := h.settingsMgr.GetAccount("bob-smith-example-com")
+	require.NoError(t, err)
+	assert.Equal(t, "bob.smith@example.com", bob.ExternalID)
+
+	rr, _ = doRequest(t, h, "provisioner-token", http.MethodPost, "/Users", `{"userName":"bob.smith@example.com"}`)
+	assert.Equal(t, http.StatusConflict, rr.Code)
+
+	for _, filter := range []string{`userName%20eq%20"bob.smith@example.com"`, `externalId%20eq%20"bob.smith@example.com"`, `userName%20eq%20"bob-smith-example-com"`} {
+		rr, res = doRequest(t, h, "provisioner-token", http.MethodGet, "/Users?filter="+filter, "")
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.InDelta(t, 1, res["totalResults"], 0, filter)
+	}
+	rr, res = doRequest(t, h, "provisioner-token", http.MethodGet, `/Users?filter=id%20eq%20"bob.smith@example.com"`, "")
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.InDelta(t, 0, res["totalResults"], 0)
+
+	rr, res = doRequest(t, h, "provisioner-token", http.MethodPut, "/Users/bob-smith-example-com", `{"userName":"bob.smith@example.com","active":false}`)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	assert.Equal(t, false, res["active"])
+	assert.Equal(t, "bob.smith@example.com", res["externalId"])
+}
+
+func TestHandler_Groups(t *testing.T) {
+	h, kubeclientset := newTestHandler(t, "true")
+	_, _ = doRequest(t, h, "provisioner-token", http.MethodPost, "/Users", `{"userName":"bob"}`)
+
+	rbacPolicy := func() string {
+		cm, err := kubeclientset.CoreV1().ConfigMaps(testNamespace).Get(t.Context(), common.ArgoCDRBACConfigMapName, metav1.GetOptions{})
+		require.NoError(t, err)
+		return cm.Data["policy.scim.csv"]
+	}
+
+	rr, res := doRequest(t, h, "provisioner-token", http.MethodPost, "/Groups", `{"displayName":"Platform Team","members":[{"value":"alice"}]}`)
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	assert.Equal(t, "Platform Team", res["id"])
+	assert.Equal(t, "g, alice, Platform Team", rbacPolicy())
+
+	rr, _ = doRequest(t, h, "provisioner-token", http.MethodPost, "/Groups", `{"displayName":"role:admin"}`)
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	rr, _ = doRequest(t, h, "provisioner-token", http.MethodPost, "/Groups", `{"displayName":"alice"}`)
+	assert.Equal(t, http.StatusConflict, rr.Code)
+	rr, _ = doRequest(t, h, "provisioner-token", http.MethodPost, "/Groups", `{"displayName":"devs","members":[{"value":"unknown"}]}`)
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	rr, _ = doRequest(t, h, "reader-token", http.MethodPost, "/Groups", `{"displayName":"devs"}`)
+	assert.Equal(t, http.StatusForbidden, rr.Code)
+
+	rr, _ = doRequest(t, h, "provisioner-token", http.MethodPatch, "/Groups/Platform%20Team", `{"Operations":[{"op":"add","path":"members","value":[{"value":"bob"}]}]}`)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	assert.Equal(t, "g, alice, Platform Team\ng, bob, Platform Team", rbacPolicy())
+
+	rr, res = doRequest(t, h, "reader-token", http.MethodGet, "/Users/bob", "")
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, []any{map[string]any{"value": "Platform Team", "display": "Platform Team"}}, res["groups"])
+
+	rr, _ = doRequest(t, h, "provisioner-token", http.MethodPatch, "/Groups/Platform%20Team", `{"Operations":[{"op":"remove","path":"members[value eq \"alice\"]"}]}`)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	assert.Equal(t, "g, bob, Platform Team", rbacPolicy())
+
+	rr, res = doRequest(t, h, "reader-token", http.MethodGet, `/Groups?filter=displayName%20eq%20"Platform%20Team"`, "")
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.InDelta(t, 1, res["totalResults"], 0)
+
+	rr, _ = doRequest(t, h, "provisioner-token", http.MethodDelete, "/Users/bob", "")
+	require.Equal(t, http.StatusNoContent, rr.Code)
+	assert.Empty(t, rbacPolicy())
+
+	rr, _ = doRequest(t, h, "provisioner-token", http.MethodPut, "/Groups/Platform%20Team", `{"displayName":"Platform Team","members":[{"value":"alice"}]}`)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	assert.Equal(t, "g, alice, Platform Team", rbacPolicy())
+
+	rr, _ = doRequest(t, h, "provisioner-token", http.MethodDelete, "/Groups/Platform%20Team", "")
+	require.Equal(t, http.StatusNoContent, rr.Code)
+	assert.Empty(t, rbacPolicy())
+	rr, _ = doRequest(t, h, "provisioner-token", http.MethodGet, "/Groups/Platform%20Team", "")
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func TestHandler_Authentication(t *testing.T) {
+	h, _ := newTestHandler(t, "true")
+	rr, res := doRequest(t, h, "", http.MethodGet, "/Users", "")
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	assert.Equal(t, "401", res["status"])
+	rr, _ = doRequest(t, h, "invalid-token", http.MethodGet, "/Users", "")
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+
+	rr, res = doRequest(t, h, "reader-token", http.MethodGet, "/ServiceProviderConfig", "")
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, map[string]any{"supported": true}, res["patch"])
+
+	h, _ = newTestHandler(t, "false")
+	rr, _ = doRequest(t, h, "provisioner-token", http.MethodGet, "/Users", "")
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
@@ -101,10 +101,12 @@ import (
 	"github.com/argoproj/argo-cd/v3/server/rbacpolicy"
 	"github.com/argoproj/argo-cd/v3/server/repocreds"
 	"github.com/argoproj/argo-cd/v3/server/repository"
+	"github.com/argoproj/argo-cd/v3/server/scim"
 	"github.com/argoproj/argo-cd/v3/server/session"
 	"github.com/argoproj/argo-cd/v3/server/settings"
 	"github.com/argoproj/argo-cd/v3/server/version"
 	"github.com/argoproj/argo-cd/v3/ui"
+	"github.com/argoproj/argo-cd/v3/util/argo"
 	"github.com/argoproj/argo-cd/v3/util/assets"
 	cacheutil "github.com/argoproj/argo-cd/v3/util/cache"
 	"github.com/argoproj/argo-cd/v3/util/db"
@@ -1012,14 +1014,14 @@ type ArgoCDServiceSet struct {
 
 func newArgoCDServiceSet(a *ArgoCDServer) *ArgoCDServiceSet {
 	kubectl := kubeutil.NewKubectl()
-	clusterService := cluster.NewServer(a.db, a.enf, a.Cache, kubectl)
+	clusterService := cluster.NewServer(a.db, a.enf, a.Cache, kubectl, a.settingsMgr)
 	repoService := repository.NewServer(a.RepoClientset, a.db, a.enf, a.Cache, a.appLister, a.projInformer, a.Namespace, a.settingsMgr, a.HydratorEnabled)
 	repoCredsService := repocreds.NewServer(a.db, a.enf)
 	var loginRateLimiter func() (utilio.Closer, error)
 	if maxConcurrentLoginRequestsCount > 0 {
 		loginRateLimiter = session.NewLoginRateLimiter(maxConcurrentLoginRequestsCount)
 	}
-	sessionService := session.NewServer(a.sessionMgr, a.settingsMgr, a, a.policyEnforcer, loginRateLimiter)
+	sessionService := session.NewServer(a.sessionMgr, a.settingsMgr, a, a.policyEnforcer, loginRateLimiter, argo.NewAuditLogger(a.KubeClientset, "argocd-server", a.EnableK8sEvent))
 	projectLock := sync.NewKeyLock()
 	applicationService, appResourceTreeFn := application.NewServer(
 		a.Namespace,
@@ -1066,7 +1068,7 @@ func newArgoCDServiceSet(a *ArgoCDServer) *ArgoCDServiceSet {
 	projectService := project.NewServer(a.Namespace, a.KubeClientset, a.AppClientset, a.enf, projectLock, a.sessionMgr, a.policyEnforcer, a.projInformer, a.settingsMgr, a.db, a.EnableK8sEvent)
 	appsInAnyNamespaceEnabled := len(a.ApplicationNamespaces) > 0
 	settingsService := settings.NewServer(a.settingsMgr, a.RepoClientset, a, a.DisableAuth, appsInAnyNamespaceEnabled, a.HydratorEnabled)
-	accountService := account.NewServer(a.sessionMgr, a.settingsMgr, a.enf)
+	accountService := account.NewServer(a.sessionMgr, a.settingsMgr, a.enf, a.policyEnforcer, a.KubeClientset, a.EnableK8sEvent)
 
 	notificationService := notification.NewServer(a.apiFactory)
 	certificateService := certificate.NewServer(a.db, a.enf)
@@ -1209,6 +1211,16 @@ func (server *ArgoCDServer) newHTTPServer(ctx context.Context, port int, grpcWeb
 	th := util_session.WithAuthMiddleware(server.DisableAuth, server.sessionMgr, terminal)
 	mux.Handle("/terminal", th)
 
+	portForward := application.NewPortForwardHandler(server.appLister, server.Namespace, server.ApplicationNamespaces, server.db, appResourceTreeFn, server.sessionMgr, &terminalOpts).
+		WithFeatureFlagMiddleware(server.settingsMgr.GetSettings)
+	mux.Handle("/port-forward", util_session.WithAuthMiddleware(server.DisableAuth, server.sessionMgr, portForward))
+
+	// SCIM endpoint to provision local accounts, which authenticates and authorizes requests itself
+	mux.Handle(scim.URLPrefix+"/", scim.NewHandler(server.Namespace, server.KubeClientset, server.settingsMgr, server.enf, server.sessionMgr, argo.NewAuditLogger(server.KubeClientset, "argocd-server", server.EnableK8sEvent)))
+
+	// OAuth 2.0 token endpoint to exchange client credentials of local accounts for short-lived tokens
+	mux.HandleFunc(common.TokenEndpoint, account.NewTokenHandler(server.sessionMgr))
+
 	// Proxy extension is currently an alpha feature and is disabled
 	// by default.
 	if server.EnableProxyExtension {
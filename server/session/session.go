@@ -7,11 +7,15 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/settings"
 
 	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
 
+	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/session"
 	"github.com/argoproj/argo-cd/v3/server/rbacpolicy"
+	"github.com/argoproj/argo-cd/v3/util/argo"
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
 	sessionmgr "github.com/argoproj/argo-cd/v3/util/session"
 )
@@ -23,6 +27,7 @@ type Server struct {
 	authenticator      Authenticator
 	policyEnf          *rbacpolicy.RBACPolicyEnforcer
 	limitLoginAttempts func() (utilio.Closer, error)
+	auditLogger        *argo.AuditLogger
 }
 
 type Authenticator interface {
@@ -35,8 +40,8 @@ const (
 )
 
 // NewServer returns a new instance of the Session service
-func NewServer(mgr *sessionmgr.SessionManager, settingsMgr *settings.SettingsManager, authenticator Authenticator, policyEnf *rbacpolicy.RBACPolicyEnforcer, rateLimiter func() (utilio.Closer, error)) *Server {
-	return &Server{mgr, settingsMgr, authenticator, policyEnf, rateLimiter}
+func NewServer(mgr *sessionmgr.SessionManager, settingsMgr *settings.SettingsManager, authenticator Authenticator, policyEnf *rbacpolicy.RBACPolicyEnforcer, rateLimiter func() (utilio.Closer, error), auditLogger *argo.AuditLogger) *Server {
+	return &Server{mgr, settingsMgr, authenticator, policyEnf, rateLimiter, auditLogger}
 }
 
 // Create generates a JWT token signed by Argo CD intended for web/CLI logins of the admin user
@@ -59,17 +64,28 @@ func (s *Server) Create(_ context.Context, q *session.SessionCreateRequest) (*se
 		s.mgr.IncLoginRequestCounter(failure)
 		return nil, status.Errorf(codes.Unauthenticated, "no credentials supplied")
 	}
-	err := s.mgr.VerifyUsernamePassword(q.Username, q.Password)
+	argoCDSettings, err := s.settingsMgr.GetSettings()
 	if err != nil {
 		s.mgr.IncLoginRequestCounter(failure)
 		return nil, err
 	}
-	uniqueId, err := uuid.NewRandom()
+	if ldapConfig := argoCDSettings.LDAPConfig(); ldapConfig != nil {
+		if _, err := s.settingsMgr.GetAccount(q.Username); status.Code(err) == codes.NotFound {
+			return s.createLDAPSession(ldapConfig, argoCDSettings, q.Username, q.Password)
+		}
+	}
+	err = s.mgr.VerifyUsernamePassword(q.Username, q.Password)
 	if err != nil {
 		s.mgr.IncLoginRequestCounter(failure)
+		s.logLoginEvent(q.Username, false, err.Error())
 		return nil, err
 	}
-	argoCDSettings, err := s.settingsMgr.GetSettings()
+	if err := s.verifyPasswordNotExpired(q.Username); err != nil {
+		s.mgr.IncLoginRequestCounter(failure)
+		s.logLoginEvent(q.Username, false, err.Error())
+		return nil, err
+	}
+	uniqueId, err := uuid.NewRandom()
 	if err != nil {
 		s.mgr.IncLoginRequestCounter(failure)
 		return nil, err
@@ -83,14 +99,100 @@ func (s *Server) Create(_ context.Context, q *session.SessionCreateRequest) (*se
 		return nil, err
 	}
 	s.mgr.IncLoginRequestCounter(success)
+	s.logLoginEvent(q.Username, true, "")
+	return &session.SessionResponse{Token: jwtToken}, nil
+}
+
+// createLDAPSession generates a JWT token for a user without local account, which is authenticated against the LDAP
+// server of the given config. The token holds the groups of the user in the LDAP directory.
+func (s *Server) createLDAPSession(ldapConfig *settings.LDAPConfig, argoCDSettings *settings.ArgoCDSettings, username string, password string) (*session.SessionResponse, error) {
+	groups, err := s.mgr.VerifyLDAPUsernamePassword(ldapConfig, username, password)
+	if err != nil {
+		s.mgr.IncLoginRequestCounter(failure)
+		return nil, err
+	}
+	uniqueId, err := uuid.NewRandom()
+	if err != nil {
+		s.mgr.IncLoginRequestCounter(failure)
+		return nil, err
+	}
+	jwtToken, err := s.mgr.CreateLDAP(username, groups, int64(argoCDSettings.UserSessionDuration.Seconds()), uniqueId.String())
+	if err != nil {
+		s.mgr.IncLoginRequestCounter(failure)
+		return nil, err
+	}
+	s.mgr.IncLoginRequestCounter(success)
 	return &session.SessionResponse{Token: jwtToken}, nil
 }
 
+// logLoginEvent records an audit event about a login attempt of a local account. Attempts for unknown accounts are
+// not recorded, so that the events cannot be flooded with arbitrary account names.
+func (s *Server) logLoginEvent(username string, succeeded bool, reason string) {
+	if s.auditLogger == nil {
+		return
+	}
+	if _, err := s.settingsMgr.GetAccount(username); err != nil {
+		return
+	}
+	eventInfo := argo.EventInfo{Type: corev1.EventTypeNormal, Reason: argo.EventReasonLoginSucceeded}
+	message := username + " logged in"
+	if !succeeded {
+		eventInfo = argo.EventInfo{Type: corev1.EventTypeWarning, Reason: argo.EventReasonLoginFailed}
+		message = fmt.Sprintf("%s failed to log in: %s", username, reason)
+	}
+	s.auditLogger.LogAccountEvent(username, s.settingsMgr.GetNamespace(), eventInfo, message, username)
+}
+
+// verifyPasswordNotExpired rejects the login of local users whose password is older than the maximum age of the
+// password policy. The admin user is exempt, so that there is always a way to reset the passwords of other users, and
+// an invalid password policy does not lock out anyone.
+func (s *Server) verifyPasswordNotExpired(username string) error {
+	passwordPolicy, err := s.settingsMgr.GetPasswordPolicy()
+	if err != nil {
+		log.Warnf("Ignoring invalid password policy: %v", err)
+		return nil
+	}
+	if passwordPolicy.MaxAge == 0 {
+		return nil
+	}
+	account, err := s.settingsMgr.GetAccount(username)
+	if err != nil {
+		return err
+	}
+	if !passwordPolicy.IsExpired(account.PasswordMtime) {
+		return nil
+	}
+	if username == common.ArgoCDAdminUsername {
+		log.Warnf("Password of account %s has expired, it should be updated", username)
+		return nil
+	}
+	return status.Errorf(codes.Unauthenticated, "password of account %s has expired, it must be reset by an administrator", username)
+}
+
 // Delete an authentication cookie from the client.  This makes sense only for the Web client.
 func (s *Server) Delete(_ context.Context, _ *session.SessionDeleteRequest) (*session.SessionResponse, error) {
 	return &session.SessionResponse{Token: ""}, nil
 }
 
+// RevokeAll revokes all session tokens issued to the current local account, including the one of this request
+func (s *Server) RevokeAll(ctx context.Context, _ *session.SessionRevokeAllRequest) (*session.SessionResponse, error) {
+	if !sessionmgr.LoggedIn(ctx) {
+		return nil, status.Errorf(codes.Unauthenticated, "no session information")
+	}
+	username := sessionmgr.GetUserIdentifier(ctx)
+	if sessionmgr.Iss(ctx) != sessionmgr.SessionManagerClaimsIssuer || rbacpolicy.IsProjectSubject(username) {
+		return nil, status.Errorf(codes.InvalidArgument, "sessions can only be revoked for local accounts, not user %q", username)
+	}
+	if err := s.mgr.RevokeSessions(username); err != nil {
+		return nil, fmt.Errorf("failed to revoke sessions of account %s: %w", username, err)
+	}
+	if s.auditLogger != nil {
+		eventInfo := argo.EventInfo{Type: corev1.EventTypeNormal, Reason: argo.EventReasonResourceUpdated}
+		s.auditLogger.LogAccountEvent(username, s.settingsMgr.GetNamespace(), eventInfo, username+" revoked all sessions", username)
+	}
+	return &session.SessionResponse{}, nil
+}
+
 // AuthFuncOverride overrides the authentication function and let us not require auth to receive auth.
 // Without this function here, ArgoCDServer.authenticate would be invoked and credentials checked.
 // Since this service is generally invoked when the user has _no_ credentials, that would create a
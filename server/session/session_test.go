@@ -0,0 +1,59 @@
+package session
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+func newTestSessionServer(t *testing.T, maxAge string) *Server {
+	t.Helper()
+	expired := time.Now().Add(-100 * 24 * time.Hour).Format(time.RFC3339)
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDConfigMapName, Namespace: "argocd", Labels: map[string]string{"app.kubernetes.io/part-of": "argocd"}},
+		Data: map[string]string{
+			"accounts.alice":        "login",
+			"passwordPolicy.maxAge": maxAge,
+		},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDSecretName, Namespace: "argocd", Labels: map[string]string{"app.kubernetes.io/part-of": "argocd"}},
+		Data: map[string][]byte{
+			"admin.password":               []byte("hash"),
+			"admin.passwordMtime":          []byte(expired),
+			"accounts.alice.password":      []byte("hash"),
+			"accounts.alice.passwordMtime": []byte(expired),
+			"server.secretkey":             []byte("test"),
+		},
+	}
+	return &Server{settingsMgr: settings.NewSettingsManager(t.Context(), fake.NewClientset(cm, secret), "argocd")}
+}
+
+func TestVerifyPasswordNotExpired(t *testing.T) {
+	t.Run("Expired", func(t *testing.T) {
+		err := newTestSessionServer(t, "90d").verifyPasswordNotExpired("alice")
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("NotExpired", func(t *testing.T) {
+		require.NoError(t, newTestSessionServer(t, "365d").verifyPasswordNotExpired("alice"))
+	})
+
+	t.Run("AdminIsExempt", func(t *testing.T) {
+		require.NoError(t, newTestSessionServer(t, "90d").verifyPasswordNotExpired(common.ArgoCDAdminUsername))
+	})
+
+	t.Run("InvalidPolicyIsIgnored", func(t *testing.T) {
+		require.NoError(t, newTestSessionServer(t, "ninety days").verifyPasswordNotExpired("alice"))
+	})
+}
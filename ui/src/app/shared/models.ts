@@ -33,6 +33,8 @@ export interface SyncOperation {
     prune: boolean;
     dryRun: boolean;
     resources?: SyncOperationResource[];
+    resourceSelector?: string;
+    reason?: string;
 }
 
 export interface RetryBackoff {
@@ -327,6 +329,7 @@ export interface ApplicationSpec {
     ignoreDifferences?: ResourceIgnoreDifferences[];
     info?: Info[];
     revisionHistoryLimit?: number;
+    dependsOn?: string[];
 }
 
 export interface ResourceIgnoreDifferences {
@@ -350,6 +353,7 @@ export interface RevisionHistory {
     deployStartedAt: models.Time;
     deployedAt: models.Time;
     initiatedBy: OperationInitiator;
+    reason?: string;
 }
 
 export type SyncStatusCode = 'Unknown' | 'Synced' | 'OutOfSync';
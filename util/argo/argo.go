@@ -194,6 +194,76 @@ func FilterByCluster(apps []argoappv1.Application, cluster string) []argoappv1.A
 	return items
 }
 
+// FilterByClusterP returns application pointers which are deployed to the specified cluster
+func FilterByClusterP(apps []*argoappv1.Application, cluster string) []*argoappv1.Application {
+	if cluster == "" {
+		return apps
+	}
+	items := make([]*argoappv1.Application, 0)
+	for i := 0; i < len(apps); i++ {
+		if apps[i].Spec.Destination.Server == cluster || apps[i].Spec.Destination.Name == cluster {
+			items = append(items, apps[i])
+		}
+	}
+	return items
+}
+
+// FilterByHealth returns applications with the specified health status, compared case-insensitively
+func FilterByHealth(apps []argoappv1.Application, health string) []argoappv1.Application {
+	if health == "" {
+		return apps
+	}
+	items := make([]argoappv1.Application, 0)
+	for i := 0; i < len(apps); i++ {
+		if strings.EqualFold(string(apps[i].Status.Health.Status), health) {
+			items = append(items, apps[i])
+		}
+	}
+	return items
+}
+
+// FilterByHealthP returns application pointers with the specified health status, compared case-insensitively
+func FilterByHealthP(apps []*argoappv1.Application, health string) []*argoappv1.Application {
+	if health == "" {
+		return apps
+	}
+	items := make([]*argoappv1.Application, 0)
+	for i := 0; i < len(apps); i++ {
+		if strings.EqualFold(string(apps[i].Status.Health.Status), health) {
+			items = append(items, apps[i])
+		}
+	}
+	return items
+}
+
+// FilterBySyncStatus returns applications with the specified sync status, compared case-insensitively
+func FilterBySyncStatus(apps []argoappv1.Application, syncStatus string) []argoappv1.Application {
+	if syncStatus == "" {
+		return apps
+	}
+	items := make([]argoappv1.Application, 0)
+	for i := 0; i < len(apps); i++ {
+		if strings.EqualFold(string(apps[i].Status.Sync.Status), syncStatus) {
+			items = append(items, apps[i])
+		}
+	}
+	return items
+}
+
+// FilterBySyncStatusP returns application pointers with the specified sync status, compared case-insensitively
+func FilterBySyncStatusP(apps []*argoappv1.Application, syncStatus string) []*argoappv1.Application {
+	if syncStatus == "" {
+		return apps
+	}
+	items := make([]*argoappv1.Application, 0)
+	for i := 0; i < len(apps); i++ {
+		if strings.EqualFold(string(apps[i].Status.Sync.Status), syncStatus) {
+			items = append(items, apps[i])
+		}
+	}
+	return items
+}
+
 // FilterByName returns an application
 func FilterByName(apps []argoappv1.Application, name string) ([]argoappv1.Application, error) {
 	if name == "" {
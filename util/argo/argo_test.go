@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/argoproj/gitops-engine/pkg/health"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube/kubetest"
 	"github.com/stretchr/testify/assert"
@@ -624,6 +625,87 @@ func TestFilterByRepoP(t *testing.T) {
 	})
 }
 
+func TestFilterByClusterP(t *testing.T) {
+	apps := []*argoappv1.Application{
+		{Spec: argoappv1.ApplicationSpec{Destination: argoappv1.ApplicationDestination{Server: "https://kubernetes.default.svc"}}},
+		{Spec: argoappv1.ApplicationSpec{Destination: argoappv1.ApplicationDestination{Name: "other-cluster"}}},
+	}
+
+	t.Run("Empty filter", func(t *testing.T) {
+		res := FilterByClusterP(apps, "")
+		assert.Len(t, res, 2)
+	})
+
+	t.Run("Match by server", func(t *testing.T) {
+		res := FilterByClusterP(apps, "https://kubernetes.default.svc")
+		assert.Len(t, res, 1)
+	})
+
+	t.Run("Match by name", func(t *testing.T) {
+		res := FilterByClusterP(apps, "other-cluster")
+		assert.Len(t, res, 1)
+	})
+
+	t.Run("No match", func(t *testing.T) {
+		res := FilterByClusterP(apps, "willnotmatch")
+		assert.Empty(t, res)
+	})
+}
+
+func TestFilterByHealthP(t *testing.T) {
+	apps := []*argoappv1.Application{
+		{Status: argoappv1.ApplicationStatus{Health: argoappv1.AppHealthStatus{Status: health.HealthStatusHealthy}}},
+		{Status: argoappv1.ApplicationStatus{Health: argoappv1.AppHealthStatus{Status: health.HealthStatusDegraded}}},
+	}
+
+	t.Run("Empty filter", func(t *testing.T) {
+		res := FilterByHealthP(apps, "")
+		assert.Len(t, res, 2)
+	})
+
+	t.Run("Match", func(t *testing.T) {
+		res := FilterByHealthP(apps, "Degraded")
+		assert.Len(t, res, 1)
+	})
+
+	t.Run("Match ignoring case", func(t *testing.T) {
+		res := FilterByHealthP(apps, "healthy")
+		assert.Len(t, res, 1)
+	})
+
+	t.Run("No match", func(t *testing.T) {
+		res := FilterByHealthP(apps, "Missing")
+		assert.Empty(t, res)
+	})
+}
+
+func TestFilterBySyncStatusP(t *testing.T) {
+	apps := []*argoappv1.Application{
+		{Status: argoappv1.ApplicationStatus{Sync: argoappv1.SyncStatus{Status: argoappv1.SyncStatusCodeSynced}}},
+		{Status: argoappv1.ApplicationStatus{Sync: argoappv1.SyncStatus{Status: argoappv1.SyncStatusCodeOutOfSync}}},
+	}
+
+	t.Run("Empty filter", func(t *testing.T) {
+		res := FilterBySyncStatusP(apps, "")
+		assert.Len(t, res, 2)
+	})
+
+	t.Run("Match", func(t *testing.T) {
+		res := FilterBySyncStatusP(apps, "OutOfSync")
+		assert.Len(t, res, 1)
+	})
+
+	t.Run("Match ignoring case", func(t *testing.T) {
+		res := FilterBySyncStatusP(apps, "synced")
+		assert.Len(t, res, 1)
+	})
+
+	t.Run("No match", func(t *testing.T) {
+		res := FilterBySyncStatusP(apps, "Unknown")
+		assert.Empty(t, res)
+	})
+}
+
 func TestValidatePermissions(t *testing.T) {
 	t.Run("Empty Repo URL result in condition", func(t *testing.T) {
 		spec := argoappv1.ApplicationSpec{
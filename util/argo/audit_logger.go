@@ -12,6 +12,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 
+	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
 
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
@@ -43,6 +44,8 @@ const (
 	EventReasonResourceActionRan  = "ResourceActionRan"
 	EventReasonOperationStarted   = "OperationStarted"
 	EventReasonOperationCompleted = "OperationCompleted"
+	EventReasonLoginSucceeded     = "LoginSucceeded"
+	EventReasonLoginFailed        = "LoginFailed"
 )
 
 func (l *AuditLogger) logEvent(objMeta ObjectRef, gvk schema.GroupVersionKind, info EventInfo, message string, logFields map[string]string, eventLabels map[string]string) {
@@ -210,3 +213,26 @@ func setK8sEventList(enableK8sEvent []string) map[string]bool {
 func DefaultEnableEventList() []string {
 	return []string{"all"}
 }
+
+// LogAccountEvent records an event about a local account. Local accounts are stored in the argocd-cm ConfigMap, so
+// the event is attached to it and labeled with the account name.
+func (l *AuditLogger) LogAccountEvent(account, namespace string, info EventInfo, message, user string) {
+	if !l.enableK8SEventLog(info) {
+		return
+	}
+
+	objectMeta := ObjectRef{
+		Name:      common.ArgoCDConfigMapName,
+		Namespace: namespace,
+	}
+	fields := map[string]string{
+		"account": account,
+	}
+	if user != "" {
+		fields["user"] = user
+	}
+	eventLabels := map[string]string{
+		common.LabelKeyAccount: account,
+	}
+	l.logEvent(objectMeta, corev1.SchemeGroupVersion.WithKind("ConfigMap"), info, message, fields, eventLabels)
+}
@@ -81,6 +81,35 @@ func TestLogAppProjEvent(t *testing.T) {
 	assert.Empty(t, output)
 }
 
+func TestLogAccountEvent(t *testing.T) {
+	logger := NewAuditLogger(fake.NewClientset(), _somecomponent, testEnableEventLog)
+	assert.NotNil(t, logger)
+
+	ei := EventInfo{
+		Reason: _test,
+		Type:   "info",
+	}
+
+	output := captureLogEntries(func() {
+		logger.LogAccountEvent("alice", "argocd", ei, "This is a test message", "admin")
+	})
+
+	assert.Contains(t, output, "level=info")
+	assert.Contains(t, output, "account=alice")
+	assert.Contains(t, output, "user=admin")
+	assert.Contains(t, output, "name=argocd-cm")
+	assert.Contains(t, output, "msg=\"This is a test message\"")
+
+	ei.Reason = "Unknown"
+
+	// If K8s Event Disable Log
+	output = captureLogEntries(func() {
+		logger.LogAccountEvent("alice", "argocd", ei, "This is a test message", "admin")
+	})
+
+	assert.Empty(t, output)
+}
+
 func TestLogAppEvent(t *testing.T) {
 	logger := NewAuditLogger(fake.NewClientset(), _somecomponent, testEnableEventLog)
 	assert.NotNil(t, logger)
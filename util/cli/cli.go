@@ -8,6 +8,7 @@ import (
 	stderrors "errors"
 	"flag"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"path"
@@ -123,6 +124,40 @@ func PromptPassword(password string) string {
 	return password
 }
 
+// ReadPasswordFile reads a password from the file at the given path, or from stdin if the path is "-". Exactly one
+// trailing newline is removed, so that passwords ending with whitespace are preserved.
+func ReadPasswordFile(path string) (string, error) {
+	return readPasswordFile(path, os.Stdin)
+}
+
+func readPasswordFile(path string, stdin io.Reader) (string, error) {
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return "", fmt.Errorf("error reading password file %s: %w", path, err)
+	}
+	password := string(data)
+	if strings.HasSuffix(password, "\r\n") {
+		password = strings.TrimSuffix(password, "\r\n")
+	} else {
+		password = strings.TrimSuffix(password, "\n")
+	}
+	if password == "" {
+		return "", fmt.Errorf("password file %s is empty", path)
+	}
+	return password, nil
+}
+
+// StdinIsTerminal returns whether stdin is a terminal which the user can be prompted on
+func StdinIsTerminal() bool {
+	return terminal.IsTerminal(int(os.Stdin.Fd()))
+}
+
 // AskToProceed prompts the user with a message (typically a yes or no question) and returns whether
 // they responded in the affirmative or negative.
 func AskToProceed(message string) bool {
@@ -237,6 +272,7 @@ func stripComments(input []byte) []byte {
 const (
 	defaultEditor  = "vi"
 	editorEnv      = "EDITOR"
+	kubeEditorEnv  = "KUBE_EDITOR"
 	commentsHeader = `# Please edit the object below. Lines beginning with a '#' will be ignored,
 # and an empty file will abort the edit. If an error occurs while saving this file will be
 # reopened with the relevant failures."
@@ -259,17 +295,19 @@ func setComments(input []byte, comments string) []byte {
 	return []byte(strings.Join(parts, "\n"))
 }
 
+// getEditor returns the editor command and its arguments. Like kubectl, KUBE_EDITOR takes precedence over EDITOR.
+func getEditor() (string, []string) {
+	for _, env := range []string{kubeEditorEnv, editorEnv} {
+		if parts := strings.Fields(os.Getenv(env)); len(parts) > 0 {
+			return parts[0], parts[1:]
+		}
+	}
+	return defaultEditor, nil
+}
+
 // InteractiveEdit launches an interactive editor
 func InteractiveEdit(filePattern string, data []byte, save func(input []byte) error) {
-	var editor string
-	var editorArgs []string
-	if overrideEditor := os.Getenv(editorEnv); overrideEditor == "" {
-		editor = defaultEditor
-	} else {
-		parts := strings.Fields(overrideEditor)
-		editor = parts[0]
-		editorArgs = parts[1:]
-	}
+	editor, editorArgs := getEditor()
 
 	errorComment := ""
 	for {
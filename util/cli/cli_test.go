@@ -5,6 +5,8 @@ import (
 	"flag"
 	"os"
 	"os/exec"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -37,6 +39,37 @@ func TestPromptPassword_Fallback(t *testing.T) {
 	require.Equal(t, pwd, password)
 }
 
+func TestReadPasswordFile(t *testing.T) {
+	dir := t.TempDir()
+	writeFile := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		return path
+	}
+
+	password, err := readPasswordFile(writeFile("newline", pwd+"\n"), nil)
+	require.NoError(t, err)
+	assert.Equal(t, pwd, password)
+
+	password, err = readPasswordFile(writeFile("crlf", pwd+"\r\n"), nil)
+	require.NoError(t, err)
+	assert.Equal(t, pwd, password)
+
+	password, err = readPasswordFile(writeFile("whitespace", pwd+" \n\n"), nil)
+	require.NoError(t, err)
+	assert.Equal(t, pwd+" \n", password)
+
+	password, err = readPasswordFile("-", strings.NewReader(pwd+"\n"))
+	require.NoError(t, err)
+	assert.Equal(t, pwd, password)
+
+	_, err = readPasswordFile(writeFile("empty", "\n"), nil)
+	require.ErrorContains(t, err, "is empty")
+
+	_, err = readPasswordFile(filepath.Join(dir, "missing"), nil)
+	require.ErrorContains(t, err, "error reading password file")
+}
+
 func TestSetLogFormat(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -133,3 +166,27 @@ func TestSetGLogLevel(t *testing.T) {
 	logToStderrFlag := flag.Lookup("logtostderr")
 	assert.Equal(t, "true", logToStderrFlag.Value.String())
 }
+
+func TestGetEditor(t *testing.T) {
+	t.Run("Default", func(t *testing.T) {
+		t.Setenv(kubeEditorEnv, "")
+		t.Setenv(editorEnv, "")
+		editor, args := getEditor()
+		assert.Equal(t, defaultEditor, editor)
+		assert.Empty(t, args)
+	})
+	t.Run("Editor", func(t *testing.T) {
+		t.Setenv(kubeEditorEnv, "")
+		t.Setenv(editorEnv, "code --wait")
+		editor, args := getEditor()
+		assert.Equal(t, "code", editor)
+		assert.Equal(t, []string{"--wait"}, args)
+	})
+	t.Run("KubeEditorTakesPrecedence", func(t *testing.T) {
+		t.Setenv(kubeEditorEnv, "nano")
+		t.Setenv(editorEnv, "code --wait")
+		editor, args := getEditor()
+		assert.Equal(t, "nano", editor)
+		assert.Empty(t, args)
+	})
+}
@@ -12,10 +12,13 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 
 	"github.com/argoproj/argo-cd/v3/common"
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 )
 
 // ArgoCDManagerServiceAccount is the name of the service account for managing a cluster
@@ -48,53 +51,21 @@ var ArgoCDManagerNamespacePolicyRules = []rbacv1.PolicyRule{
 	},
 }
 
-// CreateServiceAccount creates a service account in a given namespace
-func CreateServiceAccount(
-	clientset kubernetes.Interface,
-	serviceAccountName string,
-	namespace string,
-) error {
-	serviceAccount := corev1.ServiceAccount{
+func buildServiceAccount(name string, namespace string) *corev1.ServiceAccount {
+	return &corev1.ServiceAccount{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: "v1",
 			Kind:       "ServiceAccount",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      serviceAccountName,
+			Name:      name,
 			Namespace: namespace,
 		},
 	}
-	_, err := clientset.CoreV1().ServiceAccounts(namespace).Create(context.Background(), &serviceAccount, metav1.CreateOptions{})
-	if err != nil {
-		if !apierrors.IsAlreadyExists(err) {
-			return fmt.Errorf("failed to create service account %q in namespace %q: %w", serviceAccountName, namespace, err)
-		}
-		log.Infof("ServiceAccount %q already exists in namespace %q", serviceAccountName, namespace)
-		return nil
-	}
-	log.Infof("ServiceAccount %q created in namespace %q", serviceAccountName, namespace)
-	return nil
 }
 
-func upsert(kind string, name string, create func() (any, error), update func() (any, error)) error {
-	_, err := create()
-	if err != nil {
-		if !apierrors.IsAlreadyExists(err) {
-			return fmt.Errorf("failed to create %s %q: %w", kind, name, err)
-		}
-		_, err = update()
-		if err != nil {
-			return fmt.Errorf("failed to update %s %q: %w", kind, name, err)
-		}
-		log.Infof("%s %q updated", kind, name)
-	} else {
-		log.Infof("%s %q created", kind, name)
-	}
-	return nil
-}
-
-func upsertClusterRole(clientset kubernetes.Interface, name string, rules []rbacv1.PolicyRule) error {
-	clusterRole := rbacv1.ClusterRole{
+func buildClusterRole(name string, rules []rbacv1.PolicyRule) *rbacv1.ClusterRole {
+	return &rbacv1.ClusterRole{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: "rbac.authorization.k8s.io/v1",
 			Kind:       "ClusterRole",
@@ -104,33 +75,24 @@ func upsertClusterRole(clientset kubernetes.Interface, name string, rules []rbac
 		},
 		Rules: rules,
 	}
-	return upsert("ClusterRole", name, func() (any, error) {
-		return clientset.RbacV1().ClusterRoles().Create(context.Background(), &clusterRole, metav1.CreateOptions{})
-	}, func() (any, error) {
-		return clientset.RbacV1().ClusterRoles().Update(context.Background(), &clusterRole, metav1.UpdateOptions{})
-	})
 }
 
-func upsertRole(clientset kubernetes.Interface, name string, namespace string, rules []rbacv1.PolicyRule) error {
-	role := rbacv1.Role{
+func buildRole(name string, namespace string, rules []rbacv1.PolicyRule) *rbacv1.Role {
+	return &rbacv1.Role{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: "rbac.authorization.k8s.io/v1",
 			Kind:       "Role",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name: name,
+			Name:      name,
+			Namespace: namespace,
 		},
 		Rules: rules,
 	}
-	return upsert("Role", fmt.Sprintf("%s/%s", namespace, name), func() (any, error) {
-		return clientset.RbacV1().Roles(namespace).Create(context.Background(), &role, metav1.CreateOptions{})
-	}, func() (any, error) {
-		return clientset.RbacV1().Roles(namespace).Update(context.Background(), &role, metav1.UpdateOptions{})
-	})
 }
 
-func upsertClusterRoleBinding(clientset kubernetes.Interface, name string, clusterRoleName string, subject rbacv1.Subject) error {
-	roleBinding := rbacv1.ClusterRoleBinding{
+func buildClusterRoleBinding(name string, clusterRoleName string, subject rbacv1.Subject) *rbacv1.ClusterRoleBinding {
+	return &rbacv1.ClusterRoleBinding{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: "rbac.authorization.k8s.io/v1",
 			Kind:       "ClusterRoleBinding",
@@ -145,21 +107,17 @@ func upsertClusterRoleBinding(clientset kubernetes.Interface, name string, clust
 		},
 		Subjects: []rbacv1.Subject{subject},
 	}
-	return upsert("ClusterRoleBinding", name, func() (any, error) {
-		return clientset.RbacV1().ClusterRoleBindings().Create(context.Background(), &roleBinding, metav1.CreateOptions{})
-	}, func() (any, error) {
-		return clientset.RbacV1().ClusterRoleBindings().Update(context.Background(), &roleBinding, metav1.UpdateOptions{})
-	})
 }
 
-func upsertRoleBinding(clientset kubernetes.Interface, name string, roleName string, namespace string, subject rbacv1.Subject) error {
-	roleBinding := rbacv1.RoleBinding{
+func buildRoleBinding(name string, roleName string, namespace string, subject rbacv1.Subject) *rbacv1.RoleBinding {
+	return &rbacv1.RoleBinding{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: "rbac.authorization.k8s.io/v1",
 			Kind:       "RoleBinding",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name: name,
+			Name:      name,
+			Namespace: namespace,
 		},
 		RoleRef: rbacv1.RoleRef{
 			APIGroup: "rbac.authorization.k8s.io",
@@ -168,10 +126,119 @@ func upsertRoleBinding(clientset kubernetes.Interface, name string, roleName str
 		},
 		Subjects: []rbacv1.Subject{subject},
 	}
+}
+
+// NewServiceAccountTokenSecret returns the long-lived token secret which is created for the service account in order
+// to obtain a bearer token for it
+func NewServiceAccountTokenSecret(serviceAccount string, namespace string) *corev1.Secret {
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Secret",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      serviceAccount + SATokenSecretSuffix,
+			Namespace: namespace,
+			Annotations: map[string]string{
+				corev1.ServiceAccountNameKey: serviceAccount,
+			},
+		},
+		Type: corev1.SecretTypeServiceAccountToken,
+	}
+}
+
+// ClusterManagerRBACManifests returns the resources which InstallClusterManagerRBAC creates or updates on a cluster,
+// so that they can be reviewed or applied by other means
+func ClusterManagerRBACManifests(ns string, namespaces []string) []runtime.Object {
+	subject := rbacv1.Subject{
+		Kind:      rbacv1.ServiceAccountKind,
+		Name:      ArgoCDManagerServiceAccount,
+		Namespace: ns,
+	}
+	objs := []runtime.Object{buildServiceAccount(ArgoCDManagerServiceAccount, ns)}
+	if len(namespaces) == 0 {
+		objs = append(objs,
+			buildClusterRole(ArgoCDManagerClusterRole, ArgoCDManagerClusterPolicyRules),
+			buildClusterRoleBinding(ArgoCDManagerClusterRoleBinding, ArgoCDManagerClusterRole, subject))
+	} else {
+		for _, namespace := range namespaces {
+			objs = append(objs,
+				buildRole(ArgoCDManagerClusterRole, namespace, ArgoCDManagerNamespacePolicyRules),
+				buildRoleBinding(ArgoCDManagerClusterRoleBinding, ArgoCDManagerClusterRole, namespace, subject))
+		}
+	}
+	return append(objs, NewServiceAccountTokenSecret(ArgoCDManagerServiceAccount, ns))
+}
+
+// CreateServiceAccount creates a service account in a given namespace
+func CreateServiceAccount(
+	clientset kubernetes.Interface,
+	serviceAccountName string,
+	namespace string,
+) error {
+	serviceAccount := buildServiceAccount(serviceAccountName, namespace)
+	_, err := clientset.CoreV1().ServiceAccounts(namespace).Create(context.Background(), serviceAccount, metav1.CreateOptions{})
+	if err != nil {
+		if !apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("failed to create service account %q in namespace %q: %w", serviceAccountName, namespace, err)
+		}
+		log.Infof("ServiceAccount %q already exists in namespace %q", serviceAccountName, namespace)
+		return nil
+	}
+	log.Infof("ServiceAccount %q created in namespace %q", serviceAccountName, namespace)
+	return nil
+}
+
+func upsert(kind string, name string, create func() (any, error), update func() (any, error)) error {
+	_, err := create()
+	if err != nil {
+		if !apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("failed to create %s %q: %w", kind, name, err)
+		}
+		_, err = update()
+		if err != nil {
+			return fmt.Errorf("failed to update %s %q: %w", kind, name, err)
+		}
+		log.Infof("%s %q updated", kind, name)
+	} else {
+		log.Infof("%s %q created", kind, name)
+	}
+	return nil
+}
+
+func upsertClusterRole(clientset kubernetes.Interface, name string, rules []rbacv1.PolicyRule) error {
+	clusterRole := buildClusterRole(name, rules)
+	return upsert("ClusterRole", name, func() (any, error) {
+		return clientset.RbacV1().ClusterRoles().Create(context.Background(), clusterRole, metav1.CreateOptions{})
+	}, func() (any, error) {
+		return clientset.RbacV1().ClusterRoles().Update(context.Background(), clusterRole, metav1.UpdateOptions{})
+	})
+}
+
+func upsertRole(clientset kubernetes.Interface, name string, namespace string, rules []rbacv1.PolicyRule) error {
+	role := buildRole(name, namespace, rules)
+	return upsert("Role", fmt.Sprintf("%s/%s", namespace, name), func() (any, error) {
+		return clientset.RbacV1().Roles(namespace).Create(context.Background(), role, metav1.CreateOptions{})
+	}, func() (any, error) {
+		return clientset.RbacV1().Roles(namespace).Update(context.Background(), role, metav1.UpdateOptions{})
+	})
+}
+
+func upsertClusterRoleBinding(clientset kubernetes.Interface, name string, clusterRoleName string, subject rbacv1.Subject) error {
+	roleBinding := buildClusterRoleBinding(name, clusterRoleName, subject)
+	return upsert("ClusterRoleBinding", name, func() (any, error) {
+		return clientset.RbacV1().ClusterRoleBindings().Create(context.Background(), roleBinding, metav1.CreateOptions{})
+	}, func() (any, error) {
+		return clientset.RbacV1().ClusterRoleBindings().Update(context.Background(), roleBinding, metav1.UpdateOptions{})
+	})
+}
+
+func upsertRoleBinding(clientset kubernetes.Interface, name string, roleName string, namespace string, subject rbacv1.Subject) error {
+	roleBinding := buildRoleBinding(name, roleName, namespace, subject)
 	return upsert("RoleBinding", fmt.Sprintf("%s/%s", namespace, name), func() (any, error) {
-		return clientset.RbacV1().RoleBindings(namespace).Create(context.Background(), &roleBinding, metav1.CreateOptions{})
+		return clientset.RbacV1().RoleBindings(namespace).Create(context.Background(), roleBinding, metav1.CreateOptions{})
 	}, func() (any, error) {
-		return clientset.RbacV1().RoleBindings(namespace).Update(context.Background(), &roleBinding, metav1.UpdateOptions{})
+		return clientset.RbacV1().RoleBindings(namespace).Update(context.Background(), roleBinding, metav1.UpdateOptions{})
 	})
 }
 
@@ -256,16 +323,7 @@ func GetServiceAccountBearerToken(clientset kubernetes.Interface, ns string, sa
 // use the existing one with that name.
 // This was added to help add k8s v1.24+ clusters.
 func getOrCreateServiceAccountTokenSecret(clientset kubernetes.Interface, serviceaccount, namespace string) (string, error) {
-	secret := &corev1.Secret{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      serviceaccount + SATokenSecretSuffix,
-			Namespace: namespace,
-			Annotations: map[string]string{
-				corev1.ServiceAccountNameKey: serviceaccount,
-			},
-		},
-		Type: corev1.SecretTypeServiceAccountToken,
-	}
+	secret := NewServiceAccountTokenSecret(serviceaccount, namespace)
 
 	ctx, cancel := context.WithTimeout(context.Background(), common.ClusterAuthRequestTimeout)
 	defer cancel()
@@ -413,3 +471,57 @@ func RotateServiceAccountSecrets(clientset kubernetes.Interface, claims *Service
 	}
 	return nil
 }
+
+// RotateClusterToken replaces the bearer token of the cluster with the token of a new secret of the same service
+// account. The new token is passed to verify before the cluster is handed to persist, and the old secret is only removed
+// once the cluster has been persisted. If verifying or persisting fails, the new secret is deleted again.
+func RotateClusterToken(cluster *appv1.Cluster, verify func(config *rest.Config) error, persist func(cluster *appv1.Cluster) error) error {
+	restCfg, err := cluster.RESTConfig()
+	if err != nil {
+		return fmt.Errorf("failed to get REST config for cluster: %w", err)
+	}
+	claims, err := ParseServiceAccountToken(restCfg.BearerToken)
+	if err != nil {
+		return fmt.Errorf("failed to parse service account token: %w", err)
+	}
+	kubeclientset, err := kubernetes.NewForConfig(restCfg)
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes clientset: %w", err)
+	}
+	return rotateClusterToken(kubeclientset, claims, cluster, verify, persist)
+}
+
+func rotateClusterToken(clientset kubernetes.Interface, claims *ServiceAccountClaims, cluster *appv1.Cluster, verify func(config *rest.Config) error, persist func(cluster *appv1.Cluster) error) error {
+	newSecret, err := GenerateNewClusterManagerSecret(clientset, claims)
+	if err != nil {
+		return fmt.Errorf("failed to generate new cluster manager secret: %w", err)
+	}
+	err = func() error {
+		// we are using token auth, make sure we don't store client-cert information
+		cluster.Config.KeyData = nil
+		cluster.Config.CertData = nil
+		cluster.Config.BearerToken = string(newSecret.Data["token"])
+
+		clusterRESTConfig, err := cluster.RESTConfig()
+		if err != nil {
+			return fmt.Errorf("failed to get REST config for cluster: %w", err)
+		}
+		// Test the token we just created before persisting it
+		if err := verify(clusterRESTConfig); err != nil {
+			return fmt.Errorf("failed to verify the new token: %w", err)
+		}
+		return persist(cluster)
+	}()
+	if err != nil {
+		// the old token is still in use, so the new secret would be orphaned
+		if deleteErr := clientset.CoreV1().Secrets(claims.Namespace).Delete(context.Background(), newSecret.Name, metav1.DeleteOptions{}); deleteErr != nil && !apierrors.IsNotFound(deleteErr) {
+			log.Warnf("Failed to delete new cluster manager secret %s/%s: %v", claims.Namespace, newSecret.Name, deleteErr)
+		}
+		return err
+	}
+	if err := RotateServiceAccountSecrets(clientset, claims, newSecret); err != nil {
+		return fmt.Errorf("failed to rotate service account secrets: %w", err)
+	}
+	log.Infof("Rotated service account token secret %s/%s to %s", claims.Namespace, claims.SecretName, newSecret.Name)
+	return nil
+}
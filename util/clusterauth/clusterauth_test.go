@@ -10,13 +10,17 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
 	kubetesting "k8s.io/client-go/testing"
 	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 )
 
 const (
@@ -264,6 +268,81 @@ func TestRotateServiceAccountSecrets(t *testing.T) {
 	assert.True(t, apierrors.IsNotFound(err))
 }
 
+func TestRotateClusterToken(t *testing.T) {
+	claims := testClaims
+	claims.SecretName = "argocd-manager-long-lived-token"
+	newClientset := func() *fake.Clientset {
+		clientset := fake.NewClientset(newServiceAccount(t), newServiceAccountSecret(t))
+		clientset.PrependReactor("create", "secrets", func(action kubetesting.Action) (bool, runtime.Object, error) {
+			secret := action.(kubetesting.CreateAction).GetObject().(*corev1.Secret)
+			secret.Name = secret.GenerateName + "abc123"
+			secret.Data = map[string][]byte{"token": []byte("new-token")}
+			return false, secret, nil
+		})
+		return clientset
+	}
+	newCluster := func() *v1alpha1.Cluster {
+		return &v1alpha1.Cluster{
+			Server: "https://cluster",
+			Config: v1alpha1.ClusterConfig{
+				BearerToken:     testToken,
+				TLSClientConfig: v1alpha1.TLSClientConfig{CertData: []byte("cert"), KeyData: []byte("key")},
+			},
+		}
+	}
+	verify := func(config *rest.Config) error {
+		assert.Equal(t, "new-token", config.BearerToken)
+		return nil
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		clientset := newClientset()
+		var persisted *v1alpha1.Cluster
+		err := rotateClusterToken(clientset, &claims, newCluster(), verify, func(cluster *v1alpha1.Cluster) error {
+			persisted = cluster
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "new-token", persisted.Config.BearerToken)
+		assert.Nil(t, persisted.Config.CertData)
+		assert.Nil(t, persisted.Config.KeyData)
+
+		secretsClient := clientset.CoreV1().Secrets(claims.Namespace)
+		_, err = secretsClient.Get(t.Context(), "argocd-manager-long-lived-abc123", metav1.GetOptions{})
+		require.NoError(t, err)
+		_, err = secretsClient.Get(t.Context(), claims.SecretName, metav1.GetOptions{})
+		assert.True(t, apierrors.IsNotFound(err))
+	})
+
+	t.Run("PersistFailed", func(t *testing.T) {
+		clientset := newClientset()
+		err := rotateClusterToken(clientset, &claims, newCluster(), verify, func(_ *v1alpha1.Cluster) error {
+			return errors.New("forbidden")
+		})
+		require.EqualError(t, err, "forbidden")
+
+		secretsClient := clientset.CoreV1().Secrets(claims.Namespace)
+		_, err = secretsClient.Get(t.Context(), "argocd-manager-long-lived-abc123", metav1.GetOptions{})
+		assert.True(t, apierrors.IsNotFound(err))
+		_, err = secretsClient.Get(t.Context(), claims.SecretName, metav1.GetOptions{})
+		require.NoError(t, err)
+	})
+
+	t.Run("VerifyFailed", func(t *testing.T) {
+		clientset := newClientset()
+		err := rotateClusterToken(clientset, &claims, newCluster(), func(_ *rest.Config) error {
+			return errors.New("unauthorized")
+		}, func(_ *v1alpha1.Cluster) error {
+			t.Fatal("cluster must not be persisted")
+			return nil
+		})
+		require.EqualError(t, err, "failed to verify the new token: unauthorized")
+
+		_, err = clientset.CoreV1().Secrets(claims.Namespace).Get(t.Context(), "argocd-manager-long-lived-abc123", metav1.GetOptions{})
+		assert.True(t, apierrors.IsNotFound(err))
+	})
+}
+
 func TestGetServiceAccountBearerToken(t *testing.T) {
 	sa := newServiceAccount(t)
 	tokenSecret := newServiceAccountSecret(t)
@@ -404,3 +483,26 @@ func Test_getOrCreateServiceAccountTokenSecret_SAHasSecret(t *testing.T) {
 		assert.Equal(t, "sa-secret", sa.Secrets[0].Name)
 	}
 }
+
+func TestClusterManagerRBACManifests(t *testing.T) {
+	kinds := func(objs []runtime.Object) []string {
+		var res []string
+		for _, obj := range objs {
+			res = append(res, obj.GetObjectKind().GroupVersionKind().Kind)
+		}
+		return res
+	}
+
+	t.Run("Cluster Scope", func(t *testing.T) {
+		objs := ClusterManagerRBACManifests("kube-system", nil)
+		assert.Equal(t, []string{"ServiceAccount", "ClusterRole", "ClusterRoleBinding", "Secret"}, kinds(objs))
+	})
+
+	t.Run("Namespace Scope", func(t *testing.T) {
+		objs := ClusterManagerRBACManifests("kube-system", []string{"nsa", "nsb"})
+		assert.Equal(t, []string{"ServiceAccount", "Role", "RoleBinding", "Role", "RoleBinding", "Secret"}, kinds(objs))
+		binding := objs[4].(*rbacv1.RoleBinding)
+		assert.Equal(t, "nsb", binding.Namespace)
+		assert.Equal(t, rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Name: ArgoCDManagerServiceAccount, Namespace: "kube-system"}, binding.Subjects[0])
+	})
+}
@@ -101,18 +101,7 @@ func (db *db) CreateCluster(ctx context.Context, c *appv1.Cluster) (*appv1.Clust
 	if c.Server == appv1.KubernetesInternalAPIServerAddr && !settings.InClusterEnabled {
 		return nil, status.Errorf(codes.InvalidArgument, "cannot register cluster: in-cluster has been disabled")
 	}
-	secName, err := URIToSecretName("cluster", c.Server)
-	if err != nil {
-		return nil, err
-	}
-
-	clusterSecret := &corev1.Secret{
-		ObjectMeta: metav1.ObjectMeta{
-			Name: secName,
-		},
-	}
-
-	err = clusterToSecret(c, clusterSecret)
+	clusterSecret, err := NewClusterSecret(c)
 	if err != nil {
 		return nil, err
 	}
@@ -350,6 +339,23 @@ func (db *db) DeleteCluster(ctx context.Context, server string) error {
 	return db.settingsMgr.ResyncInformers()
 }
 
+// NewClusterSecret returns the secret in which the cluster is stored when it is created
+func NewClusterSecret(c *appv1.Cluster) (*corev1.Secret, error) {
+	secName, err := URIToSecretName("cluster", c.Server)
+	if err != nil {
+		return nil, err
+	}
+	clusterSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: secName,
+		},
+	}
+	if err := clusterToSecret(c, clusterSecret); err != nil {
+		return nil, err
+	}
+	return clusterSecret, nil
+}
+
 // clusterToSecret converts a cluster object to string data for serialization to a secret
 func clusterToSecret(c *appv1.Cluster, secret *corev1.Secret) error {
 	data := make(map[string][]byte)
@@ -362,6 +368,9 @@ func clusterToSecret(c *appv1.Cluster, secret *corev1.Secret) error {
 	if len(c.Namespaces) != 0 {
 		data["namespaces"] = []byte(strings.Join(c.Namespaces, ","))
 	}
+	if _, err := c.Config.GetRotateAuthInterval(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
 	configBytes, err := json.Marshal(c.Config)
 	if err != nil {
 		return err
@@ -393,6 +402,16 @@ func clusterToSecret(c *appv1.Cluster, secret *corev1.Secret) error {
 	} else {
 		delete(secret.Annotations, appv1.AnnotationKeyRefresh)
 	}
+	if c.AuthRotatedAt != nil {
+		secret.Annotations[common.AnnotationKeyClusterAuthRotatedAt] = c.AuthRotatedAt.Format(time.RFC3339)
+	} else {
+		delete(secret.Annotations, common.AnnotationKeyClusterAuthRotatedAt)
+	}
+	if c.AuthRotationError != "" {
+		secret.Annotations[common.AnnotationKeyClusterAuthRotationError] = c.AuthRotationError
+	} else {
+		delete(secret.Annotations, common.AnnotationKeyClusterAuthRotationError)
+	}
 	addSecretMetadata(secret, common.LabelValueSecretTypeCluster)
 	return nil
 }
@@ -422,6 +441,15 @@ func SecretToCluster(s *corev1.Secret) (*appv1.Cluster, error) {
 			refreshRequestedAt = &metav1.Time{Time: requestedAt}
 		}
 	}
+	var authRotatedAt *metav1.Time
+	if v, found := s.Annotations[common.AnnotationKeyClusterAuthRotatedAt]; found {
+		rotatedAt, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			log.Warnf("Error while parsing date in cluster secret '%s': %v", s.Name, err)
+		} else {
+			authRotatedAt = &metav1.Time{Time: rotatedAt}
+		}
+	}
 	var shard *int64
 	if shardStr := s.Data["shard"]; shardStr != nil {
 		if val, err := strconv.Atoi(string(shardStr)); err != nil {
@@ -443,6 +471,8 @@ func SecretToCluster(s *corev1.Secret) (*appv1.Cluster, error) {
 		// delete system annotations
 		delete(annotations, corev1.LastAppliedConfigAnnotation)
 		delete(annotations, common.AnnotationKeyManagedBy)
+		delete(annotations, common.AnnotationKeyClusterAuthRotatedAt)
+		delete(annotations, common.AnnotationKeyClusterAuthRotationError)
 	}
 
 	cluster := appv1.Cluster{
@@ -457,6 +487,8 @@ func SecretToCluster(s *corev1.Secret) (*appv1.Cluster, error) {
 		Project:            string(s.Data["project"]),
 		Labels:             labels,
 		Annotations:        annotations,
+		AuthRotatedAt:      authRotatedAt,
+		AuthRotationError:  s.Annotations[common.AnnotationKeyClusterAuthRotationError],
 	}
 	return &cluster, nil
 }
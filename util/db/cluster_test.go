@@ -117,6 +117,33 @@ func TestClusterToSecret(t *testing.T) {
 	assert.Equal(t, cluster.Labels, s.Labels)
 }
 
+func TestClusterToSecret_AuthRotation(t *testing.T) {
+	rotatedAt := metav1.Unix(1700000000, 0)
+	cluster := &v1alpha1.Cluster{
+		Server:            "server",
+		Name:              "test",
+		Config:            v1alpha1.ClusterConfig{RotateAuthInterval: "720h"},
+		AuthRotatedAt:     &rotatedAt,
+		AuthRotationError: "failed to verify new token",
+	}
+	s := &corev1.Secret{}
+	require.NoError(t, clusterToSecret(cluster, s))
+	assert.Equal(t, rotatedAt.Format(time.RFC3339), s.Annotations[common.AnnotationKeyClusterAuthRotatedAt])
+	assert.Equal(t, "failed to verify new token", s.Annotations[common.AnnotationKeyClusterAuthRotationError])
+
+	restored, err := SecretToCluster(s)
+	require.NoError(t, err)
+	assert.Equal(t, "720h", restored.Config.RotateAuthInterval)
+	assert.True(t, rotatedAt.Equal(restored.AuthRotatedAt))
+	assert.Equal(t, "failed to verify new token", restored.AuthRotationError)
+	assert.NotContains(t, restored.Annotations, common.AnnotationKeyClusterAuthRotatedAt)
+	assert.NotContains(t, restored.Annotations, common.AnnotationKeyClusterAuthRotationError)
+
+	restored.AuthRotationError = ""
+	require.NoError(t, clusterToSecret(restored, s))
+	assert.NotContains(t, s.Annotations, common.AnnotationKeyClusterAuthRotationError)
+}
+
 func TestClusterToSecret_LastAppliedConfigurationRejected(t *testing.T) {
 	cluster := &v1alpha1.Cluster{
 		Server:      "server",
@@ -98,6 +98,8 @@ func GenerateDexConfigYAML(argocdSettings *settings.ArgoCDSettings, disableTLS b
 		"redirectURIs": []string{
 			"http://localhost",
 			"http://localhost:8085/auth/callback",
+			// Dex only allows the device authorization grant if its device callback is an allowed redirect URI
+			"/device/callback",
 		},
 	}
 
@@ -0,0 +1,103 @@
+package errors
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+)
+
+type verboseErrorsKey struct{}
+
+// WithVerboseErrors returns a copy of the context which makes CheckErrorWithContext print the full details of errors
+func WithVerboseErrors(ctx context.Context, verbose bool) context.Context {
+	return context.WithValue(ctx, verboseErrorsKey{}, verbose)
+}
+
+// VerboseErrorsFromContext returns whether the full details of errors should be printed
+func VerboseErrorsFromContext(ctx context.Context) bool {
+	if ctx == nil {
+		return false
+	}
+	verbose, _ := ctx.Value(verboseErrorsKey{}).(bool)
+	return verbose
+}
+
+// CheckErrorWithContext is like CheckError, but prints the gRPC status code, the decoded status details and the
+// request and trace ids returned by the server if verbose errors are enabled in the context
+func CheckErrorWithContext(ctx context.Context, err error) {
+	if err == nil {
+		return
+	}
+	if VerboseErrorsFromContext(ctx) {
+		Fatal(ErrorGeneric, VerboseErrorMessage(err))
+	}
+	Fatal(ErrorGeneric, err)
+}
+
+// RequestError is an error annotated with the request and trace ids the server returned along with it
+type RequestError struct {
+	Err       error
+	RequestID string
+	TraceID   string
+}
+
+func (e *RequestError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *RequestError) Unwrap() error {
+	return e.Err
+}
+
+// GRPCStatus returns the status of the wrapped error, so that the status package treats a RequestError like the error
+// it wraps
+func (e *RequestError) GRPCStatus() *status.Status {
+	return status.Convert(e.Err)
+}
+
+// VerboseErrorMessage returns a multi-line description of the error, including the gRPC status code and details, and
+// the request and trace ids if known
+func VerboseErrorMessage(err error) string {
+	var sb strings.Builder
+	sb.WriteString(err.Error())
+	var reqErr *RequestError
+	if stderrors.As(err, &reqErr) {
+		if reqErr.RequestID != "" {
+			fmt.Fprintf(&sb, "\n  Request ID: %s", reqErr.RequestID)
+		}
+		if reqErr.TraceID != "" {
+			fmt.Fprintf(&sb, "\n  Trace ID: %s", reqErr.TraceID)
+		}
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return sb.String()
+	}
+	fmt.Fprintf(&sb, "\n  Code: %s", st.Code())
+	fmt.Fprintf(&sb, "\n  Message: %s", st.Message())
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.BadRequest:
+			for _, violation := range d.GetFieldViolations() {
+				fmt.Fprintf(&sb, "\n  Field violation: %s: %s", violation.GetField(), violation.GetDescription())
+			}
+		case *errdetails.RetryInfo:
+			fmt.Fprintf(&sb, "\n  Retry after: %s", d.GetRetryDelay().AsDuration())
+		case *errdetails.RequestInfo:
+			fmt.Fprintf(&sb, "\n  Request ID: %s", d.GetRequestId())
+		case *errdetails.ErrorInfo:
+			fmt.Fprintf(&sb, "\n  Reason: %s (domain: %s)", d.GetReason(), d.GetDomain())
+		case *errdetails.DebugInfo:
+			fmt.Fprintf(&sb, "\n  Debug info: %s", d.GetDetail())
+		case error:
+			fmt.Fprintf(&sb, "\n  Detail: %v", d)
+		default:
+			fmt.Fprintf(&sb, "\n  Detail: %T %v", d, d)
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,46 @@
+package errors
+
+import (
+	stderrors "errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestVerboseErrorsFromContext(t *testing.T) {
+	assert.False(t, VerboseErrorsFromContext(t.Context()))
+	assert.True(t, VerboseErrorsFromContext(WithVerboseErrors(t.Context(), true)))
+	assert.False(t, VerboseErrorsFromContext(WithVerboseErrors(t.Context(), false)))
+}
+
+func TestVerboseErrorMessage(t *testing.T) {
+	st, err := status.New(codes.InvalidArgument, "application spec is invalid").WithDetails(
+		&errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: "spec.destination", Description: "must be set"},
+		}},
+		&errdetails.RetryInfo{RetryDelay: durationpb.New(5 * time.Second)},
+	)
+	require.NoError(t, err)
+	reqErr := &RequestError{Err: st.Err(), RequestID: "req-1", TraceID: "trace-1"}
+
+	assert.Equal(t, st.Err().Error(), reqErr.Error())
+	assert.Equal(t, codes.InvalidArgument, status.Code(reqErr))
+
+	msg := VerboseErrorMessage(reqErr)
+	assert.Contains(t, msg, "rpc error: code = InvalidArgument desc = application spec is invalid")
+	assert.Contains(t, msg, "Request ID: req-1")
+	assert.Contains(t, msg, "Trace ID: trace-1")
+	assert.Contains(t, msg, "Code: InvalidArgument")
+	assert.Contains(t, msg, "Field violation: spec.destination: must be set")
+	assert.Contains(t, msg, "Retry after: 5s")
+}
+
+func TestVerboseErrorMessage_NonGRPCError(t *testing.T) {
+	assert.Equal(t, "boom", VerboseErrorMessage(stderrors.New("boom")))
+}
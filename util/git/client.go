@@ -95,6 +95,9 @@ type RevisionMetadata struct {
 type Refs struct {
 	Branches []string
 	Tags     []string
+	// BranchSHAs and TagSHAs are the commit SHAs of the branches and tags, in the same order
+	BranchSHAs []string
+	TagSHAs    []string
 	// heads and remotes are also refs, but are not needed at this time.
 }
 
@@ -639,9 +642,13 @@ func (m *nativeGitClient) LsRefs() (*Refs, error) {
 		return nil, err
 	}
 
+	// refToHash keeps the commit SHA of each branch and tag, annotated tags are already peeled by listRemote
+	refToHash := make(map[plumbing.ReferenceName]string)
 	sortedRefs := &Refs{
-		Branches: []string{},
-		Tags:     []string{},
+		Branches:   []string{},
+		Tags:       []string{},
+		BranchSHAs: []string{},
+		TagSHAs:    []string{},
 	}
 
 	for _, revision := range refs {
@@ -650,6 +657,9 @@ func (m *nativeGitClient) LsRefs() (*Refs, error) {
 		} else if revision.Name().IsTag() {
 			sortedRefs.Tags = append(sortedRefs.Tags, revision.Name().Short())
 		}
+		if revision.Type() == plumbing.HashReference {
+			refToHash[revision.Name()] = revision.Hash().String()
+		}
 	}
 
 	log.Debugf("LsRefs resolved %d branches and %d tags on repository", len(sortedRefs.Branches), len(sortedRefs.Tags))
@@ -657,6 +667,12 @@ func (m *nativeGitClient) LsRefs() (*Refs, error) {
 	// Would prefer to sort by last modified date but that info does not appear to be available without resolving each ref
 	sort.Strings(sortedRefs.Branches)
 	sort.Strings(sortedRefs.Tags)
+	for _, branch := range sortedRefs.Branches {
+		sortedRefs.BranchSHAs = append(sortedRefs.BranchSHAs, refToHash[plumbing.NewBranchReferenceName(branch)])
+	}
+	for _, tag := range sortedRefs.Tags {
+		sortedRefs.TagSHAs = append(sortedRefs.TagSHAs, refToHash[plumbing.NewTagReferenceName(tag)])
+	}
 
 	return sortedRefs, nil
 }
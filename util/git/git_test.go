@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -449,6 +450,8 @@ func TestListRevisions(t *testing.T) {
 	assert.Contains(t, lsResult.Tags, testTag)
 	assert.NotContains(t, lsResult.Branches, testTag)
 	assert.NotContains(t, lsResult.Tags, testBranch)
+	assert.Len(t, lsResult.BranchSHAs, len(lsResult.Branches))
+	assert.Len(t, lsResult.TagSHAs, len(lsResult.Tags))
 }
 
 func TestLsFiles(t *testing.T) {
@@ -710,4 +713,6 @@ func TestAnnotatedTagHandling(t *testing.T) {
 
 	// Verify tag exists in the list and points to a valid commit SHA
 	assert.Contains(t, refs.Tags, "v1.0.0", "Tag v1.0.0 should exist in refs")
+	require.Len(t, refs.TagSHAs, len(refs.Tags))
+	assert.Equal(t, commitSHA, refs.TagSHAs[slices.Index(refs.Tags, "v1.0.0")])
 }
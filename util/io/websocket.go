@@ -0,0 +1,57 @@
+package io
+
+import (
+	"errors"
+	"io"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// webSocketStream exposes the binary messages of a WebSocket connection as a stream of bytes
+type webSocketStream struct {
+	conn   *websocket.Conn
+	reader io.Reader
+}
+
+// NewWebSocketStream returns a stream which reads from and writes to the binary messages of a WebSocket connection. The
+// stream ends with io.EOF once the peer closes the connection normally. Closing the stream closes the connection.
+func NewWebSocketStream(conn *websocket.Conn) io.ReadWriteCloser {
+	return &webSocketStream{conn: conn}
+}
+
+func (s *webSocketStream) Read(p []byte) (int, error) {
+	for {
+		if s.reader == nil {
+			_, reader, err := s.conn.NextReader()
+			if websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+				return 0, io.EOF
+			} else if err != nil {
+				return 0, err
+			}
+			s.reader = reader
+		}
+		n, err := s.reader.Read(p)
+		if errors.Is(err, io.EOF) {
+			s.reader = nil
+			if n == 0 {
+				continue
+			}
+			err = nil
+		}
+		return n, err
+	}
+}
+
+func (s *webSocketStream) Write(p []byte) (int, error) {
+	if err := s.conn.WriteMessage(websocket.BinaryMessage, p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (s *webSocketStream) Close() error {
+	// the close message is best effort, since the peer may already be gone
+	_ = s.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(time.Second))
+	return s.conn.Close()
+}
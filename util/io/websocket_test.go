@@ -0,0 +1,46 @@
+package io
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebSocketStream(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		// echo the stream in two messages and close it normally
+		stream := NewWebSocketStream(conn)
+		defer Close(stream)
+		buf := make([]byte, 5)
+		if _, err := io.ReadFull(stream, buf); err != nil {
+			return
+		}
+		_, _ = stream.Write(buf[:2])
+		_, _ = stream.Write(buf[2:])
+	}))
+	defer server.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	require.NoError(t, err)
+	stream := NewWebSocketStream(conn)
+	defer Close(stream)
+
+	_, err = stream.Write([]byte("hel"))
+	require.NoError(t, err)
+	_, err = stream.Write([]byte("lo"))
+	require.NoError(t, err)
+	data, err := io.ReadAll(stream)
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(data))
+}
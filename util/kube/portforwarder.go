@@ -4,14 +4,17 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
 	"os"
+	"strconv"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/httpstream"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/tools/portforward"
 	"k8s.io/client-go/transport/spdy"
@@ -39,6 +42,97 @@ func selectPodForPortForward(clientSet kubernetes.Interface, namespace string, p
 	return nil, fmt.Errorf("cannot find ready pod with selector: %v - use the --{component}-name flag in this command or set the environmental variable (Refer to https://argo-cd.readthedocs.io/en/stable/user-guide/environment-variables), to change the Argo CD component name in the CLI", podSelectors)
 }
 
+// newPortForwardDialer returns a dialer for the port forwarding connection to the given pod
+func newPortForwardDialer(clientSet kubernetes.Interface, config *rest.Config, namespace, podName string) (httpstream.Dialer, error) {
+	url := clientSet.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(namespace).
+		Name(podName).
+		SubResource("portforward").URL()
+
+	transport, upgrader, err := spdy.RoundTripperFor(config)
+	if err != nil {
+		return nil, fmt.Errorf("could not create round tripper: %w", err)
+	}
+	var dialer httpstream.Dialer = spdy.NewDialer(upgrader, &http.Client{Transport: transport}, "POST", url)
+
+	// Reuse environment variable for kubectl to disable the feature flag, default is enabled.
+	if !cmdutil.PortForwardWebsockets.IsDisabled() {
+		tunnelingDialer, err := portforward.NewSPDYOverWebsocketDialer(url, config)
+		if err != nil {
+			return nil, fmt.Errorf("could not create tunneling dialer: %w", err)
+		}
+		// First attempt tunneling (websocket) dialer, then fallback to spdy dialer.
+		dialer = portforward.NewFallbackDialer(tunnelingDialer, dialer, func(err error) bool {
+			return httpstream.IsUpgradeFailure(err) || httpstream.IsHTTPSProxyError(err)
+		})
+	}
+	return dialer, nil
+}
+
+// ForwardPortStream forwards a single connection, represented by the given stream, to a port of a pod. It returns once
+// either side closes the connection.
+func ForwardPortStream(clientSet kubernetes.Interface, config *rest.Config, namespace, podName string, port int, stream io.ReadWriter) error {
+	dialer, err := newPortForwardDialer(clientSet, config, namespace, podName)
+	if err != nil {
+		return err
+	}
+	streamConn, _, err := dialer.Dial(portforward.PortForwardProtocolV1Name)
+	if err != nil {
+		return fmt.Errorf("error upgrading connection: %w", err)
+	}
+	defer utilio.Close(streamConn)
+
+	headers := http.Header{}
+	headers.Set(corev1.StreamType, corev1.StreamTypeError)
+	headers.Set(corev1.PortHeader, strconv.Itoa(port))
+	headers.Set(corev1.PortForwardRequestIDHeader, "0")
+	errorStream, err := streamConn.CreateStream(headers)
+	if err != nil {
+		return fmt.Errorf("error creating error stream for port %d: %w", port, err)
+	}
+	// the error stream is only read from
+	utilio.Close(errorStream)
+	errorChan := make(chan error, 1)
+	go func() {
+		message, err := io.ReadAll(errorStream)
+		switch {
+		case err != nil:
+			errorChan <- fmt.Errorf("error reading from error stream for port %d: %w", port, err)
+		case len(message) > 0:
+			errorChan <- fmt.Errorf("an error occurred forwarding port %d: %s", port, string(message))
+		}
+		close(errorChan)
+	}()
+
+	headers.Set(corev1.StreamType, corev1.StreamTypeData)
+	dataStream, err := streamConn.CreateStream(headers)
+	if err != nil {
+		return fmt.Errorf("error creating forwarding stream for port %d: %w", port, err)
+	}
+
+	localError := make(chan error, 1)
+	remoteDone := make(chan struct{})
+	go func() {
+		// copy from the remote side to the local connection
+		_, _ = io.Copy(stream, dataStream)
+		close(remoteDone)
+	}()
+	go func() {
+		// copy from the local connection to the remote side, and inform the remote side once the local one is done
+		defer utilio.Close(dataStream)
+		if _, err := io.Copy(dataStream, stream); err != nil {
+			localError <- err
+		}
+	}()
+	select {
+	case <-remoteDone:
+	case err := <-localError:
+		return fmt.Errorf("error copying from local connection to port %d: %w", port, err)
+	}
+	return <-errorChan
+}
+
 func PortForward(targetPort int, namespace string, overrides *clientcmd.ConfigOverrides, podSelectors ...string) (int, error) {
 	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
 	loadingRules.DefaultClientConfig = &clientcmd.DefaultClientConfig
@@ -65,28 +159,9 @@ func PortForward(targetPort int, namespace string, overrides *clientcmd.ConfigOv
 		return -1, err
 	}
 
-	url := clientSet.CoreV1().RESTClient().Post().
-		Resource("pods").
-		Namespace(pod.Namespace).
-		Name(pod.Name).
-		SubResource("portforward").URL()
-
-	transport, upgrader, err := spdy.RoundTripperFor(config)
+	dialer, err := newPortForwardDialer(clientSet, config, pod.Namespace, pod.Name)
 	if err != nil {
-		return -1, fmt.Errorf("could not create round tripper: %w", err)
-	}
-	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, "POST", url)
-
-	// Reuse environment variable for kubectl to disable the feature flag, default is enabled.
-	if !cmdutil.PortForwardWebsockets.IsDisabled() {
-		tunnelingDialer, err := portforward.NewSPDYOverWebsocketDialer(url, config)
-		if err != nil {
-			return -1, fmt.Errorf("could not create tunneling dialer: %w", err)
-		}
-		// First attempt tunneling (websocket) dialer, then fallback to spdy dialer.
-		dialer = portforward.NewFallbackDialer(tunnelingDialer, dialer, func(err error) bool {
-			return httpstream.IsUpgradeFailure(err) || httpstream.IsHTTPSProxyError(err)
-		})
+		return -1, err
 	}
 
 	readyChan := make(chan struct{}, 1)
@@ -6,6 +6,7 @@ import (
 	"os"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 
@@ -26,6 +27,10 @@ type ContextRef struct {
 	Name   string `json:"name"`
 	Server string `json:"server"`
 	User   string `json:"user"`
+	// ServerVersion is the last known version of the Argo CD server, used to warn about client/server version skew
+	ServerVersion string `json:"server-version,omitempty"`
+	// ServerVersionCheckedAt is the time the server version was last retrieved from the server
+	ServerVersionCheckedAt *time.Time `json:"server-version-checked-at,omitempty"`
 }
 
 // Context is the resolved Server and User objects resolved
@@ -228,6 +233,15 @@ func (l *LocalConfig) RemoveToken(serverName string) bool {
 	return false
 }
 
+func (l *LocalConfig) GetContext(name string) (*ContextRef, error) {
+	for _, c := range l.Contexts {
+		if c.Name == name {
+			return &c, nil
+		}
+	}
+	return nil, fmt.Errorf("Context '%s' undefined", name)
+}
+
 func (l *LocalConfig) UpsertContext(context ContextRef) {
 	for i, c := range l.Contexts {
 		if c.Name == context.Name {
@@ -40,6 +40,12 @@ type RepoStreamReceiver interface {
 
 // SendApplicationManifestQueryWithFiles compresses a folder and sends it over the stream
 func SendApplicationManifestQueryWithFiles(ctx context.Context, stream ApplicationStreamSender, appName string, appNs string, dir string, inclusions []string) error {
+	return SendApplicationManifestQuery(ctx, stream, &applicationpkg.ApplicationManifestQueryWithFiles{Name: &appName, AppNamespace: &appNs}, dir, inclusions)
+}
+
+// SendApplicationManifestQuery compresses a folder and sends it over the stream together with the given query, whose
+// checksum is set to the one of the compressed folder
+func SendApplicationManifestQuery(ctx context.Context, stream ApplicationStreamSender, query *applicationpkg.ApplicationManifestQueryWithFiles, dir string, inclusions []string) error {
 	f, filesWritten, checksum, err := tgzstream.CompressFiles(dir, inclusions, nil)
 	if err != nil {
 		return fmt.Errorf("failed to compress files: %w", err)
@@ -48,13 +54,10 @@ func SendApplicationManifestQueryWithFiles(ctx context.Context, stream Applicati
 		return errors.New("no files to send")
 	}
 
+	query.Checksum = &checksum
 	err = stream.Send(&applicationpkg.ApplicationManifestQueryWithFilesWrapper{
 		Part: &applicationpkg.ApplicationManifestQueryWithFilesWrapper_Query{
-			Query: &applicationpkg.ApplicationManifestQueryWithFiles{
-				Name:         &appName,
-				Checksum:     &checksum,
-				AppNamespace: &appNs,
-			},
+			Query: query,
 		},
 	})
 	if err != nil {
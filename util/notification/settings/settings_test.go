@@ -93,3 +93,23 @@ func TestInitGetVars(t *testing.T) {
 		assert.Equal(t, result["secrets"], notificationsSecret.Data)
 	})
 }
+
+func TestGetFactorySettingsForCLI_DeferredService(t *testing.T) {
+	var argocdService service.Service
+	settings := GetFactorySettingsForCLI(func() service.Service { return argocdService }, "argocd-notifications-secret", "argocd-notifications-cm", false)
+	cm := &corev1.ConfigMap{}
+	secret := &corev1.Secret{}
+
+	_, err := settings.InitGetVars(&api.Config{}, cm, secret)
+	require.ErrorContains(t, err, "argocdService is not initialized")
+
+	mockRepoClient := &mocks.Clientset{RepoServerServiceClient: &mocks.RepoServerServiceClient{}}
+	svc, err := service.NewArgoCDService(fake.NewClientset(), testNamespace, mockRepoClient)
+	require.NoError(t, err)
+	defer svc.Close()
+	argocdService = svc
+
+	getVars, err := settings.InitGetVars(&api.Config{}, cm, secret)
+	require.NoError(t, err)
+	assert.NotNil(t, getVars)
+}
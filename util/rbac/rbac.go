@@ -47,6 +47,7 @@ const (
 type CasbinEnforcer interface {
 	EnableLog(bool)
 	Enforce(rvals ...any) (bool, error)
+	EnforceEx(rvals ...any) (bool, []string, error)
 	LoadPolicy() error
 	EnableEnforce(bool)
 	AddFunction(name string, function govaluate.ExpressionFunction)
@@ -71,14 +72,15 @@ const (
 	ResourceExtensions        = "extensions"
 
 	// please add new items to Actions
-	ActionGet      = "get"
-	ActionCreate   = "create"
-	ActionUpdate   = "update"
-	ActionDelete   = "delete"
-	ActionSync     = "sync"
-	ActionOverride = "override"
-	ActionAction   = "action"
-	ActionInvoke   = "invoke"
+	ActionGet         = "get"
+	ActionCreate      = "create"
+	ActionUpdate      = "update"
+	ActionDelete      = "delete"
+	ActionSync        = "sync"
+	ActionOverride    = "override"
+	ActionAction      = "action"
+	ActionInvoke      = "invoke"
+	ActionImpersonate = "impersonate"
 )
 
 var (
@@ -106,6 +108,7 @@ var (
 		ActionOverride,
 		ActionAction,
 		ActionInvoke,
+		ActionImpersonate,
 	}
 )
 
@@ -327,6 +330,58 @@ func (e *Enforcer) Enforce(rvals ...any) bool {
 	return enforce(e.getCasbinEnforcer("", ""), e.defaultRole, e.claimsEnforcerFunc, rvals...)
 }
 
+// EnforceExplain is like Enforce for a subject string, but additionally returns the policy rule which decided the
+// result. The rule is empty if no rule matched and the request was denied by default.
+func (e *Enforcer) EnforceExplain(rvals ...any) (bool, []string, error) {
+	enf, err := e.tryGetCasbinEnforcer("", "")
+	if err != nil {
+		return false, nil, err
+	}
+	// check the default role
+	if e.defaultRole != "" && len(rvals) >= 2 {
+		if ok, rule, err := enf.EnforceEx(append([]any{e.defaultRole}, rvals[1:]...)...); ok && err == nil {
+			return true, rule, nil
+		}
+	}
+	return enf.EnforceEx(rvals...)
+}
+
+// GetImplicitPermissions returns the policy rules which apply to any of the subjects or to the default role, either
+// directly or through the roles assigned to them. Each rule consists of the subject, resource, action, object and
+// effect of the policy line.
+func (e *Enforcer) GetImplicitPermissions(subjects ...string) ([][]string, error) {
+	return e.GetImplicitPermissionsWithRuntimePolicy("", "", subjects...)
+}
+
+// GetImplicitPermissionsWithRuntimePolicy returns the policy rules which apply to any of the subjects or to the default
+// role, considering the runtime policy of the given project in addition to the built-in and user defined policies
+func (e *Enforcer) GetImplicitPermissionsWithRuntimePolicy(project string, policy string, subjects ...string) ([][]string, error) {
+	enf, err := e.tryGetCasbinEnforcer(project, policy)
+	if err != nil {
+		return nil, err
+	}
+	if e.defaultRole != "" {
+		subjects = append(subjects, e.defaultRole)
+	}
+	seen := make(map[string]bool)
+	var rules [][]string
+	for _, subject := range subjects {
+		permissions, err := enf.GetImplicitPermissionsForUser(subject)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get permissions of %s: %w", subject, err)
+		}
+		for _, permission := range permissions {
+			key := strings.Join(permission, ",")
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			rules = append(rules, permission)
+		}
+	}
+	return rules, nil
+}
+
 // EnforceErr is a convenience helper to wrap a failed enforcement with a detailed error about the request
 func (e *Enforcer) EnforceErr(rvals ...any) error {
 	if !e.Enforce(rvals...) {
@@ -379,15 +434,17 @@ func (e *Enforcer) EnforceWithCustomEnforcer(enf CasbinEnforcer, rvals ...any) b
 
 // enforce is a helper to additionally check a default role and invoke a custom claims enforcement function
 func enforce(enf CasbinEnforcer, defaultRole string, claimsEnforcerFunc ClaimsEnforcerFunc, rvals ...any) bool {
-	// check the default role
-	if defaultRole != "" && len(rvals) >= 2 {
+	if len(rvals) == 0 {
+		return false
+	}
+	// check the default role. The default role of claims is checked by the claims enforcement function instead, so
+	// that it cannot grant more than the claims are restricted to.
+	_, isClaims := rvals[0].(jwt.Claims)
+	if defaultRole != "" && len(rvals) >= 2 && (!isClaims || claimsEnforcerFunc == nil) {
 		if ok, err := enf.Enforce(append([]any{defaultRole}, rvals[1:]...)...); ok && err == nil {
 			return true
 		}
 	}
-	if len(rvals) == 0 {
-		return false
-	}
 	// check if subject is jwt.Claims vs. a normal subject string and run custom claims
 	// enforcement func (if set)
 	sub := rvals[0]
@@ -542,6 +599,38 @@ func ValidatePolicy(policy string) error {
 	return nil
 }
 
+// PolicyLineError is a syntax error in a line of a policy
+type PolicyLineError struct {
+	Line int
+	Err  error
+}
+
+func (e *PolicyLineError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+// ValidatePolicyLines verifies each line of a policy string and returns an error with the line number of every
+// invalid line, including policies with an effect other than allow or deny.
+func ValidatePolicyLines(policy string) []error {
+	var errs []error
+	m := newBuiltInModel()
+	for i, line := range strings.Split(policy, "\n") {
+		policies := len(m["p"]["p"].Policy)
+		if err := loadPolicyLine(strings.TrimSpace(line), m); err != nil {
+			errs = append(errs, &PolicyLineError{Line: i + 1, Err: err})
+			continue
+		}
+		if len(m["p"]["p"].Policy) == policies {
+			continue
+		}
+		rule := m["p"]["p"].Policy[policies]
+		if eft := rule[len(rule)-1]; eft != "allow" && eft != "deny" {
+			errs = append(errs, &PolicyLineError{Line: i + 1, Err: fmt.Errorf("invalid effect '%s', must be allow or deny", eft)})
+		}
+	}
+	return errs
+}
+
 // newBuiltInModel is a helper to return a brand new casbin model from the built-in model string.
 // This is needed because it is not safe to re-use the same casbin Model when instantiating new
 // casbin enforcers.
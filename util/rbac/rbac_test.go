@@ -388,6 +388,51 @@ func TestValidatePolicy(t *testing.T) {
 	}
 }
 
+func TestValidatePolicyLines(t *testing.T) {
+	policy := `# comment
+p, role:admin, projects, delete, *, allow
+p, role:ci, applications, delete, prod/*, alow
+g, alice
+
+g, bob, role:admin
+p, role:ci, applications, get, */*`
+	errs := ValidatePolicyLines(policy)
+	require.Len(t, errs, 3)
+	assert.EqualError(t, errs[0], "line 3: invalid effect 'alow', must be allow or deny")
+	assert.EqualError(t, errs[1], "line 4: invalid RBAC policy: g, alice")
+	assert.EqualError(t, errs[2], "line 7: invalid RBAC policy: p, role:ci, applications, get, */*")
+
+	assert.Empty(t, ValidatePolicyLines(`p, "role,admin", projects, delete, *, allow`))
+}
+
+func TestEnforceExplain(t *testing.T) {
+	enf := NewEnforcer(fake.NewClientset(), fakeNamespace, fakeConfigMapName, nil)
+	require.NoError(t, enf.SetUserPolicy(`p, role:ci, applications, *, */*, allow
+p, role:ci, applications, delete, prod/*, deny
+g, ci-bot, role:ci`))
+
+	ok, rule, err := enf.EnforceExplain("ci-bot", "applications", "sync", "prod/guestbook")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"role:ci", "applications", "*", "*/*", "allow"}, rule)
+
+	ok, rule, err = enf.EnforceExplain("ci-bot", "applications", "delete", "prod/guestbook")
+	require.NoError(t, err)
+	assert.False(t, ok)
+	assert.Equal(t, []string{"role:ci", "applications", "delete", "prod/*", "deny"}, rule)
+
+	ok, rule, err = enf.EnforceExplain("alice", "applications", "get", "prod/guestbook")
+	require.NoError(t, err)
+	assert.False(t, ok)
+	assert.Empty(t, rule)
+
+	enf.SetDefaultRole("role:ci")
+	ok, rule, err = enf.EnforceExplain("alice", "applications", "get", "prod/guestbook")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"role:ci", "applications", "*", "*/*", "allow"}, rule)
+}
+
 // TestEnforceErrorMessage ensures we give descriptive error message
 func TestEnforceErrorMessage(t *testing.T) {
 	kubeclientset := fake.NewClientset()
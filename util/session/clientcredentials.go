@@ -0,0 +1,104 @@
+package session
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/argoproj/argo-cd/v3/util/password"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+const (
+	// ClientCredentialsTokenDuration is the lifetime of the tokens issued in exchange for client credentials
+	ClientCredentialsTokenDuration = time.Hour
+	// maxClientIDLength is the maximum length of client ids, which consist of a token id and an account name
+	maxClientIDLength = 128
+)
+
+// ClientCredentialsID returns the client id of the client-credentials token with the given id of an account
+func ClientCredentialsID(account string, id string) string {
+	return id + "@" + account
+}
+
+// parseClientCredentialsID returns the account and the token id of the given client id. Account names cannot contain
+// @, so the client id is split at the last one.
+func parseClientCredentialsID(clientID string) (string, string, bool) {
+	i := strings.LastIndex(clientID, "@")
+	if i < 1 || i == len(clientID)-1 {
+		return "", "", false
+	}
+	return clientID[i+1:], clientID[:i], true
+}
+
+// ExchangeClientCredentials verifies the given client credentials of a local account and returns a short-lived API
+// token of the account together with its lifetime in seconds. The token carries the id of the client-credentials
+// token, so that deleting the client credentials revokes all tokens issued for them.
+func (mgr *SessionManager) ExchangeClientCredentials(clientID string, clientSecret string) (string, int64, error) {
+	if clientSecret == "" {
+		return "", 0, status.Errorf(codes.Unauthenticated, blankPasswordError)
+	}
+	if len(clientID) > maxClientIDLength {
+		return "", 0, status.Errorf(codes.InvalidArgument, "Client id is too long (%d bytes max)", maxClientIDLength)
+	}
+	attempt := mgr.getFailureCount(clientID)
+	if mgr.exceededFailedLoginAttempts(attempt) {
+		log.Warnf("Client %s had too many failed logins (%d)", clientID, attempt.FailCount)
+		return "", 0, InvalidLoginErr
+	}
+
+	token, name, err := mgr.verifyClientCredentials(clientID, clientSecret)
+	if err != nil {
+		mgr.updateFailureCount(clientID, true)
+		return "", 0, err
+	}
+	mgr.updateFailureCount(clientID, false)
+
+	expiresIn := int64(ClientCredentialsTokenDuration.Seconds())
+	if token.ExpiresAt > 0 {
+		expiresIn = min(expiresIn, token.ExpiresAt-time.Now().Unix())
+	}
+	tokenString, err := mgr.CreateScoped(fmt.Sprintf("%s:%s", name, settings.AccountCapabilityApiKey), expiresIn, token.ID, token.Scope)
+	if err != nil {
+		return "", 0, err
+	}
+	return tokenString, expiresIn, nil
+}
+
+// verifyClientCredentials returns the client-credentials token and the name of the account of the given client
+// credentials, or InvalidLoginErr if they are not valid
+func (mgr *SessionManager) verifyClientCredentials(clientID string, clientSecret string) (*settings.Token, string, error) {
+	name, id, ok := parseClientCredentialsID(clientID)
+	if !ok {
+		return nil, "", InvalidLoginErr
+	}
+	account, err := mgr.settingsMgr.GetAccount(name)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, "", InvalidLoginErr
+		}
+		return nil, "", err
+	}
+	index := account.TokenIndex(id)
+	if index == -1 || account.Tokens[index].Type != settings.TokenTypeClientCredentials {
+		return nil, "", InvalidLoginErr
+	}
+	token := account.Tokens[index]
+	if valid, _ := password.VerifyPassword(clientSecret, token.SecretHash); !valid {
+		return nil, "", InvalidLoginErr
+	}
+	if token.ExpiresAt > 0 && token.ExpiresAt <= time.Now().Unix() {
+		return nil, "", InvalidLoginErr
+	}
+	if !account.Enabled {
+		return nil, "", status.Errorf(codes.Unauthenticated, accountDisabled, name)
+	}
+	if !account.HasCapability(settings.AccountCapabilityApiKey) {
+		return nil, "", status.Errorf(codes.Unauthenticated, "account %s does not have %s capability", name, settings.AccountCapabilityApiKey)
+	}
+	return &token, name, nil
+}
@@ -0,0 +1,214 @@
+package session
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/golang-jwt/jwt/v5"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+const (
+	// authProviderClaim is the claim holding the provider which authenticated the subject of a token issued by Argo CD
+	// to a user which is not a local account
+	authProviderClaim = "auth_provider"
+	// AuthProviderLDAP is the provider of tokens issued to users authenticated against LDAP
+	AuthProviderLDAP = "ldap"
+	// ldapTimeout is the timeout of requests to the LDAP server
+	ldapTimeout = 10 * time.Second
+)
+
+// errLDAPUnavailable is returned to clients instead of the errors of the LDAP server, which may reveal details of the
+// directory and of the service account
+var errLDAPUnavailable = status.Error(codes.Unavailable, "failed to authenticate against the LDAP server")
+
+// ldapConn is the subset of an LDAP connection used to authenticate users
+type ldapConn interface {
+	Bind(username, password string) error
+	Search(searchRequest *ldap.SearchRequest) (*ldap.SearchResult, error)
+	Close() error
+}
+
+// ldapClaims are the claims of a session token issued to a user authenticated against LDAP
+type ldapClaims struct {
+	jwt.RegisteredClaims
+	AuthProvider string   `json:"auth_provider"`
+	Groups       []string `json:"groups,omitempty"`
+}
+
+func dialLDAP(config *settings.LDAPConfig) (ldapConn, error) {
+	u, err := url.Parse(config.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid LDAP url %s: %w", config.URL, err)
+	}
+	tlsConfig := config.TLSConfig(u.Hostname())
+	conn, err := ldap.DialURL(config.URL, ldap.DialWithTLSConfig(tlsConfig), ldap.DialWithDialer(&net.Dialer{Timeout: ldapTimeout}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to LDAP server %s: %w", config.URL, err)
+	}
+	conn.SetTimeout(ldapTimeout)
+	if config.StartTLS && u.Scheme != "ldaps" {
+		if err := conn.StartTLS(tlsConfig); err != nil {
+			_ = conn.Close()
+			return nil, fmt.Errorf("failed to start TLS with LDAP server %s: %w", config.URL, err)
+		}
+	}
+	return conn, nil
+}
+
+// VerifyLDAPUsernamePassword verifies the password of a user against the LDAP server of the given config and returns
+// the names of the groups the user is a member of. The user is looked up with the service account of the config and
+// the password is verified by binding as the user.
+func (mgr *SessionManager) VerifyLDAPUsernamePassword(config *settings.LDAPConfig, username string, password string) ([]string, error) {
+	groups, err := mgr.verifyLDAPUsernamePassword(config, username, password)
+	if _, ok := status.FromError(err); !ok {
+		log.WithError(err).Errorf("Failed to authenticate user %s against the LDAP server", username)
+		return nil, errLDAPUnavailable
+	}
+	return groups, err
+}
+
+func (mgr *SessionManager) verifyLDAPUsernamePassword(config *settings.LDAPConfig, username string, password string) ([]string, error) {
+	if password == "" {
+		return nil, status.Errorf(codes.Unauthenticated, blankPasswordError)
+	}
+	if len(username) > maxUsernameLength {
+		return nil, status.Errorf(codes.InvalidArgument, usernameTooLongError, maxUsernameLength)
+	}
+	attempt := mgr.getFailureCount(username)
+	if mgr.exceededFailedLoginAttempts(attempt) {
+		log.Warnf("User %s had too many failed logins (%d)", username, attempt.FailCount)
+		return nil, InvalidLoginErr
+	}
+
+	conn, err := mgr.dialLDAP(config)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	if config.BindDN != "" {
+		if err := conn.Bind(config.BindDN, config.BindPassword); err != nil {
+			return nil, fmt.Errorf("failed to bind to LDAP server with the service account: %w", err)
+		}
+	}
+	user, err := searchLDAPUser(conn, config, username)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		mgr.updateFailureCount(username, true)
+		return nil, InvalidLoginErr
+	}
+	if err := conn.Bind(user.DN, password); err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+			mgr.updateFailureCount(username, true)
+			return nil, InvalidLoginErr
+		}
+		return nil, fmt.Errorf("failed to bind to LDAP server as %s: %w", user.DN, err)
+	}
+	mgr.updateFailureCount(username, false)
+
+	if config.GroupSearch == nil {
+		return nil, nil
+	}
+	// search the groups with the service account, as users may not be allowed to
+	if config.BindDN != "" {
+		if err := conn.Bind(config.BindDN, config.BindPassword); err != nil {
+			return nil, fmt.Errorf("failed to bind to LDAP server with the service account: %w", err)
+		}
+	}
+	return searchLDAPGroups(conn, config.GroupSearch, user)
+}
+
+// searchLDAPUser returns the entry of the user with the given username, or nil if there is none
+func searchLDAPUser(conn ldapConn, config *settings.LDAPConfig, username string) (*ldap.Entry, error) {
+	filter := fmt.Sprintf("(%s=%s)", config.UserSearch.UsernameAttr, ldap.EscapeFilter(username))
+	if config.UserSearch.Filter != "" {
+		filter = fmt.Sprintf("(&%s%s)", config.UserSearch.Filter, filter)
+	}
+	var attributes []string
+	if g := config.GroupSearch; g != nil && g.UserAttr != "DN" {
+		attributes = append(attributes, g.UserAttr)
+	}
+	req := ldap.NewSearchRequest(config.UserSearch.BaseDN, ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 2, int(ldapTimeout.Seconds()), false, filter, attributes, nil)
+	res, err := conn.Search(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search LDAP user %s: %w", username, err)
+	}
+	switch len(res.Entries) {
+	case 0:
+		return nil, nil
+	case 1:
+		return res.Entries[0], nil
+	default:
+		return nil, fmt.Errorf("LDAP user search for %s matched multiple entries", username)
+	}
+}
+
+// searchLDAPGroups returns the names of the groups listing the given user as member
+func searchLDAPGroups(conn ldapConn, config *settings.LDAPGroupSearch, user *ldap.Entry) ([]string, error) {
+	member := user.DN
+	if config.UserAttr != "DN" {
+		member = user.GetAttributeValue(config.UserAttr)
+		if member == "" {
+			return nil, nil
+		}
+	}
+	filter := fmt.Sprintf("(%s=%s)", config.GroupAttr, ldap.EscapeFilter(member))
+	if config.Filter != "" {
+		filter = fmt.Sprintf("(&%s%s)", config.Filter, filter)
+	}
+	req := ldap.NewSearchRequest(config.BaseDN, ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 0, int(ldapTimeout.Seconds()), false, filter, []string{config.NameAttr}, nil)
+	res, err := conn.Search(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search LDAP groups of %s: %w", user.DN, err)
+	}
+	var groups []string
+	for _, entry := range res.Entries {
+		if name := entry.GetAttributeValue(config.NameAttr); name != "" {
+			groups = append(groups, name)
+		}
+	}
+	return groups, nil
+}
+
+// CreateLDAP creates a session token for a user authenticated against LDAP, which holds the groups of the user
+func (mgr *SessionManager) CreateLDAP(username string, groups []string, secondsBeforeExpiry int64, id string) (string, error) {
+	now := time.Now().UTC()
+	claims := ldapClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			IssuedAt:  jwt.NewNumericDate(now),
+			Issuer:    SessionManagerClaimsIssuer,
+			NotBefore: jwt.NewNumericDate(now),
+			Subject:   username,
+			ID:        id,
+		},
+		AuthProvider: AuthProviderLDAP,
+		Groups:       groups,
+	}
+	if secondsBeforeExpiry > 0 {
+		claims.ExpiresAt = jwt.NewNumericDate(now.Add(time.Duration(secondsBeforeExpiry) * time.Second))
+	}
+	return mgr.signClaims(claims)
+}
+
+// verifyLDAPToken verifies that a session token with the given id issued to a user authenticated against LDAP is still
+// valid
+func (mgr *SessionManager) verifyLDAPToken(argoCDSettings *settings.ArgoCDSettings, id string) error {
+	if argoCDSettings.LDAPConfig() == nil {
+		return errors.New("LDAP authentication is not configured")
+	}
+	if id == "" || mgr.storage.IsTokenRevoked(id) {
+		return errors.New("token is revoked, please re-login")
+	}
+	return nil
+}
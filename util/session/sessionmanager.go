@@ -2,6 +2,8 @@ package session
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"math"
@@ -44,6 +46,8 @@ type SessionManager struct {
 	verificationDelayNoiseEnabled bool
 	failedLock                    sync.RWMutex
 	metricsRegistry               MetricsRegistry
+	// dialLDAP connects to the LDAP server users are authenticated against
+	dialLDAP func(config *settings.LDAPConfig) (ldapConn, error)
 }
 
 // LoginAttempts is a timestamped counter for failed login attempts
@@ -123,6 +127,7 @@ func NewSessionManager(settingsMgr *settings.SettingsManager, projectsLister v1a
 		sleep:                         time.Sleep,
 		projectsLister:                projectsLister,
 		verificationDelayNoiseEnabled: true,
+		dialLDAP:                      dialLDAP,
 	}
 	settings, err := settingsMgr.GetSettings()
 	if err != nil {
@@ -161,6 +166,39 @@ func NewSessionManager(settingsMgr *settings.SettingsManager, projectsLister v1a
 // Passing a value of `0` for secondsBeforeExpiry creates a token that never expires.
 // The id parameter holds an optional unique JWT token identifier and stored as a standard claim "jti" in the JWT token.
 func (mgr *SessionManager) Create(subject string, secondsBeforeExpiry int64, id string) (string, error) {
+	return mgr.CreateScoped(subject, secondsBeforeExpiry, id, nil)
+}
+
+// sessionNonceClaim is the claim holding the session nonce of the account a session token was issued to
+const sessionNonceClaim = "session_nonce"
+
+// sessionActivityUpdateFraction is the fraction of the idle timeout after which the usage of a session is recorded
+// again, so that a session in use does not write to Redis on every request while its idle timeout is delayed by at
+// most this fraction
+const sessionActivityUpdateFraction = 10
+
+// authTimeClaim is the claim holding the time a user logged in, which is kept when a session token is renewed
+const authTimeClaim = "auth_time"
+
+// localClaims are the claims of a token issued to a local account. Scope restricts the token to a subset of the
+// permissions of its subject, SessionNonce ties a session token to the current session nonce of its account and
+// AuthTime is the time the session started.
+type localClaims struct {
+	jwt.RegisteredClaims
+	Scope        *settings.TokenScope `json:"token_scope,omitempty"`
+	SessionNonce string               `json:"session_nonce,omitempty"`
+	AuthTime     *jwt.NumericDate     `json:"auth_time,omitempty"`
+}
+
+// CreateScoped is like Create, but embeds the given scope in the token so that it is restricted to
+// a subset of the permissions of the subject. A nil scope creates an unrestricted token.
+func (mgr *SessionManager) CreateScoped(subject string, secondsBeforeExpiry int64, id string, scope *settings.TokenScope) (string, error) {
+	return mgr.create(subject, secondsBeforeExpiry, id, scope, time.Time{})
+}
+
+// create creates a token for the given subject. Session tokens of the login capability carry the given time the
+// session started, or the current time if it is zero, and never expire after the maximum session lifetime.
+func (mgr *SessionManager) create(subject string, secondsBeforeExpiry int64, id string, scope *settings.TokenScope, authTime time.Time) (string, error) {
 	now := time.Now().UTC()
 	claims := jwt.RegisteredClaims{
 		IssuedAt:  jwt.NewNumericDate(now),
@@ -173,8 +211,78 @@ func (mgr *SessionManager) Create(subject string, secondsBeforeExpiry int64, id
 		expires := now.Add(time.Duration(secondsBeforeExpiry) * time.Second)
 		claims.ExpiresAt = jwt.NewNumericDate(expires)
 	}
+	signed := localClaims{RegisteredClaims: claims, Scope: scope}
+	if name, capability := GetSubjectAccountAndCapability(subject); capability == settings.AccountCapabilityLogin {
+		account, err := mgr.settingsMgr.GetAccount(name)
+		if err != nil {
+			return "", err
+		}
+		signed.SessionNonce = account.SessionNonce
+		if authTime.IsZero() {
+			authTime = now
+		}
+		signed.AuthTime = jwt.NewNumericDate(authTime)
+		argoCDSettings, err := mgr.settingsMgr.GetSettings()
+		if err != nil {
+			return "", err
+		}
+		if maxLifetime := argoCDSettings.UserSessionMaxLifetime; maxLifetime > 0 {
+			if deadline := authTime.Add(maxLifetime); signed.ExpiresAt == nil || deadline.Before(signed.ExpiresAt.Time) {
+				signed.ExpiresAt = jwt.NewNumericDate(deadline)
+			}
+		}
+	}
+	return mgr.signClaims(signed)
+}
+
+// verifySessionActivity rejects session tokens which exceeded the maximum session lifetime or were not used within
+// the idle timeout, and records the usage of the token otherwise, so that the idle timeout slides with the activity
+// of the user
+func (mgr *SessionManager) verifySessionActivity(argoCDSettings *settings.ArgoCDSettings, claims jwt.MapClaims, ref TokenRef, issuedAt time.Time) error {
+	if maxLifetime := argoCDSettings.UserSessionMaxLifetime; maxLifetime > 0 {
+		authTime := issuedAt
+		if val, ok := claims[authTimeClaim].(float64); ok {
+			authTime = time.Unix(int64(val), 0)
+		}
+		if time.Since(authTime) > maxLifetime {
+			return errors.New("session has exceeded its maximum lifetime, please re-login")
+		}
+	}
+	idleTimeout := argoCDSettings.UserSessionIdleTimeout
+	if idleTimeout <= 0 {
+		return nil
+	}
+	lastUsedByRef, err := mgr.storage.GetTokensLastUsed(context.Background(), []TokenRef{ref})
+	if err != nil {
+		return fmt.Errorf("failed to get last usage of session: %w", err)
+	}
+	lastUsed := lastUsedByRef[ref]
+	if lastUsed.IsZero() || lastUsed.Before(issuedAt) {
+		lastUsed = issuedAt
+	}
+	if time.Since(lastUsed) > idleTimeout {
+		return errors.New("session has expired due to inactivity, please re-login")
+	}
+	if time.Since(lastUsed) < idleTimeout/sessionActivityUpdateFraction {
+		return nil
+	}
+	if err := mgr.storage.SetTokenLastUsed(context.Background(), ref, time.Now(), idleTimeout); err != nil {
+		log.Warnf("Failed to record last usage of session %s: %v", ref.ID, err)
+	}
+	return nil
+}
 
-	return mgr.signClaims(claims)
+// RevokeSessions revokes all session tokens issued to the given local account by changing its session nonce.
+// API tokens of the account are not affected.
+func (mgr *SessionManager) RevokeSessions(username string) error {
+	nonce, err := uuid.NewRandom()
+	if err != nil {
+		return err
+	}
+	return mgr.settingsMgr.UpdateAccount(username, func(acc *settings.Account) error {
+		acc.SessionNonce = nonce.String()
+		return nil
+	})
 }
 
 func (mgr *SessionManager) CollectMetrics(registry MetricsRegistry) {
@@ -251,11 +359,25 @@ func (mgr *SessionManager) Parse(tokenString string) (jwt.Claims, string, error)
 		if err != nil {
 			return nil, "", err
 		}
-		_, _, err = proj.GetJWTToken(role, issuedAt.Unix(), id)
+		projToken, _, err := proj.GetJWTToken(role, issuedAt.Unix(), id)
 		if err != nil {
 			return nil, "", err
 		}
+		// the expiry of the token in the project is shortened when the token is rotated with an overlap
+		if projToken.ExpiresAt > 0 && projToken.ExpiresAt <= time.Now().Unix() {
+			return nil, "", fmt.Errorf("JWT token for role '%s' of project '%s' has expired", role, projName)
+		}
+
+		return token.Claims, "", nil
+	}
 
+	if jwtutil.StringField(claims, authProviderClaim) == AuthProviderLDAP {
+		if err := mgr.verifyLDAPToken(argoCDSettings, id); err != nil {
+			return nil, "", err
+		}
+		if err := mgr.verifySessionActivity(argoCDSettings, claims, TokenRef{Account: subject, ID: id}, issuedAt); err != nil {
+			return nil, "", err
+		}
 		return token.Claims, "", nil
 	}
 
@@ -275,16 +397,42 @@ func (mgr *SessionManager) Parse(tokenString string) (jwt.Claims, string, error)
 		return nil, "", fmt.Errorf("account %s does not have '%s' capability", subject, capability)
 	}
 
+	if len(account.Projects) > 0 {
+		claims[rbacpolicy.AccountProjectsClaim] = account.Projects
+	} else {
+		delete(claims, rbacpolicy.AccountProjectsClaim)
+	}
+
 	if id == "" || mgr.storage.IsTokenRevoked(id) {
 		return nil, "", errors.New("token is revoked, please re-login")
 	} else if capability == settings.AccountCapabilityApiKey && account.TokenIndex(id) == -1 {
 		return nil, "", fmt.Errorf("account %s does not have token with id %s", subject, id)
 	}
 
+	if capability == settings.AccountCapabilityLogin {
+		if account.SessionNonce != jwtutil.StringField(claims, sessionNonceClaim) {
+			return nil, "", errors.New("token is revoked, please re-login")
+		}
+		if err := mgr.verifySessionActivity(argoCDSettings, claims, TokenRef{Account: subject, ID: id}, issuedAt); err != nil {
+			return nil, "", err
+		}
+	}
+
 	if account.PasswordMtime != nil && issuedAt.Before(*account.PasswordMtime) {
 		return nil, "", errors.New("account password has changed since token issued")
 	}
 
+	if capability == settings.AccountCapabilityApiKey {
+		// tokens without expiration keep their last usage until the record is evicted
+		var expiringAt time.Duration
+		if exp, err := jwtutil.ExpirationTime(claims); err == nil {
+			expiringAt = time.Until(exp)
+		}
+		if err := mgr.storage.SetTokenLastUsed(context.Background(), TokenRef{Account: subject, ID: id}, time.Now(), expiringAt); err != nil {
+			log.Warnf("Failed to record last usage of token %s: %v", id, err)
+		}
+	}
+
 	newToken := ""
 	if exp, err := jwtutil.ExpirationTime(claims); err == nil {
 		tokenExpDuration := exp.Sub(issuedAt)
@@ -292,7 +440,11 @@ func (mgr *SessionManager) Parse(tokenString string) (jwt.Claims, string, error)
 
 		if remainingDuration < autoRegenerateTokenDuration && capability == settings.AccountCapabilityLogin {
 			if uniqueId, err := uuid.NewRandom(); err == nil {
-				if val, err := mgr.Create(fmt.Sprintf("%s:%s", subject, settings.AccountCapabilityLogin), int64(tokenExpDuration.Seconds()), uniqueId.String()); err == nil {
+				authTime := issuedAt
+				if val, ok := claims[authTimeClaim].(float64); ok {
+					authTime = time.Unix(int64(val), 0)
+				}
+				if val, err := mgr.create(fmt.Sprintf("%s:%s", subject, settings.AccountCapabilityLogin), int64(tokenExpDuration.Seconds()), uniqueId.String(), nil, authTime); err == nil {
 					newToken = val
 				}
 			}
@@ -301,6 +453,12 @@ func (mgr *SessionManager) Parse(tokenString string) (jwt.Claims, string, error)
 	return token.Claims, newToken, nil
 }
 
+// GetTokensLastUsed returns when the given account tokens were last used to authenticate. Tokens which were not used
+// since their usage is tracked are omitted.
+func (mgr *SessionManager) GetTokensLastUsed(ctx context.Context, refs []TokenRef) (map[TokenRef]time.Time, error) {
+	return mgr.storage.GetTokensLastUsed(ctx, refs)
+}
+
 // GetLoginFailures retrieves the login failure information from the cache. Any modifications to the LoginAttemps map must be done in a thread-safe manner.
 func (mgr *SessionManager) GetLoginFailures() map[string]LoginAttempts {
 	// Get failures from the cache
@@ -461,9 +619,23 @@ func (mgr *SessionManager) VerifyUsernamePassword(username string, password stri
 		return err
 	}
 
+	lockoutPolicy, err := mgr.settingsMgr.GetAccountLockoutPolicy()
+	if err != nil {
+		return err
+	}
+	// the password of a locked account is not verified, and the error does not differ from a wrong password, so that
+	// guessing can not continue against a locked account
+	if account.IsLocked(lockoutPolicy) {
+		log.Warnf("Login of locked account %s rejected", username)
+		mgr.updateFailureCount(username, true)
+		_, _ = passwordutil.HashPassword("for_consistent_response_time")
+		return InvalidLoginErr
+	}
+
 	valid, _ := passwordutil.VerifyPassword(password, account.PasswordHash)
 	if !valid {
 		mgr.updateFailureCount(username, true)
+		mgr.recordLockoutFailure(username, lockoutPolicy)
 		return InvalidLoginErr
 	}
 
@@ -475,9 +647,49 @@ func (mgr *SessionManager) VerifyUsernamePassword(username string, password stri
 		return status.Errorf(codes.Unauthenticated, userDoesNotHaveCapability, username, settings.AccountCapabilityLogin)
 	}
 	mgr.updateFailureCount(username, false)
+	mgr.resetLockoutFailures(username)
 	return nil
 }
 
+// recordLockoutFailure records a failed login of an existing local account and locks the account once the number of
+// failed logins within the window of the lockout policy is reached
+func (mgr *SessionManager) recordLockoutFailure(username string, policy *settings.AccountLockoutPolicy) {
+	if policy.MaxFailedAttempts == 0 {
+		return
+	}
+	now := time.Now()
+	failures, err := mgr.storage.RecordFailedLogin(context.Background(), username, now, policy.Window)
+	if err != nil {
+		log.Warnf("Failed to record failed login of account %s: %v", username, err)
+		return
+	}
+	if failures < policy.MaxFailedAttempts {
+		return
+	}
+	err = mgr.settingsMgr.UpdateAccount(username, func(acc *settings.Account) error {
+		acc.LockedAt = &now
+		return nil
+	})
+	if err != nil {
+		log.Errorf("Failed to lock account %s: %v", username, err)
+		return
+	}
+	log.Warnf("Account %s locked after %d failed logins", username, failures)
+	mgr.resetLockoutFailures(username)
+}
+
+func (mgr *SessionManager) resetLockoutFailures(username string) {
+	if err := mgr.storage.ResetFailedLogins(context.Background(), username); err != nil {
+		log.Warnf("Failed to reset failed logins of account %s: %v", username, err)
+	}
+}
+
+// ResetLoginFailures forgets the failed logins of the given user, e.g. when its account is unlocked
+func (mgr *SessionManager) ResetLoginFailures(username string) {
+	mgr.updateFailureCount(username, false)
+	mgr.resetLockoutFailures(username)
+}
+
 // AuthMiddlewareFunc returns a function that can be used as an
 // authentication middleware for HTTP requests.
 func (mgr *SessionManager) AuthMiddlewareFunc(disabled bool) func(http.Handler) http.Handler {
@@ -570,10 +782,25 @@ func (mgr *SessionManager) VerifyToken(tokenString string) (jwt.Claims, string,
 		if err != nil {
 			return nil, "", err
 		}
+		// SSO sessions are subject to the same idle timeout and maximum lifetime as sessions of local users. Tokens
+		// without an issued at time are rejected when one of them is configured.
+		issuedAt, _ := jwtutil.IssuedAtTime(claims)
+		if err := mgr.verifySessionActivity(argoSettings, claims, TokenRef{Account: jwtutil.GetUserIdentifier(claims), ID: ssoTokenID(claims, tokenString)}, issuedAt); err != nil {
+			return nil, "", err
+		}
 		return claims, "", nil
 	}
 }
 
+// ssoTokenID returns the ID of a token issued by an IDP, or the hash of the token if it has none
+func ssoTokenID(claims jwt.MapClaims, tokenString string) string {
+	if id := jwtutil.StringField(claims, "jti"); id != "" {
+		return id
+	}
+	hash := sha256.Sum256([]byte(tokenString))
+	return hex.EncodeToString(hash[:])
+}
+
 func (mgr *SessionManager) provider() (oidcutil.Provider, error) {
 	if mgr.prov != nil {
 		return mgr.prov, nil
@@ -640,6 +867,16 @@ func GetUserIdentifier(ctx context.Context) string {
 	return jwtutil.GetUserIdentifier(mapClaims)
 }
 
+// IsScoped returns whether the token of the context is restricted to a subset of the permissions of its subject
+func IsScoped(ctx context.Context) bool {
+	mapClaims, ok := mapClaims(ctx)
+	if !ok {
+		return false
+	}
+	_, ok = mapClaims[rbacpolicy.TokenScopeClaim]
+	return ok
+}
+
 func Groups(ctx context.Context, scopes []string) []string {
 	mapClaims, ok := mapClaims(ctx)
 	if !ok {
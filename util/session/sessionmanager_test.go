@@ -14,6 +14,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-ldap/ldap/v3"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -28,6 +29,7 @@ import (
 	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	apps "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned/fake"
 	"github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/server/rbacpolicy"
 	"github.com/argoproj/argo-cd/v3/test"
 	jwtutil "github.com/argoproj/argo-cd/v3/util/jwt"
 	"github.com/argoproj/argo-cd/v3/util/password"
@@ -104,6 +106,65 @@ func TestSessionManager_AdminToken(t *testing.T) {
 	}
 }
 
+func TestSessionManager_RevokeSessions(t *testing.T) {
+	redisClient, closer := test.NewInMemoryRedis()
+	defer closer()
+
+	settingsMgr := settings.NewSettingsManager(t.Context(), getKubeClient(t, "pass", true), "argocd")
+	mgr := newSessionManager(settingsMgr, getProjLister(), NewUserStateStorage(redisClient))
+
+	token, err := mgr.Create("admin:login", 0, "123")
+	require.NoError(t, err)
+	_, _, err = mgr.Parse(token)
+	require.NoError(t, err)
+
+	require.NoError(t, mgr.RevokeSessions("admin"))
+	_, _, err = mgr.Parse(token)
+	require.EqualError(t, err, "token is revoked, please re-login")
+
+	// sessions created afterwards carry the new nonce
+	token, err = mgr.Create("admin:login", 0, "456")
+	require.NoError(t, err)
+	_, _, err = mgr.Parse(token)
+	require.NoError(t, err)
+
+	require.NoError(t, mgr.RevokeSessions("admin"))
+	_, _, err = mgr.Parse(token)
+	require.EqualError(t, err, "token is revoked, please re-login")
+}
+
+func TestSessionManager_AccountProjects(t *testing.T) {
+	redisClient, closer := test.NewInMemoryRedis()
+	defer closer()
+
+	kubeClient := getKubeClientWithConfig(map[string]string{"accounts.ci": "apiKey", "accounts.ci.projects": "foo, bar"}, nil)
+	settingsMgr := settings.NewSettingsManager(t.Context(), kubeClient, "argocd")
+	mgr := newSessionManager(settingsMgr, getProjLister(), NewUserStateStorage(redisClient))
+	require.NoError(t, settingsMgr.UpdateAccount("ci", func(account *settings.Account) error {
+		account.Tokens = append(account.Tokens, settings.Token{ID: "123"})
+		return nil
+	}))
+
+	token, err := mgr.Create("ci:apiKey", 0, "123")
+	require.NoError(t, err)
+	claims, _, err := mgr.Parse(token)
+	require.NoError(t, err)
+	mapClaims, err := jwtutil.MapClaims(claims)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"foo", "bar"}, jwtutil.GetScopeValues(mapClaims, []string{rbacpolicy.AccountProjectsClaim}))
+
+	// the claim always reflects the current binding of the account
+	require.NoError(t, settingsMgr.UpdateAccount("ci", func(account *settings.Account) error {
+		account.Projects = nil
+		return nil
+	}))
+	claims, _, err = mgr.Parse(token)
+	require.NoError(t, err)
+	mapClaims, err = jwtutil.MapClaims(claims)
+	require.NoError(t, err)
+	assert.NotContains(t, mapClaims, rbacpolicy.AccountProjectsClaim)
+}
+
 func TestSessionManager_AdminToken_ExpiringSoon(t *testing.T) {
 	redisClient, closer := test.NewInMemoryRedis()
 	defer closer()
@@ -216,6 +277,28 @@ func TestSessionManager_ProjectToken(t *testing.T) {
 		_, _, err = mgr.Parse(jwtToken)
 		assert.ErrorContains(t, err, "does not exist in project 'default'")
 	})
+
+	t.Run("Token Expired In Project", func(t *testing.T) {
+		proj := appv1.AppProject{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "default",
+				Namespace: "argocd",
+			},
+			Spec: appv1.AppProjectSpec{Roles: []appv1.ProjectRole{{Name: "test"}}},
+			Status: appv1.AppProjectStatus{JWTTokensByRole: map[string]appv1.JWTTokens{
+				"test": {
+					Items: []appv1.JWTToken{{ID: "abc", IssuedAt: time.Now().Unix(), ExpiresAt: time.Now().Add(-time.Minute).Unix()}},
+				},
+			}},
+		}
+		mgr := newSessionManager(settingsMgr, getProjLister(&proj), NewUserStateStorage(nil))
+
+		jwtToken, err := mgr.Create("proj:default:test", 0, "abc")
+		require.NoError(t, err)
+
+		_, _, err = mgr.Parse(jwtToken)
+		assert.ErrorContains(t, err, "has expired")
+	})
 }
 
 type tokenVerifierMock struct {
@@ -519,6 +602,69 @@ func TestLoginRateLimiter(t *testing.T) {
 	})
 }
 
+func TestAccountLockout(t *testing.T) {
+	bcrypt, err := password.HashPassword("password")
+	require.NoError(t, err)
+	kubeClient := getKubeClientWithConfig(map[string]string{
+		"accountLockout.maxFailedAttempts": "3",
+		"accountLockout.window":            "1h",
+	}, map[string][]byte{
+		"admin.password": []byte(bcrypt),
+	})
+	settingsMgr := settings.NewSettingsManager(t.Context(), kubeClient, "argocd")
+	mgr := newSessionManager(settingsMgr, getProjLister(), NewUserStateStorage(nil))
+
+	for i := 0; i < 2; i++ {
+		require.ErrorIs(t, mgr.VerifyUsernamePassword("admin", "wrong"), InvalidLoginErr)
+	}
+	// a successful login resets the failed logins
+	require.NoError(t, mgr.VerifyUsernamePassword("admin", "password"))
+	account, err := settingsMgr.GetAccount("admin")
+	require.NoError(t, err)
+	assert.Nil(t, account.LockedAt)
+
+	for i := 0; i < 3; i++ {
+		require.ErrorIs(t, mgr.VerifyUsernamePassword("admin", "wrong"), InvalidLoginErr)
+	}
+	account, err = settingsMgr.GetAccount("admin")
+	require.NoError(t, err)
+	require.NotNil(t, account.LockedAt)
+
+	// the right password is rejected like a wrong one while the account is locked
+	require.ErrorIs(t, mgr.VerifyUsernamePassword("admin", "password"), InvalidLoginErr)
+
+	require.NoError(t, settingsMgr.UpdateAccount("admin", func(acc *settings.Account) error {
+		acc.LockedAt = nil
+		return nil
+	}))
+	mgr.ResetLoginFailures("admin")
+	require.NoError(t, mgr.VerifyUsernamePassword("admin", "password"))
+}
+
+func TestAccountLockout_AcrossReplicas(t *testing.T) {
+	bcrypt, err := password.HashPassword("password")
+	require.NoError(t, err)
+	kubeClient := getKubeClientWithConfig(map[string]string{
+		"accountLockout.maxFailedAttempts": "3",
+		"accountLockout.window":            "1h",
+	}, map[string][]byte{
+		"admin.password": []byte(bcrypt),
+	})
+	redisClient, closer := test.NewInMemoryRedis()
+	defer closer()
+	settingsMgr := settings.NewSettingsManager(t.Context(), kubeClient, "argocd")
+	replica1 := newSessionManager(settingsMgr, getProjLister(), NewUserStateStorage(redisClient))
+	replica2 := newSessionManager(settingsMgr, getProjLister(), NewUserStateStorage(redisClient))
+
+	require.ErrorIs(t, replica1.VerifyUsernamePassword("admin", "wrong"), InvalidLoginErr)
+	require.ErrorIs(t, replica2.VerifyUsernamePassword("admin", "wrong"), InvalidLoginErr)
+	require.ErrorIs(t, replica1.VerifyUsernamePassword("admin", "wrong"), InvalidLoginErr)
+
+	account, err := settingsMgr.GetAccount("admin")
+	require.NoError(t, err)
+	require.NotNil(t, account.LockedAt)
+}
+
 func TestMaxUsernameLength(t *testing.T) {
 	username := ""
 	for i := 0; i < maxUsernameLength+1; i++ {
@@ -869,6 +1015,50 @@ requestedScopes: ["oidc"]`, oidcTestServer.URL),
 		require.Error(t, err)
 	})
 
+	t.Run("OIDC sessions are subject to the idle timeout and maximum lifetime", func(t *testing.T) {
+		config := map[string]string{
+			"url": "",
+			"oidc.config": fmt.Sprintf(`
+name: Test
+issuer: %s
+clientID: xxx
+clientSecret: yyy
+requestedScopes: ["oidc"]
+skipAudienceCheckWhenTokenHasNoAudience: true`, oidcTestServer.URL),
+			"oidc.tls.insecure.skip.verify": "true",
+			"users.session.idleTimeout":     "15m",
+			"users.session.maxLifetime":     "8h",
+		}
+
+		settingsMgr := settings.NewSettingsManager(t.Context(), getKubeClientWithConfig(config, nil), "argocd")
+		mgr := NewSessionManager(settingsMgr, getProjLister(), "", nil, NewUserStateStorage(nil))
+		mgr.verificationDelayNoiseEnabled = false
+
+		idToken := func(issuedAt time.Time, authTime time.Time) string {
+			claims := jwt.MapClaims{
+				"sub":       "admin",
+				"iss":       oidcTestServer.URL,
+				"iat":       issuedAt.Unix(),
+				"auth_time": authTime.Unix(),
+				"exp":       time.Now().Add(time.Hour * 24).Unix(),
+			}
+			key, err := jwt.ParseRSAPrivateKeyFromPEM(utiltest.PrivateKey)
+			require.NoError(t, err)
+			tokenString, err := jwt.NewWithClaims(jwt.SigningMethodRS512, claims).SignedString(key)
+			require.NoError(t, err)
+			return tokenString
+		}
+
+		_, _, err := mgr.VerifyToken(idToken(time.Now(), time.Now()))
+		require.NoError(t, err)
+
+		_, _, err = mgr.VerifyToken(idToken(time.Now().Add(-20*time.Minute), time.Now().Add(-20*time.Minute)))
+		require.EqualError(t, err, "session has expired due to inactivity, please re-login")
+
+		_, _, err = mgr.VerifyToken(idToken(time.Now(), time.Now().Add(-9*time.Hour)))
+		require.EqualError(t, err, "session has exceeded its maximum lifetime, please re-login")
+	})
+
 	t.Run("OIDC provider is external, audience is not specified, absent audience is allowed", func(t *testing.T) {
 		config := map[string]string{
 			"url": "",
@@ -1237,3 +1427,213 @@ func Test_PickFailureAttemptWhenOverflowed(t *testing.T) {
 		}
 	})
 }
+
+type fakeLDAPConn struct {
+	passwords map[string]string
+	users     []*ldap.Entry
+	groups    []*ldap.Entry
+	filters   []string
+}
+
+func (c *fakeLDAPConn) Bind(username, password string) error {
+	if p, ok := c.passwords[username]; !ok || p != password {
+		return ldap.NewError(ldap.LDAPResultInvalidCredentials, stderrors.New("invalid credentials"))
+	}
+	return nil
+}
+
+func (c *fakeLDAPConn) Search(req *ldap.SearchRequest) (*ldap.SearchResult, error) {
+	c.filters = append(c.filters, req.Filter)
+	var entries []*ldap.Entry
+	candidates := c.users
+	if req.BaseDN == "ou=groups,dc=example,dc=org" {
+		candidates = c.groups
+	}
+	for _, entry := range candidates {
+		for _, attr := range entry.Attributes {
+			for _, value := range attr.Values {
+				if strings.Contains(req.Filter, fmt.Sprintf("(%s=%s)", attr.Name, ldap.EscapeFilter(value))) {
+					entries = append(entries, entry)
+				}
+			}
+		}
+	}
+	return &ldap.SearchResult{Entries: entries}, nil
+}
+
+func (c *fakeLDAPConn) Close() error {
+	return nil
+}
+
+const testLDAPConfig = `
+url: ldaps://ldap.example.org
+bindDN: cn=argocd,dc=example,dc=org
+bindPassword: $ldap.bindPassword
+userSearch:
+  baseDN: ou=users,dc=example,dc=org
+groupSearch:
+  baseDN: ou=groups,dc=example,dc=org`
+
+func newLDAPSessionManager(t *testing.T, storage UserStateStorage) (*SessionManager, *fakeLDAPConn) {
+	t.Helper()
+	kubeClient := getKubeClientWithConfig(map[string]string{"ldap.config": testLDAPConfig}, map[string][]byte{"ldap.bindPassword": []byte("service-pass")})
+	settingsMgr := settings.NewSettingsManager(t.Context(), kubeClient, "argocd")
+	mgr := newSessionManager(settingsMgr, getProjLister(), storage)
+	conn := &fakeLDAPConn{
+		passwords: map[string]string{
+			"cn=argocd,dc=example,dc=org":          "service-pass",
+			"uid=alice,ou=users,dc=example,dc=org": "alice-pass",
+		},
+		users: []*ldap.Entry{
+			ldap.NewEntry("uid=alice,ou=users,dc=example,dc=org", map[string][]string{"uid": {"alice"}}),
+		},
+		groups: []*ldap.Entry{
+			ldap.NewEntry("cn=devs,ou=groups,dc=example,dc=org", map[string][]string{"cn": {"devs"}, "member": {"uid=alice,ou=users,dc=example,dc=org"}}),
+			ldap.NewEntry("cn=ops,ou=groups,dc=example,dc=org", map[string][]string{"cn": {"ops"}, "member": {"uid=bob,ou=users,dc=example,dc=org"}}),
+		},
+	}
+	mgr.dialLDAP = func(_ *settings.LDAPConfig) (ldapConn, error) {
+		return conn, nil
+	}
+	return mgr, conn
+}
+
+func TestVerifyLDAPUsernamePassword(t *testing.T) {
+	mgr, conn := newLDAPSessionManager(t, NewUserStateStorage(nil))
+	argoCDSettings, err := mgr.settingsMgr.GetSettings()
+	require.NoError(t, err)
+	config := argoCDSettings.LDAPConfig()
+	require.NotNil(t, config)
+	assert.Equal(t, "service-pass", config.BindPassword)
+
+	groups, err := mgr.VerifyLDAPUsernamePassword(config, "alice", "alice-pass")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"devs"}, groups)
+	assert.Equal(t, []string{"(uid=alice)", "(member=uid=alice,ou=users,dc=example,dc=org)"}, conn.filters)
+
+	_, err = mgr.VerifyLDAPUsernamePassword(config, "alice", "wrong")
+	require.EqualError(t, err, InvalidLoginErr.Error())
+	_, err = mgr.VerifyLDAPUsernamePassword(config, "bob", "bob-pass")
+	require.EqualError(t, err, InvalidLoginErr.Error())
+	_, err = mgr.VerifyLDAPUsernamePassword(config, "alice", "")
+	require.EqualError(t, err, status.Errorf(codes.Unauthenticated, blankPasswordError).Error())
+	assert.Len(t, mgr.GetLoginFailures(), 2)
+
+	_, err = mgr.VerifyLDAPUsernamePassword(config, "*", "alice-pass")
+	require.EqualError(t, err, InvalidLoginErr.Error())
+	assert.Contains(t, conn.filters, `(uid=\2a)`)
+
+	// errors of the LDAP server do not reach the client
+	conn.passwords["cn=argocd,dc=example,dc=org"] = "rotated"
+	_, err = mgr.VerifyLDAPUsernamePassword(config, "alice", "alice-pass")
+	require.EqualError(t, err, errLDAPUnavailable.Error())
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestSessionManager_LDAPToken(t *testing.T) {
+	redisClient, closer := test.NewInMemoryRedis()
+	defer closer()
+
+	mgr, _ := newLDAPSessionManager(t, NewUserStateStorage(redisClient))
+	token, err := mgr.CreateLDAP("alice", []string{"devs"}, 0, "123")
+	require.NoError(t, err)
+
+	claims, newToken, err := mgr.Parse(token)
+	require.NoError(t, err)
+	assert.Empty(t, newToken)
+	mapClaims, err := jwtutil.MapClaims(claims)
+	require.NoError(t, err)
+	assert.Equal(t, "alice", jwtutil.StringField(mapClaims, "sub"))
+	assert.Equal(t, AuthProviderLDAP, jwtutil.StringField(mapClaims, "auth_provider"))
+	assert.Equal(t, []string{"devs"}, jwtutil.GetGroups(mapClaims, []string{"groups"}))
+
+	token, err = mgr.CreateLDAP("alice", nil, 0, "")
+	require.NoError(t, err)
+	_, _, err = mgr.Parse(token)
+	require.EqualError(t, err, "token is revoked, please re-login")
+}
+
+func TestSessionManager_SessionIdleTimeoutAndMaxLifetime(t *testing.T) {
+	kubeClient := getKubeClient(t, "pass", true)
+	cm, err := kubeClient.CoreV1().ConfigMaps("argocd").Get(t.Context(), common.ArgoCDConfigMapName, metav1.GetOptions{})
+	require.NoError(t, err)
+	cm.Data["users.session.idleTimeout"] = "15m"
+	cm.Data["users.session.maxLifetime"] = "8h"
+	_, err = kubeClient.CoreV1().ConfigMaps("argocd").Update(t.Context(), cm, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	settingsMgr := settings.NewSettingsManager(t.Context(), kubeClient, "argocd")
+	storage := NewUserStateStorage(nil)
+	mgr := newSessionManager(settingsMgr, getProjLister(), storage)
+
+	sessionToken := func(id string, issuedAt time.Time, authTime time.Time, expiresIn time.Duration) string {
+		token, err := mgr.signClaims(localClaims{
+			RegisteredClaims: jwt.RegisteredClaims{
+				IssuedAt:  jwt.NewNumericDate(issuedAt),
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(expiresIn)),
+				Issuer:    SessionManagerClaimsIssuer,
+				Subject:   "admin:login",
+				ID:        id,
+			},
+			AuthTime: jwt.NewNumericDate(authTime),
+		})
+		require.NoError(t, err)
+		return token
+	}
+
+	t.Run("Sessions are capped by the maximum lifetime", func(t *testing.T) {
+		token, err := mgr.Create("admin:login", int64((24 * time.Hour).Seconds()), "1")
+		require.NoError(t, err)
+		claims, _, err := mgr.Parse(token)
+		require.NoError(t, err)
+		exp, err := claims.GetExpirationTime()
+		require.NoError(t, err)
+		assert.WithinDuration(t, time.Now().Add(8*time.Hour), exp.Time, time.Minute)
+	})
+
+	t.Run("Idle sessions expire", func(t *testing.T) {
+		issuedAt := time.Now().Add(-20 * time.Minute)
+		_, _, err := mgr.Parse(sessionToken("2", issuedAt, issuedAt, time.Hour))
+		require.EqualError(t, err, "session has expired due to inactivity, please re-login")
+
+		// activity slides the idle window
+		ref := TokenRef{Account: "admin", ID: "3"}
+		require.NoError(t, storage.SetTokenLastUsed(t.Context(), ref, time.Now().Add(-10*time.Minute), time.Hour))
+		_, _, err = mgr.Parse(sessionToken("3", issuedAt, issuedAt, time.Hour))
+		require.NoError(t, err)
+		lastUsed, err := storage.GetTokensLastUsed(t.Context(), []TokenRef{ref})
+		require.NoError(t, err)
+		assert.WithinDuration(t, time.Now(), lastUsed[ref], time.Minute)
+	})
+
+	t.Run("Renewed sessions expire after the maximum lifetime", func(t *testing.T) {
+		authTime := time.Now().Add(-9 * time.Hour)
+		_, _, err := mgr.Parse(sessionToken("4", time.Now(), authTime, time.Hour))
+		require.EqualError(t, err, "session has exceeded its maximum lifetime, please re-login")
+
+		authTime = time.Now().Add(-time.Hour)
+		_, newToken, err := mgr.Parse(sessionToken("5", time.Now(), authTime, time.Minute))
+		require.NoError(t, err)
+		require.NotEmpty(t, newToken)
+		claims, _, err := mgr.Parse(newToken)
+		require.NoError(t, err)
+		mapClaims, err := jwtutil.MapClaims(claims)
+		require.NoError(t, err)
+		assert.InDelta(t, authTime.Unix(), mapClaims["auth_time"], 0)
+	})
+
+	t.Run("Recent activity is not recorded again", func(t *testing.T) {
+		redisClient, closer := test.NewInMemoryRedis()
+		defer closer()
+		mgr := newSessionManager(settingsMgr, getProjLister(), NewUserStateStorage(redisClient))
+		ref := TokenRef{Account: "admin", ID: "6"}
+		usedAt := time.Now().Add(-30 * time.Second).Truncate(time.Second)
+		require.NoError(t, NewUserStateStorage(redisClient).SetTokenLastUsed(t.Context(), ref, usedAt, time.Hour))
+
+		issuedAt := time.Now().Add(-20 * time.Minute)
+		_, _, err := mgr.Parse(sessionToken("6", issuedAt, issuedAt, time.Hour))
+		require.NoError(t, err)
+		lastUsed, err := NewUserStateStorage(redisClient).GetTokensLastUsed(t.Context(), []TokenRef{ref})
+		require.NoError(t, err)
+		assert.Equal(t, usedAt.Unix(), lastUsed[ref].Unix())
+	})
+}
@@ -2,6 +2,7 @@ package session
 
 import (
 	"context"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -13,15 +14,33 @@ import (
 )
 
 const (
-	revokedTokenPrefix = "revoked-token|"
-	newRevokedTokenKey = "new-revoked-token"
+	revokedTokenPrefix  = "revoked-token|"
+	newRevokedTokenKey  = "new-revoked-token"
+	tokenLastUsedPrefix = "token-last-used|"
+	failedLoginsPrefix  = "failed-logins|"
+	// tokenLastUsedResolution is the minimum interval between two updates of the last usage of a token, so that
+	// authenticated requests do not write to Redis every time
+	tokenLastUsedResolution = time.Minute
 )
 
+// TokenRef identifies a token issued to an account. Token ids are only unique per account, since API tokens can be
+// created with an explicit id.
+type TokenRef struct {
+	Account string
+	ID      string
+}
+
+func (ref TokenRef) lastUsedKey() string {
+	return tokenLastUsedPrefix + ref.Account + "|" + ref.ID
+}
+
 type userStateStorage struct {
 	attempts            map[string]LoginAttempts
 	redis               *redis.Client
 	revokedTokens       map[string]bool
 	recentRevokedTokens map[string]bool
+	tokensLastUsed      map[TokenRef]time.Time
+	failedLogins        map[string][]time.Time
 	lock                sync.RWMutex
 	resyncDuration      time.Duration
 }
@@ -33,6 +52,8 @@ func NewUserStateStorage(redis *redis.Client) *userStateStorage {
 		attempts:            map[string]LoginAttempts{},
 		revokedTokens:       map[string]bool{},
 		recentRevokedTokens: map[string]bool{},
+		tokensLastUsed:      map[TokenRef]time.Time{},
+		failedLogins:        map[string][]time.Time{},
 		resyncDuration:      time.Second * 15,
 		redis:               redis,
 	}
@@ -136,6 +157,96 @@ func (storage *userStateStorage) IsTokenRevoked(id string) bool {
 	return storage.revokedTokens[id]
 }
 
+func (storage *userStateStorage) SetTokenLastUsed(ctx context.Context, ref TokenRef, usedAt time.Time, expiringAt time.Duration) error {
+	storage.lock.Lock()
+	if lastUsed, ok := storage.tokensLastUsed[ref]; ok && usedAt.Sub(lastUsed) < tokenLastUsedResolution {
+		storage.lock.Unlock()
+		return nil
+	}
+	storage.tokensLastUsed[ref] = usedAt
+	storage.lock.Unlock()
+	if storage.redis == nil {
+		return nil
+	}
+	return storage.redis.Set(ctx, ref.lastUsedKey(), usedAt.Unix(), expiringAt).Err()
+}
+
+func (storage *userStateStorage) GetTokensLastUsed(ctx context.Context, refs []TokenRef) (map[TokenRef]time.Time, error) {
+	res := make(map[TokenRef]time.Time, len(refs))
+	storage.lock.RLock()
+	for _, ref := range refs {
+		if usedAt, ok := storage.tokensLastUsed[ref]; ok {
+			res[ref] = usedAt
+		}
+	}
+	storage.lock.RUnlock()
+	if storage.redis == nil || len(refs) == 0 {
+		return res, nil
+	}
+	keys := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		keys = append(keys, ref.lastUsedKey())
+	}
+	// read the usage recorded by all replicas with a single round trip
+	values, err := storage.redis.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+	for i, val := range values {
+		str, ok := val.(string)
+		if !ok {
+			continue
+		}
+		usedAt, err := strconv.ParseInt(str, 10, 64)
+		if err != nil {
+			log.Warnf("Unexpected value of redis key '%s': %v", keys[i], err)
+			continue
+		}
+		res[refs[i]] = time.Unix(usedAt, 0)
+	}
+	return res, nil
+}
+
+func (storage *userStateStorage) RecordFailedLogin(ctx context.Context, username string, failedAt time.Time, window time.Duration) (int, error) {
+	if storage.redis == nil {
+		storage.lock.Lock()
+		defer storage.lock.Unlock()
+		var failures []time.Time
+		for _, t := range storage.failedLogins[username] {
+			if failedAt.Sub(t) <= window {
+				failures = append(failures, t)
+			}
+		}
+		failures = append(failures, failedAt)
+		storage.failedLogins[username] = failures
+		return len(failures), nil
+	}
+	// the failed logins are kept in a sorted set scored by time, so that the failures seen by all replicas count
+	key := failedLoginsPrefix + username
+	var count *redis.IntCmd
+	_, err := storage.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZRemRangeByScore(ctx, key, "-inf", "("+strconv.FormatInt(failedAt.Add(-window).UnixNano(), 10))
+		pipe.ZAdd(ctx, key, redis.Z{Score: float64(failedAt.UnixNano()), Member: strconv.FormatInt(failedAt.UnixNano(), 10)})
+		count = pipe.ZCard(ctx, key)
+		pipe.Expire(ctx, key, window)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return int(count.Val()), nil
+}
+
+func (storage *userStateStorage) ResetFailedLogins(ctx context.Context, username string) error {
+	storage.lock.Lock()
+	delete(storage.failedLogins, username)
+	storage.lock.Unlock()
+	if storage.redis == nil {
+		return nil
+	}
+	return storage.redis.Del(ctx, failedLoginsPrefix+username).Err()
+}
+
 func (storage *userStateStorage) GetLockObject() *sync.RWMutex {
 	return &storage.lock
 }
@@ -150,6 +261,15 @@ type UserStateStorage interface {
 	RevokeToken(ctx context.Context, id string, expiringAt time.Duration) error
 	// IsTokenRevoked checks if given token is revoked
 	IsTokenRevoked(id string) bool
+	// SetTokenLastUsed records the last usage of the given token (the record expires after specified timeout)
+	SetTokenLastUsed(ctx context.Context, ref TokenRef, usedAt time.Time, expiringAt time.Duration) error
+	// GetTokensLastUsed returns the last usage of the given tokens, tokens without recorded usage are omitted
+	GetTokensLastUsed(ctx context.Context, refs []TokenRef) (map[TokenRef]time.Time, error)
+	// RecordFailedLogin records a failed login of the given local account and returns the number of its failed logins
+	// within the window, across all API server replicas
+	RecordFailedLogin(ctx context.Context, username string, failedAt time.Time, window time.Duration) (int, error)
+	// ResetFailedLogins forgets the failed logins of the given local account
+	ResetFailedLogins(ctx context.Context, username string) error
 	// GetLockObject returns a lock used by the storage
 	GetLockObject() *sync.RWMutex
 }
@@ -27,3 +27,40 @@ func TestUserStateStorage_LoadRevokedTokens(t *testing.T) {
 
 	assert.True(t, storage.IsTokenRevoked("abc"))
 }
+
+func TestUserStateStorage_TokenLastUsed(t *testing.T) {
+	redis, closer := test.NewInMemoryRedis()
+	defer closer()
+
+	storage := NewUserStateStorage(redis)
+	abc := TokenRef{Account: "account1", ID: "abc"}
+	usedAt := time.Unix(1700000000, 0)
+	require.NoError(t, storage.SetTokenLastUsed(t.Context(), abc, usedAt, time.Hour))
+
+	lastUsed, err := storage.GetTokensLastUsed(t.Context(), []TokenRef{abc})
+	require.NoError(t, err)
+	assert.Equal(t, map[TokenRef]time.Time{abc: usedAt}, lastUsed)
+
+	// updates within the resolution are not recorded
+	require.NoError(t, storage.SetTokenLastUsed(t.Context(), abc, usedAt.Add(time.Second), time.Hour))
+	lastUsed, err = storage.GetTokensLastUsed(t.Context(), []TokenRef{abc})
+	require.NoError(t, err)
+	assert.Equal(t, usedAt, lastUsed[abc])
+
+	require.NoError(t, storage.SetTokenLastUsed(t.Context(), abc, usedAt.Add(tokenLastUsedResolution), time.Hour))
+	lastUsed, err = storage.GetTokensLastUsed(t.Context(), []TokenRef{abc})
+	require.NoError(t, err)
+	assert.Equal(t, usedAt.Add(tokenLastUsedResolution), lastUsed[abc])
+
+	// tokens with the same id in other accounts and unknown tokens are not reported
+	otherAccount := TokenRef{Account: "account2", ID: "abc"}
+	unknown := TokenRef{Account: "account1", ID: "unknown"}
+	lastUsed, err = storage.GetTokensLastUsed(t.Context(), []TokenRef{abc, otherAccount, unknown})
+	require.NoError(t, err)
+	assert.Equal(t, map[TokenRef]time.Time{abc: usedAt.Add(tokenLastUsedResolution)}, lastUsed)
+
+	// the usage recorded by other replicas is read from Redis
+	lastUsed, err = NewUserStateStorage(redis).GetTokensLastUsed(t.Context(), []TokenRef{abc, otherAccount})
+	require.NoError(t, err)
+	assert.Equal(t, map[TokenRef]time.Time{abc: usedAt.Add(tokenLastUsedResolution)}, lastUsed)
+}
@@ -3,10 +3,12 @@ package settings
 import (
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
 
+	timeutil "github.com/argoproj/pkg/v2/time"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -22,6 +24,10 @@ const (
 	accountPasswordMtimeSuffix = "passwordMtime"
 	accountEnabledSuffix       = "enabled"
 	accountTokensSuffix        = "tokens"
+	accountLockedAtSuffix      = "lockedAt"
+	accountSessionNonceSuffix  = "sessionNonce"
+	accountProjectsSuffix      = "projects"
+	accountExternalIDSuffix    = "externalId"
 
 	// Admin superuser password storage
 	// settingAdminPasswordHashKey designates the key for a root password hash inside a Kubernetes secret.
@@ -30,8 +36,31 @@ const (
 	settingAdminPasswordMtimeKey = "admin.passwordMtime"
 	settingAdminEnabledKey       = "admin.enabled"
 	settingAdminTokensKey        = "admin.tokens"
+	// settingAdminLockedAtKey designates the key for the time the admin account was locked inside a Kubernetes secret.
+	settingAdminLockedAtKey = "admin.lockedAt"
+	// settingAdminSessionNonceKey designates the key for the session nonce of the admin account inside a Kubernetes secret.
+	settingAdminSessionNonceKey = "admin.sessionNonce"
+
+	// accountLockoutMaxFailedAttemptsKey is the key to configure the number of failed logins after which an account is locked
+	accountLockoutMaxFailedAttemptsKey = "accountLockout.maxFailedAttempts"
+	// accountLockoutWindowKey is the key to configure the window in which failed logins are counted
+	accountLockoutWindowKey = "accountLockout.window"
+	// accountLockoutDurationKey is the key to configure how long an account stays locked
+	accountLockoutDurationKey = "accountLockout.duration"
+	// defaultAccountLockoutWindow is the default window in which failed logins are counted
+	defaultAccountLockoutWindow = 15 * time.Minute
+	// scimEnabledKey is the key to configure whether local accounts can be provisioned through the SCIM endpoint
+	scimEnabledKey = "scim.enabled"
 )
 
+// accountNameRegexp restricts local account names to characters that are safe to use in argocd-cm keys
+var accountNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9]([-_a-zA-Z0-9]*[a-zA-Z0-9])?$`)
+
+// IsValidAccountName returns whether the given name can be used for a local account
+func IsValidAccountName(name string) bool {
+	return accountNameRegexp.MatchString(name)
+}
+
 type AccountCapability string
 
 const (
@@ -41,11 +70,27 @@ const (
 	AccountCapabilityApiKey AccountCapability = "apiKey" //nolint:revive //FIXME(var-naming)
 )
 
+// TokenTypeClientCredentials is the type of tokens consisting of a client id and secret which are exchanged for
+// short-lived tokens at the token endpoint
+const TokenTypeClientCredentials = "client-credentials"
+
 // Token holds the information about the generated auth token.
 type Token struct {
-	ID        string `json:"id"`
-	IssuedAt  int64  `json:"iat"`
-	ExpiresAt int64  `json:"exp,omitempty"`
+	ID        string      `json:"id"`
+	IssuedAt  int64       `json:"iat"`
+	ExpiresAt int64       `json:"exp,omitempty"`
+	Scope     *TokenScope `json:"scope,omitempty"`
+	// Type is the type of the token, empty for API keys
+	Type string `json:"type,omitempty"`
+	// SecretHash is the hash of the client secret of a token of type client-credentials
+	SecretHash string `json:"secretHash,omitempty"`
+}
+
+// TokenScope restricts a token to a subset of the permissions of its account. Empty fields are not restricted.
+type TokenScope struct {
+	Projects  []string `json:"projects,omitempty"`
+	Actions   []string `json:"actions,omitempty"`
+	Resources []string `json:"resources,omitempty"`
 }
 
 // Account holds local account information
@@ -55,6 +100,40 @@ type Account struct {
 	Enabled       bool
 	Capabilities  []AccountCapability
 	Tokens        []Token
+	LockedAt      *time.Time
+	// SessionNonce is embedded in the session tokens of the account. Changing it revokes all issued session tokens.
+	SessionNonce string
+	// Projects are the projects the account is bound to. If set, all requests of the account are restricted to them.
+	Projects []string
+	// ExternalID is the identifier of the account in an external identity provider, e.g. the SCIM userName it was
+	// provisioned with if that is not a valid account name.
+	ExternalID string
+}
+
+// AccountLockoutPolicy holds the settings of the lockout of local accounts after repeated failed logins
+type AccountLockoutPolicy struct {
+	// MaxFailedAttempts is the number of failed logins within Window after which an account is locked. Zero disables the lockout.
+	MaxFailedAttempts int
+	// Window is the duration in which failed logins are counted
+	Window time.Duration
+	// Duration is how long an account stays locked. Zero means until it is unlocked explicitly.
+	Duration time.Duration
+}
+
+// IsLocked returns whether the account is locked according to the given lockout policy
+func (a *Account) IsLocked(policy *AccountLockoutPolicy) bool {
+	if a.LockedAt == nil {
+		return false
+	}
+	return policy.Duration == 0 || time.Since(*a.LockedAt) < policy.Duration
+}
+
+// FormatLockedAt return the formatted lock time or empty string if the account is not locked.
+func (a *Account) FormatLockedAt() string {
+	if a.LockedAt == nil {
+		return ""
+	}
+	return a.LockedAt.Format(time.RFC3339)
 }
 
 // FormatPasswordMtime return the formatted password modify time or empty string of password modify time is nil.
@@ -114,6 +193,22 @@ func (mgr *SettingsManager) AddAccount(name string, account Account) error {
 	return mgr.saveAccount(name, account)
 }
 
+// DeleteAccount removes the account with the given name together with its password and tokens.
+func (mgr *SettingsManager) DeleteAccount(name string) error {
+	if name == common.ArgoCDAdminUsername {
+		return status.Errorf(codes.InvalidArgument, "account '%s' cannot be deleted", name)
+	}
+	if _, err := mgr.GetAccount(name); err != nil {
+		return err
+	}
+	return mgr.updateSecret(func(secret *corev1.Secret) error {
+		return mgr.updateConfigMap(func(cm *corev1.ConfigMap) error {
+			deleteAccount(secret, cm, name)
+			return nil
+		})
+	})
+}
+
 // GetAccount return an account info by the specified name.
 func (mgr *SettingsManager) GetAccount(name string) (*Account, error) {
 	accounts, err := mgr.GetAccounts()
@@ -156,6 +251,50 @@ func (mgr *SettingsManager) GetAccounts() (map[string]Account, error) {
 	return parseAccounts(secret, cm)
 }
 
+// IsSCIMEnabled returns whether local accounts and their groups can be provisioned through the SCIM endpoint
+func (mgr *SettingsManager) IsSCIMEnabled() (bool, error) {
+	cm, err := mgr.getConfigMap()
+	if err != nil {
+		return false, fmt.Errorf("error checking %s property in configmap: %w", scimEnabledKey, err)
+	}
+	return cm.Data[scimEnabledKey] == "true", nil
+}
+
+// GetAccountLockoutPolicy returns the lockout policy of local accounts configured in argocd-cm
+func (mgr *SettingsManager) GetAccountLockoutPolicy() (*AccountLockoutPolicy, error) {
+	cm, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, err
+	}
+	return getAccountLockoutPolicy(cm.Data)
+}
+
+func getAccountLockoutPolicy(data map[string]string) (*AccountLockoutPolicy, error) {
+	policy := AccountLockoutPolicy{Window: defaultAccountLockoutWindow}
+	if val := data[accountLockoutMaxFailedAttemptsKey]; val != "" {
+		maxFailedAttempts, err := strconv.Atoi(val)
+		if err != nil || maxFailedAttempts < 0 {
+			return nil, fmt.Errorf("invalid value %q for %s: must be a non-negative integer", val, accountLockoutMaxFailedAttemptsKey)
+		}
+		policy.MaxFailedAttempts = maxFailedAttempts
+	}
+	if val := data[accountLockoutWindowKey]; val != "" {
+		window, err := timeutil.ParseDuration(val)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %q for %s: %w", val, accountLockoutWindowKey, err)
+		}
+		policy.Window = *window
+	}
+	if val := data[accountLockoutDurationKey]; val != "" {
+		duration, err := timeutil.ParseDuration(val)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %q for %s: %w", val, accountLockoutDurationKey, err)
+		}
+		policy.Duration = *duration
+	}
+	return &policy, nil
+}
+
 func updateAccountMap(cm *corev1.ConfigMap, key string, val string, defVal string) {
 	existingVal := cm.Data[key]
 	if existingVal != val {
@@ -187,17 +326,33 @@ func saveAccount(secret *corev1.Secret, cm *corev1.ConfigMap, name string, accou
 		updateAccountSecret(secret, settingAdminPasswordHashKey, account.PasswordHash, "")
 		updateAccountSecret(secret, settingAdminPasswordMtimeKey, account.FormatPasswordMtime(), "")
 		updateAccountSecret(secret, settingAdminTokensKey, string(tokens), "[]")
+		updateAccountSecret(secret, settingAdminLockedAtKey, account.FormatLockedAt(), "")
+		updateAccountSecret(secret, settingAdminSessionNonceKey, account.SessionNonce, "")
 		updateAccountMap(cm, settingAdminEnabledKey, strconv.FormatBool(account.Enabled), "true")
 	} else {
 		updateAccountSecret(secret, fmt.Sprintf("%s.%s.%s", accountsKeyPrefix, name, accountPasswordSuffix), account.PasswordHash, "")
 		updateAccountSecret(secret, fmt.Sprintf("%s.%s.%s", accountsKeyPrefix, name, accountPasswordMtimeSuffix), account.FormatPasswordMtime(), "")
 		updateAccountSecret(secret, fmt.Sprintf("%s.%s.%s", accountsKeyPrefix, name, accountTokensSuffix), string(tokens), "[]")
+		updateAccountSecret(secret, fmt.Sprintf("%s.%s.%s", accountsKeyPrefix, name, accountLockedAtSuffix), account.FormatLockedAt(), "")
+		updateAccountSecret(secret, fmt.Sprintf("%s.%s.%s", accountsKeyPrefix, name, accountSessionNonceSuffix), account.SessionNonce, "")
 		updateAccountMap(cm, fmt.Sprintf("%s.%s.%s", accountsKeyPrefix, name, accountEnabledSuffix), strconv.FormatBool(account.Enabled), "true")
+		updateAccountMap(cm, fmt.Sprintf("%s.%s.%s", accountsKeyPrefix, name, accountProjectsSuffix), strings.Join(account.Projects, ","), "")
+		updateAccountMap(cm, fmt.Sprintf("%s.%s.%s", accountsKeyPrefix, name, accountExternalIDSuffix), account.ExternalID, "")
 		updateAccountMap(cm, fmt.Sprintf("%s.%s", accountsKeyPrefix, name), account.FormatCapabilities(), "")
 	}
 	return nil
 }
 
+func deleteAccount(secret *corev1.Secret, cm *corev1.ConfigMap, name string) {
+	for _, suffix := range []string{accountPasswordSuffix, accountPasswordMtimeSuffix, accountTokensSuffix, accountLockedAtSuffix, accountSessionNonceSuffix} {
+		delete(secret.Data, fmt.Sprintf("%s.%s.%s", accountsKeyPrefix, name, suffix))
+	}
+	delete(cm.Data, fmt.Sprintf("%s.%s.%s", accountsKeyPrefix, name, accountEnabledSuffix))
+	delete(cm.Data, fmt.Sprintf("%s.%s.%s", accountsKeyPrefix, name, accountProjectsSuffix))
+	delete(cm.Data, fmt.Sprintf("%s.%s.%s", accountsKeyPrefix, name, accountExternalIDSuffix))
+	delete(cm.Data, fmt.Sprintf("%s.%s", accountsKeyPrefix, name))
+}
+
 func parseAdminAccount(secret *corev1.Secret, cm *corev1.ConfigMap) (*Account, error) {
 	adminAccount := &Account{Enabled: true, Capabilities: []AccountCapability{AccountCapabilityLogin}}
 	if adminPasswordHash, ok := secret.Data[settingAdminPasswordHashKey]; ok {
@@ -208,6 +363,14 @@ func parseAdminAccount(secret *corev1.Secret, cm *corev1.ConfigMap) (*Account, e
 			adminAccount.PasswordMtime = &mTime
 		}
 	}
+	if adminLockedAtBytes, ok := secret.Data[settingAdminLockedAtKey]; ok {
+		if lockedAt, err := time.Parse(time.RFC3339, string(adminLockedAtBytes)); err == nil {
+			adminAccount.LockedAt = &lockedAt
+		}
+	}
+	if sessionNonce, ok := secret.Data[settingAdminSessionNonceKey]; ok {
+		adminAccount.SessionNonce = string(sessionNonce)
+	}
 
 	adminAccount.Tokens = make([]Token, 0)
 	if tokensStr, ok := secret.Data[settingAdminTokensKey]; ok && len(tokensStr) != 0 {
@@ -283,6 +446,14 @@ func parseAccounts(secret *corev1.Secret, cm *corev1.ConfigMap) (map[string]Acco
 			if err != nil {
 				return nil, err
 			}
+		case accountProjectsSuffix:
+			for _, project := range strings.Split(val, ",") {
+				if project = strings.TrimSpace(project); project != "" {
+					account.Projects = append(account.Projects, project)
+				}
+			}
+		case accountExternalIDSuffix:
+			account.ExternalID = val
 		}
 		accounts[accountName] = account
 	}
@@ -302,6 +473,16 @@ func parseAccounts(secret *corev1.Secret, cm *corev1.ConfigMap) (map[string]Acco
 			}
 			account.PasswordMtime = &mTime
 		}
+		if lockedAt, ok := secret.Data[fmt.Sprintf("%s.%s.%s", accountsKeyPrefix, name, accountLockedAtSuffix)]; ok {
+			lockedAtTime, err := time.Parse(time.RFC3339, string(lockedAt))
+			if err != nil {
+				return nil, err
+			}
+			account.LockedAt = &lockedAtTime
+		}
+		if sessionNonce, ok := secret.Data[fmt.Sprintf("%s.%s.%s", accountsKeyPrefix, name, accountSessionNonceSuffix)]; ok {
+			account.SessionNonce = string(sessionNonce)
+		}
 		if tokensStr, ok := secret.Data[fmt.Sprintf("%s.%s.%s", accountsKeyPrefix, name, accountTokensSuffix)]; ok {
 			account.Tokens = make([]Token, 0)
 			if len(tokensStr) != 0 {
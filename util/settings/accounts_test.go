@@ -166,6 +166,44 @@ func TestAddAccount_AccountAdded(t *testing.T) {
 	assert.JSONEq(t, `[{"id":"123","iat":0}]`, string(secret.Data["accounts.test.tokens"]))
 }
 
+func TestAddAccount_Projects(t *testing.T) {
+	clientset, settingsManager := fixtures(nil)
+	err := settingsManager.AddAccount("ci", Account{Enabled: true, Capabilities: []AccountCapability{AccountCapabilityApiKey}, Projects: []string{"foo", "bar"}})
+	require.NoError(t, err)
+
+	cm, err := clientset.CoreV1().ConfigMaps("default").Get(t.Context(), common.ArgoCDConfigMapName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "foo,bar", cm.Data["accounts.ci.projects"])
+
+	acc, err := settingsManager.GetAccount("ci")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"foo", "bar"}, acc.Projects)
+
+	require.NoError(t, settingsManager.DeleteAccount("ci"))
+	cm, err = clientset.CoreV1().ConfigMaps("default").Get(t.Context(), common.ArgoCDConfigMapName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.NotContains(t, cm.Data, "accounts.ci.projects")
+}
+
+func TestAddAccount_ExternalID(t *testing.T) {
+	clientset, settingsManager := fixtures(nil)
+	err := settingsManager.AddAccount("bob-example-com", Account{Enabled: true, ExternalID: "bob@example.com"})
+	require.NoError(t, err)
+
+	cm, err := clientset.CoreV1().ConfigMaps("default").Get(t.Context(), common.ArgoCDConfigMapName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "bob@example.com", cm.Data["accounts.bob-example-com.externalId"])
+
+	acc, err := settingsManager.GetAccount("bob-example-com")
+	require.NoError(t, err)
+	assert.Equal(t, "bob@example.com", acc.ExternalID)
+
+	require.NoError(t, settingsManager.DeleteAccount("bob-example-com"))
+	cm, err = clientset.CoreV1().ConfigMaps("default").Get(t.Context(), common.ArgoCDConfigMapName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.NotContains(t, cm.Data, "accounts.bob-example-com.externalId")
+}
+
 func TestAddAccount_AlreadyExists(t *testing.T) {
 	_, settingsManager := fixtures(map[string]string{"accounts.test": "login"})
 	err := settingsManager.AddAccount("test", Account{})
@@ -224,6 +262,86 @@ func TestUpdateAccount_UpdateAdminPassword(t *testing.T) {
 	assert.Equal(t, mTime.Format(time.RFC3339), string(secret.Data["admin.passwordMtime"]))
 }
 
+func TestUpdateAccount_LockAccount(t *testing.T) {
+	clientset, settingsManager := fixtures(map[string]string{"accounts.test": "login"})
+	lockedAt := time.Now().Truncate(time.Second)
+
+	err := settingsManager.UpdateAccount("test", func(account *Account) error {
+		account.LockedAt = &lockedAt
+		return nil
+	})
+	require.NoError(t, err)
+
+	secret, err := clientset.CoreV1().Secrets("default").Get(t.Context(), common.ArgoCDSecretName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, lockedAt.Format(time.RFC3339), string(secret.Data["accounts.test.lockedAt"]))
+
+	acc, err := settingsManager.GetAccount("test")
+	require.NoError(t, err)
+	require.NotNil(t, acc.LockedAt)
+	assert.True(t, lockedAt.Equal(*acc.LockedAt))
+
+	err = settingsManager.UpdateAccount("test", func(account *Account) error {
+		account.LockedAt = nil
+		return nil
+	})
+	require.NoError(t, err)
+
+	secret, err = clientset.CoreV1().Secrets("default").Get(t.Context(), common.ArgoCDSecretName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.NotContains(t, secret.Data, "accounts.test.lockedAt")
+}
+
+func TestUpdateAccount_SessionNonce(t *testing.T) {
+	clientset, settingsManager := fixtures(map[string]string{"accounts.test": "login"})
+
+	for _, name := range []string{"test", "admin"} {
+		err := settingsManager.UpdateAccount(name, func(account *Account) error {
+			account.SessionNonce = "nonce-" + name
+			return nil
+		})
+		require.NoError(t, err)
+
+		acc, err := settingsManager.GetAccount(name)
+		require.NoError(t, err)
+		assert.Equal(t, "nonce-"+name, acc.SessionNonce)
+	}
+
+	secret, err := clientset.CoreV1().Secrets("default").Get(t.Context(), common.ArgoCDSecretName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "nonce-test", string(secret.Data["accounts.test.sessionNonce"]))
+	assert.Equal(t, "nonce-admin", string(secret.Data["admin.sessionNonce"]))
+}
+
+func TestIsLocked(t *testing.T) {
+	recently := time.Now().Add(-time.Minute)
+	longAgo := time.Now().Add(-time.Hour)
+
+	assert.False(t, (&Account{}).IsLocked(&AccountLockoutPolicy{}))
+	assert.True(t, (&Account{LockedAt: &longAgo}).IsLocked(&AccountLockoutPolicy{}))
+	assert.True(t, (&Account{LockedAt: &recently}).IsLocked(&AccountLockoutPolicy{Duration: 30 * time.Minute}))
+	assert.False(t, (&Account{LockedAt: &longAgo}).IsLocked(&AccountLockoutPolicy{Duration: 30 * time.Minute}))
+}
+
+func TestGetAccountLockoutPolicy(t *testing.T) {
+	policy, err := getAccountLockoutPolicy(map[string]string{})
+	require.NoError(t, err)
+	assert.Equal(t, &AccountLockoutPolicy{Window: 15 * time.Minute}, policy)
+
+	policy, err = getAccountLockoutPolicy(map[string]string{
+		"accountLockout.maxFailedAttempts": "10",
+		"accountLockout.window":            "1h",
+		"accountLockout.duration":          "30m",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &AccountLockoutPolicy{MaxFailedAttempts: 10, Window: time.Hour, Duration: 30 * time.Minute}, policy)
+
+	_, err = getAccountLockoutPolicy(map[string]string{"accountLockout.maxFailedAttempts": "many"})
+	require.ErrorContains(t, err, "accountLockout.maxFailedAttempts")
+	_, err = getAccountLockoutPolicy(map[string]string{"accountLockout.duration": "forever"})
+	require.ErrorContains(t, err, "accountLockout.duration")
+}
+
 func TestUpdateAccount_AccountDoesNotExist(t *testing.T) {
 	_, settingsManager := fixtures(map[string]string{"accounts.test": "login"})
 
@@ -233,3 +351,34 @@ func TestUpdateAccount_AccountDoesNotExist(t *testing.T) {
 	})
 	require.Error(t, err)
 }
+
+func TestDeleteAccount_AccountDeleted(t *testing.T) {
+	clientset, settingsManager := fixtures(map[string]string{"accounts.test": "login", "accounts.test.enabled": "false", "accounts.other": "apiKey"}, func(secret *corev1.Secret) {
+		secret.Data["accounts.test.password"] = []byte("hash")
+		secret.Data["accounts.test.tokens"] = []byte(`[{"id":"123","iat":0}]`)
+	})
+
+	err := settingsManager.DeleteAccount("test")
+	require.NoError(t, err)
+
+	cm, err := clientset.CoreV1().ConfigMaps("default").Get(t.Context(), common.ArgoCDConfigMapName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"accounts.other": "apiKey"}, cm.Data)
+
+	secret, err := clientset.CoreV1().Secrets("default").Get(t.Context(), common.ArgoCDSecretName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.NotContains(t, secret.Data, "accounts.test.password")
+	assert.NotContains(t, secret.Data, "accounts.test.tokens")
+}
+
+func TestDeleteAccount_AccountDoesNotExist(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{"accounts.test": "login"})
+	err := settingsManager.DeleteAccount("test1")
+	require.Error(t, err)
+}
+
+func TestDeleteAccount_CannotDeleteAdmin(t *testing.T) {
+	_, settingsManager := fixtures(nil)
+	err := settingsManager.DeleteAccount("admin")
+	require.Error(t, err)
+}
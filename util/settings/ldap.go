@@ -0,0 +1,131 @@
+package settings
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+
+	log "github.com/sirupsen/logrus"
+	"sigs.k8s.io/yaml"
+)
+
+// settingsLDAPConfigKey designates the key for the LDAP authentication config
+const settingsLDAPConfigKey = "ldap.config"
+
+// LDAPConfig holds the configuration of the authentication of users against an LDAP server, e.g. Active Directory
+type LDAPConfig struct {
+	// URL is the URL of the LDAP server, e.g. ldaps://ad.example.com:636
+	URL string `json:"url"`
+	// StartTLS upgrades a plain ldap:// connection to TLS
+	StartTLS bool `json:"startTLS,omitempty"`
+	// InsecureSkipVerify disables the verification of the certificate of the LDAP server
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+	// RootCA is the PEM encoded certificate of the CA which signed the certificate of the LDAP server
+	RootCA string `json:"rootCA,omitempty"`
+	// BindDN and BindPassword are the credentials of the service account used to search users and groups
+	BindDN       string `json:"bindDN,omitempty"`
+	BindPassword string `json:"bindPassword,omitempty"`
+	// UserSearch configures how the entry of a user is found by the username
+	UserSearch LDAPUserSearch `json:"userSearch"`
+	// GroupSearch configures how the groups of a user are found. Users have no groups if it is not configured.
+	GroupSearch *LDAPGroupSearch `json:"groupSearch,omitempty"`
+}
+
+// LDAPUserSearch configures how the entry of a user is found by the username
+type LDAPUserSearch struct {
+	// BaseDN is the DN of the subtree to search users in
+	BaseDN string `json:"baseDN"`
+	// Filter is an optional filter which is combined with the username filter, e.g. (objectClass=person)
+	Filter string `json:"filter,omitempty"`
+	// UsernameAttr is the attribute matched against the username. Defaults to uid.
+	UsernameAttr string `json:"usernameAttr,omitempty"`
+}
+
+// LDAPGroupSearch configures how the groups of a user are found
+type LDAPGroupSearch struct {
+	// BaseDN is the DN of the subtree to search groups in
+	BaseDN string `json:"baseDN"`
+	// Filter is an optional filter which is combined with the membership filter, e.g. (objectClass=group)
+	Filter string `json:"filter,omitempty"`
+	// UserAttr is the attribute of the user entry which is listed in the groups. Defaults to DN, the DN of the entry.
+	UserAttr string `json:"userAttr,omitempty"`
+	// GroupAttr is the attribute of the group entries which lists their members. Defaults to member.
+	GroupAttr string `json:"groupAttr,omitempty"`
+	// NameAttr is the attribute holding the name of a group used in the groups claim. Defaults to cn.
+	NameAttr string `json:"nameAttr,omitempty"`
+}
+
+func unmarshalLDAPConfig(configStr string) (*LDAPConfig, error) {
+	var config LDAPConfig
+	if err := yaml.Unmarshal([]byte(configStr), &config); err != nil {
+		return nil, err
+	}
+	if config.URL == "" {
+		return nil, errors.New("url is required")
+	}
+	if config.UserSearch.BaseDN == "" {
+		return nil, errors.New("userSearch.baseDN is required")
+	}
+	if config.UserSearch.UsernameAttr == "" {
+		config.UserSearch.UsernameAttr = "uid"
+	}
+	if g := config.GroupSearch; g != nil {
+		if g.BaseDN == "" {
+			return nil, errors.New("groupSearch.baseDN is required")
+		}
+		if g.UserAttr == "" {
+			g.UserAttr = "DN"
+		}
+		if g.GroupAttr == "" {
+			g.GroupAttr = "member"
+		}
+		if g.NameAttr == "" {
+			g.NameAttr = "cn"
+		}
+	}
+	return &config, nil
+}
+
+// ValidateLDAPConfig returns an error if the given LDAP config is invalid
+func ValidateLDAPConfig(configStr string) error {
+	_, err := unmarshalLDAPConfig(configStr)
+	return err
+}
+
+// LDAPConfig returns the LDAP authentication config with the secret references replaced by their values, or nil if
+// LDAP authentication is not configured
+func (a *ArgoCDSettings) LDAPConfig() *LDAPConfig {
+	if a.LDAPConfigRAW == "" {
+		return nil
+	}
+	configMap := map[string]any{}
+	if err := yaml.Unmarshal([]byte(a.LDAPConfigRAW), &configMap); err != nil {
+		log.Warnf("invalid ldap config: %v", err)
+		return nil
+	}
+	data, err := yaml.Marshal(ReplaceMapSecrets(configMap, a.Secrets))
+	if err != nil {
+		log.Warnf("invalid ldap config: %v", err)
+		return nil
+	}
+	config, err := unmarshalLDAPConfig(string(data))
+	if err != nil {
+		log.Warnf("invalid ldap config: %v", err)
+		return nil
+	}
+	return config
+}
+
+// TLSConfig returns the TLS config used to connect to the LDAP server
+func (c *LDAPConfig) TLSConfig(serverName string) *tls.Config {
+	tlsConfig := &tls.Config{ServerName: serverName, InsecureSkipVerify: c.InsecureSkipVerify} //nolint:gosec // configurable for test setups
+	if c.RootCA != "" {
+		certPool := x509.NewCertPool()
+		if certPool.AppendCertsFromPEM([]byte(c.RootCA)) {
+			tlsConfig.RootCAs = certPool
+		} else {
+			log.Warn("failed to append certificates from PEM: proceeding without custom rootCA")
+		}
+	}
+	return tlsConfig
+}
@@ -0,0 +1,144 @@
+package settings
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	timeutil "github.com/argoproj/pkg/v2/time"
+)
+
+const (
+	// passwordPolicyMinLengthKey is the key to configure the minimum length of local user passwords
+	passwordPolicyMinLengthKey = "passwordPolicy.minLength"
+	// passwordPolicyCharacterClassesKey is the key to configure the character classes local user passwords must contain
+	passwordPolicyCharacterClassesKey = "passwordPolicy.characterClasses"
+	// passwordPolicyBannedPasswordsKey is the key to configure the newline separated list of banned passwords
+	passwordPolicyBannedPasswordsKey = "passwordPolicy.bannedPasswords"
+	// passwordPolicyMaxAgeKey is the key to configure the duration after which local user passwords expire
+	passwordPolicyMaxAgeKey = "passwordPolicy.maxAge"
+)
+
+// PasswordCharacterClass is a class of characters a password can be required to contain
+type PasswordCharacterClass string
+
+const (
+	PasswordCharacterClassUppercase PasswordCharacterClass = "uppercase"
+	PasswordCharacterClassLowercase PasswordCharacterClass = "lowercase"
+	PasswordCharacterClassDigit     PasswordCharacterClass = "digit"
+	PasswordCharacterClassSymbol    PasswordCharacterClass = "symbol"
+)
+
+var passwordCharacterClasses = map[PasswordCharacterClass]func(rune) bool{
+	PasswordCharacterClassUppercase: unicode.IsUpper,
+	PasswordCharacterClassLowercase: unicode.IsLower,
+	PasswordCharacterClassDigit:     unicode.IsDigit,
+	PasswordCharacterClassSymbol: func(r rune) bool {
+		return unicode.IsPunct(r) || unicode.IsSymbol(r)
+	},
+}
+
+// PasswordPolicy holds the requirements local user passwords must satisfy
+type PasswordPolicy struct {
+	// MinLength is the minimum number of characters of a password
+	MinLength int
+	// CharacterClasses are the classes of characters a password must contain at least one character of
+	CharacterClasses []PasswordCharacterClass
+	// BannedPasswords are passwords which are not allowed, compared case-insensitively
+	BannedPasswords []string
+	// MaxAge is the duration after which a password expires. Zero means passwords never expire.
+	MaxAge time.Duration
+}
+
+// PasswordPolicyErrorPrefix prefixes the message of a PasswordPolicyError, which lists the violations separated by
+// PasswordPolicyViolationSeparator
+const (
+	PasswordPolicyErrorPrefix        = "password does not satisfy the password policy: "
+	PasswordPolicyViolationSeparator = "; "
+)
+
+// PasswordPolicyError is returned when a password does not satisfy the password policy
+type PasswordPolicyError struct {
+	Violations []string
+}
+
+func (e *PasswordPolicyError) Error() string {
+	return PasswordPolicyErrorPrefix + strings.Join(e.Violations, PasswordPolicyViolationSeparator)
+}
+
+// Validate checks the given password of the given account against the policy and returns a *PasswordPolicyError
+// listing all violations, or nil if the password satisfies the policy.
+func (p *PasswordPolicy) Validate(account, password string) error {
+	var violations []string
+	if len([]rune(password)) < p.MinLength {
+		violations = append(violations, fmt.Sprintf("must be at least %d characters long", p.MinLength))
+	}
+	for _, class := range p.CharacterClasses {
+		if !strings.ContainsFunc(password, passwordCharacterClasses[class]) {
+			violations = append(violations, fmt.Sprintf("must contain at least one %s character", class))
+		}
+	}
+	if account != "" && strings.EqualFold(password, account) {
+		violations = append(violations, "must not be the account name")
+	}
+	for _, banned := range p.BannedPasswords {
+		if strings.EqualFold(password, banned) {
+			violations = append(violations, "must not be a commonly used password")
+			break
+		}
+	}
+	if len(violations) > 0 {
+		return &PasswordPolicyError{Violations: violations}
+	}
+	return nil
+}
+
+// IsExpired returns whether a password changed at the given time has expired according to the policy
+func (p *PasswordPolicy) IsExpired(mtime *time.Time) bool {
+	return p.MaxAge > 0 && mtime != nil && time.Since(*mtime) > p.MaxAge
+}
+
+// GetPasswordPolicy returns the password policy configured in argocd-cm
+func (mgr *SettingsManager) GetPasswordPolicy() (*PasswordPolicy, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, err
+	}
+	return getPasswordPolicy(argoCDCM.Data)
+}
+
+func getPasswordPolicy(data map[string]string) (*PasswordPolicy, error) {
+	var policy PasswordPolicy
+	if val := data[passwordPolicyMinLengthKey]; val != "" {
+		minLength, err := strconv.Atoi(val)
+		if err != nil || minLength < 0 {
+			return nil, fmt.Errorf("invalid value %q for %s: must be a non-negative integer", val, passwordPolicyMinLengthKey)
+		}
+		policy.MinLength = minLength
+	}
+	for _, val := range strings.Split(data[passwordPolicyCharacterClassesKey], ",") {
+		class := PasswordCharacterClass(strings.TrimSpace(val))
+		if class == "" {
+			continue
+		}
+		if _, ok := passwordCharacterClasses[class]; !ok {
+			return nil, fmt.Errorf("invalid character class %q in %s: must be one of uppercase, lowercase, digit, symbol", class, passwordPolicyCharacterClassesKey)
+		}
+		policy.CharacterClasses = append(policy.CharacterClasses, class)
+	}
+	for _, line := range strings.Split(data[passwordPolicyBannedPasswordsKey], "\n") {
+		if banned := strings.TrimSpace(line); banned != "" {
+			policy.BannedPasswords = append(policy.BannedPasswords, banned)
+		}
+	}
+	if val := data[passwordPolicyMaxAgeKey]; val != "" {
+		maxAge, err := timeutil.ParseDuration(val)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %q for %s: %w", val, passwordPolicyMaxAgeKey, err)
+		}
+		policy.MaxAge = *maxAge
+	}
+	return &policy, nil
+}
@@ -0,0 +1,84 @@
+package settings
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPasswordPolicy(t *testing.T) {
+	t.Run("Default", func(t *testing.T) {
+		policy, err := getPasswordPolicy(map[string]string{})
+		require.NoError(t, err)
+		assert.Equal(t, &PasswordPolicy{}, policy)
+		require.NoError(t, policy.Validate("admin", "x"))
+	})
+
+	t.Run("Configured", func(t *testing.T) {
+		policy, err := getPasswordPolicy(map[string]string{
+			"passwordPolicy.minLength":        "10",
+			"passwordPolicy.characterClasses": "uppercase, lowercase,digit,symbol",
+			"passwordPolicy.bannedPasswords":  "password\n  qwerty123 \n\n",
+			"passwordPolicy.maxAge":           "90d",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, &PasswordPolicy{
+			MinLength:        10,
+			CharacterClasses: []PasswordCharacterClass{PasswordCharacterClassUppercase, PasswordCharacterClassLowercase, PasswordCharacterClassDigit, PasswordCharacterClassSymbol},
+			BannedPasswords:  []string{"password", "qwerty123"},
+			MaxAge:           90 * 24 * time.Hour,
+		}, policy)
+	})
+
+	t.Run("InvalidMinLength", func(t *testing.T) {
+		_, err := getPasswordPolicy(map[string]string{"passwordPolicy.minLength": "-1"})
+		assert.ErrorContains(t, err, "passwordPolicy.minLength")
+	})
+
+	t.Run("InvalidCharacterClass", func(t *testing.T) {
+		_, err := getPasswordPolicy(map[string]string{"passwordPolicy.characterClasses": "emoji"})
+		assert.ErrorContains(t, err, `invalid character class "emoji"`)
+	})
+
+	t.Run("InvalidMaxAge", func(t *testing.T) {
+		_, err := getPasswordPolicy(map[string]string{"passwordPolicy.maxAge": "forever"})
+		assert.ErrorContains(t, err, "passwordPolicy.maxAge")
+	})
+}
+
+func TestPasswordPolicy_Validate(t *testing.T) {
+	policy := &PasswordPolicy{
+		MinLength:        8,
+		CharacterClasses: []PasswordCharacterClass{PasswordCharacterClassUppercase, PasswordCharacterClassSymbol},
+		BannedPasswords:  []string{"Password!"},
+	}
+
+	require.NoError(t, policy.Validate("alice", "S3cret-Pass"))
+
+	err := policy.Validate("alice", "short")
+	var policyErr *PasswordPolicyError
+	require.ErrorAs(t, err, &policyErr)
+	assert.Equal(t, []string{
+		"must be at least 8 characters long",
+		"must contain at least one uppercase character",
+		"must contain at least one symbol character",
+	}, policyErr.Violations)
+
+	require.ErrorAs(t, policy.Validate("alice", "PASSWORD!"), &policyErr)
+	assert.Equal(t, []string{"must not be a commonly used password"}, policyErr.Violations)
+
+	require.ErrorAs(t, policy.Validate("Alice-Admin", "alice-admin"), &policyErr)
+	assert.Equal(t, []string{"must contain at least one uppercase character", "must not be the account name"}, policyErr.Violations)
+}
+
+func TestPasswordPolicy_IsExpired(t *testing.T) {
+	recently := time.Now().Add(-time.Hour)
+	longAgo := time.Now().Add(-48 * time.Hour)
+
+	assert.False(t, (&PasswordPolicy{}).IsExpired(&longAgo))
+	assert.False(t, (&PasswordPolicy{MaxAge: 24 * time.Hour}).IsExpired(nil))
+	assert.False(t, (&PasswordPolicy{MaxAge: 24 * time.Hour}).IsExpired(&recently))
+	assert.True(t, (&PasswordPolicy{MaxAge: 24 * time.Hour}).IsExpired(&longAgo))
+}
@@ -61,6 +61,8 @@ type ArgoCDSettings struct {
 	DexConfig string `json:"dexConfig,omitempty"`
 	// OIDCConfigRAW holds OIDC configuration as a raw string
 	OIDCConfigRAW string `json:"oidcConfig,omitempty"`
+	// LDAPConfigRAW holds the LDAP authentication configuration as a raw string
+	LDAPConfigRAW string `json:"ldapConfig,omitempty"`
 	// ServerSignature holds the key used to generate JWT tokens.
 	ServerSignature []byte `json:"serverSignature,omitempty"`
 	// Certificate holds the certificate/private key for the Argo CD API server.
@@ -90,6 +92,11 @@ type ArgoCDSettings struct {
 	AnonymousUserEnabled bool `json:"anonymousUserEnabled,omitempty"`
 	// Specifies token expiration duration
 	UserSessionDuration time.Duration `json:"userSessionDuration,omitempty"`
+	// UserSessionIdleTimeout is the duration of inactivity after which a UI/CLI session expires. Zero disables it.
+	UserSessionIdleTimeout time.Duration `json:"userSessionIdleTimeout,omitempty"`
+	// UserSessionMaxLifetime is the duration after login after which a UI/CLI session expires, even if it was renewed.
+	// Zero disables it.
+	UserSessionMaxLifetime time.Duration `json:"userSessionMaxLifetime,omitempty"`
 	// UiCssURL local or remote path to user-defined CSS to customize ArgoCD UI
 	UiCssURL string `json:"uiCssURL,omitempty"` //nolint:revive //FIXME(var-naming)
 	// Content of UI Banner
@@ -456,6 +463,8 @@ const (
 	settingsInstallationID = "installationID"
 	// resourcesCustomizationsKey is the key to the map of resource overrides
 	resourceCustomizationsKey = "resource.customizations"
+	// projectTemplateKeyPrefix is the prefix of the keys of the project templates, e.g. project.template.default
+	projectTemplateKeyPrefix = "project.template."
 	// resourceExclusions is the key to the list of excluded resources
 	resourceExclusionsKey = "resource.exclusions"
 	// resourceInclusions is the key to the list of explicitly watched resources
@@ -480,6 +489,10 @@ const (
 	anonymousUserEnabledKey = "users.anonymous.enabled"
 	// userSessionDurationKey is the key which specifies token expiration duration
 	userSessionDurationKey = "users.session.duration"
+	// userSessionIdleTimeoutKey is the key which specifies the duration of inactivity after which a session expires
+	userSessionIdleTimeoutKey = "users.session.idleTimeout"
+	// userSessionMaxLifetimeKey is the key which specifies the maximum lifetime of a session including renewals
+	userSessionMaxLifetimeKey = "users.session.maxLifetime"
 	// diffOptions is the key where diff options are configured
 	resourceCompareOptionsKey = "resource.compareoptions"
 	// settingUICSSURLKey designates the key for user-defined CSS URL for UI customization
@@ -955,6 +968,27 @@ func (mgr *SettingsManager) GetIsIgnoreResourceUpdatesEnabled() (bool, error) {
 	return strconv.ParseBool(argoCDCM.Data[resourceIgnoreResourceUpdatesEnabledKey])
 }
 
+// GetProjectTemplates loads the project templates from argocd-cm ConfigMap, keyed by the name of the template
+func (mgr *SettingsManager) GetProjectTemplates() (map[string]v1alpha1.AppProjectSpec, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving config map: %w", err)
+	}
+	templates := map[string]v1alpha1.AppProjectSpec{}
+	for k, v := range argoCDCM.Data {
+		name, ok := strings.CutPrefix(k, projectTemplateKeyPrefix)
+		if !ok || name == "" {
+			continue
+		}
+		var spec v1alpha1.AppProjectSpec
+		if err := yaml.Unmarshal([]byte(v), &spec); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal project template '%s': %w", name, err)
+		}
+		templates[name] = spec
+	}
+	return templates, nil
+}
+
 // GetResourceOverrides loads Resource Overrides from argocd-cm ConfigMap
 func (mgr *SettingsManager) GetResourceOverrides() (map[string]v1alpha1.ResourceOverride, error) {
 	argoCDCM, err := mgr.getConfigMap()
@@ -1385,6 +1419,7 @@ func getDownloadBinaryUrlsFromConfigMap(argoCDCM *corev1.ConfigMap) map[string]s
 func updateSettingsFromConfigMap(settings *ArgoCDSettings, argoCDCM *corev1.ConfigMap) {
 	settings.DexConfig = argoCDCM.Data[settingDexConfigKey]
 	settings.OIDCConfigRAW = argoCDCM.Data[settingsOIDCConfigKey]
+	settings.LDAPConfigRAW = argoCDCM.Data[settingsLDAPConfigKey]
 	settings.KustomizeBuildOptions = argoCDCM.Data[kustomizeBuildOptionsKey]
 	settings.StatusBadgeEnabled = argoCDCM.Data[statusBadgeEnabledKey] == "true"
 	settings.StatusBadgeRootUrl = argoCDCM.Data[statusBadgeRootURLKey]
@@ -1420,6 +1455,20 @@ func updateSettingsFromConfigMap(settings *ArgoCDSettings, argoCDCM *corev1.Conf
 			settings.UserSessionDuration = *val
 		}
 	}
+	if userSessionIdleTimeoutStr, ok := argoCDCM.Data[userSessionIdleTimeoutKey]; ok {
+		if val, err := timeutil.ParseDuration(userSessionIdleTimeoutStr); err != nil {
+			log.Warnf("Failed to parse '%s' key: %v", userSessionIdleTimeoutKey, err)
+		} else {
+			settings.UserSessionIdleTimeout = *val
+		}
+	}
+	if userSessionMaxLifetimeStr, ok := argoCDCM.Data[userSessionMaxLifetimeKey]; ok {
+		if val, err := timeutil.ParseDuration(userSessionMaxLifetimeStr); err != nil {
+			log.Warnf("Failed to parse '%s' key: %v", userSessionMaxLifetimeKey, err)
+		} else {
+			settings.UserSessionMaxLifetime = *val
+		}
+	}
 	settings.PasswordPattern = argoCDCM.Data[settingsPasswordPatternKey]
 	if settings.PasswordPattern == "" {
 		settings.PasswordPattern = common.PasswordPatten
@@ -1572,6 +1621,11 @@ func (mgr *SettingsManager) SaveSettings(settings *ArgoCDSettings) error {
 		} else {
 			delete(argoCDCM.Data, settingsOIDCConfigKey)
 		}
+		if settings.LDAPConfigRAW != "" {
+			argoCDCM.Data[settingsLDAPConfigKey] = settings.LDAPConfigRAW
+		} else {
+			delete(argoCDCM.Data, settingsLDAPConfigKey)
+		}
 		if settings.UiCssURL != "" {
 			argoCDCM.Data[settingUICSSURLKey] = settings.UiCssURL
 		}
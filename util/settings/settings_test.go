@@ -293,6 +293,34 @@ func TestGetIsIgnoreResourceUpdatesEnabledFalse(t *testing.T) {
 	assert.False(t, ignoreResourceUpdatesEnabled)
 }
 
+func TestGetProjectTemplates(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{
+		"project.template.standard": `
+description: Standard project
+sourceRepos:
+- https://github.com/my-org/*
+destinations:
+- server: https://kubernetes.default.svc
+  namespace: '*'
+`,
+		"project.template.empty": "",
+		"resource.exclusions":    "",
+	})
+	templates, err := settingsManager.GetProjectTemplates()
+	require.NoError(t, err)
+	assert.Len(t, templates, 2)
+	assert.Equal(t, "Standard project", templates["standard"].Description)
+	assert.Equal(t, []string{"https://github.com/my-org/*"}, templates["standard"].SourceRepos)
+	assert.Equal(t, []v1alpha1.ApplicationDestination{{Server: "https://kubernetes.default.svc", Namespace: "*"}}, templates["standard"].Destinations)
+	assert.Equal(t, v1alpha1.AppProjectSpec{}, templates["empty"])
+
+	_, settingsManager = fixtures(map[string]string{
+		"project.template.invalid": "sourceRepos: invalid",
+	})
+	_, err = settingsManager.GetProjectTemplates()
+	assert.ErrorContains(t, err, "failed to unmarshal project template 'invalid'")
+}
+
 func TestGetResourceOverrides(t *testing.T) {
 	ignoreStatus := v1alpha1.ResourceOverride{IgnoreDifferences: v1alpha1.OverrideIgnoreDiff{
 		JSONPointers: []string{"/status"},
@@ -1059,6 +1087,34 @@ func TestGetOIDCConfig(t *testing.T) {
 	assert.True(t, claim.Essential)
 }
 
+func TestGetLDAPConfig(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{
+		"ldap.config": `
+url: ldaps://ad.example.com
+bindDN: cn=argocd,dc=example,dc=com
+bindPassword: $ldap.bindPassword
+userSearch:
+  baseDN: ou=users,dc=example,dc=com
+groupSearch:
+  baseDN: ou=groups,dc=example,dc=com`,
+	}, func(secret *corev1.Secret) {
+		secret.Data["server.secretkey"] = nil
+		secret.Data["ldap.bindPassword"] = []byte("secret")
+	})
+	settings, err := settingsManager.GetSettings()
+	require.NoError(t, err)
+
+	ldapConfig := settings.LDAPConfig()
+	require.NotNil(t, ldapConfig)
+	assert.Equal(t, "secret", ldapConfig.BindPassword)
+	assert.Equal(t, "uid", ldapConfig.UserSearch.UsernameAttr)
+	assert.Equal(t, &LDAPGroupSearch{BaseDN: "ou=groups,dc=example,dc=com", UserAttr: "DN", GroupAttr: "member", NameAttr: "cn"}, ldapConfig.GroupSearch)
+
+	require.EqualError(t, ValidateLDAPConfig("url: ldaps://ad.example.com"), "userSearch.baseDN is required")
+	settings.LDAPConfigRAW = "userSearch: {baseDN: dc=example,dc=com}"
+	assert.Nil(t, settings.LDAPConfig())
+}
+
 func TestRedirectURL(t *testing.T) {
 	cases := map[string][]string{
 		"https://localhost:4000":         {"https://localhost:4000/auth/callback", "https://localhost:4000/api/dex/callback"},